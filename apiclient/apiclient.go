@@ -0,0 +1,270 @@
+// Package apiclient is a typed Go client for the kodit HTTP API (/api/v1).
+//
+// It is hand-written rather than generated: it reuses the server-side DTOs
+// from infrastructure/api/v1/dto directly, so response shapes can never
+// drift from what the server actually sends. Coverage favors the resources
+// most likely to be consumed by other Go services and CLI subcommands
+// (repositories, commits, search, wiki, tags); additional resources follow
+// the same Client.do / pagination pattern and can be added as their own
+// file under this package.
+package apiclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/helixml/kodit/infrastructure/api/middleware"
+)
+
+const defaultMaxRetries = 2
+
+const defaultRetryBackoff = 200 * time.Millisecond
+
+// Client is a typed HTTP client for the kodit REST API.
+type Client struct {
+	baseURL string
+	config  clientConfig
+}
+
+// clientConfig holds the settings applied by Option functions.
+type clientConfig struct {
+	httpClient   *http.Client
+	apiKey       string
+	maxRetries   int
+	retryBackoff time.Duration
+}
+
+// Option configures a Client.
+type Option func(*clientConfig)
+
+// WithHTTPClient overrides the http.Client used to send requests.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *clientConfig) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithAPIKey sets the X-API-KEY header sent with every request.
+func WithAPIKey(apiKey string) Option {
+	return func(c *clientConfig) {
+		c.apiKey = apiKey
+	}
+}
+
+// WithMaxRetries sets how many times a request is retried after a transient
+// failure (network error or 5xx response). The default is 2.
+func WithMaxRetries(maxRetries int) Option {
+	return func(c *clientConfig) {
+		c.maxRetries = maxRetries
+	}
+}
+
+// WithRetryBackoff sets the delay between retry attempts. The default is
+// 200ms.
+func WithRetryBackoff(backoff time.Duration) Option {
+	return func(c *clientConfig) {
+		c.retryBackoff = backoff
+	}
+}
+
+// New creates a Client for the kodit API hosted at baseURL, e.g.
+// "https://kodit.example.com". baseURL must not include the "/api/v1"
+// prefix; it is added to every request.
+func New(baseURL string, opts ...Option) (*Client, error) {
+	if baseURL == "" {
+		return nil, fmt.Errorf("apiclient: base URL is required")
+	}
+
+	config := clientConfig{
+		httpClient:   http.DefaultClient,
+		maxRetries:   defaultMaxRetries,
+		retryBackoff: defaultRetryBackoff,
+	}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	return &Client{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		config:  config,
+	}, nil
+}
+
+// APIError represents a JSON:API error returned by the server.
+type APIError struct {
+	Status int
+	Title  string
+	Detail string
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	if e.Detail != "" {
+		return fmt.Sprintf("apiclient: %d %s: %s", e.Status, e.Title, e.Detail)
+	}
+	return fmt.Sprintf("apiclient: %d %s", e.Status, e.Title)
+}
+
+// do sends an HTTP request against path (relative to "/api/v1"), encoding
+// body as JSON when non-nil and decoding the response into out when
+// non-nil. Transient network errors and 5xx responses are retried up to
+// config.maxRetries times.
+func (c *Client) do(ctx context.Context, method, path string, body, out any) error {
+	var payload []byte
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("apiclient: failed to encode request body: %w", err)
+		}
+		payload = encoded
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= c.config.maxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(c.config.retryBackoff):
+			}
+		}
+
+		resp, err := c.send(ctx, method, path, payload)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		retryable, decodeErr := c.decode(resp, out)
+		if decodeErr == nil {
+			return nil
+		}
+		lastErr = decodeErr
+		if !retryable {
+			return lastErr
+		}
+	}
+
+	return fmt.Errorf("apiclient: request failed after %d attempts: %w", c.config.maxRetries+1, lastErr)
+}
+
+// doRaw is like do, but returns the raw response body instead of decoding
+// it as JSON. It is used for endpoints that respond with a content type
+// other than application/json, such as the wiki page endpoint's markdown.
+func (c *Client) doRaw(ctx context.Context, method, path string) (string, error) {
+	var lastErr error
+	for attempt := 0; attempt <= c.config.maxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return "", ctx.Err()
+			case <-time.After(c.config.retryBackoff):
+			}
+		}
+
+		resp, err := c.send(ctx, method, path, nil)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		body, retryable, decodeErr := c.decodeRaw(resp)
+		if decodeErr == nil {
+			return body, nil
+		}
+		lastErr = decodeErr
+		if !retryable {
+			return "", lastErr
+		}
+	}
+
+	return "", fmt.Errorf("apiclient: request failed after %d attempts: %w", c.config.maxRetries+1, lastErr)
+}
+
+// decodeRaw reads resp's body as-is, translating non-2xx responses into an
+// *APIError in the same way as decode.
+func (c *Client) decodeRaw(resp *http.Response) (body string, retryable bool, err error) {
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", true, fmt.Errorf("apiclient: failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		return string(data), false, nil
+	}
+
+	apiErr := &APIError{Status: resp.StatusCode, Title: http.StatusText(resp.StatusCode)}
+	var errResp middleware.JSONAPIErrorResponse
+	if err := json.Unmarshal(data, &errResp); err == nil && len(errResp.Errors) > 0 {
+		apiErr.Title = errResp.Errors[0].Title
+		apiErr.Detail = errResp.Errors[0].Detail
+	}
+
+	return "", resp.StatusCode >= http.StatusInternalServerError, apiErr
+}
+
+// send issues a single HTTP request without retrying.
+func (c *Client) send(ctx context.Context, method, path string, payload []byte) (*http.Response, error) {
+	url := c.baseURL + "/api/v1" + path
+
+	var reader io.Reader
+	if payload != nil {
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, reader)
+	if err != nil {
+		return nil, fmt.Errorf("apiclient: failed to build request: %w", err)
+	}
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.config.apiKey != "" {
+		req.Header.Set("X-API-KEY", c.config.apiKey)
+	}
+
+	resp, err := c.config.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("apiclient: request to %s failed: %w", url, err)
+	}
+	return resp, nil
+}
+
+// decode reads resp into out, translating non-2xx responses into an
+// *APIError. The returned bool reports whether the error is retryable
+// (server errors and malformed bodies on a 5xx response).
+func (c *Client) decode(resp *http.Response, out any) (retryable bool, err error) {
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return true, fmt.Errorf("apiclient: failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		if out == nil || len(data) == 0 {
+			return false, nil
+		}
+		if err := json.Unmarshal(data, out); err != nil {
+			return false, fmt.Errorf("apiclient: failed to decode response body: %w", err)
+		}
+		return false, nil
+	}
+
+	apiErr := &APIError{Status: resp.StatusCode, Title: http.StatusText(resp.StatusCode)}
+	var errResp middleware.JSONAPIErrorResponse
+	if err := json.Unmarshal(data, &errResp); err == nil && len(errResp.Errors) > 0 {
+		apiErr.Title = errResp.Errors[0].Title
+		apiErr.Detail = errResp.Errors[0].Detail
+	}
+
+	return resp.StatusCode >= http.StatusInternalServerError, apiErr
+}
@@ -0,0 +1,98 @@
+package apiclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/helixml/kodit/infrastructure/api/jsonapi"
+)
+
+// Page is one page of results for a paginated list endpoint, along with the
+// total number of items across all pages.
+type Page[T any] struct {
+	Items      []T
+	TotalCount int
+	hasNext    bool
+}
+
+// Paginator walks a paginated list endpoint page by page.
+type Paginator[T any] struct {
+	fetch    func(ctx context.Context, page, pageSize int) (Page[T], error)
+	pageSize int
+	next     int
+	done     bool
+}
+
+// newPaginator builds a Paginator that calls fetch to retrieve each page,
+// starting at page 1 with pageSize results per page.
+func newPaginator[T any](pageSize int, fetch func(ctx context.Context, page, pageSize int) (Page[T], error)) *Paginator[T] {
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+	return &Paginator[T]{fetch: fetch, pageSize: pageSize, next: 1}
+}
+
+// Next fetches the next page of results. It returns an empty slice and no
+// error once every page has been retrieved.
+func (p *Paginator[T]) Next(ctx context.Context) ([]T, error) {
+	if p.done {
+		return nil, nil
+	}
+
+	page, err := p.fetch(ctx, p.next, p.pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("apiclient: failed to fetch page %d: %w", p.next, err)
+	}
+
+	if !page.hasNext {
+		p.done = true
+	}
+	p.next++
+
+	return page.Items, nil
+}
+
+// newPage builds a Page from a list endpoint's data and JSON:API links,
+// treating a non-empty "next" link as evidence that more pages remain.
+func newPage[T any](items []T, links *jsonapi.Links, meta *jsonapi.Meta) Page[T] {
+	page := Page[T]{Items: items}
+	if links != nil {
+		page.hasNext = links.Next != ""
+	}
+	if meta != nil {
+		if total, ok := (*meta)["total_count"]; ok {
+			if count, ok := total.(int); ok {
+				page.TotalCount = count
+			} else if count, ok := total.(float64); ok {
+				page.TotalCount = int(count)
+			}
+		}
+	}
+	return page
+}
+
+// listPath builds a list endpoint path with page/page_size query params.
+func listPath(path string, page, pageSize int) string {
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+	return fmt.Sprintf("%s?page=%d&page_size=%d", path, page, pageSize)
+}
+
+// All drains the Paginator, collecting every item across every page.
+func (p *Paginator[T]) All(ctx context.Context) ([]T, error) {
+	var all []T
+	for {
+		items, err := p.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if len(items) == 0 && p.done {
+			return all, nil
+		}
+		all = append(all, items...)
+	}
+}
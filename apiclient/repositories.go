@@ -0,0 +1,91 @@
+package apiclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/helixml/kodit/infrastructure/api/v1/dto"
+)
+
+// ListRepositories returns a single page of tracked repositories.
+func (c *Client) ListRepositories(ctx context.Context, page, pageSize int) (Page[dto.RepositoryData], error) {
+	var resp dto.RepositoryListResponse
+	if err := c.do(ctx, "GET", listPath("/repositories", page, pageSize), nil, &resp); err != nil {
+		return Page[dto.RepositoryData]{}, err
+	}
+	return newPage(resp.Data, resp.Links, resp.Meta), nil
+}
+
+// RepositoriesPaginator returns a Paginator that walks every tracked
+// repository, pageSize results at a time.
+func (c *Client) RepositoriesPaginator(pageSize int) *Paginator[dto.RepositoryData] {
+	return newPaginator(pageSize, c.ListRepositories)
+}
+
+// GetRepository fetches a repository by ID.
+func (c *Client) GetRepository(ctx context.Context, id int64) (*dto.RepositoryDetailsResponse, error) {
+	var resp dto.RepositoryDetailsResponse
+	if err := c.do(ctx, "GET", fmt.Sprintf("/repositories/%d", id), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// AddRepository adds a new repository to track.
+func (c *Client) AddRepository(ctx context.Context, remoteURI, upstreamURL, pipeline string) (*dto.RepositoryResponse, error) {
+	body := dto.RepositoryCreateRequest{
+		Data: dto.RepositoryCreateData{
+			Type: "repository",
+			Attributes: dto.RepositoryCreateAttributes{
+				RemoteURI:   remoteURI,
+				UpstreamURL: upstreamURL,
+				Pipeline:    pipeline,
+			},
+		},
+	}
+
+	var resp dto.RepositoryResponse
+	if err := c.do(ctx, "POST", "/repositories", body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DeleteRepository removes a repository by ID.
+func (c *Client) DeleteRepository(ctx context.Context, id int64) error {
+	return c.do(ctx, "DELETE", fmt.Sprintf("/repositories/%d", id), nil, nil)
+}
+
+// ListCommits returns a single page of commits for a repository.
+func (c *Client) ListCommits(ctx context.Context, repoID int64, page, pageSize int) (Page[dto.CommitData], error) {
+	var resp dto.CommitJSONAPIListResponse
+	if err := c.do(ctx, "GET", listPath(fmt.Sprintf("/repositories/%d/commits", repoID), page, pageSize), nil, &resp); err != nil {
+		return Page[dto.CommitData]{}, err
+	}
+	return newPage(resp.Data, resp.Links, resp.Meta), nil
+}
+
+// CommitsPaginator returns a Paginator that walks every commit of a
+// repository, pageSize results at a time.
+func (c *Client) CommitsPaginator(repoID int64, pageSize int) *Paginator[dto.CommitData] {
+	return newPaginator(pageSize, func(ctx context.Context, page, size int) (Page[dto.CommitData], error) {
+		return c.ListCommits(ctx, repoID, page, size)
+	})
+}
+
+// ListTags returns a single page of tags for a repository.
+func (c *Client) ListTags(ctx context.Context, repoID int64, page, pageSize int) (Page[dto.TagData], error) {
+	var resp dto.TagJSONAPIListResponse
+	if err := c.do(ctx, "GET", listPath(fmt.Sprintf("/repositories/%d/tags", repoID), page, pageSize), nil, &resp); err != nil {
+		return Page[dto.TagData]{}, err
+	}
+	return newPage(resp.Data, resp.Links, resp.Meta), nil
+}
+
+// TagsPaginator returns a Paginator that walks every tag of a repository,
+// pageSize results at a time.
+func (c *Client) TagsPaginator(repoID int64, pageSize int) *Paginator[dto.TagData] {
+	return newPaginator(pageSize, func(ctx context.Context, page, size int) (Page[dto.TagData], error) {
+		return c.ListTags(ctx, repoID, page, size)
+	})
+}
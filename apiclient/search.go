@@ -0,0 +1,71 @@
+package apiclient
+
+import (
+	"context"
+
+	"github.com/helixml/kodit/infrastructure/api/v1/dto"
+)
+
+// SearchQuery is the set of parameters accepted by Client.Search.
+type SearchQuery struct {
+	Keywords  []string
+	Code      *string
+	Text      *string
+	Limit     *int
+	Filters   *dto.SearchFilters
+	GroupBy   *string
+	Namespace *string
+}
+
+// Search runs a hybrid search across code snippets and enrichments. It does
+// not support GroupBy: "file", which makes the server return a differently
+// shaped GroupedSearchResponse.
+func (c *Client) Search(ctx context.Context, query SearchQuery) (*dto.SearchResponse, error) {
+	body := dto.SearchRequest{
+		Data: dto.SearchData{
+			Type: "search",
+			Attributes: dto.SearchAttributes{
+				Keywords:  query.Keywords,
+				Code:      query.Code,
+				Text:      query.Text,
+				Limit:     query.Limit,
+				Filters:   query.Filters,
+				GroupBy:   query.GroupBy,
+				Namespace: query.Namespace,
+			},
+		},
+	}
+
+	var resp dto.SearchResponse
+	if err := c.do(ctx, "POST", "/search", body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// SearchDebug runs the same hybrid search as Search, but returns a
+// step-by-step trace of the pipeline (query expansion, candidate retrieval,
+// fusion, reranking) alongside the results, for diagnosing why a result
+// ranks where it does.
+func (c *Client) SearchDebug(ctx context.Context, query SearchQuery) (*dto.SearchDebugResponse, error) {
+	body := dto.SearchRequest{
+		Data: dto.SearchData{
+			Type: "search",
+			Attributes: dto.SearchAttributes{
+				Keywords:  query.Keywords,
+				Code:      query.Code,
+				Text:      query.Text,
+				Limit:     query.Limit,
+				Filters:   query.Filters,
+				GroupBy:   query.GroupBy,
+				Namespace: query.Namespace,
+			},
+		},
+	}
+
+	var resp dto.SearchDebugResponse
+	if err := c.do(ctx, "POST", "/search/debug", body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,41 @@
+package apiclient
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/helixml/kodit/infrastructure/api/v1/dto"
+)
+
+// GetWikiTree fetches the navigation tree for a repository's wiki.
+func (c *Client) GetWikiTree(ctx context.Context, repoID int64) (*dto.WikiTreeResponse, error) {
+	var resp dto.WikiTreeResponse
+	if err := c.do(ctx, "GET", fmt.Sprintf("/repositories/%d/wiki", repoID), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetWikiPage fetches the rendered markdown content of a single wiki page
+// by slug path, e.g. "guides/getting-started".
+func (c *Client) GetWikiPage(ctx context.Context, repoID int64, pagePath string) (string, error) {
+	path := fmt.Sprintf("/repositories/%d/wiki/%s", repoID, pagePath)
+	return c.doRaw(ctx, "GET", path)
+}
+
+// SearchWiki searches a repository's wiki pages by meaning and keyword.
+func (c *Client) SearchWiki(ctx context.Context, repoID int64, query string, limit int) (*dto.WikiSearchResponse, error) {
+	params := url.Values{}
+	params.Set("query", query)
+	if limit > 0 {
+		params.Set("limit", fmt.Sprintf("%d", limit))
+	}
+
+	var resp dto.WikiSearchResponse
+	path := fmt.Sprintf("/repositories/%d/wiki/search?%s", repoID, params.Encode())
+	if err := c.do(ctx, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
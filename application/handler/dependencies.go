@@ -12,11 +12,27 @@ import (
 
 // EnrichmentContext holds the stores and services shared by all enrichment handlers.
 type EnrichmentContext struct {
-	Enrichments  enrichment.EnrichmentStore
-	Associations enrichment.AssociationStore
-	Enricher     domainservice.Enricher
-	Tracker      TrackerFactory
-	Logger       zerolog.Logger
+	Enrichments      enrichment.EnrichmentStore
+	Associations     enrichment.AssociationStore
+	Enricher         domainservice.Enricher
+	Tracker          TrackerFactory
+	Logger           zerolog.Logger
+	LanguageDetector domainservice.LanguageDetector
+}
+
+// LanguageOptions resolves the enrich options that pin the output language
+// for repo: its explicit enrichment language override if set, otherwise the
+// dominant human language detected in the first request's text. Returns no
+// options if neither yields a language, leaving the choice to the model.
+func (c EnrichmentContext) LanguageOptions(repo repository.Repository, requests []domainservice.EnrichmentRequest) []domainservice.EnrichOption {
+	lang := repo.EnrichmentLanguage()
+	if lang == "" && c.LanguageDetector != nil && len(requests) > 0 {
+		lang = c.LanguageDetector.Detect(requests[0].Text())
+	}
+	if lang == "" {
+		return nil
+	}
+	return []domainservice.EnrichOption{domainservice.WithOutputLanguage(lang)}
 }
 
 // VectorIndex pairs an embedding domain service with its backing vector store.
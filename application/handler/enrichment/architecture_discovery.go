@@ -9,6 +9,7 @@ import (
 	"github.com/helixml/kodit/domain/repository"
 	domainservice "github.com/helixml/kodit/domain/service"
 	"github.com/helixml/kodit/domain/task"
+	"github.com/helixml/kodit/domain/usage"
 )
 
 const architectureSystemPrompt = `
@@ -67,6 +68,7 @@ func (h *ArchitectureDiscovery) Execute(ctx context.Context, payload map[string]
 	if err != nil {
 		return err
 	}
+	ctx = usage.ContextWithRepositoryID(ctx, fmt.Sprintf("%d", cp.RepoID()))
 
 	tracker := h.enrichCtx.Tracker.ForOperation(
 		task.OperationCreateArchitectureEnrichmentForCommit,
@@ -110,7 +112,7 @@ func (h *ArchitectureDiscovery) Execute(ctx context.Context, payload map[string]
 		domainservice.NewEnrichmentRequest(cp.CommitSHA(), taskPrompt, architectureSystemPrompt),
 	}
 
-	responses, err := h.enrichCtx.Enricher.Enrich(ctx, requests)
+	responses, err := h.enrichCtx.Enricher.Enrich(ctx, requests, h.enrichCtx.LanguageOptions(repo, requests)...)
 	if err != nil {
 		return fmt.Errorf("enrich architecture: %w", err)
 	}
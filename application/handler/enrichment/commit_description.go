@@ -9,6 +9,7 @@ import (
 	"github.com/helixml/kodit/domain/repository"
 	domainservice "github.com/helixml/kodit/domain/service"
 	"github.com/helixml/kodit/domain/task"
+	"github.com/helixml/kodit/domain/usage"
 	infraGit "github.com/helixml/kodit/infrastructure/git"
 )
 
@@ -52,6 +53,7 @@ func (h *CommitDescription) Execute(ctx context.Context, payload map[string]any)
 	if err != nil {
 		return err
 	}
+	ctx = usage.ContextWithRepositoryID(ctx, fmt.Sprintf("%d", cp.RepoID()))
 
 	tracker := h.enrichCtx.Tracker.ForOperation(
 		task.OperationCreateCommitDescriptionForCommit,
@@ -82,7 +84,7 @@ func (h *CommitDescription) Execute(ctx context.Context, payload map[string]any)
 	tracker.SetTotal(ctx, 3)
 	tracker.SetCurrent(ctx, 1, "Getting commit diff")
 
-	diff, err := h.adapter.CommitDiff(ctx, clonedPath, cp.CommitSHA())
+	diff, err := h.adapter.CommitDiff(ctx, clonedPath, cp.CommitSHA(), "")
 	if err != nil {
 		return fmt.Errorf("get commit diff: %w", err)
 	}
@@ -98,7 +100,7 @@ func (h *CommitDescription) Execute(ctx context.Context, payload map[string]any)
 		domainservice.NewEnrichmentRequest(cp.CommitSHA(), TruncateDiff(diff, MaxDiffLength), commitDescriptionSystemPrompt),
 	}
 
-	responses, err := h.enrichCtx.Enricher.Enrich(ctx, requests)
+	responses, err := h.enrichCtx.Enricher.Enrich(ctx, requests, h.enrichCtx.LanguageOptions(repo, requests)...)
 	if err != nil {
 		return fmt.Errorf("enrich commit description: %w", err)
 	}
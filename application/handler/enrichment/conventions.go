@@ -0,0 +1,182 @@
+package enrichment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/helixml/kodit/application/handler"
+	"github.com/helixml/kodit/domain/enrichment"
+	"github.com/helixml/kodit/domain/repository"
+	domainservice "github.com/helixml/kodit/domain/service"
+	"github.com/helixml/kodit/domain/task"
+	"github.com/helixml/kodit/domain/usage"
+)
+
+const conventionsSystemPrompt = `
+You are a senior engineer documenting a codebase's conventions for new contributors.
+You will be given representative source files sampled across the repository's languages.
+Produce a concise "code conventions" document covering naming, error handling, and test patterns.
+`
+
+const conventionsTaskPrompt = `
+Based on the following representative source samples, document the repository's code conventions:
+
+<samples>
+%s
+</samples>
+
+Produce a document with these sections:
+1. Naming conventions (files, types, functions, variables)
+2. Error handling patterns
+3. Test patterns (layout, naming, fixtures)
+
+Only describe patterns you can observe in the samples. Keep it concise and concrete.
+`
+
+// ConventionsContextGatherer gathers representative source samples for
+// conventions generation.
+type ConventionsContextGatherer interface {
+	Gather(ctx context.Context, repoPath string, languages []string) (string, error)
+}
+
+// Conventions handles the CREATE_CONVENTIONS_FOR_COMMIT operation.
+type Conventions struct {
+	repoStore       repository.RepositoryStore
+	fileStore       repository.FileStore
+	enrichCtx       handler.EnrichmentContext
+	contextGatherer ConventionsContextGatherer
+}
+
+// NewConventions creates a new Conventions handler.
+func NewConventions(
+	repoStore repository.RepositoryStore,
+	fileStore repository.FileStore,
+	enrichCtx handler.EnrichmentContext,
+	contextGatherer ConventionsContextGatherer,
+) (*Conventions, error) {
+	if repoStore == nil {
+		return nil, fmt.Errorf("NewConventions: nil repoStore")
+	}
+	if fileStore == nil {
+		return nil, fmt.Errorf("NewConventions: nil fileStore")
+	}
+	if enrichCtx.Enricher == nil {
+		return nil, fmt.Errorf("NewConventions: nil Enricher")
+	}
+	if contextGatherer == nil {
+		return nil, fmt.Errorf("NewConventions: nil contextGatherer")
+	}
+	return &Conventions{
+		repoStore:       repoStore,
+		fileStore:       fileStore,
+		enrichCtx:       enrichCtx,
+		contextGatherer: contextGatherer,
+	}, nil
+}
+
+// Execute processes the CREATE_CONVENTIONS_FOR_COMMIT task.
+func (h *Conventions) Execute(ctx context.Context, payload map[string]any) error {
+	cp, err := handler.ExtractCommitPayload(payload)
+	if err != nil {
+		return err
+	}
+	ctx = usage.ContextWithRepositoryID(ctx, fmt.Sprintf("%d", cp.RepoID()))
+
+	tracker := h.enrichCtx.Tracker.ForOperation(
+		task.OperationCreateConventionsForCommit,
+		payload,
+	)
+
+	count, err := h.enrichCtx.Enrichments.Count(ctx, enrichment.WithCommitSHA(cp.CommitSHA()), enrichment.WithType(enrichment.TypeUsage), enrichment.WithSubtype(enrichment.SubtypeConventions))
+	if err != nil {
+		h.enrichCtx.Logger.Error().Str("error", err.Error()).Msg("failed to check existing conventions doc")
+		return err
+	}
+
+	if count > 0 {
+		tracker.Skip(ctx, "Conventions document already exists for commit")
+		return nil
+	}
+
+	repo, err := h.repoStore.FindOne(ctx, repository.WithID(cp.RepoID()))
+	if err != nil {
+		return fmt.Errorf("get repository: %w", err)
+	}
+
+	clonedPath := repo.WorkingCopy().Path()
+	if clonedPath == "" {
+		return fmt.Errorf("repository %d has never been cloned", cp.RepoID())
+	}
+
+	tracker.SetTotal(ctx, 3)
+	tracker.SetCurrent(ctx, 1, "Getting files for conventions sampling")
+
+	files, err := h.fileStore.Find(ctx, repository.WithCommitSHA(cp.CommitSHA()))
+	if err != nil {
+		return fmt.Errorf("get files: %w", err)
+	}
+
+	if len(files) == 0 {
+		tracker.Skip(ctx, "No files to derive conventions from")
+		return nil
+	}
+
+	languages := distinctLanguages(files)
+	if len(languages) == 0 {
+		tracker.Skip(ctx, "No supported languages found for conventions")
+		return nil
+	}
+
+	tracker.SetCurrent(ctx, 2, "Sampling representative files per language")
+
+	samples, err := h.contextGatherer.Gather(ctx, clonedPath, languages)
+	if err != nil {
+		return fmt.Errorf("gather conventions samples: %w", err)
+	}
+
+	tracker.SetCurrent(ctx, 3, "Generating conventions document with LLM")
+
+	taskPrompt := fmt.Sprintf(conventionsTaskPrompt, samples)
+	requests := []domainservice.EnrichmentRequest{
+		domainservice.NewEnrichmentRequest(cp.CommitSHA(), taskPrompt, conventionsSystemPrompt),
+	}
+
+	responses, err := h.enrichCtx.Enricher.Enrich(ctx, requests, h.enrichCtx.LanguageOptions(repo, requests)...)
+	if err != nil {
+		return fmt.Errorf("enrich conventions: %w", err)
+	}
+
+	if len(responses) == 0 {
+		return fmt.Errorf("no enrichment response for commit %s", cp.CommitSHA())
+	}
+
+	conventionsEnrichment := enrichment.NewConventions(responses[0].Text())
+	saved, err := h.enrichCtx.Enrichments.Save(ctx, conventionsEnrichment)
+	if err != nil {
+		return fmt.Errorf("save conventions enrichment: %w", err)
+	}
+
+	commitAssoc := enrichment.CommitAssociation(saved.ID(), cp.CommitSHA())
+	if _, err := h.enrichCtx.Associations.Save(ctx, commitAssoc); err != nil {
+		return fmt.Errorf("save commit association: %w", err)
+	}
+
+	return nil
+}
+
+func distinctLanguages(files []repository.File) []string {
+	seen := make(map[string]bool)
+	var languages []string
+	for _, f := range files {
+		lang := f.Language()
+		if lang == "" || seen[lang] {
+			continue
+		}
+		seen[lang] = true
+		languages = append(languages, lang)
+	}
+	return languages
+}
+
+// Ensure Conventions implements handler.Handler.
+var _ handler.Handler = (*Conventions)(nil)
@@ -9,6 +9,7 @@ import (
 	"github.com/helixml/kodit/domain/repository"
 	domainservice "github.com/helixml/kodit/domain/service"
 	"github.com/helixml/kodit/domain/task"
+	"github.com/helixml/kodit/domain/usage"
 )
 
 const cookbookSystemPrompt = `
@@ -78,6 +79,7 @@ func (h *Cookbook) Execute(ctx context.Context, payload map[string]any) error {
 	if err != nil {
 		return err
 	}
+	ctx = usage.ContextWithRepositoryID(ctx, fmt.Sprintf("%d", cp.RepoID()))
 
 	tracker := h.enrichCtx.Tracker.ForOperation(
 		task.OperationCreateCookbookForCommit,
@@ -140,7 +142,7 @@ func (h *Cookbook) Execute(ctx context.Context, payload map[string]any) error {
 		domainservice.NewEnrichmentRequest(cp.CommitSHA(), taskPrompt, cookbookSystemPrompt),
 	}
 
-	responses, err := h.enrichCtx.Enricher.Enrich(ctx, requests)
+	responses, err := h.enrichCtx.Enricher.Enrich(ctx, requests, h.enrichCtx.LanguageOptions(repo, requests)...)
 	if err != nil {
 		return fmt.Errorf("enrich cookbook: %w", err)
 	}
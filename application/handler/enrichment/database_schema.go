@@ -10,6 +10,7 @@ import (
 	"github.com/helixml/kodit/domain/repository"
 	domainservice "github.com/helixml/kodit/domain/service"
 	"github.com/helixml/kodit/domain/task"
+	"github.com/helixml/kodit/domain/usage"
 )
 
 const databaseSchemaSystemPrompt = `
@@ -114,6 +115,7 @@ func (h *DatabaseSchema) Execute(ctx context.Context, payload map[string]any) er
 	if err != nil {
 		return err
 	}
+	ctx = usage.ContextWithRepositoryID(ctx, fmt.Sprintf("%d", cp.RepoID()))
 
 	tracker := h.enrichCtx.Tracker.ForOperation(
 		task.OperationCreateDatabaseSchemaForCommit,
@@ -161,7 +163,7 @@ func (h *DatabaseSchema) Execute(ctx context.Context, payload map[string]any) er
 		domainservice.NewEnrichmentRequest(cp.CommitSHA(), taskPrompt, databaseSchemaSystemPrompt),
 	}
 
-	responses, err := h.enrichCtx.Enricher.Enrich(ctx, requests)
+	responses, err := h.enrichCtx.Enricher.Enrich(ctx, requests, h.enrichCtx.LanguageOptions(repo, requests)...)
 	if err != nil {
 		return fmt.Errorf("enrich database schema: %w", err)
 	}
@@ -0,0 +1,202 @@
+package enrichment
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/helixml/kodit/application/handler"
+	"github.com/helixml/kodit/domain/enrichment"
+	"github.com/helixml/kodit/domain/repository"
+	domainservice "github.com/helixml/kodit/domain/service"
+	"github.com/helixml/kodit/domain/task"
+	"github.com/helixml/kodit/domain/usage"
+)
+
+const directorySummarySystemPrompt = `
+You are a technical writer who produces concise summaries of codebase
+directories. Given the summaries of the files directly within a directory,
+describe the directory's purpose in 2-4 sentences.
+`
+
+const directorySummaryTaskPrompt = `
+Summarize the following directory, given summaries of the files within it:
+
+<file_summaries>
+%s
+</file_summaries>
+`
+
+// DirectorySummary handles the CREATE_DIRECTORY_SUMMARY_FOR_COMMIT operation.
+// It aggregates the file summaries of the files directly within each
+// directory into a single AI-generated directory summary, forming a
+// file-to-directory-to-repository hierarchy used by the wiki generator.
+type DirectorySummary struct {
+	repoStore repository.RepositoryStore
+	fileStore repository.FileStore
+	enrichCtx handler.EnrichmentContext
+}
+
+// NewDirectorySummary creates a new DirectorySummary handler.
+func NewDirectorySummary(
+	repoStore repository.RepositoryStore,
+	fileStore repository.FileStore,
+	enrichCtx handler.EnrichmentContext,
+) (*DirectorySummary, error) {
+	if repoStore == nil {
+		return nil, fmt.Errorf("NewDirectorySummary: nil repoStore")
+	}
+	if fileStore == nil {
+		return nil, fmt.Errorf("NewDirectorySummary: nil fileStore")
+	}
+	if enrichCtx.Enricher == nil {
+		return nil, fmt.Errorf("NewDirectorySummary: nil Enricher")
+	}
+	return &DirectorySummary{
+		repoStore: repoStore,
+		fileStore: fileStore,
+		enrichCtx: enrichCtx,
+	}, nil
+}
+
+// Execute processes the CREATE_DIRECTORY_SUMMARY_FOR_COMMIT task.
+func (h *DirectorySummary) Execute(ctx context.Context, payload map[string]any) error {
+	cp, err := handler.ExtractCommitPayload(payload)
+	if err != nil {
+		return err
+	}
+	ctx = usage.ContextWithRepositoryID(ctx, fmt.Sprintf("%d", cp.RepoID()))
+
+	tracker := h.enrichCtx.Tracker.ForOperation(
+		task.OperationCreateDirectorySummaryForCommit,
+		payload,
+	)
+
+	count, err := h.enrichCtx.Enrichments.Count(ctx,
+		enrichment.WithCommitSHA(cp.CommitSHA()),
+		enrichment.WithType(enrichment.TypeDevelopment),
+		enrichment.WithSubtype(enrichment.SubtypeDirectorySummary),
+	)
+	if err != nil {
+		return fmt.Errorf("check existing directory summaries: %w", err)
+	}
+	if count > 0 {
+		tracker.Skip(ctx, "Directory summaries already exist for commit")
+		return nil
+	}
+
+	files, err := h.fileStore.Find(ctx, repository.WithCommitSHA(cp.CommitSHA()))
+	if err != nil {
+		return fmt.Errorf("get commit files: %w", err)
+	}
+	if len(files) == 0 {
+		tracker.Skip(ctx, "No files found for commit")
+		return nil
+	}
+
+	dirFileIDs := make(map[string][]int64)
+	for _, f := range files {
+		if f.ID() == 0 {
+			continue
+		}
+		dir := path.Dir(f.Path())
+		dirFileIDs[dir] = append(dirFileIDs[dir], f.ID())
+	}
+
+	tracker.SetTotal(ctx, len(dirFileIDs))
+
+	requests := make([]domainservice.EnrichmentRequest, 0, len(dirFileIDs))
+	i := 0
+	for dir, fileIDs := range dirFileIDs {
+		tracker.SetCurrent(ctx, i, fmt.Sprintf("Gathering file summaries for %s", dir))
+		i++
+
+		content, gatherErr := h.gatherFileSummaries(ctx, fileIDs)
+		if gatherErr != nil {
+			return gatherErr
+		}
+		if content == "" {
+			continue
+		}
+
+		requests = append(requests, domainservice.NewEnrichmentRequest(dir, fmt.Sprintf(directorySummaryTaskPrompt, content), directorySummarySystemPrompt))
+	}
+
+	if len(requests) == 0 {
+		tracker.Skip(ctx, "No directories with summarized files")
+		return nil
+	}
+
+	repo, err := h.repoStore.FindOne(ctx, repository.WithID(cp.RepoID()))
+	if err != nil {
+		return fmt.Errorf("get repository: %w", err)
+	}
+
+	responses, err := h.enrichCtx.Enricher.Enrich(ctx, requests, h.enrichCtx.LanguageOptions(repo, requests)...)
+	if err != nil {
+		return fmt.Errorf("enrich directory summaries: %w", err)
+	}
+
+	for _, resp := range responses {
+		saved, saveErr := h.enrichCtx.Enrichments.Save(ctx, enrichment.NewDirectorySummary(resp.Text()))
+		if saveErr != nil {
+			return fmt.Errorf("save directory summary enrichment: %w", saveErr)
+		}
+
+		if _, err := h.enrichCtx.Associations.Save(ctx, enrichment.CommitAssociation(saved.ID(), cp.CommitSHA())); err != nil {
+			return fmt.Errorf("save commit association: %w", err)
+		}
+		if _, err := h.enrichCtx.Associations.Save(ctx, enrichment.DirectoryAssociation(saved.ID(), cp.CommitSHA(), resp.ID())); err != nil {
+			return fmt.Errorf("save directory association: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// gatherFileSummaries concatenates the file summary enrichments for the
+// given file IDs. Returns "" if none of the files have a summary yet.
+func (h *DirectorySummary) gatherFileSummaries(ctx context.Context, fileIDs []int64) (string, error) {
+	entityIDs := make([]string, 0, len(fileIDs))
+	for _, id := range fileIDs {
+		entityIDs = append(entityIDs, strconv.FormatInt(id, 10))
+	}
+
+	assocs, err := h.enrichCtx.Associations.Find(ctx,
+		enrichment.WithEntityType(enrichment.EntityTypeFile),
+		enrichment.WithEntityIDIn(entityIDs),
+	)
+	if err != nil {
+		return "", fmt.Errorf("find file associations: %w", err)
+	}
+	if len(assocs) == 0 {
+		return "", nil
+	}
+
+	ids := make([]int64, 0, len(assocs))
+	for _, a := range assocs {
+		ids = append(ids, a.EnrichmentID())
+	}
+
+	summaries, err := h.enrichCtx.Enrichments.Find(ctx,
+		repository.WithIDIn(ids),
+		enrichment.WithSubtype(enrichment.SubtypeFileSummary),
+	)
+	if err != nil {
+		return "", fmt.Errorf("find file summary enrichments: %w", err)
+	}
+	if len(summaries) == 0 {
+		return "", nil
+	}
+
+	parts := make([]string, 0, len(summaries))
+	for _, s := range summaries {
+		parts = append(parts, s.Content())
+	}
+	return strings.Join(parts, "\n\n"), nil
+}
+
+// Ensure DirectorySummary implements handler.Handler.
+var _ handler.Handler = (*DirectorySummary)(nil)
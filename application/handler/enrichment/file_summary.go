@@ -0,0 +1,262 @@
+package enrichment
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/helixml/kodit/application/handler"
+	"github.com/helixml/kodit/domain/enrichment"
+	"github.com/helixml/kodit/domain/repository"
+	domainservice "github.com/helixml/kodit/domain/service"
+	"github.com/helixml/kodit/domain/task"
+	"github.com/helixml/kodit/domain/usage"
+)
+
+const fileSummarySystemPrompt = `
+You are a technical writer who produces concise summaries of source files.
+Given the chunks extracted from a file, describe what the file contains and
+what role it plays in the codebase in 2-4 sentences.
+`
+
+const fileSummaryTaskPrompt = `
+Summarize the following file contents:
+
+<file_contents>
+%s
+</file_contents>
+`
+
+// FileSummary handles the CREATE_FILE_SUMMARY_FOR_COMMIT operation.
+// It aggregates the chunk enrichments extracted from each file into a single
+// AI-generated file summary, the building block for directory summaries.
+// When the repository has an enrichment budget configured, summarization is
+// capped to that many files per commit, largest first: kodit does not track
+// an import graph, so file size is used as the closest available proxy for
+// a file's importance to the codebase.
+type FileSummary struct {
+	repoStore repository.RepositoryStore
+	fileStore repository.FileStore
+	enrichCtx handler.EnrichmentContext
+}
+
+// NewFileSummary creates a new FileSummary handler.
+func NewFileSummary(
+	repoStore repository.RepositoryStore,
+	fileStore repository.FileStore,
+	enrichCtx handler.EnrichmentContext,
+) (*FileSummary, error) {
+	if repoStore == nil {
+		return nil, fmt.Errorf("NewFileSummary: nil repoStore")
+	}
+	if fileStore == nil {
+		return nil, fmt.Errorf("NewFileSummary: nil fileStore")
+	}
+	if enrichCtx.Enricher == nil {
+		return nil, fmt.Errorf("NewFileSummary: nil Enricher")
+	}
+	return &FileSummary{
+		repoStore: repoStore,
+		fileStore: fileStore,
+		enrichCtx: enrichCtx,
+	}, nil
+}
+
+// Execute processes the CREATE_FILE_SUMMARY_FOR_COMMIT task.
+func (h *FileSummary) Execute(ctx context.Context, payload map[string]any) error {
+	cp, err := handler.ExtractCommitPayload(payload)
+	if err != nil {
+		return err
+	}
+	ctx = usage.ContextWithRepositoryID(ctx, fmt.Sprintf("%d", cp.RepoID()))
+
+	tracker := h.enrichCtx.Tracker.ForOperation(
+		task.OperationCreateFileSummaryForCommit,
+		payload,
+	)
+
+	count, err := h.enrichCtx.Enrichments.Count(ctx,
+		enrichment.WithCommitSHA(cp.CommitSHA()),
+		enrichment.WithType(enrichment.TypeDevelopment),
+		enrichment.WithSubtype(enrichment.SubtypeFileSummary),
+	)
+	if err != nil {
+		return fmt.Errorf("check existing file summaries: %w", err)
+	}
+	if count > 0 {
+		tracker.Skip(ctx, "File summaries already exist for commit")
+		return nil
+	}
+
+	files, err := h.fileStore.Find(ctx, repository.WithCommitSHA(cp.CommitSHA()))
+	if err != nil {
+		return fmt.Errorf("get commit files: %w", err)
+	}
+	if len(files) == 0 {
+		tracker.Skip(ctx, "No files found for commit")
+		return nil
+	}
+
+	repo, err := h.repoStore.FindOne(ctx, repository.WithID(cp.RepoID()))
+	if err != nil {
+		return fmt.Errorf("get repository: %w", err)
+	}
+
+	files = h.applyBudget(repo, files)
+
+	tracker.SetTotal(ctx, len(files))
+
+	requests := make([]domainservice.EnrichmentRequest, 0, len(files))
+	filesByID := make(map[string]repository.File, len(files))
+	for i, f := range files {
+		tracker.SetCurrent(ctx, i, fmt.Sprintf("Gathering chunks for %s", f.Path()))
+
+		if f.ID() == 0 {
+			continue
+		}
+
+		content, gatherErr := h.gatherFileChunks(ctx, f.ID())
+		if gatherErr != nil {
+			return gatherErr
+		}
+		if content == "" {
+			continue
+		}
+
+		fileID := strconv.FormatInt(f.ID(), 10)
+		requests = append(requests, domainservice.NewEnrichmentRequest(fileID, fmt.Sprintf(fileSummaryTaskPrompt, content), fileSummarySystemPrompt))
+		filesByID[fileID] = f
+	}
+
+	if len(requests) == 0 {
+		tracker.Skip(ctx, "No chunked files to summarize")
+		return nil
+	}
+
+	responses, err := h.enrichCtx.Enricher.Enrich(ctx, requests, h.enrichCtx.LanguageOptions(repo, requests)...)
+	if err != nil {
+		return fmt.Errorf("enrich file summaries: %w", err)
+	}
+
+	for _, resp := range responses {
+		f, ok := filesByID[resp.ID()]
+		if !ok {
+			continue
+		}
+
+		saved, saveErr := h.enrichCtx.Enrichments.Save(ctx, enrichment.NewFileSummary(resp.Text()))
+		if saveErr != nil {
+			return fmt.Errorf("save file summary enrichment: %w", saveErr)
+		}
+
+		if _, err := h.enrichCtx.Associations.Save(ctx, enrichment.CommitAssociation(saved.ID(), cp.CommitSHA())); err != nil {
+			return fmt.Errorf("save commit association: %w", err)
+		}
+		if _, err := h.enrichCtx.Associations.Save(ctx, enrichment.FileAssociation(saved.ID(), strconv.FormatInt(f.ID(), 10))); err != nil {
+			return fmt.Errorf("save file association: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Simulate reports how many files would be summarized for the commit,
+// without calling the enrichment provider or writing anything.
+func (h *FileSummary) Simulate(ctx context.Context, payload map[string]any) (task.Plan, error) {
+	cp, err := handler.ExtractCommitPayload(payload)
+	if err != nil {
+		return task.Plan{}, err
+	}
+
+	count, err := h.enrichCtx.Enrichments.Count(ctx,
+		enrichment.WithCommitSHA(cp.CommitSHA()),
+		enrichment.WithType(enrichment.TypeDevelopment),
+		enrichment.WithSubtype(enrichment.SubtypeFileSummary),
+	)
+	if err != nil {
+		return task.Plan{}, fmt.Errorf("check existing file summaries: %w", err)
+	}
+	if count > 0 {
+		return task.NewPlan(task.OperationCreateFileSummaryForCommit, "file summaries already exist for commit", 0, 0), nil
+	}
+
+	files, err := h.fileStore.Find(ctx, repository.WithCommitSHA(cp.CommitSHA()))
+	if err != nil {
+		return task.Plan{}, fmt.Errorf("get commit files: %w", err)
+	}
+	if len(files) == 0 {
+		return task.NewPlan(task.OperationCreateFileSummaryForCommit, "no files found for commit", 0, 0), nil
+	}
+
+	repo, err := h.repoStore.FindOne(ctx, repository.WithID(cp.RepoID()))
+	if err != nil {
+		return task.Plan{}, fmt.Errorf("get repository: %w", err)
+	}
+
+	files = h.applyBudget(repo, files)
+
+	return task.NewPlan(task.OperationCreateFileSummaryForCommit,
+		fmt.Sprintf("would enrich up to %d files with a summary", len(files)), len(files), 0), nil
+}
+
+// Ensure FileSummary implements task.Simulator.
+var _ task.Simulator = (*FileSummary)(nil)
+
+// applyBudget caps files to the repository's configured enrichment budget,
+// keeping the largest files first. Returns files unchanged if the budget is
+// unlimited or already covers every file.
+func (h *FileSummary) applyBudget(repo repository.Repository, files []repository.File) []repository.File {
+	budget := repo.EnrichmentBudget()
+	if budget.Unlimited() || len(files) <= budget.MaxFileSummaries() {
+		return files
+	}
+
+	sorted := make([]repository.File, len(files))
+	copy(sorted, files)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Size() > sorted[j].Size() })
+
+	return sorted[:budget.MaxFileSummaries()]
+}
+
+// gatherFileChunks concatenates the content of every chunk enrichment
+// associated with the given file, in no particular order. Returns "" if the
+// file has no chunks (e.g. it was skipped by chunking as non-indexable).
+func (h *FileSummary) gatherFileChunks(ctx context.Context, fileID int64) (string, error) {
+	assocs, err := h.enrichCtx.Associations.Find(ctx,
+		enrichment.WithEntityType(enrichment.EntityTypeFile),
+		enrichment.WithEntityID(strconv.FormatInt(fileID, 10)),
+	)
+	if err != nil {
+		return "", fmt.Errorf("find file associations: %w", err)
+	}
+	if len(assocs) == 0 {
+		return "", nil
+	}
+
+	ids := make([]int64, 0, len(assocs))
+	for _, a := range assocs {
+		ids = append(ids, a.EnrichmentID())
+	}
+
+	chunks, err := h.enrichCtx.Enrichments.Find(ctx,
+		repository.WithIDIn(ids),
+		enrichment.WithSubtype(enrichment.SubtypeChunk),
+	)
+	if err != nil {
+		return "", fmt.Errorf("find chunk enrichments: %w", err)
+	}
+	if len(chunks) == 0 {
+		return "", nil
+	}
+
+	var parts []string
+	for _, c := range chunks {
+		parts = append(parts, c.Content())
+	}
+	return strings.Join(parts, "\n\n"), nil
+}
+
+// Ensure FileSummary implements handler.Handler.
+var _ handler.Handler = (*FileSummary)(nil)
@@ -134,7 +134,14 @@ func (f *fakeGitAdapter) AllTags(_ context.Context, _ string) ([]infraGit.TagInf
 	return nil, nil
 }
 
-func (f *fakeGitAdapter) CommitDiff(_ context.Context, _, _ string) (string, error) {
+func (f *fakeGitAdapter) CommitDiff(_ context.Context, _, _, _ string) (string, error) {
+	if f.err != nil {
+		return "", f.err
+	}
+	return f.diff, nil
+}
+
+func (f *fakeGitAdapter) DiffRefs(_ context.Context, _, _, _ string) (string, error) {
 	if f.err != nil {
 		return "", f.err
 	}
@@ -145,6 +152,22 @@ func (f *fakeGitAdapter) Grep(_ context.Context, _ string, _ string, _ string, _
 	return nil, nil
 }
 
+func (f *fakeGitAdapter) UncommittedFiles(_ context.Context, _ string) ([]infraGit.UncommittedFile, error) {
+	return nil, nil
+}
+
+func (f *fakeGitAdapter) RemoteRefs(_ context.Context, _ string) (map[string]string, error) {
+	return nil, nil
+}
+
+func (f *fakeGitAdapter) IsAncestor(_ context.Context, _, _, _ string) (bool, error) {
+	return false, nil
+}
+
+func (f *fakeGitAdapter) FetchRef(_ context.Context, _, _ string) (string, error) {
+	return "", nil
+}
+
 func newEnrichmentContext(
 	enrichmentStore enrichment.EnrichmentStore,
 	associationStore enrichment.AssociationStore,
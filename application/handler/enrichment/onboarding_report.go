@@ -0,0 +1,106 @@
+package enrichment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/helixml/kodit/application/handler"
+	"github.com/helixml/kodit/domain/enrichment"
+	"github.com/helixml/kodit/domain/repository"
+	"github.com/helixml/kodit/domain/task"
+	"github.com/helixml/kodit/infrastructure/extraction"
+	"github.com/helixml/kodit/infrastructure/onboarding"
+)
+
+// OnboardingReport handles the CREATE_ONBOARDING_REPORT_FOR_COMMIT
+// operation: it runs a deterministic preflight analysis over a commit's
+// files (size, language breakdown, analyzer coverage, projected snippet and
+// token counts) so a newly added repository's expected indexing coverage is
+// visible before the rest of the pipeline finishes. Unlike most enrichment
+// handlers, this one is static analysis and requires no LLM.
+type OnboardingReport struct {
+	repoStore repository.RepositoryStore
+	fileStore repository.FileStore
+	enrichCtx handler.EnrichmentContext
+}
+
+// NewOnboardingReport creates a new OnboardingReport handler.
+func NewOnboardingReport(
+	repoStore repository.RepositoryStore,
+	fileStore repository.FileStore,
+	enrichCtx handler.EnrichmentContext,
+) (*OnboardingReport, error) {
+	if repoStore == nil {
+		return nil, fmt.Errorf("NewOnboardingReport: nil repoStore")
+	}
+	if fileStore == nil {
+		return nil, fmt.Errorf("NewOnboardingReport: nil fileStore")
+	}
+	return &OnboardingReport{
+		repoStore: repoStore,
+		fileStore: fileStore,
+		enrichCtx: enrichCtx,
+	}, nil
+}
+
+// Execute processes the CREATE_ONBOARDING_REPORT_FOR_COMMIT task.
+func (h *OnboardingReport) Execute(ctx context.Context, payload map[string]any) error {
+	cp, err := handler.ExtractCommitPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	tracker := h.enrichCtx.Tracker.ForOperation(task.OperationCreateOnboardingReportForCommit, payload)
+
+	count, err := h.enrichCtx.Enrichments.Count(ctx, enrichment.WithCommitSHA(cp.CommitSHA()), enrichment.WithSubtype(enrichment.SubtypeOnboardingReport))
+	if err != nil {
+		h.enrichCtx.Logger.Error().Str("error", err.Error()).Msg("failed to check existing onboarding report")
+		return err
+	}
+	if count > 0 {
+		tracker.Skip(ctx, "Onboarding report already generated for commit")
+		return nil
+	}
+
+	repo, err := h.repoStore.FindOne(ctx, repository.WithID(cp.RepoID()))
+	if err != nil {
+		return fmt.Errorf("get repository: %w", err)
+	}
+
+	files, err := h.fileStore.Find(ctx, repository.WithCommitSHA(cp.CommitSHA()))
+	if err != nil {
+		return fmt.Errorf("get files: %w", err)
+	}
+
+	tracker.SetTotal(ctx, 1)
+	tracker.SetCurrent(ctx, 0, "Analyzing files")
+
+	infos := make([]onboarding.FileInfo, len(files))
+	for i, f := range files {
+		infos[i] = onboarding.FileInfo{
+			Path:      f.Path(),
+			Language:  f.Language(),
+			Size:      f.Size(),
+			Indexable: extraction.IsIndexable(f.Path()),
+		}
+	}
+
+	cc := repo.ChunkingConfig()
+	content, err := onboarding.Generate(infos, cc.Size(), cc.Overlap())
+	if err != nil {
+		return fmt.Errorf("generate onboarding report: %w", err)
+	}
+
+	saved, err := h.enrichCtx.Enrichments.Save(ctx, enrichment.NewOnboardingReport(string(content)))
+	if err != nil {
+		return fmt.Errorf("save onboarding report enrichment: %w", err)
+	}
+	if _, err := h.enrichCtx.Associations.Save(ctx, enrichment.CommitAssociation(saved.ID(), cp.CommitSHA())); err != nil {
+		return fmt.Errorf("save commit association: %w", err)
+	}
+
+	return nil
+}
+
+// Ensure OnboardingReport implements handler.Handler.
+var _ handler.Handler = (*OnboardingReport)(nil)
@@ -0,0 +1,129 @@
+package enrichment
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/helixml/kodit/application/handler"
+	"github.com/helixml/kodit/domain/enrichment"
+	"github.com/helixml/kodit/domain/repository"
+	"github.com/helixml/kodit/domain/task"
+	"github.com/helixml/kodit/infrastructure/analysis/gomodule"
+	"github.com/helixml/kodit/infrastructure/sbom"
+)
+
+// CreateSBOM handles the CREATE_SBOM_FOR_COMMIT operation: it parses every
+// go.mod in a commit's working copy and generates a CycloneDX software bill
+// of materials document covering all declared dependencies, for compliance
+// workflows.
+type CreateSBOM struct {
+	repoStore repository.RepositoryStore
+	fileStore repository.FileStore
+	enrichCtx handler.EnrichmentContext
+}
+
+// NewCreateSBOM creates a new CreateSBOM handler.
+func NewCreateSBOM(
+	repoStore repository.RepositoryStore,
+	fileStore repository.FileStore,
+	enrichCtx handler.EnrichmentContext,
+) (*CreateSBOM, error) {
+	if repoStore == nil {
+		return nil, fmt.Errorf("NewCreateSBOM: nil repoStore")
+	}
+	if fileStore == nil {
+		return nil, fmt.Errorf("NewCreateSBOM: nil fileStore")
+	}
+	return &CreateSBOM{
+		repoStore: repoStore,
+		fileStore: fileStore,
+		enrichCtx: enrichCtx,
+	}, nil
+}
+
+// Execute processes the CREATE_SBOM_FOR_COMMIT task.
+func (h *CreateSBOM) Execute(ctx context.Context, payload map[string]any) error {
+	cp, err := handler.ExtractCommitPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	tracker := h.enrichCtx.Tracker.ForOperation(task.OperationCreateSBOMForCommit, payload)
+
+	count, err := h.enrichCtx.Enrichments.Count(ctx, enrichment.WithCommitSHA(cp.CommitSHA()), enrichment.WithType(enrichment.TypeCompliance))
+	if err != nil {
+		h.enrichCtx.Logger.Error().Str("error", err.Error()).Msg("failed to check existing SBOM")
+		return err
+	}
+	if count > 0 {
+		tracker.Skip(ctx, "SBOM already generated for commit")
+		return nil
+	}
+
+	repo, err := h.repoStore.FindOne(ctx, repository.WithID(cp.RepoID()))
+	if err != nil {
+		return fmt.Errorf("get repository: %w", err)
+	}
+
+	clonedPath := repo.WorkingCopy().Path()
+	if clonedPath == "" {
+		return fmt.Errorf("repository %d has never been cloned", cp.RepoID())
+	}
+
+	files, err := h.fileStore.Find(ctx, repository.WithCommitSHA(cp.CommitSHA()))
+	if err != nil {
+		return fmt.Errorf("get files: %w", err)
+	}
+
+	goModFiles := make([]repository.File, 0)
+	for _, f := range files {
+		if filepath.Base(f.Path()) == "go.mod" {
+			goModFiles = append(goModFiles, f)
+		}
+	}
+
+	if len(goModFiles) == 0 {
+		tracker.Skip(ctx, "No go.mod files to generate an SBOM from")
+		return nil
+	}
+
+	tracker.SetTotal(ctx, len(goModFiles))
+
+	var components []sbom.Component
+	for i, goMod := range goModFiles {
+		tracker.SetCurrent(ctx, i, fmt.Sprintf("Reading dependencies in %s", goMod.Path()))
+
+		reqs, err := gomodule.Requirements(filepath.Join(clonedPath, goMod.Path()))
+		if err != nil {
+			h.enrichCtx.Logger.Warn().Str("file", goMod.Path()).Str("error", err.Error()).Msg("failed to parse go.mod for SBOM")
+			continue
+		}
+		for _, req := range reqs {
+			components = append(components, sbom.Component{Name: req.Path, Version: req.Version, Ecosystem: "Go"})
+		}
+	}
+
+	if len(components) == 0 {
+		tracker.Skip(ctx, "No dependencies found to include in an SBOM")
+		return nil
+	}
+
+	document, err := sbom.GenerateCycloneDX(repo.SanitizedURL(), components)
+	if err != nil {
+		return fmt.Errorf("generate SBOM: %w", err)
+	}
+
+	saved, err := h.enrichCtx.Enrichments.Save(ctx, enrichment.NewSBOMFinding(string(document)))
+	if err != nil {
+		return fmt.Errorf("save SBOM enrichment: %w", err)
+	}
+	if _, err := h.enrichCtx.Associations.Save(ctx, enrichment.CommitAssociation(saved.ID(), cp.CommitSHA())); err != nil {
+		return fmt.Errorf("save commit association: %w", err)
+	}
+
+	return nil
+}
+
+// Ensure CreateSBOM implements handler.Handler.
+var _ handler.Handler = (*CreateSBOM)(nil)
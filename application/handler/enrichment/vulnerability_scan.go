@@ -0,0 +1,160 @@
+package enrichment
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/helixml/kodit/application/handler"
+	"github.com/helixml/kodit/domain/enrichment"
+	"github.com/helixml/kodit/domain/repository"
+	"github.com/helixml/kodit/domain/task"
+	"github.com/helixml/kodit/infrastructure/analysis/gomodule"
+	"github.com/helixml/kodit/infrastructure/vulnerability/osv"
+)
+
+// VulnerabilityQuerier checks a set of package versions for known
+// vulnerabilities, keyed by the index into the packages slice.
+type VulnerabilityQuerier interface {
+	Query(ctx context.Context, packages []osv.Package) (map[int][]osv.Vulnerability, error)
+}
+
+// VulnerabilityScan handles the CREATE_VULNERABILITY_SCAN_FOR_COMMIT
+// operation: it parses every go.mod in a commit's working copy and checks
+// its dependencies against the OSV vulnerability database.
+//
+// Flagging which snippets are affected by a vulnerable dependency would
+// require resolving each file's imports back to the package that declared
+// them, which this repository does not currently do (gomodule only
+// resolves intra-repo imports via Resolver.ResolveImport, not third-party
+// ones). This handler therefore attaches findings to the commit and to the
+// go.mod file that declared the dependency, not to individual snippets.
+type VulnerabilityScan struct {
+	repoStore repository.RepositoryStore
+	fileStore repository.FileStore
+	enrichCtx handler.EnrichmentContext
+	querier   VulnerabilityQuerier
+}
+
+// NewVulnerabilityScan creates a new VulnerabilityScan handler.
+func NewVulnerabilityScan(
+	repoStore repository.RepositoryStore,
+	fileStore repository.FileStore,
+	enrichCtx handler.EnrichmentContext,
+	querier VulnerabilityQuerier,
+) (*VulnerabilityScan, error) {
+	if repoStore == nil {
+		return nil, fmt.Errorf("NewVulnerabilityScan: nil repoStore")
+	}
+	if fileStore == nil {
+		return nil, fmt.Errorf("NewVulnerabilityScan: nil fileStore")
+	}
+	if querier == nil {
+		return nil, fmt.Errorf("NewVulnerabilityScan: nil querier")
+	}
+	return &VulnerabilityScan{
+		repoStore: repoStore,
+		fileStore: fileStore,
+		enrichCtx: enrichCtx,
+		querier:   querier,
+	}, nil
+}
+
+// Execute processes the CREATE_VULNERABILITY_SCAN_FOR_COMMIT task.
+func (h *VulnerabilityScan) Execute(ctx context.Context, payload map[string]any) error {
+	cp, err := handler.ExtractCommitPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	tracker := h.enrichCtx.Tracker.ForOperation(task.OperationScanDependencyVulnerabilitiesForCommit, payload)
+
+	count, err := h.enrichCtx.Enrichments.Count(ctx, enrichment.WithCommitSHA(cp.CommitSHA()), enrichment.WithType(enrichment.TypeSecurity))
+	if err != nil {
+		h.enrichCtx.Logger.Error().Str("error", err.Error()).Msg("failed to check existing vulnerability findings")
+		return err
+	}
+	if count > 0 {
+		tracker.Skip(ctx, "Vulnerability scan already ran for commit")
+		return nil
+	}
+
+	repo, err := h.repoStore.FindOne(ctx, repository.WithID(cp.RepoID()))
+	if err != nil {
+		return fmt.Errorf("get repository: %w", err)
+	}
+
+	clonedPath := repo.WorkingCopy().Path()
+	if clonedPath == "" {
+		return fmt.Errorf("repository %d has never been cloned", cp.RepoID())
+	}
+
+	files, err := h.fileStore.Find(ctx, repository.WithCommitSHA(cp.CommitSHA()))
+	if err != nil {
+		return fmt.Errorf("get files: %w", err)
+	}
+
+	goModFiles := make([]repository.File, 0)
+	for _, f := range files {
+		if filepath.Base(f.Path()) == "go.mod" {
+			goModFiles = append(goModFiles, f)
+		}
+	}
+
+	tracker.SetTotal(ctx, len(goModFiles))
+
+	for i, goMod := range goModFiles {
+		tracker.SetCurrent(ctx, i, fmt.Sprintf("Scanning dependencies in %s", goMod.Path()))
+
+		if err := h.scanGoMod(ctx, cp.CommitSHA(), clonedPath, goMod); err != nil {
+			h.enrichCtx.Logger.Warn().Str("file", goMod.Path()).Str("error", err.Error()).Msg("failed to scan go.mod for vulnerabilities")
+		}
+	}
+
+	return nil
+}
+
+// scanGoMod parses the dependencies declared by a single go.mod file and
+// persists an enrichment for every known vulnerability found.
+func (h *VulnerabilityScan) scanGoMod(ctx context.Context, commitSHA, clonedPath string, goMod repository.File) error {
+	reqs, err := gomodule.Requirements(filepath.Join(clonedPath, goMod.Path()))
+	if err != nil {
+		return fmt.Errorf("parse requirements: %w", err)
+	}
+	if len(reqs) == 0 {
+		return nil
+	}
+
+	packages := make([]osv.Package, len(reqs))
+	for i, r := range reqs {
+		packages[i] = osv.Package{Name: r.Path, Version: r.Version, Ecosystem: "Go"}
+	}
+
+	findings, err := h.querier.Query(ctx, packages)
+	if err != nil {
+		return fmt.Errorf("query OSV: %w", err)
+	}
+
+	fileID := strconv.FormatInt(goMod.ID(), 10)
+	for i, vulns := range findings {
+		dep := reqs[i]
+		for _, v := range vulns {
+			content := fmt.Sprintf("%s@%s is affected by %s: %s", dep.Path, dep.Version, v.ID, v.Summary)
+			saved, err := h.enrichCtx.Enrichments.Save(ctx, enrichment.NewVulnerabilityFinding(content))
+			if err != nil {
+				return fmt.Errorf("save vulnerability finding: %w", err)
+			}
+			if _, err := h.enrichCtx.Associations.Save(ctx, enrichment.CommitAssociation(saved.ID(), commitSHA)); err != nil {
+				return fmt.Errorf("save commit association: %w", err)
+			}
+			if _, err := h.enrichCtx.Associations.Save(ctx, enrichment.FileAssociation(saved.ID(), fileID)); err != nil {
+				return fmt.Errorf("save file association: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// Ensure VulnerabilityScan implements handler.Handler.
+var _ handler.Handler = (*VulnerabilityScan)(nil)
@@ -12,6 +12,7 @@ import (
 	"github.com/helixml/kodit/domain/repository"
 	domainservice "github.com/helixml/kodit/domain/service"
 	"github.com/helixml/kodit/domain/task"
+	"github.com/helixml/kodit/domain/usage"
 	"github.com/helixml/kodit/domain/wiki"
 )
 
@@ -143,6 +144,7 @@ func (h *Wiki) Execute(ctx context.Context, payload map[string]any) error {
 	if err != nil {
 		return err
 	}
+	ctx = usage.ContextWithRepositoryID(ctx, fmt.Sprintf("%d", cp.RepoID()))
 
 	tracker := h.enrichCtx.Tracker.ForOperation(
 		task.OperationGenerateWikiForCommit,
@@ -189,10 +191,12 @@ func (h *Wiki) Execute(ctx context.Context, payload map[string]any) error {
 		return fmt.Errorf("get existing enrichments: %w", err)
 	}
 
-	// Filter to only architecture, API docs, and cookbook.
+	// Filter to only architecture, API docs, cookbook, and the file/directory
+	// summary hierarchy.
 	var relevantEnrichments []enrichment.Enrichment
 	for _, e := range existingEnrichments {
-		if enrichment.IsArchitectureEnrichment(e) || enrichment.IsAPIDocs(e) || enrichment.IsCookbook(e) {
+		if enrichment.IsArchitectureEnrichment(e) || enrichment.IsAPIDocs(e) || enrichment.IsCookbook(e) ||
+			enrichment.IsFileSummary(e) || enrichment.IsDirectorySummary(e) {
 			relevantEnrichments = append(relevantEnrichments, e)
 		}
 	}
@@ -214,7 +218,7 @@ func (h *Wiki) Execute(ctx context.Context, payload map[string]any) error {
 	tracker.SetTotal(ctx, 3)
 	tracker.SetCurrent(ctx, 0, "Planning wiki structure")
 
-	outline, err := h.planWiki(ctx, wikiCtx)
+	outline, err := h.planWiki(ctx, repo, wikiCtx)
 	if err != nil {
 		return fmt.Errorf("plan wiki: %w", err)
 	}
@@ -229,7 +233,7 @@ func (h *Wiki) Execute(ctx context.Context, payload map[string]any) error {
 	tracker.SetTotal(ctx, flatCount+2) // +2 for plan and index phases
 	tracker.SetCurrent(ctx, 1, "Generating wiki pages")
 
-	pages, err := h.generatePages(ctx, tracker, outline, wikiCtx, clonedPath)
+	pages, err := h.generatePages(ctx, repo, tracker, outline, wikiCtx, clonedPath)
 	if err != nil {
 		return fmt.Errorf("generate pages: %w", err)
 	}
@@ -237,7 +241,7 @@ func (h *Wiki) Execute(ctx context.Context, payload map[string]any) error {
 	// Phase 3: Generate index page.
 	tracker.SetCurrent(ctx, flatCount+1, "Generating wiki index")
 
-	indexPage, err := h.generateIndex(ctx, outline, wikiCtx)
+	indexPage, err := h.generateIndex(ctx, repo, outline, wikiCtx)
 	if err != nil {
 		return fmt.Errorf("generate index: %w", err)
 	}
@@ -265,18 +269,45 @@ func (h *Wiki) Execute(ctx context.Context, payload map[string]any) error {
 		return fmt.Errorf("save commit association: %w", err)
 	}
 
+	if err := h.savePages(ctx, allPages, cp.CommitSHA()); err != nil {
+		return fmt.Errorf("save wiki pages: %w", err)
+	}
+
+	return nil
+}
+
+// savePages persists each page in the wiki as its own enrichment, so later
+// indexing can embed and search pages individually instead of only the
+// wiki as a whole.
+func (h *Wiki) savePages(ctx context.Context, allPages []wiki.Page, commitSHA string) error {
+	for _, p := range wiki.Flatten(allPages) {
+		doc, err := p.Document()
+		if err != nil {
+			return fmt.Errorf("serialize page %s: %w", p.Slug(), err)
+		}
+
+		saved, err := h.enrichCtx.Enrichments.Save(ctx, enrichment.NewWikiPage(doc))
+		if err != nil {
+			return fmt.Errorf("save wiki page %s: %w", p.Slug(), err)
+		}
+
+		pageAssoc := enrichment.CommitAssociation(saved.ID(), commitSHA)
+		if _, err := h.enrichCtx.Associations.Save(ctx, pageAssoc); err != nil {
+			return fmt.Errorf("save wiki page %s association: %w", p.Slug(), err)
+		}
+	}
 	return nil
 }
 
 // planWiki calls the LLM to produce a structured wiki outline (Phase 1).
-func (h *Wiki) planWiki(ctx context.Context, wikiCtx wikiGatheredContext) (wikiOutline, error) {
+func (h *Wiki) planWiki(ctx context.Context, repo repository.Repository, wikiCtx wikiGatheredContext) (wikiOutline, error) {
 	prompt := fmt.Sprintf(wikiPlanTaskPrompt, wikiCtx.fileTree, wikiCtx.readme, wikiCtx.enrichments)
 
 	requests := []domainservice.EnrichmentRequest{
 		domainservice.NewEnrichmentRequest("wiki-plan", prompt, wikiPlanSystemPrompt),
 	}
 
-	responses, err := h.enrichCtx.Enricher.Enrich(ctx, requests)
+	responses, err := h.enrichCtx.Enricher.Enrich(ctx, requests, h.enrichCtx.LanguageOptions(repo, requests)...)
 	if err != nil {
 		return wikiOutline{}, fmt.Errorf("enrich wiki plan: %w", err)
 	}
@@ -306,6 +337,7 @@ func (h *Wiki) planWiki(ctx context.Context, wikiCtx wikiGatheredContext) (wikiO
 // generatePages generates content for each page in the outline (Phase 2).
 func (h *Wiki) generatePages(
 	ctx context.Context,
+	repo repository.Repository,
 	tracker handler.Tracker,
 	outline wikiOutline,
 	wikiCtx wikiGatheredContext,
@@ -326,7 +358,7 @@ func (h *Wiki) generatePages(
 			domainservice.NewEnrichmentRequest(entry.Slug, prompt, wikiPageSystemPrompt),
 		}
 
-		responses, err := h.enrichCtx.Enricher.Enrich(ctx, requests)
+		responses, err := h.enrichCtx.Enricher.Enrich(ctx, requests, h.enrichCtx.LanguageOptions(repo, requests)...)
 		if err != nil {
 			return nil, fmt.Errorf("generate page %s: %w", entry.Slug, err)
 		}
@@ -342,7 +374,7 @@ func (h *Wiki) generatePages(
 }
 
 // generateIndex generates the wiki home page (Phase 3).
-func (h *Wiki) generateIndex(ctx context.Context, outline wikiOutline, wikiCtx wikiGatheredContext) (wiki.Page, error) {
+func (h *Wiki) generateIndex(ctx context.Context, repo repository.Repository, outline wikiOutline, wikiCtx wikiGatheredContext) (wiki.Page, error) {
 	pageListing := h.pageListingText(outline)
 	now := time.Now().UTC().Format("2006-01-02")
 	prompt := fmt.Sprintf(wikiIndexTaskPrompt, wikiCtx.repoURL, wikiCtx.commitSHA, now, pageListing, wikiCtx.readme)
@@ -351,7 +383,7 @@ func (h *Wiki) generateIndex(ctx context.Context, outline wikiOutline, wikiCtx w
 		domainservice.NewEnrichmentRequest("index", prompt, wikiIndexSystemPrompt),
 	}
 
-	responses, err := h.enrichCtx.Enricher.Enrich(ctx, requests)
+	responses, err := h.enrichCtx.Enricher.Enrich(ctx, requests, h.enrichCtx.LanguageOptions(repo, requests)...)
 	if err != nil {
 		return wiki.Page{}, fmt.Errorf("generate index: %w", err)
 	}
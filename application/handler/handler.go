@@ -74,6 +74,23 @@ func (r *Registry) Handler(operation task.Operation) (Handler, error) {
 	return handler, nil
 }
 
+// Simulate runs the registered handler's planning logic for operation
+// without side effects. Returns ErrNoHandler if no handler is registered,
+// or task.ErrNotSimulatable if the registered handler does not implement
+// task.Simulator.
+func (r *Registry) Simulate(ctx context.Context, operation task.Operation, payload map[string]any) (task.Plan, error) {
+	h, err := r.Handler(operation)
+	if err != nil {
+		return task.Plan{}, err
+	}
+
+	sim, ok := h.(task.Simulator)
+	if !ok {
+		return task.Plan{}, fmt.Errorf("%w: %s", task.ErrNotSimulatable, operation)
+	}
+	return sim.Simulate(ctx, payload)
+}
+
 // HasHandler checks if a handler is registered for the operation.
 func (r *Registry) HasHandler(operation task.Operation) bool {
 	r.mu.RLock()
@@ -2,6 +2,7 @@ package indexing
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"path/filepath"
 	"strconv"
@@ -12,10 +13,13 @@ import (
 	"github.com/helixml/kodit/application/handler"
 	"github.com/helixml/kodit/domain/enrichment"
 	"github.com/helixml/kodit/domain/repository"
+	domainservice "github.com/helixml/kodit/domain/service"
 	"github.com/helixml/kodit/domain/sourcelocation"
 	"github.com/helixml/kodit/domain/task"
+	"github.com/helixml/kodit/infrastructure/analysis/gomodule"
 	"github.com/helixml/kodit/infrastructure/chunking"
 	"github.com/helixml/kodit/infrastructure/extraction"
+	"github.com/helixml/kodit/internal/database"
 )
 
 // FileContentSource reads file content at a specific commit.
@@ -31,6 +35,7 @@ type DocumentTextSource interface {
 // ChunkFiles creates fixed-size text chunks from commit files.
 type ChunkFiles struct {
 	repoStore        repository.RepositoryStore
+	commitStore      repository.CommitStore
 	enrichmentStore  enrichment.EnrichmentStore
 	associationStore enrichment.AssociationStore
 	lineRangeStore   sourcelocation.Store
@@ -42,14 +47,17 @@ type ChunkFiles struct {
 	params           chunking.ChunkParams
 	trackerFactory   handler.TrackerFactory
 	logger           zerolog.Logger
+	complexity       domainservice.ComplexityAnalyzer
 }
 
 // NewChunkFiles creates a new ChunkFiles handler.
 // When documentText is nil, document files are skipped.
 // When textRenderers is non-nil, document text is extracted per-page so
 // that each chunk records the page it came from.
+// When complexity is nil, chunk enrichments are saved without metrics.
 func NewChunkFiles(
 	repoStore repository.RepositoryStore,
+	commitStore repository.CommitStore,
 	enrichmentStore enrichment.EnrichmentStore,
 	associationStore enrichment.AssociationStore,
 	lineRangeStore sourcelocation.Store,
@@ -61,9 +69,11 @@ func NewChunkFiles(
 	params chunking.ChunkParams,
 	trackerFactory handler.TrackerFactory,
 	logger zerolog.Logger,
+	complexity domainservice.ComplexityAnalyzer,
 ) *ChunkFiles {
 	return &ChunkFiles{
 		repoStore:        repoStore,
+		commitStore:      commitStore,
 		enrichmentStore:  enrichmentStore,
 		associationStore: associationStore,
 		lineRangeStore:   lineRangeStore,
@@ -74,6 +84,7 @@ func NewChunkFiles(
 		textRenderers:    textRenderers,
 		params:           params,
 		trackerFactory:   trackerFactory,
+		complexity:       complexity,
 		logger:           logger,
 	}
 }
@@ -136,15 +147,37 @@ func (h *ChunkFiles) Execute(ctx context.Context, payload map[string]any) error
 	tracker.SetTotal(ctx, len(files))
 	repoIDStr := strconv.FormatInt(cp.RepoID(), 10)
 
+	previousFiles, err := h.previousCommitFiles(ctx, cp.CommitSHA())
+	if err != nil {
+		return err
+	}
+
+	modules, err := gomodule.Discover(clonedPath)
+	if err != nil {
+		h.logger.Warn().Str("error", err.Error()).Msg("failed to discover Go modules, module metadata will be omitted")
+	}
+	moduleResolver := gomodule.NewResolver(modules)
+
 	processed := 0
 	for _, f := range files {
 		tracker.SetCurrent(ctx, processed, fmt.Sprintf("Chunking %s", f.Path()))
 
-		if !isIndexable(f.Path()) {
+		if !extraction.IsIndexable(f.Path()) || !repo.IndexFilterConfig().Allows(f.Path(), domainservice.MatchGlob) {
 			processed++
 			continue
 		}
 
+		if prev, ok := previousFiles[f.Path()]; ok && prev.BlobSHA() != "" && prev.BlobSHA() == f.BlobSHA() {
+			reused, reuseErr := h.reuseChunks(ctx, prev, f, cp.CommitSHA())
+			if reuseErr != nil {
+				return reuseErr
+			}
+			if reused {
+				processed++
+				continue
+			}
+		}
+
 		ext := strings.ToLower(filepath.Ext(f.Path()))
 		relPath := relativeFilePath(f.Path(), clonedPath)
 
@@ -211,14 +244,48 @@ func (h *ChunkFiles) Execute(ctx context.Context, payload map[string]any) error
 			continue
 		}
 
-		textChunks, chunkErr := chunking.NewTextChunks(text, params)
+		if isGenerated(text) {
+			h.logger.Info().Str("path", f.Path()).Msg("skipping generated file for snippet extraction")
+			processed++
+			continue
+		}
+
+		var textChunks chunking.TextChunks
+		var chunkErr error
+		if isProseDocument(ext) {
+			textChunks, _, chunkErr = chunking.NewDocumentChunks(text, params)
+		} else {
+			textChunks, chunkErr = chunking.NewTextChunks(text, params)
+		}
 		if chunkErr != nil {
 			h.logger.Warn().Str("path", f.Path()).Str("error", chunkErr.Error()).Msg("failed to chunk file")
 			processed++
 			continue
 		}
 
-		if err := h.persistChunks(ctx, textChunks, pageBoundaries, f, cp.CommitSHA(), repoIDStr); err != nil {
+		chunks := textChunks.All()
+		if len(chunks) > maxChunksPerFile {
+			reason := "oversized file"
+			if isMinified(text) {
+				reason = "minified file"
+			}
+			h.logger.Warn().
+				Str("path", f.Path()).
+				Int("chunks", len(chunks)).
+				Int("sampled", maxChunksPerFile).
+				Str("reason", reason).
+				Msg("sampling snippets for file exceeding per-file chunk limit")
+			chunks = sampleChunks(chunks, maxChunksPerFile)
+		}
+
+		var modulePath string
+		if ext == ".go" {
+			if mod, ok := moduleResolver.ModuleForPath(relPath); ok {
+				modulePath = mod.Path()
+			}
+		}
+
+		if err := h.persistChunks(ctx, chunks, pageBoundaries, f, cp.CommitSHA(), repoIDStr, modulePath, text); err != nil {
 			return err
 		}
 
@@ -230,41 +297,158 @@ func (h *ChunkFiles) Execute(ctx context.Context, payload map[string]any) error
 	return nil
 }
 
+// previousCommitFiles returns the file records from commitSHA's immediate
+// parent commit, keyed by path, so unchanged blobs can be detected. Returns
+// nil (every file will be chunked fresh) when there is no parent commit, or
+// the parent hasn't been chunked yet — reuse is only safe once the source
+// chunk enrichments are known to exist.
+func (h *ChunkFiles) previousCommitFiles(ctx context.Context, commitSHA string) (map[string]repository.File, error) {
+	commit, err := h.commitStore.FindOne(ctx, repository.WithSHA(commitSHA))
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get commit: %w", err)
+	}
+
+	parentSHA := commit.ParentCommitSHA()
+	if parentSHA == "" {
+		return nil, nil
+	}
+
+	indexed, err := h.enrichmentStore.Exists(ctx,
+		enrichment.WithCommitSHA(parentSHA),
+		enrichment.WithType(enrichment.TypeDevelopment),
+		enrichment.WithSubtype(enrichment.SubtypeChunk),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("check parent commit chunks: %w", err)
+	}
+	if !indexed {
+		return nil, nil
+	}
+
+	parentFiles, err := h.fileStore.Find(ctx, repository.WithCommitSHA(parentSHA))
+	if err != nil {
+		return nil, fmt.Errorf("get parent commit files: %w", err)
+	}
+
+	byPath := make(map[string]repository.File, len(parentFiles))
+	for _, f := range parentFiles {
+		byPath[f.Path()] = f
+	}
+	return byPath, nil
+}
+
+// reuseChunks copies the chunk enrichments already associated with prev (the
+// same file at the parent commit) onto f at the current commit, without
+// re-extracting or re-chunking its content. Returns false when prev has no
+// chunk enrichments to reuse, so the caller falls back to chunking f fresh.
+func (h *ChunkFiles) reuseChunks(ctx context.Context, prev, f repository.File, commitSHA string) (bool, error) {
+	if prev.ID() == 0 {
+		return false, nil
+	}
+
+	assocs, err := h.associationStore.Find(ctx,
+		enrichment.WithEntityType(enrichment.EntityTypeFile),
+		enrichment.WithEntityID(strconv.FormatInt(prev.ID(), 10)),
+	)
+	if err != nil {
+		return false, fmt.Errorf("find previous file associations: %w", err)
+	}
+	if len(assocs) == 0 {
+		return false, nil
+	}
+
+	ids := make([]int64, 0, len(assocs))
+	for _, a := range assocs {
+		ids = append(ids, a.EnrichmentID())
+	}
+
+	chunks, err := h.enrichmentStore.Find(ctx, repository.WithIDIn(ids), enrichment.WithSubtype(enrichment.SubtypeChunk))
+	if err != nil {
+		return false, fmt.Errorf("find previous chunk enrichments: %w", err)
+	}
+	if len(chunks) == 0 {
+		return false, nil
+	}
+
+	newAssociations := make([]enrichment.Association, 0, len(chunks)*2)
+	for _, c := range chunks {
+		newAssociations = append(newAssociations, enrichment.CommitAssociation(c.ID(), commitSHA))
+		if f.ID() != 0 {
+			newAssociations = append(newAssociations, enrichment.FileAssociation(c.ID(), strconv.FormatInt(f.ID(), 10)))
+		}
+	}
+
+	if _, err := h.associationStore.SaveAll(ctx, newAssociations); err != nil {
+		return false, fmt.Errorf("save reused chunk associations: %w", err)
+	}
+
+	h.logger.Debug().Str("path", f.Path()).Int("chunks", len(chunks)).Msg("reused chunks from unchanged file")
+	return true, nil
+}
+
 // persistChunks saves enrichments, line ranges, and associations for the given chunks.
+// Enrichments and associations are written in bulk rather than row-by-row, since a
+// large file can produce hundreds of chunks and each needs up to four associations.
 // When pageBoundaries is non-empty, each chunk is assigned the page where it starts.
-func (h *ChunkFiles) persistChunks(ctx context.Context, textChunks chunking.TextChunks, pageBoundaries []extraction.PageBoundary, f repository.File, commitSHA string, repoIDStr string) error {
-	for _, ch := range textChunks.All() {
+// When modulePath is non-empty, each chunk is also associated with the Go module it belongs to.
+// text is the full extracted file text; each chunk's line range is anchored against
+// its surrounding lines so a later read can re-locate it if the file has since drifted.
+func (h *ChunkFiles) persistChunks(ctx context.Context, chunks []chunking.Chunk, pageBoundaries []extraction.PageBoundary, f repository.File, commitSHA string, repoIDStr string, modulePath string, text string) error {
+	if len(chunks) == 0 {
+		return nil
+	}
+
+	lines := strings.Split(text, "\n")
+
+	enrichments := make([]enrichment.Enrichment, len(chunks))
+	for i, ch := range chunks {
 		e := enrichment.NewChunkEnrichmentWithLanguage(ch.Content(), f.Extension())
-		saved, saveErr := h.enrichmentStore.Save(ctx, e)
-		if saveErr != nil {
-			return fmt.Errorf("save chunk enrichment: %w", saveErr)
+		if h.complexity != nil {
+			e = e.WithMetrics(h.complexity.Analyze(ch.Content()))
 		}
+		enrichments[i] = e
+	}
+
+	saved, saveErr := h.enrichmentStore.SaveAll(ctx, enrichments)
+	if saveErr != nil {
+		return fmt.Errorf("save chunk enrichments: %w", saveErr)
+	}
+
+	associations := make([]enrichment.Association, 0, len(chunks)*2)
+	for i, ch := range chunks {
+		s := saved[i]
 
 		page := pageForByteOffset(pageBoundaries, ch.Offset())
 		var lr sourcelocation.SourceLocation
 		if page > 0 {
-			lr = sourcelocation.NewWithPage(saved.ID(), page, ch.StartLine(), ch.EndLine())
+			lr = sourcelocation.NewWithPage(s.ID(), page, ch.StartLine(), ch.EndLine())
 		} else {
-			lr = sourcelocation.New(saved.ID(), ch.StartLine(), ch.EndLine())
+			lr = sourcelocation.New(s.ID(), ch.StartLine(), ch.EndLine())
+		}
+		if anchor := sourcelocation.ComputeAnchor(lines, ch.StartLine(), ch.EndLine()); anchor != "" {
+			lr = lr.WithAnchor(anchor)
 		}
 		if _, err := h.lineRangeStore.Save(ctx, lr); err != nil {
 			return fmt.Errorf("save chunk line range: %w", err)
 		}
 
-		if _, err := h.associationStore.Save(ctx, enrichment.CommitAssociation(saved.ID(), commitSHA)); err != nil {
-			return fmt.Errorf("save commit association: %w", err)
-		}
-
+		associations = append(associations, enrichment.CommitAssociation(s.ID(), commitSHA))
 		if f.ID() != 0 {
-			if _, err := h.associationStore.Save(ctx, enrichment.FileAssociation(saved.ID(), strconv.FormatInt(f.ID(), 10))); err != nil {
-				return fmt.Errorf("save file association: %w", err)
-			}
+			associations = append(associations, enrichment.FileAssociation(s.ID(), strconv.FormatInt(f.ID(), 10)))
 		}
-
-		if _, err := h.associationStore.Save(ctx, enrichment.RepositoryAssociation(saved.ID(), repoIDStr)); err != nil {
-			return fmt.Errorf("save repository association: %w", err)
+		associations = append(associations, enrichment.RepositoryAssociation(s.ID(), repoIDStr))
+		if modulePath != "" {
+			associations = append(associations, enrichment.ModuleAssociation(s.ID(), modulePath))
 		}
 	}
+
+	if _, err := h.associationStore.SaveAll(ctx, associations); err != nil {
+		return fmt.Errorf("save chunk associations: %w", err)
+	}
+
 	return nil
 }
 
@@ -312,80 +496,18 @@ func safeDiskPath(clonedPath, relPath string) (string, bool) {
 	return clean, true
 }
 
-// indexableExtensions lists file extensions that contain human-written source
-// code or documentation worth indexing. Everything else (lock files, images,
-// binary formats, data files) is skipped.
-var indexableExtensions = map[string]bool{
-	// Go
-	".go": true,
-	// Python
-	".py": true, ".pyi": true, ".pyx": true,
-	// JavaScript / TypeScript
-	".js": true, ".mjs": true, ".cjs": true, ".jsx": true,
-	".ts": true, ".mts": true, ".cts": true, ".tsx": true,
-	// Ruby
-	".rb": true, ".erb": true,
-	// Rust
-	".rs": true,
-	// Java / Kotlin / Scala / Groovy
-	".java": true, ".kt": true, ".kts": true, ".scala": true, ".groovy": true,
-	// C / C++ / Objective-C
-	".c": true, ".h": true, ".cpp": true, ".cc": true, ".cxx": true,
-	".hpp": true, ".hxx": true, ".m": true, ".mm": true,
-	// C# / F#
-	".cs": true, ".fs": true, ".fsx": true,
-	// PHP
-	".php": true,
-	// Swift
-	".swift": true,
-	// Shell
-	".sh": true, ".bash": true, ".zsh": true, ".fish": true,
-	// SQL
-	".sql": true,
-	// R
-	".r": true,
-	// Lua
-	".lua": true,
-	// Perl
-	".pl": true, ".pm": true,
-	// Elixir / Erlang
-	".ex": true, ".exs": true, ".erl": true, ".hrl": true,
-	// Haskell
-	".hs": true,
-	// Clojure
-	".clj": true, ".cljs": true, ".cljc": true,
-	// Dart
-	".dart": true,
-	// Zig / Nim
-	".zig": true, ".nim": true,
-	// Julia
-	".jl": true,
-	// OCaml
-	".ml": true, ".mli": true,
-	// V / D
-	".v": true, ".d": true,
-	// Web
-	".html": true, ".htm": true, ".css": true, ".scss": true,
-	".sass": true, ".less": true, ".vue": true, ".svelte": true,
-	// Documentation
-	".md": true, ".mdx": true, ".rst": true, ".adoc": true, ".tex": true,
-	// IDL / Schema
-	".proto": true, ".graphql": true, ".gql": true, ".thrift": true,
-	// Data
-	".csv": true,
-}
-
-func init() {
-	for _, ext := range extraction.Extensions() {
-		indexableExtensions[ext] = true
-	}
+// proseExtensions lists text/docs formats with heading structure worth
+// splitting on, rather than chunking as arbitrary fixed-size windows.
+var proseExtensions = map[string]bool{
+	".md":   true,
+	".rst":  true,
+	".adoc": true,
 }
 
-// isIndexable returns true if the file extension is in the whitelist of
-// source code and documentation formats worth indexing.
-func isIndexable(path string) bool {
-	ext := strings.ToLower(filepath.Ext(path))
-	return indexableExtensions[ext]
+// isProseDocument returns true if the file extension should be chunked with
+// NewDocumentChunks (heading-aware, frontmatter-stripped) instead of NewTextChunks.
+func isProseDocument(ext string) bool {
+	return proseExtensions[ext]
 }
 
 // extractPerPage extracts text from each page of a document and returns
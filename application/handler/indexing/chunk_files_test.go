@@ -48,6 +48,7 @@ func TestChunkFiles_SkipsWhenEnrichmentsExist(t *testing.T) {
 	associationStore := persistence.NewAssociationStore(db)
 	lineRangeStore := persistence.NewSourceLocationStore(db)
 	repoStore := persistence.NewRepositoryStore(db)
+	commitStore := persistence.NewCommitStore(db)
 	fileStore := persistence.NewFileStore(db)
 
 	commitSHA := "aaa111bbb222"
@@ -69,11 +70,12 @@ func TestChunkFiles_SkipsWhenEnrichmentsExist(t *testing.T) {
 	require.NoError(t, err)
 
 	h := NewChunkFiles(
-		repoStore, enrichmentStore, associationStore, lineRangeStore, fileStore,
+		repoStore, commitStore, enrichmentStore, associationStore, lineRangeStore, fileStore,
 		&fakeGitAdapter{}, nil, extraction.NewExtractors(), nil,
 		chunking.ChunkParams{Size: 100, Overlap: 0, MinSize: 1},
 		&fakeTrackerFactory{},
 		logger,
+		nil,
 	)
 
 	payload := map[string]any{
@@ -103,6 +105,7 @@ func TestChunkFiles_CreatesEnrichmentsForTextFiles(t *testing.T) {
 	associationStore := persistence.NewAssociationStore(db)
 	lineRangeStore := persistence.NewSourceLocationStore(db)
 	repoStore := persistence.NewRepositoryStore(db)
+	commitStore := persistence.NewCommitStore(db)
 	fileStore := persistence.NewFileStore(db)
 
 	commitSHA := "bbb222ccc333"
@@ -132,11 +135,12 @@ func TestChunkFiles_CreatesEnrichmentsForTextFiles(t *testing.T) {
 	adapter := &fakeGitAdapter{files: map[string][]byte{"main.go": content}}
 
 	h := NewChunkFiles(
-		repoStore, enrichmentStore, associationStore, lineRangeStore, fileStore,
+		repoStore, commitStore, enrichmentStore, associationStore, lineRangeStore, fileStore,
 		adapter, nil, extraction.NewExtractors(), nil,
 		chunking.ChunkParams{Size: 100, Overlap: 0, MinSize: 1},
 		&fakeTrackerFactory{},
 		logger,
+		nil,
 	)
 
 	payload := map[string]any{
@@ -203,6 +207,7 @@ func TestChunkFiles_SkipsBinaryFiles(t *testing.T) {
 	associationStore := persistence.NewAssociationStore(db)
 	lineRangeStore := persistence.NewSourceLocationStore(db)
 	repoStore := persistence.NewRepositoryStore(db)
+	commitStore := persistence.NewCommitStore(db)
 	fileStore := persistence.NewFileStore(db)
 
 	commitSHA := "ccc333ddd444"
@@ -225,11 +230,12 @@ func TestChunkFiles_SkipsBinaryFiles(t *testing.T) {
 	adapter := &fakeGitAdapter{files: map[string][]byte{"image.png": binaryContent}}
 
 	h := NewChunkFiles(
-		repoStore, enrichmentStore, associationStore, lineRangeStore, fileStore,
+		repoStore, commitStore, enrichmentStore, associationStore, lineRangeStore, fileStore,
 		adapter, nil, extraction.NewExtractors(), nil,
 		chunking.ChunkParams{Size: 100, Overlap: 0, MinSize: 1},
 		&fakeTrackerFactory{},
 		logger,
+		nil,
 	)
 
 	payload := map[string]any{
@@ -258,6 +264,7 @@ func TestChunkFiles_ContinuesOnFileContentError(t *testing.T) {
 	associationStore := persistence.NewAssociationStore(db)
 	lineRangeStore := persistence.NewSourceLocationStore(db)
 	repoStore := persistence.NewRepositoryStore(db)
+	commitStore := persistence.NewCommitStore(db)
 	fileStore := persistence.NewFileStore(db)
 
 	commitSHA := "ddd444eee555"
@@ -290,11 +297,12 @@ func TestChunkFiles_ContinuesOnFileContentError(t *testing.T) {
 	adapter := &fakeGitAdapter{files: map[string][]byte{"good.go": goodContent}}
 
 	h := NewChunkFiles(
-		repoStore, enrichmentStore, associationStore, lineRangeStore, fileStore,
+		repoStore, commitStore, enrichmentStore, associationStore, lineRangeStore, fileStore,
 		adapter, nil, extraction.NewExtractors(), nil,
 		chunking.ChunkParams{Size: 100, Overlap: 0, MinSize: 1},
 		&fakeTrackerFactory{},
 		logger,
+		nil,
 	)
 
 	payload := map[string]any{
@@ -420,6 +428,7 @@ func TestChunkFiles_HandlesAbsoluteFilePaths(t *testing.T) {
 	associationStore := persistence.NewAssociationStore(db)
 	lineRangeStore := persistence.NewSourceLocationStore(db)
 	repoStore := persistence.NewRepositoryStore(db)
+	commitStore := persistence.NewCommitStore(db)
 	fileStore := persistence.NewFileStore(db)
 
 	commitSHA := "fff666ggg777"
@@ -451,11 +460,12 @@ func TestChunkFiles_HandlesAbsoluteFilePaths(t *testing.T) {
 	adapter := &fakeGitAdapter{files: map[string][]byte{"bigquery/main.py": content}}
 
 	h := NewChunkFiles(
-		repoStore, enrichmentStore, associationStore, lineRangeStore, fileStore,
+		repoStore, commitStore, enrichmentStore, associationStore, lineRangeStore, fileStore,
 		adapter, nil, extraction.NewExtractors(), nil,
 		chunking.ChunkParams{Size: 100, Overlap: 0, MinSize: 1},
 		&fakeTrackerFactory{},
 		logger,
+		nil,
 	)
 
 	payload := map[string]any{
@@ -484,6 +494,7 @@ func TestChunkFiles_OnlyIndexesSourceAndDocFiles(t *testing.T) {
 	associationStore := persistence.NewAssociationStore(db)
 	lineRangeStore := persistence.NewSourceLocationStore(db)
 	repoStore := persistence.NewRepositoryStore(db)
+	commitStore := persistence.NewCommitStore(db)
 	fileStore := persistence.NewFileStore(db)
 
 	commitSHA := "meta111meta222"
@@ -560,11 +571,12 @@ func TestChunkFiles_OnlyIndexesSourceAndDocFiles(t *testing.T) {
 	}
 
 	h := NewChunkFiles(
-		repoStore, enrichmentStore, associationStore, lineRangeStore, fileStore,
+		repoStore, commitStore, enrichmentStore, associationStore, lineRangeStore, fileStore,
 		&fakeGitAdapter{files: adapterFiles}, nil, extraction.NewExtractors(), nil,
 		chunking.ChunkParams{Size: 100, Overlap: 0, MinSize: 1},
 		&fakeTrackerFactory{},
 		logger,
+		nil,
 	)
 
 	payload := map[string]any{
@@ -593,6 +605,7 @@ func TestChunkFiles_SetsLanguageFromExtension(t *testing.T) {
 	associationStore := persistence.NewAssociationStore(db)
 	lineRangeStore := persistence.NewSourceLocationStore(db)
 	repoStore := persistence.NewRepositoryStore(db)
+	commitStore := persistence.NewCommitStore(db)
 	fileStore := persistence.NewFileStore(db)
 
 	commitSHA := "eee555fff666"
@@ -621,11 +634,12 @@ func TestChunkFiles_SetsLanguageFromExtension(t *testing.T) {
 	adapter := &fakeGitAdapter{files: map[string][]byte{"script.py": content}}
 
 	h := NewChunkFiles(
-		repoStore, enrichmentStore, associationStore, lineRangeStore, fileStore,
+		repoStore, commitStore, enrichmentStore, associationStore, lineRangeStore, fileStore,
 		adapter, nil, extraction.NewExtractors(), nil,
 		chunking.ChunkParams{Size: 100, Overlap: 0, MinSize: 1},
 		&fakeTrackerFactory{},
 		logger,
+		nil,
 	)
 
 	payload := map[string]any{
@@ -655,6 +669,7 @@ func TestChunkFiles_PersistsLineRanges(t *testing.T) {
 	associationStore := persistence.NewAssociationStore(db)
 	lineRangeStore := persistence.NewSourceLocationStore(db)
 	repoStore := persistence.NewRepositoryStore(db)
+	commitStore := persistence.NewCommitStore(db)
 	fileStore := persistence.NewFileStore(db)
 
 	commitSHA := "linerange111222"
@@ -683,11 +698,12 @@ func TestChunkFiles_PersistsLineRanges(t *testing.T) {
 	adapter := &fakeGitAdapter{files: map[string][]byte{"lines.go": content}}
 
 	h := NewChunkFiles(
-		repoStore, enrichmentStore, associationStore, lineRangeStore, fileStore,
+		repoStore, commitStore, enrichmentStore, associationStore, lineRangeStore, fileStore,
 		adapter, nil, extraction.NewExtractors(), nil,
 		chunking.ChunkParams{Size: 25, Overlap: 0, MinSize: 1},
 		&fakeTrackerFactory{},
 		logger,
+		nil,
 	)
 
 	payload := map[string]any{
@@ -765,6 +781,7 @@ func TestChunkFiles_ExtractsDocumentFiles(t *testing.T) {
 	associationStore := persistence.NewAssociationStore(db)
 	lineRangeStore := persistence.NewSourceLocationStore(db)
 	repoStore := persistence.NewRepositoryStore(db)
+	commitStore := persistence.NewCommitStore(db)
 	fileStore := persistence.NewFileStore(db)
 
 	commitSHA := "doc111doc222"
@@ -790,11 +807,12 @@ func TestChunkFiles_ExtractsDocumentFiles(t *testing.T) {
 	docText := &fakeDocumentText{texts: map[string]string{diskPath: extractedText}}
 
 	h := NewChunkFiles(
-		repoStore, enrichmentStore, associationStore, lineRangeStore, fileStore,
+		repoStore, commitStore, enrichmentStore, associationStore, lineRangeStore, fileStore,
 		&fakeGitAdapter{}, docText, extraction.NewExtractors(), nil,
 		chunking.ChunkParams{Size: 100, Overlap: 0, MinSize: 1},
 		&fakeTrackerFactory{},
 		logger,
+		nil,
 	)
 
 	payload := map[string]any{
@@ -849,6 +867,7 @@ func TestChunkFiles_SkipsDocumentsWhenExtractorNil(t *testing.T) {
 	associationStore := persistence.NewAssociationStore(db)
 	lineRangeStore := persistence.NewSourceLocationStore(db)
 	repoStore := persistence.NewRepositoryStore(db)
+	commitStore := persistence.NewCommitStore(db)
 	fileStore := persistence.NewFileStore(db)
 
 	commitSHA := "nodoc111222"
@@ -867,11 +886,12 @@ func TestChunkFiles_SkipsDocumentsWhenExtractorNil(t *testing.T) {
 	require.NoError(t, err)
 
 	h := NewChunkFiles(
-		repoStore, enrichmentStore, associationStore, lineRangeStore, fileStore,
+		repoStore, commitStore, enrichmentStore, associationStore, lineRangeStore, fileStore,
 		&fakeGitAdapter{}, nil, extraction.NewExtractors(), nil,
 		chunking.ChunkParams{Size: 100, Overlap: 0, MinSize: 1},
 		&fakeTrackerFactory{},
 		logger,
+		nil,
 	)
 
 	payload := map[string]any{
@@ -900,6 +920,7 @@ func TestChunkFiles_ReturnsErrorWhenDocumentExtractionFails(t *testing.T) {
 	associationStore := persistence.NewAssociationStore(db)
 	lineRangeStore := persistence.NewSourceLocationStore(db)
 	repoStore := persistence.NewRepositoryStore(db)
+	commitStore := persistence.NewCommitStore(db)
 	fileStore := persistence.NewFileStore(db)
 
 	commitSHA := "docerr111222"
@@ -923,11 +944,12 @@ func TestChunkFiles_ReturnsErrorWhenDocumentExtractionFails(t *testing.T) {
 	docText := &fakeDocumentText{err: fmt.Errorf("invalid xref subsection header")}
 
 	h := NewChunkFiles(
-		repoStore, enrichmentStore, associationStore, lineRangeStore, fileStore,
+		repoStore, commitStore, enrichmentStore, associationStore, lineRangeStore, fileStore,
 		&fakeGitAdapter{}, docText, extraction.NewExtractors(), nil,
 		chunking.ChunkParams{Size: 100, Overlap: 0, MinSize: 1},
 		&fakeTrackerFactory{},
 		logger,
+		nil,
 	)
 
 	payload := map[string]any{
@@ -958,6 +980,7 @@ func TestChunkFiles_ReturnsErrorWhenPerPageExtractionFails(t *testing.T) {
 	associationStore := persistence.NewAssociationStore(db)
 	lineRangeStore := persistence.NewSourceLocationStore(db)
 	repoStore := persistence.NewRepositoryStore(db)
+	commitStore := persistence.NewCommitStore(db)
 	fileStore := persistence.NewFileStore(db)
 
 	commitSHA := "perpageerr111"
@@ -985,11 +1008,12 @@ func TestChunkFiles_ReturnsErrorWhenPerPageExtractionFails(t *testing.T) {
 	docText := &fakeDocumentText{err: fmt.Errorf("invalid xref subsection header")}
 
 	h := NewChunkFiles(
-		repoStore, enrichmentStore, associationStore, lineRangeStore, fileStore,
+		repoStore, commitStore, enrichmentStore, associationStore, lineRangeStore, fileStore,
 		&fakeGitAdapter{}, docText, extraction.NewExtractors(), registry,
 		chunking.ChunkParams{Size: 100, Overlap: 0, MinSize: 1},
 		&fakeTrackerFactory{},
 		logger,
+		nil,
 	)
 
 	payload := map[string]any{
@@ -1011,6 +1035,7 @@ func TestChunkFiles_ParsesCSVFiles(t *testing.T) {
 	associationStore := persistence.NewAssociationStore(db)
 	lineRangeStore := persistence.NewSourceLocationStore(db)
 	repoStore := persistence.NewRepositoryStore(db)
+	commitStore := persistence.NewCommitStore(db)
 	fileStore := persistence.NewFileStore(db)
 
 	commitSHA := "csv111aaa222"
@@ -1036,11 +1061,12 @@ func TestChunkFiles_ParsesCSVFiles(t *testing.T) {
 	require.NoError(t, err)
 
 	h := NewChunkFiles(
-		repoStore, enrichmentStore, associationStore, lineRangeStore, fileStore,
+		repoStore, commitStore, enrichmentStore, associationStore, lineRangeStore, fileStore,
 		&fakeGitAdapter{files: map[string][]byte{"data.csv": csvContent}}, nil, extraction.NewExtractors(), nil,
 		chunking.ChunkParams{Size: 1500, Overlap: 0, MinSize: 1},
 		&fakeTrackerFactory{},
 		logger,
+		nil,
 	)
 
 	payload := map[string]any{
@@ -77,7 +77,7 @@ func (h *CreateBM25Index) Execute(ctx context.Context, payload map[string]any) e
 	documents := make([]search.Document, 0, len(newEnrichments))
 	for _, e := range newEnrichments {
 		if e.Content() != "" {
-			doc := search.NewDocument(strconv.FormatInt(e.ID(), 10), e.Content())
+			doc := search.NewDocumentWithLanguage(strconv.FormatInt(e.ID(), 10), e.Content(), e.Language())
 			documents = append(documents, doc)
 		}
 	}
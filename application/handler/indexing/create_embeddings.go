@@ -2,8 +2,10 @@ package indexing
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/rs/zerolog"
 
@@ -16,11 +18,16 @@ import (
 
 // CreateCodeEmbeddings creates vector embeddings for commit enrichments.
 type CreateCodeEmbeddings struct {
-	codeIndex       handler.VectorIndex
-	enrichmentStore enrichment.EnrichmentStore
-	subtype         enrichment.Subtype
-	trackerFactory  handler.TrackerFactory
-	logger          zerolog.Logger
+	codeIndex        handler.VectorIndex
+	enrichmentStore  enrichment.EnrichmentStore
+	associationStore enrichment.AssociationStore
+	fileStore        repository.FileStore
+	repoStore        repository.RepositoryStore
+	statusStore      search.EmbeddingStatusStore
+	contextTemplates search.ContextTemplateConfig
+	subtype          enrichment.Subtype
+	trackerFactory   handler.TrackerFactory
+	logger           zerolog.Logger
 }
 
 // NewCreateCodeEmbeddings creates a new CreateCodeEmbeddings handler.
@@ -28,6 +35,11 @@ type CreateCodeEmbeddings struct {
 func NewCreateCodeEmbeddings(
 	codeIndex handler.VectorIndex,
 	enrichmentStore enrichment.EnrichmentStore,
+	associationStore enrichment.AssociationStore,
+	fileStore repository.FileStore,
+	repoStore repository.RepositoryStore,
+	statusStore search.EmbeddingStatusStore,
+	contextTemplates search.ContextTemplateConfig,
 	trackerFactory handler.TrackerFactory,
 	logger zerolog.Logger,
 	subtype enrichment.Subtype,
@@ -41,15 +53,32 @@ func NewCreateCodeEmbeddings(
 	if enrichmentStore == nil {
 		return nil, fmt.Errorf("NewCreateCodeEmbeddings: nil enrichmentStore")
 	}
+	if associationStore == nil {
+		return nil, fmt.Errorf("NewCreateCodeEmbeddings: nil associationStore")
+	}
+	if fileStore == nil {
+		return nil, fmt.Errorf("NewCreateCodeEmbeddings: nil fileStore")
+	}
+	if repoStore == nil {
+		return nil, fmt.Errorf("NewCreateCodeEmbeddings: nil repoStore")
+	}
+	if statusStore == nil {
+		return nil, fmt.Errorf("NewCreateCodeEmbeddings: nil statusStore")
+	}
 	if trackerFactory == nil {
 		return nil, fmt.Errorf("NewCreateCodeEmbeddings: nil trackerFactory")
 	}
 	return &CreateCodeEmbeddings{
-		codeIndex:       codeIndex,
-		enrichmentStore: enrichmentStore,
-		subtype:         subtype,
-		trackerFactory:  trackerFactory,
-		logger:          logger,
+		codeIndex:        codeIndex,
+		enrichmentStore:  enrichmentStore,
+		associationStore: associationStore,
+		fileStore:        fileStore,
+		repoStore:        repoStore,
+		statusStore:      statusStore,
+		contextTemplates: contextTemplates,
+		subtype:          subtype,
+		trackerFactory:   trackerFactory,
+		logger:           logger,
 	}, nil
 }
 
@@ -60,6 +89,9 @@ func (h *CreateCodeEmbeddings) Execute(ctx context.Context, payload map[string]a
 		return err
 	}
 
+	ctx, cancel := task.ContextWithDeadline(ctx, payload)
+	defer cancel()
+
 	tracker := h.trackerFactory.ForOperation(
 		task.OperationCreateCodeEmbeddingsForCommit,
 		payload,
@@ -89,12 +121,19 @@ func (h *CreateCodeEmbeddings) Execute(ctx context.Context, payload map[string]a
 		return nil
 	}
 
+	repo, err := h.repoStore.FindOne(ctx, repository.WithID(cp.RepoID()))
+	if err != nil {
+		h.logger.Warn().Int64("repo_id", cp.RepoID()).Str("error", err.Error()).Msg("failed to resolve repository for embedding context header")
+	}
+
 	documents := make([]search.Document, 0, len(newEnrichments))
 	for _, e := range newEnrichments {
-		if e.Content() != "" {
-			doc := search.NewDocument(strconv.FormatInt(e.ID(), 10), e.Content())
-			documents = append(documents, doc)
+		if e.Content() == "" {
+			continue
 		}
+		input := h.embeddingInput(ctx, e, repo.UpstreamURL(), repo.EmbeddingConfig().StripComments())
+		doc := search.NewDocument(strconv.FormatInt(e.ID(), 10), input)
+		documents = append(documents, doc)
 	}
 
 	if len(documents) == 0 {
@@ -111,7 +150,18 @@ func (h *CreateCodeEmbeddings) Execute(ctx context.Context, payload map[string]a
 		search.WithBatchError(func(batchStart, batchEnd int, err error) {
 			h.logger.Error().Str("operation", "create_code_embeddings").Int("batch_start", batchStart).Int("batch_end", batchEnd).Str("error", err.Error()).Msg("embedding batch failed")
 		}),
+		search.WithBatchResult(func(docs []search.Document, err error) {
+			h.recordStatus(ctx, docs, err)
+		}),
+		search.WithItemFailure(func(doc search.Document, err error) {
+			h.logger.Warn().Str("operation", "create_code_embeddings").Str("snippet_id", doc.SnippetID()).Str("error", err.Error()).Msg("embedding item rejected, other items in its batch continued")
+			h.recordStatus(ctx, []search.Document{doc}, err)
+		}),
 	); err != nil {
+		if ctx.Err() != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			h.logger.Warn().Str("commit", handler.ShortSHA(cp.CommitSHA())).Msg("time-box deadline reached, pausing code embeddings for resume")
+			return task.ErrDeadlinePaused
+		}
 		h.logger.Error().Str("error", err.Error()).Msg("failed to create embeddings")
 		return err
 	}
@@ -120,3 +170,181 @@ func (h *CreateCodeEmbeddings) Execute(ctx context.Context, payload map[string]a
 
 	return nil
 }
+
+// Simulate reports how many enrichments would be embedded for the commit,
+// without calling the embedding provider or writing anything.
+func (h *CreateCodeEmbeddings) Simulate(ctx context.Context, payload map[string]any) (task.Plan, error) {
+	cp, err := handler.ExtractCommitPayload(payload)
+	if err != nil {
+		return task.Plan{}, err
+	}
+
+	enrichments, err := h.enrichmentStore.Find(ctx, enrichment.WithCommitSHA(cp.CommitSHA()), enrichment.WithType(enrichment.TypeDevelopment), enrichment.WithSubtype(h.subtype))
+	if err != nil {
+		return task.Plan{}, fmt.Errorf("get snippet enrichments for commit: %w", err)
+	}
+	if len(enrichments) == 0 {
+		return task.NewPlan(task.OperationCreateCodeEmbeddingsForCommit, "no snippets to embed", 0, 0), nil
+	}
+
+	newEnrichments, err := filterNewEnrichments(ctx, func(ctx context.Context, ids []string) (map[string]struct{}, error) {
+		return search.ExistingSnippetIDs(ctx, h.codeIndex.Store, ids)
+	}, enrichments)
+	if err != nil {
+		return task.Plan{}, fmt.Errorf("filter new enrichments: %w", err)
+	}
+
+	if len(newEnrichments) == 0 {
+		return task.NewPlan(task.OperationCreateCodeEmbeddingsForCommit, "all snippets already have code embeddings", 0, 0), nil
+	}
+
+	return task.NewPlan(task.OperationCreateCodeEmbeddingsForCommit,
+		fmt.Sprintf("would create %d code embeddings", len(newEnrichments)), len(newEnrichments), 0), nil
+}
+
+// Ensure CreateCodeEmbeddings implements task.Simulator.
+var _ task.Simulator = (*CreateCodeEmbeddings)(nil)
+
+// embeddingInput builds the text sent for embedding, prepending a
+// structured context header (repo name, file path, language, nearest
+// docstring) ahead of the raw content. The enrichment's stored content is
+// never modified, so display is unaffected by this header or by
+// stripComments.
+func (h *CreateCodeEmbeddings) embeddingInput(ctx context.Context, e enrichment.Enrichment, repoName string, stripCommentsForEmbedding bool) string {
+	filePath, language := h.fileInfo(ctx, e.ID())
+	content := e.Content()
+	if stripCommentsForEmbedding {
+		content = stripComments(content, e.Language())
+	}
+	fields := search.NewContextFields(repoName, filePath, language, leadingDocstring(e.Content()))
+	return h.contextTemplates.BuildInput(fields, content)
+}
+
+// fileInfo resolves the path and language of the file an enrichment was
+// extracted from, via its EntityTypeFile association.
+func (h *CreateCodeEmbeddings) fileInfo(ctx context.Context, enrichmentID int64) (filePath, language string) {
+	associations, err := h.associationStore.Find(ctx, enrichment.WithEnrichmentID(enrichmentID), enrichment.WithEntityType(enrichment.EntityTypeFile))
+	if err != nil || len(associations) == 0 {
+		return "", ""
+	}
+
+	fileID, err := strconv.ParseInt(associations[0].EntityID(), 10, 64)
+	if err != nil {
+		return "", ""
+	}
+
+	file, err := h.fileStore.FindOne(ctx, repository.WithID(fileID))
+	if err != nil {
+		return "", ""
+	}
+	return file.Path(), file.Language()
+}
+
+// leadingDocstring returns the leading comment block of code, used as the
+// nearest docstring when no structured doc-comment metadata is available.
+func leadingDocstring(code string) string {
+	var lines []string
+	for _, line := range strings.Split(code, "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(trimmed, "//"):
+			lines = append(lines, strings.TrimSpace(strings.TrimPrefix(trimmed, "//")))
+		case strings.HasPrefix(trimmed, "#"):
+			lines = append(lines, strings.TrimSpace(strings.TrimPrefix(trimmed, "#")))
+		default:
+			return strings.Join(lines, " ")
+		}
+	}
+	return strings.Join(lines, " ")
+}
+
+// commentSyntax describes the line and block comment markers for a
+// language, keyed by file extension.
+type commentSyntax struct {
+	line       string
+	blockStart string
+	blockEnd   string
+}
+
+// commentSyntaxByExtension maps file extensions to their comment markers.
+// Extensions not listed here are left unstripped.
+var commentSyntaxByExtension = map[string]commentSyntax{
+	".go":    {line: "//", blockStart: "/*", blockEnd: "*/"},
+	".js":    {line: "//", blockStart: "/*", blockEnd: "*/"},
+	".jsx":   {line: "//", blockStart: "/*", blockEnd: "*/"},
+	".ts":    {line: "//", blockStart: "/*", blockEnd: "*/"},
+	".tsx":   {line: "//", blockStart: "/*", blockEnd: "*/"},
+	".java":  {line: "//", blockStart: "/*", blockEnd: "*/"},
+	".c":     {line: "//", blockStart: "/*", blockEnd: "*/"},
+	".h":     {line: "//", blockStart: "/*", blockEnd: "*/"},
+	".cpp":   {line: "//", blockStart: "/*", blockEnd: "*/"},
+	".cs":    {line: "//", blockStart: "/*", blockEnd: "*/"},
+	".rs":    {line: "//", blockStart: "/*", blockEnd: "*/"},
+	".php":   {line: "//", blockStart: "/*", blockEnd: "*/"},
+	".kt":    {line: "//", blockStart: "/*", blockEnd: "*/"},
+	".swift": {line: "//", blockStart: "/*", blockEnd: "*/"},
+	".py":    {line: "#"},
+	".rb":    {line: "#"},
+	".sh":    {line: "#"},
+	".yml":   {line: "#"},
+	".yaml":  {line: "#"},
+	".sql":   {line: "--"},
+	".lua":   {line: "--"},
+}
+
+// stripComments removes line and block comments from code using the
+// comment syntax registered for ext (typically a file extension such as
+// ".go"). Extensions without a registered syntax are returned unchanged.
+// This is a line-oriented heuristic, not a full parser: it does not
+// account for comment markers inside string literals.
+func stripComments(code, ext string) string {
+	syntax, ok := commentSyntaxByExtension[strings.ToLower(ext)]
+	if !ok {
+		return code
+	}
+
+	var out strings.Builder
+	inBlock := false
+	for _, line := range strings.Split(code, "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case inBlock:
+			if syntax.blockEnd != "" && strings.Contains(line, syntax.blockEnd) {
+				inBlock = false
+				if rest := strings.TrimSpace(line[strings.Index(line, syntax.blockEnd)+len(syntax.blockEnd):]); rest != "" {
+					out.WriteString(rest)
+					out.WriteString("\n")
+				}
+			}
+		case syntax.line != "" && strings.HasPrefix(trimmed, syntax.line):
+			// drop the comment line
+		case syntax.blockStart != "" && strings.HasPrefix(trimmed, syntax.blockStart):
+			if syntax.blockEnd != "" && strings.Contains(trimmed[len(syntax.blockStart):], syntax.blockEnd) {
+				continue // single-line block comment
+			}
+			inBlock = true
+		default:
+			out.WriteString(line)
+			out.WriteString("\n")
+		}
+	}
+	return out.String()
+}
+
+// recordStatus persists the outcome of embedding a batch of documents so a
+// provider outage or partial failure can be surfaced and retried instead of
+// silently leaving the snippet unsearchable.
+func (h *CreateCodeEmbeddings) recordStatus(ctx context.Context, docs []search.Document, batchErr error) {
+	state := search.EmbeddingStatusEmbedded
+	errMsg := ""
+	if batchErr != nil {
+		state = search.EmbeddingStatusFailed
+		errMsg = batchErr.Error()
+	}
+	for _, doc := range docs {
+		status := search.NewEmbeddingStatus(doc.SnippetID(), search.TaskNameCode, state, errMsg)
+		if _, err := h.statusStore.Save(ctx, status); err != nil {
+			h.logger.Error().Str("snippet_id", doc.SnippetID()).Str("error", err.Error()).Msg("failed to record embedding status")
+		}
+	}
+}
@@ -18,6 +18,7 @@ type CreateSummaryEmbeddings struct {
 	textIndex        handler.VectorIndex
 	enrichmentStore  enrichment.EnrichmentStore
 	associationStore enrichment.AssociationStore
+	statusStore      search.EmbeddingStatusStore
 	trackerFactory   handler.TrackerFactory
 	logger           zerolog.Logger
 }
@@ -27,6 +28,7 @@ func NewCreateSummaryEmbeddings(
 	textIndex handler.VectorIndex,
 	enrichmentStore enrichment.EnrichmentStore,
 	associationStore enrichment.AssociationStore,
+	statusStore search.EmbeddingStatusStore,
 	trackerFactory handler.TrackerFactory,
 	logger zerolog.Logger,
 ) (*CreateSummaryEmbeddings, error) {
@@ -42,6 +44,9 @@ func NewCreateSummaryEmbeddings(
 	if associationStore == nil {
 		return nil, fmt.Errorf("NewCreateSummaryEmbeddings: nil associationStore")
 	}
+	if statusStore == nil {
+		return nil, fmt.Errorf("NewCreateSummaryEmbeddings: nil statusStore")
+	}
 	if trackerFactory == nil {
 		return nil, fmt.Errorf("NewCreateSummaryEmbeddings: nil trackerFactory")
 	}
@@ -49,6 +54,7 @@ func NewCreateSummaryEmbeddings(
 		textIndex:        textIndex,
 		enrichmentStore:  enrichmentStore,
 		associationStore: associationStore,
+		statusStore:      statusStore,
 		trackerFactory:   trackerFactory,
 		logger:           logger,
 	}, nil
@@ -124,6 +130,13 @@ func (h *CreateSummaryEmbeddings) Execute(ctx context.Context, payload map[strin
 		search.WithBatchError(func(batchStart, batchEnd int, err error) {
 			h.logger.Error().Str("operation", "create_summary_embeddings").Int("batch_start", batchStart).Int("batch_end", batchEnd).Str("error", err.Error()).Msg("embedding batch failed")
 		}),
+		search.WithBatchResult(func(docs []search.Document, err error) {
+			h.recordStatus(ctx, docs, err)
+		}),
+		search.WithItemFailure(func(doc search.Document, err error) {
+			h.logger.Warn().Str("operation", "create_summary_embeddings").Str("snippet_id", doc.SnippetID()).Str("error", err.Error()).Msg("embedding item rejected, other items in its batch continued")
+			h.recordStatus(ctx, []search.Document{doc}, err)
+		}),
 	); err != nil {
 		h.logger.Error().Str("error", err.Error()).Msg("failed to create summary embeddings")
 		return err
@@ -189,5 +202,23 @@ func (h *CreateSummaryEmbeddings) findSnippetSHA(ctx context.Context, enrichment
 	return "", nil
 }
 
+// recordStatus persists the outcome of embedding a batch of documents so a
+// provider outage or partial failure can be surfaced and retried instead of
+// silently leaving the snippet unsearchable.
+func (h *CreateSummaryEmbeddings) recordStatus(ctx context.Context, docs []search.Document, batchErr error) {
+	state := search.EmbeddingStatusEmbedded
+	errMsg := ""
+	if batchErr != nil {
+		state = search.EmbeddingStatusFailed
+		errMsg = batchErr.Error()
+	}
+	for _, doc := range docs {
+		status := search.NewEmbeddingStatus(doc.SnippetID(), search.TaskNameText, state, errMsg)
+		if _, err := h.statusStore.Save(ctx, status); err != nil {
+			h.logger.Error().Str("snippet_id", doc.SnippetID()).Str("error", err.Error()).Msg("failed to record embedding status")
+		}
+	}
+}
+
 // Ensure CreateSummaryEmbeddings implements handler.Handler.
 var _ handler.Handler = (*CreateSummaryEmbeddings)(nil)
@@ -0,0 +1,160 @@
+package indexing
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/rs/zerolog"
+
+	"github.com/helixml/kodit/application/handler"
+	"github.com/helixml/kodit/domain/enrichment"
+	"github.com/helixml/kodit/domain/repository"
+	"github.com/helixml/kodit/domain/search"
+	"github.com/helixml/kodit/domain/task"
+	"github.com/helixml/kodit/domain/wiki"
+)
+
+// CreateWikiPageEmbeddings creates text vector embeddings for wiki page
+// enrichments, so search_wiki can match pages by meaning rather than just
+// keyword.
+type CreateWikiPageEmbeddings struct {
+	textIndex       handler.VectorIndex
+	enrichmentStore enrichment.EnrichmentStore
+	statusStore     search.EmbeddingStatusStore
+	trackerFactory  handler.TrackerFactory
+	logger          zerolog.Logger
+}
+
+// NewCreateWikiPageEmbeddings creates a new CreateWikiPageEmbeddings handler.
+func NewCreateWikiPageEmbeddings(
+	textIndex handler.VectorIndex,
+	enrichmentStore enrichment.EnrichmentStore,
+	statusStore search.EmbeddingStatusStore,
+	trackerFactory handler.TrackerFactory,
+	logger zerolog.Logger,
+) (*CreateWikiPageEmbeddings, error) {
+	if textIndex.Embedding == nil {
+		return nil, fmt.Errorf("NewCreateWikiPageEmbeddings: nil Embedding")
+	}
+	if textIndex.Store == nil {
+		return nil, fmt.Errorf("NewCreateWikiPageEmbeddings: nil Store")
+	}
+	if enrichmentStore == nil {
+		return nil, fmt.Errorf("NewCreateWikiPageEmbeddings: nil enrichmentStore")
+	}
+	if statusStore == nil {
+		return nil, fmt.Errorf("NewCreateWikiPageEmbeddings: nil statusStore")
+	}
+	if trackerFactory == nil {
+		return nil, fmt.Errorf("NewCreateWikiPageEmbeddings: nil trackerFactory")
+	}
+	return &CreateWikiPageEmbeddings{
+		textIndex:       textIndex,
+		enrichmentStore: enrichmentStore,
+		statusStore:     statusStore,
+		trackerFactory:  trackerFactory,
+		logger:          logger,
+	}, nil
+}
+
+// Execute processes the CREATE_WIKI_PAGE_EMBEDDINGS_FOR_COMMIT task.
+func (h *CreateWikiPageEmbeddings) Execute(ctx context.Context, payload map[string]any) error {
+	cp, err := handler.ExtractCommitPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	tracker := h.trackerFactory.ForOperation(
+		task.OperationCreateWikiPageEmbeddingsForCommit,
+		payload,
+	)
+
+	enrichments, err := h.enrichmentStore.Find(ctx, enrichment.WithCommitSHA(cp.CommitSHA()), enrichment.WithType(enrichment.TypeUsage), enrichment.WithSubtype(enrichment.SubtypeWikiPage), repository.WithOrderAsc("enrichments_v2.id"))
+	if err != nil {
+		h.logger.Error().Str("error", err.Error()).Msg("failed to get wiki page enrichments for commit")
+		return err
+	}
+
+	if len(enrichments) == 0 {
+		tracker.Skip(ctx, "No wiki pages to embed")
+		return nil
+	}
+
+	newEnrichments, err := filterNewEnrichments(ctx, func(ctx context.Context, ids []string) (map[string]struct{}, error) {
+		return search.ExistingSnippetIDs(ctx, h.textIndex.Store, ids)
+	}, enrichments)
+	if err != nil {
+		h.logger.Error().Str("error", err.Error()).Msg("failed to filter new enrichments")
+		return err
+	}
+
+	if len(newEnrichments) == 0 {
+		tracker.Skip(ctx, "All wiki pages already have embeddings")
+		return nil
+	}
+
+	documents := make([]search.Document, 0, len(newEnrichments))
+	for _, e := range newEnrichments {
+		page, err := wiki.ParsePageDocument(e.Content())
+		if err != nil {
+			h.logger.Warn().Int64("enrichment_id", e.ID()).Str("error", err.Error()).Msg("failed to parse wiki page document")
+			continue
+		}
+		if page.Content() == "" {
+			continue
+		}
+		documents = append(documents, search.NewDocument(strconv.FormatInt(e.ID(), 10), page.Title()+"\n\n"+page.Content()))
+	}
+
+	if len(documents) == 0 {
+		tracker.Skip(ctx, "No valid wiki pages to embed")
+		return nil
+	}
+
+	tracker.SetTotal(ctx, len(documents))
+
+	if err := h.textIndex.Embedding.Index(ctx, documents,
+		search.WithProgress(func(completed, total int) {
+			tracker.SetCurrent(ctx, completed, "Creating wiki page embeddings")
+		}),
+		search.WithBatchError(func(batchStart, batchEnd int, err error) {
+			h.logger.Error().Str("operation", "create_wiki_page_embeddings").Int("batch_start", batchStart).Int("batch_end", batchEnd).Str("error", err.Error()).Msg("embedding batch failed")
+		}),
+		search.WithBatchResult(func(docs []search.Document, err error) {
+			h.recordStatus(ctx, docs, err)
+		}),
+		search.WithItemFailure(func(doc search.Document, err error) {
+			h.logger.Warn().Str("operation", "create_wiki_page_embeddings").Str("snippet_id", doc.SnippetID()).Str("error", err.Error()).Msg("embedding item rejected, other items in its batch continued")
+			h.recordStatus(ctx, []search.Document{doc}, err)
+		}),
+	); err != nil {
+		h.logger.Error().Str("error", err.Error()).Msg("failed to create wiki page embeddings")
+		return err
+	}
+
+	h.logger.Info().Int("documents", len(documents)).Str("commit", handler.ShortSHA(cp.CommitSHA())).Msg("wiki page embeddings created")
+
+	return nil
+}
+
+// recordStatus persists the outcome of embedding a batch of documents so a
+// provider outage or partial failure can be surfaced and retried instead of
+// silently leaving the page unsearchable.
+func (h *CreateWikiPageEmbeddings) recordStatus(ctx context.Context, docs []search.Document, batchErr error) {
+	state := search.EmbeddingStatusEmbedded
+	errMsg := ""
+	if batchErr != nil {
+		state = search.EmbeddingStatusFailed
+		errMsg = batchErr.Error()
+	}
+	for _, doc := range docs {
+		status := search.NewEmbeddingStatus(doc.SnippetID(), search.TaskNameText, state, errMsg)
+		if _, err := h.statusStore.Save(ctx, status); err != nil {
+			h.logger.Error().Str("snippet_id", doc.SnippetID()).Str("error", err.Error()).Msg("failed to record embedding status")
+		}
+	}
+}
+
+// Ensure CreateWikiPageEmbeddings implements handler.Handler.
+var _ handler.Handler = (*CreateWikiPageEmbeddings)(nil)
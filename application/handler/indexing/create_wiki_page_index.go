@@ -0,0 +1,109 @@
+package indexing
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/rs/zerolog"
+
+	"github.com/helixml/kodit/application/handler"
+	"github.com/helixml/kodit/domain/enrichment"
+	"github.com/helixml/kodit/domain/search"
+	domainservice "github.com/helixml/kodit/domain/service"
+	"github.com/helixml/kodit/domain/task"
+	"github.com/helixml/kodit/domain/wiki"
+)
+
+// CreateWikiPageIndex creates a BM25 keyword index entry for each wiki page
+// enrichment belonging to a commit, so search_wiki can match pages by
+// keyword independently of the full wiki.
+type CreateWikiPageIndex struct {
+	bm25Service     *domainservice.BM25
+	enrichmentStore enrichment.EnrichmentStore
+	trackerFactory  handler.TrackerFactory
+	logger          zerolog.Logger
+}
+
+// NewCreateWikiPageIndex creates a new CreateWikiPageIndex handler.
+func NewCreateWikiPageIndex(
+	bm25Service *domainservice.BM25,
+	enrichmentStore enrichment.EnrichmentStore,
+	trackerFactory handler.TrackerFactory,
+	logger zerolog.Logger,
+) *CreateWikiPageIndex {
+	return &CreateWikiPageIndex{
+		bm25Service:     bm25Service,
+		enrichmentStore: enrichmentStore,
+		trackerFactory:  trackerFactory,
+		logger:          logger,
+	}
+}
+
+// Execute processes the CREATE_WIKI_PAGE_INDEX_FOR_COMMIT task.
+func (h *CreateWikiPageIndex) Execute(ctx context.Context, payload map[string]any) error {
+	cp, err := handler.ExtractCommitPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	tracker := h.trackerFactory.ForOperation(
+		task.OperationCreateWikiPageIndexForCommit,
+		payload,
+	)
+
+	enrichments, err := h.enrichmentStore.Find(ctx, enrichment.WithCommitSHA(cp.CommitSHA()), enrichment.WithType(enrichment.TypeUsage), enrichment.WithSubtype(enrichment.SubtypeWikiPage))
+	if err != nil {
+		h.logger.Error().Str("error", err.Error()).Msg("failed to get wiki page enrichments for commit")
+		return err
+	}
+
+	if len(enrichments) == 0 {
+		tracker.Skip(ctx, "No wiki pages to index")
+		return nil
+	}
+
+	newEnrichments, err := filterNewEnrichments(ctx, h.bm25Service.ExistingIDs, enrichments)
+	if err != nil {
+		h.logger.Error().Str("error", err.Error()).Msg("failed to filter new enrichments")
+		return err
+	}
+
+	if len(newEnrichments) == 0 {
+		tracker.Skip(ctx, "All wiki pages already have BM25 entries")
+		return nil
+	}
+
+	documents := make([]search.Document, 0, len(newEnrichments))
+	for _, e := range newEnrichments {
+		page, err := wiki.ParsePageDocument(e.Content())
+		if err != nil {
+			h.logger.Warn().Int64("enrichment_id", e.ID()).Str("error", err.Error()).Msg("failed to parse wiki page document")
+			continue
+		}
+		if page.Content() == "" {
+			continue
+		}
+		documents = append(documents, search.NewDocument(strconv.FormatInt(e.ID(), 10), page.Title()+"\n\n"+page.Content()))
+	}
+
+	if len(documents) == 0 {
+		tracker.Skip(ctx, "No valid wiki pages to index")
+		return nil
+	}
+
+	tracker.SetTotal(ctx, len(documents))
+
+	if err := h.bm25Service.Index(ctx, documents); err != nil {
+		h.logger.Error().Str("error", err.Error()).Msg("failed to index wiki pages")
+		return err
+	}
+
+	tracker.SetCurrent(ctx, len(documents), "BM25 index created for wiki pages")
+
+	h.logger.Info().Int("documents", len(documents)).Str("commit", handler.ShortSHA(cp.CommitSHA())).Msg("wiki page BM25 index created")
+
+	return nil
+}
+
+// Ensure CreateWikiPageIndex implements handler.Handler.
+var _ handler.Handler = (*CreateWikiPageIndex)(nil)
@@ -100,6 +100,11 @@ func TestCreateCodeEmbeddings_DeduplicatesBeyondMaxSnippetIDsPerFind(t *testing.
 	h, err := NewCreateCodeEmbeddings(
 		handler.VectorIndex{Embedding: rec, Store: store},
 		enrichmentStore,
+		associationStore,
+		persistence.NewFileStore(db),
+		persistence.NewRepositoryStore(db),
+		persistence.NewEmbeddingStatusStore(db),
+		search.NewContextTemplateConfig("", nil),
 		&fakeTrackerFactory{},
 		logger,
 		enrichment.SubtypeChunk,
@@ -153,6 +158,7 @@ func TestCreateSummaryEmbeddings_DeduplicatesBeyondMaxSnippetIDsPerFind(t *testi
 		handler.VectorIndex{Embedding: rec, Store: store},
 		enrichmentStore,
 		associationStore,
+		persistence.NewEmbeddingStatusStore(db),
 		&fakeTrackerFactory{},
 		logger,
 	)
@@ -0,0 +1,74 @@
+package indexing
+
+import (
+	"strings"
+
+	"github.com/helixml/kodit/infrastructure/chunking"
+)
+
+// maxChunksPerFile caps the number of chunks written for a single file.
+// Minified bundles and generated code (protobuf .pb.go, vendored JS
+// bundles) can otherwise produce thousands of near-duplicate chunks that
+// drown out real snippets in search results.
+const maxChunksPerFile = 500
+
+// generatedHeaderLines is how many leading lines are checked for a generated
+// marker; such markers are conventionally placed in the file's header comment.
+const generatedHeaderLines = 20
+
+// generatedMarkers are comment substrings that conventionally mark a file as
+// machine-generated and not meant to be hand-edited.
+var generatedMarkers = []string{
+	"do not edit",
+	"code generated",
+	"autogenerated",
+	"auto-generated",
+	"@generated",
+}
+
+// minifiedAverageLineLength is the average line length, in bytes, above
+// which a file is treated as minified rather than hand-written.
+const minifiedAverageLineLength = 500
+
+// isGenerated returns true if text carries a conventional "generated code"
+// marker in its header.
+func isGenerated(text string) bool {
+	lines := strings.SplitN(text, "\n", generatedHeaderLines+1)
+	if len(lines) > generatedHeaderLines {
+		lines = lines[:generatedHeaderLines]
+	}
+	for _, line := range lines {
+		lower := strings.ToLower(line)
+		for _, marker := range generatedMarkers {
+			if strings.Contains(lower, marker) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isMinified returns true if text's average line length suggests it has
+// been minified rather than hand-written, as with a bundled JS or CSS asset.
+func isMinified(text string) bool {
+	lines := strings.Split(text, "\n")
+	if len(lines) == 0 {
+		return false
+	}
+	return len(text)/len(lines) > minifiedAverageLineLength
+}
+
+// sampleChunks returns at most max chunks, evenly spaced across all so that
+// a capped file still yields a representative spread of snippets instead of
+// just its opening lines.
+func sampleChunks(all []chunking.Chunk, max int) []chunking.Chunk {
+	if len(all) <= max || max <= 0 {
+		return all
+	}
+	sampled := make([]chunking.Chunk, 0, max)
+	step := float64(len(all)) / float64(max)
+	for i := 0; i < max; i++ {
+		sampled = append(sampled, all[int(float64(i)*step)])
+	}
+	return sampled
+}
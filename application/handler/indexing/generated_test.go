@@ -0,0 +1,43 @@
+package indexing
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/helixml/kodit/infrastructure/chunking"
+)
+
+func TestIsGenerated(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want bool
+	}{
+		{"protoc header", "// Code generated by protoc-gen-go. DO NOT EDIT.\npackage pb\n", true},
+		{"do not edit lowercase", "// this file is generated, do not edit\n", true},
+		{"generated marker", "// @generated by some tool\n", true},
+		{"hand-written", "package main\n\nfunc main() {}\n", false},
+		{"marker outside header", strings.Repeat("// filler\n", 25) + "// DO NOT EDIT\n", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isGenerated(tt.text))
+		})
+	}
+}
+
+func TestIsMinified(t *testing.T) {
+	assert.False(t, isMinified("line one\nline two\nline three\n"))
+	assert.True(t, isMinified(strings.Repeat("x", 2000)+"\n"))
+}
+
+func TestSampleChunks(t *testing.T) {
+	all := make([]chunking.Chunk, 10)
+	sampled := sampleChunks(all, 3)
+	assert.Len(t, sampled, 3)
+
+	assert.Equal(t, all, sampleChunks(all, 20))
+	assert.Equal(t, all, sampleChunks(all, 0))
+}
@@ -139,6 +139,7 @@ func TestCreateSummaryEmbeddings_FilterPropagatesError(t *testing.T) {
 		handler.VectorIndex{Embedding: rec, Store: &emptyEmbeddingStore{}},
 		enrichmentStore,
 		fakeAssocStore,
+		persistence.NewEmbeddingStatusStore(db),
 		&fakeTrackerFactory{},
 		logger,
 	)
@@ -177,6 +178,11 @@ func TestCreateCodeEmbeddings_OrdersByID(t *testing.T) {
 	h, err := NewCreateCodeEmbeddings(
 		handler.VectorIndex{Embedding: rec, Store: &emptyEmbeddingStore{}},
 		enrichmentStore,
+		associationStore,
+		persistence.NewFileStore(db),
+		persistence.NewRepositoryStore(db),
+		persistence.NewEmbeddingStatusStore(db),
+		search.NewContextTemplateConfig("", nil),
 		&fakeTrackerFactory{},
 		logger,
 		enrichment.SubtypeSnippet,
@@ -240,6 +246,7 @@ func TestCreateSummaryEmbeddings_OrdersByID(t *testing.T) {
 		handler.VectorIndex{Embedding: rec, Store: &emptyEmbeddingStore{}},
 		enrichmentStore,
 		associationStore,
+		persistence.NewEmbeddingStatusStore(db),
 		&fakeTrackerFactory{},
 		logger,
 	)
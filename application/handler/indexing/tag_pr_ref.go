@@ -0,0 +1,86 @@
+package indexing
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+
+	"github.com/helixml/kodit/application/handler"
+	"github.com/helixml/kodit/domain/enrichment"
+	"github.com/helixml/kodit/domain/task"
+)
+
+// TagPRRef associates a commit's snippet enrichments with the PR ref they
+// were indexed for, so search can scope results to that ref via the pr_ref
+// filter without duplicating the underlying enrichment data.
+type TagPRRef struct {
+	enrichmentStore  enrichment.EnrichmentStore
+	associationStore enrichment.AssociationStore
+	trackerFactory   handler.TrackerFactory
+	logger           zerolog.Logger
+}
+
+// NewTagPRRef creates a new TagPRRef handler.
+func NewTagPRRef(
+	enrichmentStore enrichment.EnrichmentStore,
+	associationStore enrichment.AssociationStore,
+	trackerFactory handler.TrackerFactory,
+	logger zerolog.Logger,
+) *TagPRRef {
+	return &TagPRRef{
+		enrichmentStore:  enrichmentStore,
+		associationStore: associationStore,
+		trackerFactory:   trackerFactory,
+		logger:           logger,
+	}
+}
+
+// Execute processes the TAG_PR_REF_FOR_COMMIT task.
+func (h *TagPRRef) Execute(ctx context.Context, payload map[string]any) error {
+	cp, err := handler.ExtractCommitPayload(payload)
+	if err != nil {
+		return err
+	}
+
+	ref, err := handler.ExtractString(payload, "pr_ref")
+	if err != nil {
+		return err
+	}
+
+	tracker := h.trackerFactory.ForOperation(
+		task.OperationTagPRRefForCommit,
+		payload,
+	)
+
+	enrichments, err := h.enrichmentStore.Find(ctx, enrichment.WithCommitSHA(cp.CommitSHA()), enrichment.WithType(enrichment.TypeDevelopment), enrichment.WithSubtype(enrichment.SubtypeSnippet))
+	if err != nil {
+		h.logger.Error().Str("error", err.Error()).Msg("failed to get snippet enrichments for commit")
+		return err
+	}
+
+	if len(enrichments) == 0 {
+		tracker.Skip(ctx, "No snippets to tag")
+		return nil
+	}
+
+	associations := make([]enrichment.Association, 0, len(enrichments))
+	for _, e := range enrichments {
+		associations = append(associations, enrichment.PRRefAssociation(e.ID(), ref))
+	}
+
+	tracker.SetTotal(ctx, len(associations))
+
+	if _, err := h.associationStore.SaveAll(ctx, associations); err != nil {
+		h.logger.Error().Str("error", err.Error()).Msg("failed to save pr_ref associations")
+		return err
+	}
+
+	tracker.SetCurrent(ctx, len(associations), "PR ref tagged for commit")
+
+	h.logger.Info().Int("snippets", len(associations)).Str("ref", ref).Str("commit", handler.ShortSHA(cp.CommitSHA())).Msg("PR ref tagged")
+
+	return nil
+}
+
+// Ensure TagPRRef implements handler.Handler.
+var _ handler.Handler = (*TagPRRef)(nil)
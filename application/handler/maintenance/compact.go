@@ -0,0 +1,59 @@
+// Package maintenance provides task handlers for periodic upkeep operations
+// that aren't tied to a specific repository or commit.
+package maintenance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog"
+
+	"github.com/helixml/kodit/application/handler"
+	"github.com/helixml/kodit/application/service"
+	"github.com/helixml/kodit/domain/task"
+)
+
+// CompactVectorStore handles the COMPACT_VECTOR_STORE task operation.
+// It removes vector and BM25 rows left behind by snippets that no longer
+// exist, then vacuums the underlying stores to reclaim space.
+type CompactVectorStore struct {
+	enrichments    *service.Enrichment
+	trackerFactory handler.TrackerFactory
+	logger         zerolog.Logger
+}
+
+// NewCompactVectorStore creates a new CompactVectorStore handler.
+func NewCompactVectorStore(
+	enrichments *service.Enrichment,
+	trackerFactory handler.TrackerFactory,
+	logger zerolog.Logger,
+) *CompactVectorStore {
+	return &CompactVectorStore{
+		enrichments:    enrichments,
+		trackerFactory: trackerFactory,
+		logger:         logger,
+	}
+}
+
+// Execute processes the COMPACT_VECTOR_STORE task.
+func (h *CompactVectorStore) Execute(ctx context.Context, payload map[string]any) error {
+	tracker := h.trackerFactory.ForOperation(task.OperationCompactVectorStore, payload)
+
+	stats, err := h.enrichments.Compact(ctx)
+	if err != nil {
+		return fmt.Errorf("compact vector store: %w", err)
+	}
+
+	tracker.SetCurrent(ctx, 1, fmt.Sprintf(
+		"Removed %d orphan vectors, vacuumed %d stores, reclaimed %d bytes",
+		stats.OrphansRemoved, stats.StoresVacuumed, stats.ReclaimedBytes,
+	))
+
+	h.logger.Info().
+		Int("orphans_removed", stats.OrphansRemoved).
+		Int("stores_vacuumed", stats.StoresVacuumed).
+		Int64("reclaimed_bytes", stats.ReclaimedBytes).
+		Msg("vector store compaction complete")
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package maintenance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog"
+
+	"github.com/helixml/kodit/application/handler"
+	"github.com/helixml/kodit/application/service"
+	"github.com/helixml/kodit/domain/task"
+)
+
+// TruncateVectorStore handles the TRUNCATE_VECTOR_STORE task operation.
+// It shrinks already-stored vectors to a reduced dimension in place, the
+// one-off migration that lets WithEmbeddingDimensions apply to a corpus
+// indexed before the setting changed without re-embedding it.
+type TruncateVectorStore struct {
+	enrichments    *service.Enrichment
+	trackerFactory handler.TrackerFactory
+	logger         zerolog.Logger
+}
+
+// NewTruncateVectorStore creates a new TruncateVectorStore handler.
+func NewTruncateVectorStore(
+	enrichments *service.Enrichment,
+	trackerFactory handler.TrackerFactory,
+	logger zerolog.Logger,
+) *TruncateVectorStore {
+	return &TruncateVectorStore{
+		enrichments:    enrichments,
+		trackerFactory: trackerFactory,
+		logger:         logger,
+	}
+}
+
+// Execute processes the TRUNCATE_VECTOR_STORE task.
+func (h *TruncateVectorStore) Execute(ctx context.Context, payload map[string]any) error {
+	tracker := h.trackerFactory.ForOperation(task.OperationTruncateVectorStore, payload)
+
+	dimensions, err := handler.ExtractInt64(payload, "dimensions")
+	if err != nil {
+		return err
+	}
+
+	stats, err := h.enrichments.TruncateVectors(ctx, int(dimensions))
+	if err != nil {
+		return fmt.Errorf("truncate vector store: %w", err)
+	}
+
+	tracker.SetCurrent(ctx, 1, fmt.Sprintf(
+		"Truncated %d vectors to %d dimensions, skipped %d stores that can't truncate in place",
+		stats.VectorsTruncated, dimensions, stats.StoresSkipped,
+	))
+
+	h.logger.Info().
+		Int("vectors_truncated", stats.VectorsTruncated).
+		Int("dimensions", int(dimensions)).
+		Int("stores_skipped", stats.StoresSkipped).
+		Msg("vector store truncation complete")
+
+	return nil
+}
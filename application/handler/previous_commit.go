@@ -7,6 +7,7 @@ import (
 	"github.com/helixml/kodit/application/service"
 	"github.com/helixml/kodit/domain/enrichment"
 	"github.com/helixml/kodit/domain/repository"
+	"github.com/helixml/kodit/domain/task"
 )
 
 // Cleaner removes data from old commits after new data has been created.
@@ -137,6 +138,17 @@ func (h *cleanupHandler) Execute(ctx context.Context, payload map[string]any) er
 	return h.cleanup.Clean(ctx, cp.RepoID(), cp.CommitSHA())
 }
 
+// Simulate forwards to the inner handler's planning logic, if it implements
+// Simulator. Cleanup itself has no side-effect-free plan to report — it only
+// ever runs after Execute succeeds for real.
+func (h *cleanupHandler) Simulate(ctx context.Context, payload map[string]any) (task.Plan, error) {
+	sim, ok := h.inner.(task.Simulator)
+	if !ok {
+		return task.Plan{}, fmt.Errorf("%w: wrapped handler does not support simulation", task.ErrNotSimulatable)
+	}
+	return sim.Simulate(ctx, payload)
+}
+
 // oldCommitSHAs returns SHAs of all commits for the repo except the current one.
 func oldCommitSHAs(ctx context.Context, commits repository.CommitStore, repoID int64, currentSHA string) ([]string, error) {
 	all, err := commits.Find(ctx, repository.WithRepoID(repoID))
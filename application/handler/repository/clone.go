@@ -87,6 +87,30 @@ func (h *Clone) Execute(ctx context.Context, payload map[string]any) error {
 	return nil
 }
 
+// Simulate reports whether the repository still needs cloning, without
+// making any network calls or filesystem writes.
+func (h *Clone) Simulate(ctx context.Context, payload map[string]any) (task.Plan, error) {
+	repoID, err := handler.ExtractInt64(payload, "repository_id")
+	if err != nil {
+		return task.Plan{}, err
+	}
+
+	repo, err := h.repoStore.FindOne(ctx, repository.WithID(repoID))
+	if err != nil {
+		return task.Plan{}, fmt.Errorf("get repository: %w", err)
+	}
+
+	if repo.HasWorkingCopy() {
+		return task.NewPlan(task.OperationCloneRepository, "repository already cloned, no work to do", 0, 0), nil
+	}
+
+	return task.NewPlan(task.OperationCloneRepository,
+		fmt.Sprintf("would clone %s", repo.RemoteURL()), 1, 0), nil
+}
+
+// Ensure Clone implements task.Simulator.
+var _ task.Simulator = (*Clone)(nil)
+
 func (h *Clone) enqueueFollowUpTasks(ctx context.Context, repoID int64) error {
 	payload := map[string]any{"repository_id": repoID}
 
@@ -28,6 +28,7 @@ func newDeleteHandler(t *testing.T) (*Delete, persistence.RepositoryStore) {
 	tagStore := persistence.NewTagStore(db)
 	fileStore := persistence.NewFileStore(db)
 	taskStore := persistence.NewTaskStore(db)
+	statusStore := persistence.NewStatusStore(db)
 
 	enrichmentStore := persistence.NewEnrichmentStore(db)
 	associationStore := persistence.NewAssociationStore(db)
@@ -42,7 +43,7 @@ func newDeleteHandler(t *testing.T) (*Delete, persistence.RepositoryStore) {
 		nil, // line ranges
 	)
 
-	queue := service.NewQueue(taskStore, logger)
+	queue := service.NewQueue(taskStore, statusStore, logger)
 
 	stores := handler.RepositoryStores{
 		Repositories: repoStore,
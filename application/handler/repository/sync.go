@@ -111,12 +111,16 @@ func (h *Sync) Execute(ctx context.Context, payload map[string]any) error {
 
 	if !repo.HasTrackingConfig() {
 		if tc, ok := defaultTrackingConfig(branches); ok {
-			repo = repo.WithTrackingConfig(tc)
+			repo = repo.WithAutoDetectedTrackingConfig(tc)
 			if _, err := h.repoStore.Save(ctx, repo); err != nil {
 				return fmt.Errorf("save default tracking config: %w", err)
 			}
 			h.logger.Info().Int64("repo_id", repoID).Str("branch", tc.Branch()).Msg("set default tracking config")
 		}
+	} else if repaired, err := h.repairTrackingIfDefaultBranchChanged(ctx, repo, branches); err != nil {
+		h.logger.Warn().Str("error", err.Error()).Msg("failed to check default branch tracking")
+	} else if !repaired.TrackingConfig().Equal(repo.TrackingConfig()) {
+		repo = repaired
 	}
 
 	tracker.SetCurrent(ctx, 2, "Queueing commit scans")
@@ -193,3 +197,45 @@ func defaultTrackingConfig(branches []repository.Branch) (repository.TrackingCon
 	}
 	return repository.TrackingConfig{}, false
 }
+
+// repairTrackingIfDefaultBranchChanged compares an auto-detected branch
+// tracking config against the remote's current default branch, since an
+// upstream repository can rename or repoint its default branch (e.g. master
+// to main) after it was first added. Explicitly configured tracking is left
+// alone. If AutoRepairTracking is disabled, a mismatch is only logged; the
+// returned Repository is the saved, repaired copy when a repair was made,
+// otherwise repo unchanged.
+func (h *Sync) repairTrackingIfDefaultBranchChanged(ctx context.Context, repo repository.Repository, branches []repository.Branch) (repository.Repository, error) {
+	tc := repo.TrackingConfig()
+	if !repo.TrackingAutoDetected() || !tc.IsBranch() {
+		return repo, nil
+	}
+
+	remoteDefault, ok := defaultTrackingConfig(branches)
+	if !ok || remoteDefault.Branch() == tc.Branch() {
+		return repo, nil
+	}
+
+	if !repo.AutoRepairTracking() {
+		h.logger.Warn().
+			Int64("repo_id", repo.ID()).
+			Str("tracked_branch", tc.Branch()).
+			Str("remote_default_branch", remoteDefault.Branch()).
+			Msg("remote default branch changed; enable auto-repair to update tracking automatically")
+		return repo, nil
+	}
+
+	repaired := repo.WithAutoDetectedTrackingConfig(remoteDefault)
+	saved, err := h.repoStore.Save(ctx, repaired)
+	if err != nil {
+		return repo, fmt.Errorf("save repaired tracking config: %w", err)
+	}
+
+	h.logger.Info().
+		Int64("repo_id", repo.ID()).
+		Str("old_branch", tc.Branch()).
+		Str("new_branch", remoteDefault.Branch()).
+		Msg("repaired tracking config after remote default branch change")
+
+	return saved, nil
+}
@@ -83,7 +83,8 @@ func newSyncHandler(t *testing.T, scanner *fakeScanner) (*Sync, persistence.Repo
 	repoStore := persistence.NewRepositoryStore(db)
 	branchStore := persistence.NewBranchStore(db)
 	taskStore := persistence.NewTaskStore(db)
-	queue := service.NewQueue(taskStore, logger)
+	statusStore := persistence.NewStatusStore(db)
+	queue := service.NewQueue(taskStore, statusStore, logger)
 
 	h := NewSync(
 		repoStore,
@@ -154,3 +155,90 @@ func TestSync_PreservesExistingTrackingConfig(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "develop", updated.TrackingConfig().Branch())
 }
+
+func TestSync_WarnsOnDefaultBranchMismatchWithoutAutoRepair(t *testing.T) {
+	ctx := context.Background()
+
+	// Upstream's default branch has moved from "master" to "main".
+	defaultBranch := repository.NewBranch(1, "main", "abc123", true)
+	oldBranch := repository.NewBranch(1, "master", "def456", false)
+	scanner := &fakeScanner{branches: []repository.Branch{defaultBranch, oldBranch}}
+
+	h, repoStore, _ := newSyncHandler(t, scanner)
+
+	repo, err := repository.NewRepository("https://example.com/test/repo.git")
+	require.NoError(t, err)
+	repo = repo.WithWorkingCopy(repository.NewWorkingCopy("/tmp/clone", "https://example.com/test/repo.git"))
+	repo = repo.WithPipelineID(1)
+	repo = repo.WithAutoDetectedTrackingConfig(repository.NewTrackingConfigForBranch("master"))
+	repo, err = repoStore.Save(ctx, repo)
+	require.NoError(t, err)
+
+	payload := map[string]any{"repository_id": repo.ID()}
+	err = h.Execute(ctx, payload)
+	require.NoError(t, err)
+
+	// Without auto-repair enabled, the mismatch is only logged; tracking
+	// stays put.
+	updated, err := repoStore.FindOne(ctx, repository.WithID(repo.ID()))
+	require.NoError(t, err)
+	assert.Equal(t, "master", updated.TrackingConfig().Branch())
+}
+
+func TestSync_RepairsDefaultBranchMismatchWhenAutoRepairEnabled(t *testing.T) {
+	ctx := context.Background()
+
+	defaultBranch := repository.NewBranch(1, "main", "abc123", true)
+	oldBranch := repository.NewBranch(1, "master", "def456", false)
+	scanner := &fakeScanner{branches: []repository.Branch{defaultBranch, oldBranch}}
+
+	h, repoStore, _ := newSyncHandler(t, scanner)
+
+	repo, err := repository.NewRepository("https://example.com/test/repo.git")
+	require.NoError(t, err)
+	repo = repo.WithWorkingCopy(repository.NewWorkingCopy("/tmp/clone", "https://example.com/test/repo.git"))
+	repo = repo.WithPipelineID(1)
+	repo = repo.WithAutoDetectedTrackingConfig(repository.NewTrackingConfigForBranch("master"))
+	repo = repo.WithAutoRepairTracking(true)
+	repo, err = repoStore.Save(ctx, repo)
+	require.NoError(t, err)
+
+	payload := map[string]any{"repository_id": repo.ID()}
+	err = h.Execute(ctx, payload)
+	require.NoError(t, err)
+
+	updated, err := repoStore.FindOne(ctx, repository.WithID(repo.ID()))
+	require.NoError(t, err)
+	assert.Equal(t, "main", updated.TrackingConfig().Branch())
+	assert.True(t, updated.TrackingAutoDetected())
+}
+
+func TestSync_DoesNotRepairExplicitTrackingConfigOnDefaultBranchChange(t *testing.T) {
+	ctx := context.Background()
+
+	defaultBranch := repository.NewBranch(1, "main", "abc123", true)
+	oldBranch := repository.NewBranch(1, "master", "def456", false)
+	scanner := &fakeScanner{branches: []repository.Branch{defaultBranch, oldBranch}}
+
+	h, repoStore, _ := newSyncHandler(t, scanner)
+
+	// A user explicitly pinned tracking to "master"; it should not be
+	// overridden even with auto-repair enabled, since it was not
+	// auto-detected.
+	repo, err := repository.NewRepository("https://example.com/test/repo.git")
+	require.NoError(t, err)
+	repo = repo.WithWorkingCopy(repository.NewWorkingCopy("/tmp/clone", "https://example.com/test/repo.git"))
+	repo = repo.WithPipelineID(1)
+	repo = repo.WithTrackingConfig(repository.NewTrackingConfigForBranch("master"))
+	repo = repo.WithAutoRepairTracking(true)
+	repo, err = repoStore.Save(ctx, repo)
+	require.NoError(t, err)
+
+	payload := map[string]any{"repository_id": repo.ID()}
+	err = h.Execute(ctx, payload)
+	require.NoError(t, err)
+
+	updated, err := repoStore.FindOne(ctx, repository.WithID(repo.ID()))
+	require.NoError(t, err)
+	assert.Equal(t, "master", updated.TrackingConfig().Branch())
+}
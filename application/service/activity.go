@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/helixml/kodit/domain/activity"
+	"github.com/helixml/kodit/domain/repository"
+	"github.com/helixml/kodit/domain/task"
+)
+
+// Activity assembles a repository's recent activity feed by combining its
+// commit history, generated enrichments, and task status transitions into a
+// single reverse-chronological timeline.
+type Activity struct {
+	commits     *Commit
+	enrichments *Enrichment
+	tracking    *Tracking
+}
+
+// NewActivity creates a new Activity service.
+func NewActivity(commits *Commit, enrichments *Enrichment, tracking *Tracking) *Activity {
+	return &Activity{
+		commits:     commits,
+		enrichments: enrichments,
+		tracking:    tracking,
+	}
+}
+
+// Feed returns the most recent limit events for a repository, newest first,
+// drawn from up to maxCommits of its most recently indexed commits.
+func (a *Activity) Feed(ctx context.Context, repoID int64, maxCommits, limit int) ([]activity.Event, error) {
+	commits, err := a.commits.Find(ctx,
+		repository.WithRepoID(repoID),
+		repository.WithOrderDesc("date"),
+		repository.WithLimit(maxCommits),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("find commits: %w", err)
+	}
+
+	events := make([]activity.Event, 0, len(commits)*2)
+	commitSHAs := make([]string, 0, len(commits))
+	for _, c := range commits {
+		commitSHAs = append(commitSHAs, c.SHA())
+		events = append(events, activity.NewEvent(
+			activity.EventTypeCommitIndexed,
+			c.CommittedAt(),
+			fmt.Sprintf("Indexed commit %s: %s", c.ShortSHA(), c.ShortMessage()),
+			c.SHA(),
+		))
+	}
+
+	enrichments, err := a.enrichments.List(ctx, &EnrichmentListParams{CommitSHAs: commitSHAs})
+	if err != nil {
+		return nil, fmt.Errorf("list enrichments: %w", err)
+	}
+	for _, e := range enrichments {
+		events = append(events, activity.NewEvent(
+			activity.EventTypeEnrichmentGenerated,
+			e.CreatedAt(),
+			fmt.Sprintf("Generated %s/%s enrichment", e.Type(), e.Subtype()),
+			"",
+		))
+	}
+
+	statuses, err := a.tracking.Statuses(ctx, repoID)
+	if err != nil {
+		return nil, fmt.Errorf("get task statuses: %w", err)
+	}
+	for _, s := range statuses {
+		events = append(events, statusEvent(s))
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].Timestamp().After(events[j].Timestamp())
+	})
+
+	if limit > 0 && len(events) > limit {
+		events = events[:limit]
+	}
+	return events, nil
+}
+
+// statusEvent converts a task status transition into an activity event. A
+// failed status is always reported as a failure regardless of operation;
+// otherwise the operation name determines whether it reads as a sync, a
+// commit being indexed, or an enrichment being generated.
+func statusEvent(s task.Status) activity.Event {
+	if s.State() == task.ReportingStateFailed {
+		return activity.NewEvent(activity.EventTypeFailure, s.UpdatedAt(),
+			fmt.Sprintf("%s failed: %s", s.Operation(), s.Error()), "")
+	}
+	return activity.NewEvent(statusEventType(s.Operation()), s.UpdatedAt(),
+		fmt.Sprintf("%s: %s", s.Operation(), s.State()), "")
+}
+
+// statusEventType classifies a task operation into an activity event type
+// based on its name, since the queue has no explicit event-category field.
+func statusEventType(op task.Operation) activity.EventType {
+	switch {
+	case op == task.OperationCloneRepository || op == task.OperationSyncRepository:
+		return activity.EventTypeSync
+	case strings.Contains(op.String(), "extract_snippets"):
+		return activity.EventTypeCommitIndexed
+	default:
+		return activity.EventTypeEnrichmentGenerated
+	}
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/helixml/kodit/domain/activity"
+	"github.com/helixml/kodit/domain/enrichment"
+	"github.com/helixml/kodit/domain/repository"
+	"github.com/helixml/kodit/domain/task"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActivity_Feed_MergesAndSortsEvents(t *testing.T) {
+	stores := newTestStores(t)
+	ctx := context.Background()
+
+	newRepo, err := repository.NewRepository("https://github.com/test/repo")
+	require.NoError(t, err)
+	repo, err := stores.repos.Save(ctx, newRepo)
+	require.NoError(t, err)
+
+	oldest := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	middle := oldest.Add(time.Hour)
+	newest := middle.Add(time.Hour)
+
+	commit, err := stores.commits.Save(ctx, repository.NewCommit(
+		"abc123", repo.ID(), "add feature",
+		repository.NewAuthor("A", "a@a.com"), repository.NewAuthor("A", "a@a.com"),
+		oldest, oldest,
+	))
+	require.NoError(t, err)
+
+	e := enrichment.NewEnrichment(enrichment.TypeDevelopment, enrichment.SubtypeSnippetSummary, enrichment.EntityTypeCommit, "summary")
+	savedEnrichment, err := stores.enrichments.Save(ctx, e)
+	require.NoError(t, err)
+	_, err = stores.associations.Save(ctx, enrichment.NewAssociation(savedEnrichment.ID(), commit.SHA(), enrichment.EntityTypeCommit))
+	require.NoError(t, err)
+
+	_, err = stores.statuses.Save(ctx, task.NewStatusFull(
+		"s1", task.ReportingStateFailed, task.OperationSyncRepository, "network timeout",
+		newest, newest, 0, 0, "", nil, repo.ID(), task.TrackableTypeRepository,
+	))
+	require.NoError(t, err)
+
+	activitySvc := NewActivity(
+		NewCommit(stores.commits),
+		NewEnrichment(stores.enrichments, stores.associations, nil, nil, nil, nil, nil),
+		NewTracking(stores.statuses, stores.tasks),
+	)
+
+	events, err := activitySvc.Feed(ctx, repo.ID(), 100, 0)
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+
+	// Newest first: the failed sync, then the enrichment, then the commit.
+	assert.Equal(t, activity.EventTypeFailure, events[0].Type())
+	assert.Equal(t, activity.EventTypeEnrichmentGenerated, events[1].Type())
+	assert.Equal(t, activity.EventTypeCommitIndexed, events[2].Type())
+	assert.Equal(t, commit.SHA(), events[2].CommitSHA())
+}
+
+func TestActivity_Feed_RespectsLimit(t *testing.T) {
+	stores := newTestStores(t)
+	ctx := context.Background()
+
+	newRepo, err := repository.NewRepository("https://github.com/test/repo")
+	require.NoError(t, err)
+	repo, err := stores.repos.Save(ctx, newRepo)
+	require.NoError(t, err)
+
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 3; i++ {
+		sha := "sha" + strconv.Itoa(i)
+		when := base.Add(time.Duration(i) * time.Hour)
+		_, err := stores.commits.Save(ctx, repository.NewCommit(
+			sha, repo.ID(), "commit "+sha,
+			repository.NewAuthor("A", "a@a.com"), repository.NewAuthor("A", "a@a.com"),
+			when, when,
+		))
+		require.NoError(t, err)
+	}
+
+	activitySvc := NewActivity(
+		NewCommit(stores.commits),
+		NewEnrichment(stores.enrichments, stores.associations, nil, nil, nil, nil, nil),
+		NewTracking(stores.statuses, stores.tasks),
+	)
+
+	events, err := activitySvc.Feed(ctx, repo.ID(), 100, 2)
+	require.NoError(t, err)
+	assert.Len(t, events, 2)
+}
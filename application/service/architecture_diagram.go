@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/helixml/kodit/domain/repository"
+	"github.com/helixml/kodit/infrastructure/enricher"
+)
+
+// ArchitectureDiagram renders a Mermaid diagram of a repository's physical architecture.
+type ArchitectureDiagram struct {
+	repositories repository.RepositoryStore
+	discoverer   *enricher.PhysicalArchitectureService
+}
+
+// NewArchitectureDiagram creates a new ArchitectureDiagram service.
+func NewArchitectureDiagram(
+	repositories repository.RepositoryStore,
+	discoverer *enricher.PhysicalArchitectureService,
+) *ArchitectureDiagram {
+	return &ArchitectureDiagram{
+		repositories: repositories,
+		discoverer:   discoverer,
+	}
+}
+
+// Generate returns Mermaid flowchart source describing a repository's
+// components and startup dependencies, derived from its Docker Compose
+// configuration.
+func (a *ArchitectureDiagram) Generate(ctx context.Context, repoID int64) (string, error) {
+	repo, err := a.repositories.FindOne(ctx, repository.WithID(repoID))
+	if err != nil {
+		return "", fmt.Errorf("find repository: %w", err)
+	}
+
+	if !repo.HasWorkingCopy() {
+		return "", fmt.Errorf("repository %d has no working copy", repoID)
+	}
+
+	diagram, err := a.discoverer.DiscoverDiagram(repo.WorkingCopy().Path())
+	if err != nil {
+		return "", fmt.Errorf("discover architecture diagram: %w", err)
+	}
+
+	return diagram, nil
+}
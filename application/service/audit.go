@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/helixml/kodit/domain/audit"
+)
+
+// Audit records denied access attempts (currently: repository path ACL
+// denials) so operators can review who was blocked from reading what.
+type Audit struct {
+	store audit.Store
+}
+
+// NewAudit creates a new Audit service.
+func NewAudit(store audit.Store) *Audit {
+	return &Audit{store: store}
+}
+
+// Deny records a denied access attempt for repositoryID at path, with reason
+// explaining why the path was denied (e.g. the deny glob that matched).
+func (s *Audit) Deny(ctx context.Context, repositoryID, path, reason string) error {
+	if _, err := s.store.Save(ctx, audit.New(repositoryID, path, reason)); err != nil {
+		return fmt.Errorf("save audit event: %w", err)
+	}
+	return nil
+}
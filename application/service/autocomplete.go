@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/helixml/kodit/domain/repository"
+)
+
+// defaultAutocompleteLimit caps suggestion lists when the caller doesn't
+// specify one, keeping type-ahead responses fast and UI-sized.
+const defaultAutocompleteLimit = 10
+
+// Autocomplete provides fast, partial-match suggestions for type-ahead UIs
+// and MCP elicitation flows, so callers don't need to list and filter every
+// repository or path client-side.
+type Autocomplete struct {
+	repoStore repository.RepositoryStore
+	fileStore repository.FileStore
+}
+
+// NewAutocomplete creates a new Autocomplete service.
+func NewAutocomplete(repoStore repository.RepositoryStore, fileStore repository.FileStore) *Autocomplete {
+	return &Autocomplete{
+		repoStore: repoStore,
+		fileStore: fileStore,
+	}
+}
+
+// Repositories returns repository remote URLs whose value contains q,
+// ordered by relevance, up to limit results.
+func (s *Autocomplete) Repositories(ctx context.Context, q string, limit int) ([]string, error) {
+	if limit <= 0 {
+		limit = defaultAutocompleteLimit
+	}
+
+	repos, err := s.repoStore.Find(ctx, repository.WithRemoteURLLike(q), repository.WithLimit(limit))
+	if err != nil {
+		return nil, fmt.Errorf("find repositories: %w", err)
+	}
+
+	suggestions := make([]string, len(repos))
+	for i, repo := range repos {
+		suggestions[i] = repo.SanitizedURL()
+	}
+	return suggestions, nil
+}
+
+// Paths returns distinct file paths under repoID's commits that start with
+// q, up to limit results. Since paths repeat across commits, it scans a
+// wider window and dedupes before truncating to limit.
+func (s *Autocomplete) Paths(ctx context.Context, repoID int64, q string, limit int) ([]string, error) {
+	if limit <= 0 {
+		limit = defaultAutocompleteLimit
+	}
+
+	rows, err := s.fileStore.Find(ctx,
+		repository.WithJoin("JOIN git_commits ON git_commits.commit_sha = git_commit_files.commit_sha"),
+		repository.WithWhere("git_commits.repo_id = ?", repoID),
+		repository.WithWhere("path LIKE ?", q+"%"),
+		repository.WithOrderAsc("path"),
+		repository.WithLimit(limit*4),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("find files for repo %d: %w", repoID, err)
+	}
+
+	seen := make(map[string]struct{}, len(rows))
+	suggestions := make([]string, 0, limit)
+	for _, row := range rows {
+		if len(suggestions) == limit {
+			break
+		}
+		if _, dup := seen[row.Path()]; dup {
+			continue
+		}
+		seen[row.Path()] = struct{}{}
+		suggestions = append(suggestions, row.Path())
+	}
+	return suggestions, nil
+}
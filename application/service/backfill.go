@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog"
+
+	"github.com/helixml/kodit/domain/repository"
+	"github.com/helixml/kodit/domain/task"
+)
+
+// BackfillParams configures an enrichment backfill run.
+type BackfillParams struct {
+	// RepoIDs restricts the backfill to these repositories. Empty means all.
+	RepoIDs []int64
+	// Operations restricts the backfill to these enrichment operations.
+	// Empty means every operation from task.EnrichmentOperations.
+	Operations []task.Operation
+	// CommitDepth limits how many of each repository's most recent commits
+	// are considered. Zero means no limit.
+	CommitDepth int
+}
+
+// Backfill enqueues enrichment operations for commits that predate a
+// provider being configured, or that were indexed before an enrichment type
+// existed. It relies on the enrichment handlers' own existence checks to
+// skip commits that already have the enrichment, so it only needs to decide
+// which commits and operations are in scope.
+type Backfill struct {
+	repoStore   repository.RepositoryStore
+	commitStore repository.CommitStore
+	queue       *Queue
+	logger      zerolog.Logger
+}
+
+// NewBackfill creates a new Backfill service.
+func NewBackfill(
+	repoStore repository.RepositoryStore,
+	commitStore repository.CommitStore,
+	queue *Queue,
+	logger zerolog.Logger,
+) *Backfill {
+	return &Backfill{
+		repoStore:   repoStore,
+		commitStore: commitStore,
+		queue:       queue,
+		logger:      logger,
+	}
+}
+
+// Run enqueues the backfill's enrichment operations for every matching
+// commit and returns the number of commits enqueued against.
+func (s *Backfill) Run(ctx context.Context, params BackfillParams) (int, error) {
+	operations := params.Operations
+	if len(operations) == 0 {
+		operations = task.EnrichmentOperations()
+	}
+
+	repos, err := s.backfillRepositories(ctx, params.RepoIDs)
+	if err != nil {
+		return 0, fmt.Errorf("find repositories: %w", err)
+	}
+
+	enqueued := 0
+	for _, repo := range repos {
+		opts := []repository.Option{repository.WithRepoID(repo.ID()), repository.WithOrderDesc("date")}
+		if params.CommitDepth > 0 {
+			opts = append(opts, repository.WithLimit(params.CommitDepth))
+		}
+
+		commits, err := s.commitStore.Find(ctx, opts...)
+		if err != nil {
+			return enqueued, fmt.Errorf("find commits for repo %d: %w", repo.ID(), err)
+		}
+
+		for _, commit := range commits {
+			payload := map[string]any{
+				"repository_id": repo.ID(),
+				"commit_sha":    commit.SHA(),
+			}
+			if err := s.queue.EnqueueOperations(ctx, operations, task.PriorityBackground, payload); err != nil {
+				return enqueued, fmt.Errorf("enqueue backfill for commit %s: %w", commit.ShortSHA(), err)
+			}
+			enqueued++
+		}
+	}
+
+	s.logger.Info().Int("repos", len(repos)).Int("commits", enqueued).Int("operations", len(operations)).Msg("enrichment backfill enqueued")
+
+	return enqueued, nil
+}
+
+func (s *Backfill) backfillRepositories(ctx context.Context, repoIDs []int64) ([]repository.Repository, error) {
+	if len(repoIDs) == 0 {
+		return s.repoStore.Find(ctx)
+	}
+	return s.repoStore.Find(ctx, repository.WithIDIn(repoIDs))
+}
@@ -9,6 +9,7 @@ import (
 	"path"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/helixml/kodit/domain/repository"
@@ -16,6 +17,10 @@ import (
 	"github.com/helixml/kodit/internal/database"
 )
 
+// ErrPathDenied indicates a file path is blocked by the repository's access
+// control configuration.
+var ErrPathDenied = errors.New("path denied by repository access control")
+
 var commitSHAPattern = regexp.MustCompile(`^[0-9a-f]{7,40}$`)
 
 // safeRelativePath normalises filePath to a clean, repository-relative,
@@ -64,6 +69,7 @@ type Blob struct {
 	tags         repository.TagStore
 	branches     repository.BranchStore
 	git          git.Adapter
+	audit        *Audit
 }
 
 // NewBlob creates a new Blob service.
@@ -73,6 +79,7 @@ func NewBlob(
 	tags repository.TagStore,
 	branches repository.BranchStore,
 	gitAdapter git.Adapter,
+	audit *Audit,
 ) *Blob {
 	return &Blob{
 		repositories: repositories,
@@ -80,7 +87,23 @@ func NewBlob(
 		tags:         tags,
 		branches:     branches,
 		git:          gitAdapter,
+		audit:        audit,
+	}
+}
+
+// checkAccess denies safePath if it matches one of repo's deny globs,
+// recording the denial to the audit trail.
+func (b *Blob) checkAccess(ctx context.Context, repo repository.Repository, safePath string) error {
+	for _, glob := range repo.AccessConfig().DenyGlobs() {
+		if matchGlob(glob, safePath) {
+			repoIDFmt := strconv.FormatInt(repo.ID(), 10)
+			if err := b.audit.Deny(ctx, repoIDFmt, safePath, fmt.Sprintf("matched deny glob %q", glob)); err != nil {
+				return fmt.Errorf("record audit event: %w", err)
+			}
+			return fmt.Errorf("%s: %w", safePath, ErrPathDenied)
+		}
 	}
+	return nil
 }
 
 // Resolve resolves a blob name to a commit SHA.
@@ -248,6 +271,10 @@ func (b *Blob) DiskPath(ctx context.Context, repoID int64, blobName, filePath st
 		return "", "", fmt.Errorf("repository %d has no working copy", repoID)
 	}
 
+	if err := b.checkAccess(ctx, repo, safePath); err != nil {
+		return "", "", err
+	}
+
 	return filepath.Join(repo.WorkingCopy().Path(), safePath), commitSHA, nil
 }
 
@@ -272,6 +299,10 @@ func (b *Blob) Content(ctx context.Context, repoID int64, blobName, filePath str
 		return BlobContent{}, fmt.Errorf("repository %d has no working copy", repoID)
 	}
 
+	if err := b.checkAccess(ctx, repo, safePath); err != nil {
+		return BlobContent{}, err
+	}
+
 	content, err := b.git.FileContent(ctx, repo.WorkingCopy().Path(), commitSHA, safePath)
 	if err != nil {
 		if errors.Is(err, git.ErrFileNotFound) {
@@ -5,9 +5,11 @@ import (
 	"errors"
 	"os"
 	"path/filepath"
+	"strconv"
 	"testing"
 	"time"
 
+	"github.com/helixml/kodit/domain/audit"
 	"github.com/helixml/kodit/domain/repository"
 	"github.com/helixml/kodit/infrastructure/git"
 	"github.com/helixml/kodit/internal/database"
@@ -23,7 +25,7 @@ func newBlobTestDeps(t *testing.T) blobTestDeps {
 	t.Helper()
 	stores := newTestStores(t)
 	gitAdapter := &fakeGitAdapter{content: map[string][]byte{}}
-	blob := NewBlob(stores.repos, stores.commits, stores.tags, stores.branches, gitAdapter)
+	blob := NewBlob(stores.repos, stores.commits, stores.tags, stores.branches, gitAdapter, NewAudit(stores.audit))
 	return blobTestDeps{blob: blob, git: gitAdapter, stores: stores}
 }
 
@@ -181,6 +183,57 @@ func TestBlob_ContentFileNotFound(t *testing.T) {
 	}
 }
 
+func TestBlob_ContentDeniedByAccessConfig(t *testing.T) {
+	deps := newBlobTestDeps(t)
+	repoID := seedBlobFixtures(t, deps)
+	ctx := context.Background()
+
+	repo, err := deps.stores.repos.FindOne(ctx, repository.WithID(repoID))
+	if err != nil {
+		t.Fatalf("find repo: %v", err)
+	}
+	repo = repo.WithAccessConfig(repository.NewAccessConfig([]string{"**/README.md"}))
+	if _, err := deps.stores.repos.Save(ctx, repo); err != nil {
+		t.Fatalf("save repo: %v", err)
+	}
+
+	_, err = deps.blob.Content(ctx, repoID, "main", "README.md")
+	if !errors.Is(err, ErrPathDenied) {
+		t.Fatalf("expected ErrPathDenied, got: %v", err)
+	}
+
+	events, err := deps.stores.audit.Find(ctx, audit.WithRepositoryID(strconv.FormatInt(repoID, 10)))
+	if err != nil {
+		t.Fatalf("find audit events: %v", err)
+	}
+	if len(events) != 1 {
+		t.Fatalf("expected 1 audit event, got %d", len(events))
+	}
+	if events[0].Path() != "README.md" {
+		t.Errorf("expected path README.md, got %s", events[0].Path())
+	}
+}
+
+func TestBlob_DiskPathDeniedByAccessConfig(t *testing.T) {
+	deps := newBlobTestDeps(t)
+	repoID := seedBlobFixtures(t, deps)
+	ctx := context.Background()
+
+	repo, err := deps.stores.repos.FindOne(ctx, repository.WithID(repoID))
+	if err != nil {
+		t.Fatalf("find repo: %v", err)
+	}
+	repo = repo.WithAccessConfig(repository.NewAccessConfig([]string{"**/README.md"}))
+	if _, err := deps.stores.repos.Save(ctx, repo); err != nil {
+		t.Fatalf("save repo: %v", err)
+	}
+
+	_, _, err = deps.blob.DiskPath(ctx, repoID, "main", "README.md")
+	if !errors.Is(err, ErrPathDenied) {
+		t.Fatalf("expected ErrPathDenied, got: %v", err)
+	}
+}
+
 func TestBlob_ListFiles(t *testing.T) {
 	dir := t.TempDir()
 	if err := os.MkdirAll(filepath.Join(dir, "src"), 0o755); err != nil {
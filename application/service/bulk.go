@@ -0,0 +1,272 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/helixml/kodit/domain/enrichment"
+	"github.com/helixml/kodit/domain/job"
+	"github.com/helixml/kodit/domain/repository"
+	"github.com/helixml/kodit/domain/task"
+)
+
+// BulkOperationParams scopes a single admin bulk operation. Repositories are
+// selected either by RepoIDs (an explicit list) or RemoteURLLike (a
+// case-insensitive substring match against each repository's remote URL —
+// repositories have no separate label/tag concept in this codebase).
+// EnrichmentSubtype and OlderThan apply only to job.KindDeleteEnrichments;
+// a zero OlderThan matches enrichments of any age.
+type BulkOperationParams struct {
+	Kind              job.Kind
+	RepoIDs           []int64
+	RemoteURLLike     string
+	EnrichmentSubtype enrichment.Subtype
+	OlderThan         time.Time
+}
+
+// BulkOperations runs admin operations across many repositories as a single
+// tracked job: a parent Job record plus one child unit of work per matched
+// repository, so a caller can fire the request and poll aggregate progress
+// instead of blocking on a potentially large fan-out.
+//
+// Sync and re-embed fan out onto the task queue: their children are
+// ordinary tasks tagged with the job ID, and Progress infers how many
+// remain from whether a still-tagged task is still queued. Deleting
+// enrichments is cheap enough to run synchronously per repository within
+// the request, so that Job is already complete by the time Run returns.
+type BulkOperations struct {
+	jobs        job.Store
+	repoStore   repository.RepositoryStore
+	commitStore repository.CommitStore
+	enrichments enrichment.EnrichmentStore
+	backfill    *Backfill
+	queue       *Queue
+	logger      zerolog.Logger
+}
+
+// NewBulkOperations creates a new BulkOperations service.
+func NewBulkOperations(
+	jobs job.Store,
+	repoStore repository.RepositoryStore,
+	commitStore repository.CommitStore,
+	enrichments enrichment.EnrichmentStore,
+	backfill *Backfill,
+	queue *Queue,
+	logger zerolog.Logger,
+) *BulkOperations {
+	return &BulkOperations{
+		jobs:        jobs,
+		repoStore:   repoStore,
+		commitStore: commitStore,
+		enrichments: enrichments,
+		backfill:    backfill,
+		queue:       queue,
+		logger:      logger,
+	}
+}
+
+// Run resolves params' target repositories and fans out the requested
+// operation, returning the parent Job.
+func (s *BulkOperations) Run(ctx context.Context, params BulkOperationParams) (job.Job, error) {
+	repoIDs, err := s.resolveRepoIDs(ctx, params)
+	if err != nil {
+		return job.Job{}, fmt.Errorf("resolve repositories: %w", err)
+	}
+
+	switch params.Kind {
+	case job.KindSyncRepositories:
+		return s.runQueued(ctx, job.KindSyncRepositories, repoIDs, task.OperationSyncRepository)
+	case job.KindReembedRepositories:
+		return s.runReembed(ctx, repoIDs)
+	case job.KindDeleteEnrichments:
+		return s.runDeleteEnrichments(ctx, repoIDs, params.EnrichmentSubtype, params.OlderThan)
+	default:
+		return job.Job{}, fmt.Errorf("unknown bulk operation kind: %q", params.Kind)
+	}
+}
+
+// Progress returns the current state of a job, refreshing the completed
+// count for queued kinds from how many of its tagged tasks remain in the
+// queue.
+func (s *BulkOperations) Progress(ctx context.Context, jobID int64) (job.Job, error) {
+	j, err := s.jobs.FindOne(ctx, repository.WithID(jobID))
+	if err != nil {
+		return job.Job{}, fmt.Errorf("find job %d: %w", jobID, err)
+	}
+	if j.Kind() == job.KindDeleteEnrichments {
+		return j, nil
+	}
+
+	tasks, err := s.queue.store.Find(ctx)
+	if err != nil {
+		return job.Job{}, fmt.Errorf("find pending tasks: %w", err)
+	}
+
+	remaining := 0
+	for _, t := range tasks {
+		if bulkJobID(t.Payload()) == jobID {
+			remaining++
+		}
+	}
+
+	completed := j.Total() - remaining
+	if completed < 0 {
+		completed = 0
+	}
+	return j.WithProgress(completed, j.Failed()), nil
+}
+
+func (s *BulkOperations) resolveRepoIDs(ctx context.Context, params BulkOperationParams) ([]int64, error) {
+	if len(params.RepoIDs) > 0 {
+		return params.RepoIDs, nil
+	}
+
+	var opts []repository.Option
+	if params.RemoteURLLike != "" {
+		opts = append(opts, repository.WithRemoteURLLike(params.RemoteURLLike))
+	}
+
+	repos, err := s.repoStore.Find(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, len(repos))
+	for i, r := range repos {
+		ids[i] = r.ID()
+	}
+	return ids, nil
+}
+
+// runQueued fans out operation as one queued task per repository, tagging
+// each task's payload with the job ID so Progress can tell how many remain.
+func (s *BulkOperations) runQueued(ctx context.Context, kind job.Kind, repoIDs []int64, operation task.Operation) (job.Job, error) {
+	saved, err := s.jobs.Save(ctx, job.New(kind, len(repoIDs)))
+	if err != nil {
+		return job.Job{}, fmt.Errorf("save job: %w", err)
+	}
+
+	for _, repoID := range repoIDs {
+		t := task.NewTask(operation, int(task.PriorityUserInitiated), map[string]any{
+			"repository_id": repoID,
+			"bulk_job_id":   saved.ID(),
+		})
+		if err := s.queue.Enqueue(ctx, t); err != nil {
+			return saved, fmt.Errorf("enqueue %s for repo %d: %w", operation, repoID, err)
+		}
+	}
+
+	return saved, nil
+}
+
+// runReembed queues a code- and text-embedding backfill for each matched
+// repository's commits, via the same Backfill path used for provider
+// onboarding.
+func (s *BulkOperations) runReembed(ctx context.Context, repoIDs []int64) (job.Job, error) {
+	saved, err := s.jobs.Save(ctx, job.New(job.KindReembedRepositories, len(repoIDs)))
+	if err != nil {
+		return job.Job{}, fmt.Errorf("save job: %w", err)
+	}
+
+	if _, err := s.backfill.Run(ctx, BackfillParams{
+		RepoIDs:    repoIDs,
+		Operations: []task.Operation{task.OperationCreateCodeEmbeddings, task.OperationCreateTextEmbeddings},
+	}); err != nil {
+		failed, saveErr := s.jobs.Save(ctx, saved.WithError(err.Error()))
+		if saveErr != nil {
+			return saved, fmt.Errorf("re-embed repositories: %w (also failed to save job error: %v)", err, saveErr)
+		}
+		return failed, fmt.Errorf("re-embed repositories: %w", err)
+	}
+
+	return saved, nil
+}
+
+// runDeleteEnrichments deletes matching enrichments for each repository
+// synchronously, since the work is a direct store deletion rather than
+// queued task processing.
+func (s *BulkOperations) runDeleteEnrichments(
+	ctx context.Context,
+	repoIDs []int64,
+	subtype enrichment.Subtype,
+	olderThan time.Time,
+) (job.Job, error) {
+	completed, failed := 0, 0
+	for _, repoID := range repoIDs {
+		if err := s.deleteEnrichmentsForRepo(ctx, repoID, subtype, olderThan); err != nil {
+			s.logger.Error().Err(err).Int64("repository_id", repoID).Msg("failed to delete enrichments for repository")
+			failed++
+			continue
+		}
+		completed++
+	}
+
+	saved, err := s.jobs.Save(ctx, job.New(job.KindDeleteEnrichments, len(repoIDs)).WithProgress(completed, failed))
+	if err != nil {
+		return job.Job{}, fmt.Errorf("save job: %w", err)
+	}
+	return saved, nil
+}
+
+func (s *BulkOperations) deleteEnrichmentsForRepo(
+	ctx context.Context,
+	repoID int64,
+	subtype enrichment.Subtype,
+	olderThan time.Time,
+) error {
+	commits, err := s.commitStore.Find(ctx, repository.WithRepoID(repoID))
+	if err != nil {
+		return fmt.Errorf("find commits: %w", err)
+	}
+	if len(commits) == 0 {
+		return nil
+	}
+
+	shas := make([]string, len(commits))
+	for i, c := range commits {
+		shas[i] = c.SHA()
+	}
+
+	opts := []repository.Option{enrichment.WithCommitSHAs(shas)}
+	if subtype != "" {
+		opts = append(opts, enrichment.WithSubtype(subtype))
+	}
+	if !olderThan.IsZero() {
+		opts = append(opts, enrichment.WithCreatedBefore(olderThan))
+	}
+
+	matches, err := s.enrichments.Find(ctx, opts...)
+	if err != nil {
+		return fmt.Errorf("find enrichments: %w", err)
+	}
+	if len(matches) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, len(matches))
+	for i, e := range matches {
+		ids[i] = e.ID()
+	}
+
+	return s.enrichments.DeleteBy(ctx, repository.WithIDIn(ids))
+}
+
+func bulkJobID(payload map[string]any) int64 {
+	val, ok := payload["bulk_job_id"]
+	if !ok {
+		return 0
+	}
+	switch v := val.(type) {
+	case int64:
+		return v
+	case int:
+		return int64(v)
+	case float64:
+		return int64(v)
+	default:
+		return 0
+	}
+}
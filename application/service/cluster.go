@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/helixml/kodit/domain/cluster"
+)
+
+// Cluster reports on the queue worker instances currently registered
+// against the shared task queue, so operators running multiple worker
+// replicas can see which node is doing what.
+type Cluster struct {
+	store cluster.Store
+}
+
+// NewCluster creates a new Cluster service.
+func NewCluster(store cluster.Store) *Cluster {
+	return &Cluster{store: store}
+}
+
+// Workers returns every registered worker instance.
+func (c *Cluster) Workers(ctx context.Context) ([]cluster.Worker, error) {
+	workers, err := c.store.Find(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("find worker instances: %w", err)
+	}
+	return workers, nil
+}
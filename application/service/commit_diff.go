@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/helixml/kodit/domain/repository"
+	"github.com/helixml/kodit/infrastructure/git"
+)
+
+// CommitDiffer produces on-demand unified diffs for a single commit, without
+// persisting anything, for tools like the MCP get_commit_diff tool.
+type CommitDiffer struct {
+	repositories repository.RepositoryStore
+	git          git.Adapter
+}
+
+// NewCommitDiffer creates a new CommitDiffer service.
+func NewCommitDiffer(repositories repository.RepositoryStore, gitAdapter git.Adapter) *CommitDiffer {
+	return &CommitDiffer{
+		repositories: repositories,
+		git:          gitAdapter,
+	}
+}
+
+// Diff returns the unified diff for commitSHA in repoID's working copy,
+// optionally scoped to a single file path.
+func (d *CommitDiffer) Diff(ctx context.Context, repoID int64, commitSHA, filePath string) (string, error) {
+	repo, err := d.repositories.FindOne(ctx, repository.WithID(repoID))
+	if err != nil {
+		return "", fmt.Errorf("find repository: %w", err)
+	}
+	if !repo.HasWorkingCopy() {
+		return "", fmt.Errorf("repository %d has no working copy", repoID)
+	}
+
+	diff, err := d.git.CommitDiff(ctx, repo.WorkingCopy().Path(), commitSHA, filePath)
+	if err != nil {
+		return "", fmt.Errorf("commit diff: %w", err)
+	}
+	return diff, nil
+}
@@ -0,0 +1,254 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/helixml/kodit/domain/enrichment"
+	"github.com/helixml/kodit/domain/repository"
+)
+
+// SharedFile records a file present with identical content (blob SHA) in
+// both compared repositories, regardless of path.
+type SharedFile struct {
+	PathA   string
+	PathB   string
+	BlobSHA string
+}
+
+// DuplicateSnippet records a pair of snippets with identical normalized
+// content found in both compared repositories.
+type DuplicateSnippet struct {
+	SnippetIDA string
+	SnippetIDB string
+}
+
+// ComparisonReport summarizes the overlap between two repositories.
+type ComparisonReport struct {
+	SharedFiles        []SharedFile
+	DuplicateSnippets  []DuplicateSnippet
+	SharedDependencies []string
+}
+
+// Comparison reports shared files, near-duplicate snippets, and shared
+// dependencies between two indexed repositories, for use during
+// consolidation and deduplication initiatives.
+type Comparison struct {
+	commits     repository.CommitStore
+	files       repository.FileStore
+	enrichments *Enrichment
+	blobs       *Blob
+}
+
+// NewComparison creates a new Comparison service.
+func NewComparison(
+	commits repository.CommitStore,
+	files repository.FileStore,
+	enrichments *Enrichment,
+	blobs *Blob,
+) *Comparison {
+	return &Comparison{
+		commits:     commits,
+		files:       files,
+		enrichments: enrichments,
+		blobs:       blobs,
+	}
+}
+
+// Compare reports the overlap between the latest indexed commits of repoAID
+// and repoBID.
+func (c *Comparison) Compare(ctx context.Context, repoAID, repoBID int64) (ComparisonReport, error) {
+	commitA, err := c.headCommit(ctx, repoAID)
+	if err != nil {
+		return ComparisonReport{}, err
+	}
+	commitB, err := c.headCommit(ctx, repoBID)
+	if err != nil {
+		return ComparisonReport{}, err
+	}
+
+	filesA, err := c.files.Find(ctx, repository.WithCommitSHA(commitA))
+	if err != nil {
+		return ComparisonReport{}, fmt.Errorf("find files for commit %s: %w", commitA, err)
+	}
+	filesB, err := c.files.Find(ctx, repository.WithCommitSHA(commitB))
+	if err != nil {
+		return ComparisonReport{}, fmt.Errorf("find files for commit %s: %w", commitB, err)
+	}
+
+	duplicateSnippets, err := c.duplicateSnippets(ctx, commitA, commitB)
+	if err != nil {
+		return ComparisonReport{}, err
+	}
+
+	sharedDependencies, err := c.sharedDependencies(ctx, repoAID, commitA, filesA, repoBID, commitB, filesB)
+	if err != nil {
+		return ComparisonReport{}, err
+	}
+
+	return ComparisonReport{
+		SharedFiles:        sharedFilesByBlobSHA(filesA, filesB),
+		DuplicateSnippets:  duplicateSnippets,
+		SharedDependencies: sharedDependencies,
+	}, nil
+}
+
+// headCommit returns the most recently dated indexed commit for repoID.
+func (c *Comparison) headCommit(ctx context.Context, repoID int64) (string, error) {
+	commits, err := c.commits.Find(ctx,
+		repository.WithRepoID(repoID),
+		repository.WithOrderDesc("date"),
+		repository.WithLimit(1),
+	)
+	if err != nil {
+		return "", fmt.Errorf("find latest commit for repository %d: %w", repoID, err)
+	}
+	if len(commits) == 0 {
+		return "", fmt.Errorf("no commits found for repository %d", repoID)
+	}
+	return commits[0].SHA(), nil
+}
+
+// sharedFilesByBlobSHA pairs files from filesA and filesB that share a blob
+// SHA, i.e. byte-identical content, independent of path.
+func sharedFilesByBlobSHA(filesA, filesB []repository.File) []SharedFile {
+	byBlobSHA := make(map[string]repository.File, len(filesB))
+	for _, f := range filesB {
+		byBlobSHA[f.BlobSHA()] = f
+	}
+
+	var shared []SharedFile
+	for _, a := range filesA {
+		b, ok := byBlobSHA[a.BlobSHA()]
+		if !ok {
+			continue
+		}
+		shared = append(shared, SharedFile{PathA: a.Path(), PathB: b.Path(), BlobSHA: a.BlobSHA()})
+	}
+	return shared
+}
+
+// duplicateSnippets pairs code chunks from commitA and commitB whose
+// whitespace-normalized content is identical.
+func (c *Comparison) duplicateSnippets(ctx context.Context, commitA, commitB string) ([]DuplicateSnippet, error) {
+	typDev := enrichment.TypeDevelopment
+	subSnippet := enrichment.SubtypeChunk
+
+	snippetsA, err := c.enrichments.List(ctx, &EnrichmentListParams{CommitSHA: commitA, Type: &typDev, Subtype: &subSnippet})
+	if err != nil {
+		return nil, fmt.Errorf("list snippets for commit %s: %w", commitA, err)
+	}
+	snippetsB, err := c.enrichments.List(ctx, &EnrichmentListParams{CommitSHA: commitB, Type: &typDev, Subtype: &subSnippet})
+	if err != nil {
+		return nil, fmt.Errorf("list snippets for commit %s: %w", commitB, err)
+	}
+
+	byNormalizedContent := make(map[string]enrichment.Enrichment, len(snippetsB))
+	for _, s := range snippetsB {
+		byNormalizedContent[normalizeSnippet(s.Content())] = s
+	}
+
+	var duplicates []DuplicateSnippet
+	for _, a := range snippetsA {
+		b, ok := byNormalizedContent[normalizeSnippet(a.Content())]
+		if !ok {
+			continue
+		}
+		duplicates = append(duplicates, DuplicateSnippet{
+			SnippetIDA: fmt.Sprintf("%d", a.ID()),
+			SnippetIDB: fmt.Sprintf("%d", b.ID()),
+		})
+	}
+	return duplicates, nil
+}
+
+// normalizeSnippet collapses whitespace so snippets that differ only in
+// indentation or trailing blank lines still compare equal.
+func normalizeSnippet(content string) string {
+	return strings.Join(strings.Fields(content), " ")
+}
+
+// sharedDependencies returns the Go module paths required by both
+// repositories' go.mod files, sorted and de-duplicated.
+func (c *Comparison) sharedDependencies(
+	ctx context.Context,
+	repoAID int64, commitA string, filesA []repository.File,
+	repoBID int64, commitB string, filesB []repository.File,
+) ([]string, error) {
+	depsA, err := c.goModRequires(ctx, repoAID, commitA, filesA)
+	if err != nil {
+		return nil, err
+	}
+	depsB, err := c.goModRequires(ctx, repoBID, commitB, filesB)
+	if err != nil {
+		return nil, err
+	}
+
+	inB := make(map[string]struct{}, len(depsB))
+	for _, d := range depsB {
+		inB[d] = struct{}{}
+	}
+
+	seen := make(map[string]struct{})
+	var shared []string
+	for _, d := range depsA {
+		if _, ok := inB[d]; !ok {
+			continue
+		}
+		if _, dup := seen[d]; dup {
+			continue
+		}
+		seen[d] = struct{}{}
+		shared = append(shared, d)
+	}
+	sort.Strings(shared)
+	return shared, nil
+}
+
+// goModRequires reads every go.mod tracked at commitSHA and returns the
+// module paths it requires.
+func (c *Comparison) goModRequires(ctx context.Context, repoID int64, commitSHA string, files []repository.File) ([]string, error) {
+	var deps []string
+	for _, f := range files {
+		if path.Base(f.Path()) != "go.mod" {
+			continue
+		}
+		content, err := c.blobs.Content(ctx, repoID, commitSHA, f.Path())
+		if err != nil {
+			return nil, fmt.Errorf("read %s: %w", f.Path(), err)
+		}
+		deps = append(deps, parseGoModRequires(content.Content())...)
+	}
+	return deps, nil
+}
+
+// parseGoModRequires extracts module paths from "require" lines and blocks
+// in a go.mod file, ignoring version numbers.
+func parseGoModRequires(data []byte) []string {
+	var deps []string
+	inBlock := false
+
+	for _, line := range strings.Split(string(data), "\n") {
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case strings.HasPrefix(trimmed, "require ("):
+			inBlock = true
+		case inBlock && trimmed == ")":
+			inBlock = false
+		case inBlock:
+			if fields := strings.Fields(trimmed); len(fields) > 0 {
+				deps = append(deps, fields[0])
+			}
+		case strings.HasPrefix(trimmed, "require "):
+			if fields := strings.Fields(strings.TrimPrefix(trimmed, "require ")); len(fields) > 0 {
+				deps = append(deps, fields[0])
+			}
+		}
+	}
+
+	return deps
+}
@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/helixml/kodit/domain/enrichment"
+	"github.com/helixml/kodit/domain/repository"
+	"github.com/helixml/kodit/domain/search"
+)
+
+// singletonSubtypes are enrichment subtypes that occur at most once per
+// commit, so a subtype present on both sides with different content is a
+// genuine change rather than an unrelated addition and removal.
+var singletonSubtypes = map[enrichment.Subtype]bool{
+	enrichment.SubtypePhysical:          true,
+	enrichment.SubtypeDatabaseSchema:    true,
+	enrichment.SubtypeCommitDescription: true,
+	enrichment.SubtypeCookbook:          true,
+	enrichment.SubtypeAPIDocs:           true,
+	enrichment.SubtypeWiki:              true,
+	enrichment.SubtypeConventions:       true,
+}
+
+// DiffCounts summarizes how many items were added, removed, or changed
+// between two corpus generations.
+type DiffCounts struct {
+	Added   int
+	Removed int
+	Changed int
+}
+
+// CorpusDiffReport summarizes how a repository's indexed corpus differs
+// between two commits ("generations").
+type CorpusDiffReport struct {
+	FromCommitSHA string
+	ToCommitSHA   string
+	Snippets      DiffCounts
+	Enrichments   DiffCounts
+	Vectors       DiffCounts
+}
+
+// CorpusDiff compares a repository's indexed corpus between two commits, to
+// help answer questions like "why did this result disappear after the last
+// sync?" without having to inspect the database by hand.
+type CorpusDiff struct {
+	commitStore     repository.CommitStore
+	enrichmentStore enrichment.EnrichmentStore
+	statusStore     search.EmbeddingStatusStore
+}
+
+// NewCorpusDiff creates a new CorpusDiff service.
+func NewCorpusDiff(commitStore repository.CommitStore, enrichmentStore enrichment.EnrichmentStore, statusStore search.EmbeddingStatusStore) *CorpusDiff {
+	return &CorpusDiff{commitStore: commitStore, enrichmentStore: enrichmentStore, statusStore: statusStore}
+}
+
+// Diff compares the corpus indexed at fromSHA against toSHA, both of which
+// must be commits of repoID.
+func (d *CorpusDiff) Diff(ctx context.Context, repoID int64, fromSHA, toSHA string) (CorpusDiffReport, error) {
+	if err := d.checkCommitBelongsToRepo(ctx, repoID, fromSHA); err != nil {
+		return CorpusDiffReport{}, fmt.Errorf("from_gen: %w", err)
+	}
+	if err := d.checkCommitBelongsToRepo(ctx, repoID, toSHA); err != nil {
+		return CorpusDiffReport{}, fmt.Errorf("to_gen: %w", err)
+	}
+
+	from, err := d.enrichmentStore.Find(ctx, enrichment.WithCommitSHA(fromSHA))
+	if err != nil {
+		return CorpusDiffReport{}, fmt.Errorf("find enrichments for %s: %w", fromSHA, err)
+	}
+	to, err := d.enrichmentStore.Find(ctx, enrichment.WithCommitSHA(toSHA))
+	if err != nil {
+		return CorpusDiffReport{}, fmt.Errorf("find enrichments for %s: %w", toSHA, err)
+	}
+
+	var fromSnippets, toSnippets, fromOther, toOther []enrichment.Enrichment
+	for _, e := range from {
+		if e.Subtype() == enrichment.SubtypeChunk {
+			fromSnippets = append(fromSnippets, e)
+		} else {
+			fromOther = append(fromOther, e)
+		}
+	}
+	for _, e := range to {
+		if e.Subtype() == enrichment.SubtypeChunk {
+			toSnippets = append(toSnippets, e)
+		} else {
+			toOther = append(toOther, e)
+		}
+	}
+
+	vectors, err := d.diffVectors(ctx, fromSnippets, toSnippets)
+	if err != nil {
+		return CorpusDiffReport{}, fmt.Errorf("diff vectors: %w", err)
+	}
+
+	return CorpusDiffReport{
+		FromCommitSHA: fromSHA,
+		ToCommitSHA:   toSHA,
+		Snippets:      diffByContent(fromSnippets, toSnippets),
+		Enrichments:   diffBySubtype(fromOther, toOther),
+		Vectors:       vectors,
+	}, nil
+}
+
+// checkCommitBelongsToRepo returns an error if sha is not a commit of
+// repoID, so a diff can't accidentally straddle two repositories.
+func (d *CorpusDiff) checkCommitBelongsToRepo(ctx context.Context, repoID int64, sha string) error {
+	commit, err := d.commitStore.FindOne(ctx, repository.WithSHA(sha))
+	if err != nil {
+		return fmt.Errorf("find commit %s: %w", sha, err)
+	}
+	if commit.RepoID() != repoID {
+		return fmt.Errorf("commit %s does not belong to repository %d", sha, repoID)
+	}
+	return nil
+}
+
+// diffVectors compares which of the snippets in from and to have a
+// successfully embedded vector, keyed the same way as diffByContent.
+func (d *CorpusDiff) diffVectors(ctx context.Context, fromSnippets, toSnippets []enrichment.Enrichment) (DiffCounts, error) {
+	fromEmbedded, err := d.embeddedContent(ctx, fromSnippets)
+	if err != nil {
+		return DiffCounts{}, err
+	}
+	toEmbedded, err := d.embeddedContent(ctx, toSnippets)
+	if err != nil {
+		return DiffCounts{}, err
+	}
+	return diffSets(fromEmbedded, toEmbedded), nil
+}
+
+// embeddedContent returns the content of each snippet in snippets that has
+// a successfully embedded code vector.
+func (d *CorpusDiff) embeddedContent(ctx context.Context, snippets []enrichment.Enrichment) (map[string]bool, error) {
+	embedded := make(map[string]bool, len(snippets))
+	for _, s := range snippets {
+		statuses, err := d.statusStore.Find(ctx,
+			repository.WithCondition("snippet_id", fmt.Sprintf("%d", s.ID())),
+			search.WithTaskName(search.TaskNameCode),
+			search.WithState(search.EmbeddingStatusEmbedded))
+		if err != nil {
+			return nil, fmt.Errorf("find embedding status for snippet %d: %w", s.ID(), err)
+		}
+		if len(statuses) > 0 {
+			embedded[s.Content()] = true
+		}
+	}
+	return embedded, nil
+}
+
+// diffByContent compares two sets of enrichments by their raw content,
+// reporting additions and removals. Content isn't a stable identity across
+// commits, so a genuinely edited snippet appears as one removal and one
+// addition rather than a change.
+func diffByContent(from, to []enrichment.Enrichment) DiffCounts {
+	return diffSets(contentSet(from), contentSet(to))
+}
+
+// diffBySubtype compares two sets of enrichments keyed by subtype. Most
+// non-chunk subtypes occur at most once per commit, so a subtype present on
+// both sides with different content is reported as changed; subtypes that
+// can recur (e.g. wiki pages, examples) fall back to a content comparison.
+func diffBySubtype(from, to []enrichment.Enrichment) DiffCounts {
+	fromBySubtype := make(map[enrichment.Subtype][]enrichment.Enrichment)
+	for _, e := range from {
+		fromBySubtype[e.Subtype()] = append(fromBySubtype[e.Subtype()], e)
+	}
+	toBySubtype := make(map[enrichment.Subtype][]enrichment.Enrichment)
+	for _, e := range to {
+		toBySubtype[e.Subtype()] = append(toBySubtype[e.Subtype()], e)
+	}
+
+	subtypes := make(map[enrichment.Subtype]bool)
+	for subtype := range fromBySubtype {
+		subtypes[subtype] = true
+	}
+	for subtype := range toBySubtype {
+		subtypes[subtype] = true
+	}
+
+	var total DiffCounts
+	for subtype := range subtypes {
+		fromEntries, toEntries := fromBySubtype[subtype], toBySubtype[subtype]
+		if !singletonSubtypes[subtype] || len(fromEntries) > 1 || len(toEntries) > 1 {
+			total = addCounts(total, diffByContent(fromEntries, toEntries))
+			continue
+		}
+		switch {
+		case len(fromEntries) == 0:
+			total.Added++
+		case len(toEntries) == 0:
+			total.Removed++
+		case fromEntries[0].Content() != toEntries[0].Content():
+			total.Changed++
+		}
+	}
+	return total
+}
+
+// contentSet builds a set of enrichment content strings.
+func contentSet(entries []enrichment.Enrichment) map[string]bool {
+	set := make(map[string]bool, len(entries))
+	for _, e := range entries {
+		set[e.Content()] = true
+	}
+	return set
+}
+
+// diffSets reports how many keys were only in from (removed), only in to
+// (added), or in both (left unchanged, so not counted).
+func diffSets(from, to map[string]bool) DiffCounts {
+	var counts DiffCounts
+	for key := range to {
+		if !from[key] {
+			counts.Added++
+		}
+	}
+	for key := range from {
+		if !to[key] {
+			counts.Removed++
+		}
+	}
+	return counts
+}
+
+// addCounts sums two DiffCounts.
+func addCounts(a, b DiffCounts) DiffCounts {
+	return DiffCounts{
+		Added:   a.Added + b.Added,
+		Removed: a.Removed + b.Removed,
+		Changed: a.Changed + b.Changed,
+	}
+}
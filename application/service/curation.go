@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/helixml/kodit/domain/curation"
+	"github.com/helixml/kodit/domain/repository"
+	"github.com/helixml/kodit/domain/search"
+)
+
+// Curation provides CRUD operations over maintainer-authored ranking rules,
+// plus applying those rules to fused search results.
+type Curation struct {
+	store curation.Store
+}
+
+// NewCuration creates a new Curation service.
+func NewCuration(store curation.Store) *Curation {
+	return &Curation{store: store}
+}
+
+// Create adds a new curation rule.
+func (c *Curation) Create(ctx context.Context, pattern, snippetID string, action curation.Action, weight float64) (curation.Rule, error) {
+	saved, err := c.store.Save(ctx, curation.New(pattern, snippetID, action, weight))
+	if err != nil {
+		return curation.Rule{}, fmt.Errorf("save curation rule: %w", err)
+	}
+	return saved, nil
+}
+
+// Update replaces the action and weight of an existing curation rule.
+func (c *Curation) Update(ctx context.Context, id int64, action curation.Action, weight float64) (curation.Rule, error) {
+	existing, err := c.store.FindOne(ctx, repository.WithID(id))
+	if err != nil {
+		return curation.Rule{}, fmt.Errorf("find curation rule: %w", err)
+	}
+
+	saved, err := c.store.Save(ctx, existing.WithAction(action, weight))
+	if err != nil {
+		return curation.Rule{}, fmt.Errorf("save curation rule: %w", err)
+	}
+	return saved, nil
+}
+
+// Delete removes a curation rule by ID.
+func (c *Curation) Delete(ctx context.Context, id int64) error {
+	existing, err := c.store.FindOne(ctx, repository.WithID(id))
+	if err != nil {
+		return fmt.Errorf("find curation rule: %w", err)
+	}
+	if err := c.store.Delete(ctx, existing); err != nil {
+		return fmt.Errorf("delete curation rule: %w", err)
+	}
+	return nil
+}
+
+// List returns every curation rule.
+func (c *Curation) List(ctx context.Context) ([]curation.Rule, error) {
+	rules, err := c.store.Find(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("find curation rules: %w", err)
+	}
+	return rules, nil
+}
+
+// Get returns a single curation rule by ID.
+func (c *Curation) Get(ctx context.Context, id int64) (curation.Rule, error) {
+	rule, err := c.store.FindOne(ctx, repository.WithID(id))
+	if err != nil {
+		return curation.Rule{}, fmt.Errorf("find curation rule: %w", err)
+	}
+	return rule, nil
+}
+
+// Apply adjusts fused search results according to every curation rule
+// whose pattern matches query: boosted or buried snippets have their
+// fused score multiplied by the rule's weight, and pinned snippets are
+// moved ahead of every non-pinned result. Snippets with no matching rule
+// keep their relative order.
+func (c *Curation) Apply(ctx context.Context, query string, results []search.FusionResult) ([]search.FusionResult, error) {
+	if len(results) == 0 {
+		return results, nil
+	}
+
+	rules, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []curation.Rule
+	for _, rule := range rules {
+		if rule.Matches(query) {
+			matched = append(matched, rule)
+		}
+	}
+	if len(matched) == 0 {
+		return results, nil
+	}
+
+	pinned := make(map[string]bool)
+	adjusted := make([]search.FusionResult, len(results))
+	for i, result := range results {
+		score := result.Score()
+		for _, rule := range matched {
+			if rule.SnippetID() != result.ID() {
+				continue
+			}
+			switch rule.Action() {
+			case curation.ActionPin:
+				pinned[result.ID()] = true
+			case curation.ActionBoost, curation.ActionBury:
+				score *= rule.Weight()
+			}
+		}
+		adjusted[i] = search.NewFusionResult(result.ID(), score, result.OriginalScores())
+	}
+
+	sort.SliceStable(adjusted, func(i, j int) bool {
+		pi, pj := pinned[adjusted[i].ID()], pinned[adjusted[j].ID()]
+		if pi != pj {
+			return pi
+		}
+		if pi && pj {
+			return false
+		}
+		return adjusted[i].Score() > adjusted[j].Score()
+	})
+
+	return adjusted, nil
+}
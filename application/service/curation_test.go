@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/helixml/kodit/domain/curation"
+	"github.com/helixml/kodit/domain/repository"
+	"github.com/helixml/kodit/domain/search"
+	"github.com/helixml/kodit/internal/database"
+)
+
+// fakeCurationStore implements curation.Store for testing.
+// Genuine fake: the real store requires a database.
+type fakeCurationStore struct {
+	rules  []curation.Rule
+	nextID int64
+}
+
+func (f *fakeCurationStore) Find(_ context.Context, _ ...repository.Option) ([]curation.Rule, error) {
+	return append([]curation.Rule(nil), f.rules...), nil
+}
+
+func (f *fakeCurationStore) FindOne(_ context.Context, opts ...repository.Option) (curation.Rule, error) {
+	id := conditionValue(opts, "id")
+	for _, r := range f.rules {
+		if id == r.ID() {
+			return r, nil
+		}
+	}
+	return curation.Rule{}, database.ErrNotFound
+}
+
+func (f *fakeCurationStore) Count(_ context.Context, opts ...repository.Option) (int64, error) {
+	rules, err := f.Find(context.Background(), opts...)
+	return int64(len(rules)), err
+}
+
+func (f *fakeCurationStore) Save(_ context.Context, entity curation.Rule) (curation.Rule, error) {
+	if entity.ID() == 0 {
+		f.nextID++
+		entity = curation.Reconstruct(f.nextID, entity.Pattern(), entity.SnippetID(), entity.Action(), entity.Weight(), entity.CreatedAt(), entity.UpdatedAt())
+		f.rules = append(f.rules, entity)
+		return entity, nil
+	}
+	for i, r := range f.rules {
+		if r.ID() == entity.ID() {
+			f.rules[i] = entity
+			return entity, nil
+		}
+	}
+	return curation.Rule{}, database.ErrNotFound
+}
+
+func (f *fakeCurationStore) Delete(_ context.Context, entity curation.Rule) error {
+	for i, r := range f.rules {
+		if r.ID() == entity.ID() {
+			f.rules = append(f.rules[:i], f.rules[i+1:]...)
+			return nil
+		}
+	}
+	return database.ErrNotFound
+}
+
+func (f *fakeCurationStore) DeleteBy(_ context.Context, _ ...repository.Option) error {
+	return nil
+}
+
+func TestCuration_Create(t *testing.T) {
+	store := &fakeCurationStore{}
+	svc := NewCuration(store)
+
+	saved, err := svc.Create(context.Background(), "http client", "42", curation.ActionPin, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if saved.ID() == 0 {
+		t.Error("expected a non-zero ID after save")
+	}
+}
+
+func TestCuration_Update(t *testing.T) {
+	store := &fakeCurationStore{}
+	svc := NewCuration(store)
+
+	saved, err := svc.Create(context.Background(), "http client", "42", curation.ActionPin, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	updated, err := svc.Update(context.Background(), saved.ID(), curation.ActionBoost, 2.0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated.Action() != curation.ActionBoost {
+		t.Errorf("expected action %q, got %q", curation.ActionBoost, updated.Action())
+	}
+}
+
+func TestCuration_Delete(t *testing.T) {
+	store := &fakeCurationStore{}
+	svc := NewCuration(store)
+
+	saved, err := svc.Create(context.Background(), "http client", "42", curation.ActionPin, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := svc.Delete(context.Background(), saved.ID()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := svc.Get(context.Background(), saved.ID()); err == nil {
+		t.Error("expected error getting deleted curation rule")
+	}
+}
+
+func TestCuration_Apply_Pin(t *testing.T) {
+	store := &fakeCurationStore{}
+	svc := NewCuration(store)
+
+	if _, err := svc.Create(context.Background(), "http client", "3", curation.ActionPin, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	results := []search.FusionResult{
+		search.NewFusionResult("1", 0.9, nil),
+		search.NewFusionResult("2", 0.5, nil),
+		search.NewFusionResult("3", 0.1, nil),
+	}
+
+	adjusted, err := svc.Apply(context.Background(), "how do I use the http client", results)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if adjusted[0].ID() != "3" {
+		t.Errorf("expected pinned snippet %q first, got %q", "3", adjusted[0].ID())
+	}
+}
+
+func TestCuration_Apply_Boost(t *testing.T) {
+	store := &fakeCurationStore{}
+	svc := NewCuration(store)
+
+	if _, err := svc.Create(context.Background(), "http client", "2", curation.ActionBoost, 10.0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	results := []search.FusionResult{
+		search.NewFusionResult("1", 0.9, nil),
+		search.NewFusionResult("2", 0.5, nil),
+	}
+
+	adjusted, err := svc.Apply(context.Background(), "how do I use the http client", results)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if adjusted[0].ID() != "2" {
+		t.Errorf("expected boosted snippet %q first, got %q", "2", adjusted[0].ID())
+	}
+}
+
+func TestCuration_Apply_NoMatch(t *testing.T) {
+	store := &fakeCurationStore{}
+	svc := NewCuration(store)
+
+	if _, err := svc.Create(context.Background(), "http client", "2", curation.ActionBoost, 10.0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	results := []search.FusionResult{
+		search.NewFusionResult("1", 0.9, nil),
+		search.NewFusionResult("2", 0.5, nil),
+	}
+
+	adjusted, err := svc.Apply(context.Background(), "how do I format a date", results)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if adjusted[0].ID() != "1" || adjusted[1].ID() != "2" {
+		t.Error("expected results unchanged when no rule matches")
+	}
+}
@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path"
+
+	"github.com/rs/zerolog"
+
+	"github.com/helixml/kodit/domain/discovery"
+	"github.com/helixml/kodit/domain/repository"
+)
+
+// RepositoryEvent describes an inbound "repository created" notification
+// from a Git hosting webhook.
+type RepositoryEvent struct {
+	Org       string
+	Name      string
+	RemoteURL string
+	Topics    []string
+	Language  string
+}
+
+// Discovery evaluates inbound Git hosting webhook events against configured
+// name patterns, either registering matching repositories immediately or
+// queuing them as candidates in a review queue.
+type Discovery struct {
+	store         discovery.Store
+	repositories  *Repository
+	webhookSecret string
+	namePatterns  []string
+	autoRegister  bool
+	logger        zerolog.Logger
+}
+
+// NewDiscovery creates a new Discovery service.
+func NewDiscovery(
+	store discovery.Store,
+	repositories *Repository,
+	webhookSecret string,
+	namePatterns []string,
+	autoRegister bool,
+	logger zerolog.Logger,
+) *Discovery {
+	return &Discovery{
+		store:         store,
+		repositories:  repositories,
+		webhookSecret: webhookSecret,
+		namePatterns:  namePatterns,
+		autoRegister:  autoRegister,
+		logger:        logger,
+	}
+}
+
+// VerifySignature checks payload's HMAC-SHA256 signature (as sent in a
+// GitHub-style "sha256=<hex>" header) against the configured webhook secret.
+func (d *Discovery) VerifySignature(payload []byte, signature string) error {
+	if d.webhookSecret == "" {
+		return fmt.Errorf("discovery webhook secret not configured")
+	}
+
+	mac := hmac.New(sha256.New, []byte(d.webhookSecret))
+	mac.Write(payload)
+	expected := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return fmt.Errorf("invalid webhook signature")
+	}
+	return nil
+}
+
+// HandleEvent evaluates a repository event against the configured name
+// patterns. A match is either registered immediately (AutoRegister) or
+// persisted as a pending discovery.Candidate for manual review. Events that
+// match no pattern are ignored and returned as a zero Candidate.
+func (d *Discovery) HandleEvent(ctx context.Context, event RepositoryEvent) (discovery.Candidate, error) {
+	matched, pattern := d.matchName(event.Org, event.Name)
+	if !matched {
+		return discovery.Candidate{}, nil
+	}
+
+	if d.autoRegister {
+		if _, _, err := d.repositories.Add(ctx, &RepositoryAddParams{URL: event.RemoteURL}); err != nil {
+			return discovery.Candidate{}, fmt.Errorf("auto-register repository: %w", err)
+		}
+		d.logger.Info().Str("org", event.Org).Str("name", event.Name).Str("pattern", pattern).Msg("repository auto-registered from discovery webhook")
+		return discovery.Candidate{}, nil
+	}
+
+	candidate := discovery.New(event.Org, event.Name, event.RemoteURL, event.Topics, event.Language, pattern)
+	saved, err := d.store.Save(ctx, candidate)
+	if err != nil {
+		return discovery.Candidate{}, fmt.Errorf("save discovery candidate: %w", err)
+	}
+
+	d.logger.Info().Str("org", event.Org).Str("name", event.Name).Str("pattern", pattern).Msg("repository queued for discovery review")
+	return saved, nil
+}
+
+// matchName reports whether org/name matches one of the configured
+// NamePatterns, tried against both "org/name" and "name" alone.
+func (d *Discovery) matchName(org, name string) (bool, string) {
+	full := org + "/" + name
+	for _, pattern := range d.namePatterns {
+		if ok, _ := path.Match(pattern, full); ok {
+			return true, pattern
+		}
+		if ok, _ := path.Match(pattern, name); ok {
+			return true, pattern
+		}
+	}
+	return false, ""
+}
+
+// ReviewQueue returns every candidate awaiting manual review.
+func (d *Discovery) ReviewQueue(ctx context.Context) ([]discovery.Candidate, error) {
+	candidates, err := d.store.Find(ctx, discovery.WithStatus(discovery.StatusPending))
+	if err != nil {
+		return nil, fmt.Errorf("find discovery candidates: %w", err)
+	}
+	return candidates, nil
+}
+
+// Approve registers a pending candidate as a tracked repository and marks
+// it approved.
+func (d *Discovery) Approve(ctx context.Context, id int64) (repository.Source, error) {
+	candidate, err := d.store.FindOne(ctx, repository.WithID(id))
+	if err != nil {
+		return repository.Source{}, fmt.Errorf("find discovery candidate: %w", err)
+	}
+
+	source, _, err := d.repositories.Add(ctx, &RepositoryAddParams{URL: candidate.RemoteURL()})
+	if err != nil {
+		return repository.Source{}, fmt.Errorf("register repository: %w", err)
+	}
+
+	if _, err := d.store.Save(ctx, candidate.WithStatus(discovery.StatusApproved)); err != nil {
+		return repository.Source{}, fmt.Errorf("save discovery candidate: %w", err)
+	}
+
+	return source, nil
+}
+
+// Exclude marks a pending candidate as excluded, without registering it.
+func (d *Discovery) Exclude(ctx context.Context, id int64) error {
+	candidate, err := d.store.FindOne(ctx, repository.WithID(id))
+	if err != nil {
+		return fmt.Errorf("find discovery candidate: %w", err)
+	}
+
+	if _, err := d.store.Save(ctx, candidate.WithStatus(discovery.StatusExcluded)); err != nil {
+		return fmt.Errorf("save discovery candidate: %w", err)
+	}
+
+	return nil
+}
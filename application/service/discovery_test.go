@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/helixml/kodit/domain/discovery"
+)
+
+func newDiscoveryTestDeps(t *testing.T, secret string, patterns []string, autoRegister bool) (*Discovery, repositoryTestDeps) {
+	t.Helper()
+	repoDeps := newRepositoryTestDeps(t)
+	svc := NewDiscovery(repoDeps.stores.discovery, repoDeps.service, secret, patterns, autoRegister, zerolog.Nop())
+	return svc, repoDeps
+}
+
+func sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestDiscovery_VerifySignature(t *testing.T) {
+	svc, _ := newDiscoveryTestDeps(t, "s3cr3t", nil, false)
+	body := []byte(`{"action":"created"}`)
+
+	assert.NoError(t, svc.VerifySignature(body, sign("s3cr3t", body)))
+	assert.Error(t, svc.VerifySignature(body, sign("wrong", body)))
+}
+
+func TestDiscovery_VerifySignature_NoSecretConfigured(t *testing.T) {
+	svc, _ := newDiscoveryTestDeps(t, "", nil, false)
+
+	err := svc.VerifySignature([]byte("body"), "sha256=anything")
+	require.Error(t, err)
+}
+
+func TestDiscovery_HandleEvent_NoMatchIgnored(t *testing.T) {
+	svc, deps := newDiscoveryTestDeps(t, "s3cr3t", []string{"acme/*"}, false)
+	ctx := context.Background()
+
+	candidate, err := svc.HandleEvent(ctx, RepositoryEvent{Org: "other", Name: "widget", RemoteURL: "https://example.com/other/widget.git"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), candidate.ID())
+	assert.Empty(t, candidate.Org())
+
+	queue, err := deps.stores.discovery.Find(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, queue)
+}
+
+func TestDiscovery_HandleEvent_MatchQueuesCandidate(t *testing.T) {
+	svc, deps := newDiscoveryTestDeps(t, "s3cr3t", []string{"acme/*"}, false)
+	ctx := context.Background()
+
+	candidate, err := svc.HandleEvent(ctx, RepositoryEvent{
+		Org:       "acme",
+		Name:      "widget",
+		RemoteURL: "https://example.com/acme/widget.git",
+		Topics:    []string{"go"},
+		Language:  "Go",
+	})
+	require.NoError(t, err)
+	assert.NotZero(t, candidate.ID())
+	assert.Equal(t, discovery.StatusPending, candidate.Status())
+	assert.Equal(t, "acme/*", candidate.MatchedPattern())
+
+	queue, err := deps.stores.discovery.Find(ctx)
+	require.NoError(t, err)
+	assert.Len(t, queue, 1)
+}
+
+func TestDiscovery_HandleEvent_AutoRegister(t *testing.T) {
+	svc, deps := newDiscoveryTestDeps(t, "s3cr3t", []string{"acme/*"}, true)
+	ctx := context.Background()
+
+	candidate, err := svc.HandleEvent(ctx, RepositoryEvent{Org: "acme", Name: "widget", RemoteURL: "https://example.com/acme/widget.git"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), candidate.ID())
+
+	queue, err := deps.stores.discovery.Find(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, queue, "auto-registered events should not be queued for review")
+
+	repos, err := deps.stores.repos.Find(ctx)
+	require.NoError(t, err)
+	assert.Len(t, repos, 1)
+}
+
+func TestDiscovery_Approve(t *testing.T) {
+	svc, deps := newDiscoveryTestDeps(t, "s3cr3t", []string{"acme/*"}, false)
+	ctx := context.Background()
+
+	candidate, err := svc.HandleEvent(ctx, RepositoryEvent{Org: "acme", Name: "widget", RemoteURL: "https://example.com/acme/widget.git"})
+	require.NoError(t, err)
+
+	source, err := svc.Approve(ctx, candidate.ID())
+	require.NoError(t, err)
+	assert.NotZero(t, source.ID())
+
+	repos, err := deps.stores.repos.Find(ctx)
+	require.NoError(t, err)
+	assert.Len(t, repos, 1)
+
+	updated, err := deps.stores.discovery.FindOne(ctx, discovery.WithStatus(discovery.StatusApproved))
+	require.NoError(t, err)
+	assert.Equal(t, candidate.ID(), updated.ID())
+}
+
+func TestDiscovery_Exclude(t *testing.T) {
+	svc, deps := newDiscoveryTestDeps(t, "s3cr3t", []string{"acme/*"}, false)
+	ctx := context.Background()
+
+	candidate, err := svc.HandleEvent(ctx, RepositoryEvent{Org: "acme", Name: "widget", RemoteURL: "https://example.com/acme/widget.git"})
+	require.NoError(t, err)
+
+	require.NoError(t, svc.Exclude(ctx, candidate.ID()))
+
+	pending, err := svc.ReviewQueue(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+
+	repos, err := deps.stores.repos.Find(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, repos)
+}
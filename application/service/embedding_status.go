@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/helixml/kodit/domain/enrichment"
+	"github.com/helixml/kodit/domain/repository"
+	"github.com/helixml/kodit/domain/search"
+	"github.com/helixml/kodit/domain/task"
+)
+
+// EmbeddingCounts summarizes the embedding status of a repository's
+// snippets for one embedding task (code, text, or vision).
+//
+// Pending snippets have no status row — they haven't been attempted yet —
+// so deriving a pending count would require scanning the full corpus. This
+// type only reports outcomes that have actually been recorded.
+type EmbeddingCounts struct {
+	Embedded int64
+	Failed   int64
+}
+
+// EmbeddingStatus reports per-repository embedding status counts and
+// re-enqueues embeddings that previously failed.
+type EmbeddingStatus struct {
+	statusStore      search.EmbeddingStatusStore
+	associationStore enrichment.AssociationStore
+	queue            *Queue
+}
+
+// NewEmbeddingStatus creates a new EmbeddingStatus service.
+func NewEmbeddingStatus(statusStore search.EmbeddingStatusStore, associationStore enrichment.AssociationStore, queue *Queue) *EmbeddingStatus {
+	return &EmbeddingStatus{statusStore: statusStore, associationStore: associationStore, queue: queue}
+}
+
+// Counts returns the embedded and failed row counts for a repository's
+// snippets for the given embedding task.
+func (s *EmbeddingStatus) Counts(ctx context.Context, repoID int64, taskName search.TaskName) (EmbeddingCounts, error) {
+	embedded, err := s.statusStore.Count(ctx, repoScopedStatusOptions(repoID, taskName, search.EmbeddingStatusEmbedded)...)
+	if err != nil {
+		return EmbeddingCounts{}, fmt.Errorf("count embedded statuses: %w", err)
+	}
+	failed, err := s.statusStore.Count(ctx, repoScopedStatusOptions(repoID, taskName, search.EmbeddingStatusFailed)...)
+	if err != nil {
+		return EmbeddingCounts{}, fmt.Errorf("count failed statuses: %w", err)
+	}
+	return EmbeddingCounts{Embedded: embedded, Failed: failed}, nil
+}
+
+// RetryFailed re-enqueues operation for every commit that owns a snippet
+// with a failed embedding status for the given task. Handlers re-embed the
+// whole commit and skip snippets that already succeeded, so this only
+// needs to find which commits had a failure, not which individual
+// snippets failed.
+func (s *EmbeddingStatus) RetryFailed(ctx context.Context, taskName search.TaskName, operation task.Operation) (int, error) {
+	shas, err := s.failedCommitSHAs(ctx, taskName)
+	if err != nil {
+		return 0, fmt.Errorf("find failed commits: %w", err)
+	}
+
+	requeued := 0
+	for _, sha := range shas {
+		payload := map[string]any{"commit_sha": sha}
+		if err := s.queue.EnqueueOperations(ctx, []task.Operation{operation}, task.PriorityBackground, payload); err != nil {
+			return requeued, fmt.Errorf("enqueue retry for commit %s: %w", sha, err)
+		}
+		requeued++
+	}
+	return requeued, nil
+}
+
+// failedCommitSHAs returns the distinct commit SHAs owning a snippet with a
+// failed embedding status for the given task. snippet_id for code/summary
+// embeddings is the enrichment ID, so the owning commit is resolved via
+// the enrichment's commit association.
+func (s *EmbeddingStatus) failedCommitSHAs(ctx context.Context, taskName search.TaskName) ([]string, error) {
+	statuses, err := s.statusStore.Find(ctx, search.WithTaskName(taskName), search.WithState(search.EmbeddingStatusFailed))
+	if err != nil {
+		return nil, fmt.Errorf("find failed statuses: %w", err)
+	}
+
+	seen := make(map[string]struct{}, len(statuses))
+	shas := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		enrichmentID, err := parseSnippetID(status.SnippetID())
+		if err != nil {
+			continue
+		}
+		associations, err := s.associationStore.Find(ctx, enrichment.WithEnrichmentID(enrichmentID), enrichment.WithEntityType(enrichment.EntityTypeCommit))
+		if err != nil {
+			return nil, fmt.Errorf("find commit association for snippet %s: %w", status.SnippetID(), err)
+		}
+		for _, assoc := range associations {
+			if _, ok := seen[assoc.EntityID()]; ok {
+				continue
+			}
+			seen[assoc.EntityID()] = struct{}{}
+			shas = append(shas, assoc.EntityID())
+		}
+	}
+	return shas, nil
+}
+
+// parseSnippetID parses a snippet ID as the enrichment ID it represents for
+// code and summary embeddings.
+func parseSnippetID(snippetID string) (int64, error) {
+	return strconv.ParseInt(snippetID, 10, 64)
+}
+
+// repoScopedStatusOptions scopes an embedding_statuses query to a
+// repository by joining through enrichment_associations (snippet_id is an
+// enrichment ID) to the owning commit. CAST ... AS TEXT is portable across
+// PostgreSQL and SQLite, avoiding the dialect-specific integer cast used
+// elsewhere for the reverse direction.
+func repoScopedStatusOptions(repoID int64, taskName search.TaskName, state search.EmbeddingStatusState) []repository.Option {
+	return []repository.Option{
+		repository.WithJoin(
+			"JOIN enrichment_associations ea_embstatus ON ea_embstatus.entity_type = 'git_commits' AND CAST(ea_embstatus.enrichment_id AS TEXT) = embedding_statuses.snippet_id"),
+		repository.WithJoin("JOIN git_commits gc_embstatus ON gc_embstatus.commit_sha = ea_embstatus.entity_id"),
+		repository.WithWhere("gc_embstatus.repo_id = ?", repoID),
+		search.WithTaskName(taskName),
+		search.WithState(state),
+	}
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"path"
 	"strconv"
 
 	"github.com/helixml/kodit/domain/enrichment"
@@ -12,13 +13,22 @@ import (
 )
 
 // EnrichmentListParams configures enrichment listing.
+//
+// Pagination defaults to limit/offset. Setting Cursor switches to keyset
+// pagination instead: results are ordered by id and restricted to
+// id > AfterID, which stays correct as the table grows or is written to
+// concurrently, unlike a growing offset.
 type EnrichmentListParams struct {
 	Type       *enrichment.Type
 	Subtype    *enrichment.Subtype
 	CommitSHA  string
 	CommitSHAs []string
+	Language   string
+	Query      string
 	Limit      int
 	Offset     int
+	Cursor     bool
+	AfterID    int64
 }
 
 // Enrichment provides queries for enrichments and their associations.
@@ -89,6 +99,12 @@ func (s *Enrichment) filterOptions(params *EnrichmentListParams) []repository.Op
 	if params.Subtype != nil {
 		opts = append(opts, enrichment.WithSubtype(*params.Subtype))
 	}
+	if params.Language != "" {
+		opts = append(opts, enrichment.WithLanguage(params.Language))
+	}
+	if params.Query != "" {
+		opts = append(opts, enrichment.WithContentLike(params.Query))
+	}
 	return opts
 }
 
@@ -103,6 +119,16 @@ func (s *Enrichment) commitOptions(params *EnrichmentListParams) []repository.Op
 }
 
 func (s *Enrichment) paginationOptions(params *EnrichmentListParams) []repository.Option {
+	if params.Cursor {
+		opts := []repository.Option{repository.WithOrderAsc("id")}
+		if params.Limit > 0 {
+			opts = append(opts, repository.WithLimit(params.Limit))
+		}
+		if params.AfterID > 0 {
+			opts = append(opts, repository.WithWhere("id > ?", params.AfterID))
+		}
+		return opts
+	}
 	if params.Limit > 0 {
 		return repository.WithPagination(params.Limit, params.Offset)
 	}
@@ -155,6 +181,231 @@ func (s *Enrichment) DeleteBy(ctx context.Context, opts ...repository.Option) er
 	return s.enrichmentStore.DeleteBy(ctx, opts...)
 }
 
+// CompactionStats summarizes a Compact pass across all search stores.
+type CompactionStats struct {
+	OrphansRemoved int
+	StoresVacuumed int
+	ReclaimedBytes int64
+}
+
+// Compact removes vector and BM25 rows that no longer correspond to a live
+// enrichment, then vacuums any store that supports it. Orphans accumulate
+// when a store falls out of sync with the enrichment table — e.g. a
+// dimension change that rebuilds an embedding table, or a delete that raced
+// with an in-flight index. DeleteBy keeps the common path consistent;
+// Compact is the periodic sweep that catches everything else.
+func (s *Enrichment) Compact(ctx context.Context) (CompactionStats, error) {
+	live, err := s.enrichmentStore.Find(ctx)
+	if err != nil {
+		return CompactionStats{}, fmt.Errorf("find live enrichments: %w", err)
+	}
+	liveIDs := make(map[string]struct{}, len(live))
+	for _, e := range live {
+		liveIDs[strconv.FormatInt(e.ID(), 10)] = struct{}{}
+	}
+
+	var stats CompactionStats
+	for _, store := range []search.Store{s.bm25Store, s.codeEmbeddingStore, s.textEmbeddingStore, s.visionEmbeddingStore} {
+		if store == nil {
+			continue
+		}
+
+		removed, err := compactStore(ctx, store, liveIDs)
+		if err != nil {
+			return stats, err
+		}
+		stats.OrphansRemoved += removed
+
+		vacuumer, ok := store.(search.Vacuumer)
+		if !ok {
+			continue
+		}
+		vacuumStats, err := vacuumer.Vacuum(ctx)
+		if err != nil {
+			return stats, fmt.Errorf("vacuum store: %w", err)
+		}
+		stats.StoresVacuumed++
+		stats.ReclaimedBytes += vacuumStats.ReclaimedBytes
+	}
+
+	return stats, nil
+}
+
+// TruncationStats summarizes a TruncateVectors pass across all search stores.
+type TruncationStats struct {
+	VectorsTruncated int
+	StoresSkipped    int
+}
+
+// TruncateVectors shrinks every stored vector to its first dimensions
+// entries in place, re-normalizing as it goes. This is the cheap
+// alternative to a full RescanAll after lowering the configured embedding
+// dimension: it rewrites already-computed vectors instead of paying to
+// re-embed the corpus through the provider. Stores that don't implement
+// search.VectorTruncator (fixed-width vector columns can't shrink in place)
+// are left untouched and counted as skipped.
+func (s *Enrichment) TruncateVectors(ctx context.Context, dimensions int) (TruncationStats, error) {
+	var stats TruncationStats
+	for _, store := range []search.Store{s.codeEmbeddingStore, s.textEmbeddingStore, s.visionEmbeddingStore} {
+		if store == nil {
+			continue
+		}
+
+		truncator, ok := store.(search.VectorTruncator)
+		if !ok {
+			stats.StoresSkipped++
+			continue
+		}
+
+		truncated, err := truncator.TruncateVectors(ctx, dimensions)
+		if err != nil {
+			return stats, fmt.Errorf("truncate vectors: %w", err)
+		}
+		stats.VectorsTruncated += truncated
+	}
+	return stats, nil
+}
+
+// compactStore removes rows from store whose snippet_id isn't in liveIDs.
+// Stores that don't implement SnippetLister can't be enumerated and are left
+// untouched — most notably Qdrant, whose Scroll API isn't wired up here.
+func compactStore(ctx context.Context, store search.Store, liveIDs map[string]struct{}) (int, error) {
+	lister, ok := store.(search.SnippetLister)
+	if !ok {
+		return 0, nil
+	}
+
+	ids, err := lister.SnippetIDs(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("list snippet ids: %w", err)
+	}
+
+	var orphans []string
+	for _, id := range ids {
+		if _, ok := liveIDs[id]; !ok {
+			orphans = append(orphans, id)
+		}
+	}
+	if len(orphans) == 0 {
+		return 0, nil
+	}
+
+	if err := store.DeleteBy(ctx, search.WithSnippetIDs(orphans)); err != nil {
+		return 0, fmt.Errorf("delete orphan vectors: %w", err)
+	}
+	return len(orphans), nil
+}
+
+// Override stores content as a human-edited override of the enrichment
+// identified by id. The original is left untouched so ResetOverride can
+// restore it and regeneration logic, which only runs when no enrichment of
+// that type/subtype exists yet, is unaffected. Any existing override is
+// replaced.
+func (s *Enrichment) Override(ctx context.Context, id int64, content string) (enrichment.Enrichment, error) {
+	original, err := s.enrichmentStore.FindOne(ctx, repository.WithID(id))
+	if err != nil {
+		return enrichment.Enrichment{}, fmt.Errorf("find enrichment %d: %w", id, err)
+	}
+
+	if err := s.ResetOverride(ctx, id); err != nil {
+		return enrichment.Enrichment{}, err
+	}
+
+	override := enrichment.NewEnrichment(original.Type(), original.Subtype(), original.EntityTypeKey(), content)
+	saved, err := s.enrichmentStore.Save(ctx, override)
+	if err != nil {
+		return enrichment.Enrichment{}, fmt.Errorf("save override: %w", err)
+	}
+
+	if _, err := s.associationStore.Save(ctx, enrichment.OverrideAssociation(saved.ID(), id)); err != nil {
+		return enrichment.Enrichment{}, fmt.Errorf("save override association: %w", err)
+	}
+
+	return saved, nil
+}
+
+// ResetOverride removes any human override of the enrichment identified by
+// id, so read APIs fall back to the original AI-generated content and
+// regeneration can replace it.
+func (s *Enrichment) ResetOverride(ctx context.Context, id int64) error {
+	associations, err := s.associationStore.Find(ctx,
+		enrichment.WithEntityID(strconv.FormatInt(id, 10)),
+		enrichment.WithEntityType(enrichment.EntityTypeOverrideSource),
+	)
+	if err != nil {
+		return fmt.Errorf("find override associations: %w", err)
+	}
+
+	for _, a := range associations {
+		if err := s.enrichmentStore.DeleteBy(ctx, repository.WithID(a.EnrichmentID())); err != nil {
+			return fmt.Errorf("delete override %d: %w", a.EnrichmentID(), err)
+		}
+		if err := s.associationStore.DeleteBy(ctx, repository.WithID(a.ID())); err != nil {
+			return fmt.Errorf("delete override association %d: %w", a.ID(), err)
+		}
+	}
+
+	return nil
+}
+
+// ResolveOverrides replaces the content of each enrichment that has a human
+// override with the override's content, so read APIs always return the
+// latest edited version under the original enrichment's ID.
+func (s *Enrichment) ResolveOverrides(ctx context.Context, enrichments []enrichment.Enrichment) ([]enrichment.Enrichment, error) {
+	if len(enrichments) == 0 {
+		return enrichments, nil
+	}
+
+	entityIDs := make([]string, len(enrichments))
+	for i, e := range enrichments {
+		entityIDs[i] = strconv.FormatInt(e.ID(), 10)
+	}
+
+	associations, err := s.associationStore.Find(ctx,
+		enrichment.WithEntityIDIn(entityIDs),
+		enrichment.WithEntityType(enrichment.EntityTypeOverrideSource),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("find override associations: %w", err)
+	}
+	if len(associations) == 0 {
+		return enrichments, nil
+	}
+
+	overrideIDByOriginal := make(map[string]int64, len(associations))
+	overrideIDs := make([]int64, 0, len(associations))
+	for _, a := range associations {
+		overrideIDByOriginal[a.EntityID()] = a.EnrichmentID()
+		overrideIDs = append(overrideIDs, a.EnrichmentID())
+	}
+
+	overrides, err := s.enrichmentStore.Find(ctx, repository.WithIDIn(overrideIDs))
+	if err != nil {
+		return nil, fmt.Errorf("find override enrichments: %w", err)
+	}
+	contentByOverrideID := make(map[int64]string, len(overrides))
+	for _, o := range overrides {
+		contentByOverrideID[o.ID()] = o.Content()
+	}
+
+	resolved := make([]enrichment.Enrichment, len(enrichments))
+	for i, e := range enrichments {
+		overrideID, ok := overrideIDByOriginal[strconv.FormatInt(e.ID(), 10)]
+		if !ok {
+			resolved[i] = e
+			continue
+		}
+		content, ok := contentByOverrideID[overrideID]
+		if !ok {
+			resolved[i] = e
+			continue
+		}
+		resolved[i] = e.WithContent(content)
+	}
+
+	return resolved, nil
+}
+
 // RelatedEnrichments returns enrichments that reference the given enrichment IDs
 // through the association store (e.g., snippet_summary enrichments pointing to snippet enrichments).
 // Returns a map of parent enrichment ID (as string) to its related enrichments.
@@ -262,6 +513,111 @@ func (s *Enrichment) SourceLocations(ctx context.Context, enrichmentIDs []int64)
 	return result, nil
 }
 
+// PathSummary pairs a file or directory path with its summary content.
+type PathSummary struct {
+	Path    string
+	Content string
+}
+
+// ExplainPath returns the summary chain for a file: the directory summary
+// for each ancestor directory (root first), followed by the file's own
+// summary. Ancestors or the file itself with no summary yet are omitted
+// rather than erroring, since summaries are generated asynchronously.
+func (s *Enrichment) ExplainPath(ctx context.Context, commitSHA string, fileID int64, filePath string) ([]PathSummary, error) {
+	var chain []PathSummary
+
+	for _, dir := range ancestorDirs(filePath) {
+		content, err := s.directorySummary(ctx, commitSHA, dir)
+		if err != nil {
+			return nil, err
+		}
+		if content != "" {
+			chain = append(chain, PathSummary{Path: dir, Content: content})
+		}
+	}
+
+	content, err := s.fileSummary(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+	if content != "" {
+		chain = append(chain, PathSummary{Path: filePath, Content: content})
+	}
+
+	return chain, nil
+}
+
+// directorySummary returns the directory summary content for dir within
+// commitSHA, or "" if none exists yet.
+func (s *Enrichment) directorySummary(ctx context.Context, commitSHA, dir string) (string, error) {
+	assocs, err := s.associationStore.Find(ctx,
+		enrichment.WithEntityType(enrichment.EntityTypeDirectory),
+		enrichment.WithEntityID(enrichment.DirectoryKey(commitSHA, dir)),
+	)
+	if err != nil {
+		return "", fmt.Errorf("find directory association: %w", err)
+	}
+	if len(assocs) == 0 {
+		return "", nil
+	}
+
+	summary, err := s.enrichmentStore.FindOne(ctx, repository.WithID(assocs[0].EnrichmentID()))
+	if err != nil {
+		return "", fmt.Errorf("find directory summary: %w", err)
+	}
+	return summary.Content(), nil
+}
+
+// fileSummary returns the file summary content for fileID, or "" if none exists yet.
+func (s *Enrichment) fileSummary(ctx context.Context, fileID int64) (string, error) {
+	assocs, err := s.associationStore.Find(ctx,
+		enrichment.WithEntityType(enrichment.EntityTypeFile),
+		enrichment.WithEntityID(strconv.FormatInt(fileID, 10)),
+	)
+	if err != nil {
+		return "", fmt.Errorf("find file association: %w", err)
+	}
+	if len(assocs) == 0 {
+		return "", nil
+	}
+
+	ids := make([]int64, 0, len(assocs))
+	for _, a := range assocs {
+		ids = append(ids, a.EnrichmentID())
+	}
+
+	summaries, err := s.enrichmentStore.Find(ctx, repository.WithIDIn(ids), enrichment.WithSubtype(enrichment.SubtypeFileSummary))
+	if err != nil {
+		return "", fmt.Errorf("find file summary: %w", err)
+	}
+	if len(summaries) == 0 {
+		return "", nil
+	}
+	return summaries[0].Content(), nil
+}
+
+// ancestorDirs returns the ancestor directories of filePath, from the
+// repository root ("." ) down to the file's immediate parent.
+func ancestorDirs(filePath string) []string {
+	dir := path.Dir(filePath)
+	var dirs []string
+	for {
+		dirs = append(dirs, dir)
+		if dir == "." || dir == "/" {
+			break
+		}
+		parent := path.Dir(dir)
+		if parent == dir {
+			break
+		}
+		dir = parent
+	}
+	for i, j := 0, len(dirs)-1; i < j; i, j = i+1, j-1 {
+		dirs[i], dirs[j] = dirs[j], dirs[i]
+	}
+	return dirs
+}
+
 // RepositoryIDs returns repository IDs keyed by enrichment ID string.
 // It queries associations where enrichment_id IN (ids) and entity_type = "git_repos".
 func (s *Enrichment) RepositoryIDs(ctx context.Context, enrichmentIDs []int64) (map[string]int64, error) {
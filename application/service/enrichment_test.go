@@ -263,6 +263,113 @@ func TestEnrichment_DeleteBy_NilStores(t *testing.T) {
 	}
 }
 
+type recordingCompactableStore struct {
+	recordingEmbeddingStore
+	ids          []string
+	vacuumCalled bool
+	vacuumStats  search.VacuumStats
+}
+
+func (r *recordingCompactableStore) SnippetIDs(_ context.Context) ([]string, error) {
+	return r.ids, nil
+}
+
+func (r *recordingCompactableStore) Vacuum(_ context.Context) (search.VacuumStats, error) {
+	r.vacuumCalled = true
+	return r.vacuumStats, nil
+}
+
+func TestEnrichment_Compact_RemovesOrphansAndVacuums(t *testing.T) {
+	stores := newTestStores(t)
+	ctx := context.Background()
+
+	live := enrichment.NewEnrichment(enrichment.TypeDevelopment, enrichment.SubtypeSnippet, enrichment.EntityTypeSnippet, "code1")
+	saved, err := stores.enrichments.Save(ctx, live)
+	if err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	liveID := strconv.FormatInt(saved.ID(), 10)
+
+	codeEmb := &recordingCompactableStore{
+		ids:         []string{liveID, "orphan-1"},
+		vacuumStats: search.VacuumStats{ReclaimedBytes: 4096},
+	}
+
+	svc := NewEnrichment(stores.enrichments, nil, nil, codeEmb, nil, &recordingCompactableStore{}, nil)
+	stats, err := svc.Compact(ctx)
+	if err != nil {
+		t.Fatalf("Compact: %v", err)
+	}
+
+	if !codeEmb.deleteCalled {
+		t.Error("expected codeEmbeddingStore.DeleteBy to be called for the orphan")
+	}
+	if !codeEmb.vacuumCalled {
+		t.Error("expected codeEmbeddingStore.Vacuum to be called")
+	}
+	if stats.OrphansRemoved != 1 {
+		t.Errorf("OrphansRemoved = %d, want 1", stats.OrphansRemoved)
+	}
+	if stats.StoresVacuumed != 2 {
+		t.Errorf("StoresVacuumed = %d, want 2", stats.StoresVacuumed)
+	}
+	if stats.ReclaimedBytes != 4096 {
+		t.Errorf("ReclaimedBytes = %d, want 4096", stats.ReclaimedBytes)
+	}
+}
+
+func TestEnrichment_Compact_NoOrphans(t *testing.T) {
+	stores := newTestStores(t)
+	ctx := context.Background()
+
+	live := enrichment.NewEnrichment(enrichment.TypeDevelopment, enrichment.SubtypeSnippet, enrichment.EntityTypeSnippet, "code1")
+	saved, err := stores.enrichments.Save(ctx, live)
+	if err != nil {
+		t.Fatalf("save: %v", err)
+	}
+
+	codeEmb := &recordingCompactableStore{ids: []string{strconv.FormatInt(saved.ID(), 10)}}
+	svc := NewEnrichment(stores.enrichments, nil, nil, codeEmb, nil, &recordingCompactableStore{}, nil)
+	stats, err := svc.Compact(ctx)
+	if err != nil {
+		t.Fatalf("Compact: %v", err)
+	}
+
+	if codeEmb.deleteCalled {
+		t.Error("expected DeleteBy not to be called when there are no orphans")
+	}
+	if stats.OrphansRemoved != 0 {
+		t.Errorf("OrphansRemoved = %d, want 0", stats.OrphansRemoved)
+	}
+}
+
+type recordingTruncatableStore struct {
+	recordingEmbeddingStore
+	truncated int
+}
+
+func (r *recordingTruncatableStore) TruncateVectors(_ context.Context, _ int) (int, error) {
+	return r.truncated, nil
+}
+
+func TestEnrichment_TruncateVectors_SkipsStoresWithoutTheCapability(t *testing.T) {
+	codeEmb := &recordingTruncatableStore{truncated: 3}
+	textEmb := &recordingEmbeddingStore{}
+
+	svc := NewEnrichment(nil, nil, nil, codeEmb, textEmb, nil, nil)
+	stats, err := svc.TruncateVectors(context.Background(), 512)
+	if err != nil {
+		t.Fatalf("TruncateVectors: %v", err)
+	}
+
+	if stats.VectorsTruncated != 3 {
+		t.Errorf("VectorsTruncated = %d, want 3", stats.VectorsTruncated)
+	}
+	if stats.StoresSkipped != 1 {
+		t.Errorf("StoresSkipped = %d, want 1", stats.StoresSkipped)
+	}
+}
+
 func TestEnrichment_RelatedEnrichments(t *testing.T) {
 	stores := newTestStores(t)
 	ctx := context.Background()
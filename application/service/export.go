@@ -0,0 +1,306 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+
+	"github.com/helixml/kodit/domain/enrichment"
+	"github.com/helixml/kodit/domain/repository"
+	"github.com/helixml/kodit/domain/wiki"
+)
+
+// DefaultExportMaxCommits bounds how many recent commits a bundle export
+// scans for enrichments, mirroring the default used by enrichment listing.
+const DefaultExportMaxCommits = 100
+
+// Export builds portable document bundles from a repository's enrichments,
+// suitable for publishing to a static docs site or committing back to the
+// repository.
+type Export struct {
+	commits      repository.CommitStore
+	files        repository.FileStore
+	enrichments  *Enrichment
+	associations enrichment.AssociationStore
+}
+
+// NewExport creates a new Export service.
+func NewExport(
+	commits repository.CommitStore,
+	files repository.FileStore,
+	enrichments *Enrichment,
+	associations enrichment.AssociationStore,
+) *Export {
+	return &Export{
+		commits:      commits,
+		files:        files,
+		enrichments:  enrichments,
+		associations: associations,
+	}
+}
+
+// MarkdownBundle builds a zip archive of a repository's architecture docs,
+// wiki pages, commit descriptions, and file summaries, scanning up to
+// maxCommits of its most recent commits and keeping the newest enrichment
+// for each document. The archive is organized into folders suitable for
+// publishing to a static docs site or committing back to the repo:
+//
+//	architecture/physical-architecture.md
+//	architecture/database-schema.md
+//	wiki/<page-slug>.md
+//	commits/<short-sha>.md
+//	files/<file-path>.md
+func (s *Export) MarkdownBundle(ctx context.Context, repoID int64, maxCommits int) ([]byte, error) {
+	if maxCommits <= 0 {
+		maxCommits = DefaultExportMaxCommits
+	}
+
+	commits, err := s.commits.Find(ctx, repository.WithRepoID(repoID), repository.WithLimit(maxCommits))
+	if err != nil {
+		return nil, fmt.Errorf("find commits: %w", err)
+	}
+
+	shas := make([]string, 0, len(commits))
+	for _, c := range commits {
+		shas = append(shas, c.SHA())
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+
+	if err := s.addArchitectureDocs(ctx, zw, shas); err != nil {
+		return nil, err
+	}
+	if err := s.addWikiPages(ctx, zw, shas); err != nil {
+		return nil, err
+	}
+	if err := s.addCommitDescriptions(ctx, zw, shas); err != nil {
+		return nil, err
+	}
+	if err := s.addFileSummaries(ctx, zw, shas); err != nil {
+		return nil, err
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("close export archive: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (s *Export) addArchitectureDocs(ctx context.Context, zw *zip.Writer, shas []string) error {
+	docs := []struct {
+		subtype enrichment.Subtype
+		name    string
+		title   string
+	}{
+		{enrichment.SubtypePhysical, "architecture/physical-architecture.md", "Physical Architecture"},
+		{enrichment.SubtypeDatabaseSchema, "architecture/database-schema.md", "Database Schema"},
+	}
+
+	typ := enrichment.TypeArchitecture
+	for _, doc := range docs {
+		subtype := doc.subtype
+		enrichments, err := s.enrichments.List(ctx, &EnrichmentListParams{Type: &typ, Subtype: &subtype, CommitSHAs: shas})
+		if err != nil {
+			return fmt.Errorf("list %s enrichments: %w", doc.subtype, err)
+		}
+
+		latest, ok := latestEnrichment(enrichments)
+		if !ok {
+			continue
+		}
+
+		if err := writeZipFile(zw, doc.name, fmt.Sprintf("# %s\n\n%s\n", doc.title, latest.Content())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Export) addWikiPages(ctx context.Context, zw *zip.Writer, shas []string) error {
+	typ := enrichment.TypeUsage
+	subtype := enrichment.SubtypeWikiPage
+	enrichments, err := s.enrichments.List(ctx, &EnrichmentListParams{Type: &typ, Subtype: &subtype, CommitSHAs: shas})
+	if err != nil {
+		return fmt.Errorf("list wiki page enrichments: %w", err)
+	}
+
+	pagesBySlug := make(map[string]wiki.Page, len(enrichments))
+	latestBySlug := make(map[string]enrichment.Enrichment, len(enrichments))
+	for _, e := range enrichments {
+		page, err := wiki.ParsePageDocument(e.Content())
+		if err != nil {
+			continue
+		}
+
+		existing, ok := latestBySlug[page.Slug()]
+		if !ok || e.CreatedAt().After(existing.CreatedAt()) {
+			latestBySlug[page.Slug()] = e
+			pagesBySlug[page.Slug()] = page
+		}
+	}
+
+	slugs := make([]string, 0, len(pagesBySlug))
+	for slug := range pagesBySlug {
+		slugs = append(slugs, slug)
+	}
+	sort.Strings(slugs)
+
+	for _, slug := range slugs {
+		page := pagesBySlug[slug]
+		name := path.Join("wiki", slug+".md")
+		if err := writeZipFile(zw, name, fmt.Sprintf("# %s\n\n%s\n", page.Title(), page.Content())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Export) addCommitDescriptions(ctx context.Context, zw *zip.Writer, shas []string) error {
+	typ := enrichment.TypeHistory
+	subtype := enrichment.SubtypeCommitDescription
+	enrichments, err := s.enrichments.List(ctx, &EnrichmentListParams{Type: &typ, Subtype: &subtype, CommitSHAs: shas})
+	if err != nil {
+		return fmt.Errorf("list commit description enrichments: %w", err)
+	}
+	if len(enrichments) == 0 {
+		return nil
+	}
+
+	shaByEnrichment, err := s.entityIDsByEnrichment(ctx, enrichments, enrichment.EntityTypeCommit)
+	if err != nil {
+		return fmt.Errorf("resolve commit associations: %w", err)
+	}
+
+	for _, e := range enrichments {
+		sha, ok := shaByEnrichment[e.ID()]
+		if !ok {
+			continue
+		}
+
+		shortSHA := sha
+		if len(shortSHA) > 8 {
+			shortSHA = shortSHA[:8]
+		}
+
+		name := path.Join("commits", shortSHA+".md")
+		if err := writeZipFile(zw, name, fmt.Sprintf("# Commit %s\n\n%s\n", shortSHA, e.Content())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Export) addFileSummaries(ctx context.Context, zw *zip.Writer, shas []string) error {
+	typ := enrichment.TypeDevelopment
+	subtype := enrichment.SubtypeFileSummary
+	enrichments, err := s.enrichments.List(ctx, &EnrichmentListParams{Type: &typ, Subtype: &subtype, CommitSHAs: shas})
+	if err != nil {
+		return fmt.Errorf("list file summary enrichments: %w", err)
+	}
+	if len(enrichments) == 0 {
+		return nil
+	}
+
+	fileIDByEnrichment, err := s.entityIDsByEnrichment(ctx, enrichments, enrichment.EntityTypeFile)
+	if err != nil {
+		return fmt.Errorf("resolve file associations: %w", err)
+	}
+
+	latestByFileID := make(map[string]enrichment.Enrichment, len(enrichments))
+	for _, e := range enrichments {
+		fileID, ok := fileIDByEnrichment[e.ID()]
+		if !ok {
+			continue
+		}
+
+		existing, ok := latestByFileID[fileID]
+		if !ok || e.CreatedAt().After(existing.CreatedAt()) {
+			latestByFileID[fileID] = e
+		}
+	}
+
+	fileIDs := make([]string, 0, len(latestByFileID))
+	for fileID := range latestByFileID {
+		fileIDs = append(fileIDs, fileID)
+	}
+	sort.Strings(fileIDs)
+
+	for _, fileID := range fileIDs {
+		id, err := strconv.ParseInt(fileID, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		f, err := s.files.FindOne(ctx, repository.WithID(id))
+		if err != nil {
+			continue
+		}
+
+		name := path.Join("files", f.Path()+".md")
+		if err := writeZipFile(zw, name, fmt.Sprintf("# %s\n\n%s\n", f.Path(), latestByFileID[fileID].Content())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// entityIDsByEnrichment resolves each enrichment's associated entity ID
+// (commit SHA or numeric file ID, depending on entityType) via the
+// association table, since an Enrichment does not carry that identity
+// itself.
+func (s *Export) entityIDsByEnrichment(ctx context.Context, enrichments []enrichment.Enrichment, entityType enrichment.EntityTypeKey) (map[int64]string, error) {
+	ids := make([]int64, len(enrichments))
+	for i, e := range enrichments {
+		ids[i] = e.ID()
+	}
+
+	associations, err := s.associations.Find(ctx, enrichment.WithEnrichmentIDIn(ids), enrichment.WithEntityType(entityType))
+	if err != nil {
+		return nil, err
+	}
+
+	entityIDs := make(map[int64]string, len(associations))
+	for _, a := range associations {
+		entityIDs[a.EnrichmentID()] = a.EntityID()
+	}
+
+	return entityIDs, nil
+}
+
+func latestEnrichment(enrichments []enrichment.Enrichment) (enrichment.Enrichment, bool) {
+	if len(enrichments) == 0 {
+		return enrichment.Enrichment{}, false
+	}
+
+	latest := enrichments[0]
+	for _, e := range enrichments[1:] {
+		if e.CreatedAt().After(latest.CreatedAt()) {
+			latest = e
+		}
+	}
+
+	return latest, true
+}
+
+func writeZipFile(zw *zip.Writer, name, content string) error {
+	w, err := zw.Create(name)
+	if err != nil {
+		return fmt.Errorf("create archive entry %s: %w", name, err)
+	}
+
+	if _, err := w.Write([]byte(content)); err != nil {
+		return fmt.Errorf("write archive entry %s: %w", name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/helixml/kodit/domain/repository"
+	"github.com/helixml/kodit/domain/tracking"
+	infratracking "github.com/helixml/kodit/infrastructure/tracking"
+	"github.com/helixml/kodit/internal/config"
+)
+
+// HealthMonitor computes per-repository health scores from indexing
+// failures, enrichment coverage, and staleness, and dispatches alerts
+// through an Alerter when a repository's score falls to or below the
+// configured threshold.
+type HealthMonitor struct {
+	repositories *Repository
+	commits      *Commit
+	enrichments  *Enrichment
+	tracking     *Tracking
+	alerter      infratracking.Alerter
+	threshold    float64
+	interval     time.Duration
+	enabled      bool
+	logger       zerolog.Logger
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	mu     sync.Mutex
+}
+
+// NewHealthMonitor creates a new HealthMonitor from config and dependencies.
+func NewHealthMonitor(
+	cfg config.HealthAlertConfig,
+	repositories *Repository,
+	commits *Commit,
+	enrichments *Enrichment,
+	trackingSvc *Tracking,
+	alerter infratracking.Alerter,
+	logger zerolog.Logger,
+) *HealthMonitor {
+	return &HealthMonitor{
+		repositories: repositories,
+		commits:      commits,
+		enrichments:  enrichments,
+		tracking:     trackingSvc,
+		alerter:      alerter,
+		threshold:    cfg.ScoreThreshold(),
+		interval:     cfg.CheckInterval(),
+		enabled:      cfg.Enabled(),
+		logger:       logger,
+	}
+}
+
+// Health computes the health score for a single repository.
+func (m *HealthMonitor) Health(ctx context.Context, repo repository.Repository) (tracking.RepositoryHealth, error) {
+	coverage, err := m.enrichmentCoverage(ctx, repo.ID())
+	if err != nil {
+		return tracking.RepositoryHealth{}, fmt.Errorf("compute enrichment coverage: %w", err)
+	}
+
+	return m.tracking.Health(ctx, repo.ID(), coverage, repo.LastScannedAt())
+}
+
+// enrichmentCoverage reports whether the repository's most recently indexed
+// commit has at least one enrichment, as a coarse 0/1 coverage signal.
+// Repositories with no commits yet are treated as fully covered, since
+// there is nothing to enrich.
+func (m *HealthMonitor) enrichmentCoverage(ctx context.Context, repoID int64) (float64, error) {
+	commits, err := m.commits.Find(ctx,
+		repository.WithRepoID(repoID),
+		repository.WithOrderDesc("date"),
+		repository.WithLimit(1),
+	)
+	if err != nil {
+		return 0, err
+	}
+	if len(commits) == 0 {
+		return 1, nil
+	}
+
+	count, err := m.enrichments.Count(ctx, &EnrichmentListParams{CommitSHA: commits[0].SHA()})
+	if err != nil {
+		return 0, err
+	}
+	if count > 0 {
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// CheckAll computes health for every tracked repository and alerts for any
+// whose score has fallen to or below the configured threshold.
+func (m *HealthMonitor) CheckAll(ctx context.Context) error {
+	repos, err := m.repositories.Find(ctx)
+	if err != nil {
+		return fmt.Errorf("list repositories: %w", err)
+	}
+
+	for _, repo := range repos {
+		health, err := m.Health(ctx, repo)
+		if err != nil {
+			return fmt.Errorf("compute health for repository %d: %w", repo.ID(), err)
+		}
+		if m.alerter != nil && health.Unhealthy(m.threshold) {
+			if err := m.alerter.Alert(ctx, repo.ID(), repo.UpstreamURL(), health); err != nil {
+				return fmt.Errorf("alert for repository %d: %w", repo.ID(), err)
+			}
+		}
+	}
+	return nil
+}
+
+// Start begins periodic health checking in a background goroutine.
+// If disabled, this is a no-op.
+func (m *HealthMonitor) Start(ctx context.Context) {
+	if !m.enabled {
+		m.logger.Info().Msg("health alerting disabled")
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ctx, m.cancel = context.WithCancel(ctx)
+	m.wg.Go(func() {
+		m.run(ctx)
+	})
+
+	m.logger.Info().Dur("interval", m.interval).Float64("threshold", m.threshold).Msg("health alerting started")
+}
+
+// Stop cancels the background goroutine and waits for it to finish.
+func (m *HealthMonitor) Stop() {
+	m.mu.Lock()
+	cancel := m.cancel
+	m.cancel = nil
+	m.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+	}
+	m.wg.Wait()
+	m.logger.Info().Msg("health alerting stopped")
+}
+
+func (m *HealthMonitor) run(ctx context.Context) {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := m.CheckAll(ctx); err != nil {
+				m.logger.Error().Interface("error", err).Msg("repository health check failed")
+			}
+		}
+	}
+}
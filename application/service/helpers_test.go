@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	domainservice "github.com/helixml/kodit/domain/service"
 	"github.com/helixml/kodit/infrastructure/git"
 	"github.com/helixml/kodit/infrastructure/persistence"
 	"github.com/helixml/kodit/internal/testdb"
@@ -21,9 +22,12 @@ type testStores struct {
 	branches     persistence.BranchStore
 	tags         persistence.TagStore
 	tasks        persistence.TaskStore
+	statuses     persistence.StatusStore
 	enrichments  persistence.EnrichmentStore
 	associations persistence.AssociationStore
 	lineRanges   persistence.SourceLocationStore
+	discovery    persistence.DiscoveryStore
+	audit        persistence.AuditStore
 }
 
 func newTestStores(t *testing.T) testStores {
@@ -36,9 +40,12 @@ func newTestStores(t *testing.T) testStores {
 		branches:     persistence.NewBranchStore(db),
 		tags:         persistence.NewTagStore(db),
 		tasks:        persistence.NewTaskStore(db),
+		statuses:     persistence.NewStatusStore(db),
 		enrichments:  persistence.NewEnrichmentStore(db),
 		associations: persistence.NewAssociationStore(db),
 		lineRanges:   persistence.NewSourceLocationStore(db),
+		discovery:    persistence.NewDiscoveryStore(db),
+		audit:        persistence.NewAuditStore(db),
 	}
 }
 
@@ -51,6 +58,10 @@ type fakeGitAdapter struct {
 	grepErr     error
 	cloneFn     func(remoteURI, localPath string) error
 	cloneCalled bool
+	uncommitted []git.UncommittedFile
+	remoteRefs  map[string]string
+	isAncestor  bool
+	diffRefs    string
 }
 
 func (f *fakeGitAdapter) FileContent(_ context.Context, _, commitSHA, filePath string) ([]byte, error) {
@@ -128,6 +139,46 @@ func (f *fakeGitAdapter) AllTags(context.Context, string) ([]git.TagInfo, error)
 	return nil, nil
 }
 
-func (f *fakeGitAdapter) CommitDiff(context.Context, string, string) (string, error) {
+func (f *fakeGitAdapter) CommitDiff(context.Context, string, string, string) (string, error) {
 	return "", nil
 }
+
+func (f *fakeGitAdapter) DiffRefs(context.Context, string, string, string) (string, error) {
+	return f.diffRefs, nil
+}
+
+func (f *fakeGitAdapter) UncommittedFiles(context.Context, string) ([]git.UncommittedFile, error) {
+	return f.uncommitted, nil
+}
+
+func (f *fakeGitAdapter) RemoteRefs(context.Context, string) (map[string]string, error) {
+	return f.remoteRefs, nil
+}
+
+func (f *fakeGitAdapter) IsAncestor(context.Context, string, string, string) (bool, error) {
+	return f.isAncestor, nil
+}
+
+func (f *fakeGitAdapter) FetchRef(context.Context, string, string) (string, error) {
+	return "", nil
+}
+
+// fakeEnricher provides a configurable in-memory domainservice.Enricher for tests.
+type fakeEnricher struct {
+	responses []domainservice.EnrichmentResponse
+	err       error
+}
+
+func (f *fakeEnricher) Enrich(_ context.Context, requests []domainservice.EnrichmentRequest, _ ...domainservice.EnrichOption) ([]domainservice.EnrichmentResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if f.responses != nil {
+		return f.responses, nil
+	}
+	var responses []domainservice.EnrichmentResponse
+	for _, r := range requests {
+		responses = append(responses, domainservice.NewEnrichmentResponse(r.ID(), "enriched content for "+r.ID()))
+	}
+	return responses, nil
+}
@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/helixml/kodit/domain/repository"
+	"github.com/helixml/kodit/domain/tracking"
+	"github.com/helixml/kodit/infrastructure/git"
+	"github.com/helixml/kodit/internal/config"
+)
+
+// Integrity periodically compares each tracked repository's recorded branch
+// or tag head against its live upstream remote (via `git ls-remote`),
+// flagging repositories whose tracked ref diverged, was force-pushed, or
+// was deleted upstream, and optionally resyncing them back in line.
+type Integrity struct {
+	repositories *Repository
+	git          git.Adapter
+	autoReset    bool
+	interval     time.Duration
+	enabled      bool
+	logger       zerolog.Logger
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	mu     sync.Mutex
+}
+
+// NewIntegrity creates a new Integrity checker from config and dependencies.
+func NewIntegrity(
+	cfg config.IntegrityConfig,
+	repositories *Repository,
+	gitAdapter git.Adapter,
+	logger zerolog.Logger,
+) *Integrity {
+	return &Integrity{
+		repositories: repositories,
+		git:          gitAdapter,
+		autoReset:    cfg.AutoReset(),
+		interval:     cfg.CheckInterval(),
+		enabled:      cfg.Enabled(),
+		logger:       logger,
+	}
+}
+
+// Check compares a single repository's tracked ref against its remote.
+// Repositories tracking a fixed commit, or without a working copy yet, are
+// always reported OK since there is no live ref to compare against.
+func (m *Integrity) Check(ctx context.Context, repo repository.Repository) (tracking.IntegrityCheck, error) {
+	tc := repo.TrackingConfig()
+	if tc.IsEmpty() || tc.IsCommit() || !repo.HasWorkingCopy() {
+		return tracking.NewIntegrityCheck(tracking.IntegrityStatusOK, "", time.Now()), nil
+	}
+
+	localSHA, err := m.localHeadSHA(ctx, repo, tc)
+	if err != nil {
+		return tracking.IntegrityCheck{}, err
+	}
+	if localSHA == "" {
+		return tracking.NewIntegrityCheck(tracking.IntegrityStatusOK, "", time.Now()), nil
+	}
+
+	refs, err := m.git.RemoteRefs(ctx, repo.UpstreamURL())
+	if err != nil {
+		return tracking.IntegrityCheck{}, fmt.Errorf("list remote refs: %w", err)
+	}
+
+	remoteSHA, ok := refs[tc.Reference()]
+	if !ok {
+		return tracking.NewIntegrityCheck(
+			tracking.IntegrityStatusDeleted,
+			fmt.Sprintf("tracked ref %q no longer exists on remote", tc.Reference()),
+			time.Now(),
+		), nil
+	}
+	if remoteSHA == localSHA {
+		return tracking.NewIntegrityCheck(tracking.IntegrityStatusOK, "", time.Now()), nil
+	}
+
+	// Fetch so the new remote tip is available locally before checking
+	// ancestry; without it merge-base has nothing to compare against.
+	if err := m.git.FetchRepository(ctx, repo.WorkingCopy().Path()); err != nil {
+		return tracking.IntegrityCheck{}, fmt.Errorf("fetch latest refs: %w", err)
+	}
+
+	ancestor, err := m.git.IsAncestor(ctx, repo.WorkingCopy().Path(), localSHA, remoteSHA)
+	if err == nil && ancestor {
+		// The remote simply advanced since the last sync; the regular
+		// periodic sync will catch up on its own.
+		return tracking.NewIntegrityCheck(tracking.IntegrityStatusOK, "", time.Now()), nil
+	}
+
+	message := fmt.Sprintf("tracked ref %q diverged from remote: local %s, remote %s", tc.Reference(), localSHA, remoteSHA)
+	if m.autoReset {
+		if resetErr := m.repositories.Sync(ctx, repo.ID()); resetErr != nil {
+			return tracking.IntegrityCheck{}, fmt.Errorf("auto-reset repository %d: %w", repo.ID(), resetErr)
+		}
+		message += " (auto-reset triggered)"
+	}
+	return tracking.NewIntegrityCheck(tracking.IntegrityStatusDiverged, message, time.Now()), nil
+}
+
+// localHeadSHA returns the recorded commit SHA for the repository's tracked
+// branch or tag, or "" if it has not been recorded yet.
+func (m *Integrity) localHeadSHA(ctx context.Context, repo repository.Repository, tc repository.TrackingConfig) (string, error) {
+	switch {
+	case tc.IsBranch():
+		branches, err := m.repositories.BranchesForRepository(ctx, repo.ID())
+		if err != nil {
+			return "", fmt.Errorf("find branches: %w", err)
+		}
+		for _, b := range branches {
+			if b.Name() == tc.Branch() {
+				return b.HeadCommitSHA(), nil
+			}
+		}
+		return "", nil
+	case tc.IsTag():
+		tags, err := m.repositories.TagsForRepository(ctx, repo.ID())
+		if err != nil {
+			return "", fmt.Errorf("find tags: %w", err)
+		}
+		for _, t := range tags {
+			if t.Name() == tc.Tag() {
+				return t.CommitSHA(), nil
+			}
+		}
+		return "", nil
+	default:
+		return "", nil
+	}
+}
+
+// CheckAll runs Check for every tracked repository, logging any integrity
+// issue found.
+func (m *Integrity) CheckAll(ctx context.Context) error {
+	repos, err := m.repositories.Find(ctx)
+	if err != nil {
+		return fmt.Errorf("list repositories: %w", err)
+	}
+
+	for _, repo := range repos {
+		check, err := m.Check(ctx, repo)
+		if err != nil {
+			return fmt.Errorf("check integrity for repository %d: %w", repo.ID(), err)
+		}
+		if !check.OK() {
+			m.logger.Warn().
+				Int64("repo_id", repo.ID()).
+				Str("status", string(check.Status())).
+				Str("message", check.Message()).
+				Msg("repository integrity check flagged an issue")
+		}
+	}
+	return nil
+}
+
+// Start begins periodic integrity checking in a background goroutine.
+// If disabled, this is a no-op.
+func (m *Integrity) Start(ctx context.Context) {
+	if !m.enabled {
+		m.logger.Info().Msg("integrity verification disabled")
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ctx, m.cancel = context.WithCancel(ctx)
+	m.wg.Go(func() {
+		m.run(ctx)
+	})
+
+	m.logger.Info().Dur("interval", m.interval).Bool("auto_reset", m.autoReset).Msg("integrity verification started")
+}
+
+// Stop cancels the background goroutine and waits for it to finish.
+func (m *Integrity) Stop() {
+	m.mu.Lock()
+	cancel := m.cancel
+	m.cancel = nil
+	m.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+	}
+	m.wg.Wait()
+	m.logger.Info().Msg("integrity verification stopped")
+}
+
+func (m *Integrity) run(ctx context.Context) {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := m.CheckAll(ctx); err != nil {
+				m.logger.Error().Interface("error", err).Msg("repository integrity check failed")
+			}
+		}
+	}
+}
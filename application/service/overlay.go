@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/helixml/kodit/domain/repository"
+	"github.com/helixml/kodit/infrastructure/chunking"
+	"github.com/helixml/kodit/infrastructure/extraction"
+	"github.com/helixml/kodit/infrastructure/git"
+)
+
+// OverlaySnippet holds a chunk of an uncommitted working tree file. Unlike a
+// snippet from the persisted pipeline, it has no enrichment ID and is never
+// saved — it only exists for the duration of the call that produced it.
+type OverlaySnippet struct {
+	Path      string
+	Language  string
+	Content   string
+	StartLine int
+	EndLine   int
+}
+
+// Overlay searches a repository's current uncommitted working tree changes.
+// Snippets are chunked fresh from disk on every call rather than persisted,
+// so results always reflect whatever is on disk right now and disappear
+// once changes are committed or discarded. This trades the ranking of the
+// full BM25/vector fusion search for immediacy on in-progress edits.
+type Overlay struct {
+	repositories repository.RepositoryStore
+	git          git.Adapter
+	extractors   *extraction.Extractors
+	params       chunking.ChunkParams
+}
+
+// NewOverlay creates a new Overlay service.
+func NewOverlay(
+	repositories repository.RepositoryStore,
+	gitAdapter git.Adapter,
+	extractors *extraction.Extractors,
+	params chunking.ChunkParams,
+) *Overlay {
+	return &Overlay{
+		repositories: repositories,
+		git:          gitAdapter,
+		extractors:   extractors,
+		params:       params,
+	}
+}
+
+// Snippets chunks every uncommitted file in repoID's working tree.
+func (o *Overlay) Snippets(ctx context.Context, repoID int64) ([]OverlaySnippet, error) {
+	repo, err := o.repositories.FindOne(ctx, repository.WithID(repoID))
+	if err != nil {
+		return nil, fmt.Errorf("find repository: %w", err)
+	}
+
+	if !repo.HasWorkingCopy() {
+		return nil, fmt.Errorf("repository %d has no working copy", repoID)
+	}
+
+	clonedPath := repo.WorkingCopy().Path()
+
+	files, err := o.git.UncommittedFiles(ctx, clonedPath)
+	if err != nil {
+		return nil, fmt.Errorf("list uncommitted files: %w", err)
+	}
+
+	var snippets []OverlaySnippet
+	for _, f := range files {
+		if !extraction.IsIndexable(f.Path) {
+			continue
+		}
+
+		diskPath, safe := safeOverlayPath(clonedPath, f.Path)
+		if !safe {
+			continue
+		}
+
+		content, readErr := os.ReadFile(diskPath)
+		if readErr != nil {
+			// The working tree can change between the git status call and
+			// this read (e.g. the file is deleted or moved); skip it
+			// rather than failing the whole overlay.
+			continue
+		}
+
+		ext := strings.ToLower(filepath.Ext(f.Path))
+		text, extractErr := o.extractors.For(ext).Text(content)
+		if extractErr != nil || strings.TrimSpace(text) == "" {
+			continue
+		}
+
+		textChunks, chunkErr := chunking.NewTextChunks(text, o.params)
+		if chunkErr != nil {
+			continue
+		}
+
+		for _, ch := range textChunks.All() {
+			snippets = append(snippets, OverlaySnippet{
+				Path:      f.Path,
+				Language:  ext,
+				Content:   ch.Content(),
+				StartLine: ch.StartLine(),
+				EndLine:   ch.EndLine(),
+			})
+		}
+	}
+
+	return snippets, nil
+}
+
+// Search chunks repoID's uncommitted files and returns the snippets whose
+// content contains query, case-insensitively. This is a plain substring
+// match, not the BM25/vector fusion used by the persisted search pipeline —
+// uncommitted content has no enrichment ID to rank or fuse against, so
+// overlay results are best used as a quick check on in-progress edits
+// rather than a ranked search.
+func (o *Overlay) Search(ctx context.Context, repoID int64, query string) ([]OverlaySnippet, error) {
+	snippets, err := o.Snippets(ctx, repoID)
+	if err != nil {
+		return nil, err
+	}
+
+	if query == "" {
+		return snippets, nil
+	}
+
+	needle := strings.ToLower(query)
+	var matches []OverlaySnippet
+	for _, s := range snippets {
+		if strings.Contains(strings.ToLower(s.Content), needle) {
+			matches = append(matches, s)
+		}
+	}
+	return matches, nil
+}
+
+// safeOverlayPath joins clonedPath and relPath and verifies the result
+// stays inside clonedPath. Returns ("", false) if the resolved path escapes.
+func safeOverlayPath(clonedPath, relPath string) (string, bool) {
+	diskPath := filepath.Join(clonedPath, relPath)
+	clean := filepath.Clean(diskPath)
+	base := filepath.Clean(clonedPath) + string(filepath.Separator)
+	if !strings.HasPrefix(clean, base) && clean != filepath.Clean(clonedPath) {
+		return "", false
+	}
+	return clean, true
+}
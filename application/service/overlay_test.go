@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/helixml/kodit/domain/repository"
+	"github.com/helixml/kodit/infrastructure/chunking"
+	"github.com/helixml/kodit/infrastructure/extraction"
+	"github.com/helixml/kodit/infrastructure/git"
+)
+
+func newTestOverlay(gitAdapter git.Adapter, repos repository.RepositoryStore) *Overlay {
+	return NewOverlay(repos, gitAdapter, extraction.NewExtractors(), chunking.ChunkParams{Size: 100, Overlap: 0, MinSize: 1})
+}
+
+func TestOverlay_Snippets_ChunksUncommittedFiles(t *testing.T) {
+	stores := newTestStores(t)
+	ctx := context.Background()
+
+	clonePath := t.TempDir()
+	if err := os.WriteFile(filepath.Join(clonePath, "main.go"), []byte("package main\n\nfunc main() {}\n"), 0o644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	remoteURL := "https://github.com/test/repo"
+	repo, err := repository.NewRepository(remoteURL)
+	if err != nil {
+		t.Fatalf("new repo: %v", err)
+	}
+	repo = repo.WithWorkingCopy(repository.NewWorkingCopy(clonePath, remoteURL))
+	saved, err := stores.repos.Save(ctx, repo)
+	if err != nil {
+		t.Fatalf("save repo: %v", err)
+	}
+
+	gitAdapter := &fakeGitAdapter{
+		uncommitted: []git.UncommittedFile{{Path: "main.go"}},
+	}
+	overlay := newTestOverlay(gitAdapter, stores.repos)
+
+	snippets, err := overlay.Snippets(ctx, saved.ID())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(snippets) != 1 {
+		t.Fatalf("expected 1 snippet, got %d", len(snippets))
+	}
+	if snippets[0].Path != "main.go" {
+		t.Errorf("expected path main.go, got %s", snippets[0].Path)
+	}
+	if snippets[0].Language != ".go" {
+		t.Errorf("expected language .go, got %s", snippets[0].Language)
+	}
+}
+
+func TestOverlay_Snippets_SkipsNonIndexableFiles(t *testing.T) {
+	stores := newTestStores(t)
+	ctx := context.Background()
+
+	clonePath := t.TempDir()
+	if err := os.WriteFile(filepath.Join(clonePath, "image.bin"), []byte{0x00, 0x01}, 0o644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	remoteURL := "https://github.com/test/repo"
+	repo, err := repository.NewRepository(remoteURL)
+	if err != nil {
+		t.Fatalf("new repo: %v", err)
+	}
+	repo = repo.WithWorkingCopy(repository.NewWorkingCopy(clonePath, remoteURL))
+	saved, err := stores.repos.Save(ctx, repo)
+	if err != nil {
+		t.Fatalf("save repo: %v", err)
+	}
+
+	gitAdapter := &fakeGitAdapter{
+		uncommitted: []git.UncommittedFile{{Path: "image.bin"}},
+	}
+	overlay := newTestOverlay(gitAdapter, stores.repos)
+
+	snippets, err := overlay.Snippets(ctx, saved.ID())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(snippets) != 0 {
+		t.Fatalf("expected no snippets for non-indexable file, got %d", len(snippets))
+	}
+}
+
+func TestOverlay_Snippets_RepoNotFound(t *testing.T) {
+	stores := newTestStores(t)
+
+	overlay := newTestOverlay(&fakeGitAdapter{}, stores.repos)
+
+	_, err := overlay.Snippets(context.Background(), 999)
+	if err == nil {
+		t.Fatal("expected error for missing repo")
+	}
+}
+
+func TestOverlay_Snippets_NoWorkingCopy(t *testing.T) {
+	stores := newTestStores(t)
+	ctx := context.Background()
+
+	repo, err := repository.NewRepository("https://github.com/test/repo")
+	if err != nil {
+		t.Fatalf("new repo: %v", err)
+	}
+	saved, err := stores.repos.Save(ctx, repo)
+	if err != nil {
+		t.Fatalf("save repo: %v", err)
+	}
+
+	overlay := newTestOverlay(&fakeGitAdapter{}, stores.repos)
+
+	_, err = overlay.Snippets(ctx, saved.ID())
+	if err == nil {
+		t.Fatal("expected error for repo with no working copy")
+	}
+}
+
+func TestOverlay_Search_FiltersBySubstring(t *testing.T) {
+	stores := newTestStores(t)
+	ctx := context.Background()
+
+	clonePath := t.TempDir()
+	if err := os.WriteFile(filepath.Join(clonePath, "main.go"), []byte("package main\n\nfunc main() {}\n"), 0o644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(clonePath, "util.go"), []byte("package main\n\nfunc helper() {}\n"), 0o644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	remoteURL := "https://github.com/test/repo"
+	repo, err := repository.NewRepository(remoteURL)
+	if err != nil {
+		t.Fatalf("new repo: %v", err)
+	}
+	repo = repo.WithWorkingCopy(repository.NewWorkingCopy(clonePath, remoteURL))
+	saved, err := stores.repos.Save(ctx, repo)
+	if err != nil {
+		t.Fatalf("save repo: %v", err)
+	}
+
+	gitAdapter := &fakeGitAdapter{
+		uncommitted: []git.UncommittedFile{{Path: "main.go"}, {Path: "util.go"}},
+	}
+	overlay := newTestOverlay(gitAdapter, stores.repos)
+
+	results, err := overlay.Search(ctx, saved.ID(), "helper")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("expected 1 match, got %d", len(results))
+	}
+	if results[0].Path != "util.go" {
+		t.Errorf("expected match in util.go, got %s", results[0].Path)
+	}
+}
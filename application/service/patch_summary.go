@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/helixml/kodit/domain/repository"
+	domainservice "github.com/helixml/kodit/domain/service"
+	"github.com/helixml/kodit/infrastructure/git"
+)
+
+const patchSummarySystemPrompt = `
+You are a professional software developer reviewing a patch. You will be given a
+unified diff. Respond with exactly three lines, each starting with the given
+label:
+
+Intent: <one sentence describing what the change does and why>
+Risk: <one sentence describing the biggest risk this change introduces, or "low" if none stands out>
+Affected areas: <comma-separated list of the components or subsystems this change touches>
+`
+
+// MaxPatchDiffLength is the maximum characters of diff sent to the enricher
+// (~25k tokens), matching the commit description pipeline's diff budget.
+const MaxPatchDiffLength = 100_000
+
+// PatchSummary is a structured, on-demand review summary of a single patch:
+// what it does, its biggest risk, and the areas of the codebase it touches.
+type PatchSummary struct {
+	intent        string
+	risk          string
+	affectedAreas []string
+}
+
+// Intent returns a one-sentence description of what the patch does and why.
+func (s PatchSummary) Intent() string { return s.intent }
+
+// Risk returns a one-sentence description of the patch's biggest risk.
+func (s PatchSummary) Risk() string { return s.risk }
+
+// AffectedAreas returns the components or subsystems the patch touches.
+func (s PatchSummary) AffectedAreas() []string { return s.affectedAreas }
+
+// PatchSummarizer produces on-demand patch summaries for code review. Unlike
+// CommitDescription, it never persists its output as an enrichment.
+type PatchSummarizer struct {
+	repositories repository.RepositoryStore
+	enricher     domainservice.Enricher
+	git          git.Adapter
+}
+
+// NewPatchSummarizer creates a new PatchSummarizer service.
+func NewPatchSummarizer(
+	repositories repository.RepositoryStore,
+	enricher domainservice.Enricher,
+	gitAdapter git.Adapter,
+) *PatchSummarizer {
+	return &PatchSummarizer{
+		repositories: repositories,
+		enricher:     enricher,
+		git:          gitAdapter,
+	}
+}
+
+// Summarize returns a structured summary of diff. If diff is empty, it is
+// resolved first by diffing baseRef against headRef in repoID's working copy.
+func (p *PatchSummarizer) Summarize(ctx context.Context, repoID int64, diff, baseRef, headRef string) (PatchSummary, error) {
+	if diff == "" {
+		resolved, err := p.diffRefs(ctx, repoID, baseRef, headRef)
+		if err != nil {
+			return PatchSummary{}, err
+		}
+		diff = resolved
+	}
+	if diff == "" {
+		return PatchSummary{}, fmt.Errorf("no diff to summarize")
+	}
+
+	requests := []domainservice.EnrichmentRequest{
+		domainservice.NewEnrichmentRequest("patch", truncateDiff(diff, MaxPatchDiffLength), patchSummarySystemPrompt),
+	}
+
+	responses, err := p.enricher.Enrich(ctx, requests)
+	if err != nil {
+		return PatchSummary{}, fmt.Errorf("enrich patch summary: %w", err)
+	}
+	if len(responses) == 0 {
+		return PatchSummary{}, fmt.Errorf("no enrichment response for patch summary")
+	}
+
+	return parsePatchSummary(responses[0].Text()), nil
+}
+
+// diffRefs resolves the diff between baseRef and headRef in repoID's working copy.
+func (p *PatchSummarizer) diffRefs(ctx context.Context, repoID int64, baseRef, headRef string) (string, error) {
+	if baseRef == "" || headRef == "" {
+		return "", fmt.Errorf("base and head refs are required when no diff is provided")
+	}
+
+	repo, err := p.repositories.FindOne(ctx, repository.WithID(repoID))
+	if err != nil {
+		return "", fmt.Errorf("find repository: %w", err)
+	}
+	if !repo.HasWorkingCopy() {
+		return "", fmt.Errorf("repository %d has no working copy", repoID)
+	}
+
+	diff, err := p.git.DiffRefs(ctx, repo.WorkingCopy().Path(), baseRef, headRef)
+	if err != nil {
+		return "", fmt.Errorf("diff refs: %w", err)
+	}
+	return diff, nil
+}
+
+// truncateDiff truncates a diff to a reasonable length for LLM processing,
+// mirroring application/handler/enrichment.TruncateDiff.
+func truncateDiff(diff string, maxLength int) string {
+	if len(diff) <= maxLength {
+		return diff
+	}
+	truncationNotice := "\n\n[diff truncated due to size]"
+	cutoff := max(maxLength-len(truncationNotice), 0)
+	return diff[:cutoff] + truncationNotice
+}
+
+// parsePatchSummary parses the enricher's labelled-line response into a
+// PatchSummary, tolerating missing or reordered labels.
+func parsePatchSummary(text string) PatchSummary {
+	var summary PatchSummary
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(line, "Intent:"):
+			summary.intent = strings.TrimSpace(strings.TrimPrefix(line, "Intent:"))
+		case strings.HasPrefix(line, "Risk:"):
+			summary.risk = strings.TrimSpace(strings.TrimPrefix(line, "Risk:"))
+		case strings.HasPrefix(line, "Affected areas:"):
+			areas := strings.TrimSpace(strings.TrimPrefix(line, "Affected areas:"))
+			for _, area := range strings.Split(areas, ",") {
+				if area = strings.TrimSpace(area); area != "" {
+					summary.affectedAreas = append(summary.affectedAreas, area)
+				}
+			}
+		}
+	}
+	return summary
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/helixml/kodit/domain/repository"
+	domainservice "github.com/helixml/kodit/domain/service"
+)
+
+func TestParsePatchSummary_ParsesAllFields(t *testing.T) {
+	text := "Intent: adds retry logic to the webhook sender\n" +
+		"Risk: could retry non-idempotent requests\n" +
+		"Affected areas: webhooks, queue\n"
+
+	summary := parsePatchSummary(text)
+
+	if summary.Intent() != "adds retry logic to the webhook sender" {
+		t.Errorf("unexpected intent: %q", summary.Intent())
+	}
+	if summary.Risk() != "could retry non-idempotent requests" {
+		t.Errorf("unexpected risk: %q", summary.Risk())
+	}
+	if len(summary.AffectedAreas()) != 2 || summary.AffectedAreas()[0] != "webhooks" || summary.AffectedAreas()[1] != "queue" {
+		t.Errorf("unexpected affected areas: %v", summary.AffectedAreas())
+	}
+}
+
+func TestParsePatchSummary_MissingLabels(t *testing.T) {
+	summary := parsePatchSummary("not a labelled response")
+
+	if summary.Intent() != "" || summary.Risk() != "" || summary.AffectedAreas() != nil {
+		t.Errorf("expected empty summary, got %+v", summary)
+	}
+}
+
+func TestPatchSummarizer_Summarize_UsesGivenDiff(t *testing.T) {
+	enricher := &fakeEnricher{
+		responses: []domainservice.EnrichmentResponse{
+			domainservice.NewEnrichmentResponse("patch", "Intent: fixes a bug\nRisk: low\nAffected areas: parser\n"),
+		},
+	}
+
+	summarizer := NewPatchSummarizer(nil, enricher, &fakeGitAdapter{})
+
+	summary, err := summarizer.Summarize(context.Background(), 0, "diff --git a/foo b/foo", "", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if summary.Intent() != "fixes a bug" {
+		t.Errorf("unexpected intent: %q", summary.Intent())
+	}
+}
+
+func TestPatchSummarizer_Summarize_ResolvesDiffFromRefs(t *testing.T) {
+	stores := newTestStores(t)
+	ctx := context.Background()
+
+	remoteURL := "https://github.com/test/repo"
+	repo, err := repository.NewRepository(remoteURL)
+	if err != nil {
+		t.Fatalf("new repo: %v", err)
+	}
+	repo = repo.WithWorkingCopy(repository.NewWorkingCopy("/tmp/repo", remoteURL))
+	saved, err := stores.repos.Save(ctx, repo)
+	if err != nil {
+		t.Fatalf("save repo: %v", err)
+	}
+
+	gitAdapter := &fakeGitAdapter{diffRefs: "diff --git a/foo b/foo"}
+	enricher := &fakeEnricher{}
+
+	summarizer := NewPatchSummarizer(stores.repos, enricher, gitAdapter)
+
+	summary, err := summarizer.Summarize(ctx, saved.ID(), "", "main", "feature")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if summary.Intent() != "" {
+		t.Errorf("expected empty intent for unlabelled fake response, got %q", summary.Intent())
+	}
+}
+
+func TestPatchSummarizer_Summarize_NoDiffNoRefs(t *testing.T) {
+	summarizer := NewPatchSummarizer(nil, &fakeEnricher{}, &fakeGitAdapter{})
+
+	_, err := summarizer.Summarize(context.Background(), 0, "", "", "")
+	if err == nil {
+		t.Fatal("expected error when no diff and no refs are provided")
+	}
+}
+
+func TestPatchSummarizer_Summarize_RepoNotFound(t *testing.T) {
+	stores := newTestStores(t)
+
+	summarizer := NewPatchSummarizer(stores.repos, &fakeEnricher{}, &fakeGitAdapter{})
+
+	_, err := summarizer.Summarize(context.Background(), 999, "", "main", "feature")
+	if err == nil {
+		t.Fatal("expected error for missing repo")
+	}
+}
@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/helixml/kodit/domain/task"
+	"github.com/helixml/kodit/internal/config"
+)
+
+// PeriodicCompaction enqueues a CompactVectorStore task on a timer, so orphan
+// vector and BM25 rows left behind by deleted snippets get swept up and the
+// underlying stores get vacuumed without manual intervention.
+type PeriodicCompaction struct {
+	queue    *Queue
+	logger   zerolog.Logger
+	interval time.Duration
+	enabled  bool
+
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+	mu        sync.Mutex
+	nextRunAt time.Time
+}
+
+// NewPeriodicCompaction creates a new PeriodicCompaction from config and dependencies.
+func NewPeriodicCompaction(cfg config.CompactionConfig, queue *Queue, logger zerolog.Logger) *PeriodicCompaction {
+	return &PeriodicCompaction{
+		queue:    queue,
+		logger:   logger,
+		interval: cfg.CheckInterval(),
+		enabled:  cfg.Enabled(),
+	}
+}
+
+// Start begins periodic compaction in a background goroutine.
+// If disabled, this is a no-op.
+func (p *PeriodicCompaction) Start(ctx context.Context) {
+	if !p.enabled {
+		p.logger.Info().Msg("periodic compaction disabled")
+		return
+	}
+
+	p.mu.Lock()
+	ctx, p.cancel = context.WithCancel(ctx)
+	p.nextRunAt = time.Now().Add(p.interval)
+	p.mu.Unlock()
+
+	p.wg.Go(func() {
+		p.run(ctx)
+	})
+
+	p.logger.Info().Dur("interval", p.interval).Msg("periodic compaction started")
+}
+
+// NextRunAt returns the estimated time of the next compaction sweep, or the
+// zero time if periodic compaction is disabled or has not started yet.
+func (p *PeriodicCompaction) NextRunAt() time.Time {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.nextRunAt
+}
+
+// Enabled returns true if periodic compaction is running.
+func (p *PeriodicCompaction) Enabled() bool { return p.enabled }
+
+// Stop cancels the background goroutine and waits for it to finish.
+func (p *PeriodicCompaction) Stop() {
+	p.mu.Lock()
+	cancel := p.cancel
+	p.cancel = nil
+	p.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+	}
+	p.wg.Wait()
+	p.logger.Info().Msg("periodic compaction stopped")
+}
+
+func (p *PeriodicCompaction) run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.mu.Lock()
+			p.nextRunAt = time.Now().Add(p.interval)
+			p.mu.Unlock()
+			p.enqueue(ctx)
+		}
+	}
+}
+
+func (p *PeriodicCompaction) enqueue(ctx context.Context) {
+	t := task.NewTask(task.OperationCompactVectorStore, int(task.PriorityBackground), nil)
+	if err := p.queue.Enqueue(ctx, t); err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		p.logger.Error().Str("error", err.Error()).Msg("periodic compaction failed to enqueue")
+		return
+	}
+	p.logger.Debug().Msg("periodic compaction enqueued")
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/helixml/kodit/domain/enrichment"
+	"github.com/helixml/kodit/domain/prindex"
+)
+
+// DefaultPRGCInterval is how often expired PR branch previews are swept.
+const DefaultPRGCInterval = time.Hour
+
+// PeriodicPRGC sweeps expired PR branch preview indexes on a timer, so
+// short-lived previews don't linger in search results or the pr_indexes
+// table once their TTL elapses. The underlying commit enrichments are left
+// alone — only the pr_refs associations and PRIndex record are removed.
+type PeriodicPRGC struct {
+	prIndexes    prindex.Store
+	associations enrichment.AssociationStore
+	logger       zerolog.Logger
+	interval     time.Duration
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	mu     sync.Mutex
+}
+
+// NewPeriodicPRGC creates a new PeriodicPRGC.
+func NewPeriodicPRGC(prIndexes prindex.Store, associations enrichment.AssociationStore, logger zerolog.Logger) *PeriodicPRGC {
+	return &PeriodicPRGC{
+		prIndexes:    prIndexes,
+		associations: associations,
+		logger:       logger,
+		interval:     DefaultPRGCInterval,
+	}
+}
+
+// Start begins periodic PR preview garbage collection in a background goroutine.
+func (p *PeriodicPRGC) Start(ctx context.Context) {
+	p.mu.Lock()
+	ctx, p.cancel = context.WithCancel(ctx)
+	p.mu.Unlock()
+
+	p.wg.Go(func() {
+		p.run(ctx)
+	})
+
+	p.logger.Info().Dur("interval", p.interval).Msg("periodic PR preview GC started")
+}
+
+// Stop cancels the background goroutine and waits for it to finish.
+func (p *PeriodicPRGC) Stop() {
+	p.mu.Lock()
+	cancel := p.cancel
+	p.cancel = nil
+	p.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+	}
+	p.wg.Wait()
+	p.logger.Info().Msg("periodic PR preview GC stopped")
+}
+
+func (p *PeriodicPRGC) run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.sweep(ctx)
+		}
+	}
+}
+
+func (p *PeriodicPRGC) sweep(ctx context.Context) {
+	expired, err := p.prIndexes.Find(ctx, prindex.WithExpiresBefore(time.Now()))
+	if err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		p.logger.Error().Str("error", err.Error()).Msg("periodic PR preview GC failed to list expired previews")
+		return
+	}
+
+	for _, idx := range expired {
+		if err := p.associations.DeleteBy(ctx,
+			enrichment.WithEntityType(enrichment.EntityTypePRRef),
+			enrichment.WithEntityID(idx.Ref()),
+		); err != nil {
+			p.logger.Error().Str("error", err.Error()).Str("ref", idx.Ref()).Msg("periodic PR preview GC failed to delete associations")
+			continue
+		}
+
+		if err := p.prIndexes.Delete(ctx, idx); err != nil {
+			p.logger.Error().Str("error", err.Error()).Str("ref", idx.Ref()).Msg("periodic PR preview GC failed to delete preview")
+			continue
+		}
+
+		p.logger.Debug().Str("ref", idx.Ref()).Msg("PR preview garbage collected")
+	}
+}
@@ -21,9 +21,11 @@ type PeriodicSync struct {
 	checkInterval time.Duration
 	enabled       bool
 
-	cancel context.CancelFunc
-	wg     sync.WaitGroup
-	mu     sync.Mutex
+	cancel        context.CancelFunc
+	wg            sync.WaitGroup
+	mu            sync.Mutex
+	firstPass     chan struct{}
+	firstPassOnce sync.Once
 }
 
 // NewPeriodicSync creates a new PeriodicSync from config and dependencies.
@@ -40,14 +42,34 @@ func NewPeriodicSync(
 		interval:      cfg.Interval(),
 		checkInterval: cfg.CheckInterval(),
 		enabled:       cfg.Enabled(),
+		firstPass:     make(chan struct{}),
 	}
 }
 
+// Interval returns the configured minimum time between syncs of the same
+// repository.
+func (p *PeriodicSync) Interval() time.Duration { return p.interval }
+
+// Enabled returns true if periodic sync is running.
+func (p *PeriodicSync) Enabled() bool { return p.enabled }
+
+// FirstPassComplete returns a channel that closes once the first sync pass
+// has run, or immediately if periodic sync is disabled. Callers can use
+// this to gate startup readiness on a scheduler having run at least once.
+func (p *PeriodicSync) FirstPassComplete() <-chan struct{} {
+	return p.firstPass
+}
+
+func (p *PeriodicSync) markFirstPassComplete() {
+	p.firstPassOnce.Do(func() { close(p.firstPass) })
+}
+
 // Start begins periodic sync in a background goroutine.
 // If disabled, this is a no-op.
 func (p *PeriodicSync) Start(ctx context.Context) {
 	if !p.enabled {
 		p.logger.Info().Msg("periodic sync disabled")
+		p.markFirstPassComplete()
 		return
 	}
 
@@ -77,6 +99,9 @@ func (p *PeriodicSync) Stop() {
 }
 
 func (p *PeriodicSync) run(ctx context.Context) {
+	p.sync(ctx)
+	p.markFirstPassComplete()
+
 	ticker := time.NewTicker(p.checkInterval)
 	defer ticker.Stop()
 
@@ -104,7 +129,10 @@ func (p *PeriodicSync) sync(ctx context.Context) {
 		return
 	}
 
-	repos, err := p.repositories.Find(ctx, repository.WithScanDueBefore(time.Now().Add(-p.interval)))
+	repos, err := p.repositories.Find(ctx,
+		repository.WithScanDueBefore(time.Now().Add(-p.interval)),
+		repository.WithArchived(false),
+	)
 	if err != nil {
 		if ctx.Err() != nil {
 			return
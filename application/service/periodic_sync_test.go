@@ -33,7 +33,7 @@ func TestPeriodicSync_Enabled(t *testing.T) {
 	_, err = stores.repos.Save(ctx, repoB)
 	require.NoError(t, err)
 
-	queue := NewQueue(stores.tasks, logger)
+	queue := NewQueue(stores.tasks, stores.statuses, logger)
 
 	cfg := config.NewPeriodicSyncConfig().
 		WithEnabled(true).
@@ -69,7 +69,7 @@ func TestPeriodicSync_Disabled(t *testing.T) {
 	_, err = stores.repos.Save(ctx, repo)
 	require.NoError(t, err)
 
-	queue := NewQueue(stores.tasks, logger)
+	queue := NewQueue(stores.tasks, stores.statuses, logger)
 
 	cfg := config.NewPeriodicSyncConfig().
 		WithEnabled(false)
@@ -86,12 +86,57 @@ func TestPeriodicSync_Disabled(t *testing.T) {
 	assert.Empty(t, tasks)
 }
 
+func TestPeriodicSync_FirstPassCompleteWhenDisabled(t *testing.T) {
+	stores := newTestStores(t)
+	ctx := context.Background()
+	logger := zerolog.Nop()
+
+	queue := NewQueue(stores.tasks, stores.statuses, logger)
+
+	cfg := config.NewPeriodicSyncConfig().WithEnabled(false)
+
+	ps := NewPeriodicSync(cfg, stores.repos, queue, logger)
+	ps.Start(ctx)
+
+	select {
+	case <-ps.FirstPassComplete():
+	case <-time.After(time.Second):
+		t.Fatal("expected FirstPassComplete to close immediately when disabled")
+	}
+
+	ps.Stop()
+}
+
+func TestPeriodicSync_FirstPassCompleteAfterFirstRun(t *testing.T) {
+	stores := newTestStores(t)
+	ctx := context.Background()
+	logger := zerolog.Nop()
+
+	queue := NewQueue(stores.tasks, stores.statuses, logger)
+
+	cfg := config.NewPeriodicSyncConfig().
+		WithEnabled(true).
+		WithIntervalSeconds(60).
+		WithCheckIntervalSeconds(60)
+
+	ps := NewPeriodicSync(cfg, stores.repos, queue, logger)
+	ps.Start(ctx)
+
+	select {
+	case <-ps.FirstPassComplete():
+	case <-time.After(time.Second):
+		t.Fatal("expected FirstPassComplete to close after the first sync pass")
+	}
+
+	ps.Stop()
+}
+
 func TestPeriodicSync_EmptyRepositories(t *testing.T) {
 	stores := newTestStores(t)
 	ctx := context.Background()
 	logger := zerolog.Nop()
 
-	queue := NewQueue(stores.tasks, logger)
+	queue := NewQueue(stores.tasks, stores.statuses, logger)
 
 	cfg := config.NewPeriodicSyncConfig().
 		WithEnabled(true).
@@ -112,7 +112,14 @@ func (s *Pipeline) defaultSteps() []StepParams {
 			StepParams{Name: op(task.OperationCreateCommitDescriptionForCommit), Kind: "internal", DependsOn: []string{op(task.OperationCreateArchitectureEnrichmentForCommit)}},
 			StepParams{Name: op(task.OperationCreateDatabaseSchemaForCommit), Kind: "internal", DependsOn: []string{op(task.OperationCreateCommitDescriptionForCommit)}},
 			StepParams{Name: op(task.OperationCreateCookbookForCommit), Kind: "internal", DependsOn: []string{op(task.OperationCreateDatabaseSchemaForCommit)}},
-			StepParams{Name: op(task.OperationGenerateWikiForCommit), Kind: "internal", DependsOn: []string{op(task.OperationCreateCookbookForCommit)}},
+			StepParams{Name: op(task.OperationCreateFileSummaryForCommit), Kind: "internal", DependsOn: []string{op(task.OperationExtractSnippetsForCommit)}},
+			StepParams{Name: op(task.OperationCreateDirectorySummaryForCommit), Kind: "internal", DependsOn: []string{op(task.OperationCreateFileSummaryForCommit)}},
+			StepParams{Name: op(task.OperationGenerateWikiForCommit), Kind: "internal", DependsOn: []string{
+				op(task.OperationCreateCookbookForCommit),
+				op(task.OperationCreateDirectorySummaryForCommit),
+			}},
+			StepParams{Name: op(task.OperationCreateWikiPageIndexForCommit), Kind: "internal", DependsOn: []string{op(task.OperationGenerateWikiForCommit)}},
+			StepParams{Name: op(task.OperationCreateWikiPageEmbeddingsForCommit), Kind: "internal", DependsOn: []string{op(task.OperationCreateWikiPageIndexForCommit)}},
 		)
 	}
 	return steps
@@ -130,6 +137,7 @@ func ragSteps() []StepParams {
 		{Name: op(task.OperationDeleteRepository), Kind: "internal"},
 		// Commit scanning and indexing
 		{Name: op(task.OperationScanCommit), Kind: "internal"},
+		{Name: op(task.OperationCreateOnboardingReportForCommit), Kind: "internal", DependsOn: []string{op(task.OperationScanCommit)}},
 		{Name: op(task.OperationExtractSnippetsForCommit), Kind: "internal", DependsOn: []string{op(task.OperationScanCommit)}},
 		{Name: op(task.OperationCreateBM25IndexForCommit), Kind: "internal", DependsOn: []string{op(task.OperationExtractSnippetsForCommit)}},
 		{Name: op(task.OperationCreateCodeEmbeddingsForCommit), Kind: "internal", DependsOn: []string{op(task.OperationCreateBM25IndexForCommit)}},
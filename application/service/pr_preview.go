@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/helixml/kodit/domain/prindex"
+	"github.com/helixml/kodit/domain/repository"
+	"github.com/helixml/kodit/domain/task"
+	"github.com/helixml/kodit/infrastructure/git"
+)
+
+// DefaultPRPreviewTTL is how long a PR branch preview index remains
+// searchable before it becomes eligible for garbage collection.
+const DefaultPRPreviewTTL = 24 * time.Hour
+
+// PRPreview indexes short-lived pull request branches into an ephemeral
+// namespace that overlays a repository's regular index, so agents can
+// search unmerged code alongside the rest of the repo before it merges.
+type PRPreview struct {
+	repositories repository.RepositoryStore
+	prIndexes    prindex.Store
+	git          git.Adapter
+	queue        *Queue
+	resolver     CommitOperationResolver
+	ttl          time.Duration
+}
+
+// NewPRPreview creates a new PRPreview service.
+func NewPRPreview(
+	repositories repository.RepositoryStore,
+	prIndexes prindex.Store,
+	gitAdapter git.Adapter,
+	queue *Queue,
+	resolver CommitOperationResolver,
+	ttl time.Duration,
+) *PRPreview {
+	if ttl <= 0 {
+		ttl = DefaultPRPreviewTTL
+	}
+	return &PRPreview{
+		repositories: repositories,
+		prIndexes:    prIndexes,
+		git:          gitAdapter,
+		queue:        queue,
+		resolver:     resolver,
+		ttl:          ttl,
+	}
+}
+
+// Index fetches ref from origin, enqueues the same commit-scoped operations
+// used to index a tracked branch, and records the resulting PRIndex so the
+// ref becomes searchable via the pr_ref filter once indexing completes.
+// Re-indexing an already-previewed ref refreshes its head commit and TTL.
+func (p *PRPreview) Index(ctx context.Context, repoID int64, ref string) (prindex.PRIndex, error) {
+	repo, err := p.repositories.FindOne(ctx, repository.WithID(repoID))
+	if err != nil {
+		return prindex.PRIndex{}, fmt.Errorf("find repository: %w", err)
+	}
+
+	if !repo.HasWorkingCopy() {
+		return prindex.PRIndex{}, fmt.Errorf("repository %d has no working copy", repoID)
+	}
+
+	headCommitSHA, err := p.git.FetchRef(ctx, repo.WorkingCopy().Path(), ref)
+	if err != nil {
+		return prindex.PRIndex{}, fmt.Errorf("fetch ref %s: %w", ref, err)
+	}
+
+	operations, err := p.resolver.Operations(ctx, repo.PipelineID())
+	if err != nil {
+		return prindex.PRIndex{}, fmt.Errorf("resolve pipeline operations: %w", err)
+	}
+	operations = append(operations, task.OperationTagPRRefForCommit)
+
+	payload := map[string]any{
+		"repository_id": repoID,
+		"commit_sha":    headCommitSHA,
+		"pr_ref":        ref,
+	}
+	if err := p.queue.EnqueueOperations(ctx, operations, task.PriorityUserInitiated, payload); err != nil {
+		return prindex.PRIndex{}, fmt.Errorf("enqueue operations: %w", err)
+	}
+
+	existing, err := p.prIndexes.FindOne(ctx, prindex.WithRepoID(repoID), prindex.WithRef(ref))
+	index := prindex.New(repoID, ref, headCommitSHA, p.ttl)
+	if err == nil {
+		index = index.WithID(existing.ID())
+	}
+
+	saved, err := p.prIndexes.Save(ctx, index)
+	if err != nil {
+		return prindex.PRIndex{}, fmt.Errorf("save pr index: %w", err)
+	}
+
+	return saved, nil
+}
+
+// List returns the active PR previews indexed for a repository.
+func (p *PRPreview) List(ctx context.Context, repoID int64) ([]prindex.PRIndex, error) {
+	return p.prIndexes.Find(ctx, prindex.WithRepoID(repoID))
+}
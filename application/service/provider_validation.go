@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/helixml/kodit/domain/search"
+	"github.com/helixml/kodit/infrastructure/provider"
+)
+
+// chatModelNamed is implemented by text generators that can report which
+// model serves their completions. Mirrors the optional interface accepted
+// by provider.MeteredGenerator.
+type chatModelNamed interface {
+	ChatModelName() string
+}
+
+// embeddingModelNamed is implemented by embedders that can report which
+// model serves their embeddings. Mirrors the optional interface accepted
+// by provider.MeteredEmbedder.
+type embeddingModelNamed interface {
+	EmbeddingModelName() string
+}
+
+// EndpointCheck reports the outcome of a single live round-trip against a
+// configured provider endpoint.
+type EndpointCheck struct {
+	OK           bool
+	Model        string
+	Latency      time.Duration
+	PromptTokens int
+	TotalTokens  int
+	Dimension    int    // Set for embedding checks: the vector length the provider actually returned.
+	Message      string // Set when OK is false.
+}
+
+// ValidationReport holds the outcome of validating whichever provider
+// endpoints are currently configured. A nil field means that endpoint was
+// never configured, as opposed to configured-but-failing.
+type ValidationReport struct {
+	Embedding  *EndpointCheck
+	Enrichment *EndpointCheck
+}
+
+// ProviderValidation performs live round-trips against the configured
+// embedding and text generation providers, so misconfigured keys,
+// unreachable endpoints, or renamed models surface as an explicit check
+// result instead of as the first failed indexing or enrichment task.
+type ProviderValidation struct {
+	textProvider      provider.TextGenerator
+	embeddingProvider search.Embedder
+}
+
+// NewProviderValidation creates a new ProviderValidation. Either provider
+// may be nil if that capability isn't configured.
+func NewProviderValidation(textProvider provider.TextGenerator, embeddingProvider search.Embedder) *ProviderValidation {
+	return &ProviderValidation{
+		textProvider:      textProvider,
+		embeddingProvider: embeddingProvider,
+	}
+}
+
+// Validate round-trips a tiny embed and a tiny chat completion against
+// whichever providers are configured, and reports latency, model, token
+// usage, and (for embedding) the actual vector dimension returned, so a
+// renamed or swapped embedding model surfaces here instead of as a
+// dimension mismatch the first time a vector store tries to write to it.
+func (v *ProviderValidation) Validate(ctx context.Context) ValidationReport {
+	var report ValidationReport
+
+	if v.embeddingProvider != nil {
+		check := v.checkEmbedding(ctx)
+		report.Embedding = &check
+	}
+	if v.textProvider != nil {
+		check := v.checkText(ctx)
+		report.Enrichment = &check
+	}
+
+	return report
+}
+
+func (v *ProviderValidation) checkEmbedding(ctx context.Context) EndpointCheck {
+	model := ""
+	if named, ok := v.embeddingProvider.(embeddingModelNamed); ok {
+		model = named.EmbeddingModelName()
+	}
+
+	start := time.Now()
+	embeddings, err := v.embeddingProvider.Embed(ctx, []search.EmbeddingItem{search.NewTextItem("kodit provider validation ping")})
+	latency := time.Since(start)
+	if err != nil {
+		return EndpointCheck{Model: model, Latency: latency, Message: err.Error()}
+	}
+
+	var dimension int
+	if len(embeddings) > 0 {
+		dimension = len(embeddings[0])
+	}
+
+	return EndpointCheck{OK: true, Model: model, Latency: latency, Dimension: dimension}
+}
+
+func (v *ProviderValidation) checkText(ctx context.Context) EndpointCheck {
+	model := ""
+	if named, ok := v.textProvider.(chatModelNamed); ok {
+		model = named.ChatModelName()
+	}
+
+	req := provider.NewChatCompletionRequest([]provider.Message{
+		provider.UserMessage("Reply with the single word: ok"),
+	}).WithMaxTokens(5)
+
+	start := time.Now()
+	resp, err := v.textProvider.ChatCompletion(ctx, req)
+	latency := time.Since(start)
+	if err != nil {
+		return EndpointCheck{Model: model, Latency: latency, Message: err.Error()}
+	}
+
+	return EndpointCheck{
+		OK:           true,
+		Model:        model,
+		Latency:      latency,
+		PromptTokens: resp.Usage().PromptTokens(),
+		TotalTokens:  resp.Usage().TotalTokens(),
+	}
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestProviderValidation_Validate_ReportsEmbeddingDimension(t *testing.T) {
+	embedder := fakeEmbedder{vectors: [][]float64{{0.1, 0.2, 0.3, 0.4}}}
+
+	validation := NewProviderValidation(nil, embedder)
+	report := validation.Validate(context.Background())
+
+	if report.Embedding == nil {
+		t.Fatal("expected embedding check to be reported")
+	}
+	if !report.Embedding.OK {
+		t.Fatalf("expected embedding check to succeed, got message %q", report.Embedding.Message)
+	}
+	if report.Embedding.Dimension != 4 {
+		t.Errorf("expected dimension 4, got %d", report.Embedding.Dimension)
+	}
+	if report.Enrichment != nil {
+		t.Error("expected enrichment check to be nil when no text provider is configured")
+	}
+}
+
+func TestProviderValidation_Validate_EmbeddingFailureHasNoDimension(t *testing.T) {
+	embedder := fakeEmbedder{err: errors.New("endpoint unreachable")}
+
+	validation := NewProviderValidation(nil, embedder)
+	report := validation.Validate(context.Background())
+
+	if report.Embedding == nil {
+		t.Fatal("expected embedding check to be reported")
+	}
+	if report.Embedding.OK {
+		t.Error("expected embedding check to fail")
+	}
+	if report.Embedding.Dimension != 0 {
+		t.Errorf("expected zero dimension on failure, got %d", report.Embedding.Dimension)
+	}
+}
+
+func TestProviderValidation_Validate_NoProvidersConfigured(t *testing.T) {
+	validation := NewProviderValidation(nil, nil)
+	report := validation.Validate(context.Background())
+
+	if report.Embedding != nil {
+		t.Error("expected embedding check to be nil when unconfigured")
+	}
+	if report.Enrichment != nil {
+		t.Error("expected enrichment check to be nil when unconfigured")
+	}
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/helixml/kodit/domain/querylog"
+	"github.com/helixml/kodit/domain/repository"
+)
+
+// FrequentQuestion groups recurring, near-duplicate queries so common
+// questions can be surfaced back to users instead of being answered once
+// and forgotten.
+type FrequentQuestion struct {
+	Normalized string
+	Sample     string
+	Count      int
+	LastAsked  time.Time
+}
+
+// QueryLog records issued search queries and reports which ones recur, so
+// common questions become candidates for first-class documentation (e.g.
+// the cookbook and wiki generators).
+//
+// Clustering recurring queries ideally uses a cross-encoder to catch
+// semantically equivalent but differently-worded questions; this codebase
+// has no cross-encoder or reranker model integration anywhere, so clusters
+// are instead grouped by normalized exact text (lowercased, punctuation and
+// extra whitespace stripped). This catches exact and near-exact repeats but
+// not paraphrases.
+type QueryLog struct {
+	store querylog.Store
+}
+
+// NewQueryLog creates a new QueryLog service.
+func NewQueryLog(store querylog.Store) *QueryLog {
+	return &QueryLog{store: store}
+}
+
+// Record persists a query as it was issued. repositoryID may be "" when the
+// query was not scoped to a specific repository.
+func (s *QueryLog) Record(ctx context.Context, repositoryID, query string) error {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil
+	}
+	if _, err := s.store.Save(ctx, querylog.New(repositoryID, query)); err != nil {
+		return fmt.Errorf("save query log: %w", err)
+	}
+	return nil
+}
+
+var normalizeQueryPattern = regexp.MustCompile(`[^a-z0-9 ]+`)
+
+// normalizeQuery lowercases, strips punctuation, and collapses whitespace so
+// "How do I parse JSON?" and "how do i parse json" cluster together.
+func normalizeQuery(q string) string {
+	lowered := strings.ToLower(q)
+	stripped := normalizeQueryPattern.ReplaceAllString(lowered, " ")
+	return strings.Join(strings.Fields(stripped), " ")
+}
+
+// FrequentQuestions returns recurring queries for a repository (or across
+// all repositories when repositoryID is ""), most frequent first. Queries
+// asked only once are omitted.
+func (s *QueryLog) FrequentQuestions(ctx context.Context, repositoryID string, limit int) ([]FrequentQuestion, error) {
+	var opts []repository.Option
+	if repositoryID != "" {
+		opts = append(opts, querylog.WithRepositoryID(repositoryID))
+	}
+
+	logs, err := s.store.Find(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("find query logs: %w", err)
+	}
+
+	byNormalized := map[string]*FrequentQuestion{}
+	order := make([]string, 0, len(logs))
+	for _, l := range logs {
+		key := normalizeQuery(l.Query())
+		if key == "" {
+			continue
+		}
+		q, ok := byNormalized[key]
+		if !ok {
+			q = &FrequentQuestion{Normalized: key, Sample: l.Query()}
+			byNormalized[key] = q
+			order = append(order, key)
+		}
+		q.Count++
+		if l.CreatedAt().After(q.LastAsked) {
+			q.LastAsked = l.CreatedAt()
+		}
+	}
+
+	result := make([]FrequentQuestion, 0, len(order))
+	for _, key := range order {
+		q := byNormalized[key]
+		if q.Count > 1 {
+			result = append(result, *q)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Count > result[j].Count })
+
+	if limit > 0 && len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/helixml/kodit/domain/querylog"
+	"github.com/helixml/kodit/domain/repository"
+)
+
+// fakeQueryLogStore implements querylog.Store for testing.
+// Genuine fake: the real store requires a database.
+type fakeQueryLogStore struct {
+	logs []querylog.QueryLog
+}
+
+func (f *fakeQueryLogStore) Find(_ context.Context, _ ...repository.Option) ([]querylog.QueryLog, error) {
+	return f.logs, nil
+}
+
+func (f *fakeQueryLogStore) FindOne(_ context.Context, _ ...repository.Option) (querylog.QueryLog, error) {
+	return querylog.QueryLog{}, nil
+}
+
+func (f *fakeQueryLogStore) Count(_ context.Context, _ ...repository.Option) (int64, error) {
+	return int64(len(f.logs)), nil
+}
+
+func (f *fakeQueryLogStore) Save(_ context.Context, entity querylog.QueryLog) (querylog.QueryLog, error) {
+	f.logs = append(f.logs, entity)
+	return entity, nil
+}
+
+func (f *fakeQueryLogStore) Delete(_ context.Context, _ querylog.QueryLog) error {
+	return nil
+}
+
+func (f *fakeQueryLogStore) DeleteBy(_ context.Context, _ ...repository.Option) error {
+	return nil
+}
+
+func TestQueryLog_Record(t *testing.T) {
+	store := &fakeQueryLogStore{}
+	svc := NewQueryLog(store)
+
+	if err := svc.Record(context.Background(), "42", "how do I parse JSON?"); err != nil {
+		t.Fatalf("Record returned error: %v", err)
+	}
+
+	if len(store.logs) != 1 {
+		t.Fatalf("expected 1 stored log, got %d", len(store.logs))
+	}
+	if store.logs[0].Query() != "how do I parse JSON?" {
+		t.Errorf("unexpected query: %q", store.logs[0].Query())
+	}
+}
+
+func TestQueryLog_Record_BlankIgnored(t *testing.T) {
+	store := &fakeQueryLogStore{}
+	svc := NewQueryLog(store)
+
+	if err := svc.Record(context.Background(), "42", "   "); err != nil {
+		t.Fatalf("Record returned error: %v", err)
+	}
+	if len(store.logs) != 0 {
+		t.Fatalf("expected blank query to be ignored, got %d stored logs", len(store.logs))
+	}
+}
+
+func TestQueryLog_FrequentQuestions_ClustersAndRanks(t *testing.T) {
+	now := time.Now()
+	store := &fakeQueryLogStore{logs: []querylog.QueryLog{
+		querylog.Reconstruct(1, "42", "How do I parse JSON?", now.Add(-2*time.Hour)),
+		querylog.Reconstruct(2, "42", "how do i parse json", now.Add(-1*time.Hour)),
+		querylog.Reconstruct(3, "42", "how do i parse json!!", now),
+		querylog.Reconstruct(4, "42", "what is a snippet", now),
+	}}
+	svc := NewQueryLog(store)
+
+	questions, err := svc.FrequentQuestions(context.Background(), "42", 0)
+	if err != nil {
+		t.Fatalf("FrequentQuestions returned error: %v", err)
+	}
+
+	if len(questions) != 1 {
+		t.Fatalf("expected 1 recurring question, got %d", len(questions))
+	}
+	if questions[0].Count != 3 {
+		t.Errorf("expected count 3, got %d", questions[0].Count)
+	}
+	if !questions[0].LastAsked.Equal(now) {
+		t.Errorf("expected last asked to be the most recent occurrence")
+	}
+}
+
+func TestQueryLog_FrequentQuestions_RespectsLimit(t *testing.T) {
+	store := &fakeQueryLogStore{logs: []querylog.QueryLog{
+		querylog.Reconstruct(1, "", "a", time.Now()),
+		querylog.Reconstruct(2, "", "a", time.Now()),
+		querylog.Reconstruct(3, "", "b", time.Now()),
+		querylog.Reconstruct(4, "", "b", time.Now()),
+		querylog.Reconstruct(5, "", "b", time.Now()),
+	}}
+	svc := NewQueryLog(store)
+
+	questions, err := svc.FrequentQuestions(context.Background(), "", 1)
+	if err != nil {
+		t.Fatalf("FrequentQuestions returned error: %v", err)
+	}
+	if len(questions) != 1 {
+		t.Fatalf("expected limit of 1, got %d", len(questions))
+	}
+	if questions[0].Sample != "b" {
+		t.Errorf("expected the most frequent question first, got %q", questions[0].Sample)
+	}
+}
@@ -11,23 +11,32 @@ import (
 )
 
 // TaskListParams configures task listing.
+//
+// Setting Cursor switches from limit/offset pagination to keyset pagination:
+// priority ordering is dropped in favor of ordering by id, restricted to
+// id > AfterID, so pages stay consistent as tasks are enqueued and completed
+// concurrently.
 type TaskListParams struct {
 	Operation *task.Operation
 	Limit     int
 	Offset    int
+	Cursor    bool
+	AfterID   int64
 }
 
 // Queue provides the main interface for enqueuing and managing tasks.
 type Queue struct {
-	store  task.TaskStore
-	logger zerolog.Logger
+	store       task.TaskStore
+	statusStore task.StatusStore
+	logger      zerolog.Logger
 }
 
 // NewQueue creates a new queue service.
-func NewQueue(store task.TaskStore, logger zerolog.Logger) *Queue {
+func NewQueue(store task.TaskStore, statusStore task.StatusStore, logger zerolog.Logger) *Queue {
 	return &Queue{
-		store:  store,
-		logger: logger,
+		store:       store,
+		statusStore: statusStore,
+		logger:      logger,
 	}
 }
 
@@ -65,12 +74,26 @@ func (s *Queue) EnqueueOperations(
 }
 
 // List returns tasks matching the given params.
-// Tasks are sorted by priority (highest first) then by created_at (oldest first).
+// Tasks are sorted by priority (highest first) then by created_at (oldest
+// first), unless Cursor is set, in which case they are sorted by id so pages
+// can be addressed with a keyset cursor.
 func (s *Queue) List(ctx context.Context, params *TaskListParams) ([]task.Task, error) {
-	options := append([]repository.Option{}, task.WithPriorityOrder()...)
+	var options []repository.Option
 
-	if params != nil && params.Limit > 0 {
-		options = append(options, repository.WithPagination(params.Limit, params.Offset)...)
+	switch {
+	case params != nil && params.Cursor:
+		options = append(options, repository.WithOrderAsc("id"))
+		if params.Limit > 0 {
+			options = append(options, repository.WithLimit(params.Limit))
+		}
+		if params.AfterID > 0 {
+			options = append(options, repository.WithWhere("id > ?", params.AfterID))
+		}
+	default:
+		options = append(options, task.WithPriorityOrder()...)
+		if params != nil && params.Limit > 0 {
+			options = append(options, repository.WithPagination(params.Limit, params.Offset)...)
+		}
 	}
 
 	tasks, err := s.store.Find(ctx, options...)
@@ -139,6 +162,62 @@ func (s *Queue) DrainForRepository(ctx context.Context, repoID int64) (int, erro
 	return removed, nil
 }
 
+// PurgeFilter narrows which queued tasks PurgeBy removes. A nil field
+// matches every value for that dimension.
+type PurgeFilter struct {
+	Operation *task.Operation
+	RepoID    *int64
+}
+
+// PurgeBy removes every queued task matching the given filter, returning how
+// many tasks were removed.
+func (s *Queue) PurgeBy(ctx context.Context, filter PurgeFilter) (int, error) {
+	tasks, err := s.store.Find(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("find pending tasks: %w", err)
+	}
+
+	removed := 0
+	for _, t := range tasks {
+		if filter.Operation != nil && t.Operation() != *filter.Operation {
+			continue
+		}
+		if filter.RepoID != nil && payloadRepoID(t.Payload()) != *filter.RepoID {
+			continue
+		}
+		if err := s.store.Delete(ctx, t); err != nil {
+			return removed, fmt.Errorf("delete task %d: %w", t.ID(), err)
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+// RetryFailed re-enqueues operation for the given repository for every
+// status record showing it last failed there. Re-running the operation from
+// scratch is safe because handlers are idempotent: they check for existing
+// enrichments before doing work.
+func (s *Queue) RetryFailed(ctx context.Context, operation task.Operation, repoID int64) (int, error) {
+	statuses, err := s.statusStore.Find(ctx,
+		task.WithFailedState(),
+		task.WithOperation(operation),
+		repository.WithCondition("trackable_type", string(task.TrackableTypeRepository)),
+		repository.WithCondition("trackable_id", repoID),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("find failed statuses: %w", err)
+	}
+	if len(statuses) == 0 {
+		return 0, nil
+	}
+
+	payload := map[string]any{"repository_id": repoID}
+	if err := s.EnqueueOperations(ctx, []task.Operation{operation}, task.PriorityUserInitiated, payload); err != nil {
+		return 0, fmt.Errorf("enqueue retry: %w", err)
+	}
+	return len(statuses), nil
+}
+
 func payloadRepoID(payload map[string]any) int64 {
 	val, ok := payload["repository_id"]
 	if !ok {
@@ -16,7 +16,8 @@ import (
 func TestQueue_Enqueue(t *testing.T) {
 	db := testdb.New(t)
 	store := persistence.NewTaskStore(db)
-	queue := NewQueue(store, zerolog.Nop())
+	statusStore := persistence.NewStatusStore(db)
+	queue := NewQueue(store, statusStore, zerolog.Nop())
 	ctx := context.Background()
 
 	tsk := task.NewTask(task.OperationSyncRepository, int(task.PriorityNormal), map[string]any{"repository_id": int64(1)})
@@ -31,7 +32,8 @@ func TestQueue_Enqueue(t *testing.T) {
 func TestQueue_EnqueueDeduplicates(t *testing.T) {
 	db := testdb.New(t)
 	store := persistence.NewTaskStore(db)
-	queue := NewQueue(store, zerolog.Nop())
+	statusStore := persistence.NewStatusStore(db)
+	queue := NewQueue(store, statusStore, zerolog.Nop())
 	ctx := context.Background()
 
 	tsk := task.NewTask(task.OperationSyncRepository, int(task.PriorityNormal), map[string]any{"repository_id": int64(1)})
@@ -46,7 +48,8 @@ func TestQueue_EnqueueDeduplicates(t *testing.T) {
 func TestQueue_ListSortsByPriority(t *testing.T) {
 	db := testdb.New(t)
 	store := persistence.NewTaskStore(db)
-	queue := NewQueue(store, zerolog.Nop())
+	statusStore := persistence.NewStatusStore(db)
+	queue := NewQueue(store, statusStore, zerolog.Nop())
 	ctx := context.Background()
 
 	low := task.NewTask(task.OperationSyncRepository, int(task.PriorityBackground), map[string]any{"repository_id": int64(1)})
@@ -65,7 +68,8 @@ func TestQueue_ListSortsByPriority(t *testing.T) {
 func TestQueue_ListFiltersByOperation(t *testing.T) {
 	db := testdb.New(t)
 	store := persistence.NewTaskStore(db)
-	queue := NewQueue(store, zerolog.Nop())
+	statusStore := persistence.NewStatusStore(db)
+	queue := NewQueue(store, statusStore, zerolog.Nop())
 	ctx := context.Background()
 
 	sync := task.NewTask(task.OperationSyncRepository, int(task.PriorityNormal), map[string]any{"repository_id": int64(1)})
@@ -84,7 +88,8 @@ func TestQueue_ListFiltersByOperation(t *testing.T) {
 func TestQueue_Remove(t *testing.T) {
 	db := testdb.New(t)
 	store := persistence.NewTaskStore(db)
-	queue := NewQueue(store, zerolog.Nop())
+	statusStore := persistence.NewStatusStore(db)
+	queue := NewQueue(store, statusStore, zerolog.Nop())
 	ctx := context.Background()
 
 	tsk := task.NewTask(task.OperationSyncRepository, int(task.PriorityNormal), map[string]any{"repository_id": int64(1)})
@@ -104,7 +109,8 @@ func TestQueue_Remove(t *testing.T) {
 func TestQueue_Reprioritize(t *testing.T) {
 	db := testdb.New(t)
 	store := persistence.NewTaskStore(db)
-	queue := NewQueue(store, zerolog.Nop())
+	statusStore := persistence.NewStatusStore(db)
+	queue := NewQueue(store, statusStore, zerolog.Nop())
 	ctx := context.Background()
 
 	tsk := task.NewTask(task.OperationSyncRepository, int(task.PriorityBackground), map[string]any{"repository_id": int64(1)})
@@ -124,7 +130,8 @@ func TestQueue_Reprioritize(t *testing.T) {
 func TestQueue_DrainForRepository(t *testing.T) {
 	db := testdb.New(t)
 	store := persistence.NewTaskStore(db)
-	queue := NewQueue(store, zerolog.Nop())
+	statusStore := persistence.NewStatusStore(db)
+	queue := NewQueue(store, statusStore, zerolog.Nop())
 	ctx := context.Background()
 
 	t1 := task.NewTask(task.OperationSyncRepository, int(task.PriorityNormal), map[string]any{"repository_id": int64(1)})
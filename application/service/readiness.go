@@ -0,0 +1,45 @@
+package service
+
+import "sync/atomic"
+
+// Readiness reports whether startup-critical background work — database
+// migrations and the first periodic sync pass — has finished, so a health
+// check can report unavailable until the server can actually serve
+// requests. The zero value reports not ready.
+type Readiness struct {
+	ready atomic.Bool
+	err   atomic.Value
+}
+
+// errBox wraps an error so atomic.Value always stores the same concrete
+// type, regardless of the underlying error's type.
+type errBox struct {
+	err error
+}
+
+// NewReadiness creates a new Readiness gate, initially not ready.
+func NewReadiness() *Readiness {
+	return &Readiness{}
+}
+
+// MarkReady flips the gate to ready.
+func (r *Readiness) MarkReady() {
+	r.ready.Store(true)
+}
+
+// MarkFailed records a startup failure, so Ready continues to report false
+// and Err explains why.
+func (r *Readiness) MarkFailed(err error) {
+	r.err.Store(errBox{err: err})
+}
+
+// Ready reports whether startup has completed successfully.
+func (r *Readiness) Ready() bool {
+	return r.ready.Load()
+}
+
+// Err returns the startup failure recorded via MarkFailed, or nil.
+func (r *Readiness) Err() error {
+	box, _ := r.err.Load().(errBox)
+	return box.err
+}
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadiness_InitiallyNotReady(t *testing.T) {
+	r := NewReadiness()
+
+	assert.False(t, r.Ready())
+	assert.NoError(t, r.Err())
+}
+
+func TestReadiness_MarkReady(t *testing.T) {
+	r := NewReadiness()
+
+	r.MarkReady()
+
+	assert.True(t, r.Ready())
+	assert.NoError(t, r.Err())
+}
+
+func TestReadiness_MarkFailed(t *testing.T) {
+	r := NewReadiness()
+	failure := errors.New("migration failed")
+
+	r.MarkFailed(failure)
+
+	assert.False(t, r.Ready())
+	assert.ErrorIs(t, r.Err(), failure)
+}
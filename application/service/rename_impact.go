@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/helixml/kodit/domain/repository"
+)
+
+// FileImpact is the reference count for a single file within a repository.
+type FileImpact struct {
+	Path           string
+	ReferenceCount int
+}
+
+// RepoImpact is the reference count for a symbol within a single repository,
+// broken down by file.
+type RepoImpact struct {
+	RepoID         int64
+	RepoURL        string
+	ReferenceCount int
+	Files          []FileImpact
+}
+
+// RenameImpact estimates the blast radius of renaming or deprecating a
+// symbol, by counting its references across every indexed repository.
+//
+// There is no dedicated symbol/reference index in this codebase, so impact
+// is measured via a word-boundary git grep against each repository's
+// working copy rather than a resolved call graph. This means results are
+// textual matches, not type-checked references: it will miss aliased
+// imports and can over-count unrelated identifiers that happen to share a
+// name, but it needs no language-specific tooling and works across every
+// indexed language.
+type RenameImpact struct {
+	repositories repository.RepositoryStore
+	grep         *Grep
+}
+
+// NewRenameImpact creates a new RenameImpact service.
+func NewRenameImpact(repositories repository.RepositoryStore, grep *Grep) *RenameImpact {
+	return &RenameImpact{repositories: repositories, grep: grep}
+}
+
+// Analyze searches every indexed repository with a working copy for
+// references to symbol, returning one RepoImpact per repository that has
+// at least one match. maxFilesPerRepo caps how many files are inspected
+// per repository.
+func (s *RenameImpact) Analyze(ctx context.Context, symbol string, maxFilesPerRepo int) ([]RepoImpact, error) {
+	repos, err := s.repositories.Find(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("find repositories: %w", err)
+	}
+
+	pattern := wordBoundaryPattern(symbol)
+
+	impacts := make([]RepoImpact, 0, len(repos))
+	for _, repo := range repos {
+		if !repo.HasWorkingCopy() {
+			continue
+		}
+
+		results, err := s.grep.Search(ctx, repo.ID(), pattern, "", maxFilesPerRepo)
+		if err != nil {
+			return nil, fmt.Errorf("search repository %d: %w", repo.ID(), err)
+		}
+		if len(results) == 0 {
+			continue
+		}
+
+		impact := RepoImpact{RepoID: repo.ID(), RepoURL: repo.SanitizedURL()}
+		for _, r := range results {
+			impact.Files = append(impact.Files, FileImpact{Path: r.Path, ReferenceCount: len(r.Matches)})
+			impact.ReferenceCount += len(r.Matches)
+		}
+		impacts = append(impacts, impact)
+	}
+
+	return impacts, nil
+}
+
+// wordBoundaryPattern builds a regex matching symbol as a whole word, so
+// "User" doesn't also match "UserService".
+func wordBoundaryPattern(symbol string) string {
+	return `\b` + regexp.QuoteMeta(symbol) + `\b`
+}
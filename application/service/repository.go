@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/rs/zerolog"
@@ -10,6 +11,10 @@ import (
 	"github.com/helixml/kodit/domain/task"
 )
 
+// ErrRepositoryArchived indicates an operation was rejected because the
+// repository has been archived.
+var ErrRepositoryArchived = errors.New("repository is archived")
+
 // RepositoryAddParams configures adding a new repository.
 type RepositoryAddParams struct {
 	URL         string
@@ -40,6 +45,11 @@ type ChunkingConfigParams struct {
 	MinSize int
 }
 
+// EnrichmentBudgetParams holds the parameters for updating a repository's enrichment budget.
+type EnrichmentBudgetParams struct {
+	MaxFileSummaries int
+}
+
 // CommitOperationResolver resolves the operation sequence for a pipeline.
 type CommitOperationResolver interface {
 	DefaultID(ctx context.Context) (int64, error)
@@ -171,10 +181,13 @@ func (s *Repository) Delete(ctx context.Context, id int64) error {
 
 // Sync triggers a sync (git fetch + branch scan + commit indexing) for a repository.
 func (s *Repository) Sync(ctx context.Context, id int64) error {
-	_, err := s.repoStore.FindOne(ctx, repository.WithID(id))
+	repo, err := s.repoStore.FindOne(ctx, repository.WithID(id))
 	if err != nil {
 		return fmt.Errorf("get repository: %w", err)
 	}
+	if repo.Archived() {
+		return ErrRepositoryArchived
+	}
 
 	payload := map[string]any{"repository_id": id}
 	operations := []task.Operation{task.OperationCloneRepository, task.OperationSyncRepository}
@@ -227,7 +240,12 @@ func (s *Repository) RescanAll(ctx context.Context) error {
 	return nil
 }
 
-// UpdateTrackingConfig updates a repository's tracking configuration.
+// UpdateTrackingConfig updates a repository's tracking configuration. When
+// this changes the effective ref, it cancels pending sync/index tasks queued
+// against the old ref (they'd otherwise index a ref the repository no longer
+// tracks) and enqueues a fresh sync + index chain for the new one. The
+// transition surfaces through the normal status summary: it flips back to
+// in_progress once the new tasks are queued.
 func (s *Repository) UpdateTrackingConfig(ctx context.Context, id int64, params *TrackingConfigParams) (repository.Source, error) {
 	trackingConfig := repository.NewTrackingConfig(params.Branch, params.Tag, params.Commit)
 	repo, err := s.repoStore.FindOne(ctx, repository.WithID(id))
@@ -235,6 +253,7 @@ func (s *Repository) UpdateTrackingConfig(ctx context.Context, id int64, params
 		return repository.Source{}, fmt.Errorf("get repository: %w", err)
 	}
 
+	oldConfig := repo.TrackingConfig()
 	updatedRepo := repo.WithTrackingConfig(trackingConfig)
 
 	savedRepo, err := s.repoStore.Save(ctx, updatedRepo)
@@ -242,11 +261,41 @@ func (s *Repository) UpdateTrackingConfig(ctx context.Context, id int64, params
 		return repository.Source{}, fmt.Errorf("save repository: %w", err)
 	}
 
+	if !oldConfig.Equal(trackingConfig) {
+		if err := s.requeueForNewRef(ctx, id, oldConfig.Reference(), trackingConfig.Reference()); err != nil {
+			return repository.Source{}, err
+		}
+	}
+
 	s.logger.Info().Int64("repo_id", id).Str("tracking", trackingConfig.Reference()).Msg("tracking config updated")
 
 	return repository.NewSource(savedRepo), nil
 }
 
+// requeueForNewRef cancels pending tasks left over from the old ref and
+// queues the clone + sync chain needed to pick up the new one.
+func (s *Repository) requeueForNewRef(ctx context.Context, id int64, oldReference, newReference string) error {
+	cancelled, err := s.queue.PurgeBy(ctx, PurgeFilter{RepoID: &id})
+	if err != nil {
+		return fmt.Errorf("cancel pending tasks for old ref: %w", err)
+	}
+
+	payload := map[string]any{"repository_id": id}
+	operations := []task.Operation{task.OperationCloneRepository, task.OperationSyncRepository}
+	if err := s.queue.EnqueueOperations(ctx, operations, task.PriorityUserInitiated, payload); err != nil {
+		return fmt.Errorf("enqueue reindex for new ref: %w", err)
+	}
+
+	s.logger.Info().
+		Int64("repo_id", id).
+		Str("old_ref", oldReference).
+		Str("new_ref", newReference).
+		Int("cancelled_tasks", cancelled).
+		Msg("tracking ref changed, reindex queued")
+
+	return nil
+}
+
 // UpdateChunkingConfig updates a repository's chunking configuration.
 func (s *Repository) UpdateChunkingConfig(ctx context.Context, id int64, params *ChunkingConfigParams) (repository.Repository, error) {
 	cc, err := repository.NewChunkingConfig(params.Size, params.Overlap, params.MinSize)
@@ -271,6 +320,188 @@ func (s *Repository) UpdateChunkingConfig(ctx context.Context, id int64, params
 	return saved, nil
 }
 
+// UpdateEnrichmentBudget updates a repository's enrichment budget (the cap on
+// how many files are AI-summarized per commit).
+func (s *Repository) UpdateEnrichmentBudget(ctx context.Context, id int64, params *EnrichmentBudgetParams) (repository.Repository, error) {
+	budget, err := repository.NewEnrichmentBudgetConfig(params.MaxFileSummaries)
+	if err != nil {
+		return repository.Repository{}, fmt.Errorf("invalid enrichment budget: %w", err)
+	}
+
+	repo, err := s.repoStore.FindOne(ctx, repository.WithID(id))
+	if err != nil {
+		return repository.Repository{}, fmt.Errorf("get repository: %w", err)
+	}
+
+	updated := repo.WithEnrichmentBudget(budget)
+
+	saved, err := s.repoStore.Save(ctx, updated)
+	if err != nil {
+		return repository.Repository{}, fmt.Errorf("save repository: %w", err)
+	}
+
+	s.logger.Info().Int64("repo_id", id).Msg("enrichment budget updated")
+
+	return saved, nil
+}
+
+// UpdateEmbeddingConfig updates whether comments and docstrings are stripped
+// from a repository's chunk content before it is sent for embedding. The
+// displayed snippet content is unaffected.
+func (s *Repository) UpdateEmbeddingConfig(ctx context.Context, id int64, stripComments bool) (repository.Repository, error) {
+	repo, err := s.repoStore.FindOne(ctx, repository.WithID(id))
+	if err != nil {
+		return repository.Repository{}, fmt.Errorf("get repository: %w", err)
+	}
+
+	updated := repo.WithEmbeddingConfig(repository.NewEmbeddingConfig(stripComments))
+
+	saved, err := s.repoStore.Save(ctx, updated)
+	if err != nil {
+		return repository.Repository{}, fmt.Errorf("save repository: %w", err)
+	}
+
+	s.logger.Info().Int64("repo_id", id).Bool("strip_comments", stripComments).Msg("embedding config updated")
+
+	return saved, nil
+}
+
+// UpdateAccessConfig sets the deny globs that block file paths from being
+// read back through the blob API and MCP file resource, even though they
+// remain indexed and searchable.
+func (s *Repository) UpdateAccessConfig(ctx context.Context, id int64, denyGlobs []string) (repository.Repository, error) {
+	repo, err := s.repoStore.FindOne(ctx, repository.WithID(id))
+	if err != nil {
+		return repository.Repository{}, fmt.Errorf("get repository: %w", err)
+	}
+
+	updated := repo.WithAccessConfig(repository.NewAccessConfig(denyGlobs))
+
+	saved, err := s.repoStore.Save(ctx, updated)
+	if err != nil {
+		return repository.Repository{}, fmt.Errorf("save repository: %w", err)
+	}
+
+	s.logger.Info().Int64("repo_id", id).Int("deny_glob_count", len(denyGlobs)).Msg("access config updated")
+
+	return saved, nil
+}
+
+// UpdateIndexFilterConfig sets the index-path and ignore-path globs that
+// control which files are eligible for snippet extraction and enrichment.
+func (s *Repository) UpdateIndexFilterConfig(ctx context.Context, id int64, indexPaths, ignorePaths []string) (repository.Repository, error) {
+	repo, err := s.repoStore.FindOne(ctx, repository.WithID(id))
+	if err != nil {
+		return repository.Repository{}, fmt.Errorf("get repository: %w", err)
+	}
+
+	updated := repo.WithIndexFilterConfig(repository.NewIndexFilterConfig(indexPaths, ignorePaths))
+
+	saved, err := s.repoStore.Save(ctx, updated)
+	if err != nil {
+		return repository.Repository{}, fmt.Errorf("save repository: %w", err)
+	}
+
+	s.logger.Info().
+		Int64("repo_id", id).
+		Int("index_path_count", len(indexPaths)).
+		Int("ignore_path_count", len(ignorePaths)).
+		Msg("index filter config updated")
+
+	return saved, nil
+}
+
+// UpdateEnrichmentLanguage sets the human language enrichments should be
+// written in for a repository. Pass "" to fall back to automatic detection
+// from the repository's own comments and docs.
+func (s *Repository) UpdateEnrichmentLanguage(ctx context.Context, id int64, language string) (repository.Repository, error) {
+	repo, err := s.repoStore.FindOne(ctx, repository.WithID(id))
+	if err != nil {
+		return repository.Repository{}, fmt.Errorf("get repository: %w", err)
+	}
+
+	updated := repo.WithEnrichmentLanguage(language)
+
+	saved, err := s.repoStore.Save(ctx, updated)
+	if err != nil {
+		return repository.Repository{}, fmt.Errorf("save repository: %w", err)
+	}
+
+	s.logger.Info().Int64("repo_id", id).Str("enrichment_language", language).Msg("enrichment language updated")
+
+	return saved, nil
+}
+
+// UpdateAutoRepairTracking sets whether Sync should automatically update a
+// repository's tracking config when the remote's default branch changes out
+// from under an auto-detected branch. When disabled, a mismatch is only
+// logged, leaving the existing tracking config in place.
+func (s *Repository) UpdateAutoRepairTracking(ctx context.Context, id int64, enabled bool) (repository.Repository, error) {
+	repo, err := s.repoStore.FindOne(ctx, repository.WithID(id))
+	if err != nil {
+		return repository.Repository{}, fmt.Errorf("get repository: %w", err)
+	}
+
+	updated := repo.WithAutoRepairTracking(enabled)
+
+	saved, err := s.repoStore.Save(ctx, updated)
+	if err != nil {
+		return repository.Repository{}, fmt.Errorf("save repository: %w", err)
+	}
+
+	s.logger.Info().Int64("repo_id", id).Bool("auto_repair_tracking", enabled).Msg("auto-repair tracking updated")
+
+	return saved, nil
+}
+
+// Archive marks a repository as archived: periodic sync stops scheduling it
+// and manual syncs are rejected, but its existing snippets, embeddings, and
+// enrichments remain searchable. Any pending sync/index tasks for the
+// repository are cancelled so no new enrichment work starts after archiving.
+// Distinct from Delete, which removes the repository and all of its data.
+func (s *Repository) Archive(ctx context.Context, id int64) (repository.Repository, error) {
+	repo, err := s.repoStore.FindOne(ctx, repository.WithID(id))
+	if err != nil {
+		return repository.Repository{}, fmt.Errorf("get repository: %w", err)
+	}
+
+	updated := repo.WithArchived(true)
+
+	saved, err := s.repoStore.Save(ctx, updated)
+	if err != nil {
+		return repository.Repository{}, fmt.Errorf("save repository: %w", err)
+	}
+
+	cancelled, err := s.queue.PurgeBy(ctx, PurgeFilter{RepoID: &id})
+	if err != nil {
+		return repository.Repository{}, fmt.Errorf("cancel pending tasks: %w", err)
+	}
+
+	s.logger.Info().Int64("repo_id", id).Int("cancelled_tasks", cancelled).Msg("repository archived")
+
+	return saved, nil
+}
+
+// Unarchive clears a repository's archived state, allowing periodic sync and
+// enrichment to resume on its normal schedule.
+func (s *Repository) Unarchive(ctx context.Context, id int64) (repository.Repository, error) {
+	repo, err := s.repoStore.FindOne(ctx, repository.WithID(id))
+	if err != nil {
+		return repository.Repository{}, fmt.Errorf("get repository: %w", err)
+	}
+
+	updated := repo.WithArchived(false)
+
+	saved, err := s.repoStore.Save(ctx, updated)
+	if err != nil {
+		return repository.Repository{}, fmt.Errorf("save repository: %w", err)
+	}
+
+	s.logger.Info().Int64("repo_id", id).Msg("repository unarchived")
+
+	return saved, nil
+}
+
 // AssignPipeline links a pipeline to a repository.
 func (s *Repository) AssignPipeline(ctx context.Context, repoID, pipelineID int64) (repository.Source, error) {
 	repo, err := s.repoStore.FindOne(ctx, repository.WithID(repoID))
@@ -343,6 +574,15 @@ func (s *Repository) BranchesForRepository(ctx context.Context, repoID int64) ([
 	return branches, nil
 }
 
+// TagsForRepository returns all tags for a repository.
+func (s *Repository) TagsForRepository(ctx context.Context, repoID int64) ([]repository.Tag, error) {
+	tags, err := s.tagStore.Find(ctx, repository.WithRepoID(repoID))
+	if err != nil {
+		return nil, fmt.Errorf("find tags: %w", err)
+	}
+	return tags, nil
+}
+
 // BackfillDefaultPipeline assigns the default pipeline to any repositories
 // that have no pipeline assigned (pipelineID == 0).
 func (s *Repository) BackfillDefaultPipeline(ctx context.Context) error {
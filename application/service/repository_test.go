@@ -24,7 +24,7 @@ type repositoryTestDeps struct {
 func newRepositoryTestDeps(t *testing.T) repositoryTestDeps {
 	t.Helper()
 	stores := newTestStores(t)
-	queue := NewQueue(stores.tasks, zerolog.Nop())
+	queue := NewQueue(stores.tasks, stores.statuses, zerolog.Nop())
 
 	pipelineDB := testdb.New(t)
 	pipelineSvc := NewPipeline(
@@ -246,6 +246,72 @@ func TestRepository_Sync_NotFound(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestRepository_Sync_RejectsArchived(t *testing.T) {
+	deps := newRepositoryTestDeps(t)
+	ctx := context.Background()
+
+	repo, err := repository.NewRepository("https://github.com/test/repo")
+	require.NoError(t, err)
+	repo = repo.WithArchived(true)
+	saved, err := deps.stores.repos.Save(ctx, repo)
+	require.NoError(t, err)
+
+	err = deps.service.Sync(ctx, saved.ID())
+	assert.ErrorIs(t, err, ErrRepositoryArchived)
+	assert.Empty(t, savedTasks(t, deps))
+}
+
+func TestRepository_Archive_CancelsPendingTasksAndMarksArchived(t *testing.T) {
+	deps := newRepositoryTestDeps(t)
+	ctx := context.Background()
+
+	repo, err := repository.NewRepository("https://github.com/test/repo")
+	require.NoError(t, err)
+	saved, err := deps.stores.repos.Save(ctx, repo)
+	require.NoError(t, err)
+
+	// A pending sync left over from before archiving.
+	payload := map[string]any{"repository_id": saved.ID()}
+	_, err = deps.stores.tasks.Save(ctx, task.NewTask(task.OperationSyncRepository, int(task.PriorityUserInitiated), payload))
+	require.NoError(t, err)
+
+	archived, err := deps.service.Archive(ctx, saved.ID())
+	require.NoError(t, err)
+	assert.True(t, archived.Archived())
+
+	fetched, err := deps.stores.repos.FindOne(ctx, repository.WithID(saved.ID()))
+	require.NoError(t, err)
+	assert.True(t, fetched.Archived())
+
+	assert.Empty(t, savedTasks(t, deps), "pending tasks should be cancelled on archive")
+}
+
+func TestRepository_Archive_NotFound(t *testing.T) {
+	deps := newRepositoryTestDeps(t)
+	ctx := context.Background()
+
+	_, err := deps.service.Archive(ctx, 999)
+	assert.Error(t, err)
+}
+
+func TestRepository_Unarchive_ClearsArchivedState(t *testing.T) {
+	deps := newRepositoryTestDeps(t)
+	ctx := context.Background()
+
+	repo, err := repository.NewRepository("https://github.com/test/repo")
+	require.NoError(t, err)
+	repo = repo.WithArchived(true)
+	saved, err := deps.stores.repos.Save(ctx, repo)
+	require.NoError(t, err)
+
+	unarchived, err := deps.service.Unarchive(ctx, saved.ID())
+	require.NoError(t, err)
+	assert.False(t, unarchived.Archived())
+
+	err = deps.service.Sync(ctx, saved.ID())
+	require.NoError(t, err)
+}
+
 func TestRepository_Rescan_EnqueuesOperations(t *testing.T) {
 	deps := newRepositoryTestDeps(t)
 	ctx := context.Background()
@@ -346,6 +412,59 @@ func TestRepository_UpdateTrackingConfig(t *testing.T) {
 	assert.Equal(t, "v2.0", source.TrackingConfig().Tag())
 }
 
+func TestRepository_UpdateTrackingConfig_RequeuesOnRefChange(t *testing.T) {
+	deps := newRepositoryTestDeps(t)
+	ctx := context.Background()
+
+	repo, err := repository.NewRepository("https://github.com/test/repo")
+	require.NoError(t, err)
+	repo = repo.WithTrackingConfig(repository.NewTrackingConfigForBranch("main"))
+	saved, err := deps.stores.repos.Save(ctx, repo)
+	require.NoError(t, err)
+
+	// A stale task left over from tracking "main".
+	stalePayload := map[string]any{"repository_id": saved.ID()}
+	_, err = deps.stores.tasks.Save(ctx, task.NewTask(task.OperationSyncRepository, int(task.PriorityUserInitiated), stalePayload))
+	require.NoError(t, err)
+
+	_, err = deps.service.UpdateTrackingConfig(ctx, saved.ID(), &TrackingConfigParams{
+		Branch: "develop",
+	})
+	require.NoError(t, err)
+
+	tasks := savedTasks(t, deps)
+	require.NotEmpty(t, tasks)
+	for _, tsk := range tasks {
+		assert.Equal(t, saved.ID(), payloadRepoID(tsk.Payload()))
+	}
+
+	operations := make([]task.Operation, len(tasks))
+	for i, tsk := range tasks {
+		operations[i] = tsk.Operation()
+	}
+	assert.Contains(t, operations, task.OperationCloneRepository)
+	assert.Contains(t, operations, task.OperationSyncRepository)
+}
+
+func TestRepository_UpdateTrackingConfig_SameRefDoesNotRequeue(t *testing.T) {
+	deps := newRepositoryTestDeps(t)
+	ctx := context.Background()
+
+	repo, err := repository.NewRepository("https://github.com/test/repo")
+	require.NoError(t, err)
+	repo = repo.WithTrackingConfig(repository.NewTrackingConfigForBranch("main"))
+	saved, err := deps.stores.repos.Save(ctx, repo)
+	require.NoError(t, err)
+
+	_, err = deps.service.UpdateTrackingConfig(ctx, saved.ID(), &TrackingConfigParams{
+		Branch: "main",
+	})
+	require.NoError(t, err)
+
+	tasks := savedTasks(t, deps)
+	assert.Empty(t, tasks)
+}
+
 func TestRepository_UpdateTrackingConfig_NotFound(t *testing.T) {
 	deps := newRepositoryTestDeps(t)
 	ctx := context.Background()
@@ -467,6 +586,56 @@ func TestRepository_UpdateChunkingConfig_InvalidParams(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestRepository_UpdateEnrichmentBudget(t *testing.T) {
+	deps := newRepositoryTestDeps(t)
+	ctx := context.Background()
+
+	repo, err := repository.NewRepository("https://github.com/test/repo")
+	require.NoError(t, err)
+	saved, err := deps.stores.repos.Save(ctx, repo)
+	require.NoError(t, err)
+
+	// Verify default: unlimited
+	assert.True(t, saved.EnrichmentBudget().Unlimited())
+
+	updated, err := deps.service.UpdateEnrichmentBudget(ctx, saved.ID(), &EnrichmentBudgetParams{
+		MaxFileSummaries: 10,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 10, updated.EnrichmentBudget().MaxFileSummaries())
+	assert.False(t, updated.EnrichmentBudget().Unlimited())
+
+	// Verify persistence
+	fetched, err := deps.stores.repos.FindOne(ctx, repository.WithID(saved.ID()))
+	require.NoError(t, err)
+	assert.Equal(t, 10, fetched.EnrichmentBudget().MaxFileSummaries())
+}
+
+func TestRepository_UpdateEnrichmentBudget_NotFound(t *testing.T) {
+	deps := newRepositoryTestDeps(t)
+	ctx := context.Background()
+
+	_, err := deps.service.UpdateEnrichmentBudget(ctx, 999, &EnrichmentBudgetParams{
+		MaxFileSummaries: 10,
+	})
+	assert.Error(t, err)
+}
+
+func TestRepository_UpdateEnrichmentBudget_InvalidParams(t *testing.T) {
+	deps := newRepositoryTestDeps(t)
+	ctx := context.Background()
+
+	repo, err := repository.NewRepository("https://github.com/test/repo")
+	require.NoError(t, err)
+	saved, err := deps.stores.repos.Save(ctx, repo)
+	require.NoError(t, err)
+
+	_, err = deps.service.UpdateEnrichmentBudget(ctx, saved.ID(), &EnrichmentBudgetParams{
+		MaxFileSummaries: -1,
+	})
+	assert.Error(t, err)
+}
+
 func TestRepository_BranchesForRepository(t *testing.T) {
 	deps := newRepositoryTestDeps(t)
 	ctx := context.Background()
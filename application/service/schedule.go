@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/helixml/kodit/domain/repository"
+)
+
+// ScheduledSync predicts when a repository's next periodic sync will be
+// enqueued. This codebase expresses per-repository refresh policy purely
+// through the shared periodic sync interval — there is no separate
+// refresh-policy engine — so this also doubles as the "pending refresh
+// policy" view operators need.
+type ScheduledSync struct {
+	RepoID        int64
+	LastScannedAt time.Time
+	NextSyncAt    time.Time
+	Overdue       bool
+}
+
+// Schedule reports upcoming periodic work (repository syncs, compaction
+// sweeps) so operators can predict load and verify the scheduler's behavior
+// after config changes.
+type Schedule struct {
+	repositories repository.RepositoryStore
+	periodicSync *PeriodicSync
+	compaction   *PeriodicCompaction
+}
+
+// NewSchedule creates a new Schedule service.
+func NewSchedule(repositories repository.RepositoryStore, periodicSync *PeriodicSync, compaction *PeriodicCompaction) *Schedule {
+	return &Schedule{
+		repositories: repositories,
+		periodicSync: periodicSync,
+		compaction:   compaction,
+	}
+}
+
+// UpcomingSyncs returns the estimated next periodic sync time for every
+// tracked repository, most overdue first. Returns an empty slice if periodic
+// sync is disabled.
+func (s *Schedule) UpcomingSyncs(ctx context.Context) ([]ScheduledSync, error) {
+	if !s.periodicSync.Enabled() {
+		return nil, nil
+	}
+
+	repos, err := s.repositories.Find(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("find repositories: %w", err)
+	}
+
+	interval := s.periodicSync.Interval()
+	now := time.Now()
+
+	syncs := make([]ScheduledSync, len(repos))
+	for i, repo := range repos {
+		nextSyncAt := repo.LastScannedAt().Add(interval)
+		syncs[i] = ScheduledSync{
+			RepoID:        repo.ID(),
+			LastScannedAt: repo.LastScannedAt(),
+			NextSyncAt:    nextSyncAt,
+			Overdue:       nextSyncAt.Before(now),
+		}
+	}
+
+	sort.Slice(syncs, func(i, j int) bool { return syncs[i].NextSyncAt.Before(syncs[j].NextSyncAt) })
+
+	return syncs, nil
+}
+
+// NextCompactionAt returns the estimated time of the next janitor-style
+// compaction sweep, or the zero time if periodic compaction is disabled.
+func (s *Schedule) NextCompactionAt() time.Time {
+	if !s.compaction.Enabled() {
+		return time.Time{}
+	}
+	return s.compaction.NextRunAt()
+}
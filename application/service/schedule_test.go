@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/helixml/kodit/domain/repository"
+	"github.com/helixml/kodit/internal/config"
+)
+
+func TestSchedule_UpcomingSyncs(t *testing.T) {
+	stores := newTestStores(t)
+	ctx := context.Background()
+	logger := zerolog.Nop()
+
+	remote := "https://github.com/org/repo"
+	repo, err := repository.NewRepository(remote)
+	require.NoError(t, err)
+	repo = repo.WithWorkingCopy(repository.NewWorkingCopy("/tmp/r", remote))
+	repo = repo.WithLastScannedAt(time.Now().Add(-2 * time.Hour))
+	saved, err := stores.repos.Save(ctx, repo)
+	require.NoError(t, err)
+
+	queue := NewQueue(stores.tasks, stores.statuses, logger)
+	cfg := config.NewPeriodicSyncConfig().WithEnabled(true).WithIntervalSeconds(3600)
+	ps := NewPeriodicSync(cfg, stores.repos, queue, logger)
+	compaction := NewPeriodicCompaction(config.NewCompactionConfig().WithEnabled(false), queue, logger)
+
+	sched := NewSchedule(stores.repos, ps, compaction)
+
+	syncs, err := sched.UpcomingSyncs(ctx)
+	require.NoError(t, err)
+	require.Len(t, syncs, 1)
+
+	sync := syncs[0]
+	if sync.RepoID != saved.ID() {
+		t.Errorf("expected repo ID %d, got %d", saved.ID(), sync.RepoID)
+	}
+	if !sync.Overdue {
+		t.Error("expected sync to be overdue")
+	}
+}
+
+func TestSchedule_UpcomingSyncsDisabled(t *testing.T) {
+	stores := newTestStores(t)
+	ctx := context.Background()
+	logger := zerolog.Nop()
+
+	remote := "https://github.com/org/repo"
+	repo, err := repository.NewRepository(remote)
+	require.NoError(t, err)
+	_, err = stores.repos.Save(ctx, repo)
+	require.NoError(t, err)
+
+	queue := NewQueue(stores.tasks, stores.statuses, logger)
+	ps := NewPeriodicSync(config.NewPeriodicSyncConfig().WithEnabled(false), stores.repos, queue, logger)
+	compaction := NewPeriodicCompaction(config.NewCompactionConfig().WithEnabled(false), queue, logger)
+
+	sched := NewSchedule(stores.repos, ps, compaction)
+
+	syncs, err := sched.UpcomingSyncs(ctx)
+	require.NoError(t, err)
+	require.Empty(t, syncs)
+}
+
+func TestSchedule_NextCompactionAt(t *testing.T) {
+	stores := newTestStores(t)
+	logger := zerolog.Nop()
+	queue := NewQueue(stores.tasks, stores.statuses, logger)
+
+	ps := NewPeriodicSync(config.NewPeriodicSyncConfig().WithEnabled(false), stores.repos, queue, logger)
+	compaction := NewPeriodicCompaction(config.NewCompactionConfig().WithEnabled(true).WithCheckIntervalSeconds(60), queue, logger)
+	sched := NewSchedule(stores.repos, ps, compaction)
+
+	if !sched.NextCompactionAt().IsZero() {
+		t.Fatal("expected zero time before compaction starts")
+	}
+
+	compaction.Start(context.Background())
+	defer compaction.Stop()
+
+	if sched.NextCompactionAt().IsZero() {
+		t.Error("expected a non-zero next run time once compaction has started")
+	}
+}
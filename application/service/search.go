@@ -7,6 +7,7 @@ import (
 	"maps"
 	"sort"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/helixml/kodit/domain/enrichment"
 	"github.com/helixml/kodit/domain/repository"
 	"github.com/helixml/kodit/domain/search"
+	"github.com/helixml/kodit/domain/wiki"
 	"github.com/helixml/kodit/internal/config"
 )
 
@@ -25,15 +27,22 @@ type SearchOption func(*searchConfig)
 
 // searchConfig holds search parameters.
 type searchConfig struct {
-	semanticWeight   float64
-	limit            int
-	offset           int
-	languages        []string
-	repositories     []int64
-	enrichmentTypes  []string
-	minScore         float64
-	includeSnippets  bool
-	includeDocuments bool
+	semanticWeight      float64
+	semanticWeightSet   bool
+	autoWeight          bool
+	limit               int
+	offset              int
+	languages           []string
+	repositories        []int64
+	enrichmentTypes     []string
+	minScore            float64
+	includeSnippets     bool
+	includeDocuments    bool
+	excludeKeywords     []string
+	excludePathPrefixes []string
+	excludeRepositories []int64
+	namespace           string
+	preferSimple        bool
 }
 
 // newSearchConfig creates a searchConfig with defaults.
@@ -43,15 +52,27 @@ func newSearchConfig() *searchConfig {
 	}
 }
 
-// WithSemanticWeight sets the weight for semantic (vector) search (0-1).
+// WithSemanticWeight sets the weight for semantic (vector) search (0-1),
+// with keyword (BM25) search sharing the remainder.
 func WithSemanticWeight(w float64) SearchOption {
 	return func(c *searchConfig) {
 		if w >= 0 && w <= 1 {
 			c.semanticWeight = w
+			c.semanticWeightSet = true
+			c.autoWeight = false
 		}
 	}
 }
 
+// WithAutoWeight enables automatic semantic/keyword weighting, inferred
+// from the shape of the query text, instead of a fixed WithSemanticWeight.
+func WithAutoWeight() SearchOption {
+	return func(c *searchConfig) {
+		c.autoWeight = true
+		c.semanticWeightSet = false
+	}
+}
+
 // WithLimit sets the maximum number of results.
 func WithLimit(n int) SearchOption {
 	return func(c *searchConfig) {
@@ -100,6 +121,39 @@ func WithMinScore(score float64) SearchOption {
 	}
 }
 
+// WithExcludeKeywords drops results whose matched content contains any of
+// the given terms — e.g. excluding "retry logic" matches from tests or
+// vendored code that would otherwise crowd out the real implementation.
+func WithExcludeKeywords(keywords ...string) SearchOption {
+	return func(c *searchConfig) {
+		c.excludeKeywords = keywords
+	}
+}
+
+// WithExcludePathPrefixes drops results whose file path starts with any of
+// the given prefixes, e.g. "vendor/" or "test/".
+func WithExcludePathPrefixes(prefixes ...string) SearchOption {
+	return func(c *searchConfig) {
+		c.excludePathPrefixes = prefixes
+	}
+}
+
+// WithExcludeRepositories drops results sourced from any of the given
+// repository IDs.
+func WithExcludeRepositories(ids ...int64) SearchOption {
+	return func(c *searchConfig) {
+		c.excludeRepositories = ids
+	}
+}
+
+// WithNamespace selects which synonym dictionary expands the query and
+// keywords before searching. "" (the default) disables expansion.
+func WithNamespace(namespace string) SearchOption {
+	return func(c *searchConfig) {
+		c.namespace = namespace
+	}
+}
+
 // WithSnippets includes code snippets in search results.
 func WithSnippets(include bool) SearchOption {
 	return func(c *searchConfig) {
@@ -114,6 +168,15 @@ func WithDocuments(include bool) SearchOption {
 	}
 }
 
+// WithPreferSimple boosts simpler snippets — those with lower cyclomatic
+// complexity — towards the top of the results, all else being equal. It has
+// no effect on enrichments without computed SnippetMetrics.
+func WithPreferSimple() SearchOption {
+	return func(c *searchConfig) {
+		c.preferSimple = true
+	}
+}
+
 // SearchResult represents the result of a hybrid search.
 type SearchResult struct {
 	enrichments []enrichment.Enrichment
@@ -143,9 +206,11 @@ func (r SearchResult) Count() int {
 
 // MultiSearchResult represents the result of a multi-modal search.
 type MultiSearchResult struct {
-	enrichments    []enrichment.Enrichment
-	fusedScores    map[string]float64
-	originalScores map[string][]float64
+	enrichments       []enrichment.Enrichment
+	fusedScores       map[string]float64
+	originalScores    map[string][]float64
+	semanticWeight    float64
+	hasResolvedWeight bool
 }
 
 // NewMultiSearchResult creates a new MultiSearchResult.
@@ -204,6 +269,21 @@ func (r MultiSearchResult) Count() int {
 	return len(r.enrichments)
 }
 
+// WithResolvedWeight attaches the semantic fusion weight actually used to
+// produce this result, so a caller (e.g. an API handler) can report it back
+// for transparency. weight is the semantic share of fusion weight, in [0,1].
+func (r MultiSearchResult) WithResolvedWeight(weight float64) MultiSearchResult {
+	r.semanticWeight = weight
+	r.hasResolvedWeight = true
+	return r
+}
+
+// ResolvedSemanticWeight returns the semantic fusion weight actually used
+// and true, or (0, false) if the search used the default unweighted fusion.
+func (r MultiSearchResult) ResolvedSemanticWeight() (weight float64, ok bool) {
+	return r.semanticWeight, r.hasResolvedWeight
+}
+
 // Search orchestrates hybrid code search across text and code vector indexes.
 type Search struct {
 	embedder          search.Embedder
@@ -214,11 +294,15 @@ type Search struct {
 	visionVectorStore search.Store
 	enrichmentStore   enrichment.EnrichmentStore
 	fusion            search.Fusion
+	synonyms          *Synonym
+	curation          *Curation
 	closed            *atomic.Bool
 	logger            zerolog.Logger
 }
 
-// NewSearch creates a new Search service.
+// NewSearch creates a new Search service. synonyms may be nil, in which
+// case query expansion is skipped regardless of MultiRequest.Namespace.
+// curation may be nil, in which case fused results are returned unadjusted.
 func NewSearch(
 	embedder search.Embedder,
 	textVectorStore search.Store,
@@ -227,6 +311,8 @@ func NewSearch(
 	visionEmbedder search.Embedder,
 	visionVectorStore search.Store,
 	enrichmentStore enrichment.EnrichmentStore,
+	synonyms *Synonym,
+	curation *Curation,
 	closed *atomic.Bool,
 	logger zerolog.Logger,
 ) *Search {
@@ -239,6 +325,8 @@ func NewSearch(
 		visionVectorStore: visionVectorStore,
 		enrichmentStore:   enrichmentStore,
 		fusion:            search.NewFusion(),
+		synonyms:          synonyms,
+		curation:          curation,
 		closed:            closed,
 		logger:            logger,
 	}
@@ -264,34 +352,102 @@ func (s Search) Query(ctx context.Context, query string, opts ...SearchOption) (
 	if len(searchCfg.languages) > 0 {
 		filterOpts = append(filterOpts, search.WithLanguages(searchCfg.languages))
 	}
+	if len(searchCfg.excludeKeywords) > 0 {
+		filterOpts = append(filterOpts, search.WithExcludeKeywords(searchCfg.excludeKeywords))
+	}
+	if len(searchCfg.excludePathPrefixes) > 0 {
+		filterOpts = append(filterOpts, search.WithExcludePathPrefixes(searchCfg.excludePathPrefixes))
+	}
+	if len(searchCfg.excludeRepositories) > 0 {
+		filterOpts = append(filterOpts, search.WithExcludeRepoIDs(searchCfg.excludeRepositories))
+	}
 	filters := search.NewFilters(filterOpts...)
 
-	request := search.NewMultiRequest(searchCfg.limit, query, query, nil, filters)
+	var requestOpts []search.MultiRequestOption
+	if searchCfg.semanticWeightSet {
+		requestOpts = append(requestOpts, search.WithSemanticWeight(searchCfg.semanticWeight))
+	} else if searchCfg.autoWeight {
+		requestOpts = append(requestOpts, search.WithAutoWeight())
+	}
+
+	request := search.NewMultiRequest(searchCfg.limit, query, query, nil, filters, searchCfg.namespace, requestOpts...)
 
 	result, err := s.Search(ctx, request)
 	if err != nil {
 		return SearchResult{}, err
 	}
 
+	enrichments := result.Enrichments()
+	scores := result.FusedScores()
+	if searchCfg.preferSimple {
+		scores = boostSimpleSnippets(enrichments, scores)
+		enrichments = orderByScore(enrichments, scores)
+	}
+
 	return SearchResult{
-		enrichments: result.Enrichments(),
-		scores:      result.FusedScores(),
+		enrichments: enrichments,
+		scores:      scores,
 	}, nil
 }
 
 // Search performs a hybrid search combining text and code vector search results.
 func (s Search) Search(ctx context.Context, request search.MultiRequest) (MultiSearchResult, error) {
+	return s.search(ctx, request, nil)
+}
+
+// Debug performs the same hybrid search as Search, but additionally records
+// a step-by-step SearchTrace of query expansion, candidate retrieval,
+// fusion, reranking, and the final ordering — so relevance engineers can see
+// exactly where a desired result drops out of the pipeline.
+func (s Search) Debug(ctx context.Context, request search.MultiRequest) (SearchTrace, MultiSearchResult, error) {
+	recorder := newSearchTraceRecorder()
+	result, err := s.search(ctx, request, recorder)
+	return recorder.build(), result, err
+}
+
+// search is the shared implementation behind Search and Debug. trace is nil
+// for ordinary searches; when non-nil, each pipeline stage records its
+// outcome to it.
+func (s Search) search(ctx context.Context, request search.MultiRequest, trace *searchTraceRecorder) (MultiSearchResult, error) {
 	textQuery := request.TextQuery()
 	codeQuery := request.CodeQuery()
+	keywords := request.Keywords()
 	topK := request.TopK()
 
 	if topK <= 0 {
 		topK = 10
 	}
 
+	origTextQuery, origCodeQuery, origKeywords := textQuery, codeQuery, keywords
+	if s.synonyms != nil && request.Namespace() != "" {
+		var err error
+		if textQuery != "" {
+			if textQuery, err = s.synonyms.ExpandQuery(ctx, request.Namespace(), textQuery); err != nil {
+				return MultiSearchResult{}, fmt.Errorf("expand text query: %w", err)
+			}
+		}
+		if codeQuery != "" {
+			if codeQuery, err = s.synonyms.ExpandQuery(ctx, request.Namespace(), codeQuery); err != nil {
+				return MultiSearchResult{}, fmt.Errorf("expand code query: %w", err)
+			}
+		}
+		if keywords, err = s.synonyms.ExpandKeywords(ctx, request.Namespace(), keywords); err != nil {
+			return MultiSearchResult{}, fmt.Errorf("expand keywords: %w", err)
+		}
+	}
+	if trace != nil {
+		description := fmt.Sprintf("text %q -> %q, code %q -> %q, keywords %v -> %v",
+			origTextQuery, textQuery, origCodeQuery, codeQuery, origKeywords, keywords)
+		trace.record(StageQueryExpansion, description, nil)
+	}
+
 	filterOpt := search.WithFilters(request.Filters())
+	if trace != nil {
+		trace.record(StageFiltersApplied, describeFilters(request.Filters()), nil)
+	}
 
-	var fusionLists [][]search.FusionRequest
+	var semanticLists [][]search.FusionRequest
+	var keywordLists [][]search.FusionRequest
 
 	// Embed queries for vector search
 	var textEmbedding, codeEmbedding []float64
@@ -343,7 +499,7 @@ func (s Search) Search(ctx context.Context, request search.MultiRequest) (MultiS
 			}
 			if len(results) > 0 {
 				mu.Lock()
-				fusionLists = append(fusionLists, toFusionRequests(results))
+				semanticLists = append(semanticLists, toFusionRequests(results))
 				mu.Unlock()
 			}
 			return nil
@@ -362,7 +518,7 @@ func (s Search) Search(ctx context.Context, request search.MultiRequest) (MultiS
 			}
 			if len(results) > 0 {
 				mu.Lock()
-				fusionLists = append(fusionLists, toFusionRequests(results))
+				semanticLists = append(semanticLists, toFusionRequests(results))
 				mu.Unlock()
 			}
 			return nil
@@ -370,7 +526,6 @@ func (s Search) Search(ctx context.Context, request search.MultiRequest) (MultiS
 	}
 
 	// Each keyword becomes a separate fusion list for proper RRF scoring
-	keywords := request.Keywords()
 	if s.bm25Store != nil {
 		for _, keyword := range keywords {
 			g.Go(func() error {
@@ -384,7 +539,7 @@ func (s Search) Search(ctx context.Context, request search.MultiRequest) (MultiS
 				}
 				if len(results) > 0 {
 					mu.Lock()
-					fusionLists = append(fusionLists, toFusionRequests(results))
+					keywordLists = append(keywordLists, toFusionRequests(results))
 					mu.Unlock()
 				}
 				return nil
@@ -396,12 +551,59 @@ func (s Search) Search(ctx context.Context, request search.MultiRequest) (MultiS
 		return MultiSearchResult{}, err
 	}
 
-	if len(fusionLists) == 0 {
+	if trace != nil {
+		trace.record(StageBM25Candidates, fmt.Sprintf("%d BM25 keyword searches", len(keywordLists)), fusionRequestIDs(keywordLists))
+		trace.record(StageVectorCandidates, fmt.Sprintf("%d vector searches", len(semanticLists)), fusionRequestIDs(semanticLists))
+	}
+
+	if len(semanticLists) == 0 && len(keywordLists) == 0 {
 		return NewMultiSearchResult(nil, nil, nil), nil
 	}
 
-	// Fuse all result lists together
-	fusedResults := s.fusion.FuseTopK(topK, fusionLists...)
+	// Fuse all result lists together, weighting semantic (vector) lists
+	// against keyword (BM25) lists when the request asks for it.
+	resolvedWeight, weighted := resolveSemanticWeight(request, textQuery, codeQuery, keywords)
+	var fusedResults []search.FusionResult
+	if weighted && len(semanticLists) > 0 && len(keywordLists) > 0 {
+		lists := make([][]search.FusionRequest, 0, len(semanticLists)+len(keywordLists))
+		weights := make([]float64, 0, len(semanticLists)+len(keywordLists))
+		semanticShare := resolvedWeight / float64(len(semanticLists))
+		keywordShare := (1 - resolvedWeight) / float64(len(keywordLists))
+		for _, list := range semanticLists {
+			lists = append(lists, list)
+			weights = append(weights, semanticShare)
+		}
+		for _, list := range keywordLists {
+			lists = append(lists, list)
+			weights = append(weights, keywordShare)
+		}
+		fusedResults = s.fusion.FuseWeightedTopK(topK, weights, lists...)
+	} else {
+		lists := make([][]search.FusionRequest, 0, len(semanticLists)+len(keywordLists))
+		lists = append(lists, semanticLists...)
+		lists = append(lists, keywordLists...)
+		fusedResults = s.fusion.FuseTopK(topK, lists...)
+	}
+
+	if trace != nil {
+		trace.record(StageFusion, "reciprocal rank fusion of BM25 and vector candidates", fusionResultIDs(fusedResults))
+	}
+
+	if s.curation != nil {
+		queryText := strings.TrimSpace(strings.Join(append([]string{textQuery, codeQuery}, keywords...), " "))
+		curated, err := s.curation.Apply(ctx, queryText, fusedResults)
+		if err != nil {
+			return MultiSearchResult{}, fmt.Errorf("apply curation rules: %w", err)
+		}
+		fusedResults = curated
+	}
+	if trace != nil {
+		description := "no curation rules configured"
+		if s.curation != nil {
+			description = "curation rules applied"
+		}
+		trace.record(StageRerank, description, fusionResultIDs(fusedResults))
+	}
 
 	// Extract enrichment IDs and scores from fused results
 	fusedScores := make(map[string]float64, len(fusedResults))
@@ -431,7 +633,35 @@ func (s Search) Search(ctx context.Context, request search.MultiRequest) (MultiS
 	// Order enrichments by fused score
 	ordered := orderByScore(enrichments, fusedScores)
 
-	return NewMultiSearchResult(ordered, fusedScores, originalScores), nil
+	if trace != nil {
+		finalIDs := make([]string, len(ordered))
+		for i, e := range ordered {
+			finalIDs[i] = strconv.FormatInt(e.ID(), 10)
+		}
+		trace.record(StageFinalOrder, fmt.Sprintf("%d results after resolving enrichments", len(finalIDs)), finalIDs)
+	}
+
+	result := NewMultiSearchResult(ordered, fusedScores, originalScores)
+	if weighted {
+		result = result.WithResolvedWeight(resolvedWeight)
+	}
+	return result, nil
+}
+
+// resolveSemanticWeight determines the semantic share of fusion weight for
+// a search request: a manually-set MultiRequest.SemanticWeight takes
+// priority, then MultiRequest.AutoWeight infers one from the shape of the
+// combined query text, otherwise weighting is left off entirely (ok=false)
+// and fusion falls back to its default unweighted RRF.
+func resolveSemanticWeight(request search.MultiRequest, textQuery, codeQuery string, keywords []string) (weight float64, ok bool) {
+	if w, ok := request.SemanticWeight(); ok {
+		return w, true
+	}
+	if !request.AutoWeight() {
+		return 0, false
+	}
+	queryText := strings.TrimSpace(strings.Join(append([]string{textQuery, codeQuery}, keywords...), " "))
+	return search.InferSemanticWeight(queryText), true
 }
 
 // SearchText performs text vector search against enrichment summaries.
@@ -606,6 +836,53 @@ func (s Search) SearchKeywordsWithScores(ctx context.Context, query string, limi
 	return ordered, scores, nil
 }
 
+// WikiPageMatch represents a single wiki page returned by SearchWiki,
+// carrying enough identity (slug) for a caller to fetch the full page
+// through the existing per-page wiki lookup.
+type WikiPageMatch struct {
+	Slug    string
+	Title   string
+	Content string
+	Score   float64
+}
+
+// SearchWiki performs a hybrid (text vector + BM25 keyword) search over a
+// repository's indexed wiki pages and returns the best-matching pages.
+func (s Search) SearchWiki(ctx context.Context, repoID int64, query string, limit int) ([]WikiPageMatch, error) {
+	if limit <= 0 {
+		limit = 10
+	}
+
+	filters := search.NewFilters(
+		search.WithSourceRepos([]int64{repoID}),
+		search.WithEnrichmentSubtypes([]string{string(enrichment.SubtypeWikiPage)}),
+	)
+	request := search.NewMultiRequest(limit, query, "", []string{query}, filters, "")
+
+	result, err := s.Search(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("search wiki pages: %w", err)
+	}
+
+	scores := result.FusedScores()
+	matches := make([]WikiPageMatch, 0, result.Count())
+	for _, e := range result.Enrichments() {
+		page, err := wiki.ParsePageDocument(e.Content())
+		if err != nil {
+			s.logger.Warn().Int64("enrichment_id", e.ID()).Str("error", err.Error()).Msg("failed to parse wiki page document")
+			continue
+		}
+		matches = append(matches, WikiPageMatch{
+			Slug:    page.Slug(),
+			Title:   page.Title(),
+			Content: page.Content(),
+			Score:   scores[strconv.FormatInt(e.ID(), 10)],
+		})
+	}
+
+	return matches, nil
+}
+
 // SearchVisualWithScores performs cross-modal visual search: it embeds the text
 // query using the vision model's text encoder and searches the vision embedding
 // store, returning page-image enrichments with their similarity scores.
@@ -685,3 +962,27 @@ func orderByScore(enrichments []enrichment.Enrichment, scores map[string]float64
 
 	return result
 }
+
+// simplicityBoostWeight is the maximum fractional score increase applied to
+// the least complex snippet, decaying towards zero as complexity grows.
+const simplicityBoostWeight = 0.15
+
+// boostSimpleSnippets nudges fused scores upward for snippets with lower
+// cyclomatic complexity, used by WithPreferSimple to favor easier-to-read
+// examples when several results are otherwise close in relevance.
+// Enrichments without computed metrics are left unboosted.
+func boostSimpleSnippets(enrichments []enrichment.Enrichment, scores map[string]float64) map[string]float64 {
+	boosted := make(map[string]float64, len(scores))
+	maps.Copy(boosted, scores)
+
+	for _, e := range enrichments {
+		metrics := e.Metrics()
+		if metrics.IsZero() {
+			continue
+		}
+		key := strconv.FormatInt(e.ID(), 10)
+		boosted[key] *= 1 + simplicityBoostWeight/float64(1+metrics.CyclomaticComplexity())
+	}
+
+	return boosted
+}
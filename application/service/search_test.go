@@ -116,10 +116,12 @@ func TestSearch_EmbeddingFailure_ReturnsError(t *testing.T) {
 		nil,
 		stores.enrichments,
 		nil,
+		nil,
+		nil,
 		zerolog.Nop(),
 	)
 
-	req := search.NewMultiRequest(10, "test query", "test query", nil, search.NewFilters())
+	req := search.NewMultiRequest(10, "test query", "test query", nil, search.NewFilters(), "")
 	_, err := svc.Search(context.Background(), req)
 
 	if err == nil {
@@ -151,9 +153,9 @@ func TestSearch_KeywordsProduceSeparateFusionLists(t *testing.T) {
 		},
 	}
 
-	svc := NewSearch(nil, nil, nil, bm25, nil, nil, stores.enrichments, nil, zerolog.Nop())
+	svc := NewSearch(nil, nil, nil, bm25, nil, nil, stores.enrichments, nil, nil, nil, zerolog.Nop())
 
-	req := search.NewMultiRequest(10, "", "", []string{"auth", "login"}, search.NewFilters())
+	req := search.NewMultiRequest(10, "", "", []string{"auth", "login"}, search.NewFilters(), "")
 	result, err := svc.Search(context.Background(), req)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
@@ -183,10 +185,12 @@ func TestSearch_TextVectorFailure_ReturnsError(t *testing.T) {
 		nil,
 		stores.enrichments,
 		nil,
+		nil,
+		nil,
 		zerolog.Nop(),
 	)
 
-	req := search.NewMultiRequest(10, "test", "test", nil, search.NewFilters())
+	req := search.NewMultiRequest(10, "test", "test", nil, search.NewFilters(), "")
 	_, err := svc.Search(context.Background(), req)
 
 	if err == nil {
@@ -209,10 +213,12 @@ func TestSearch_BM25Failure_ReturnsError(t *testing.T) {
 		nil,
 		stores.enrichments,
 		nil,
+		nil,
+		nil,
 		zerolog.Nop(),
 	)
 
-	req := search.NewMultiRequest(10, "", "", []string{"test"}, search.NewFilters())
+	req := search.NewMultiRequest(10, "", "", []string{"test"}, search.NewFilters(), "")
 	_, err := svc.Search(context.Background(), req)
 
 	if err == nil {
@@ -225,9 +231,9 @@ func TestSearch_BM25Failure_ReturnsError(t *testing.T) {
 
 func TestSearch_NoStoresConfigured_ReturnsEmpty(t *testing.T) {
 	stores := newTestStores(t)
-	svc := NewSearch(nil, nil, nil, nil, nil, nil, stores.enrichments, nil, zerolog.Nop())
+	svc := NewSearch(nil, nil, nil, nil, nil, nil, stores.enrichments, nil, nil, nil, zerolog.Nop())
 
-	req := search.NewMultiRequest(10, "test", "test", []string{"keyword"}, search.NewFilters())
+	req := search.NewMultiRequest(10, "test", "test", []string{"keyword"}, search.NewFilters(), "")
 	result, err := svc.Search(context.Background(), req)
 
 	if err != nil {
@@ -263,6 +269,8 @@ func TestSearchCodeWithScores_OrdersByScoreDescending(t *testing.T) {
 		nil,
 		stores.enrichments,
 		nil,
+		nil,
+		nil,
 		zerolog.Nop(),
 	)
 
@@ -303,7 +311,7 @@ func TestSearchKeywordsWithScores_ReturnsResults(t *testing.T) {
 		},
 	}
 
-	svc := NewSearch(nil, nil, nil, bm25, nil, nil, stores.enrichments, nil, zerolog.Nop())
+	svc := NewSearch(nil, nil, nil, bm25, nil, nil, stores.enrichments, nil, nil, nil, zerolog.Nop())
 
 	results, scores, err := svc.SearchKeywordsWithScores(context.Background(), "auth", 10, search.NewFilters())
 	if err != nil {
@@ -332,7 +340,7 @@ func TestSearchKeywordsWithScores_ReturnsResults(t *testing.T) {
 
 func TestSearchKeywordsWithScores_NilStore(t *testing.T) {
 	stores := newTestStores(t)
-	svc := NewSearch(nil, nil, nil, nil, nil, nil, stores.enrichments, nil, zerolog.Nop())
+	svc := NewSearch(nil, nil, nil, nil, nil, nil, stores.enrichments, nil, nil, nil, zerolog.Nop())
 
 	results, scores, err := svc.SearchKeywordsWithScores(context.Background(), "auth", 10, search.NewFilters())
 	if err != nil {
@@ -352,7 +360,7 @@ func TestSearchKeywordsWithScores_NoResults(t *testing.T) {
 		resultsByKeyword: map[string][]search.Result{},
 	}
 
-	svc := NewSearch(nil, nil, nil, bm25, nil, nil, stores.enrichments, nil, zerolog.Nop())
+	svc := NewSearch(nil, nil, nil, bm25, nil, nil, stores.enrichments, nil, nil, nil, zerolog.Nop())
 
 	results, scores, err := svc.SearchKeywordsWithScores(context.Background(), "nonexistent", 10, search.NewFilters())
 	if err != nil {
@@ -394,6 +402,8 @@ func TestSearch_Query_ReturnsResults(t *testing.T) {
 		nil,
 		stores.enrichments,
 		nil,
+		nil,
+		nil,
 		zerolog.Nop(),
 	)
 
@@ -413,7 +423,7 @@ func TestSearch_Query_ReturnsResults(t *testing.T) {
 
 func TestSearch_Query_NilStores(t *testing.T) {
 	stores := newTestStores(t)
-	svc := NewSearch(nil, nil, nil, nil, nil, nil, stores.enrichments, nil, zerolog.Nop())
+	svc := NewSearch(nil, nil, nil, nil, nil, nil, stores.enrichments, nil, nil, nil, zerolog.Nop())
 
 	result, err := svc.Query(context.Background(), "test query")
 	if err != nil {
@@ -449,6 +459,8 @@ func TestSearch_SearchText_ReturnsEnrichments(t *testing.T) {
 		nil,
 		stores.enrichments,
 		nil,
+		nil,
+		nil,
 		zerolog.Nop(),
 	)
 
@@ -472,6 +484,8 @@ func TestSearch_SearchText_NilStore(t *testing.T) {
 		nil,
 		stores.enrichments,
 		nil,
+		nil,
+		nil,
 		zerolog.Nop(),
 	)
 
@@ -496,6 +510,8 @@ func TestSearch_SearchText_EmbedError(t *testing.T) {
 		nil,
 		stores.enrichments,
 		nil,
+		nil,
+		nil,
 		zerolog.Nop(),
 	)
 
@@ -533,6 +549,8 @@ func TestSearch_SearchCode_ReturnsEnrichments(t *testing.T) {
 		nil,
 		stores.enrichments,
 		nil,
+		nil,
+		nil,
 		zerolog.Nop(),
 	)
 
@@ -556,6 +574,8 @@ func TestSearch_SearchCode_NilStore(t *testing.T) {
 		nil,
 		stores.enrichments,
 		nil,
+		nil,
+		nil,
 		zerolog.Nop(),
 	)
 
@@ -580,6 +600,8 @@ func TestSearch_SearchCode_EmbedError(t *testing.T) {
 		nil,
 		stores.enrichments,
 		nil,
+		nil,
+		nil,
 		zerolog.Nop(),
 	)
 
@@ -595,9 +617,15 @@ func TestSearch_SearchCode_EmbedError(t *testing.T) {
 func TestOrderByScore(t *testing.T) {
 	now := time.Now()
 	enrichments := []enrichment.Enrichment{
-		enrichment.ReconstructEnrichment(1, enrichment.TypeDevelopment, enrichment.SubtypeSnippet, enrichment.EntityTypeSnippet, "low", ".go", now, now),
-		enrichment.ReconstructEnrichment(2, enrichment.TypeDevelopment, enrichment.SubtypeSnippet, enrichment.EntityTypeSnippet, "high", ".go", now, now),
-		enrichment.ReconstructEnrichment(3, enrichment.TypeDevelopment, enrichment.SubtypeSnippet, enrichment.EntityTypeSnippet, "mid", ".go", now, now),
+		enrichment.ReconstructEnrichment(1, enrichment.TypeDevelopment, enrichment.SubtypeSnippet, enrichment.EntityTypeSnippet, "low", ".go",
+			false,
+			"", enrichment.SnippetMetrics{}, now, now),
+		enrichment.ReconstructEnrichment(2, enrichment.TypeDevelopment, enrichment.SubtypeSnippet, enrichment.EntityTypeSnippet, "high", ".go",
+			false,
+			"", enrichment.SnippetMetrics{}, now, now),
+		enrichment.ReconstructEnrichment(3, enrichment.TypeDevelopment, enrichment.SubtypeSnippet, enrichment.EntityTypeSnippet, "mid", ".go",
+			false,
+			"", enrichment.SnippetMetrics{}, now, now),
 	}
 
 	scores := map[string]float64{
@@ -621,3 +649,182 @@ func TestOrderByScore(t *testing.T) {
 		t.Errorf("expected third result ID=1, got %d", ordered[2].ID())
 	}
 }
+
+func TestSearch_SemanticWeight_BiasesFusionTowardVector(t *testing.T) {
+	stores := newTestStores(t)
+	enrichments := seedEnrichments(t, stores, []string{"vector only", "keyword only"})
+
+	vectorID := strconv.FormatInt(enrichments[0].ID(), 10)
+	keywordID := strconv.FormatInt(enrichments[1].ID(), 10)
+
+	textVectorStore := fakeEmbeddingStore{
+		results: []search.Result{search.NewResult(vectorID, 0.9)},
+	}
+	bm25 := fakeBM25Store{
+		resultsByKeyword: map[string][]search.Result{
+			"term": {search.NewResult(keywordID, 0.9)},
+		},
+	}
+	svc := NewSearch(
+		fakeEmbedder{vectors: [][]float64{{0.1, 0.2, 0.3}}},
+		textVectorStore,
+		nil,
+		bm25,
+		nil,
+		nil,
+		stores.enrichments,
+		nil,
+		nil,
+		nil,
+		zerolog.Nop(),
+	)
+
+	semanticHeavy := search.NewMultiRequest(10, "query", "", []string{"term"}, search.NewFilters(), "", search.WithSemanticWeight(0.9))
+	result, err := svc.Search(context.Background(), semanticHeavy)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	scores := result.FusedScores()
+	if scores[vectorID] <= scores[keywordID] {
+		t.Errorf("expected vector-only result to outscore keyword-only result with semantic weight 0.9, got vector=%f keyword=%f", scores[vectorID], scores[keywordID])
+	}
+	if weight, ok := result.ResolvedSemanticWeight(); !ok || weight != 0.9 {
+		t.Errorf("expected resolved semantic weight 0.9, got %f (ok=%v)", weight, ok)
+	}
+
+	keywordHeavy := search.NewMultiRequest(10, "query", "", []string{"term"}, search.NewFilters(), "", search.WithSemanticWeight(0.1))
+	result, err = svc.Search(context.Background(), keywordHeavy)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	scores = result.FusedScores()
+	if scores[keywordID] <= scores[vectorID] {
+		t.Errorf("expected keyword-only result to outscore vector-only result with semantic weight 0.1, got vector=%f keyword=%f", scores[vectorID], scores[keywordID])
+	}
+}
+
+func TestSearch_AutoWeight_InfersFromQueryShape(t *testing.T) {
+	stores := newTestStores(t)
+	enrichments := seedEnrichments(t, stores, []string{"vector only", "keyword only"})
+
+	vectorID := strconv.FormatInt(enrichments[0].ID(), 10)
+	keywordID := strconv.FormatInt(enrichments[1].ID(), 10)
+
+	textVectorStore := fakeEmbeddingStore{
+		results: []search.Result{search.NewResult(vectorID, 0.9)},
+	}
+	bm25 := fakeBM25Store{
+		resultsByKeyword: map[string][]search.Result{
+			"term": {search.NewResult(keywordID, 0.9)},
+		},
+	}
+	svc := NewSearch(
+		fakeEmbedder{vectors: [][]float64{{0.1, 0.2, 0.3}}},
+		textVectorStore,
+		nil,
+		bm25,
+		nil,
+		nil,
+		stores.enrichments,
+		nil,
+		nil,
+		nil,
+		zerolog.Nop(),
+	)
+
+	naturalLanguage := search.NewMultiRequest(10, "how do I configure the login flow", "", []string{"term"}, search.NewFilters(), "", search.WithAutoWeight())
+	result, err := svc.Search(context.Background(), naturalLanguage)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := result.ResolvedSemanticWeight(); !ok {
+		t.Fatal("expected a resolved semantic weight when auto weight is enabled")
+	}
+	scores := result.FusedScores()
+	if scores[vectorID] <= scores[keywordID] {
+		t.Errorf("expected natural-language query to favor the vector result, got vector=%f keyword=%f", scores[vectorID], scores[keywordID])
+	}
+}
+
+func TestSearch_Debug_RecordsPipelineStages(t *testing.T) {
+	stores := newTestStores(t)
+	enrichments := seedEnrichments(t, stores, []string{"code a", "code b"})
+
+	id1 := strconv.FormatInt(enrichments[0].ID(), 10)
+	id2 := strconv.FormatInt(enrichments[1].ID(), 10)
+
+	textVectorStore := fakeEmbeddingStore{
+		results: []search.Result{search.NewResult(id1, 0.9)},
+	}
+	bm25 := fakeBM25Store{
+		resultsByKeyword: map[string][]search.Result{
+			"auth": {search.NewResult(id2, 0.5)},
+		},
+	}
+	svc := NewSearch(
+		fakeEmbedder{vectors: [][]float64{{0.1, 0.2, 0.3}}},
+		textVectorStore,
+		nil,
+		bm25,
+		nil,
+		nil,
+		stores.enrichments,
+		nil,
+		nil,
+		nil,
+		zerolog.Nop(),
+	)
+
+	req := search.NewMultiRequest(10, "auth", "", []string{"auth"}, search.NewFilters(), "")
+	trace, result, err := svc.Debug(context.Background(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Count() != 2 {
+		t.Fatalf("expected 2 results, got %d", result.Count())
+	}
+
+	stages := make([]SearchStage, 0)
+	for _, step := range trace.Steps() {
+		stages = append(stages, step.Stage())
+	}
+	wantStages := []SearchStage{
+		StageQueryExpansion,
+		StageFiltersApplied,
+		StageBM25Candidates,
+		StageVectorCandidates,
+		StageFusion,
+		StageRerank,
+		StageFinalOrder,
+	}
+	if len(stages) != len(wantStages) {
+		t.Fatalf("expected stages %v, got %v", wantStages, stages)
+	}
+	for i, want := range wantStages {
+		if stages[i] != want {
+			t.Errorf("stage %d: expected %s, got %s", i, want, stages[i])
+		}
+	}
+
+	final := trace.Steps()[len(trace.Steps())-1]
+	if final.Count() != 2 {
+		t.Errorf("expected final stage to report 2 candidates, got %d", final.Count())
+	}
+}
+
+func TestSearch_Debug_NoStoresConfigured_StillProducesTrace(t *testing.T) {
+	stores := newTestStores(t)
+	svc := NewSearch(nil, nil, nil, nil, nil, nil, stores.enrichments, nil, nil, nil, zerolog.Nop())
+
+	req := search.NewMultiRequest(10, "", "", nil, search.NewFilters(), "")
+	trace, result, err := svc.Debug(context.Background(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Count() != 0 {
+		t.Errorf("expected no results, got %d", result.Count())
+	}
+	if len(trace.Steps()) == 0 {
+		t.Error("expected at least the query expansion and filters steps to be recorded")
+	}
+}
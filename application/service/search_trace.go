@@ -0,0 +1,187 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/helixml/kodit/domain/search"
+)
+
+// SearchStage identifies one step of the hybrid search pipeline.
+type SearchStage string
+
+// SearchStage values, in the order a debugged query passes through them.
+const (
+	StageQueryExpansion   SearchStage = "query_expansion"
+	StageFiltersApplied   SearchStage = "filters_applied"
+	StageBM25Candidates   SearchStage = "bm25_candidates"
+	StageVectorCandidates SearchStage = "vector_candidates"
+	StageFusion           SearchStage = "fusion"
+	StageRerank           SearchStage = "rerank"
+	StageFinalOrder       SearchStage = "final_order"
+)
+
+// maxTraceSampleIDs caps how many enrichment IDs a single trace step
+// records, so debugging a query with thousands of candidates doesn't
+// produce an unbounded response.
+const maxTraceSampleIDs = 25
+
+// SearchTraceStep records what happened at one stage of a debugged search:
+// a human-readable description plus a sample of the enrichment IDs that
+// were still in play once the stage completed.
+type SearchTraceStep struct {
+	stage       SearchStage
+	description string
+	count       int
+	sampleIDs   []string
+}
+
+// Stage returns which pipeline stage this step describes.
+func (s SearchTraceStep) Stage() SearchStage {
+	return s.stage
+}
+
+// Description returns a human-readable summary of what happened at this stage.
+func (s SearchTraceStep) Description() string {
+	return s.description
+}
+
+// Count returns how many candidates were in play after this stage.
+func (s SearchTraceStep) Count() int {
+	return s.count
+}
+
+// SampleIDs returns up to maxTraceSampleIDs of the candidate enrichment IDs
+// in play after this stage, in the stage's own order.
+func (s SearchTraceStep) SampleIDs() []string {
+	ids := make([]string, len(s.sampleIDs))
+	copy(ids, s.sampleIDs)
+	return ids
+}
+
+// SearchTrace is the ordered sequence of steps captured while debugging a
+// query, so a caller can see exactly where a desired result entered or
+// dropped out of the pipeline.
+type SearchTrace struct {
+	steps []SearchTraceStep
+}
+
+// Steps returns the trace's steps in the order the pipeline executed them.
+func (t SearchTrace) Steps() []SearchTraceStep {
+	steps := make([]SearchTraceStep, len(t.steps))
+	copy(steps, t.steps)
+	return steps
+}
+
+// searchTraceRecorder accumulates SearchTraceStep entries while a debugged
+// query runs. It is nil for ordinary (non-debug) searches, so recording
+// calls are always guarded with a nil check and impose no cost otherwise.
+type searchTraceRecorder struct {
+	steps []SearchTraceStep
+}
+
+// newSearchTraceRecorder creates an empty recorder.
+func newSearchTraceRecorder() *searchTraceRecorder {
+	return &searchTraceRecorder{}
+}
+
+// record appends a step describing the given stage. ids is the full set of
+// candidate enrichment IDs in play after the stage; only a sample of it is
+// retained.
+func (r *searchTraceRecorder) record(stage SearchStage, description string, ids []string) {
+	sample := ids
+	if len(sample) > maxTraceSampleIDs {
+		sample = sample[:maxTraceSampleIDs]
+	}
+	sampleIDs := make([]string, len(sample))
+	copy(sampleIDs, sample)
+
+	r.steps = append(r.steps, SearchTraceStep{
+		stage:       stage,
+		description: description,
+		count:       len(ids),
+		sampleIDs:   sampleIDs,
+	})
+}
+
+// build finalizes the recorded steps into a SearchTrace.
+func (r *searchTraceRecorder) build() SearchTrace {
+	return SearchTrace{steps: r.steps}
+}
+
+// describeFilters summarizes the non-default fields of filters for the
+// StageFiltersApplied trace step.
+func describeFilters(filters search.Filters) string {
+	if filters.IsEmpty() {
+		return "no filters applied"
+	}
+
+	var parts []string
+	if len(filters.Languages()) > 0 {
+		parts = append(parts, fmt.Sprintf("languages=%v", filters.Languages()))
+	}
+	if len(filters.Authors()) > 0 {
+		parts = append(parts, fmt.Sprintf("authors=%v", filters.Authors()))
+	}
+	if len(filters.SourceRepos()) > 0 {
+		parts = append(parts, fmt.Sprintf("source_repos=%v", filters.SourceRepos()))
+	}
+	if len(filters.FilePaths()) > 0 {
+		parts = append(parts, fmt.Sprintf("file_paths=%v", filters.FilePaths()))
+	}
+	if len(filters.EnrichmentTypes()) > 0 {
+		parts = append(parts, fmt.Sprintf("enrichment_types=%v", filters.EnrichmentTypes()))
+	}
+	if len(filters.EnrichmentSubtypes()) > 0 {
+		parts = append(parts, fmt.Sprintf("enrichment_subtypes=%v", filters.EnrichmentSubtypes()))
+	}
+	if len(filters.CommitSHAs()) > 0 {
+		parts = append(parts, fmt.Sprintf("commit_shas=%v", filters.CommitSHAs()))
+	}
+	if len(filters.ExcludeKeywords()) > 0 {
+		parts = append(parts, fmt.Sprintf("exclude_keywords=%v", filters.ExcludeKeywords()))
+	}
+	if len(filters.ExcludePathPrefixes()) > 0 {
+		parts = append(parts, fmt.Sprintf("exclude_path_prefixes=%v", filters.ExcludePathPrefixes()))
+	}
+	if len(filters.ExcludeRepoIDs()) > 0 {
+		parts = append(parts, fmt.Sprintf("exclude_repo_ids=%v", filters.ExcludeRepoIDs()))
+	}
+	if filters.PRRef() != "" {
+		parts = append(parts, fmt.Sprintf("pr_ref=%s", filters.PRRef()))
+	}
+	if !filters.CreatedAfter().IsZero() {
+		parts = append(parts, fmt.Sprintf("created_after=%s", filters.CreatedAfter()))
+	}
+	if !filters.CreatedBefore().IsZero() {
+		parts = append(parts, fmt.Sprintf("created_before=%s", filters.CreatedBefore()))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// fusionRequestIDs flattens the IDs of every FusionRequest across a set of
+// candidate lists (e.g. one BM25 keyword search per list), in list order,
+// deduplicating IDs that appear in more than one list.
+func fusionRequestIDs(lists [][]search.FusionRequest) []string {
+	seen := make(map[string]struct{})
+	var ids []string
+	for _, list := range lists {
+		for _, item := range list {
+			if _, ok := seen[item.ID()]; ok {
+				continue
+			}
+			seen[item.ID()] = struct{}{}
+			ids = append(ids, item.ID())
+		}
+	}
+	return ids
+}
+
+// fusionResultIDs extracts the ordered IDs of a fused result list.
+func fusionResultIDs(results []search.FusionResult) []string {
+	ids := make([]string, len(results))
+	for i, r := range results {
+		ids[i] = r.ID()
+	}
+	return ids
+}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/helixml/kodit/domain/repository"
+	"github.com/helixml/kodit/domain/snapshot"
+)
+
+// Snapshot captures and restores point-in-time copies of the corpus index
+// tables, so a bad reindex, prompt change, or faulty migration can be
+// rolled back without relying on a full database backup handled
+// externally.
+type Snapshot struct {
+	store    snapshot.Store
+	archiver snapshot.Archiver
+}
+
+// NewSnapshot creates a new Snapshot service.
+func NewSnapshot(store snapshot.Store, archiver snapshot.Archiver) *Snapshot {
+	return &Snapshot{store: store, archiver: archiver}
+}
+
+// Capture dumps every index table and saves the result under label.
+func (s *Snapshot) Capture(ctx context.Context, label string) (snapshot.Snapshot, error) {
+	data, tables, rows, err := s.archiver.Dump(ctx)
+	if err != nil {
+		return snapshot.Snapshot{}, fmt.Errorf("dump index tables: %w", err)
+	}
+
+	saved, err := s.store.Save(ctx, snapshot.New(label, tables, rows, data))
+	if err != nil {
+		return snapshot.Snapshot{}, fmt.Errorf("save snapshot: %w", err)
+	}
+
+	return saved, nil
+}
+
+// Restore replaces the contents of every index table with the tables and
+// rows captured in the snapshot identified by id.
+func (s *Snapshot) Restore(ctx context.Context, id int64) error {
+	snap, err := s.store.FindOne(ctx, repository.WithID(id))
+	if err != nil {
+		return fmt.Errorf("find snapshot %d: %w", id, err)
+	}
+
+	if err := s.archiver.Restore(ctx, snap.Data()); err != nil {
+		return fmt.Errorf("restore snapshot %d: %w", id, err)
+	}
+
+	return nil
+}
+
+// List returns every captured snapshot.
+func (s *Snapshot) List(ctx context.Context) ([]snapshot.Snapshot, error) {
+	return s.store.Find(ctx)
+}
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/helixml/kodit/domain/repository"
+	"github.com/helixml/kodit/domain/synonym"
+)
+
+// Synonym provides CRUD operations over the corpus-level synonym
+// dictionary, plus query expansion for BM25 and vector search.
+type Synonym struct {
+	store synonym.Store
+}
+
+// NewSynonym creates a new Synonym service.
+func NewSynonym(store synonym.Store) *Synonym {
+	return &Synonym{store: store}
+}
+
+// Create adds a new synonym entry to a namespace.
+func (s *Synonym) Create(ctx context.Context, namespace, term string, aliases []string) (synonym.Synonym, error) {
+	saved, err := s.store.Save(ctx, synonym.New(namespace, term, aliases))
+	if err != nil {
+		return synonym.Synonym{}, fmt.Errorf("save synonym: %w", err)
+	}
+	return saved, nil
+}
+
+// Update replaces the aliases of an existing synonym entry.
+func (s *Synonym) Update(ctx context.Context, id int64, aliases []string) (synonym.Synonym, error) {
+	existing, err := s.store.FindOne(ctx, repository.WithID(id))
+	if err != nil {
+		return synonym.Synonym{}, fmt.Errorf("find synonym: %w", err)
+	}
+
+	saved, err := s.store.Save(ctx, existing.WithAliases(aliases))
+	if err != nil {
+		return synonym.Synonym{}, fmt.Errorf("save synonym: %w", err)
+	}
+	return saved, nil
+}
+
+// Delete removes a synonym entry by ID.
+func (s *Synonym) Delete(ctx context.Context, id int64) error {
+	existing, err := s.store.FindOne(ctx, repository.WithID(id))
+	if err != nil {
+		return fmt.Errorf("find synonym: %w", err)
+	}
+	if err := s.store.Delete(ctx, existing); err != nil {
+		return fmt.Errorf("delete synonym: %w", err)
+	}
+	return nil
+}
+
+// List returns every synonym entry in a namespace (or across all
+// namespaces when namespace is "").
+func (s *Synonym) List(ctx context.Context, namespace string) ([]synonym.Synonym, error) {
+	var opts []repository.Option
+	if namespace != "" {
+		opts = append(opts, synonym.WithNamespace(namespace))
+	}
+	entries, err := s.store.Find(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("find synonyms: %w", err)
+	}
+	return entries, nil
+}
+
+// Get returns a single synonym entry by ID.
+func (s *Synonym) Get(ctx context.Context, id int64) (synonym.Synonym, error) {
+	entry, err := s.store.FindOne(ctx, repository.WithID(id))
+	if err != nil {
+		return synonym.Synonym{}, fmt.Errorf("find synonym: %w", err)
+	}
+	return entry, nil
+}
+
+// ExpandQuery appends the aliases of every term matched in query (case
+// insensitively, whole-word) within namespace, so BM25 and embedding
+// search see tribal terminology alongside the text that was actually
+// typed. The original query is always returned unmodified as a prefix.
+func (s *Synonym) ExpandQuery(ctx context.Context, namespace, query string) (string, error) {
+	if strings.TrimSpace(query) == "" {
+		return query, nil
+	}
+
+	entries, err := s.List(ctx, namespace)
+	if err != nil {
+		return "", fmt.Errorf("list synonyms: %w", err)
+	}
+
+	lowered := strings.ToLower(query)
+	additions := make([]string, 0)
+	seen := map[string]struct{}{}
+	for _, e := range entries {
+		if !strings.Contains(lowered, strings.ToLower(e.Term())) {
+			continue
+		}
+		for _, alias := range e.Aliases() {
+			key := strings.ToLower(alias)
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			additions = append(additions, alias)
+		}
+	}
+
+	if len(additions) == 0 {
+		return query, nil
+	}
+	return query + " " + strings.Join(additions, " "), nil
+}
+
+// ExpandKeywords returns keywords with any matching aliases appended as
+// additional keywords, so each alias gets its own BM25 match list.
+func (s *Synonym) ExpandKeywords(ctx context.Context, namespace string, keywords []string) ([]string, error) {
+	if len(keywords) == 0 {
+		return keywords, nil
+	}
+
+	entries, err := s.List(ctx, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("list synonyms: %w", err)
+	}
+
+	expanded := append([]string(nil), keywords...)
+	seen := map[string]struct{}{}
+	for _, k := range keywords {
+		seen[strings.ToLower(k)] = struct{}{}
+	}
+	for _, e := range entries {
+		term := strings.ToLower(e.Term())
+		matched := false
+		for _, k := range keywords {
+			if strings.ToLower(k) == term {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			continue
+		}
+		for _, alias := range e.Aliases() {
+			key := strings.ToLower(alias)
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			expanded = append(expanded, alias)
+		}
+	}
+	return expanded, nil
+}
@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/helixml/kodit/domain/repository"
+	"github.com/helixml/kodit/domain/synonym"
+	"github.com/helixml/kodit/internal/database"
+)
+
+// fakeSynonymStore implements synonym.Store for testing.
+// Genuine fake: the real store requires a database.
+type fakeSynonymStore struct {
+	entries []synonym.Synonym
+	nextID  int64
+}
+
+func (f *fakeSynonymStore) Find(_ context.Context, opts ...repository.Option) ([]synonym.Synonym, error) {
+	ns := conditionValue(opts, "namespace")
+	if ns == "" {
+		return append([]synonym.Synonym(nil), f.entries...), nil
+	}
+	var result []synonym.Synonym
+	for _, e := range f.entries {
+		if e.Namespace() == ns {
+			result = append(result, e)
+		}
+	}
+	return result, nil
+}
+
+func (f *fakeSynonymStore) FindOne(_ context.Context, opts ...repository.Option) (synonym.Synonym, error) {
+	id := conditionValue(opts, "id")
+	for _, e := range f.entries {
+		if id == e.ID() {
+			return e, nil
+		}
+	}
+	return synonym.Synonym{}, database.ErrNotFound
+}
+
+func (f *fakeSynonymStore) Count(_ context.Context, opts ...repository.Option) (int64, error) {
+	entries, err := f.Find(context.Background(), opts...)
+	return int64(len(entries)), err
+}
+
+func (f *fakeSynonymStore) Save(_ context.Context, entity synonym.Synonym) (synonym.Synonym, error) {
+	if entity.ID() == 0 {
+		f.nextID++
+		entity = synonym.Reconstruct(f.nextID, entity.Namespace(), entity.Term(), entity.Aliases(), entity.CreatedAt(), entity.UpdatedAt())
+		f.entries = append(f.entries, entity)
+		return entity, nil
+	}
+	for i, e := range f.entries {
+		if e.ID() == entity.ID() {
+			f.entries[i] = entity
+			return entity, nil
+		}
+	}
+	return synonym.Synonym{}, database.ErrNotFound
+}
+
+func (f *fakeSynonymStore) Delete(_ context.Context, entity synonym.Synonym) error {
+	for i, e := range f.entries {
+		if e.ID() == entity.ID() {
+			f.entries = append(f.entries[:i], f.entries[i+1:]...)
+			return nil
+		}
+	}
+	return database.ErrNotFound
+}
+
+func (f *fakeSynonymStore) DeleteBy(_ context.Context, _ ...repository.Option) error {
+	return nil
+}
+
+// conditionValue returns the value of the first equality condition on field,
+// type-asserted to int64 or string depending on what's found. Returns the
+// zero value of the caller's expected type when absent, which both callers
+// above treat as "no filter".
+func conditionValue(opts []repository.Option, field string) any {
+	for _, c := range repository.Build(opts...).Conditions() {
+		if c.Field() == field {
+			return c.Value()
+		}
+	}
+	return nil
+}
+
+func TestSynonym_Create(t *testing.T) {
+	store := &fakeSynonymStore{}
+	svc := NewSynonym(store)
+
+	saved, err := svc.Create(context.Background(), "acme", "phoenix", []string{"billing service"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if saved.ID() == 0 {
+		t.Error("expected a non-zero ID after save")
+	}
+	if saved.Term() != "phoenix" {
+		t.Errorf("unexpected term: %q", saved.Term())
+	}
+}
+
+func TestSynonym_Update(t *testing.T) {
+	store := &fakeSynonymStore{}
+	svc := NewSynonym(store)
+
+	saved, err := svc.Create(context.Background(), "acme", "phoenix", []string{"billing service"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	updated, err := svc.Update(context.Background(), saved.ID(), []string{"billing", "payments"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(updated.Aliases()) != 2 {
+		t.Errorf("expected 2 aliases, got %d", len(updated.Aliases()))
+	}
+}
+
+func TestSynonym_Delete(t *testing.T) {
+	store := &fakeSynonymStore{}
+	svc := NewSynonym(store)
+
+	saved, err := svc.Create(context.Background(), "acme", "phoenix", []string{"billing service"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := svc.Delete(context.Background(), saved.ID()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := svc.Get(context.Background(), saved.ID()); err == nil {
+		t.Error("expected error getting deleted synonym")
+	}
+}
+
+func TestSynonym_ExpandQuery(t *testing.T) {
+	store := &fakeSynonymStore{}
+	svc := NewSynonym(store)
+
+	if _, err := svc.Create(context.Background(), "acme", "phoenix", []string{"billing service"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := svc.Create(context.Background(), "acme", "dal", []string{"data access layer"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expanded, err := svc.ExpandQuery(context.Background(), "acme", "how does phoenix handle retries?")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if expanded != "how does phoenix handle retries? billing service" {
+		t.Errorf("unexpected expansion: %q", expanded)
+	}
+}
+
+func TestSynonym_ExpandQuery_NoMatch(t *testing.T) {
+	store := &fakeSynonymStore{}
+	svc := NewSynonym(store)
+
+	if _, err := svc.Create(context.Background(), "acme", "phoenix", []string{"billing service"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expanded, err := svc.ExpandQuery(context.Background(), "acme", "how does auth work?")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expanded != "how does auth work?" {
+		t.Errorf("expected query unchanged, got %q", expanded)
+	}
+}
+
+func TestSynonym_ExpandKeywords(t *testing.T) {
+	store := &fakeSynonymStore{}
+	svc := NewSynonym(store)
+
+	if _, err := svc.Create(context.Background(), "acme", "dal", []string{"data access layer"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expanded, err := svc.ExpandKeywords(context.Background(), "acme", []string{"dal", "retry"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(expanded) != 3 {
+		t.Fatalf("expected 3 keywords, got %d: %v", len(expanded), expanded)
+	}
+}
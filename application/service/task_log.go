@@ -0,0 +1,80 @@
+package service
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/helixml/kodit/domain/task"
+)
+
+// defaultTaskLogCapacity bounds how many log entries are retained per task.
+const defaultTaskLogCapacity = 100
+
+// defaultTaskLogRetention bounds how many distinct tasks have their log
+// entries retained at once; the oldest task is evicted first.
+const defaultTaskLogRetention = 500
+
+// TaskLogStore is a bounded, in-memory ring of structured log entries per
+// task, keyed by task ID. It exists so that a failed task's execution
+// history is retrievable from the API without grepping host logs. Entries
+// are ephemeral: they are not persisted and do not survive a process
+// restart, and they remain readable after a task is dequeued and deleted,
+// since that's exactly when a failure is investigated.
+type TaskLogStore struct {
+	mu       sync.Mutex
+	entries  map[int64][]task.LogEntry
+	order    *list.List
+	elements map[int64]*list.Element
+}
+
+// NewTaskLogStore creates an empty TaskLogStore.
+func NewTaskLogStore() *TaskLogStore {
+	return &TaskLogStore{
+		entries:  make(map[int64][]task.LogEntry),
+		order:    list.New(),
+		elements: make(map[int64]*list.Element),
+	}
+}
+
+// Append records a log entry for the given task ID. Once a task's entries
+// reach defaultTaskLogCapacity, the oldest entry for that task is dropped.
+// Once more than defaultTaskLogRetention distinct tasks have entries, the
+// least recently appended task's entries are evicted entirely.
+func (s *TaskLogStore) Append(taskID int64, entry task.LogEntry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if elem, ok := s.elements[taskID]; ok {
+		s.order.MoveToBack(elem)
+	} else {
+		s.elements[taskID] = s.order.PushBack(taskID)
+		if s.order.Len() > defaultTaskLogRetention {
+			oldest := s.order.Front()
+			s.order.Remove(oldest)
+			evictedID, ok := oldest.Value.(int64)
+			if ok {
+				delete(s.entries, evictedID)
+				delete(s.elements, evictedID)
+			}
+		}
+	}
+
+	entries := append(s.entries[taskID], entry)
+	if len(entries) > defaultTaskLogCapacity {
+		entries = entries[len(entries)-defaultTaskLogCapacity:]
+	}
+	s.entries[taskID] = entries
+}
+
+// Get returns the recorded log entries for a task ID, oldest first. It
+// returns an empty slice if no entries have been recorded, including when
+// the task ID is unknown or its entries have since been evicted.
+func (s *TaskLogStore) Get(taskID int64) []task.LogEntry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries := s.entries[taskID]
+	result := make([]task.LogEntry, len(entries))
+	copy(result, entries)
+	return result
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/helixml/kodit/domain/task"
 	"github.com/helixml/kodit/domain/tracking"
@@ -53,6 +54,17 @@ func (s *Tracking) Summary(ctx context.Context, repositoryID int64) (tracking.Re
 	return tracking.StatusSummaryFromTasks(statuses, pendingCount), nil
 }
 
+// Health returns an aggregated health assessment for a repository, given
+// its enrichment coverage (0 to 1) and the time it was last scanned.
+func (s *Tracking) Health(ctx context.Context, repositoryID int64, enrichmentCoverage float64, lastScannedAt time.Time) (tracking.RepositoryHealth, error) {
+	statuses, err := s.Statuses(ctx, repositoryID)
+	if err != nil {
+		return tracking.RepositoryHealth{}, err
+	}
+
+	return tracking.ComputeRepositoryHealth(statuses, enrichmentCoverage, lastScannedAt), nil
+}
+
 func (s *Tracking) pendingTaskCount(ctx context.Context, repositoryID int64) (int, error) {
 	if s.taskStore == nil {
 		return 0, nil
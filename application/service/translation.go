@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/helixml/kodit/domain/enrichment"
+	"github.com/helixml/kodit/domain/repository"
+	domainservice "github.com/helixml/kodit/domain/service"
+)
+
+// translationSystemPrompt instructs the enricher to translate rather than
+// summarize or analyze, reusing the same request/response shape as every
+// other enrichment generation.
+const translationSystemPrompt = "You are a professional technical translator. " +
+	"Translate the following text into %s. Preserve formatting such as Markdown " +
+	"and code blocks verbatim; only translate prose. Respond with the translation only."
+
+// Translation generates and caches translated copies of enrichments on
+// request. Translations are stored as ordinary enrichments, linked back to
+// their source via a TranslationAssociation, so a later request for the
+// same enrichment and language is served from the store instead of the
+// Enricher.
+type Translation struct {
+	enrichmentStore  enrichment.EnrichmentStore
+	associationStore enrichment.AssociationStore
+	enricher         domainservice.Enricher
+}
+
+// NewTranslation creates a new Translation service. enricher may be nil when
+// no text provider is configured; Translate then returns an error for any
+// language not already cached.
+func NewTranslation(
+	enrichmentStore enrichment.EnrichmentStore,
+	associationStore enrichment.AssociationStore,
+	enricher domainservice.Enricher,
+) *Translation {
+	return &Translation{
+		enrichmentStore:  enrichmentStore,
+		associationStore: associationStore,
+		enricher:         enricher,
+	}
+}
+
+// Translate returns a copy of the enrichment identified by sourceID with its
+// content translated into targetLang, generating and caching it on first
+// request. Subsequent calls for the same source and language return the
+// cached translation without invoking the enricher.
+func (t *Translation) Translate(ctx context.Context, sourceID int64, targetLang string) (enrichment.Enrichment, error) {
+	source, err := t.enrichmentStore.FindOne(ctx, repository.WithID(sourceID))
+	if err != nil {
+		return enrichment.Enrichment{}, fmt.Errorf("find source enrichment: %w", err)
+	}
+
+	cached, err := t.cached(ctx, sourceID, targetLang)
+	if err != nil {
+		return enrichment.Enrichment{}, fmt.Errorf("find cached translation: %w", err)
+	}
+	if cached != nil {
+		return *cached, nil
+	}
+
+	if t.enricher == nil {
+		return enrichment.Enrichment{}, fmt.Errorf("translate enrichment %d to %s: no text provider configured", sourceID, targetLang)
+	}
+
+	prompt := fmt.Sprintf(translationSystemPrompt, targetLang)
+	requests := []domainservice.EnrichmentRequest{
+		domainservice.NewEnrichmentRequest(fmt.Sprintf("%d", sourceID), source.Content(), prompt),
+	}
+
+	responses, err := t.enricher.Enrich(ctx, requests)
+	if err != nil {
+		return enrichment.Enrichment{}, fmt.Errorf("translate enrichment %d to %s: %w", sourceID, targetLang, err)
+	}
+	if len(responses) == 0 {
+		return enrichment.Enrichment{}, fmt.Errorf("translate enrichment %d to %s: no response from enricher", sourceID, targetLang)
+	}
+
+	translated := enrichment.NewEnrichmentWithLanguage(source.Type(), source.Subtype(), source.EntityTypeKey(), responses[0].Text(), targetLang)
+	saved, err := t.enrichmentStore.Save(ctx, translated)
+	if err != nil {
+		return enrichment.Enrichment{}, fmt.Errorf("save translation: %w", err)
+	}
+
+	if _, err := t.associationStore.Save(ctx, enrichment.TranslationAssociation(saved.ID(), sourceID)); err != nil {
+		return enrichment.Enrichment{}, fmt.Errorf("save translation association: %w", err)
+	}
+
+	return saved, nil
+}
+
+// cached returns the already-translated enrichment for sourceID and
+// targetLang, or nil if no translation has been generated yet.
+func (t *Translation) cached(ctx context.Context, sourceID int64, targetLang string) (*enrichment.Enrichment, error) {
+	associations, err := t.associationStore.Find(ctx,
+		repository.WithCondition("entity_type", string(enrichment.EntityTypeTranslationSource)),
+		repository.WithCondition("entity_id", fmt.Sprintf("%d", sourceID)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(associations) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]int64, len(associations))
+	for i, a := range associations {
+		ids[i] = a.EnrichmentID()
+	}
+
+	matches, err := t.enrichmentStore.Find(ctx,
+		repository.WithIDIn(ids),
+		enrichment.WithLanguage(targetLang),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(matches) == 0 {
+		return nil, nil
+	}
+
+	return &matches[0], nil
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/helixml/kodit/domain/repository"
+	"github.com/helixml/kodit/domain/usage"
+)
+
+// UsageSummary aggregates provider usage for one repository, operation, and
+// model combination.
+type UsageSummary struct {
+	RepositoryID     string
+	Operation        usage.Operation
+	Model            string
+	Calls            int
+	PromptTokens     int
+	CompletionTokens int
+	TotalTokens      int
+	CostEstimate     float64
+}
+
+// BudgetStatus reports today's recorded spend against an operation's daily
+// usage.Budget, and how much headroom remains before it cuts off new calls.
+type BudgetStatus struct {
+	Operation       usage.Operation
+	Budget          usage.Budget
+	TokensSpent     int
+	CostSpent       float64
+	RemainingTokens int     // -1 if the operation's token limit is unenforced.
+	RemainingCost   float64 // -1 if the operation's cost limit is unenforced.
+}
+
+// Usage reports aggregated provider spend and today's status against each
+// operation's configured daily usage.Budget, so finance and platform teams
+// can attribute cost per repository and operation and see how close a
+// runaway day is to being cut off.
+type Usage struct {
+	store   usage.Store
+	budgets map[usage.Operation]usage.Budget
+}
+
+// NewUsage creates a new Usage service. budgets may be nil or omit an
+// operation to leave it unenforced.
+func NewUsage(store usage.Store, budgets map[usage.Operation]usage.Budget) *Usage {
+	return &Usage{store: store, budgets: budgets}
+}
+
+// Summarize aggregates provider usage records matching options by
+// repository, operation, and model.
+func (s *Usage) Summarize(ctx context.Context, options ...repository.Option) ([]UsageSummary, error) {
+	records, err := s.store.Find(ctx, options...)
+	if err != nil {
+		return nil, fmt.Errorf("find provider usage: %w", err)
+	}
+
+	summaries := map[string]*UsageSummary{}
+	order := make([]string, 0, len(records))
+	for _, r := range records {
+		key := r.RepositoryID() + "|" + string(r.Operation()) + "|" + r.Model()
+		sum, ok := summaries[key]
+		if !ok {
+			sum = &UsageSummary{RepositoryID: r.RepositoryID(), Operation: r.Operation(), Model: r.Model()}
+			summaries[key] = sum
+			order = append(order, key)
+		}
+		sum.Calls++
+		sum.PromptTokens += r.PromptTokens()
+		sum.CompletionTokens += r.CompletionTokens()
+		sum.TotalTokens += r.TotalTokens()
+		sum.CostEstimate += r.CostEstimate()
+	}
+
+	result := make([]UsageSummary, len(order))
+	for i, key := range order {
+		result[i] = *summaries[key]
+	}
+	return result, nil
+}
+
+// BudgetStatuses reports today's recorded spend against every configured
+// operation budget, ordered by operation name for a stable response.
+func (s *Usage) BudgetStatuses(ctx context.Context) ([]BudgetStatus, error) {
+	operations := make([]usage.Operation, 0, len(s.budgets))
+	for op := range s.budgets {
+		operations = append(operations, op)
+	}
+	sort.Slice(operations, func(i, j int) bool { return operations[i] < operations[j] })
+
+	statuses := make([]BudgetStatus, 0, len(operations))
+	for _, op := range operations {
+		tokens, cost, err := s.spentToday(ctx, op)
+		if err != nil {
+			return nil, err
+		}
+		budget := s.budgets[op]
+		statuses = append(statuses, BudgetStatus{
+			Operation:       op,
+			Budget:          budget,
+			TokensSpent:     tokens,
+			CostSpent:       cost,
+			RemainingTokens: budget.RemainingTokens(tokens),
+			RemainingCost:   budget.RemainingCost(cost),
+		})
+	}
+	return statuses, nil
+}
+
+// spentToday sums tokens and cost recorded for operation since the start of
+// the current UTC day.
+func (s *Usage) spentToday(ctx context.Context, operation usage.Operation) (int, float64, error) {
+	records, err := s.store.Find(ctx, usage.WithOperation(operation), usage.WithCreatedAfter(usage.StartOfDayUTC()))
+	if err != nil {
+		return 0, 0, fmt.Errorf("find provider usage: %w", err)
+	}
+	tokens, cost := usage.Sum(records)
+	return tokens, cost, nil
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"sync"
+
+	"github.com/rs/zerolog"
+
+	"github.com/helixml/kodit/domain/repository"
+	"github.com/helixml/kodit/internal/config"
+)
+
+// WarmUp preloads frequently accessed repositories' search indexes by
+// running representative queries against them, so the first real searches
+// after a cold start don't pay the full ANN/cache warm-up cost.
+type WarmUp struct {
+	repositories repository.RepositoryStore
+	search       *Search
+	logger       zerolog.Logger
+	repoLimit    int
+	queries      []string
+	enabled      bool
+
+	wg sync.WaitGroup
+}
+
+// NewWarmUp creates a new WarmUp from config and dependencies.
+func NewWarmUp(
+	cfg config.WarmUpConfig,
+	repositories repository.RepositoryStore,
+	search *Search,
+	logger zerolog.Logger,
+) *WarmUp {
+	return &WarmUp{
+		repositories: repositories,
+		search:       search,
+		logger:       logger,
+		repoLimit:    cfg.RepoLimit(),
+		queries:      cfg.Queries(),
+		enabled:      cfg.Enabled(),
+	}
+}
+
+// Start runs a warm-up pass in a background goroutine so it never delays
+// readiness. If disabled, this is a no-op. Callers may also invoke Run
+// directly — e.g. after a manual reindex — to warm the indexes again.
+func (w *WarmUp) Start(ctx context.Context) {
+	if !w.enabled {
+		w.logger.Info().Msg("index warm-up disabled")
+		return
+	}
+
+	w.wg.Go(func() {
+		w.Run(ctx)
+	})
+}
+
+// Stop waits for any in-flight warm-up pass to finish.
+func (w *WarmUp) Stop() {
+	w.wg.Wait()
+}
+
+// Run preloads the most recently synced repositories' indexes by running
+// each configured representative query against them.
+func (w *WarmUp) Run(ctx context.Context) {
+	if !w.search.Available() {
+		return
+	}
+
+	repos, err := w.repositories.Find(ctx, repository.WithOrderDesc("last_scanned_at"), repository.WithLimit(w.repoLimit))
+	if err != nil {
+		if ctx.Err() == nil {
+			w.logger.Error().Str("error", err.Error()).Msg("warm-up failed to list repositories")
+		}
+		return
+	}
+
+	warmed := 0
+	for _, repo := range repos {
+		if ctx.Err() != nil {
+			return
+		}
+		if !repo.HasWorkingCopy() {
+			continue
+		}
+		for _, query := range w.queries {
+			if _, err := w.search.Query(ctx, query, WithRepositories(repo.ID()), WithLimit(1)); err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				w.logger.Warn().Int64("repo_id", repo.ID()).Str("error", err.Error()).Msg("warm-up query failed")
+			}
+		}
+		warmed++
+	}
+
+	w.logger.Info().Int("repositories", warmed).Msg("index warm-up complete")
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/helixml/kodit/domain/repository"
+	"github.com/helixml/kodit/domain/search"
+	"github.com/helixml/kodit/internal/config"
+)
+
+func TestWarmUp_Enabled_QueriesMostRecentlyScannedRepos(t *testing.T) {
+	stores := newTestStores(t)
+	ctx := context.Background()
+	logger := zerolog.Nop()
+
+	remote := "https://github.com/org/repo"
+	repo, err := repository.NewRepository(remote)
+	require.NoError(t, err)
+	repo = repo.WithWorkingCopy(repository.NewWorkingCopy("/tmp/r", remote))
+	repo = repo.WithLastScannedAt(time.Now())
+	_, err = stores.repos.Save(ctx, repo)
+	require.NoError(t, err)
+
+	bm25 := &fakeBM25Store{resultsByKeyword: map[string][]search.Result{}}
+	searchSvc := NewSearch(nil, nil, nil, bm25, nil, nil, stores.enrichments, nil, nil, &atomic.Bool{}, logger)
+
+	cfg := config.NewWarmUpConfig().WithEnabled(true).WithQueries([]string{"function"})
+	wu := NewWarmUp(cfg, stores.repos, searchSvc, logger)
+
+	wu.Run(ctx)
+}
+
+func TestWarmUp_Disabled_DoesNotRun(t *testing.T) {
+	stores := newTestStores(t)
+	ctx := context.Background()
+	logger := zerolog.Nop()
+
+	bm25 := &fakeBM25Store{resultsByKeyword: map[string][]search.Result{}}
+	searchSvc := NewSearch(nil, nil, nil, bm25, nil, nil, stores.enrichments, nil, nil, &atomic.Bool{}, logger)
+
+	cfg := config.NewWarmUpConfig().WithEnabled(false)
+	wu := NewWarmUp(cfg, stores.repos, searchSvc, logger)
+
+	wu.Start(ctx)
+	wu.Stop()
+
+	assert.False(t, cfg.Enabled())
+}
+
+func TestWarmUp_NoSearchBackend_NoOp(t *testing.T) {
+	stores := newTestStores(t)
+	ctx := context.Background()
+	logger := zerolog.Nop()
+
+	searchSvc := NewSearch(nil, nil, nil, nil, nil, nil, stores.enrichments, nil, nil, &atomic.Bool{}, logger)
+
+	cfg := config.NewWarmUpConfig().WithEnabled(true)
+	wu := NewWarmUp(cfg, stores.repos, searchSvc, logger)
+
+	wu.Run(ctx)
+}
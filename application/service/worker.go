@@ -2,14 +2,19 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/rs/zerolog"
 
+	"github.com/helixml/kodit/domain/cluster"
+	"github.com/helixml/kodit/domain/repository"
 	"github.com/helixml/kodit/domain/task"
+	"github.com/helixml/kodit/domain/usage"
 )
 
 // WorkerTracker marks a task status as failed or complete.
@@ -64,6 +69,23 @@ func (r *Registry) HasHandler(operation task.Operation) bool {
 	return ok
 }
 
+// Simulate runs the registered handler's planning logic for operation
+// without side effects. Returns an error if no handler is registered for
+// operation, or task.ErrNotSimulatable if the registered handler does not
+// implement task.Simulator.
+func (r *Registry) Simulate(ctx context.Context, operation task.Operation, payload map[string]any) (task.Plan, error) {
+	h, ok := r.Handler(operation)
+	if !ok {
+		return task.Plan{}, fmt.Errorf("no handler registered: %s", operation)
+	}
+
+	sim, ok := h.(task.Simulator)
+	if !ok {
+		return task.Plan{}, fmt.Errorf("%w: %s", task.ErrNotSimulatable, operation)
+	}
+	return sim.Simulate(ctx, payload)
+}
+
 // Operations returns all registered operations.
 func (r *Registry) Operations() []task.Operation {
 	r.mu.RLock()
@@ -85,6 +107,10 @@ type Worker struct {
 	logger         zerolog.Logger
 	pollPeriod     time.Duration
 
+	instances  cluster.Store
+	instanceID int64
+	taskLogs   *TaskLogStore
+
 	inFlight atomic.Int64
 	cancel   context.CancelFunc
 	wg       sync.WaitGroup
@@ -109,6 +135,32 @@ func (w *Worker) WithPollPeriod(d time.Duration) *Worker {
 	return w
 }
 
+// WithInstanceRegistry enables cluster-mode registration: the worker records
+// itself, its heartbeats, and its currently leased task in store so that
+// GET /api/v1/admin/workers can report on it. Without this, the worker runs
+// exactly as before, unregistered.
+func (w *Worker) WithInstanceRegistry(store cluster.Store) *Worker {
+	w.instances = store
+	return w
+}
+
+// WithTaskLogs enables per-task structured log capture: the worker's
+// processing, completion, and failure events are additionally recorded to
+// store, keyed by task ID, so they can be retrieved via the API. Without
+// this, the worker runs exactly as before, only logging to w.logger.
+func (w *Worker) WithTaskLogs(store *TaskLogStore) *Worker {
+	w.taskLogs = store
+	return w
+}
+
+// recordLog appends a log entry for taskID, if task log capture is enabled.
+func (w *Worker) recordLog(taskID int64, level task.LogLevel, message string) {
+	if w.taskLogs == nil {
+		return
+	}
+	w.taskLogs.Append(taskID, task.NewLogEntry(level, message))
+}
+
 // Start begins processing tasks from the queue.
 // The worker runs in a goroutine and can be stopped with Stop().
 func (w *Worker) Start(ctx context.Context) error {
@@ -119,6 +171,10 @@ func (w *Worker) Start(ctx context.Context) error {
 		return fmt.Errorf("recover stale statuses: %w", err)
 	}
 
+	if err := w.register(ctx); err != nil {
+		return fmt.Errorf("register worker instance: %w", err)
+	}
+
 	ctx, w.cancel = context.WithCancel(ctx)
 	w.wg.Add(1)
 
@@ -150,9 +206,13 @@ func (w *Worker) recoverStaleStatuses(ctx context.Context) error {
 	return nil
 }
 
-// Stop gracefully shuts down the worker.
-// It waits for the current task to complete before returning.
-func (w *Worker) Stop() {
+// Stop gracefully shuts down the worker: it stops claiming new tasks
+// immediately, then waits up to gracePeriod for an in-flight task, if any,
+// to finish. A cooperative handler that observes ctx cancellation causes
+// processTask to requeue its task rather than abandon it; a handler that
+// doesn't is left for recoverStaleStatuses to clean up on the next start,
+// since a running goroutine cannot be interrupted without its cooperation.
+func (w *Worker) Stop(gracePeriod time.Duration) {
 	w.mu.Lock()
 	cancel := w.cancel
 	w.cancel = nil
@@ -161,8 +221,111 @@ func (w *Worker) Stop() {
 	if cancel != nil {
 		cancel()
 	}
-	w.wg.Wait()
-	w.logger.Info().Msg("queue worker stopped")
+
+	done := make(chan struct{})
+	go func() {
+		w.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		w.logger.Info().Msg("queue worker stopped")
+	case <-time.After(gracePeriod):
+		w.logger.Warn().Dur("grace_period", gracePeriod).Msg("worker drain grace period elapsed with a task still in flight, leaving it for recovery on next start")
+	}
+
+	w.deregister()
+}
+
+// register adds this process to the worker instance registry, if one is
+// configured. Uses context.Background() implicitly via the caller's ctx at
+// startup, since Start's ctx is still valid at this point.
+func (w *Worker) register(ctx context.Context) error {
+	if w.instances == nil {
+		return nil
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+
+	saved, err := w.instances.Save(ctx, cluster.New(hostname, os.Getpid()))
+	if err != nil {
+		return err
+	}
+	w.instanceID = saved.ID()
+	return nil
+}
+
+// deregister removes this process from the worker instance registry, if
+// one is configured. Runs on a background context since the worker's own
+// context is already cancelled by the time Stop calls this.
+func (w *Worker) deregister() {
+	if w.instances == nil {
+		return
+	}
+
+	if err := w.instances.DeleteBy(context.Background(), repository.WithID(w.instanceID)); err != nil {
+		w.logger.Warn().Str("error", err.Error()).Msg("failed to deregister worker instance")
+	}
+}
+
+// heartbeat records that this worker instance is still alive, if a registry
+// is configured. Errors are logged rather than propagated, since a failed
+// heartbeat write should not interrupt task processing.
+func (w *Worker) heartbeat(ctx context.Context) {
+	if w.instances == nil {
+		return
+	}
+
+	current, err := w.instances.FindOne(ctx, repository.WithID(w.instanceID))
+	if err != nil {
+		w.logger.Warn().Str("error", err.Error()).Msg("failed to load worker instance for heartbeat")
+		return
+	}
+
+	if _, err := w.instances.Save(ctx, current.WithHeartbeat()); err != nil {
+		w.logger.Warn().Str("error", err.Error()).Msg("failed to record worker heartbeat")
+	}
+}
+
+// lease records that this worker instance has claimed t, if a registry is
+// configured. Errors are logged rather than propagated, since a failed
+// lease write should not block task processing.
+func (w *Worker) lease(ctx context.Context, t task.Task) {
+	if w.instances == nil {
+		return
+	}
+
+	current, err := w.instances.FindOne(ctx, repository.WithID(w.instanceID))
+	if err != nil {
+		w.logger.Warn().Str("error", err.Error()).Msg("failed to load worker instance for lease")
+		return
+	}
+
+	if _, err := w.instances.Save(ctx, current.WithLease(t.ID(), t.Operation().String())); err != nil {
+		w.logger.Warn().Str("error", err.Error()).Msg("failed to record worker lease")
+	}
+}
+
+// clearLease records that this worker instance finished its leased task and
+// increments its processed count, if a registry is configured.
+func (w *Worker) clearLease(ctx context.Context) {
+	if w.instances == nil {
+		return
+	}
+
+	current, err := w.instances.FindOne(ctx, repository.WithID(w.instanceID))
+	if err != nil {
+		w.logger.Warn().Str("error", err.Error()).Msg("failed to load worker instance to clear lease")
+		return
+	}
+
+	if _, err := w.instances.Save(ctx, current.WithLeaseCleared()); err != nil {
+		w.logger.Warn().Str("error", err.Error()).Msg("failed to clear worker lease")
+	}
 }
 
 func (w *Worker) run(ctx context.Context) {
@@ -182,7 +345,9 @@ func (w *Worker) run(ctx context.Context) {
 					return // Context cancelled, exit cleanly
 				}
 				w.logger.Error().Str("error", err.Error()).Msg("error processing task")
+				continue
 			}
+			w.heartbeat(ctx)
 		}
 	}
 }
@@ -213,16 +378,47 @@ func (w *Worker) processTask(ctx context.Context, t task.Task) error {
 	start := time.Now()
 
 	w.logger.Info().Int64("task_id", t.ID()).Str("operation", t.Operation().String()).Msg("processing task")
+	w.recordLog(t.ID(), task.LogLevelInfo, "processing task")
+
+	w.lease(ctx, t)
+	defer w.clearLease(context.Background())
 
 	h, ok := w.registry.Handler(t.Operation())
 	if !ok {
 		w.logger.Error().Int64("task_id", t.ID()).Str("operation", t.Operation().String()).Msg("no handler for operation")
+		w.recordLog(t.ID(), task.LogLevelError, "no handler for operation")
 		// Delete the task anyway to prevent it from blocking the queue
 		return w.store.Delete(ctx, t)
 	}
 
 	if err := w.executeWithRecovery(ctx, h, t); err != nil {
+		if errors.Is(err, task.ErrDeadlinePaused) {
+			w.logger.Info().Int64("task_id", t.ID()).Str("operation", t.Operation().String()).Msg("task paused at time-box deadline, requeuing for resume")
+			w.recordLog(t.ID(), task.LogLevelInfo, "task paused at time-box deadline, requeuing for resume")
+			_, saveErr := w.store.Save(ctx, t)
+			return saveErr
+		}
+
+		if errors.Is(err, usage.ErrBudgetExceeded) {
+			w.logger.Warn().Int64("task_id", t.ID()).Str("operation", t.Operation().String()).Msg("task deferred: provider budget exceeded for today, requeuing")
+			w.recordLog(t.ID(), task.LogLevelWarn, "task deferred: provider budget exceeded for today, requeuing")
+			_, saveErr := w.store.Save(ctx, t)
+			return saveErr
+		}
+
+		if ctx.Err() != nil {
+			// The worker is shutting down and the handler observed the
+			// cancellation. Requeue on a fresh context, since ctx itself is
+			// no longer usable for stores, so the task resumes on restart
+			// instead of being discarded as failed.
+			w.logger.Info().Int64("task_id", t.ID()).Str("operation", t.Operation().String()).Msg("task interrupted by shutdown, requeuing")
+			w.recordLog(t.ID(), task.LogLevelInfo, "task interrupted by shutdown, requeuing")
+			_, saveErr := w.store.Save(context.Background(), t)
+			return saveErr
+		}
+
 		w.logger.Error().Int64("task_id", t.ID()).Str("operation", t.Operation().String()).Str("error", err.Error()).Msg("task execution failed")
+		w.recordLog(t.ID(), task.LogLevelError, fmt.Sprintf("task execution failed: %s", err.Error()))
 		w.markStatusFailed(ctx, t, err)
 		// Delete the task - failed tasks are not retried
 		return w.store.Delete(ctx, t)
@@ -232,6 +428,7 @@ func (w *Worker) processTask(ctx context.Context, t task.Task) error {
 
 	duration := time.Since(start)
 	w.logger.Info().Int64("task_id", t.ID()).Str("operation", t.Operation().String()).Dur("duration", duration).Msg("task completed")
+	w.recordLog(t.ID(), task.LogLevelInfo, fmt.Sprintf("task completed in %s", duration))
 
 	return w.store.Delete(ctx, t)
 }
@@ -242,7 +439,13 @@ func (w *Worker) executeWithRecovery(ctx context.Context, h Handler, t task.Task
 			err = fmt.Errorf("handler panicked: %v", r)
 		}
 	}()
-	return h.Execute(ctx, t.Payload())
+
+	payload, err := task.UpgradePayload(t.Operation(), t.Payload())
+	if err != nil {
+		return fmt.Errorf("upgrade task payload: %w", err)
+	}
+
+	return h.Execute(ctx, payload)
 }
 
 // markStatusFailed updates the tracking status to failed for a task that errored.
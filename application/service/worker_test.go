@@ -4,16 +4,78 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/helixml/kodit/domain/task"
+	"github.com/helixml/kodit/domain/usage"
 	"github.com/helixml/kodit/infrastructure/persistence"
 	"github.com/helixml/kodit/internal/testdb"
 )
 
+func TestWorker_InstanceRegistry_RegistersAndDeregisters(t *testing.T) {
+	db := testdb.New(t)
+	store := persistence.NewTaskStore(db)
+	statusStore := persistence.NewStatusStore(db)
+	instances := persistence.NewWorkerInstanceStore(db)
+	ctx := context.Background()
+
+	registry := NewRegistry()
+	worker := NewWorker(store, statusStore, registry, nil, zerolog.Nop()).WithInstanceRegistry(instances)
+
+	require.NoError(t, worker.Start(ctx))
+
+	registered, err := instances.Find(ctx)
+	require.NoError(t, err)
+	require.Len(t, registered, 1)
+	assert.Equal(t, int64(0), registered[0].LeasedTaskID())
+
+	worker.Stop(5 * time.Second)
+
+	remaining, err := instances.Find(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, remaining)
+}
+
+func TestWorker_InstanceRegistry_TracksLeaseAndProcessedCount(t *testing.T) {
+	db := testdb.New(t)
+	store := persistence.NewTaskStore(db)
+	statusStore := persistence.NewStatusStore(db)
+	instances := persistence.NewWorkerInstanceStore(db)
+	ctx := context.Background()
+
+	tsk := task.NewTask(task.OperationSyncRepository, int(task.PriorityNormal), map[string]any{"repository_id": int64(1)})
+	_, err := store.Save(ctx, tsk)
+	require.NoError(t, err)
+
+	registry := NewRegistry()
+	var leasedDuringExecution int64
+	registry.Register(task.OperationSyncRepository, handlerFunc(func(_ context.Context, _ map[string]any) error {
+		leased, err := instances.Find(ctx)
+		require.NoError(t, err)
+		require.Len(t, leased, 1)
+		leasedDuringExecution = leased[0].LeasedTaskID()
+		return nil
+	}))
+
+	worker := NewWorker(store, statusStore, registry, nil, zerolog.Nop()).WithInstanceRegistry(instances)
+	require.NoError(t, worker.register(ctx))
+
+	found, err := worker.ProcessOne(ctx)
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.NotZero(t, leasedDuringExecution)
+
+	after, err := instances.Find(ctx)
+	require.NoError(t, err)
+	require.Len(t, after, 1)
+	assert.Equal(t, int64(0), after[0].LeasedTaskID())
+	assert.Equal(t, int64(1), after[0].ProcessedCount())
+}
+
 type handlerFunc func(ctx context.Context, payload map[string]any) error
 
 func (f handlerFunc) Execute(ctx context.Context, payload map[string]any) error {
@@ -101,6 +163,66 @@ func TestWorker_ProcessOne_FailedHandler(t *testing.T) {
 	assert.Equal(t, "handler failed", factory.trackers[0].failMsg)
 }
 
+func TestWorker_ProcessOne_DeadlinePausedHandler_Requeues(t *testing.T) {
+	db := testdb.New(t)
+	store := persistence.NewTaskStore(db)
+	ctx := context.Background()
+
+	tsk := task.NewTask(task.OperationSyncRepository, int(task.PriorityNormal), map[string]any{"repository_id": int64(1)})
+	_, err := store.Save(ctx, tsk)
+	require.NoError(t, err)
+
+	registry := NewRegistry()
+	registry.Register(task.OperationSyncRepository, handlerFunc(func(_ context.Context, _ map[string]any) error {
+		return task.ErrDeadlinePaused
+	}))
+
+	factory := &recordingTrackerFactory{}
+	statusStore := persistence.NewStatusStore(db)
+	worker := NewWorker(store, statusStore, registry, factory, zerolog.Nop())
+
+	found, err := worker.ProcessOne(ctx)
+	require.NoError(t, err)
+	assert.True(t, found)
+
+	// The task is requeued, not deleted, and no failure is recorded.
+	count, err := store.Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	require.Len(t, factory.trackers, 0)
+}
+
+func TestWorker_ProcessOne_BudgetExceededHandler_Requeues(t *testing.T) {
+	db := testdb.New(t)
+	store := persistence.NewTaskStore(db)
+	ctx := context.Background()
+
+	tsk := task.NewTask(task.OperationSyncRepository, int(task.PriorityNormal), map[string]any{"repository_id": int64(1)})
+	_, err := store.Save(ctx, tsk)
+	require.NoError(t, err)
+
+	registry := NewRegistry()
+	registry.Register(task.OperationSyncRepository, handlerFunc(func(_ context.Context, _ map[string]any) error {
+		return usage.ErrBudgetExceeded
+	}))
+
+	factory := &recordingTrackerFactory{}
+	statusStore := persistence.NewStatusStore(db)
+	worker := NewWorker(store, statusStore, registry, factory, zerolog.Nop())
+
+	found, err := worker.ProcessOne(ctx)
+	require.NoError(t, err)
+	assert.True(t, found)
+
+	// The task is requeued for a later window, not deleted, and no failure is recorded.
+	count, err := store.Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	require.Len(t, factory.trackers, 0)
+}
+
 func TestWorker_ProcessOne_EmptyQueue(t *testing.T) {
 	db := testdb.New(t)
 	store := persistence.NewTaskStore(db)
@@ -158,7 +280,7 @@ func TestWorker_Start_RecoverStaleStatuses(t *testing.T) {
 
 	err := worker.Start(ctx)
 	require.NoError(t, err)
-	worker.Stop()
+	worker.Stop(5 * time.Second)
 
 	all, err := statusStore.Find(ctx)
 	require.NoError(t, err)
@@ -184,7 +306,7 @@ func TestWorker_Start_NoStaleStatuses(t *testing.T) {
 
 	err := worker.Start(ctx)
 	require.NoError(t, err)
-	worker.Stop()
+	worker.Stop(5 * time.Second)
 }
 
 func TestWorker_ProcessOne_HighestPriorityFirst(t *testing.T) {
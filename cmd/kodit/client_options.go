@@ -19,6 +19,8 @@ func clientOptions(cfg config.AppConfig) ([]kodit.Option, error) {
 	var opts []kodit.Option
 
 	opts = append(opts, storageOptions(cfg)...)
+	opts = append(opts, searchProviderOptions(cfg)...)
+	opts = append(opts, cloneEncryptionOptions(cfg)...)
 
 	embOpts, err := embeddingOptions(cfg)
 	if err != nil {
@@ -60,11 +62,36 @@ func storageOptions(cfg config.AppConfig) []kodit.Option {
 	return []kodit.Option{kodit.WithSQLite(dbPath)}
 }
 
+// searchProviderOptions returns the kodit.Option for the configured vector
+// search backend, when it differs from the database's native store.
+func searchProviderOptions(cfg config.AppConfig) []kodit.Option {
+	switch cfg.SearchProvider() {
+	case "pgvector":
+		return []kodit.Option{kodit.WithPgVector()}
+	case "qdrant":
+		return []kodit.Option{kodit.WithQdrant(cfg.QdrantURL(), cfg.QdrantAPIKey())}
+	default:
+		return nil
+	}
+}
+
+// cloneEncryptionOptions returns a kodit.Option enabling at-rest working
+// copy encryption when a clone encryption key is configured.
+func cloneEncryptionOptions(cfg config.AppConfig) []kodit.Option {
+	if !cfg.CloneEncryption().Enabled() {
+		return nil
+	}
+	return []kodit.Option{kodit.WithCloneEncryptionKey(cfg.CloneEncryption().KeyHex())}
+}
+
 // embeddingOptions returns a kodit.Option for the embedding provider when the
 // embedding endpoint is fully configured, or an empty slice otherwise.
 func embeddingOptions(cfg config.AppConfig) ([]kodit.Option, error) {
 	endpoint := cfg.EmbeddingEndpoint()
-	if endpoint == nil || endpoint.BaseURL() == "" || endpoint.APIKey() == "" {
+	// Ollama is a self-hosted server with no API key model, so only base URL
+	// is required for it; every other provider type needs a key.
+	requiresAPIKey := endpoint != nil && endpoint.ProviderType() != "ollama"
+	if endpoint == nil || endpoint.BaseURL() == "" || (requiresAPIKey && endpoint.APIKey() == "") {
 		return nil, nil
 	}
 
@@ -90,7 +117,11 @@ func embeddingOptions(cfg config.AppConfig) ([]kodit.Option, error) {
 		}
 		opts = append(opts, kodit.WithCloser(transport))
 	}
-	p := provider.NewOpenAIProviderFromConfig(openaiCfg)
+
+	p, err := embeddingProviderFor(endpoint, openaiCfg)
+	if err != nil {
+		return nil, err
+	}
 
 	budget, err := search.NewTokenBudget(endpoint.MaxBatchChars())
 	if err != nil {
@@ -107,6 +138,71 @@ func embeddingOptions(cfg config.AppConfig) ([]kodit.Option, error) {
 	return opts, nil
 }
 
+// embeddingProviderFor builds the embedding provider selected by
+// endpoint.ProviderType(), reusing the OpenAI-shaped config already derived
+// from the endpoint for providers that are OpenAI wire-compatible.
+func embeddingProviderFor(endpoint *config.Endpoint, openaiCfg provider.OpenAIConfig) (search.Embedder, error) {
+	switch endpoint.ProviderType() {
+	case "", "openai":
+		return provider.NewOpenAIProviderFromConfig(openaiCfg), nil
+	case "azure_openai":
+		return provider.NewAzureOpenAIProviderFromConfig(provider.AzureOpenAIConfig{OpenAIConfig: openaiCfg}), nil
+	case "cohere":
+		return provider.NewCohereProviderFromConfig(provider.CohereConfig{
+			APIKey:     openaiCfg.APIKey,
+			BaseURL:    openaiCfg.BaseURL,
+			Model:      openaiCfg.EmbeddingModel,
+			Timeout:    openaiCfg.Timeout,
+			MaxRetries: openaiCfg.MaxRetries,
+		}), nil
+	case "voyage":
+		return provider.NewVoyageProviderFromConfig(provider.VoyageConfig{
+			APIKey:     openaiCfg.APIKey,
+			BaseURL:    openaiCfg.BaseURL,
+			Model:      openaiCfg.EmbeddingModel,
+			Timeout:    openaiCfg.Timeout,
+			MaxRetries: openaiCfg.MaxRetries,
+		}), nil
+	case "ollama":
+		return provider.NewOllamaProviderFromConfig(provider.OllamaConfig{
+			BaseURL:    openaiCfg.BaseURL,
+			Model:      openaiCfg.EmbeddingModel,
+			Timeout:    openaiCfg.Timeout,
+			MaxRetries: openaiCfg.MaxRetries,
+		}), nil
+	default:
+		return nil, fmt.Errorf("unsupported embedding endpoint type %q", endpoint.ProviderType())
+	}
+}
+
+// textProviderFor builds the text generation provider selected by
+// endpoint.ProviderType(), reusing the OpenAI-shaped config already derived
+// from the endpoint for providers that are OpenAI wire-compatible.
+func textProviderFor(endpoint *config.Endpoint, openaiCfg provider.OpenAIConfig) (provider.TextGenerator, error) {
+	switch endpoint.ProviderType() {
+	case "", "openai":
+		return provider.NewOpenAIProviderFromConfig(openaiCfg), nil
+	case "anthropic":
+		return provider.NewAnthropicProviderFromConfig(provider.AnthropicConfig{
+			APIKey:     openaiCfg.APIKey,
+			BaseURL:    openaiCfg.BaseURL,
+			Model:      openaiCfg.ChatModel,
+			Timeout:    openaiCfg.Timeout,
+			MaxRetries: openaiCfg.MaxRetries,
+		}), nil
+	case "gemini":
+		return provider.NewGeminiProviderFromConfig(provider.GeminiConfig{
+			APIKey:     openaiCfg.APIKey,
+			BaseURL:    openaiCfg.BaseURL,
+			Model:      openaiCfg.ChatModel,
+			Timeout:    openaiCfg.Timeout,
+			MaxRetries: openaiCfg.MaxRetries,
+		}), nil
+	default:
+		return nil, fmt.Errorf("unsupported enrichment endpoint type %q", endpoint.ProviderType())
+	}
+}
+
 // textOptions returns a kodit.Option for the text generation provider when the
 // enrichment endpoint is fully configured, or an empty slice otherwise.
 func textOptions(cfg config.AppConfig) ([]kodit.Option, error) {
@@ -136,7 +232,11 @@ func textOptions(cfg config.AppConfig) ([]kodit.Option, error) {
 		}
 		opts = append(opts, kodit.WithCloser(transport))
 	}
-	p := provider.NewOpenAIProviderFromConfig(txtCfg)
+
+	p, err := textProviderFor(endpoint, txtCfg)
+	if err != nil {
+		return nil, err
+	}
 
 	budget, err := search.NewTokenBudget(endpoint.MaxBatchChars())
 	if err != nil {
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// healthcheckCmd builds the "kodit healthcheck" subcommand, a small CLI
+// wrapper around GET /readyz suitable for a Docker HEALTHCHECK instruction:
+// it loads the same configuration as `kodit serve` to determine the
+// listening address, and exits non-zero unless the server reports ready.
+func healthcheckCmd() *cobra.Command {
+	var (
+		envFile string
+		timeout time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "healthcheck",
+		Short: "Check server readiness (for use as a Docker HEALTHCHECK)",
+		Long: `Check server readiness by requesting /readyz on the configured address.
+
+Exits 0 if the server reports ready, non-zero otherwise. Intended for use
+as a container HEALTHCHECK command, e.g.:
+
+  HEALTHCHECK CMD kodit healthcheck || exit 1`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runHealthcheck(envFile, timeout)
+		},
+	}
+
+	cmd.Flags().StringVar(&envFile, "env-file", "", "Path to .env file (default: .env in current directory)")
+	cmd.Flags().DurationVar(&timeout, "timeout", 5*time.Second, "Request timeout")
+
+	return cmd
+}
+
+func runHealthcheck(envFile string, timeout time.Duration) error {
+	cfg, err := loadConfig(envFile)
+	if err != nil {
+		return err
+	}
+
+	scheme := "http"
+	if cfg.TLS().Enabled() {
+		scheme = "https"
+	}
+	url := fmt.Sprintf("%s://%s/readyz", scheme, cfg.Addr())
+
+	client := &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			// Health checks run against the server's own listener, often with
+			// a self-signed or internally-issued certificate; readiness is
+			// about liveness of the process, not certificate trust.
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, //nolint:gosec
+		},
+	}
+
+	resp, err := client.Get(url)
+	if err != nil {
+		return fmt.Errorf("readyz request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("server not ready: %s returned %d", url, resp.StatusCode)
+	}
+
+	return nil
+}
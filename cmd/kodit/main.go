@@ -41,6 +41,9 @@ func rootCmd() *cobra.Command {
 
 	cmd.AddCommand(serveCmd())
 	cmd.AddCommand(versionCmd())
+	cmd.AddCommand(migrateFromPythonCmd())
+	cmd.AddCommand(queueCmd())
+	cmd.AddCommand(healthcheckCmd())
 
 	return cmd
 }
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/helixml/kodit/infrastructure/migration"
+	"github.com/helixml/kodit/infrastructure/persistence"
+	"github.com/helixml/kodit/internal/config"
+	"github.com/helixml/kodit/internal/database"
+	"github.com/helixml/kodit/internal/log"
+)
+
+func migrateFromPythonCmd() *cobra.Command {
+	var (
+		envFile string
+		dbURL   string
+		dryRun  bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "migrate-from-python",
+		Short: "Import a legacy Python-era kodit database",
+		Long: `Import a legacy Python-era kodit database into the database configured for
+this kodit instance.
+
+Embeddings are copied directly. Legacy snippets and indexes are counted and
+validated rather than copied, since this version of kodit recomputes
+snippet text from the Git blob at search time instead of storing it — run
+a repository sync afterwards to rebuild them.
+
+Target configuration (DB_URL, DATA_DIR, etc.) is loaded the same way as
+"kodit serve".`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMigrateFromPython(envFile, dbURL, dryRun)
+		},
+	}
+
+	cmd.Flags().StringVar(&envFile, "env-file", "", "Path to .env file (default: .env in current directory)")
+	cmd.Flags().StringVar(&dbURL, "db-url", "", "Connection URL of the legacy Python-era database to import from (required)")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Report what would be imported without writing anything")
+	_ = cmd.MarkFlagRequired("db-url")
+
+	return cmd
+}
+
+func runMigrateFromPython(envFile, sourceURL string, dryRun bool) error {
+	cfg, err := loadConfig(envFile)
+	if err != nil {
+		return err
+	}
+
+	logger := log.NewLogger(cfg)
+	log.SetDefaultLogger(logger)
+	zlog := logger.Zerolog()
+
+	ctx := context.Background()
+
+	source, err := database.NewDatabase(ctx, sourceURL)
+	if err != nil {
+		return fmt.Errorf("open source database: %w", err)
+	}
+	defer func() { _ = source.Close() }()
+
+	if err := cfg.EnsureDataDir(); err != nil {
+		return fmt.Errorf("create data directory: %w", err)
+	}
+
+	target, err := database.NewDatabase(ctx, targetDBURL(cfg))
+	if err != nil {
+		return fmt.Errorf("open target database: %w", err)
+	}
+	defer func() { _ = target.Close() }()
+
+	if err := persistence.PreMigrate(target); err != nil {
+		return fmt.Errorf("pre migrate target: %w", err)
+	}
+	if err := persistence.AutoMigrate(target); err != nil {
+		return fmt.Errorf("auto migrate target: %w", err)
+	}
+
+	importer := migration.NewPythonImporter(source, target, zlog)
+	report, err := importer.Import(ctx, dryRun)
+	if err != nil {
+		return fmt.Errorf("import from python database: %w", err)
+	}
+
+	fmt.Print(report.String())
+	return nil
+}
+
+// targetDBURL resolves the connection URL for the kodit-managed database
+// from AppConfig, following the same precedence as clientOptions/storageOptions.
+func targetDBURL(cfg config.AppConfig) string {
+	dbURL := cfg.DBURL()
+	if dbURL != "" && !isSQLite(dbURL) {
+		return dbURL
+	}
+
+	dbPath := cfg.DataDir() + "/kodit.db"
+	if dbURL != "" && isSQLite(dbURL) {
+		dbPath = strings.TrimPrefix(dbURL, "sqlite:///")
+		if dbPath == dbURL {
+			dbPath = strings.TrimPrefix(dbURL, "sqlite:")
+		}
+	}
+	return "sqlite:///" + dbPath
+}
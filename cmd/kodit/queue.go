@@ -0,0 +1,413 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/helixml/kodit"
+	"github.com/helixml/kodit/application/service"
+	"github.com/helixml/kodit/domain/repository"
+	"github.com/helixml/kodit/domain/task"
+)
+
+func queueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "queue",
+		Short: "Administer the task queue",
+		Long: `Administer the task queue directly against the configured database.
+
+These subcommands bypass any running "kodit serve" process and operate on
+the database the same way "kodit serve" would, using the same DB_URL/DATA_DIR
+configuration. Run them against a server's database only while the server
+is stopped, or be aware that a running worker may race with you.`,
+	}
+
+	cmd.AddCommand(queueLsCmd())
+	cmd.AddCommand(queueRetryCmd())
+	cmd.AddCommand(queueCancelCmd())
+	cmd.AddCommand(queuePurgeCmd())
+	cmd.AddCommand(queueSimulateCmd())
+
+	return cmd
+}
+
+func queueLsCmd() *cobra.Command {
+	var (
+		envFile   string
+		operation string
+		repoRef   string
+		limit     int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "ls",
+		Short: "List queued tasks",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runQueueLs(envFile, operation, repoRef, limit)
+		},
+	}
+
+	cmd.Flags().StringVar(&envFile, "env-file", "", "Path to .env file (default: .env in current directory)")
+	cmd.Flags().StringVar(&operation, "operation", "", "Filter by operation (e.g. kodit.commit.scan)")
+	cmd.Flags().StringVar(&repoRef, "repo", "", "Filter by repository ID or remote URL")
+	cmd.Flags().IntVar(&limit, "limit", 50, "Maximum number of tasks to list")
+
+	return cmd
+}
+
+func queueRetryCmd() *cobra.Command {
+	var (
+		envFile   string
+		operation string
+		repoRef   string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "retry",
+		Short: "Re-enqueue an operation that previously failed for a repository",
+		Long: `Re-enqueue an operation that previously failed for a repository.
+
+Tasks carry no status of their own — once a handler runs, the task row is
+gone. "retry" instead looks at the repository's recorded task statuses for
+a failure in the given operation, and if one is found, enqueues a fresh
+task for it. Handlers check for existing work before redoing it, so this
+is safe to run even if some of the operation already succeeded.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runQueueRetry(envFile, operation, repoRef)
+		},
+	}
+
+	cmd.Flags().StringVar(&envFile, "env-file", "", "Path to .env file (default: .env in current directory)")
+	cmd.Flags().StringVar(&operation, "operation", "", "Operation to retry (required)")
+	cmd.Flags().StringVar(&repoRef, "repo", "", "Repository ID or remote URL (required)")
+	_ = cmd.MarkFlagRequired("operation")
+	_ = cmd.MarkFlagRequired("repo")
+
+	return cmd
+}
+
+func queueCancelCmd() *cobra.Command {
+	var (
+		envFile string
+		yes     bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "cancel <task-id>",
+		Short: "Remove a single queued task",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid task id %q: %w", args[0], err)
+			}
+			return runQueueCancel(envFile, id, yes)
+		},
+	}
+
+	cmd.Flags().StringVar(&envFile, "env-file", "", "Path to .env file (default: .env in current directory)")
+	cmd.Flags().BoolVar(&yes, "yes", false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+func queuePurgeCmd() *cobra.Command {
+	var (
+		envFile   string
+		operation string
+		repoRef   string
+		yes       bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "purge",
+		Short: "Remove every queued task matching --operation and/or --repo",
+		Long: `Remove every queued task matching --operation and/or --repo.
+
+At least one of --operation or --repo must be given, to avoid accidentally
+purging the entire queue with a bare "kodit queue purge".`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runQueuePurge(envFile, operation, repoRef, yes)
+		},
+	}
+
+	cmd.Flags().StringVar(&envFile, "env-file", "", "Path to .env file (default: .env in current directory)")
+	cmd.Flags().StringVar(&operation, "operation", "", "Only purge tasks for this operation")
+	cmd.Flags().StringVar(&repoRef, "repo", "", "Only purge tasks for this repository ID or remote URL")
+	cmd.Flags().BoolVar(&yes, "yes", false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+func queueSimulateCmd() *cobra.Command {
+	var (
+		envFile   string
+		repoRef   string
+		commitSHA string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "simulate <operation>",
+		Short: "Show what an operation would do for a repository, without running it",
+		Long: `Show what an operation would do for a repository, without running it.
+
+Runs the operation's planning logic directly against the configured
+database — no queue entry is created and no handler side effects occur.
+Not every operation supports simulation; operations that don't print an
+error saying so. Prints the resulting plan as JSON.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runQueueSimulate(envFile, args[0], repoRef, commitSHA)
+		},
+	}
+
+	cmd.Flags().StringVar(&envFile, "env-file", "", "Path to .env file (default: .env in current directory)")
+	cmd.Flags().StringVar(&repoRef, "repo", "", "Repository ID or remote URL (required)")
+	cmd.Flags().StringVar(&commitSHA, "commit", "", "Commit SHA, for operations scoped to a commit")
+	_ = cmd.MarkFlagRequired("repo")
+
+	return cmd
+}
+
+func runQueueLs(envFile, operation, repoRef string, limit int) error {
+	client, err := openQueueClient(envFile)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = client.Close() }()
+
+	ctx := context.Background()
+
+	params := &service.TaskListParams{Limit: limit}
+	if operation != "" {
+		op := task.Operation(operation)
+		params.Operation = &op
+	}
+
+	tasks, err := client.Tasks.List(ctx, params)
+	if err != nil {
+		return fmt.Errorf("list tasks: %w", err)
+	}
+
+	if repoRef != "" {
+		repoID, err := resolveRepoID(ctx, client, repoRef)
+		if err != nil {
+			return err
+		}
+		tasks = filterTasksByRepo(tasks, repoID)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 2, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tOPERATION\tPRIORITY\tCREATED\tPAYLOAD")
+	for _, t := range tasks {
+		fmt.Fprintf(w, "%d\t%s\t%d\t%s\t%v\n", t.ID(), t.Operation(), t.Priority(), t.CreatedAt().Format("2006-01-02T15:04:05Z"), t.Payload())
+	}
+	return w.Flush()
+}
+
+func runQueueRetry(envFile, operation, repoRef string) error {
+	client, err := openQueueClient(envFile)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = client.Close() }()
+
+	ctx := context.Background()
+
+	repoID, err := resolveRepoID(ctx, client, repoRef)
+	if err != nil {
+		return err
+	}
+
+	requeued, err := client.Tasks.RetryFailed(ctx, task.Operation(operation), repoID)
+	if err != nil {
+		return fmt.Errorf("retry %s for repository %d: %w", operation, repoID, err)
+	}
+	if requeued == 0 {
+		fmt.Printf("no failed %q status found for repository %d; nothing to retry\n", operation, repoID)
+		return nil
+	}
+	fmt.Printf("re-enqueued %s for repository %d\n", operation, repoID)
+	return nil
+}
+
+func runQueueSimulate(envFile, operation, repoRef, commitSHA string) error {
+	client, err := openQueueClient(envFile)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = client.Close() }()
+
+	ctx := context.Background()
+
+	repoID, err := resolveRepoID(ctx, client, repoRef)
+	if err != nil {
+		return err
+	}
+
+	payload := map[string]any{"repository_id": repoID}
+	if commitSHA != "" {
+		payload["commit_sha"] = commitSHA
+	}
+
+	plan, err := client.Simulate(ctx, task.Operation(operation), payload)
+	if err != nil {
+		return fmt.Errorf("simulate %s for repository %d: %w", operation, repoID, err)
+	}
+
+	return json.NewEncoder(os.Stdout).Encode(queueSimulatePlan{
+		Operation:   string(plan.Operation()),
+		Description: plan.Description(),
+		ItemCount:   plan.ItemCount(),
+		EstimatedMS: plan.EstimatedDuration().Milliseconds(),
+	})
+}
+
+// queueSimulatePlan is the JSON shape printed by "kodit queue simulate".
+type queueSimulatePlan struct {
+	Operation   string `json:"operation"`
+	Description string `json:"description"`
+	ItemCount   int    `json:"item_count"`
+	EstimatedMS int64  `json:"estimated_ms"`
+}
+
+func runQueueCancel(envFile string, id int64, yes bool) error {
+	client, err := openQueueClient(envFile)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = client.Close() }()
+
+	ctx := context.Background()
+
+	t, err := client.Tasks.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("find task %d: %w", id, err)
+	}
+
+	if !yes && !confirm(fmt.Sprintf("cancel task %d (%s)?", t.ID(), t.Operation())) {
+		fmt.Println("aborted")
+		return nil
+	}
+
+	if err := client.Tasks.Remove(ctx, id); err != nil {
+		return fmt.Errorf("cancel task %d: %w", id, err)
+	}
+	fmt.Printf("cancelled task %d\n", id)
+	return nil
+}
+
+func runQueuePurge(envFile, operation, repoRef string, yes bool) error {
+	if operation == "" && repoRef == "" {
+		return fmt.Errorf("purge requires --operation and/or --repo")
+	}
+
+	client, err := openQueueClient(envFile)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = client.Close() }()
+
+	ctx := context.Background()
+
+	filter := service.PurgeFilter{}
+	if operation != "" {
+		op := task.Operation(operation)
+		filter.Operation = &op
+	}
+	if repoRef != "" {
+		repoID, err := resolveRepoID(ctx, client, repoRef)
+		if err != nil {
+			return err
+		}
+		filter.RepoID = &repoID
+	}
+
+	if !yes && !confirm(fmt.Sprintf("purge all queued tasks matching operation=%q repo=%q?", operation, repoRef)) {
+		fmt.Println("aborted")
+		return nil
+	}
+
+	removed, err := client.Tasks.PurgeBy(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("purge tasks: %w", err)
+	}
+	fmt.Printf("purged %d task(s)\n", removed)
+	return nil
+}
+
+// openQueueClient builds a kodit.Client wired to the configured database
+// only — queue administration needs no embedding or text provider.
+func openQueueClient(envFile string) (*kodit.Client, error) {
+	cfg, err := loadConfig(envFile)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := kodit.New(storageOptions(cfg)...)
+	if err != nil {
+		return nil, fmt.Errorf("open kodit client: %w", err)
+	}
+	return client, nil
+}
+
+// resolveRepoID resolves ref to a repository ID, accepting either a numeric
+// ID or a remote URL.
+func resolveRepoID(ctx context.Context, client *kodit.Client, ref string) (int64, error) {
+	if id, err := strconv.ParseInt(ref, 10, 64); err == nil {
+		return id, nil
+	}
+
+	repos, err := client.Repositories.Find(ctx, repository.WithRemoteURL(ref))
+	if err != nil {
+		return 0, fmt.Errorf("find repository %q: %w", ref, err)
+	}
+	if len(repos) == 0 {
+		repos, err = client.Repositories.Find(ctx, repository.WithUpstreamURL(ref))
+		if err != nil {
+			return 0, fmt.Errorf("find repository %q: %w", ref, err)
+		}
+	}
+	if len(repos) == 0 {
+		return 0, fmt.Errorf("repository not found: %s", ref)
+	}
+	return repos[0].ID(), nil
+}
+
+func filterTasksByRepo(tasks []task.Task, repoID int64) []task.Task {
+	filtered := make([]task.Task, 0, len(tasks))
+	for _, t := range tasks {
+		val, ok := t.Payload()["repository_id"]
+		if !ok {
+			continue
+		}
+		switch v := val.(type) {
+		case int64:
+			if v == repoID {
+				filtered = append(filtered, t)
+			}
+		case float64:
+			if int64(v) == repoID {
+				filtered = append(filtered, t)
+			}
+		}
+	}
+	return filtered
+}
+
+func confirm(prompt string) bool {
+	fmt.Printf("%s [y/N] ", prompt)
+	reader := bufio.NewReader(os.Stdin)
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return false
+	}
+	return line == "y\n" || line == "Y\n" || line == "yes\n"
+}
@@ -6,23 +6,30 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
+	"github.com/go-chi/chi/v5"
+	"github.com/spf13/cobra"
+
 	"github.com/helixml/kodit"
+	"github.com/helixml/kodit/application/service"
+	"github.com/helixml/kodit/domain/search"
 	"github.com/helixml/kodit/infrastructure/api"
 	apimiddleware "github.com/helixml/kodit/infrastructure/api/middleware"
 	"github.com/helixml/kodit/infrastructure/chunking"
 	"github.com/helixml/kodit/internal/config"
 	"github.com/helixml/kodit/internal/log"
-	"github.com/spf13/cobra"
 )
 
 func serveCmd() *cobra.Command {
 	var (
-		envFile string
-		host    string
-		port    int
+		envFile        string
+		host           string
+		port           int
+		waitMigrations bool
 	)
 
 	cmd := &cobra.Command{
@@ -63,22 +70,37 @@ Environment variables:
   REMOTE_SERVER_URL            Remote Kodit server URL
   REMOTE_API_KEY               Remote server API key
 
-  HTTP_CACHE_DIR               Directory for caching HTTP POST responses on disk`,
+  WARM_UP_ENABLED              Preload frequently accessed repositories' indexes on startup (default: false)
+  WARM_UP_REPO_LIMIT           Maximum repositories to warm up (default: 5)
+  WARM_UP_QUERIES              Comma-separated representative queries to run during warm-up (default: function)
+
+  HTTP_CACHE_DIR               Directory for caching HTTP POST responses on disk
+
+  EMBEDDING_DIMENSIONS         Truncate stored/query embeddings to N dimensions (default: 0, disabled)
+
+  TLS_CERT_FILE                Server TLS certificate file; enables HTTPS when set with TLS_KEY_FILE
+  TLS_KEY_FILE                 Server TLS private key file; reloaded automatically on rotation
+  TLS_CLIENT_CA_FILE           CA bundle for verifying client certificates (enables mutual TLS)
+  TLS_REQUIRE_CLIENT_CERT      Reject requests without a verified client certificate (default: false)
+
+  CLONE_ENCRYPTION_KEY         Hex-encoded AES-256 key (64 hex chars) for encrypting Git working copies at rest`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runServe(envFile, host, port)
+			return runServe(envFile, host, port, waitMigrations)
 		},
 	}
 
 	cmd.Flags().StringVar(&envFile, "env-file", "", "Path to .env file (default: .env in current directory)")
 	cmd.Flags().StringVar(&host, "host", "", "Deprecated: use HOST environment variable instead")
 	cmd.Flags().IntVar(&port, "port", 0, "Deprecated: use PORT environment variable instead")
+	cmd.Flags().BoolVar(&waitMigrations, "wait-migrations", false,
+		"Bind the HTTP listener immediately and report /readyz as unavailable until migrations and the first periodic sync pass complete, instead of blocking startup on them")
 	_ = cmd.Flags().MarkDeprecated("host", "use the HOST environment variable instead")
 	_ = cmd.Flags().MarkDeprecated("port", "use the PORT environment variable instead")
 
 	return cmd
 }
 
-func runServe(envFile, host string, port int) error {
+func runServe(envFile, host string, port int, waitMigrations bool) error {
 	// Load configuration
 	cfg, err := loadConfig(envFile)
 	if err != nil {
@@ -103,82 +125,152 @@ func runServe(envFile, host string, port int) error {
 	log.SetDefaultLogger(logger)
 	zlog := logger.Zerolog()
 
-	// Build kodit client options from shared config (database, embedding, text)
-	opts, err := clientOptions(cfg)
+	// Create standalone server up front so the listener can bind before the
+	// kodit client (and its migrations) finish, when --wait-migrations is set.
+	server := api.NewServer(addr, zlog)
+	server, err = server.WithTLS(cfg.TLS())
 	if err != nil {
-		return fmt.Errorf("build client options: %w", err)
+		return fmt.Errorf("configure tls: %w", err)
 	}
-	opts = append(opts,
-		kodit.WithDataDir(cfg.DataDir()),
-		kodit.WithCloneDir(cfg.CloneDir()),
-		kodit.WithLogger(zlog),
-	)
 
-	// Configure API keys
-	if keys := cfg.APIKeys(); len(keys) > 0 {
-		opts = append(opts, kodit.WithAPIKeys(keys...))
-	}
+	// The gateway owns liveness/readiness endpoints directly, and forwards
+	// everything else to the full API router once it's built. Its /readyz
+	// takes precedence over any later attempt to register the same path on
+	// the API router, so readiness always reflects this process's own view.
+	ready := service.NewReadiness()
+	var apiHandler atomic.Pointer[http.Handler]
+	gateway := chi.NewRouter()
+	gateway.Get("/health", healthHandler)
+	gateway.Get("/healthz", healthHandler)
+	gateway.Get("/readyz", readyzHandler(ready))
+	gateway.Handle("/*", startupGatewayHandler(&apiHandler))
+	server.Router().Mount("/", gateway)
+
+	var clientMu sync.Mutex
+	var client *kodit.Client
+
+	buildClient := func() error {
+		// Build kodit client options from shared config (database, embedding, text)
+		opts, err := clientOptions(cfg)
+		if err != nil {
+			return fmt.Errorf("build client options: %w", err)
+		}
+		opts = append(opts,
+			kodit.WithDataDir(cfg.DataDir()),
+			kodit.WithCloneDir(cfg.CloneDir()),
+			kodit.WithLogger(zlog),
+		)
+
+		// Configure API keys
+		if keys := cfg.APIKeys(); len(keys) > 0 {
+			opts = append(opts, kodit.WithAPIKeys(keys...))
+		}
 
-	// Configure worker count
-	if cfg.WorkerCount() > 0 {
-		opts = append(opts, kodit.WithWorkerCount(cfg.WorkerCount()))
-	}
+		// Configure worker count
+		if cfg.WorkerCount() > 0 {
+			opts = append(opts, kodit.WithWorkerCount(cfg.WorkerCount()))
+		}
 
-	// Configure periodic sync
-	opts = append(opts, kodit.WithPeriodicSyncConfig(cfg.PeriodicSync()))
+		// Configure worker drain timeout
+		if cfg.WorkerDrainTimeout() > 0 {
+			opts = append(opts, kodit.WithWorkerDrainTimeout(cfg.WorkerDrainTimeout()))
+		}
 
-	// Skip provider validation if explicitly disabled (for testing)
-	if cfg.SkipProviderValidation() {
-		opts = append(opts, kodit.WithSkipProviderValidation())
-	}
+		// Configure periodic sync
+		opts = append(opts, kodit.WithPeriodicSyncConfig(cfg.PeriodicSync()))
 
-	// Chunk parameters
-	if cfg.ChunkSize() > 0 && cfg.ChunkOverlap() > 0 && cfg.ChunkMinSize() > 0 {
-		opts = append(opts, kodit.WithChunkParams(chunking.ChunkParams{
-			Size:    cfg.ChunkSize(),
-			Overlap: cfg.ChunkOverlap(),
-			MinSize: cfg.ChunkMinSize(),
-		}))
-	}
+		// Configure repository health alerting
+		opts = append(opts, kodit.WithHealthAlertConfig(cfg.HealthAlert()))
 
-	// Create kodit client and log settings
-	cfg.LogConfig(zlog.Info().Str("version", version)).Msg("starting kodit")
+		// Configure index warm-up
+		opts = append(opts, kodit.WithWarmUpConfig(cfg.WarmUp()))
 
-	client, err := kodit.New(opts...)
-	if err != nil {
-		return fmt.Errorf("create kodit client: %w", err)
-	}
-	defer func() {
-		if err := client.Close(); err != nil {
-			zlog.Error().Interface("error", err).Msg("failed to close kodit client")
+		// Configure periodic vector store compaction
+		opts = append(opts, kodit.WithCompactionConfig(cfg.Compaction()))
+
+		// Skip provider validation if explicitly disabled (for testing)
+		if cfg.SkipProviderValidation() {
+			opts = append(opts, kodit.WithSkipProviderValidation())
 		}
-	}()
 
-	// Create API server with the client's services
-	apiServer := api.NewAPIServer(client, cfg.APIKeys())
-	router := apiServer.Router()
+		// Chunk parameters
+		if cfg.ChunkSize() > 0 && cfg.ChunkOverlap() > 0 && cfg.ChunkMinSize() > 0 {
+			opts = append(opts, kodit.WithChunkParams(chunking.ChunkParams{
+				Size:    cfg.ChunkSize(),
+				Overlap: cfg.ChunkOverlap(),
+				MinSize: cfg.ChunkMinSize(),
+			}))
+		}
 
-	// Apply custom middleware (MUST be done before MountRoutes)
-	router.Use(apimiddleware.Logging(zlog))
-	router.Use(apimiddleware.CorrelationID)
+		// Truncated embedding dimensions (Matryoshka models)
+		if cfg.EmbeddingDimensions() > 0 {
+			opts = append(opts, kodit.WithEmbeddingDimensions(cfg.EmbeddingDimensions()))
+		}
 
-	// Mount API routes after middleware is configured
-	apiServer.MountRoutes()
+		// Embedding context header templates
+		if cfg.EmbeddingContextTemplate() != "" || len(cfg.EmbeddingContextLanguageTemplates()) > 0 {
+			opts = append(opts, kodit.WithContextTemplates(search.NewContextTemplateConfig(
+				cfg.EmbeddingContextTemplate(), cfg.EmbeddingContextLanguageTemplates(),
+			)))
+		}
 
-	// Health check endpoints
-	router.Get("/health", healthHandler)
-	router.Get("/healthz", healthHandler)
+		// Create kodit client and log settings. This runs migrations, so with
+		// --wait-migrations it happens off the goroutine that bound the listener.
+		cfg.LogConfig(zlog.Info().Str("version", version)).Msg("starting kodit")
 
-	// Root endpoint with API info
-	router.Get("/", func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusOK)
-		_, _ = fmt.Fprintf(w, `{"name":"kodit","version":"%s","docs":"/docs"}`, version)
-	})
+		c, err := kodit.New(opts...)
+		if err != nil {
+			return fmt.Errorf("create kodit client: %w", err)
+		}
+
+		clientMu.Lock()
+		client = c
+		clientMu.Unlock()
 
-	// Documentation routes
-	docsRouter := apiServer.DocsRouter("/docs/openapi.json")
-	router.Mount("/docs", docsRouter.Routes())
+		// Create API server with the client's services
+		apiServer := api.NewAPIServer(c, cfg.APIKeys())
+		router := apiServer.Router()
+
+		// Apply custom middleware (MUST be done before MountRoutes)
+		router.Use(apimiddleware.Logging(zlog))
+		router.Use(apimiddleware.CorrelationID)
+
+		// Mount API routes after middleware is configured
+		apiServer.MountRoutes()
+
+		// Root endpoint with API info
+		router.Get("/", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = fmt.Fprintf(w, `{"name":"kodit","version":"%s","docs":"/docs"}`, version)
+		})
+
+		// Documentation routes
+		docsRouter := apiServer.DocsRouter("/docs/openapi.json")
+		router.Mount("/docs", docsRouter.Routes())
+
+		var h http.Handler = router
+		apiHandler.Store(&h)
+
+		// Wait for the scheduler's first pass before declaring readiness, so
+		// /readyz reflects a server that has actually looked for work to do,
+		// not just one that finished migrating.
+		<-c.StartupComplete()
+		ready.MarkReady()
+
+		return nil
+	}
+
+	if waitMigrations {
+		go func() {
+			if err := buildClient(); err != nil {
+				zlog.Error().Interface("error", err).Msg("startup failed")
+				ready.MarkFailed(err)
+			}
+		}()
+	} else if err := buildClient(); err != nil {
+		return err
+	}
 
 	// Setup graceful shutdown
 	_, cancel := context.WithCancel(context.Background())
@@ -187,10 +279,6 @@ func runServe(envFile, host string, port int) error {
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
 
-	// Create standalone server for custom router
-	server := api.NewServer(addr, zlog)
-	server.Router().Mount("/", router)
-
 	go func() {
 		<-sigChan
 		zlog.Info().Msg("shutting down server")
@@ -204,8 +292,19 @@ func runServe(envFile, host string, port int) error {
 	}()
 
 	zlog.Info().Str("addr", addr).Msg("starting server")
-	if err := server.Start(); err != nil {
-		return fmt.Errorf("server error: %w", err)
+	startErr := server.Start()
+
+	clientMu.Lock()
+	c := client
+	clientMu.Unlock()
+	if c != nil {
+		if err := c.Close(); err != nil {
+			zlog.Error().Interface("error", err).Msg("failed to close kodit client")
+		}
+	}
+
+	if startErr != nil {
+		return fmt.Errorf("server error: %w", startErr)
 	}
 
 	return nil
@@ -217,6 +316,43 @@ func healthHandler(w http.ResponseWriter, _ *http.Request) {
 	_, _ = w.Write([]byte(`{"status":"healthy"}`))
 }
 
+// readyzHandler reports startup readiness: 503 while migrations or the
+// first periodic sync pass are still in progress (or if startup failed),
+// 200 once the server can actually serve requests.
+func readyzHandler(ready *service.Readiness) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := ready.Err(); err != nil {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = fmt.Fprintf(w, `{"status":"failed","error":%q}`, err.Error())
+			return
+		}
+		if !ready.Ready() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"status":"starting"}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"status":"ready"}`))
+	}
+}
+
+// startupGatewayHandler forwards requests to the API router once it has
+// been built, and reports 503 for any route the gateway doesn't own itself
+// (i.e. anything but /health, /healthz, /readyz) until then.
+func startupGatewayHandler(apiHandler *atomic.Pointer[http.Handler]) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		h := apiHandler.Load()
+		if h == nil {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"status":"starting"}`))
+			return
+		}
+		(*h).ServeHTTP(w, r)
+	}
+}
+
 // applyServeOverrides applies command line flag overrides to the config.
 func applyServeOverrides(cfg config.AppConfig, host string, port int) config.AppConfig {
 	var opts []config.AppConfigOption
@@ -0,0 +1,49 @@
+// Package activity provides the repository activity feed domain types.
+package activity
+
+import "time"
+
+// EventType categorizes an entry in a repository's activity feed.
+type EventType string
+
+// EventType values.
+const (
+	EventTypeCommitIndexed       EventType = "commit_indexed"
+	EventTypeEnrichmentGenerated EventType = "enrichment_generated"
+	EventTypeSync                EventType = "sync"
+	EventTypeFailure             EventType = "failure"
+)
+
+// Event is a single entry in a repository's activity feed: something that
+// happened to a repository, reduced to a type, a timestamp, and a short
+// human-readable message so a dashboard can render a unified timeline
+// without knowing about commits, enrichments, or tasks individually.
+type Event struct {
+	eventType EventType
+	timestamp time.Time
+	message   string
+	commitSHA string
+}
+
+// NewEvent creates an Event. commitSHA may be empty for events that are not
+// tied to a specific commit.
+func NewEvent(eventType EventType, timestamp time.Time, message, commitSHA string) Event {
+	return Event{
+		eventType: eventType,
+		timestamp: timestamp,
+		message:   message,
+		commitSHA: commitSHA,
+	}
+}
+
+// Type returns the event's category.
+func (e Event) Type() EventType { return e.eventType }
+
+// Timestamp returns when the event occurred.
+func (e Event) Timestamp() time.Time { return e.timestamp }
+
+// Message returns a short human-readable description of the event.
+func (e Event) Message() string { return e.message }
+
+// CommitSHA returns the commit the event relates to, or "" if none.
+func (e Event) CommitSHA() string { return e.commitSHA }
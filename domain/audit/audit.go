@@ -0,0 +1,51 @@
+// Package audit records security-relevant denials — currently, repository
+// path ACL enforcement — so operators can review who was blocked from
+// reading what, and when.
+package audit
+
+import "time"
+
+// Event records one denied access attempt. This is an immutable value object.
+type Event struct {
+	id           int64
+	repositoryID string
+	path         string
+	reason       string
+	createdAt    time.Time
+}
+
+// New creates an Event for a denial that just happened (not yet persisted).
+func New(repositoryID, path, reason string) Event {
+	return Event{
+		repositoryID: repositoryID,
+		path:         path,
+		reason:       reason,
+		createdAt:    time.Now(),
+	}
+}
+
+// Reconstruct recreates an Event from persistence.
+func Reconstruct(id int64, repositoryID, path, reason string, createdAt time.Time) Event {
+	return Event{
+		id:           id,
+		repositoryID: repositoryID,
+		path:         path,
+		reason:       reason,
+		createdAt:    createdAt,
+	}
+}
+
+// ID returns the database identifier.
+func (e Event) ID() int64 { return e.id }
+
+// RepositoryID returns the repository the denied access was scoped to.
+func (e Event) RepositoryID() string { return e.repositoryID }
+
+// Path returns the file path whose access was denied.
+func (e Event) Path() string { return e.path }
+
+// Reason returns why access was denied (e.g. the deny glob that matched).
+func (e Event) Reason() string { return e.reason }
+
+// CreatedAt returns when the denial was recorded.
+func (e Event) CreatedAt() time.Time { return e.createdAt }
@@ -0,0 +1,8 @@
+package audit
+
+import "github.com/helixml/kodit/domain/repository"
+
+// WithRepositoryID filters by the "repository_id" column.
+func WithRepositoryID(id string) repository.Option {
+	return repository.WithCondition("repository_id", id)
+}
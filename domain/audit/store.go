@@ -0,0 +1,8 @@
+package audit
+
+import "github.com/helixml/kodit/domain/repository"
+
+// Store defines persistence for audit events.
+type Store interface {
+	repository.Store[Event]
+}
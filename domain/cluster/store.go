@@ -0,0 +1,8 @@
+package cluster
+
+import "github.com/helixml/kodit/domain/repository"
+
+// Store defines persistence for registered worker instances.
+type Store interface {
+	repository.Store[Worker]
+}
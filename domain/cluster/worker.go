@@ -0,0 +1,105 @@
+// Package cluster tracks the queue worker instances registered against the
+// shared task queue, so operators running multiple worker replicas can see
+// which node is doing what.
+package cluster
+
+import "time"
+
+// Worker is an immutable snapshot of a registered worker instance: a queue
+// worker process that leases tasks from the shared queue and reports its
+// progress via periodic heartbeats.
+type Worker struct {
+	id              int64
+	hostname        string
+	pid             int
+	startedAt       time.Time
+	lastHeartbeat   time.Time
+	leasedTaskID    int64
+	leasedOperation string
+	processedCount  int64
+}
+
+// New creates a Worker instance registration for the current process.
+func New(hostname string, pid int) Worker {
+	now := time.Now()
+	return Worker{
+		hostname:      hostname,
+		pid:           pid,
+		startedAt:     now,
+		lastHeartbeat: now,
+	}
+}
+
+// Reconstruct rebuilds a Worker from persistence.
+func Reconstruct(
+	id int64,
+	hostname string,
+	pid int,
+	startedAt, lastHeartbeat time.Time,
+	leasedTaskID int64,
+	leasedOperation string,
+	processedCount int64,
+) Worker {
+	return Worker{
+		id:              id,
+		hostname:        hostname,
+		pid:             pid,
+		startedAt:       startedAt,
+		lastHeartbeat:   lastHeartbeat,
+		leasedTaskID:    leasedTaskID,
+		leasedOperation: leasedOperation,
+		processedCount:  processedCount,
+	}
+}
+
+// ID returns the worker instance's persistence ID.
+func (w Worker) ID() int64 { return w.id }
+
+// Hostname returns the host the worker process is running on.
+func (w Worker) Hostname() string { return w.hostname }
+
+// PID returns the worker process's operating system process ID.
+func (w Worker) PID() int { return w.pid }
+
+// StartedAt returns when the worker instance registered itself.
+func (w Worker) StartedAt() time.Time { return w.startedAt }
+
+// LastHeartbeat returns the last time the worker reported itself alive.
+func (w Worker) LastHeartbeat() time.Time { return w.lastHeartbeat }
+
+// LeasedTaskID returns the ID of the task the worker is currently
+// processing, or 0 if it is idle.
+func (w Worker) LeasedTaskID() int64 { return w.leasedTaskID }
+
+// LeasedOperation returns the operation of the task the worker is currently
+// processing, or "" if it is idle.
+func (w Worker) LeasedOperation() string { return w.leasedOperation }
+
+// ProcessedCount returns the number of tasks this worker instance has
+// finished processing since it started.
+func (w Worker) ProcessedCount() int64 { return w.processedCount }
+
+// WithHeartbeat returns a copy with LastHeartbeat set to now.
+func (w Worker) WithHeartbeat() Worker {
+	w.lastHeartbeat = time.Now()
+	return w
+}
+
+// WithLease returns a copy recording that the worker has claimed the given
+// task and is now processing it.
+func (w Worker) WithLease(taskID int64, operation string) Worker {
+	w.leasedTaskID = taskID
+	w.leasedOperation = operation
+	w.lastHeartbeat = time.Now()
+	return w
+}
+
+// WithLeaseCleared returns a copy recording that the worker finished
+// processing its leased task and incrementing its processed count.
+func (w Worker) WithLeaseCleared() Worker {
+	w.leasedTaskID = 0
+	w.leasedOperation = ""
+	w.processedCount++
+	w.lastHeartbeat = time.Now()
+	return w
+}
@@ -0,0 +1,59 @@
+package cluster
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	w := New("host-1", 42)
+
+	assert.Equal(t, int64(0), w.ID())
+	assert.Equal(t, "host-1", w.Hostname())
+	assert.Equal(t, 42, w.PID())
+	assert.Equal(t, int64(0), w.LeasedTaskID())
+	assert.False(t, w.StartedAt().IsZero())
+}
+
+func TestReconstruct(t *testing.T) {
+	started := time.Now().Add(-time.Hour)
+	heartbeat := time.Now()
+
+	w := Reconstruct(7, "host-1", 42, started, heartbeat, 99, "kodit.commit.scan", 3)
+
+	assert.Equal(t, int64(7), w.ID())
+	assert.Equal(t, int64(99), w.LeasedTaskID())
+	assert.Equal(t, "kodit.commit.scan", w.LeasedOperation())
+	assert.Equal(t, int64(3), w.ProcessedCount())
+}
+
+func TestWithLease(t *testing.T) {
+	w := New("host-1", 42)
+
+	leased := w.WithLease(5, "kodit.commit.scan")
+
+	assert.Equal(t, int64(0), w.LeasedTaskID())
+	assert.Equal(t, int64(5), leased.LeasedTaskID())
+	assert.Equal(t, "kodit.commit.scan", leased.LeasedOperation())
+}
+
+func TestWithLeaseCleared(t *testing.T) {
+	w := New("host-1", 42).WithLease(5, "kodit.commit.scan")
+
+	cleared := w.WithLeaseCleared()
+
+	assert.Equal(t, int64(0), cleared.LeasedTaskID())
+	assert.Empty(t, cleared.LeasedOperation())
+	assert.Equal(t, int64(1), cleared.ProcessedCount())
+}
+
+func TestWithHeartbeat(t *testing.T) {
+	w := New("host-1", 42)
+	before := w.LastHeartbeat()
+
+	updated := w.WithHeartbeat()
+
+	assert.True(t, !updated.LastHeartbeat().Before(before))
+}
@@ -0,0 +1,107 @@
+// Package curation holds maintainer-authored ranking rules that pin
+// canonical snippets or boost/bury specific ones for queries matching a
+// pattern (e.g. always surface the official client wrapper for "http
+// client"), so search ranking can be corrected without retraining or
+// reindexing.
+package curation
+
+import (
+	"strings"
+	"time"
+)
+
+// Action describes how a Rule adjusts ranking for matching queries.
+type Action string
+
+// Action values.
+const (
+	// ActionPin forces the target snippet to the top of the results,
+	// ahead of every other match, regardless of its fused score.
+	ActionPin Action = "pin"
+	// ActionBoost multiplies the target snippet's fused score by Weight
+	// (Weight > 1) to push it higher without guaranteeing first place.
+	ActionBoost Action = "boost"
+	// ActionBury multiplies the target snippet's fused score by Weight
+	// (0 <= Weight < 1) to push it lower without removing it outright.
+	ActionBury Action = "bury"
+)
+
+// Rule is an immutable value object describing one curation rule: when a
+// query matches Pattern, apply Action to the snippet identified by
+// SnippetID.
+type Rule struct {
+	id        int64
+	pattern   string
+	snippetID string
+	action    Action
+	weight    float64
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// New creates a Rule that has not yet been persisted. pattern is matched
+// case-insensitively as a substring of the search query. weight is ignored
+// for ActionPin.
+func New(pattern, snippetID string, action Action, weight float64) Rule {
+	now := time.Now()
+	return Rule{
+		pattern:   pattern,
+		snippetID: snippetID,
+		action:    action,
+		weight:    weight,
+		createdAt: now,
+		updatedAt: now,
+	}
+}
+
+// Reconstruct recreates a Rule from persistence.
+func Reconstruct(id int64, pattern, snippetID string, action Action, weight float64, createdAt, updatedAt time.Time) Rule {
+	return Rule{
+		id:        id,
+		pattern:   pattern,
+		snippetID: snippetID,
+		action:    action,
+		weight:    weight,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+// ID returns the database identifier.
+func (r Rule) ID() int64 { return r.id }
+
+// Pattern returns the query substring this rule matches against.
+func (r Rule) Pattern() string { return r.pattern }
+
+// SnippetID returns the target snippet's enrichment ID.
+func (r Rule) SnippetID() string { return r.snippetID }
+
+// Action returns how this rule adjusts ranking.
+func (r Rule) Action() Action { return r.action }
+
+// Weight returns the boost/bury multiplier (unused for ActionPin).
+func (r Rule) Weight() float64 { return r.weight }
+
+// CreatedAt returns when the rule was first recorded.
+func (r Rule) CreatedAt() time.Time { return r.createdAt }
+
+// UpdatedAt returns when the rule was last modified.
+func (r Rule) UpdatedAt() time.Time { return r.updatedAt }
+
+// WithAction returns a copy of the rule with its action and weight replaced.
+func (r Rule) WithAction(action Action, weight float64) Rule {
+	r.action = action
+	r.weight = weight
+	r.updatedAt = time.Now()
+	return r
+}
+
+// Matches reports whether query matches this rule's pattern. Matching is a
+// case-insensitive substring search, consistent with how existing keyword
+// and exclusion filters in the search package work.
+func (r Rule) Matches(query string) bool {
+	if r.pattern == "" {
+		return false
+	}
+	return strings.Contains(strings.ToLower(query), strings.ToLower(r.pattern))
+}
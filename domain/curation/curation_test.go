@@ -0,0 +1,46 @@
+package curation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	r := New("http client", "42", ActionPin, 0)
+
+	assert.Equal(t, int64(0), r.ID())
+	assert.Equal(t, "http client", r.Pattern())
+	assert.Equal(t, "42", r.SnippetID())
+	assert.Equal(t, ActionPin, r.Action())
+	assert.False(t, r.CreatedAt().IsZero())
+}
+
+func TestReconstruct(t *testing.T) {
+	r := Reconstruct(7, "deprecated client", "99", ActionBury, 0.1, time.Time{}, time.Time{})
+
+	assert.Equal(t, int64(7), r.ID())
+	assert.Equal(t, "deprecated client", r.Pattern())
+	assert.Equal(t, "99", r.SnippetID())
+	assert.Equal(t, ActionBury, r.Action())
+	assert.InDelta(t, 0.1, r.Weight(), 0.0001)
+}
+
+func TestWithAction(t *testing.T) {
+	r := New("http client", "42", ActionPin, 0)
+
+	updated := r.WithAction(ActionBoost, 2.0)
+
+	assert.Equal(t, ActionPin, r.Action())
+	assert.Equal(t, ActionBoost, updated.Action())
+	assert.InDelta(t, 2.0, updated.Weight(), 0.0001)
+}
+
+func TestMatches(t *testing.T) {
+	r := New("http client", "42", ActionPin, 0)
+
+	assert.True(t, r.Matches("how do I use the HTTP Client in Go"))
+	assert.False(t, r.Matches("how do I format a date"))
+	assert.False(t, New("", "42", ActionPin, 0).Matches("anything"))
+}
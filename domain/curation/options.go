@@ -0,0 +1,8 @@
+package curation
+
+import "github.com/helixml/kodit/domain/repository"
+
+// WithSnippetID filters by the "snippet_id" column.
+func WithSnippetID(snippetID string) repository.Option {
+	return repository.WithCondition("snippet_id", snippetID)
+}
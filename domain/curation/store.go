@@ -0,0 +1,8 @@
+package curation
+
+import "github.com/helixml/kodit/domain/repository"
+
+// Store defines persistence for curation rules.
+type Store interface {
+	repository.Store[Rule]
+}
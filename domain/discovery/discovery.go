@@ -0,0 +1,104 @@
+// Package discovery holds candidate repositories surfaced by an inbound Git
+// hosting webhook firehose — repositories that matched a configured name
+// pattern but were not auto-registered, and so wait in a review queue for a
+// maintainer to approve or exclude.
+package discovery
+
+import "time"
+
+// Status describes where a candidate sits in the review queue.
+type Status string
+
+// Status values.
+const (
+	// StatusPending awaits maintainer review.
+	StatusPending Status = "pending"
+	// StatusApproved has been registered as a tracked repository.
+	StatusApproved Status = "approved"
+	// StatusExcluded was reviewed and rejected.
+	StatusExcluded Status = "excluded"
+)
+
+// Candidate is an immutable value object describing one repository surfaced
+// by a discovery webhook event that matched a configured name pattern.
+type Candidate struct {
+	id             int64
+	org            string
+	name           string
+	remoteURL      string
+	topics         []string
+	language       string
+	matchedPattern string
+	status         Status
+	createdAt      time.Time
+	updatedAt      time.Time
+}
+
+// New creates a Candidate that has not yet been persisted, in StatusPending.
+func New(org, name, remoteURL string, topics []string, language, matchedPattern string) Candidate {
+	now := time.Now()
+	return Candidate{
+		org:            org,
+		name:           name,
+		remoteURL:      remoteURL,
+		topics:         topics,
+		language:       language,
+		matchedPattern: matchedPattern,
+		status:         StatusPending,
+		createdAt:      now,
+		updatedAt:      now,
+	}
+}
+
+// Reconstruct recreates a Candidate from persistence.
+func Reconstruct(id int64, org, name, remoteURL string, topics []string, language, matchedPattern string, status Status, createdAt, updatedAt time.Time) Candidate {
+	return Candidate{
+		id:             id,
+		org:            org,
+		name:           name,
+		remoteURL:      remoteURL,
+		topics:         topics,
+		language:       language,
+		matchedPattern: matchedPattern,
+		status:         status,
+		createdAt:      createdAt,
+		updatedAt:      updatedAt,
+	}
+}
+
+// ID returns the database identifier.
+func (c Candidate) ID() int64 { return c.id }
+
+// Org returns the owning organization or user reported by the webhook event.
+func (c Candidate) Org() string { return c.org }
+
+// Name returns the repository name reported by the webhook event.
+func (c Candidate) Name() string { return c.name }
+
+// RemoteURL returns the repository's clone URL.
+func (c Candidate) RemoteURL() string { return c.remoteURL }
+
+// Topics returns the repository topics reported by the webhook event.
+func (c Candidate) Topics() []string { return c.topics }
+
+// Language returns the repository's primary language, if reported.
+func (c Candidate) Language() string { return c.language }
+
+// MatchedPattern returns the configured name pattern that surfaced this candidate.
+func (c Candidate) MatchedPattern() string { return c.matchedPattern }
+
+// Status returns where the candidate sits in the review queue.
+func (c Candidate) Status() Status { return c.status }
+
+// CreatedAt returns when the candidate was first recorded.
+func (c Candidate) CreatedAt() time.Time { return c.createdAt }
+
+// UpdatedAt returns when the candidate was last modified.
+func (c Candidate) UpdatedAt() time.Time { return c.updatedAt }
+
+// WithStatus returns a copy of the candidate moved to a new review status.
+func (c Candidate) WithStatus(status Status) Candidate {
+	c.status = status
+	c.updatedAt = time.Now()
+	return c
+}
@@ -0,0 +1,38 @@
+package discovery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	c := New("acme", "widget", "https://example.com/acme/widget.git", []string{"go"}, "Go", "acme/*")
+
+	assert.Equal(t, int64(0), c.ID())
+	assert.Equal(t, "acme", c.Org())
+	assert.Equal(t, "widget", c.Name())
+	assert.Equal(t, "https://example.com/acme/widget.git", c.RemoteURL())
+	assert.Equal(t, []string{"go"}, c.Topics())
+	assert.Equal(t, "Go", c.Language())
+	assert.Equal(t, "acme/*", c.MatchedPattern())
+	assert.Equal(t, StatusPending, c.Status())
+	assert.False(t, c.CreatedAt().IsZero())
+}
+
+func TestReconstruct(t *testing.T) {
+	c := Reconstruct(7, "acme", "widget", "https://example.com/acme/widget.git", nil, "Go", "acme/*", StatusApproved, time.Time{}, time.Time{})
+
+	assert.Equal(t, int64(7), c.ID())
+	assert.Equal(t, StatusApproved, c.Status())
+}
+
+func TestWithStatus(t *testing.T) {
+	c := New("acme", "widget", "https://example.com/acme/widget.git", nil, "Go", "acme/*")
+
+	updated := c.WithStatus(StatusExcluded)
+
+	assert.Equal(t, StatusPending, c.Status())
+	assert.Equal(t, StatusExcluded, updated.Status())
+}
@@ -0,0 +1,8 @@
+package discovery
+
+import "github.com/helixml/kodit/domain/repository"
+
+// WithStatus filters by the "status" column.
+func WithStatus(status Status) repository.Option {
+	return repository.WithCondition("status", string(status))
+}
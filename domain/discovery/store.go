@@ -0,0 +1,8 @@
+package discovery
+
+import "github.com/helixml/kodit/domain/repository"
+
+// Store defines persistence for discovery candidates.
+type Store interface {
+	repository.Store[Candidate]
+}
@@ -1,5 +1,7 @@
 package enrichment
 
+import "strconv"
+
 // Association links an enrichment to an entity.
 // This is an immutable value object.
 type Association struct {
@@ -75,6 +77,46 @@ func RepositoryAssociation(enrichmentID int64, repoID string) Association {
 	return NewAssociation(enrichmentID, repoID, EntityTypeRepository)
 }
 
+// DirectoryKey builds the synthetic entity ID used by DirectoryAssociation,
+// scoping a directory path to the commit it was summarized from.
+func DirectoryKey(commitSHA, dirPath string) string {
+	return commitSHA + ":" + dirPath
+}
+
+// DirectoryAssociation creates a new association linking an enrichment to a
+// directory within a specific commit. See EntityTypeDirectory for why the
+// entity ID encodes the commit SHA.
+func DirectoryAssociation(enrichmentID int64, commitSHA, dirPath string) Association {
+	return NewAssociation(enrichmentID, DirectoryKey(commitSHA, dirPath), EntityTypeDirectory)
+}
+
+// TranslationAssociation creates a new association linking a translated
+// enrichment back to the source enrichment it was translated from. See
+// EntityTypeTranslationSource for why the entity ID is the source's ID.
+func TranslationAssociation(enrichmentID, sourceEnrichmentID int64) Association {
+	return NewAssociation(enrichmentID, strconv.FormatInt(sourceEnrichmentID, 10), EntityTypeTranslationSource)
+}
+
+// ModuleAssociation creates a new association linking an enrichment to the
+// Go module it belongs to, identified by the module's declared path.
+func ModuleAssociation(enrichmentID int64, modulePath string) Association {
+	return NewAssociation(enrichmentID, modulePath, EntityTypeModule)
+}
+
+// PRRefAssociation creates a new association linking an enrichment to the PR
+// ref it was indexed for, so search can scope results to that ref.
+func PRRefAssociation(enrichmentID int64, ref string) Association {
+	return NewAssociation(enrichmentID, ref, EntityTypePRRef)
+}
+
+// OverrideAssociation creates a new association linking a human-edited
+// override enrichment back to the original enrichment it replaces for read
+// purposes. See EntityTypeOverrideSource for why the entity ID is the
+// original's ID.
+func OverrideAssociation(enrichmentID, originalEnrichmentID int64) Association {
+	return NewAssociation(enrichmentID, strconv.FormatInt(originalEnrichmentID, 10), EntityTypeOverrideSource)
+}
+
 // SnippetSummaryLink pairs a snippet summary enrichment with its corresponding snippet enrichment.
 // This is used to track which summary belongs to which snippet.
 type SnippetSummaryLink struct {
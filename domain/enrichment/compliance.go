@@ -0,0 +1,18 @@
+package enrichment
+
+// NewSBOMFinding creates a software bill of materials enrichment for a
+// commit, capturing a generated SBOM document (e.g. CycloneDX JSON) covering
+// its declared dependencies.
+func NewSBOMFinding(content string) Enrichment {
+	return NewEnrichment(TypeCompliance, SubtypeSBOM, EntityTypeCommit, content)
+}
+
+// IsComplianceEnrichment returns true if the enrichment is a compliance type.
+func IsComplianceEnrichment(e Enrichment) bool {
+	return e.Type() == TypeCompliance
+}
+
+// IsSBOMFinding returns true if the enrichment is an SBOM subtype.
+func IsSBOMFinding(e Enrichment) bool {
+	return e.Type() == TypeCompliance && e.Subtype() == SubtypeSBOM
+}
@@ -42,6 +42,20 @@ func NewChunkEnrichmentWithLanguage(content, language string) Enrichment {
 	return NewEnrichmentWithLanguage(TypeDevelopment, SubtypeChunk, EntityTypeCommit, content, language)
 }
 
+// NewFileSummary creates a file summary enrichment for a commit.
+// File summaries provide AI-generated descriptions of entire files,
+// aggregating the chunk enrichments extracted from them.
+func NewFileSummary(content string) Enrichment {
+	return NewEnrichment(TypeDevelopment, SubtypeFileSummary, EntityTypeCommit, content)
+}
+
+// NewDirectorySummary creates a directory summary enrichment for a commit.
+// Directory summaries provide AI-generated descriptions of a directory,
+// aggregating the file summaries of the files directly within it.
+func NewDirectorySummary(content string) Enrichment {
+	return NewEnrichment(TypeDevelopment, SubtypeDirectorySummary, EntityTypeCommit, content)
+}
+
 // NewPageImage creates a page image enrichment for a commit.
 // Page image enrichments represent rendered pages of documents (PDFs, spreadsheets, etc.).
 // Content is empty because images are rendered on demand.
@@ -73,3 +87,13 @@ func IsExample(e Enrichment) bool {
 func IsExampleSummary(e Enrichment) bool {
 	return e.Type() == TypeDevelopment && e.Subtype() == SubtypeExampleSummary
 }
+
+// IsFileSummary returns true if the enrichment is a file summary subtype.
+func IsFileSummary(e Enrichment) bool {
+	return e.Type() == TypeDevelopment && e.Subtype() == SubtypeFileSummary
+}
+
+// IsDirectorySummary returns true if the enrichment is a directory summary subtype.
+func IsDirectorySummary(e Enrichment) bool {
+	return e.Type() == TypeDevelopment && e.Subtype() == SubtypeDirectorySummary
+}
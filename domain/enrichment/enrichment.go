@@ -14,6 +14,8 @@ const (
 	TypeDevelopment  Type = "development"
 	TypeHistory      Type = "history"
 	TypeUsage        Type = "usage"
+	TypeSecurity     Type = "security"
+	TypeCompliance   Type = "compliance"
 )
 
 // Subtype represents a specific enrichment within a type category.
@@ -27,12 +29,14 @@ const (
 
 // Development subtypes.
 const (
-	SubtypeSnippet        Subtype = "snippet"
-	SubtypeSnippetSummary Subtype = "snippet_summary"
-	SubtypeExample        Subtype = "example"
-	SubtypeExampleSummary Subtype = "example_summary"
-	SubtypeChunk          Subtype = "chunk"
-	SubtypePageImage      Subtype = "page_image"
+	SubtypeSnippet          Subtype = "snippet"
+	SubtypeSnippetSummary   Subtype = "snippet_summary"
+	SubtypeExample          Subtype = "example"
+	SubtypeExampleSummary   Subtype = "example_summary"
+	SubtypeChunk            Subtype = "chunk"
+	SubtypePageImage        Subtype = "page_image"
+	SubtypeFileSummary      Subtype = "file_summary"
+	SubtypeDirectorySummary Subtype = "directory_summary"
 )
 
 // History subtypes.
@@ -42,9 +46,22 @@ const (
 
 // Usage subtypes.
 const (
-	SubtypeCookbook Subtype = "cookbook"
-	SubtypeAPIDocs  Subtype = "api_docs"
-	SubtypeWiki     Subtype = "wiki"
+	SubtypeCookbook         Subtype = "cookbook"
+	SubtypeAPIDocs          Subtype = "api_docs"
+	SubtypeWiki             Subtype = "wiki"
+	SubtypeWikiPage         Subtype = "wiki_page"
+	SubtypeConventions      Subtype = "conventions"
+	SubtypeOnboardingReport Subtype = "onboarding_report"
+)
+
+// Security subtypes.
+const (
+	SubtypeVulnerability Subtype = "vulnerability"
+)
+
+// Compliance subtypes.
+const (
+	SubtypeSBOM Subtype = "sbom"
 )
 
 // EntityTypeKey represents the type of entity an enrichment is attached to.
@@ -56,19 +73,44 @@ const (
 	EntityTypeSnippet    EntityTypeKey = "enrichments_v2"
 	EntityTypeFile       EntityTypeKey = "git_commit_files"
 	EntityTypeRepository EntityTypeKey = "git_repos"
+	// EntityTypeDirectory has no backing table: directories are not a
+	// persisted entity, so associations use "{commitSHA}:{dirPath}" as the
+	// entity ID to scope a directory summary to the commit it was built from.
+	EntityTypeDirectory EntityTypeKey = "directories"
+	// EntityTypeTranslationSource has no backing table: a translation is
+	// derived from another enrichment, not a tracked entity, so associations
+	// use the source enrichment's ID (as a string) as the entity ID.
+	EntityTypeTranslationSource EntityTypeKey = "enrichment_translation_source"
+	// EntityTypeOverrideSource has no backing table: a human override is
+	// derived from the enrichment it replaces, not a tracked entity, so
+	// associations use the original enrichment's ID (as a string) as the
+	// entity ID.
+	EntityTypeOverrideSource EntityTypeKey = "enrichment_override_source"
+	// EntityTypeModule has no backing table: a Go module is not a tracked
+	// entity, so associations use the module's declared path as the entity
+	// ID, grouping every chunk belonging to that module.
+	EntityTypeModule EntityTypeKey = "module"
+	// EntityTypePRRef has no backing table: a PR ref is tracked ephemerally
+	// in the prindex package, not as a persisted enrichment entity, so
+	// associations use the PR ref string itself (e.g. "refs/pull/42/head")
+	// as the entity ID, letting search scope results to that ref.
+	EntityTypePRRef EntityTypeKey = "pr_refs"
 )
 
 // Enrichment represents AI-generated semantic metadata that can be attached to entities.
 // This is an immutable value object identified by its ID once persisted.
 type Enrichment struct {
-	id        int64
-	content   string
-	typ       Type
-	subtype   Subtype
-	entityKey EntityTypeKey
-	language  string
-	createdAt time.Time
-	updatedAt time.Time
+	id         int64
+	content    string
+	typ        Type
+	subtype    Subtype
+	entityKey  EntityTypeKey
+	language   string
+	flagged    bool
+	flagReason string
+	metrics    SnippetMetrics
+	createdAt  time.Time
+	updatedAt  time.Time
 }
 
 // NewEnrichment creates an enrichment for new instances (not yet persisted).
@@ -101,18 +143,24 @@ func ReconstructEnrichment(
 	entityKey EntityTypeKey,
 	content string,
 	language string,
+	flagged bool,
+	flagReason string,
+	metrics SnippetMetrics,
 	createdAt time.Time,
 	updatedAt time.Time,
 ) Enrichment {
 	return Enrichment{
-		id:        id,
-		content:   content,
-		typ:       typ,
-		subtype:   subtype,
-		entityKey: entityKey,
-		language:  language,
-		createdAt: createdAt,
-		updatedAt: updatedAt,
+		id:         id,
+		content:    content,
+		typ:        typ,
+		subtype:    subtype,
+		entityKey:  entityKey,
+		language:   language,
+		flagged:    flagged,
+		flagReason: flagReason,
+		metrics:    metrics,
+		createdAt:  createdAt,
+		updatedAt:  updatedAt,
 	}
 }
 
@@ -141,11 +189,30 @@ func (e Enrichment) EntityTypeKey() EntityTypeKey {
 	return e.entityKey
 }
 
-// Language returns the associated language (only applicable for API docs enrichments).
+// Language returns the associated language. For API docs enrichments this
+// is a programming language; for a translation produced via
+// TranslationAssociation it is the target natural language instead.
 func (e Enrichment) Language() string {
 	return e.language
 }
 
+// Flagged reports whether a content moderation filter flagged this
+// enrichment's content for review.
+func (e Enrichment) Flagged() bool {
+	return e.flagged
+}
+
+// FlagReason returns why the enrichment was flagged, or "" if it was not.
+func (e Enrichment) FlagReason() string {
+	return e.flagReason
+}
+
+// Metrics returns the enrichment's readability and complexity signals, or a
+// zero SnippetMetrics if they have not been computed.
+func (e Enrichment) Metrics() SnippetMetrics {
+	return e.metrics
+}
+
 // CreatedAt returns when the enrichment was created.
 func (e Enrichment) CreatedAt() time.Time {
 	return e.createdAt
@@ -173,3 +240,20 @@ func (e Enrichment) WithContent(content string) Enrichment {
 	e.updatedAt = time.Now()
 	return e
 }
+
+// WithFlag returns a copy of the enrichment flagged for review, recording
+// why a content moderation filter flagged it. The enrichment is still
+// stored with its flag set rather than being dropped, so a reviewer can
+// find and act on it.
+func (e Enrichment) WithFlag(reason string) Enrichment {
+	e.flagged = true
+	e.flagReason = reason
+	return e
+}
+
+// WithMetrics returns a copy of the enrichment with the given readability
+// and complexity metrics attached.
+func (e Enrichment) WithMetrics(metrics SnippetMetrics) Enrichment {
+	e.metrics = metrics
+	return e
+}
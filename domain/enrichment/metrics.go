@@ -0,0 +1,40 @@
+package enrichment
+
+// SnippetMetrics reports readability and complexity signals computed for a
+// code snippet enrichment. A zero value means the metrics have not been
+// computed (e.g. for non-snippet enrichments).
+type SnippetMetrics struct {
+	cyclomaticComplexity int
+	nestingDepth         int
+	lineCount            int
+}
+
+// NewSnippetMetrics creates a SnippetMetrics from its component measurements.
+func NewSnippetMetrics(cyclomaticComplexity, nestingDepth, lineCount int) SnippetMetrics {
+	return SnippetMetrics{
+		cyclomaticComplexity: cyclomaticComplexity,
+		nestingDepth:         nestingDepth,
+		lineCount:            lineCount,
+	}
+}
+
+// CyclomaticComplexity returns the snippet's approximate cyclomatic
+// complexity (branch count + 1).
+func (m SnippetMetrics) CyclomaticComplexity() int {
+	return m.cyclomaticComplexity
+}
+
+// NestingDepth returns the snippet's deepest block nesting level.
+func (m SnippetMetrics) NestingDepth() int {
+	return m.nestingDepth
+}
+
+// LineCount returns the number of lines in the snippet.
+func (m SnippetMetrics) LineCount() int {
+	return m.lineCount
+}
+
+// IsZero reports whether the metrics have not been computed.
+func (m SnippetMetrics) IsZero() bool {
+	return m == SnippetMetrics{}
+}
@@ -1,6 +1,10 @@
 package enrichment
 
-import "github.com/helixml/kodit/domain/repository"
+import (
+	"time"
+
+	"github.com/helixml/kodit/domain/repository"
+)
 
 // WithType filters by the "type" column.
 func WithType(typ Type) repository.Option {
@@ -27,6 +31,17 @@ func WithEntityType(entityType EntityTypeKey) repository.Option {
 	return repository.WithCondition("entity_type", string(entityType))
 }
 
+// WithLanguage filters by the "language" column.
+func WithLanguage(lang string) repository.Option {
+	return repository.WithCondition("language", lang)
+}
+
+// WithContentLike filters for a case-insensitive substring match against
+// the "content" column. Used for free-text search over enrichment content.
+func WithContentLike(q string) repository.Option {
+	return repository.WithWhere("LOWER(content) LIKE LOWER(?)", "%"+q+"%")
+}
+
 // WithEntityIDIn filters by multiple entity IDs.
 func WithEntityIDIn(entityIDs []string) repository.Option {
 	return repository.WithConditionIn("entity_id", entityIDs)
@@ -37,6 +52,11 @@ func WithEnrichmentIDIn(ids []int64) repository.Option {
 	return repository.WithConditionIn("enrichment_id", ids)
 }
 
+// WithCreatedBefore filters by the "created_at" column.
+func WithCreatedBefore(t time.Time) repository.Option {
+	return repository.WithWhere("created_at < ?", t)
+}
+
 // WithCommitSHA filters enrichments associated with a single commit SHA
 // via the enrichment_associations table.
 func WithCommitSHA(sha string) repository.Option {
@@ -0,0 +1,17 @@
+package enrichment
+
+// NewVulnerabilityFinding creates a vulnerability enrichment for a commit,
+// describing a known vulnerability affecting one of its dependencies.
+func NewVulnerabilityFinding(content string) Enrichment {
+	return NewEnrichment(TypeSecurity, SubtypeVulnerability, EntityTypeCommit, content)
+}
+
+// IsSecurityEnrichment returns true if the enrichment is a security type.
+func IsSecurityEnrichment(e Enrichment) bool {
+	return e.Type() == TypeSecurity
+}
+
+// IsVulnerabilityFinding returns true if the enrichment is a vulnerability subtype.
+func IsVulnerabilityFinding(e Enrichment) bool {
+	return e.Type() == TypeSecurity && e.Subtype() == SubtypeVulnerability
+}
@@ -12,10 +12,19 @@ import (
 type EnrichmentStore interface {
 	repository.Store[Enrichment]
 	DeleteBy(ctx context.Context, options ...repository.Option) error
+	Exists(ctx context.Context, options ...repository.Option) (bool, error)
+	// SaveAll creates multiple enrichments in batches within a single
+	// transaction, retrying automatically on Postgres serialization
+	// failures.
+	SaveAll(ctx context.Context, enrichments []Enrichment) ([]Enrichment, error)
 }
 
 // AssociationStore defines operations for persisting and retrieving enrichment associations.
 type AssociationStore interface {
 	repository.Store[Association]
 	DeleteBy(ctx context.Context, options ...repository.Option) error
+	// SaveAll upserts multiple associations in batches within a single
+	// transaction, retrying automatically on Postgres serialization
+	// failures.
+	SaveAll(ctx context.Context, associations []Association) ([]Association, error)
 }
@@ -55,3 +55,41 @@ func NewWiki(content string) Enrichment {
 func IsWiki(e Enrichment) bool {
 	return e.Type() == TypeUsage && e.Subtype() == SubtypeWiki
 }
+
+// NewWikiPage creates a standalone enrichment for a single wiki page,
+// persisted alongside the full wiki so each page can be indexed and searched
+// on its own. Content is a wiki.Page document (see wiki.Page.Document).
+func NewWikiPage(content string) Enrichment {
+	return NewEnrichment(TypeUsage, SubtypeWikiPage, EntityTypeCommit, content)
+}
+
+// IsWikiPage returns true if the enrichment is a wiki page subtype.
+func IsWikiPage(e Enrichment) bool {
+	return e.Type() == TypeUsage && e.Subtype() == SubtypeWikiPage
+}
+
+// NewConventions creates a code conventions enrichment for a commit.
+// Conventions documents describe the naming, error handling, and test
+// patterns observed across a sample of the repository's files.
+func NewConventions(content string) Enrichment {
+	return NewEnrichment(TypeUsage, SubtypeConventions, EntityTypeCommit, content)
+}
+
+// IsConventions returns true if the enrichment is a conventions subtype.
+func IsConventions(e Enrichment) bool {
+	return e.Type() == TypeUsage && e.Subtype() == SubtypeConventions
+}
+
+// NewOnboardingReport creates an onboarding report enrichment for a commit.
+// Onboarding reports are a deterministic preflight analysis (size, language
+// breakdown, analyzer coverage, skipped files) computed before indexing
+// finishes, so a newly added repository's expected coverage is visible
+// early. Content is a JSON-encoded onboarding.Report document.
+func NewOnboardingReport(content string) Enrichment {
+	return NewEnrichment(TypeUsage, SubtypeOnboardingReport, EntityTypeCommit, content)
+}
+
+// IsOnboardingReport returns true if the enrichment is an onboarding report subtype.
+func IsOnboardingReport(e Enrichment) bool {
+	return e.Type() == TypeUsage && e.Subtype() == SubtypeOnboardingReport
+}
@@ -0,0 +1,105 @@
+// Package job provides domain types for tracking admin bulk operations that
+// fan out across many repositories.
+package job
+
+import "time"
+
+// Kind identifies which admin bulk operation a Job represents.
+type Kind string
+
+// Kind values.
+const (
+	KindSyncRepositories    Kind = "sync_repositories"
+	KindDeleteEnrichments   Kind = "delete_enrichments"
+	KindReembedRepositories Kind = "reembed_repositories"
+)
+
+// Job tracks the aggregate progress of an admin bulk operation: a batch of
+// child units of work, one per matched repository, fanned out from a single
+// admin request so the caller can poll progress instead of blocking on it.
+type Job struct {
+	id           int64
+	kind         Kind
+	total        int
+	completed    int
+	failed       int
+	errorMessage string
+	createdAt    time.Time
+	updatedAt    time.Time
+}
+
+// New creates a Job for a bulk run about to be fanned out.
+func New(kind Kind, total int) Job {
+	now := time.Now()
+	return Job{
+		kind:      kind,
+		total:     total,
+		createdAt: now,
+		updatedAt: now,
+	}
+}
+
+// Reconstruct recreates a Job from persistence.
+func Reconstruct(
+	id int64,
+	kind Kind,
+	total, completed, failed int,
+	errorMessage string,
+	createdAt, updatedAt time.Time,
+) Job {
+	return Job{
+		id:           id,
+		kind:         kind,
+		total:        total,
+		completed:    completed,
+		failed:       failed,
+		errorMessage: errorMessage,
+		createdAt:    createdAt,
+		updatedAt:    updatedAt,
+	}
+}
+
+// ID returns the job ID.
+func (j Job) ID() int64 { return j.id }
+
+// Kind returns which bulk operation this job represents.
+func (j Job) Kind() Kind { return j.kind }
+
+// Total returns how many child units of work were fanned out.
+func (j Job) Total() int { return j.total }
+
+// Completed returns how many child units of work finished successfully.
+func (j Job) Completed() int { return j.completed }
+
+// Failed returns how many child units of work finished with an error.
+func (j Job) Failed() int { return j.failed }
+
+// Error returns a top-level error message for a run that failed before any
+// child work could be fanned out, or "" otherwise.
+func (j Job) Error() string { return j.errorMessage }
+
+// CreatedAt returns when the job was created.
+func (j Job) CreatedAt() time.Time { return j.createdAt }
+
+// UpdatedAt returns when the job's progress was last updated.
+func (j Job) UpdatedAt() time.Time { return j.updatedAt }
+
+// Done reports whether every child unit of work has finished, successfully
+// or not.
+func (j Job) Done() bool { return j.completed+j.failed >= j.total }
+
+// WithProgress returns a copy of the job with updated completed and failed
+// counts.
+func (j Job) WithProgress(completed, failed int) Job {
+	j.completed = completed
+	j.failed = failed
+	j.updatedAt = time.Now()
+	return j
+}
+
+// WithError returns a copy of the job carrying a top-level error message.
+func (j Job) WithError(msg string) Job {
+	j.errorMessage = msg
+	j.updatedAt = time.Now()
+	return j
+}
@@ -0,0 +1,8 @@
+package job
+
+import "github.com/helixml/kodit/domain/repository"
+
+// Store defines persistence for admin bulk operation jobs.
+type Store interface {
+	repository.Store[Job]
+}
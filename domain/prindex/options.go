@@ -0,0 +1,23 @@
+package prindex
+
+import (
+	"time"
+
+	"github.com/helixml/kodit/domain/repository"
+)
+
+// WithRepoID filters by the "repo_id" column.
+func WithRepoID(id int64) repository.Option {
+	return repository.WithCondition("repo_id", id)
+}
+
+// WithRef filters by the "ref" column.
+func WithRef(ref string) repository.Option {
+	return repository.WithCondition("ref", ref)
+}
+
+// WithExpiresBefore filters for PR indexes whose TTL has elapsed as of t.
+// Used by garbage collection to find records to sweep.
+func WithExpiresBefore(t time.Time) repository.Option {
+	return repository.WithWhere("expires_at < ?", t)
+}
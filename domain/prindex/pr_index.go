@@ -0,0 +1,73 @@
+// Package prindex tracks ephemeral indexes of pull request branches, so
+// unmerged code can be searched alongside a repository's regular index
+// without polluting it, and swept up automatically once the PR is stale.
+package prindex
+
+import "time"
+
+// PRIndex represents one PR branch that has been indexed into the ephemeral
+// pr_ref search namespace, overlaying its base repository's index. This is
+// an immutable value object.
+type PRIndex struct {
+	id            int64
+	repoID        int64
+	ref           string
+	headCommitSHA string
+	createdAt     time.Time
+	expiresAt     time.Time
+}
+
+// New creates a PRIndex for a PR branch that was just indexed, expiring
+// after ttl unless refreshed by re-indexing.
+func New(repoID int64, ref, headCommitSHA string, ttl time.Duration) PRIndex {
+	now := time.Now()
+	return PRIndex{
+		repoID:        repoID,
+		ref:           ref,
+		headCommitSHA: headCommitSHA,
+		createdAt:     now,
+		expiresAt:     now.Add(ttl),
+	}
+}
+
+// Reconstruct recreates a PRIndex from persistence.
+func Reconstruct(id, repoID int64, ref, headCommitSHA string, createdAt, expiresAt time.Time) PRIndex {
+	return PRIndex{
+		id:            id,
+		repoID:        repoID,
+		ref:           ref,
+		headCommitSHA: headCommitSHA,
+		createdAt:     createdAt,
+		expiresAt:     expiresAt,
+	}
+}
+
+// ID returns the database identifier.
+func (p PRIndex) ID() int64 { return p.id }
+
+// RepoID returns the base repository this PR branch belongs to.
+func (p PRIndex) RepoID() int64 { return p.repoID }
+
+// Ref returns the PR ref that was indexed (e.g. "refs/pull/42/head"), which
+// doubles as the pr_ref search filter value.
+func (p PRIndex) Ref() string { return p.ref }
+
+// HeadCommitSHA returns the commit the PR branch pointed to when indexed.
+func (p PRIndex) HeadCommitSHA() string { return p.headCommitSHA }
+
+// CreatedAt returns when this PR branch was indexed.
+func (p PRIndex) CreatedAt() time.Time { return p.createdAt }
+
+// ExpiresAt returns when this PR index becomes eligible for garbage collection.
+func (p PRIndex) ExpiresAt() time.Time { return p.expiresAt }
+
+// WithID returns a copy of p with the specified ID.
+func (p PRIndex) WithID(id int64) PRIndex {
+	p.id = id
+	return p
+}
+
+// Expired reports whether this PR index's TTL has elapsed as of now.
+func (p PRIndex) Expired(now time.Time) bool {
+	return now.After(p.expiresAt)
+}
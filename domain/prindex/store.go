@@ -0,0 +1,8 @@
+package prindex
+
+import "github.com/helixml/kodit/domain/repository"
+
+// Store defines persistence for PR index records.
+type Store interface {
+	repository.Store[PRIndex]
+}
@@ -0,0 +1,47 @@
+// Package querylog records search queries as they are issued, so recurring
+// questions can be surfaced back to users (e.g. as cookbook/wiki candidates)
+// instead of being answered silently and forgotten.
+package querylog
+
+import "time"
+
+// QueryLog records one search query. This is an immutable value object.
+type QueryLog struct {
+	id           int64
+	repositoryID string
+	query        string
+	createdAt    time.Time
+}
+
+// New creates a QueryLog for a query that was just issued (not yet
+// persisted). repositoryID may be "" when the query was not scoped to a
+// specific repository.
+func New(repositoryID, query string) QueryLog {
+	return QueryLog{
+		repositoryID: repositoryID,
+		query:        query,
+		createdAt:    time.Now(),
+	}
+}
+
+// Reconstruct recreates a QueryLog from persistence.
+func Reconstruct(id int64, repositoryID, query string, createdAt time.Time) QueryLog {
+	return QueryLog{
+		id:           id,
+		repositoryID: repositoryID,
+		query:        query,
+		createdAt:    createdAt,
+	}
+}
+
+// ID returns the database identifier.
+func (q QueryLog) ID() int64 { return q.id }
+
+// RepositoryID returns the repository the query was scoped to, or "" if unscoped.
+func (q QueryLog) RepositoryID() string { return q.repositoryID }
+
+// Query returns the raw query text as issued.
+func (q QueryLog) Query() string { return q.query }
+
+// CreatedAt returns when the query was recorded.
+func (q QueryLog) CreatedAt() time.Time { return q.createdAt }
@@ -0,0 +1,25 @@
+package querylog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	q := New("42", "how do I parse JSON?")
+
+	assert.Equal(t, int64(0), q.ID())
+	assert.Equal(t, "42", q.RepositoryID())
+	assert.Equal(t, "how do I parse JSON?", q.Query())
+	assert.False(t, q.CreatedAt().IsZero())
+}
+
+func TestReconstruct(t *testing.T) {
+	q := Reconstruct(7, "", "how do I parse json", time.Time{})
+
+	assert.Equal(t, int64(7), q.ID())
+	assert.Equal(t, "", q.RepositoryID())
+	assert.Equal(t, "how do I parse json", q.Query())
+}
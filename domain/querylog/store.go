@@ -0,0 +1,8 @@
+package querylog
+
+import "github.com/helixml/kodit/domain/repository"
+
+// Store defines persistence for query log records.
+type Store interface {
+	repository.Store[QueryLog]
+}
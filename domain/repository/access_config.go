@@ -0,0 +1,40 @@
+package repository
+
+// AccessConfig controls which file paths within a repository's working copy
+// may be read back through the blob API and MCP file resource. It lets a
+// maintainer keep secrets or generated artifacts out of an assistant's reach
+// even when the rest of the repository is indexed and searchable.
+type AccessConfig struct {
+	denyGlobs []string
+}
+
+// DefaultAccessConfig returns the system-wide default: every path in the
+// working copy is readable.
+func DefaultAccessConfig() AccessConfig {
+	return AccessConfig{}
+}
+
+// NewAccessConfig creates an AccessConfig with the given deny globs (e.g.
+// "**/secrets/**"). A path is denied if it matches any of them.
+func NewAccessConfig(denyGlobs []string) AccessConfig {
+	globs := make([]string, len(denyGlobs))
+	copy(globs, denyGlobs)
+	return AccessConfig{denyGlobs: globs}
+}
+
+// ReconstructAccessConfig rebuilds an AccessConfig from persistence.
+func ReconstructAccessConfig(denyGlobs []string) AccessConfig {
+	return NewAccessConfig(denyGlobs)
+}
+
+// DenyGlobs returns the configured deny globs.
+func (c AccessConfig) DenyGlobs() []string {
+	globs := make([]string, len(c.denyGlobs))
+	copy(globs, c.denyGlobs)
+	return globs
+}
+
+// IsDefault returns true when the config matches DefaultAccessConfig.
+func (c AccessConfig) IsDefault() bool {
+	return len(c.denyGlobs) == 0
+}
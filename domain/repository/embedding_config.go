@@ -0,0 +1,33 @@
+package repository
+
+// EmbeddingConfig controls how chunk content is transformed before being
+// sent for embedding. The displayed snippet always keeps the original
+// content; only the text handed to the embedding provider is affected.
+type EmbeddingConfig struct {
+	stripComments bool
+}
+
+// DefaultEmbeddingConfig returns the system-wide default: comments and
+// docstrings are embedded alongside code.
+func DefaultEmbeddingConfig() EmbeddingConfig {
+	return EmbeddingConfig{stripComments: false}
+}
+
+// NewEmbeddingConfig creates an EmbeddingConfig.
+func NewEmbeddingConfig(stripComments bool) EmbeddingConfig {
+	return EmbeddingConfig{stripComments: stripComments}
+}
+
+// ReconstructEmbeddingConfig rebuilds an EmbeddingConfig from persistence.
+func ReconstructEmbeddingConfig(stripComments bool) EmbeddingConfig {
+	return EmbeddingConfig{stripComments: stripComments}
+}
+
+// StripComments reports whether comments and docstrings should be removed
+// from the text sent for embedding.
+func (c EmbeddingConfig) StripComments() bool { return c.stripComments }
+
+// IsDefault returns true when the config matches DefaultEmbeddingConfig.
+func (c EmbeddingConfig) IsDefault() bool {
+	return c.stripComments == DefaultEmbeddingConfig().stripComments
+}
@@ -0,0 +1,41 @@
+package repository
+
+import "fmt"
+
+// EnrichmentBudgetConfig caps how many files are AI-summarized per commit,
+// so large repositories incur bounded enrichment cost instead of either
+// summarizing everything or disabling summaries entirely. A MaxFileSummaries
+// of 0 means unlimited (summarize every chunked file; the historical
+// behavior).
+type EnrichmentBudgetConfig struct {
+	maxFileSummaries int
+}
+
+// DefaultEnrichmentBudgetConfig returns the system-wide default: unlimited.
+func DefaultEnrichmentBudgetConfig() EnrichmentBudgetConfig {
+	return EnrichmentBudgetConfig{maxFileSummaries: 0}
+}
+
+// NewEnrichmentBudgetConfig creates a validated EnrichmentBudgetConfig.
+func NewEnrichmentBudgetConfig(maxFileSummaries int) (EnrichmentBudgetConfig, error) {
+	if maxFileSummaries < 0 {
+		return EnrichmentBudgetConfig{}, fmt.Errorf("max_file_summaries must be non-negative, got %d", maxFileSummaries)
+	}
+	return EnrichmentBudgetConfig{maxFileSummaries: maxFileSummaries}, nil
+}
+
+// ReconstructEnrichmentBudgetConfig rebuilds an EnrichmentBudgetConfig from persistence without validation.
+func ReconstructEnrichmentBudgetConfig(maxFileSummaries int) EnrichmentBudgetConfig {
+	return EnrichmentBudgetConfig{maxFileSummaries: maxFileSummaries}
+}
+
+// MaxFileSummaries returns the maximum number of files to summarize per commit, or 0 for unlimited.
+func (c EnrichmentBudgetConfig) MaxFileSummaries() int { return c.maxFileSummaries }
+
+// Unlimited returns true if no cap is configured.
+func (c EnrichmentBudgetConfig) Unlimited() bool { return c.maxFileSummaries == 0 }
+
+// IsDefault returns true when the config matches DefaultEnrichmentBudgetConfig.
+func (c EnrichmentBudgetConfig) IsDefault() bool {
+	return c.maxFileSummaries == DefaultEnrichmentBudgetConfig().maxFileSummaries
+}
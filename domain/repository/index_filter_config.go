@@ -0,0 +1,76 @@
+package repository
+
+// IndexFilterConfig controls which file paths within a repository are
+// eligible for snippet extraction and enrichment. It lets a maintainer keep
+// vendored code, generated files, and test fixtures out of the index
+// without having to .gitignore them from the working copy itself.
+type IndexFilterConfig struct {
+	indexPaths  []string
+	ignorePaths []string
+}
+
+// DefaultIndexFilterConfig returns the system-wide default: every indexable
+// file in the working copy is eligible.
+func DefaultIndexFilterConfig() IndexFilterConfig {
+	return IndexFilterConfig{}
+}
+
+// NewIndexFilterConfig creates an IndexFilterConfig. When indexPaths is
+// non-empty, only files matching one of its globs (e.g. "src/**") are
+// eligible; ignorePaths (e.g. "**/vendor/**") are excluded regardless of
+// indexPaths.
+func NewIndexFilterConfig(indexPaths, ignorePaths []string) IndexFilterConfig {
+	index := make([]string, len(indexPaths))
+	copy(index, indexPaths)
+	ignore := make([]string, len(ignorePaths))
+	copy(ignore, ignorePaths)
+	return IndexFilterConfig{indexPaths: index, ignorePaths: ignore}
+}
+
+// ReconstructIndexFilterConfig rebuilds an IndexFilterConfig from persistence.
+func ReconstructIndexFilterConfig(indexPaths, ignorePaths []string) IndexFilterConfig {
+	return NewIndexFilterConfig(indexPaths, ignorePaths)
+}
+
+// IndexPaths returns the configured index-path globs.
+func (c IndexFilterConfig) IndexPaths() []string {
+	paths := make([]string, len(c.indexPaths))
+	copy(paths, c.indexPaths)
+	return paths
+}
+
+// IgnorePaths returns the configured ignore-path globs.
+func (c IndexFilterConfig) IgnorePaths() []string {
+	paths := make([]string, len(c.ignorePaths))
+	copy(paths, c.ignorePaths)
+	return paths
+}
+
+// IsDefault returns true when the config matches DefaultIndexFilterConfig.
+func (c IndexFilterConfig) IsDefault() bool {
+	return len(c.indexPaths) == 0 && len(c.ignorePaths) == 0
+}
+
+// Allows reports whether path is eligible for indexing under this config.
+// match is used to test a glob pattern against path, letting callers supply
+// their own glob implementation without this package depending on one. path
+// is denied if it matches any ignorePaths glob, then, when indexPaths is
+// non-empty, allowed only if it also matches one of those globs.
+func (c IndexFilterConfig) Allows(path string, match func(pattern, path string) bool) bool {
+	for _, glob := range c.ignorePaths {
+		if match(glob, path) {
+			return false
+		}
+	}
+
+	if len(c.indexPaths) == 0 {
+		return true
+	}
+
+	for _, glob := range c.indexPaths {
+		if match(glob, path) {
+			return true
+		}
+	}
+	return false
+}
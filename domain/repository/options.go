@@ -22,6 +22,12 @@ func WithUpstreamURL(url string) Option {
 	return WithCondition("upstream_url", url)
 }
 
+// WithRemoteURLLike filters for a case-insensitive substring match against
+// the "sanitized_remote_uri" column. Used for autocomplete-style lookups.
+func WithRemoteURLLike(q string) Option {
+	return WithWhere("LOWER(sanitized_remote_uri) LIKE LOWER(?)", "%"+q+"%")
+}
+
 // WithDefault filters for the default branch (is_default = true).
 func WithDefault() Option {
 	return WithCondition("is_default", true)
@@ -47,6 +53,32 @@ func WithPath(path string) Option {
 	return WithCondition("path", path)
 }
 
+// WithPathPrefix filters for paths starting with the given prefix.
+func WithPathPrefix(prefix string) Option {
+	return WithWhere("path LIKE ?", prefix+"%")
+}
+
+// WithExtension filters by the "extension" column.
+func WithExtension(extension string) Option {
+	return WithCondition("extension", extension)
+}
+
+// WithOrderBySize orders results by the "size" column.
+func WithOrderBySize(ascending bool) Option {
+	if ascending {
+		return WithOrderAsc("size")
+	}
+	return WithOrderDesc("size")
+}
+
+// WithOrderByPath orders results by the "path" column.
+func WithOrderByPath(ascending bool) Option {
+	if ascending {
+		return WithOrderAsc("path")
+	}
+	return WithOrderDesc("path")
+}
+
 // WithPipelineID filters by the "pipeline_id" column.
 func WithPipelineID(id int64) Option {
 	return WithCondition("pipeline_id", id)
@@ -76,3 +108,8 @@ func WithStepIDIn(ids []int64) Option {
 func WithScanDueBefore(t time.Time) Option {
 	return WithWhere("last_scanned_at IS NULL OR last_scanned_at < ?", t)
 }
+
+// WithArchived filters by the "archived" column.
+func WithArchived(archived bool) Option {
+	return WithCondition("archived", archived)
+}
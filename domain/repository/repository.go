@@ -14,17 +14,35 @@ var ErrNotCloned = errors.New("repository has not been cloned")
 
 // Repository represents a tracked Git repository (aggregate root).
 type Repository struct {
-	id             int64
-	pipelineID     int64
-	remoteURL      string
-	sanitizedURL   string
-	upstreamURL    string
-	workingCopy    WorkingCopy
-	trackingConfig TrackingConfig
-	chunkingConfig ChunkingConfig
-	createdAt      time.Time
-	updatedAt      time.Time
-	lastScannedAt  time.Time
+	id                 int64
+	pipelineID         int64
+	remoteURL          string
+	sanitizedURL       string
+	upstreamURL        string
+	workingCopy        WorkingCopy
+	trackingConfig     TrackingConfig
+	chunkingConfig     ChunkingConfig
+	enrichmentBudget   EnrichmentBudgetConfig
+	embeddingConfig    EmbeddingConfig
+	accessConfig       AccessConfig
+	indexFilterConfig  IndexFilterConfig
+	createdAt          time.Time
+	updatedAt          time.Time
+	lastScannedAt      time.Time
+	enrichmentLanguage string
+
+	// trackingAutoDetected is true when trackingConfig was inferred from the
+	// remote's default branch (e.g. a repo added without an explicit
+	// branch/tag/commit) rather than set explicitly by a user. Only
+	// auto-detected tracking is eligible for default-branch repair.
+	trackingAutoDetected bool
+	// autoRepairTracking controls whether Sync updates trackingConfig on its
+	// own when the remote's default branch changes out from under an
+	// auto-detected tracking config. When false, a mismatch is only logged.
+	autoRepairTracking bool
+	// archived marks a repository as retired: periodic sync and new
+	// enrichment work stop, but previously indexed data remains searchable.
+	archived bool
 }
 
 // NewRepository creates a new Repository with a remote URL.
@@ -34,10 +52,14 @@ func NewRepository(remoteURL string) (Repository, error) {
 	}
 	now := time.Now()
 	return Repository{
-		remoteURL:      remoteURL,
-		chunkingConfig: DefaultChunkingConfig(),
-		createdAt:      now,
-		updatedAt:      now,
+		remoteURL:         remoteURL,
+		chunkingConfig:    DefaultChunkingConfig(),
+		enrichmentBudget:  DefaultEnrichmentBudgetConfig(),
+		embeddingConfig:   DefaultEmbeddingConfig(),
+		accessConfig:      DefaultAccessConfig(),
+		indexFilterConfig: DefaultIndexFilterConfig(),
+		createdAt:         now,
+		updatedAt:         now,
 	}, nil
 }
 
@@ -51,21 +73,37 @@ func ReconstructRepository(
 	workingCopy WorkingCopy,
 	trackingConfig TrackingConfig,
 	chunkingConfig ChunkingConfig,
+	enrichmentBudget EnrichmentBudgetConfig,
+	embeddingConfig EmbeddingConfig,
+	accessConfig AccessConfig,
+	indexFilterConfig IndexFilterConfig,
 	createdAt, updatedAt time.Time,
 	lastScannedAt time.Time,
+	enrichmentLanguage string,
+	trackingAutoDetected bool,
+	autoRepairTracking bool,
+	archived bool,
 ) Repository {
 	return Repository{
-		id:             id,
-		pipelineID:     pipelineID,
-		remoteURL:      remoteURL,
-		sanitizedURL:   sanitizedURL,
-		upstreamURL:    upstreamURL,
-		workingCopy:    workingCopy,
-		trackingConfig: trackingConfig,
-		chunkingConfig: chunkingConfig,
-		createdAt:      createdAt,
-		updatedAt:      updatedAt,
-		lastScannedAt:  lastScannedAt,
+		id:                   id,
+		pipelineID:           pipelineID,
+		remoteURL:            remoteURL,
+		sanitizedURL:         sanitizedURL,
+		upstreamURL:          upstreamURL,
+		workingCopy:          workingCopy,
+		trackingConfig:       trackingConfig,
+		chunkingConfig:       chunkingConfig,
+		enrichmentBudget:     enrichmentBudget,
+		embeddingConfig:      embeddingConfig,
+		accessConfig:         accessConfig,
+		indexFilterConfig:    indexFilterConfig,
+		createdAt:            createdAt,
+		updatedAt:            updatedAt,
+		lastScannedAt:        lastScannedAt,
+		enrichmentLanguage:   enrichmentLanguage,
+		trackingAutoDetected: trackingAutoDetected,
+		autoRepairTracking:   autoRepairTracking,
+		archived:             archived,
 	}
 }
 
@@ -120,6 +158,60 @@ func (r Repository) WithChunkingConfig(cc ChunkingConfig) Repository {
 	return r
 }
 
+// EnrichmentBudget returns the enrichment budget configuration.
+func (r Repository) EnrichmentBudget() EnrichmentBudgetConfig { return r.enrichmentBudget }
+
+// WithEnrichmentBudget returns a new Repository with the specified enrichment budget.
+func (r Repository) WithEnrichmentBudget(b EnrichmentBudgetConfig) Repository {
+	r.enrichmentBudget = b
+	r.updatedAt = time.Now()
+	return r
+}
+
+// EmbeddingConfig returns the embedding configuration.
+func (r Repository) EmbeddingConfig() EmbeddingConfig { return r.embeddingConfig }
+
+// WithEmbeddingConfig returns a new Repository with the specified embedding config.
+func (r Repository) WithEmbeddingConfig(c EmbeddingConfig) Repository {
+	r.embeddingConfig = c
+	r.updatedAt = time.Now()
+	return r
+}
+
+// AccessConfig returns the path access control configuration.
+func (r Repository) AccessConfig() AccessConfig { return r.accessConfig }
+
+// WithAccessConfig returns a new Repository with the specified access config.
+func (r Repository) WithAccessConfig(c AccessConfig) Repository {
+	r.accessConfig = c
+	r.updatedAt = time.Now()
+	return r
+}
+
+// IndexFilterConfig returns the index path filter configuration.
+func (r Repository) IndexFilterConfig() IndexFilterConfig { return r.indexFilterConfig }
+
+// WithIndexFilterConfig returns a new Repository with the specified index
+// filter config.
+func (r Repository) WithIndexFilterConfig(c IndexFilterConfig) Repository {
+	r.indexFilterConfig = c
+	r.updatedAt = time.Now()
+	return r
+}
+
+// EnrichmentLanguage returns the human language enrichments should be
+// written in for this repository, or "" if it should be detected from the
+// repository's own comments and docs instead of overridden.
+func (r Repository) EnrichmentLanguage() string { return r.enrichmentLanguage }
+
+// WithEnrichmentLanguage returns a new Repository with the given enrichment
+// output language override. Pass "" to fall back to automatic detection.
+func (r Repository) WithEnrichmentLanguage(lang string) Repository {
+	r.enrichmentLanguage = lang
+	r.updatedAt = time.Now()
+	return r
+}
+
 // CreatedAt returns the creation timestamp.
 func (r Repository) CreatedAt() time.Time { return r.createdAt }
 
@@ -143,9 +235,41 @@ func (r Repository) WithWorkingCopy(wc WorkingCopy) Repository {
 	return r
 }
 
-// WithTrackingConfig returns a new Repository with the specified tracking config.
+// WithTrackingConfig returns a new Repository with the specified tracking
+// config, set explicitly (e.g. by a user via the API). Clears
+// TrackingAutoDetected, since the config no longer reflects the remote's
+// default branch alone.
 func (r Repository) WithTrackingConfig(tc TrackingConfig) Repository {
 	r.trackingConfig = tc
+	r.trackingAutoDetected = false
+	r.updatedAt = time.Now()
+	return r
+}
+
+// WithAutoDetectedTrackingConfig returns a new Repository tracking tc,
+// marked as inferred from the remote's default branch rather than set
+// explicitly. Sync uses this so it can later detect and repair a mismatch
+// if the remote's default branch changes.
+func (r Repository) WithAutoDetectedTrackingConfig(tc TrackingConfig) Repository {
+	r.trackingConfig = tc
+	r.trackingAutoDetected = true
+	r.updatedAt = time.Now()
+	return r
+}
+
+// TrackingAutoDetected returns true if the tracking config was inferred from
+// the remote's default branch rather than set explicitly by a user.
+func (r Repository) TrackingAutoDetected() bool { return r.trackingAutoDetected }
+
+// AutoRepairTracking returns true if Sync should automatically update
+// trackingConfig when the remote's default branch changes out from under an
+// auto-detected tracking config, instead of only logging the mismatch.
+func (r Repository) AutoRepairTracking() bool { return r.autoRepairTracking }
+
+// WithAutoRepairTracking returns a new Repository with auto-repair of
+// default-branch mismatches enabled or disabled.
+func (r Repository) WithAutoRepairTracking(enabled bool) Repository {
+	r.autoRepairTracking = enabled
 	r.updatedAt = time.Now()
 	return r
 }
@@ -164,3 +288,17 @@ func (r Repository) WithID(id int64) Repository {
 	r.id = id
 	return r
 }
+
+// Archived returns true if the repository has been archived: periodic sync
+// and new enrichment work are halted, but previously indexed data remains
+// searchable.
+func (r Repository) Archived() bool { return r.archived }
+
+// WithArchived returns a new Repository with the archived state set. Archiving
+// is distinct from deletion: existing snippets, embeddings, and enrichments
+// are left in place.
+func (r Repository) WithArchived(archived bool) Repository {
+	r.archived = archived
+	r.updatedAt = time.Now()
+	return r
+}
@@ -0,0 +1,79 @@
+package search
+
+import "strings"
+
+// ContextFields are the structured values available to a ContextTemplate
+// when rendering a header for embedding input.
+type ContextFields struct {
+	repoName  string
+	filePath  string
+	language  string
+	docstring string
+}
+
+// NewContextFields creates the fields available when rendering an
+// embedding context header.
+func NewContextFields(repoName, filePath, language, docstring string) ContextFields {
+	return ContextFields{repoName: repoName, filePath: filePath, language: language, docstring: docstring}
+}
+
+// ContextTemplate renders a header that is prepended to a document's raw
+// content before it is sent for embedding, so retrieval can match on repo,
+// file, and doc context without changing what is stored for display. An
+// empty template renders to an empty header.
+type ContextTemplate string
+
+// Render substitutes the {repo}, {path}, {language}, and {docstring}
+// placeholders in the template with values from fields.
+func (t ContextTemplate) Render(fields ContextFields) string {
+	if t == "" {
+		return ""
+	}
+	replacer := strings.NewReplacer(
+		"{repo}", fields.repoName,
+		"{path}", fields.filePath,
+		"{language}", fields.language,
+		"{docstring}", fields.docstring,
+	)
+	return replacer.Replace(string(t))
+}
+
+// ContextTemplateConfig holds the default context header template plus
+// per-language overrides, so e.g. Python snippets can render a different
+// header than Go ones.
+type ContextTemplateConfig struct {
+	defaultTemplate ContextTemplate
+	perLanguage     map[string]ContextTemplate
+}
+
+// NewContextTemplateConfig creates a ContextTemplateConfig. An empty
+// defaultTemplate with no perLanguage overrides disables context headers
+// entirely: BuildInput then returns code unchanged.
+func NewContextTemplateConfig(defaultTemplate string, perLanguage map[string]string) ContextTemplateConfig {
+	templates := make(map[string]ContextTemplate, len(perLanguage))
+	for language, tmpl := range perLanguage {
+		templates[language] = ContextTemplate(tmpl)
+	}
+	return ContextTemplateConfig{defaultTemplate: ContextTemplate(defaultTemplate), perLanguage: templates}
+}
+
+// TemplateFor returns the template for the given language, falling back to
+// the default template when no per-language override is configured.
+func (c ContextTemplateConfig) TemplateFor(language string) ContextTemplate {
+	if tmpl, ok := c.perLanguage[language]; ok {
+		return tmpl
+	}
+	return c.defaultTemplate
+}
+
+// BuildInput renders the context header for fields and prepends it to code.
+// When the resolved template is empty, code is returned unchanged, so
+// embedding input matches the stored content exactly unless a template has
+// been configured.
+func (c ContextTemplateConfig) BuildInput(fields ContextFields, code string) string {
+	header := c.TemplateFor(fields.language).Render(fields)
+	if header == "" {
+		return code
+	}
+	return header + code
+}
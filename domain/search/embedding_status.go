@@ -0,0 +1,89 @@
+package search
+
+import (
+	"time"
+
+	"github.com/helixml/kodit/domain/repository"
+)
+
+// TaskName identifies which embedding a status (or store) describes.
+type TaskName string
+
+// TaskName values.
+var (
+	TaskNameCode   = TaskName("code")
+	TaskNameText   = TaskName("text")
+	TaskNameVision = TaskName("vision")
+)
+
+// EmbeddingStatusState represents the lifecycle state of a snippet's embedding.
+type EmbeddingStatusState string
+
+// EmbeddingStatusState values.
+const (
+	EmbeddingStatusEmbedded EmbeddingStatusState = "embedded"
+	EmbeddingStatusFailed   EmbeddingStatusState = "failed"
+)
+
+// EmbeddingStatus records the outcome of embedding a single snippet for a
+// given task (code, text, or vision), so a provider outage or partial
+// indexing failure can be surfaced and retried instead of silently leaving
+// the snippet unsearchable. A snippet with no status row is implicitly
+// pending — it hasn't been attempted yet.
+type EmbeddingStatus struct {
+	id        int64
+	snippetID string
+	taskName  TaskName
+	state     EmbeddingStatusState
+	errMsg    string
+	updatedAt time.Time
+}
+
+// NewEmbeddingStatus creates an EmbeddingStatus for a snippet's most recent
+// embedding attempt. errMsg should be empty unless state is
+// EmbeddingStatusFailed.
+func NewEmbeddingStatus(snippetID string, taskName TaskName, state EmbeddingStatusState, errMsg string) EmbeddingStatus {
+	return EmbeddingStatus{
+		snippetID: snippetID,
+		taskName:  taskName,
+		state:     state,
+		errMsg:    errMsg,
+	}
+}
+
+// NewEmbeddingStatusWithID creates an EmbeddingStatus with all fields (used
+// by repository).
+func NewEmbeddingStatusWithID(id int64, snippetID string, taskName TaskName, state EmbeddingStatusState, errMsg string, updatedAt time.Time) EmbeddingStatus {
+	return EmbeddingStatus{
+		id:        id,
+		snippetID: snippetID,
+		taskName:  taskName,
+		state:     state,
+		errMsg:    errMsg,
+		updatedAt: updatedAt,
+	}
+}
+
+// ID returns the status row's ID.
+func (s EmbeddingStatus) ID() int64 { return s.id }
+
+// SnippetID returns the snippet this status describes.
+func (s EmbeddingStatus) SnippetID() string { return s.snippetID }
+
+// TaskName returns which embedding (code, text, or vision) this status describes.
+func (s EmbeddingStatus) TaskName() TaskName { return s.taskName }
+
+// State returns the embedding's lifecycle state.
+func (s EmbeddingStatus) State() EmbeddingStatusState { return s.state }
+
+// Error returns the upstream error message when State is
+// EmbeddingStatusFailed, or an empty string otherwise.
+func (s EmbeddingStatus) Error() string { return s.errMsg }
+
+// UpdatedAt returns when this status was last written.
+func (s EmbeddingStatus) UpdatedAt() time.Time { return s.updatedAt }
+
+// EmbeddingStatusStore persists EmbeddingStatus rows.
+type EmbeddingStatusStore interface {
+	repository.Store[EmbeddingStatus]
+}
@@ -4,15 +4,19 @@ import "time"
 
 // Filters represents filters for snippet search.
 type Filters struct {
-	languages          []string
-	authors            []string
-	createdAfter       time.Time
-	createdBefore      time.Time
-	sourceRepos        []int64
-	filePaths          []string
-	enrichmentTypes    []string
-	enrichmentSubtypes []string
-	commitSHAs         []string
+	languages           []string
+	authors             []string
+	createdAfter        time.Time
+	createdBefore       time.Time
+	sourceRepos         []int64
+	filePaths           []string
+	enrichmentTypes     []string
+	enrichmentSubtypes  []string
+	commitSHAs          []string
+	excludeKeywords     []string
+	excludePathPrefixes []string
+	excludeRepoIDs      []int64
+	prRef               string
 }
 
 // FiltersOption is a functional option for Filters.
@@ -102,6 +106,48 @@ func WithCommitSHAs(shas []string) FiltersOption {
 	}
 }
 
+// WithExcludeKeywords sets the excluded-keyword filter: results whose
+// matched content contains any of these terms are dropped.
+func WithExcludeKeywords(keywords []string) FiltersOption {
+	return func(f *Filters) {
+		if keywords != nil {
+			f.excludeKeywords = make([]string, len(keywords))
+			copy(f.excludeKeywords, keywords)
+		}
+	}
+}
+
+// WithExcludePathPrefixes sets the excluded-path-prefix filter: results
+// whose file path starts with any of these prefixes are dropped.
+func WithExcludePathPrefixes(prefixes []string) FiltersOption {
+	return func(f *Filters) {
+		if prefixes != nil {
+			f.excludePathPrefixes = make([]string, len(prefixes))
+			copy(f.excludePathPrefixes, prefixes)
+		}
+	}
+}
+
+// WithExcludeRepoIDs sets the excluded-repository filter: results sourced
+// from any of these repositories are dropped.
+func WithExcludeRepoIDs(ids []int64) FiltersOption {
+	return func(f *Filters) {
+		if ids != nil {
+			f.excludeRepoIDs = make([]int64, len(ids))
+			copy(f.excludeRepoIDs, ids)
+		}
+	}
+}
+
+// WithPRRef scopes search to snippets indexed from a specific PR branch
+// preview (see the prindex package), overlaying the base repository's index
+// rather than replacing it.
+func WithPRRef(ref string) FiltersOption {
+	return func(f *Filters) {
+		f.prRef = ref
+	}
+}
+
 // NewFilters creates a new Filters with options.
 func NewFilters(opts ...FiltersOption) Filters {
 	f := Filters{}
@@ -195,6 +241,39 @@ func (f Filters) CommitSHAs() []string {
 	return result
 }
 
+// ExcludeKeywords returns the excluded-keyword filter.
+func (f Filters) ExcludeKeywords() []string {
+	if f.excludeKeywords == nil {
+		return nil
+	}
+	result := make([]string, len(f.excludeKeywords))
+	copy(result, f.excludeKeywords)
+	return result
+}
+
+// ExcludePathPrefixes returns the excluded-path-prefix filter.
+func (f Filters) ExcludePathPrefixes() []string {
+	if f.excludePathPrefixes == nil {
+		return nil
+	}
+	result := make([]string, len(f.excludePathPrefixes))
+	copy(result, f.excludePathPrefixes)
+	return result
+}
+
+// PRRef returns the PR ref filter, or "" if unset.
+func (f Filters) PRRef() string { return f.prRef }
+
+// ExcludeRepoIDs returns the excluded-repository filter.
+func (f Filters) ExcludeRepoIDs() []int64 {
+	if f.excludeRepoIDs == nil {
+		return nil
+	}
+	result := make([]int64, len(f.excludeRepoIDs))
+	copy(result, f.excludeRepoIDs)
+	return result
+}
+
 // IsEmpty returns true if no filters are set.
 func (f Filters) IsEmpty() bool {
 	return len(f.languages) == 0 &&
@@ -205,5 +284,9 @@ func (f Filters) IsEmpty() bool {
 		len(f.filePaths) == 0 &&
 		len(f.enrichmentTypes) == 0 &&
 		len(f.enrichmentSubtypes) == 0 &&
-		len(f.commitSHAs) == 0
+		len(f.commitSHAs) == 0 &&
+		len(f.excludeKeywords) == 0 &&
+		len(f.excludePathPrefixes) == 0 &&
+		len(f.excludeRepoIDs) == 0 &&
+		f.prRef == ""
 }
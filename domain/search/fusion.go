@@ -25,6 +25,20 @@ func NewFusionWithK(k float64) Fusion {
 // Each input list should be sorted by score (descending).
 // Returns a fused list sorted by combined RRF score.
 func (f Fusion) Fuse(lists ...[]FusionRequest) []FusionResult {
+	return f.fuse(lists, nil)
+}
+
+// FuseWeighted combines multiple ranked result lists like Fuse, but scales
+// each list's RRF contribution by the corresponding entry in weights before
+// accumulation — e.g. giving semantic (vector) lists more say than keyword
+// (BM25) lists for a natural-language query. len(weights) must equal
+// len(lists); if it doesn't, FuseWeighted falls back to the unweighted
+// behavior of Fuse.
+func (f Fusion) FuseWeighted(weights []float64, lists ...[]FusionRequest) []FusionResult {
+	return f.fuse(lists, weights)
+}
+
+func (f Fusion) fuse(lists [][]FusionRequest, weights []float64) []FusionResult {
 	if len(lists) == 0 {
 		return []FusionResult{}
 	}
@@ -35,11 +49,15 @@ func (f Fusion) Fuse(lists ...[]FusionRequest) []FusionResult {
 
 	// Process each ranked list
 	for listIdx, list := range lists {
+		weight := 1.0
+		if len(weights) == len(lists) {
+			weight = weights[listIdx]
+		}
 		for rank, req := range list {
 			id := req.ID()
 
-			// RRF formula: 1 / (k + rank)
-			rrfScore := 1.0 / (f.k + float64(rank))
+			// RRF formula: weight / (k + rank)
+			rrfScore := weight / (f.k + float64(rank))
 			scores[id] += rrfScore
 
 			// Track original scores for this document
@@ -69,12 +87,19 @@ func (f Fusion) Fuse(lists ...[]FusionRequest) []FusionResult {
 
 // FuseTopK combines multiple ranked result lists and returns the top K results.
 func (f Fusion) FuseTopK(topK int, lists ...[]FusionRequest) []FusionResult {
-	results := f.Fuse(lists...)
+	return truncateTopK(topK, f.Fuse(lists...))
+}
+
+// FuseWeightedTopK combines multiple ranked result lists using FuseWeighted
+// and returns the top K results.
+func (f Fusion) FuseWeightedTopK(topK int, weights []float64, lists ...[]FusionRequest) []FusionResult {
+	return truncateTopK(topK, f.FuseWeighted(weights, lists...))
+}
 
+func truncateTopK(topK int, results []FusionResult) []FusionResult {
 	if topK <= 0 || topK >= len(results) {
 		return results
 	}
-
 	return results[:topK]
 }
 
@@ -204,3 +204,57 @@ func TestFusion_Fuse_AsymmetricLists(t *testing.T) {
 		t.Errorf("expected first result 'a' (tie-break by ID), got %q", results[0].ID())
 	}
 }
+
+func TestFusion_FuseWeighted_BiasesTowardHigherWeightedList(t *testing.T) {
+	fusion := NewFusion()
+
+	list1 := []FusionRequest{NewFusionRequest("a", 0.9)}
+	list2 := []FusionRequest{NewFusionRequest("b", 0.9)}
+
+	results := fusion.FuseWeighted([]float64{0.9, 0.1}, list1, list2)
+
+	scores := make(map[string]float64)
+	for _, r := range results {
+		scores[r.ID()] = r.Score()
+	}
+
+	if scores["a"] <= scores["b"] {
+		t.Errorf("expected 'a' (weight 0.9) to outscore 'b' (weight 0.1): a=%f, b=%f", scores["a"], scores["b"])
+	}
+}
+
+func TestFusion_FuseWeighted_MismatchedWeightsFallsBackToUnweighted(t *testing.T) {
+	fusion := NewFusion()
+
+	list1 := []FusionRequest{NewFusionRequest("a", 0.9)}
+	list2 := []FusionRequest{NewFusionRequest("b", 0.9)}
+
+	weighted := fusion.FuseWeighted([]float64{0.9}, list1, list2)
+	unweighted := fusion.Fuse(list1, list2)
+
+	if len(weighted) != len(unweighted) {
+		t.Fatalf("expected %d results, got %d", len(unweighted), len(weighted))
+	}
+	for i := range weighted {
+		if weighted[i].ID() != unweighted[i].ID() || math.Abs(weighted[i].Score()-unweighted[i].Score()) > 1e-10 {
+			t.Errorf("expected fallback to unweighted fusion, got %+v vs %+v", weighted[i], unweighted[i])
+		}
+	}
+}
+
+func TestFusion_FuseWeightedTopK(t *testing.T) {
+	fusion := NewFusion()
+
+	list1 := []FusionRequest{
+		NewFusionRequest("a", 0.9),
+		NewFusionRequest("b", 0.7),
+	}
+
+	results := fusion.FuseWeightedTopK(1, []float64{1.0}, list1)
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(results))
+	}
+	if results[0].ID() != "a" {
+		t.Errorf("expected top result 'a', got %q", results[0].ID())
+	}
+}
@@ -10,6 +10,19 @@ type BatchProgress func(completed, total int)
 // err is the upstream error (e.g. HTTP 429, timeout, auth failure).
 type BatchError func(batchStart, batchEnd int, err error)
 
+// BatchResult is called once per batch after it has been attempted, whether
+// it succeeded or failed. docs is the batch that was attempted; err is nil
+// on success. Unlike BatchError, this fires on the success path too, so
+// callers can record a per-document outcome (e.g. persisting embedding
+// status) without re-deriving which documents were in a failed batch.
+type BatchResult func(docs []Document, err error)
+
+// ItemFailure is called for a document that a provider rejected outright
+// (e.g. token limit, content filter) and that still failed once retried in
+// isolation from the rest of its batch. err is the reason reported for that
+// specific document, not the batch as a whole.
+type ItemFailure func(doc Document, err error)
+
 // IndexOption configures the behaviour of an Index call.
 type IndexOption func(*IndexConfig)
 
@@ -17,6 +30,8 @@ type IndexOption func(*IndexConfig)
 type IndexConfig struct {
 	progress       BatchProgress
 	batchError     BatchError
+	batchResult    BatchResult
+	itemFailure    ItemFailure
 	maxFailureRate float64
 	rateSet        bool
 }
@@ -39,6 +54,12 @@ func (c IndexConfig) Progress() BatchProgress { return c.progress }
 // BatchError returns the batch error callback, or nil if none was set.
 func (c IndexConfig) BatchError() BatchError { return c.batchError }
 
+// BatchResult returns the batch result callback, or nil if none was set.
+func (c IndexConfig) BatchResult() BatchResult { return c.batchResult }
+
+// ItemFailure returns the item failure callback, or nil if none was set.
+func (c IndexConfig) ItemFailure() ItemFailure { return c.itemFailure }
+
 // MaxFailureRate returns the maximum fraction of batches that may fail
 // before the Index call returns an error. Default is 0.05 (5%).
 func (c IndexConfig) MaxFailureRate() float64 { return c.maxFailureRate }
@@ -56,6 +77,21 @@ func WithBatchError(fn BatchError) IndexOption {
 	return func(c *IndexConfig) { c.batchError = fn }
 }
 
+// WithBatchResult registers a callback that is invoked once per batch after
+// it has been attempted, on both success and failure. Use this to persist a
+// per-document outcome (e.g. embedding status) rather than just logging.
+func WithBatchResult(fn BatchResult) IndexOption {
+	return func(c *IndexConfig) { c.batchResult = fn }
+}
+
+// WithItemFailure registers a callback invoked for each document that a
+// provider rejected and that still failed once retried on its own, after a
+// batch containing it failed as a whole. Use this to record a permanent
+// per-item failure reason distinct from the batch-level error.
+func WithItemFailure(fn ItemFailure) IndexOption {
+	return func(c *IndexConfig) { c.itemFailure = fn }
+}
+
 // WithMaxFailureRate sets the maximum fraction of batches that may fail
 // before the Index call returns an error. The rate is clamped to [0, 1].
 // A rate of 0 means any single batch failure is fatal.
@@ -16,6 +16,16 @@ func WithSnippetIDs(ids []string) repository.Option {
 	return repository.WithConditionIn("snippet_id", ids)
 }
 
+// WithTaskName filters EmbeddingStatus rows by task (code, text, or vision).
+func WithTaskName(name TaskName) repository.Option {
+	return repository.WithCondition("task_name", string(name))
+}
+
+// WithState filters EmbeddingStatus rows by lifecycle state.
+func WithState(state EmbeddingStatusState) repository.Option {
+	return repository.WithCondition("state", string(state))
+}
+
 // WithEmbedding passes a pre-computed embedding vector through options.
 func WithEmbedding(embedding []float64) repository.Option {
 	return repository.WithParam("embedding", embedding)
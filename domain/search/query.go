@@ -43,32 +43,71 @@ func (q Query) TopK() int { return q.topK }
 
 // MultiRequest represents a multi-modal search request.
 type MultiRequest struct {
-	topK      int
-	textQuery string
-	codeQuery string
-	keywords  []string
-	filters   Filters
+	topK           int
+	textQuery      string
+	codeQuery      string
+	keywords       []string
+	filters        Filters
+	namespace      string
+	semanticWeight float64
+	autoWeight     bool
 }
 
-// NewMultiRequest creates a new MultiRequest.
+// MultiRequestOption configures optional semantic/keyword fusion weighting
+// for a MultiRequest. The default, with no option applied, is the fusion's
+// ordinary unweighted RRF behavior.
+type MultiRequestOption func(*MultiRequest)
+
+// WithSemanticWeight biases fusion toward semantic (vector) results over
+// keyword (BM25) results. weight is the semantic share of fusion weight, in
+// [0,1]; values outside that range are ignored.
+func WithSemanticWeight(weight float64) MultiRequestOption {
+	return func(m *MultiRequest) {
+		if weight >= 0 && weight <= 1 {
+			m.semanticWeight = weight
+			m.autoWeight = false
+		}
+	}
+}
+
+// WithAutoWeight enables automatic semantic/keyword fusion weighting,
+// inferred from the shape of the text query (see InferSemanticWeight).
+func WithAutoWeight() MultiRequestOption {
+	return func(m *MultiRequest) {
+		m.autoWeight = true
+	}
+}
+
+// NewMultiRequest creates a new MultiRequest. namespace selects which
+// synonym dictionary (if any) is used to expand the query and keywords
+// before searching; "" means no expansion. By default, fusion weighting is
+// unset; pass WithSemanticWeight or WithAutoWeight to opt in.
 func NewMultiRequest(
 	topK int,
 	textQuery, codeQuery string,
 	keywords []string,
 	filters Filters,
+	namespace string,
+	opts ...MultiRequestOption,
 ) MultiRequest {
 	var kw []string
 	if keywords != nil {
 		kw = make([]string, len(keywords))
 		copy(kw, keywords)
 	}
-	return MultiRequest{
-		topK:      topK,
-		textQuery: textQuery,
-		codeQuery: codeQuery,
-		keywords:  kw,
-		filters:   filters,
+	m := MultiRequest{
+		topK:           topK,
+		textQuery:      textQuery,
+		codeQuery:      codeQuery,
+		keywords:       kw,
+		filters:        filters,
+		namespace:      namespace,
+		semanticWeight: -1,
 	}
+	for _, opt := range opts {
+		opt(&m)
+	}
+	return m
 }
 
 // TopK returns the number of results to return.
@@ -92,3 +131,20 @@ func (m MultiRequest) Keywords() []string {
 
 // Filters returns the search filters.
 func (m MultiRequest) Filters() Filters { return m.filters }
+
+// Namespace returns the synonym dictionary to expand the query and
+// keywords against, or "" if expansion is disabled.
+func (m MultiRequest) Namespace() string { return m.namespace }
+
+// SemanticWeight returns the manually-configured semantic share of fusion
+// weight and true, or (0, false) if WithSemanticWeight was not used.
+func (m MultiRequest) SemanticWeight() (weight float64, ok bool) {
+	if m.semanticWeight < 0 {
+		return 0, false
+	}
+	return m.semanticWeight, true
+}
+
+// AutoWeight reports whether WithAutoWeight was used, meaning fusion
+// weights should be inferred from the query's shape rather than fixed.
+func (m MultiRequest) AutoWeight() bool { return m.autoWeight }
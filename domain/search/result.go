@@ -74,10 +74,13 @@ func (f FusionResult) OriginalScores() []float64 {
 // Document is a unit of search content. text is read by BM25 stores;
 // vector is read by embedding stores. A given Document carries one or
 // the other; the unused field is ignored by the implementation that
-// does not need it.
+// does not need it. language is only meaningful for BM25 documents; BM25
+// stores that support it use it to keep per-language ranking statistics
+// from skewing across language families.
 type Document struct {
 	snippetID string
 	text      string
+	language  string
 	vector    []float64
 }
 
@@ -89,6 +92,15 @@ func NewDocument(snippetID, text string) Document {
 	}
 }
 
+// NewDocumentWithLanguage creates a Document carrying text tagged with its
+// source language (e.g. "python", "go"), used by BM25 stores that
+// normalize scores per language family. language may be "" when unknown.
+func NewDocumentWithLanguage(snippetID, text, language string) Document {
+	doc := NewDocument(snippetID, text)
+	doc.language = language
+	return doc
+}
+
 // NewVectorDocument creates a Document carrying a precomputed vector —
 // used for embedding stores.
 func NewVectorDocument(snippetID string, vector []float64) Document {
@@ -106,6 +118,9 @@ func (d Document) SnippetID() string { return d.snippetID }
 // Text returns the document text (empty for vector documents).
 func (d Document) Text() string { return d.text }
 
+// Language returns the document's source language, or "" if unknown.
+func (d Document) Language() string { return d.language }
+
 // Vector returns a defensive copy of the embedding vector
 // (nil for text documents).
 func (d Document) Vector() []float64 {
@@ -22,3 +22,37 @@ type Store interface {
 	Exists(ctx context.Context, opts ...repository.Option) (bool, error)
 	DeleteBy(ctx context.Context, opts ...repository.Option) error
 }
+
+// VacuumStats reports the outcome of a single Vacuumer call.
+type VacuumStats struct {
+	RowsRemaining  int64
+	ReclaimedBytes int64
+}
+
+// SnippetLister is an optional Store capability for backends that can list
+// every snippet ID they currently hold. Maintenance tasks use it to find
+// rows that no longer correspond to a live snippet. Not every Store
+// implements this — callers should type-assert and skip stores that don't.
+type SnippetLister interface {
+	SnippetIDs(ctx context.Context) ([]string, error)
+}
+
+// Vacuumer is an optional Store capability for backends that can reclaim
+// space and re-tune their index after rows have been removed, e.g. Postgres
+// VACUUM, SQLite's FTS5 'optimize' command, or vchordrq re-clustering. Not
+// every Store implements this — callers should type-assert and skip stores
+// that don't.
+type Vacuumer interface {
+	Vacuum(ctx context.Context) (VacuumStats, error)
+}
+
+// VectorTruncator is an optional Store capability for backends that store
+// vectors at a flexible width and can therefore truncate already-stored
+// vectors to a reduced dimension in place, without paying to re-embed the
+// corpus through the provider again. Backends that store vectors in a
+// fixed-width column (e.g. pgvector's VECTOR(n) type) can't shrink a column
+// in place and don't implement this — callers should type-assert and skip
+// stores that don't.
+type VectorTruncator interface {
+	TruncateVectors(ctx context.Context, dimensions int) (int, error)
+}
@@ -0,0 +1,70 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"math"
+)
+
+// TruncatedEmbedder wraps an Embedder and truncates each vector it returns
+// to a fixed number of leading dimensions, re-normalizing the result to
+// unit length. This supports Matryoshka Representation Learning (MRL)
+// models, whose leading dimensions remain meaningful in isolation — storing
+// and comparing only the first N dimensions shrinks the index and speeds up
+// ANN search at some cost to recall.
+type TruncatedEmbedder struct {
+	embedder   Embedder
+	dimensions int
+}
+
+// NewTruncatedEmbedder creates a TruncatedEmbedder that keeps the first
+// dimensions entries of each vector produced by embedder. dimensions must
+// be positive.
+func NewTruncatedEmbedder(embedder Embedder, dimensions int) (*TruncatedEmbedder, error) {
+	if dimensions <= 0 {
+		return nil, fmt.Errorf("NewTruncatedEmbedder: dimensions must be positive, got %d", dimensions)
+	}
+	return &TruncatedEmbedder{embedder: embedder, dimensions: dimensions}, nil
+}
+
+// Embed delegates to the wrapped embedder, then truncates and re-normalizes
+// each resulting vector. Vectors shorter than the configured dimension are
+// returned unchanged.
+func (e *TruncatedEmbedder) Embed(ctx context.Context, items []EmbeddingItem) ([][]float64, error) {
+	vectors, err := e.embedder.Embed(ctx, items)
+	if err != nil {
+		return nil, err
+	}
+
+	truncated := make([][]float64, len(vectors))
+	for i, v := range vectors {
+		truncated[i] = Truncate(v, e.dimensions)
+	}
+	return truncated, nil
+}
+
+// Truncate returns the first n entries of v, re-normalized to unit length.
+// If v has n or fewer entries, it is returned unchanged. Exported so
+// Store implementations can apply the same truncation in place to
+// already-stored vectors, without going through this embedder.
+func Truncate(v []float64, n int) []float64 {
+	if len(v) <= n {
+		return v
+	}
+
+	cut := make([]float64, n)
+	copy(cut, v[:n])
+
+	var sumSquares float64
+	for _, x := range cut {
+		sumSquares += x * x
+	}
+	norm := math.Sqrt(sumSquares)
+	if norm == 0 {
+		return cut
+	}
+	for i := range cut {
+		cut[i] /= norm
+	}
+	return cut
+}
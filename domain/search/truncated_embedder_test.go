@@ -0,0 +1,49 @@
+package search
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeEmbedder struct {
+	vectors [][]float64
+}
+
+func (f *fakeEmbedder) Embed(_ context.Context, items []EmbeddingItem) ([][]float64, error) {
+	return f.vectors[:len(items)], nil
+}
+
+func TestNewTruncatedEmbedder_Invalid(t *testing.T) {
+	_, err := NewTruncatedEmbedder(&fakeEmbedder{}, 0)
+	require.Error(t, err)
+}
+
+func TestTruncatedEmbedder_Embed(t *testing.T) {
+	embedder := &fakeEmbedder{vectors: [][]float64{{3, 4, 0, 0}}}
+	truncated, err := NewTruncatedEmbedder(embedder, 2)
+	require.NoError(t, err)
+
+	vectors, err := truncated.Embed(context.Background(), []EmbeddingItem{NewTextItem("hello")})
+	require.NoError(t, err)
+	require.Len(t, vectors, 1)
+	require.Len(t, vectors[0], 2)
+
+	var sumSquares float64
+	for _, x := range vectors[0] {
+		sumSquares += x * x
+	}
+	require.InDelta(t, 1.0, math.Sqrt(sumSquares), 1e-9)
+}
+
+func TestTruncatedEmbedder_Embed_ShorterThanTarget(t *testing.T) {
+	embedder := &fakeEmbedder{vectors: [][]float64{{1, 2}}}
+	truncated, err := NewTruncatedEmbedder(embedder, 4)
+	require.NoError(t, err)
+
+	vectors, err := truncated.Embed(context.Background(), []EmbeddingItem{NewTextItem("hello")})
+	require.NoError(t, err)
+	require.Equal(t, []float64{1, 2}, vectors[0])
+}
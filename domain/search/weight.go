@@ -0,0 +1,43 @@
+package search
+
+import (
+	"regexp"
+	"strings"
+)
+
+// identifierLike matches queries that look like code identifiers or paths
+// rather than prose: camelCase/PascalCase humps, snake_case or dotted
+// segments, and path separators.
+var identifierLike = regexp.MustCompile(`[a-z0-9][A-Z]|[A-Za-z0-9]_[A-Za-z0-9]|[A-Za-z0-9]\.[A-Za-z0-9]|/`)
+
+// InferSemanticWeight inspects the shape of a query and returns the
+// semantic (vector) search's share of fusion weight, in [0,1]; keyword
+// (BM25) lists share the remainder equally. It has no notion of what the
+// query means — only how it's written:
+//
+//   - quoted substrings and identifier-like tokens (camelCase, snake_case,
+//     dotted or slash-separated paths) suggest the user wants an exact or
+//     near-exact match, so keyword search should dominate
+//   - longer, multi-word natural-language queries suggest the user is
+//     describing intent, so semantic search should dominate
+//   - anything else defaults to a balanced split
+func InferSemanticWeight(query string) float64 {
+	q := strings.TrimSpace(query)
+	if q == "" {
+		return 0.5
+	}
+
+	if strings.ContainsAny(q, `"'`) {
+		return 0.2
+	}
+
+	if identifierLike.MatchString(q) {
+		return 0.25
+	}
+
+	if words := strings.Fields(q); len(words) >= 4 {
+		return 0.75
+	}
+
+	return 0.5
+}
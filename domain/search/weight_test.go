@@ -0,0 +1,29 @@
+package search
+
+import "testing"
+
+func TestInferSemanticWeight(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  float64
+	}{
+		{"empty query defaults to balanced", "", 0.5},
+		{"quoted phrase leans keyword", `"exact phrase"`, 0.2},
+		{"camel case identifier leans keyword", "parseQueryString", 0.25},
+		{"snake case identifier leans keyword", "parse_query_string", 0.25},
+		{"file path leans keyword", "internal/config/config.go", 0.25},
+		{"dotted identifier leans keyword", "config.Load", 0.25},
+		{"short query defaults to balanced", "auth flow", 0.5},
+		{"natural language question leans semantic", "how do I configure the login flow", 0.75},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := InferSemanticWeight(tt.query)
+			if got != tt.want {
+				t.Errorf("InferSemanticWeight(%q) = %f, want %f", tt.query, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package service
+
+import "github.com/helixml/kodit/domain/enrichment"
+
+// ComplexityAnalyzer computes readability and complexity signals for a code
+// snippet, such as those produced during chunking.
+type ComplexityAnalyzer interface {
+	// Analyze returns the SnippetMetrics for content, or a zero SnippetMetrics
+	// if content has no measurable structure (e.g. it is empty).
+	Analyze(content string) enrichment.SnippetMetrics
+}
@@ -137,36 +137,30 @@ func (s *EmbeddingService) Index(ctx context.Context, docs []search.Document, op
 			start := offsets[idx]
 			end := start + len(batch)
 
-			items := make([]search.EmbeddingItem, len(batch))
-			for j, doc := range batch {
-				items[j] = search.NewTextItem(s.budget.Truncate(doc.Text()))
-			}
+			succeeded, vectors, failures := s.embedWithIsolation(ctx, batch)
 
-			vectors, err := s.embedder.Embed(ctx, items)
-			if err != nil {
-				batchErr := fmt.Errorf("embed batch [%d:%d]: %w", start, end, err)
-				mu.Lock()
-				batchErrors = append(batchErrors, batchErr)
-				mu.Unlock()
-				if cfg.BatchError() != nil {
-					cfg.BatchError()(start, end, err)
+			for _, failure := range failures {
+				if cfg.ItemFailure() != nil {
+					cfg.ItemFailure()(failure.doc, failure.err)
 				}
-				return
 			}
 
-			if len(vectors) != len(batch) {
-				batchErr := fmt.Errorf("embed batch [%d:%d]: count mismatch: got %d, expected %d", start, end, len(vectors), len(batch))
+			if len(succeeded) == 0 {
+				batchErr := fmt.Errorf("embed batch [%d:%d]: %w", start, end, errors.Join(itemFailureErrors(failures)...))
 				mu.Lock()
 				batchErrors = append(batchErrors, batchErr)
 				mu.Unlock()
 				if cfg.BatchError() != nil {
-					cfg.BatchError()(start, end, fmt.Errorf("count mismatch: got %d, expected %d", len(vectors), len(batch)))
+					cfg.BatchError()(start, end, batchErr)
+				}
+				if cfg.BatchResult() != nil {
+					cfg.BatchResult()(batch, batchErr)
 				}
 				return
 			}
 
-			vectorDocs := make([]search.Document, len(batch))
-			for j, doc := range batch {
+			vectorDocs := make([]search.Document, len(succeeded))
+			for j, doc := range succeeded {
 				vectorDocs[j] = search.NewVectorDocument(doc.SnippetID(), vectors[j])
 			}
 
@@ -178,8 +172,15 @@ func (s *EmbeddingService) Index(ctx context.Context, docs []search.Document, op
 				if cfg.BatchError() != nil {
 					cfg.BatchError()(start, end, err)
 				}
+				if cfg.BatchResult() != nil {
+					cfg.BatchResult()(succeeded, batchErr)
+				}
 				return
 			}
+
+			if cfg.BatchResult() != nil {
+				cfg.BatchResult()(succeeded, nil)
+			}
 		}(i, batch)
 	}
 
@@ -226,3 +227,72 @@ func (s *EmbeddingService) Find(ctx context.Context, query string, options ...re
 func (s *EmbeddingService) Exists(ctx context.Context, options ...repository.Option) (bool, error) {
 	return s.store.Exists(ctx, options...)
 }
+
+// itemEmbedFailure records why a single document could not be embedded,
+// once isolated from the rest of its batch.
+type itemEmbedFailure struct {
+	doc search.Document
+	err error
+}
+
+// embedWithIsolation embeds a batch as a whole, then falls back to
+// retrying each document individually if the batch fails, so a single
+// item a provider rejects (token limit, content filter) doesn't sink its
+// batch-mates. Returns the documents that embedded successfully alongside
+// their vectors (in matching order), plus a per-document failure for
+// anything that still failed once retried on its own.
+func (s *EmbeddingService) embedWithIsolation(ctx context.Context, batch []search.Document) ([]search.Document, [][]float64, []itemEmbedFailure) {
+	items := make([]search.EmbeddingItem, len(batch))
+	for j, doc := range batch {
+		items[j] = search.NewTextItem(s.budget.Truncate(doc.Text()))
+	}
+
+	vectors, err := s.embedder.Embed(ctx, items)
+	if err == nil && len(vectors) == len(batch) {
+		return batch, vectors, nil
+	}
+
+	if len(batch) == 1 {
+		if err == nil {
+			err = fmt.Errorf("count mismatch: got %d, expected 1", len(vectors))
+		}
+		return nil, nil, []itemEmbedFailure{{doc: batch[0], err: err}}
+	}
+
+	var (
+		succeeded        []search.Document
+		succeededVectors [][]float64
+		failures         []itemEmbedFailure
+	)
+	for _, doc := range batch {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			failures = append(failures, itemEmbedFailure{doc: doc, err: ctxErr})
+			continue
+		}
+
+		itemVectors, itemErr := s.embedder.Embed(ctx, []search.EmbeddingItem{search.NewTextItem(s.budget.Truncate(doc.Text()))})
+		if itemErr != nil {
+			failures = append(failures, itemEmbedFailure{doc: doc, err: itemErr})
+			continue
+		}
+		if len(itemVectors) != 1 {
+			failures = append(failures, itemEmbedFailure{doc: doc, err: fmt.Errorf("count mismatch: got %d, expected 1", len(itemVectors))})
+			continue
+		}
+
+		succeeded = append(succeeded, doc)
+		succeededVectors = append(succeededVectors, itemVectors[0])
+	}
+
+	return succeeded, succeededVectors, failures
+}
+
+// itemFailureErrors extracts the underlying errors from a set of item
+// failures, for joining into a single batch-level error.
+func itemFailureErrors(failures []itemEmbedFailure) []error {
+	errs := make([]error, len(failures))
+	for i, f := range failures {
+		errs[i] = f.err
+	}
+	return errs
+}
@@ -15,9 +15,9 @@ import (
 // --- fakes ---
 
 type fakeEmbedder struct {
-	mu    sync.Mutex
-	calls [][]string // recorded as strings for test assertions
-	errAt int        // batch index at which to return an error; -1 = never
+	mu        sync.Mutex
+	calls     [][]string      // recorded as strings for test assertions
+	failTexts map[string]bool // texts that always fail to embed, simulating a provider permanently rejecting a specific item (token limit, content filter)
 }
 
 func (f *fakeEmbedder) Embed(_ context.Context, items []search.EmbeddingItem) ([][]float64, error) {
@@ -26,12 +26,15 @@ func (f *fakeEmbedder) Embed(_ context.Context, items []search.EmbeddingItem) ([
 		texts[i] = string(item.Text())
 	}
 	f.mu.Lock()
-	idx := len(f.calls)
 	f.calls = append(f.calls, texts)
 	f.mu.Unlock()
-	if f.errAt >= 0 && idx == f.errAt {
-		return nil, fmt.Errorf("embed error at batch %d", idx)
+
+	for _, text := range texts {
+		if f.failTexts[text] {
+			return nil, fmt.Errorf("embedding rejected: %s", text)
+		}
 	}
+
 	vectors := make([][]float64, len(items))
 	for i := range items {
 		vectors[i] = []float64{0.1, 0.2, 0.3}
@@ -101,10 +104,21 @@ func testBudget() search.TokenBudget {
 	return b.WithMaxBatchSize(1000000)
 }
 
+// docText returns a 10-char string unique to id, so tests can target a
+// specific document for embedding failure while keeping every document the
+// same length for char-budget-based batching.
+func docText(id string) string {
+	text := id + "-"
+	for len(text) < 10 {
+		text += "a"
+	}
+	return text[:10]
+}
+
 // --- tests ---
 
 func TestEmbeddingService_Index_EmptyRequest(t *testing.T) {
-	embedder := &fakeEmbedder{errAt: -1}
+	embedder := &fakeEmbedder{}
 	store := &fakeEmbeddingStore{existing: map[string]search.Document{}, saveErr: -1}
 	svc, err := NewEmbedding(store, embedder, testBudget(), 1)
 	require.NoError(t, err)
@@ -116,7 +130,7 @@ func TestEmbeddingService_Index_EmptyRequest(t *testing.T) {
 }
 
 func TestEmbeddingService_Index_SingleBatch(t *testing.T) {
-	embedder := &fakeEmbedder{errAt: -1}
+	embedder := &fakeEmbedder{}
 	store := &fakeEmbeddingStore{existing: map[string]search.Document{}, saveErr: -1}
 	svc, err := NewEmbedding(store, embedder, testBudget(), 1)
 	require.NoError(t, err)
@@ -135,7 +149,7 @@ func TestEmbeddingService_Index_SingleBatch(t *testing.T) {
 }
 
 func TestEmbeddingService_Index_MultipleBatches(t *testing.T) {
-	embedder := &fakeEmbedder{errAt: -1}
+	embedder := &fakeEmbedder{}
 	store := &fakeEmbeddingStore{existing: map[string]search.Document{}, saveErr: -1}
 
 	// 30-char budget. Each doc "aaaaaaaaaa" is 10 chars, so 3 fit per batch.
@@ -166,7 +180,7 @@ func TestEmbeddingService_Index_MultipleBatches(t *testing.T) {
 }
 
 func TestEmbeddingService_Index_ProgressCallback(t *testing.T) {
-	embedder := &fakeEmbedder{errAt: -1}
+	embedder := &fakeEmbedder{}
 	store := &fakeEmbeddingStore{existing: map[string]search.Document{}, saveErr: -1}
 
 	// 30-char budget. Each doc "aaaaaaaaaa" is 10 chars, so 3 fit per batch.
@@ -203,7 +217,7 @@ func TestEmbeddingService_Index_ProgressCallback(t *testing.T) {
 }
 
 func TestEmbeddingService_Index_Deduplication(t *testing.T) {
-	embedder := &fakeEmbedder{errAt: -1}
+	embedder := &fakeEmbedder{}
 	store := &fakeEmbeddingStore{
 		existing: map[string]search.Document{
 			"id-0": search.NewVectorDocument("id-0", []float64{1, 2, 3}),
@@ -229,7 +243,7 @@ func TestEmbeddingService_Index_Deduplication(t *testing.T) {
 }
 
 func TestEmbeddingService_Index_DeduplicatesBeyondMaxSnippetIDsPerFind(t *testing.T) {
-	embedder := &fakeEmbedder{errAt: -1}
+	embedder := &fakeEmbedder{}
 
 	total := search.MaxSnippetIDsPerFind + 50
 	existing := make(map[string]search.Document, total)
@@ -253,23 +267,70 @@ func TestEmbeddingService_Index_DeduplicatesBeyondMaxSnippetIDsPerFind(t *testin
 		"all %d documents already exist, save must not be called", total)
 }
 
-func TestEmbeddingService_Index_EmbedErrorMidBatch(t *testing.T) {
-	embedder := &fakeEmbedder{errAt: 1}
-	store := &fakeEmbeddingStore{existing: map[string]search.Document{}, saveErr: -1}
-
-	// 30-char budget, 10-char docs → 3 batches of 3/3/1.
+func TestEmbeddingService_Index_IsolatesFailingItemFromBatch(t *testing.T) {
+	// 30-char budget, 10-char docs → 3 batches of 3/3/1 (id-0..2, id-3..5, id-6).
 	budget, err := search.NewTokenBudget(30)
 	require.NoError(t, err)
 	budget = budget.WithMaxBatchSize(100)
 
+	documents := make([]search.Document, 7)
+	for i := range documents {
+		id := fmt.Sprintf("id-%d", i)
+		documents[i] = search.NewDocument(id, docText(id))
+	}
+
+	// Only one document in the middle batch is rejected by the provider.
+	embedder := &fakeEmbedder{failTexts: map[string]bool{docText("id-4"): true}}
+	store := &fakeEmbeddingStore{existing: map[string]search.Document{}, saveErr: -1}
+
 	svc, err := NewEmbedding(store, embedder, budget, 1)
 	require.NoError(t, err)
 
+	var failed []string
+	err = svc.Index(context.Background(), documents,
+		search.WithItemFailure(func(doc search.Document, err error) {
+			failed = append(failed, doc.SnippetID())
+			require.Contains(t, err.Error(), "id-4")
+		}),
+	)
+	require.NoError(t, err, "healthy items in the batch still succeed, so the batch is not counted as failed")
+
+	require.Equal(t, []string{"id-4"}, failed, "only the rejected item is reported")
+
+	// The middle batch: 1 call for the whole batch (fails), then 3 calls
+	// retrying each item individually.
+	require.Len(t, embedder.calls, 1+1+3+1, "batch 0, failed batch 1 call, 3 item retries for batch 1, batch 2")
+
+	total := 0
+	for _, batch := range store.saved {
+		total += len(batch)
+	}
+	require.Equal(t, 6, total, "all documents saved except the permanently rejected one")
+}
+
+func TestEmbeddingService_Index_EmbedErrorMidBatch(t *testing.T) {
+	// 30-char budget, 10-char docs → 3 batches of 3/3/1.
+	budget, err := search.NewTokenBudget(30)
+	require.NoError(t, err)
+	budget = budget.WithMaxBatchSize(100)
+
 	documents := make([]search.Document, 7)
 	for i := range documents {
-		documents[i] = search.NewDocument(fmt.Sprintf("id-%d", i), strings.Repeat("a", 10))
+		id := fmt.Sprintf("id-%d", i)
+		documents[i] = search.NewDocument(id, docText(id))
 	}
 
+	// The whole middle batch (id-3, id-4, id-5) is permanently rejected.
+	embedder := &fakeEmbedder{failTexts: map[string]bool{
+		docText("id-3"): true,
+		docText("id-4"): true,
+		docText("id-5"): true,
+	}}
+	store := &fakeEmbeddingStore{existing: map[string]search.Document{}, saveErr: -1}
+
+	svc, err := NewEmbedding(store, embedder, budget, 1)
+	require.NoError(t, err)
+
 	err = svc.Index(context.Background(), documents,
 		search.WithMaxFailureRate(0),
 	)
@@ -277,13 +338,12 @@ func TestEmbeddingService_Index_EmbedErrorMidBatch(t *testing.T) {
 	require.Contains(t, err.Error(), "embed batch")
 	require.Contains(t, err.Error(), "1 of 3 embedding batches failed")
 
-	// All 3 batches attempted; batch 0 and 2 saved, batch 1 failed embed.
-	require.Len(t, embedder.calls, 3, "all batches attempted despite mid-batch error")
+	// batch 0 and batch 2 saved, batch 1 failed entirely after isolation.
 	require.Len(t, store.saved, 2, "2 successful saves (batch 0 and 2)")
 }
 
 func TestEmbeddingService_Index_SaveErrorMidBatch(t *testing.T) {
-	embedder := &fakeEmbedder{errAt: -1}
+	embedder := &fakeEmbedder{}
 	store := &fakeEmbeddingStore{existing: map[string]search.Document{}, saveErr: 1}
 
 	// 30-char budget, 10-char docs → 3 batches of 3/3/1.
@@ -312,44 +372,48 @@ func TestEmbeddingService_Index_SaveErrorMidBatch(t *testing.T) {
 }
 
 func TestEmbeddingService_Index_BatchErrorCallback(t *testing.T) {
-	embedder := &fakeEmbedder{errAt: 1}
-	store := &fakeEmbeddingStore{existing: map[string]search.Document{}, saveErr: -1}
-
 	// 30-char budget, 10-char docs → 3 batches of 3/3/1.
 	budget, err := search.NewTokenBudget(30)
 	require.NoError(t, err)
 	budget = budget.WithMaxBatchSize(100)
 
-	svc, err := NewEmbedding(store, embedder, budget, 1)
-	require.NoError(t, err)
-
 	documents := make([]search.Document, 7)
 	for i := range documents {
-		documents[i] = search.NewDocument(fmt.Sprintf("id-%d", i), strings.Repeat("a", 10))
+		id := fmt.Sprintf("id-%d", i)
+		documents[i] = search.NewDocument(id, docText(id))
 	}
 
+	// The whole middle batch is permanently rejected.
+	embedder := &fakeEmbedder{failTexts: map[string]bool{
+		docText("id-3"): true,
+		docText("id-4"): true,
+		docText("id-5"): true,
+	}}
+	store := &fakeEmbeddingStore{existing: map[string]search.Document{}, saveErr: -1}
+
+	svc, err := NewEmbedding(store, embedder, budget, 1)
+	require.NoError(t, err)
+
 	type batchErrCall struct {
 		start int
 		end   int
-		err   string
 	}
 	var errCalls []batchErrCall
 
 	err = svc.Index(context.Background(), documents,
 		search.WithBatchError(func(batchStart, batchEnd int, err error) {
-			errCalls = append(errCalls, batchErrCall{batchStart, batchEnd, err.Error()})
+			errCalls = append(errCalls, batchErrCall{batchStart, batchEnd})
 		}),
 	)
 	require.Error(t, err)
 
-	require.Len(t, errCalls, 1, "batch error callback called once for the failed batch")
+	require.Len(t, errCalls, 1, "batch error callback called once for the fully failed batch")
 	require.Equal(t, 3, errCalls[0].start)
 	require.Equal(t, 6, errCalls[0].end)
-	require.Contains(t, errCalls[0].err, "embed error at batch 1")
 }
 
 func TestEmbeddingService_Index_InvalidDocumentsFiltered(t *testing.T) {
-	embedder := &fakeEmbedder{errAt: -1}
+	embedder := &fakeEmbedder{}
 	store := &fakeEmbeddingStore{existing: map[string]search.Document{}, saveErr: -1}
 	svc, err := NewEmbedding(store, embedder, testBudget(), 1)
 	require.NoError(t, err)
@@ -368,7 +432,7 @@ func TestEmbeddingService_Index_InvalidDocumentsFiltered(t *testing.T) {
 }
 
 func TestEmbeddingService_Index_TruncatesLargeTexts(t *testing.T) {
-	embedder := &fakeEmbedder{errAt: -1}
+	embedder := &fakeEmbedder{}
 	store := &fakeEmbeddingStore{existing: map[string]search.Document{}, saveErr: -1}
 
 	budget, err := search.NewTokenBudget(20)
@@ -393,7 +457,7 @@ func TestEmbeddingService_Index_TruncatesLargeTexts(t *testing.T) {
 }
 
 func TestEmbeddingService_Index_SplitsByCharBudget(t *testing.T) {
-	embedder := &fakeEmbedder{errAt: -1}
+	embedder := &fakeEmbedder{}
 	store := &fakeEmbeddingStore{existing: map[string]search.Document{}, saveErr: -1}
 
 	// 30 chars budget. Each doc is 10 chars, so 3 fit per batch.
@@ -420,7 +484,7 @@ func TestEmbeddingService_Index_SplitsByCharBudget(t *testing.T) {
 }
 
 func TestEmbeddingService_Index_LargeDocGetsOwnBatch(t *testing.T) {
-	embedder := &fakeEmbedder{errAt: -1}
+	embedder := &fakeEmbedder{}
 	store := &fakeEmbeddingStore{existing: map[string]search.Document{}, saveErr: -1}
 
 	// 20 chars budget. Large doc exceeds it, gets its own batch.
@@ -446,50 +510,60 @@ func TestEmbeddingService_Index_LargeDocGetsOwnBatch(t *testing.T) {
 }
 
 func TestEmbeddingService_Index_ToleratesPartialFailure(t *testing.T) {
-	embedder := &fakeEmbedder{errAt: 1}
-	store := &fakeEmbeddingStore{existing: map[string]search.Document{}, saveErr: -1}
-
 	// 30-char budget, 10-char docs → 3 batches of 3/3/1.
 	budget, err := search.NewTokenBudget(30)
 	require.NoError(t, err)
 	budget = budget.WithMaxBatchSize(100)
 
-	svc, err := NewEmbedding(store, embedder, budget, 1)
-	require.NoError(t, err)
-
 	documents := make([]search.Document, 7)
 	for i := range documents {
-		documents[i] = search.NewDocument(fmt.Sprintf("id-%d", i), strings.Repeat("a", 10))
+		id := fmt.Sprintf("id-%d", i)
+		documents[i] = search.NewDocument(id, docText(id))
 	}
 
+	// The whole middle batch is permanently rejected.
+	embedder := &fakeEmbedder{failTexts: map[string]bool{
+		docText("id-3"): true,
+		docText("id-4"): true,
+		docText("id-5"): true,
+	}}
+	store := &fakeEmbeddingStore{existing: map[string]search.Document{}, saveErr: -1}
+
+	svc, err := NewEmbedding(store, embedder, budget, 1)
+	require.NoError(t, err)
+
 	// 1 of 3 batches fails (~33%), tolerance is 50% → no error.
 	err = svc.Index(context.Background(), documents,
 		search.WithMaxFailureRate(0.5),
 	)
 	require.NoError(t, err)
 
-	require.Len(t, embedder.calls, 3, "all batches attempted")
 	require.Len(t, store.saved, 2, "2 successful saves")
 }
 
 func TestEmbeddingService_Index_ExceedsFailureTolerance(t *testing.T) {
-	// Fail at batch 0 and batch 1 (2 of 3 batches).
-	embedder := &fakeEmbedder{errAt: 0}
-	store := &fakeEmbeddingStore{existing: map[string]search.Document{}, saveErr: -1}
-
 	// 30-char budget, 10-char docs → 3 batches of 3/3/1.
 	budget, err := search.NewTokenBudget(30)
 	require.NoError(t, err)
 	budget = budget.WithMaxBatchSize(100)
 
-	svc, err := NewEmbedding(store, embedder, budget, 1)
-	require.NoError(t, err)
-
 	documents := make([]search.Document, 7)
 	for i := range documents {
-		documents[i] = search.NewDocument(fmt.Sprintf("id-%d", i), strings.Repeat("a", 10))
+		id := fmt.Sprintf("id-%d", i)
+		documents[i] = search.NewDocument(id, docText(id))
 	}
 
+	// The whole first batch is permanently rejected.
+	embedder := &fakeEmbedder{failTexts: map[string]bool{
+		docText("id-0"): true,
+		docText("id-1"): true,
+		docText("id-2"): true,
+	}}
+	store := &fakeEmbeddingStore{existing: map[string]search.Document{}, saveErr: -1}
+
+	svc, err := NewEmbedding(store, embedder, budget, 1)
+	require.NoError(t, err)
+
 	// 1 of 3 batches fails (~33%), tolerance is 10% → error.
 	err = svc.Index(context.Background(), documents,
 		search.WithMaxFailureRate(0.1),
@@ -499,7 +573,7 @@ func TestEmbeddingService_Index_ExceedsFailureTolerance(t *testing.T) {
 }
 
 func TestEmbeddingService_Index_ParallelBatches(t *testing.T) {
-	embedder := &fakeEmbedder{errAt: -1}
+	embedder := &fakeEmbedder{}
 	store := &fakeEmbeddingStore{existing: map[string]search.Document{}, saveErr: -1}
 
 	// 30-char budget, 10-char docs → 3 batches of 3/3/1.
@@ -531,24 +605,29 @@ func TestEmbeddingService_Index_ParallelBatches(t *testing.T) {
 }
 
 func TestEmbeddingService_Index_ProgressReachesTotalOnPartialFailure(t *testing.T) {
-	// Fail batch 1 of 3.
-	embedder := &fakeEmbedder{errAt: 1}
-	store := &fakeEmbeddingStore{existing: map[string]search.Document{}, saveErr: -1}
-
 	// 30-char budget, 10-char docs → 3 batches of 3/3/1.
 	budget, err := search.NewTokenBudget(30)
 	require.NoError(t, err)
 	budget = budget.WithMaxBatchSize(100)
 
-	// Parallelism 1 for deterministic ordering.
-	svc, err := NewEmbedding(store, embedder, budget, 1)
-	require.NoError(t, err)
-
 	documents := make([]search.Document, 7)
 	for i := range documents {
-		documents[i] = search.NewDocument(fmt.Sprintf("id-%d", i), strings.Repeat("a", 10))
+		id := fmt.Sprintf("id-%d", i)
+		documents[i] = search.NewDocument(id, docText(id))
 	}
 
+	// The whole middle batch is permanently rejected.
+	embedder := &fakeEmbedder{failTexts: map[string]bool{
+		docText("id-3"): true,
+		docText("id-4"): true,
+		docText("id-5"): true,
+	}}
+	store := &fakeEmbeddingStore{existing: map[string]search.Document{}, saveErr: -1}
+
+	// Parallelism 1 for deterministic ordering.
+	svc, err := NewEmbedding(store, embedder, budget, 1)
+	require.NoError(t, err)
+
 	type call struct {
 		completed int
 		total     int
@@ -18,6 +18,7 @@ type EnrichConfig struct {
 	requestError   RequestError
 	maxFailureRate float64
 	rateSet        bool
+	outputLanguage string
 }
 
 // NewEnrichConfig applies all options and returns the resolved config.
@@ -42,6 +43,10 @@ func (c EnrichConfig) RequestError() RequestError { return c.requestError }
 // before the Enrich call returns an error. Default is 0.05 (5%).
 func (c EnrichConfig) MaxFailureRate() float64 { return c.maxFailureRate }
 
+// OutputLanguage returns the human language responses should be written in,
+// or "" to let the model pick based on the input text.
+func (c EnrichConfig) OutputLanguage() string { return c.outputLanguage }
+
 // WithEnrichProgress registers a callback that is invoked after each
 // enrichment request completes successfully.
 func WithEnrichProgress(fn EnrichProgress) EnrichOption {
@@ -70,3 +75,10 @@ func WithMaxFailureRate(rate float64) EnrichOption {
 		c.rateSet = true
 	}
 }
+
+// WithOutputLanguage instructs the enricher to write its responses in the
+// given human language (e.g. "Spanish"), instead of leaving the choice to
+// the model.
+func WithOutputLanguage(language string) EnrichOption {
+	return func(c *EnrichConfig) { c.outputLanguage = language }
+}
@@ -0,0 +1,10 @@
+package service
+
+// LanguageDetector identifies the dominant human language of a body of text,
+// such as a repository's code comments and documentation.
+type LanguageDetector interface {
+	// Detect returns the name of the dominant human language in text (e.g.
+	// "English"), or "" if no supported language could be confidently
+	// identified.
+	Detect(text string) string
+}
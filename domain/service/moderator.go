@@ -0,0 +1,29 @@
+package service
+
+import "context"
+
+// ModerationResult reports whether a moderation filter flagged a piece of
+// text, and why.
+type ModerationResult struct {
+	flagged bool
+	reason  string
+}
+
+// NewModerationResult creates a moderation result. Pass flagged=false and
+// an empty reason for content that passed the filter.
+func NewModerationResult(flagged bool, reason string) ModerationResult {
+	return ModerationResult{flagged: flagged, reason: reason}
+}
+
+// Flagged reports whether the content was flagged.
+func (r ModerationResult) Flagged() bool { return r.flagged }
+
+// Reason returns why the content was flagged, or "" if it was not.
+func (r ModerationResult) Reason() string { return r.reason }
+
+// Moderator screens generated text for content that should be flagged for
+// review before it is persisted, such as profanity, secrets echoed back
+// from repository content, or prompt-injection artifacts.
+type Moderator interface {
+	Moderate(ctx context.Context, text string) (ModerationResult, error)
+}
@@ -0,0 +1,16 @@
+package snapshot
+
+import "context"
+
+// Archiver captures and restores the raw contents of the corpus index
+// tables. Implementations live in infrastructure/persistence, since which
+// tables count as "the index" is a persistence-layer concern.
+type Archiver interface {
+	// Dump serializes every index table to data, keyed by table name, and
+	// reports how many tables and rows it covered.
+	Dump(ctx context.Context) (data []byte, tables, rows int, err error)
+
+	// Restore replaces the contents of every index table with the tables
+	// and rows recorded in data, as previously returned by Dump.
+	Restore(ctx context.Context, data []byte) error
+}
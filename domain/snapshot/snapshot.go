@@ -0,0 +1,61 @@
+// Package snapshot provides domain types for capturing and restoring
+// point-in-time copies of the corpus index tables.
+package snapshot
+
+import "time"
+
+// Snapshot records metadata about a captured point-in-time copy of the
+// corpus index tables (repositories, commits, files, enrichments,
+// associations, embeddings, and source locations). Restoring a snapshot
+// rolls the index back to the state it recorded, without relying on a
+// full database backup handled externally.
+type Snapshot struct {
+	id        int64
+	label     string
+	tables    int
+	rows      int
+	data      []byte
+	createdAt time.Time
+}
+
+// New creates a Snapshot for data just captured (not yet persisted).
+func New(label string, tables, rows int, data []byte) Snapshot {
+	return Snapshot{
+		label:     label,
+		tables:    tables,
+		rows:      rows,
+		data:      data,
+		createdAt: time.Now(),
+	}
+}
+
+// Reconstruct recreates a Snapshot from persistence.
+func Reconstruct(id int64, label string, tables, rows int, data []byte, createdAt time.Time) Snapshot {
+	return Snapshot{
+		id:        id,
+		label:     label,
+		tables:    tables,
+		rows:      rows,
+		data:      data,
+		createdAt: createdAt,
+	}
+}
+
+// ID returns the database identifier.
+func (s Snapshot) ID() int64 { return s.id }
+
+// Label returns the operator-supplied name for this snapshot.
+func (s Snapshot) Label() string { return s.label }
+
+// Tables returns how many tables were captured.
+func (s Snapshot) Tables() int { return s.tables }
+
+// Rows returns how many rows were captured across all tables.
+func (s Snapshot) Rows() int { return s.rows }
+
+// Data returns the captured table contents, serialized as JSON keyed by
+// table name.
+func (s Snapshot) Data() []byte { return s.data }
+
+// CreatedAt returns when the snapshot was captured.
+func (s Snapshot) CreatedAt() time.Time { return s.createdAt }
@@ -0,0 +1,8 @@
+package snapshot
+
+import "github.com/helixml/kodit/domain/repository"
+
+// Store defines persistence for snapshots.
+type Store interface {
+	repository.Store[Snapshot]
+}
@@ -0,0 +1,90 @@
+package sourcelocation
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// anchorContextLines is how many lines of context on each side of a range are
+// folded into its anchor, so that edits inside the range itself (which is
+// exactly what re-indexing would pick up anyway) don't invalidate it.
+const anchorContextLines = 2
+
+// DefaultSearchWindow bounds how many lines away from the original position
+// Resolve will look for a matching anchor.
+const DefaultSearchWindow = 200
+
+// ComputeAnchor fingerprints the content surrounding a 1-based, inclusive
+// line range within lines. Returns "" if the range is invalid or lines is
+// empty.
+func ComputeAnchor(lines []string, startLine, endLine int) string {
+	from, to, ok := anchorWindow(lines, startLine, endLine)
+	if !ok {
+		return ""
+	}
+
+	sum := sha256.Sum256([]byte(strings.Join(lines[from:to], "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+// Resolve re-locates a previously anchored range within content that may
+// have shifted since the anchor was computed. It first checks the original
+// coordinates, then searches outward line-by-line up to searchWindow lines
+// in either direction for a window whose anchor matches.
+//
+// Returns the corrected 1-based start/end lines and true on a match. If
+// anchor is empty or no match is found, it returns the original range
+// unchanged and false — callers should fall back to trusting the stale
+// coordinates in that case.
+func Resolve(lines []string, anchor string, startLine, endLine, searchWindow int) (correctedStart, correctedEnd int, ok bool) {
+	if anchor == "" {
+		return startLine, endLine, false
+	}
+
+	rangeLen := endLine - startLine
+	if rangeLen < 0 {
+		return startLine, endLine, false
+	}
+
+	if ComputeAnchor(lines, startLine, endLine) == anchor {
+		return startLine, endLine, true
+	}
+
+	for offset := 1; offset <= searchWindow; offset++ {
+		for _, candidateStart := range [2]int{startLine + offset, startLine - offset} {
+			candidateEnd := candidateStart + rangeLen
+			if candidateStart < 1 || candidateEnd > len(lines) {
+				continue
+			}
+			if ComputeAnchor(lines, candidateStart, candidateEnd) == anchor {
+				return candidateStart, candidateEnd, true
+			}
+		}
+	}
+
+	return startLine, endLine, false
+}
+
+// anchorWindow returns the [from, to) slice bounds of lines to fingerprint
+// for the given range, expanded by anchorContextLines on each side and
+// clamped to the bounds of lines.
+func anchorWindow(lines []string, startLine, endLine int) (from, to int, ok bool) {
+	if startLine < 1 || endLine < startLine || len(lines) == 0 {
+		return 0, 0, false
+	}
+
+	from = startLine - 1 - anchorContextLines
+	if from < 0 {
+		from = 0
+	}
+	to = endLine + anchorContextLines
+	if to > len(lines) {
+		to = len(lines)
+	}
+	if from >= to {
+		return 0, 0, false
+	}
+
+	return from, to, true
+}
@@ -0,0 +1,80 @@
+package sourcelocation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testFileLines() []string {
+	lines := make([]string, 40)
+	for i := range lines {
+		lines[i] = "line content"
+	}
+	lines[19] = "func Target() {"
+	lines[20] = "\tdoWork()"
+	lines[21] = "}"
+	return lines
+}
+
+func TestComputeAnchor_Stable(t *testing.T) {
+	lines := testFileLines()
+
+	a1 := ComputeAnchor(lines, 20, 22)
+	a2 := ComputeAnchor(lines, 20, 22)
+
+	assert.NotEmpty(t, a1)
+	assert.Equal(t, a1, a2)
+}
+
+func TestComputeAnchor_InvalidRange(t *testing.T) {
+	lines := testFileLines()
+
+	assert.Empty(t, ComputeAnchor(lines, 0, 5))
+	assert.Empty(t, ComputeAnchor(lines, 10, 5))
+	assert.Empty(t, ComputeAnchor(nil, 1, 2))
+}
+
+func TestResolve_UnchangedLocation(t *testing.T) {
+	lines := testFileLines()
+	anchor := ComputeAnchor(lines, 20, 22)
+
+	start, end, ok := Resolve(lines, anchor, 20, 22, DefaultSearchWindow)
+
+	assert.True(t, ok)
+	assert.Equal(t, 20, start)
+	assert.Equal(t, 22, end)
+}
+
+func TestResolve_ShiftedLocation(t *testing.T) {
+	lines := testFileLines()
+	anchor := ComputeAnchor(lines, 20, 22)
+
+	shifted := append([]string{"// inserted", "// inserted", "// inserted"}, lines...)
+
+	start, end, ok := Resolve(shifted, anchor, 20, 22, DefaultSearchWindow)
+
+	assert.True(t, ok)
+	assert.Equal(t, 23, start)
+	assert.Equal(t, 25, end)
+}
+
+func TestResolve_NoMatch(t *testing.T) {
+	lines := testFileLines()
+
+	start, end, ok := Resolve(lines, "not-a-real-anchor", 20, 22, DefaultSearchWindow)
+
+	assert.False(t, ok)
+	assert.Equal(t, 20, start)
+	assert.Equal(t, 22, end)
+}
+
+func TestResolve_EmptyAnchor(t *testing.T) {
+	lines := testFileLines()
+
+	start, end, ok := Resolve(lines, "", 20, 22, DefaultSearchWindow)
+
+	assert.False(t, ok)
+	assert.Equal(t, 20, start)
+	assert.Equal(t, 22, end)
+}
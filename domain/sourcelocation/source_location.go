@@ -5,12 +5,18 @@ package sourcelocation
 // SourceLocation records the origin of an enrichment within its source file.
 // For text chunks this is a line range; for page images this is a page number.
 // Immutable value object.
+//
+// anchor is a content fingerprint of the lines immediately surrounding the
+// range at index time. Files change between indexing and read, so the line
+// numbers alone drift; anchor lets a later read re-locate the same content
+// at its current line numbers instead of trusting stale coordinates blindly.
 type SourceLocation struct {
 	id           int64
 	enrichmentID int64
 	page         int
 	startLine    int
 	endLine      int
+	anchor       string
 }
 
 // New creates a SourceLocation for a line range (not yet persisted).
@@ -41,16 +47,24 @@ func NewWithPage(enrichmentID int64, page, startLine, endLine int) SourceLocatio
 }
 
 // Reconstruct recreates a SourceLocation from persistence.
-func Reconstruct(id, enrichmentID int64, page, startLine, endLine int) SourceLocation {
+func Reconstruct(id, enrichmentID int64, page, startLine, endLine int, anchor string) SourceLocation {
 	return SourceLocation{
 		id:           id,
 		enrichmentID: enrichmentID,
 		page:         page,
 		startLine:    startLine,
 		endLine:      endLine,
+		anchor:       anchor,
 	}
 }
 
+// WithAnchor attaches a content anchor computed from the lines surrounding
+// the range at index time.
+func (s SourceLocation) WithAnchor(anchor string) SourceLocation {
+	s.anchor = anchor
+	return s
+}
+
 // ID returns the database identifier.
 func (s SourceLocation) ID() int64 { return s.id }
 
@@ -65,3 +79,7 @@ func (s SourceLocation) StartLine() int { return s.startLine }
 
 // EndLine returns the 1-based last line (0 means not applicable).
 func (s SourceLocation) EndLine() int { return s.endLine }
+
+// Anchor returns the content fingerprint of the lines surrounding the range
+// at index time, or "" if none was recorded.
+func (s SourceLocation) Anchor() string { return s.anchor }
@@ -27,21 +27,30 @@ func TestNewPage(t *testing.T) {
 }
 
 func TestReconstruct(t *testing.T) {
-	sl := Reconstruct(7, 42, 0, 10, 25)
+	sl := Reconstruct(7, 42, 0, 10, 25, "deadbeef")
 
 	assert.Equal(t, int64(7), sl.ID())
 	assert.Equal(t, int64(42), sl.EnrichmentID())
 	assert.Equal(t, 0, sl.Page())
 	assert.Equal(t, 10, sl.StartLine())
 	assert.Equal(t, 25, sl.EndLine())
+	assert.Equal(t, "deadbeef", sl.Anchor())
 }
 
 func TestReconstruct_WithPage(t *testing.T) {
-	sl := Reconstruct(7, 42, 3, 0, 0)
+	sl := Reconstruct(7, 42, 3, 0, 0, "")
 
 	assert.Equal(t, int64(7), sl.ID())
 	assert.Equal(t, int64(42), sl.EnrichmentID())
 	assert.Equal(t, 3, sl.Page())
 	assert.Equal(t, 0, sl.StartLine())
 	assert.Equal(t, 0, sl.EndLine())
+	assert.Equal(t, "", sl.Anchor())
+}
+
+func TestWithAnchor(t *testing.T) {
+	sl := New(42, 10, 25).WithAnchor("deadbeef")
+
+	assert.Equal(t, "deadbeef", sl.Anchor())
+	assert.Equal(t, 10, sl.StartLine())
 }
@@ -0,0 +1,13 @@
+package synonym
+
+import "github.com/helixml/kodit/domain/repository"
+
+// WithNamespace filters by the "namespace" column.
+func WithNamespace(namespace string) repository.Option {
+	return repository.WithCondition("namespace", namespace)
+}
+
+// WithTerm filters by the "term" column.
+func WithTerm(term string) repository.Option {
+	return repository.WithCondition("term", term)
+}
@@ -0,0 +1,8 @@
+package synonym
+
+import "github.com/helixml/kodit/domain/repository"
+
+// Store defines persistence for synonym dictionary entries.
+type Store interface {
+	repository.Store[Synonym]
+}
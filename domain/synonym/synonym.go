@@ -0,0 +1,73 @@
+// Package synonym holds a corpus-level dictionary of terms and their
+// aliases (e.g. "phoenix" = "billing service"), scoped by namespace, so
+// tribal terminology can be expanded at query time for both BM25 and
+// vector search.
+package synonym
+
+import "time"
+
+// Synonym maps a term to one or more aliases within a namespace. This is an
+// immutable value object.
+type Synonym struct {
+	id        int64
+	namespace string
+	term      string
+	aliases   []string
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// New creates a Synonym that has not yet been persisted. namespace groups
+// related dictionaries (e.g. one per organization or repository); term and
+// aliases are matched case-insensitively at expansion time.
+func New(namespace, term string, aliases []string) Synonym {
+	now := time.Now()
+	return Synonym{
+		namespace: namespace,
+		term:      term,
+		aliases:   append([]string(nil), aliases...),
+		createdAt: now,
+		updatedAt: now,
+	}
+}
+
+// Reconstruct recreates a Synonym from persistence.
+func Reconstruct(id int64, namespace, term string, aliases []string, createdAt, updatedAt time.Time) Synonym {
+	return Synonym{
+		id:        id,
+		namespace: namespace,
+		term:      term,
+		aliases:   append([]string(nil), aliases...),
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+// ID returns the database identifier.
+func (s Synonym) ID() int64 { return s.id }
+
+// Namespace returns the dictionary this synonym belongs to.
+func (s Synonym) Namespace() string { return s.namespace }
+
+// Term returns the canonical term being expanded.
+func (s Synonym) Term() string { return s.term }
+
+// Aliases returns the terms that should be expanded alongside Term.
+func (s Synonym) Aliases() []string {
+	result := make([]string, len(s.aliases))
+	copy(result, s.aliases)
+	return result
+}
+
+// CreatedAt returns when the synonym was first recorded.
+func (s Synonym) CreatedAt() time.Time { return s.createdAt }
+
+// UpdatedAt returns when the synonym was last modified.
+func (s Synonym) UpdatedAt() time.Time { return s.updatedAt }
+
+// WithAliases returns a copy of the synonym with its aliases replaced.
+func (s Synonym) WithAliases(aliases []string) Synonym {
+	s.aliases = append([]string(nil), aliases...)
+	s.updatedAt = time.Now()
+	return s
+}
@@ -0,0 +1,37 @@
+package synonym
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	s := New("acme", "phoenix", []string{"billing service"})
+
+	assert.Equal(t, int64(0), s.ID())
+	assert.Equal(t, "acme", s.Namespace())
+	assert.Equal(t, "phoenix", s.Term())
+	assert.Equal(t, []string{"billing service"}, s.Aliases())
+	assert.False(t, s.CreatedAt().IsZero())
+}
+
+func TestReconstruct(t *testing.T) {
+	s := Reconstruct(7, "acme", "dal", []string{"data access layer"}, time.Time{}, time.Time{})
+
+	assert.Equal(t, int64(7), s.ID())
+	assert.Equal(t, "acme", s.Namespace())
+	assert.Equal(t, "dal", s.Term())
+	assert.Equal(t, []string{"data access layer"}, s.Aliases())
+}
+
+func TestWithAliases(t *testing.T) {
+	s := New("acme", "phoenix", []string{"billing service"})
+
+	updated := s.WithAliases([]string{"billing", "payments"})
+
+	assert.Equal(t, []string{"billing service"}, s.Aliases())
+	assert.Equal(t, []string{"billing", "payments"}, updated.Aliases())
+	assert.True(t, updated.UpdatedAt().After(s.CreatedAt()) || updated.UpdatedAt().Equal(s.CreatedAt()))
+}
@@ -0,0 +1,62 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// PayloadDeadlineKey is the task payload field holding a Unix timestamp
+// (seconds) after which a handler should stop doing new work and let the
+// task be resumed on a later run. Handlers that support time-boxing read
+// this via DeadlineFromPayload.
+const PayloadDeadlineKey = "deadline_unix"
+
+// ErrDeadlinePaused is returned by a handler that stopped early because its
+// time-box deadline was reached, with some but not necessarily all of its
+// work done. The worker requeues the task instead of treating this as a
+// failure, so the handler resumes (and skips already-completed work) on
+// its next run.
+var ErrDeadlinePaused = errors.New("task paused: time-box deadline reached")
+
+// WithDeadline returns a copy of payload with the deadline recorded as a
+// Unix timestamp. The original payload is left unmodified.
+func WithDeadline(payload map[string]any, deadline time.Time) map[string]any {
+	copied := make(map[string]any, len(payload)+1)
+	for k, v := range payload {
+		copied[k] = v
+	}
+	copied[PayloadDeadlineKey] = deadline.Unix()
+	return copied
+}
+
+// DeadlineFromPayload extracts the deadline from a task payload, if present.
+func DeadlineFromPayload(payload map[string]any) (time.Time, bool) {
+	raw, ok := payload[PayloadDeadlineKey]
+	if !ok {
+		return time.Time{}, false
+	}
+
+	var unix int64
+	switch v := raw.(type) {
+	case int64:
+		unix = v
+	case int:
+		unix = int64(v)
+	case float64:
+		unix = int64(v)
+	default:
+		return time.Time{}, false
+	}
+	return time.Unix(unix, 0), true
+}
+
+// ContextWithDeadline wraps ctx with the deadline from payload, if one is
+// set. The returned cancel function must always be called by the caller.
+func ContextWithDeadline(ctx context.Context, payload map[string]any) (context.Context, context.CancelFunc) {
+	deadline, ok := DeadlineFromPayload(payload)
+	if !ok {
+		return context.WithCancel(ctx)
+	}
+	return context.WithDeadline(ctx, deadline)
+}
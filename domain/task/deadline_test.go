@@ -0,0 +1,52 @@
+package task
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestWithDeadline_DeadlineFromPayload(t *testing.T) {
+	deadline := time.Now().Add(time.Hour).Truncate(time.Second)
+	payload := WithDeadline(map[string]any{"repository_id": int64(1)}, deadline)
+
+	got, ok := DeadlineFromPayload(payload)
+	if !ok {
+		t.Fatal("expected deadline to be present")
+	}
+	if !got.Equal(deadline) {
+		t.Errorf("got %v, want %v", got, deadline)
+	}
+
+	if payload["repository_id"] != int64(1) {
+		t.Error("expected unrelated payload fields to be preserved")
+	}
+}
+
+func TestDeadlineFromPayload_Absent(t *testing.T) {
+	_, ok := DeadlineFromPayload(map[string]any{})
+	if ok {
+		t.Error("expected no deadline to be found")
+	}
+}
+
+func TestContextWithDeadline_ExceededWhenPast(t *testing.T) {
+	payload := WithDeadline(map[string]any{}, time.Now().Add(-time.Minute))
+
+	ctx, cancel := ContextWithDeadline(context.Background(), payload)
+	defer cancel()
+
+	<-ctx.Done()
+	if ctx.Err() != context.DeadlineExceeded {
+		t.Errorf("expected DeadlineExceeded, got %v", ctx.Err())
+	}
+}
+
+func TestContextWithDeadline_NoDeadlineSet(t *testing.T) {
+	ctx, cancel := ContextWithDeadline(context.Background(), map[string]any{})
+	defer cancel()
+
+	if ctx.Err() != nil {
+		t.Errorf("expected no error without a deadline, got %v", ctx.Err())
+	}
+}
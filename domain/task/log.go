@@ -0,0 +1,40 @@
+package task
+
+import "time"
+
+// LogLevel represents the severity of a captured task log entry.
+type LogLevel string
+
+// LogLevel values.
+const (
+	LogLevelInfo  LogLevel = "info"
+	LogLevelWarn  LogLevel = "warn"
+	LogLevelError LogLevel = "error"
+)
+
+// LogEntry is a single structured log record captured during a task's
+// execution, so a failure can be investigated from the API instead of by
+// grepping host logs.
+type LogEntry struct {
+	level     LogLevel
+	message   string
+	timestamp time.Time
+}
+
+// NewLogEntry creates a LogEntry timestamped with the current time.
+func NewLogEntry(level LogLevel, message string) LogEntry {
+	return LogEntry{
+		level:     level,
+		message:   message,
+		timestamp: time.Now().UTC(),
+	}
+}
+
+// Level returns the entry's severity.
+func (e LogEntry) Level() LogLevel { return e.level }
+
+// Message returns the entry's message.
+func (e LogEntry) Message() string { return e.message }
+
+// Timestamp returns when the entry was recorded.
+func (e LogEntry) Timestamp() time.Time { return e.timestamp }
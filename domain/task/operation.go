@@ -35,15 +35,26 @@ const (
 	OperationCreateCommitDescriptionForCommit        Operation = "kodit.commit.create_commit_description"
 	OperationCreateDatabaseSchemaForCommit           Operation = "kodit.commit.create_database_schema"
 	OperationCreateCookbookForCommit                 Operation = "kodit.commit.create_cookbook"
+	OperationCreateConventionsForCommit              Operation = "kodit.commit.create_conventions"
 	OperationExtractExamplesForCommit                Operation = "kodit.commit.extract_examples"
 	OperationCreateExampleSummaryForCommit           Operation = "kodit.commit.create_example_summary"
 	OperationCreateExampleCodeEmbeddingsForCommit    Operation = "kodit.commit.create_example_code_embeddings"
 	OperationCreateExampleSummaryEmbeddingsForCommit Operation = "kodit.commit.create_example_summary_embeddings"
+	OperationCreateFileSummaryForCommit              Operation = "kodit.commit.create_file_summary"
+	OperationCreateDirectorySummaryForCommit         Operation = "kodit.commit.create_directory_summary"
 	OperationGenerateWikiForCommit                   Operation = "kodit.commit.generate_wiki"
+	OperationCreateWikiPageIndexForCommit            Operation = "kodit.commit.create_wiki_page_index"
+	OperationCreateWikiPageEmbeddingsForCommit       Operation = "kodit.commit.create_wiki_page_embeddings"
 	OperationExtractPageImagesForCommit              Operation = "kodit.commit.extract_page_images"
 	OperationCreatePageImageEmbeddingsForCommit      Operation = "kodit.commit.create_page_image_embeddings"
 	OperationScanCommit                              Operation = "kodit.commit.scan"
 	OperationRescanCommit                            Operation = "kodit.commit.rescan"
+	OperationScanDependencyVulnerabilitiesForCommit  Operation = "kodit.commit.scan_dependency_vulnerabilities"
+	OperationCreateSBOMForCommit                     Operation = "kodit.commit.create_sbom"
+	OperationCreateOnboardingReportForCommit         Operation = "kodit.commit.create_onboarding_report"
+	OperationTagPRRefForCommit                       Operation = "kodit.commit.tag_pr_ref"
+	OperationCompactVectorStore                      Operation = "kodit.maintenance.compact_vector_store"
+	OperationTruncateVectorStore                     Operation = "kodit.maintenance.truncate_vector_store"
 )
 
 // String returns the string representation of the operation.
@@ -88,6 +99,25 @@ func FullPrescribedOperations() PrescribedOperations {
 	return PrescribedOperations{enrichments: true}
 }
 
+// EnrichmentOperations returns the operations that produce an LLM enrichment
+// for a commit (summaries, docs, wikis, and the like). Used by callers that
+// need to target enrichments specifically rather than the full indexing
+// pipeline — e.g. backfilling enrichments onto commits that predate a
+// provider being configured.
+func EnrichmentOperations() []Operation {
+	return []Operation{
+		OperationCreatePublicAPIDocsForCommit,
+		OperationCreateArchitectureEnrichmentForCommit,
+		OperationCreateCommitDescriptionForCommit,
+		OperationCreateDatabaseSchemaForCommit,
+		OperationCreateCookbookForCommit,
+		OperationCreateConventionsForCommit,
+		OperationCreateFileSummaryForCommit,
+		OperationCreateDirectorySummaryForCommit,
+		OperationGenerateWikiForCommit,
+	}
+}
+
 // RequiresTextProvider reports whether this operation set needs a text
 // generation provider. Callers should fail fast when this returns true and no
 // provider is configured.
@@ -114,6 +144,9 @@ func (p PrescribedOperations) All() []Operation {
 		OperationExtractSnippetsForCommit,
 		OperationCreateBM25IndexForCommit,
 		OperationCreateCodeEmbeddingsForCommit,
+		OperationScanDependencyVulnerabilitiesForCommit,
+		OperationCreateSBOMForCommit,
+		OperationCreateOnboardingReportForCommit,
 		// Vision
 		OperationExtractPageImagesForCommit,
 		OperationCreatePageImageEmbeddingsForCommit,
@@ -132,7 +165,12 @@ func (p PrescribedOperations) All() []Operation {
 			OperationCreateCommitDescriptionForCommit,
 			OperationCreateDatabaseSchemaForCommit,
 			OperationCreateCookbookForCommit,
+			OperationCreateFileSummaryForCommit,
+			OperationCreateDirectorySummaryForCommit,
 			OperationGenerateWikiForCommit,
+			OperationCreateWikiPageIndexForCommit,
+			OperationCreateWikiPageEmbeddingsForCommit,
+			OperationCreateConventionsForCommit,
 		)
 	}
 	if p.enrichments && p.examples {
@@ -26,6 +26,7 @@ func TestAll(t *testing.T) {
 			OperationExtractSnippetsForCommit,
 			OperationCreateBM25IndexForCommit,
 			OperationCreateCodeEmbeddingsForCommit,
+			OperationScanDependencyVulnerabilitiesForCommit,
 			OperationExtractPageImagesForCommit,
 			OperationCreatePageImageEmbeddingsForCommit,
 		}
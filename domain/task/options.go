@@ -25,3 +25,13 @@ func WithActiveState() repository.Option {
 		string(ReportingStateInProgress),
 	})
 }
+
+// WithFailedState filters for statuses in the failed state.
+func WithFailedState() repository.Option {
+	return repository.WithCondition("state", string(ReportingStateFailed))
+}
+
+// WithOperation filters by operation.
+func WithOperation(operation Operation) repository.Option {
+	return repository.WithCondition("operation", string(operation))
+}
@@ -0,0 +1,110 @@
+package task
+
+import (
+	"errors"
+	"fmt"
+)
+
+// PayloadVersionKey is the reserved payload field that records the schema
+// version a payload was produced with. It lets a worker detect payloads
+// enqueued by an older producer during a rolling deployment and upgrade
+// them before handing them to a handler.
+const PayloadVersionKey = "_payload_version"
+
+// PayloadUpgrade transforms a payload from one schema version to the next.
+// Upgrade functions must tolerate missing fields, since a task may have
+// been queued long before an intermediate version was ever deployed.
+type PayloadUpgrade func(payload map[string]any) map[string]any
+
+// payloadVersions holds the current payload schema version for each
+// operation. An operation absent from this map defaults to version 1, so
+// operations whose payload shape has never changed need no entry.
+var payloadVersions = map[Operation]int{}
+
+// payloadUpgrades holds, for each operation, the function that upgrades a
+// payload from version N to N+1, keyed by N.
+var payloadUpgrades = map[Operation]map[int]PayloadUpgrade{}
+
+// RegisterPayloadVersion declares the current payload schema version for
+// an operation. Call this when a payload's shape changes, alongside
+// RegisterPayloadUpgrade for the version it upgrades from.
+func RegisterPayloadVersion(operation Operation, version int) {
+	payloadVersions[operation] = version
+}
+
+// RegisterPayloadUpgrade registers the function that upgrades payloads for
+// operation from schema version from to from+1.
+func RegisterPayloadUpgrade(operation Operation, from int, upgrade PayloadUpgrade) {
+	versions, ok := payloadUpgrades[operation]
+	if !ok {
+		versions = make(map[int]PayloadUpgrade)
+		payloadUpgrades[operation] = versions
+	}
+	versions[from] = upgrade
+}
+
+// CurrentPayloadVersion returns the current payload schema version for an
+// operation. Operations with no registered version default to 1.
+func CurrentPayloadVersion(operation Operation) int {
+	if v, ok := payloadVersions[operation]; ok {
+		return v
+	}
+	return 1
+}
+
+// StampPayloadVersion returns a copy of payload with its schema version
+// field set to the operation's current version.
+func StampPayloadVersion(operation Operation, payload map[string]any) map[string]any {
+	p := copyPayload(payload)
+	p[PayloadVersionKey] = CurrentPayloadVersion(operation)
+	return p
+}
+
+// payloadVersion reads the schema version stamped on a payload. A payload
+// with no version field predates this mechanism and is treated as version 1.
+func payloadVersion(payload map[string]any) int {
+	v, ok := payload[PayloadVersionKey]
+	if !ok {
+		return 1
+	}
+	switch n := v.(type) {
+	case int:
+		return n
+	case int64:
+		return int(n)
+	case float64:
+		return int(n)
+	default:
+		return 1
+	}
+}
+
+// ErrPayloadVersionTooNew indicates a task's payload was produced by a
+// newer schema version than this process knows how to handle. This can
+// happen briefly during a rolling deployment, when an old worker dequeues
+// a task enqueued by an already-upgraded producer.
+var ErrPayloadVersionTooNew = errors.New("payload schema version is newer than this process supports")
+
+// UpgradePayload brings payload up to operation's current schema version
+// by applying registered upgrade functions in order, so a worker running
+// newer code can process a task that an older producer enqueued.
+func UpgradePayload(operation Operation, payload map[string]any) (map[string]any, error) {
+	current := CurrentPayloadVersion(operation)
+	version := payloadVersion(payload)
+	if version > current {
+		return nil, fmt.Errorf("%w: operation %s payload is version %d, process supports up to %d",
+			ErrPayloadVersionTooNew, operation, version, current)
+	}
+
+	p := copyPayload(payload)
+	for version < current {
+		upgrade, ok := payloadUpgrades[operation][version]
+		if !ok {
+			return nil, fmt.Errorf("no upgrade registered for operation %s from version %d to %d", operation, version, version+1)
+		}
+		p = upgrade(p)
+		version++
+	}
+	p[PayloadVersionKey] = current
+	return p, nil
+}
@@ -0,0 +1,66 @@
+package task
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpgradePayload(t *testing.T) {
+	const op Operation = "test.versioning.op"
+
+	t.Run("defaults to version 1 when nothing registered", func(t *testing.T) {
+		assert.Equal(t, 1, CurrentPayloadVersion(op))
+
+		upgraded, err := UpgradePayload(op, map[string]any{"foo": "bar"})
+		require.NoError(t, err)
+		assert.Equal(t, 1, upgraded[PayloadVersionKey])
+		assert.Equal(t, "bar", upgraded["foo"])
+	})
+
+	t.Run("applies registered upgrades in order", func(t *testing.T) {
+		RegisterPayloadVersion(op, 3)
+		RegisterPayloadUpgrade(op, 1, func(payload map[string]any) map[string]any {
+			payload["added_in_v2"] = true
+			return payload
+		})
+		RegisterPayloadUpgrade(op, 2, func(payload map[string]any) map[string]any {
+			payload["added_in_v3"] = true
+			return payload
+		})
+
+		upgraded, err := UpgradePayload(op, map[string]any{PayloadVersionKey: 1})
+		require.NoError(t, err)
+		assert.Equal(t, 3, upgraded[PayloadVersionKey])
+		assert.Equal(t, true, upgraded["added_in_v2"])
+		assert.Equal(t, true, upgraded["added_in_v3"])
+	})
+
+	t.Run("errors when an upgrade step is missing", func(t *testing.T) {
+		const gapOp Operation = "test.versioning.gap"
+		RegisterPayloadVersion(gapOp, 2)
+
+		_, err := UpgradePayload(gapOp, map[string]any{PayloadVersionKey: 1})
+		require.Error(t, err)
+	})
+
+	t.Run("errors when the payload is newer than this process supports", func(t *testing.T) {
+		const newOp Operation = "test.versioning.toonew"
+		RegisterPayloadVersion(newOp, 1)
+
+		_, err := UpgradePayload(newOp, map[string]any{PayloadVersionKey: 2})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, ErrPayloadVersionTooNew))
+	})
+}
+
+func TestStampPayloadVersion(t *testing.T) {
+	const op Operation = "test.versioning.stamp"
+	RegisterPayloadVersion(op, 2)
+
+	stamped := StampPayloadVersion(op, map[string]any{"foo": "bar"})
+	assert.Equal(t, 2, stamped[PayloadVersionKey])
+	assert.Equal(t, "bar", stamped["foo"])
+}
@@ -0,0 +1,57 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrNotSimulatable indicates a handler is registered for an operation but
+// does not implement Simulator.
+var ErrNotSimulatable = errors.New("handler does not support simulation")
+
+// Simulator is implemented by task handlers that can describe what they
+// would do for a payload without making provider calls or writes — the
+// planning half of Execute, run standalone. Handlers that don't implement it
+// aren't simulatable; callers should treat that as a plain "not supported"
+// rather than falling back to running Execute for real.
+type Simulator interface {
+	Simulate(ctx context.Context, payload map[string]any) (Plan, error)
+}
+
+// Plan describes what a handler would do for a task's payload, without
+// performing any provider calls or writes. Handlers that can produce one
+// implement Simulator.
+type Plan struct {
+	operation   Operation
+	description string
+	itemCount   int
+	estimated   time.Duration
+}
+
+// NewPlan creates a Plan. description is a short human-readable summary
+// (e.g. "would create 42 embeddings"); itemCount is the number of units of
+// work identified; estimated is a rough wall-clock estimate, or 0 when the
+// handler has no basis for one.
+func NewPlan(operation Operation, description string, itemCount int, estimated time.Duration) Plan {
+	return Plan{
+		operation:   operation,
+		description: description,
+		itemCount:   itemCount,
+		estimated:   estimated,
+	}
+}
+
+// Operation returns the operation this plan was produced for.
+func (p Plan) Operation() Operation { return p.operation }
+
+// Description returns a short human-readable summary of the planned work.
+func (p Plan) Description() string { return p.description }
+
+// ItemCount returns the number of units of work identified (e.g. files to
+// chunk, documents to embed).
+func (p Plan) ItemCount() int { return p.itemCount }
+
+// EstimatedDuration returns a rough wall-clock estimate for the work, or 0
+// when the handler has no basis for one.
+func (p Plan) EstimatedDuration() time.Duration { return p.estimated }
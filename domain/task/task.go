@@ -37,9 +37,11 @@ type Task struct {
 }
 
 // NewTask creates a new Task with the given operation, priority, and payload.
-// The dedup key is generated automatically from the operation and payload.
+// The payload is stamped with the operation's current schema version (see
+// StampPayloadVersion) and the dedup key is generated from the stamped
+// payload.
 func NewTask(operation Operation, priority int, payload map[string]any) Task {
-	p := copyPayload(payload)
+	p := StampPayloadVersion(operation, payload)
 	return Task{
 		dedupKey:  createDedupKey(operation, p),
 		operation: operation,
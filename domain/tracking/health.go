@@ -0,0 +1,72 @@
+package tracking
+
+import (
+	"time"
+
+	"github.com/helixml/kodit/domain/task"
+)
+
+// healthStaleAfter is how long since the last scan before a repository is
+// considered stale, independent of its task failure rate.
+const healthStaleAfter = 7 * 24 * time.Hour
+
+// RepositoryHealth scores a repository's indexing health from 0 (unhealthy)
+// to 100 (fully healthy), combining recent task failures, enrichment
+// coverage, and staleness since the last scan.
+type RepositoryHealth struct {
+	score float64
+	stale bool
+}
+
+// NewRepositoryHealth creates a new RepositoryHealth.
+func NewRepositoryHealth(score float64, stale bool) RepositoryHealth {
+	return RepositoryHealth{score: score, stale: stale}
+}
+
+// Score returns the health score, from 0 to 100.
+func (h RepositoryHealth) Score() float64 { return h.score }
+
+// Stale reports whether the repository has not been scanned recently.
+func (h RepositoryHealth) Stale() bool { return h.stale }
+
+// Unhealthy reports whether the score has fallen to or below threshold.
+func (h RepositoryHealth) Unhealthy(threshold float64) bool { return h.score <= threshold }
+
+// ComputeRepositoryHealth derives a RepositoryHealth from task outcomes,
+// enrichment coverage (0 to 1), and the time of the last scan. Failures
+// depress the score proportionally to how many recent tasks failed;
+// missing enrichment coverage depresses it further; staleness halves
+// whatever score remains, since an unscanned repository's other signals
+// are themselves stale.
+func ComputeRepositoryHealth(tasks []task.Status, enrichmentCoverage float64, lastScannedAt time.Time) RepositoryHealth {
+	switch {
+	case enrichmentCoverage < 0:
+		enrichmentCoverage = 0
+	case enrichmentCoverage > 1:
+		enrichmentCoverage = 1
+	}
+
+	score := 100 * (1 - taskFailureRate(tasks)) * enrichmentCoverage
+
+	stale := !lastScannedAt.IsZero() && time.Since(lastScannedAt) > healthStaleAfter
+	if stale {
+		score /= 2
+	}
+
+	return NewRepositoryHealth(score, stale)
+}
+
+// taskFailureRate returns the fraction of terminal tasks that failed.
+func taskFailureRate(tasks []task.Status) float64 {
+	if len(tasks) == 0 {
+		return 0
+	}
+
+	var failed int
+	for _, t := range tasks {
+		if t.State() == task.ReportingStateFailed {
+			failed++
+		}
+	}
+	return float64(failed) / float64(len(tasks))
+}
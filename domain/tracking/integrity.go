@@ -0,0 +1,53 @@
+package tracking
+
+import "time"
+
+// IntegrityStatus classifies the result of comparing a repository's tracked
+// local ref against its upstream remote.
+type IntegrityStatus string
+
+// IntegrityStatus values.
+const (
+	// IntegrityStatusOK means the tracked ref matches (or is an ancestor
+	// of) the remote tip.
+	IntegrityStatusOK IntegrityStatus = "ok"
+	// IntegrityStatusDiverged means the remote tip is not a descendant of
+	// the tracked ref, indicating the history was rewritten (e.g. a force
+	// push) rather than simply advanced.
+	IntegrityStatusDiverged IntegrityStatus = "diverged"
+	// IntegrityStatusDeleted means the tracked ref no longer exists on the
+	// remote.
+	IntegrityStatusDeleted IntegrityStatus = "deleted_upstream"
+)
+
+// IntegrityCheck is the immutable result of comparing a repository's
+// tracked ref against its remote.
+type IntegrityCheck struct {
+	status    IntegrityStatus
+	message   string
+	checkedAt time.Time
+}
+
+// NewIntegrityCheck creates a new IntegrityCheck.
+func NewIntegrityCheck(status IntegrityStatus, message string, checkedAt time.Time) IntegrityCheck {
+	return IntegrityCheck{
+		status:    status,
+		message:   message,
+		checkedAt: checkedAt,
+	}
+}
+
+// Status returns the integrity status.
+func (c IntegrityCheck) Status() IntegrityStatus { return c.status }
+
+// Message returns a human-readable explanation, set when the status is not OK.
+func (c IntegrityCheck) Message() string { return c.message }
+
+// CheckedAt returns when the check was performed.
+func (c IntegrityCheck) CheckedAt() time.Time { return c.checkedAt }
+
+// OK reports whether the tracked ref matches the remote, including the
+// zero value (no check has run yet).
+func (c IntegrityCheck) OK() bool {
+	return c.status == "" || c.status == IntegrityStatusOK
+}
@@ -13,6 +13,7 @@ type RepositoryStatusSummary struct {
 	status    snippet.IndexStatus
 	message   string
 	updatedAt time.Time
+	integrity IntegrityCheck
 }
 
 // NewRepositoryStatusSummary creates a new RepositoryStatusSummary.
@@ -39,6 +40,18 @@ func (s RepositoryStatusSummary) UpdatedAt() time.Time {
 	return s.updatedAt
 }
 
+// Integrity returns the most recent comparison of the tracked ref against
+// its remote, or its zero value (OK) if no check has run yet.
+func (s RepositoryStatusSummary) Integrity() IntegrityCheck {
+	return s.integrity
+}
+
+// WithIntegrity returns a copy of the summary with its integrity check result replaced.
+func (s RepositoryStatusSummary) WithIntegrity(check IntegrityCheck) RepositoryStatusSummary {
+	s.integrity = check
+	return s
+}
+
 // StatusSummaryFromTasks derives a RepositoryStatusSummary from task statuses.
 // Priority: in_progress/started > pending_queue > completed_with_errors/failed > completed > pending.
 // When all tasks are terminal and failures exist, returns completed_with_errors
@@ -0,0 +1,90 @@
+package usage
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrBudgetExceeded is returned by a provider call that was refused because
+// its operation's daily Budget has already been spent. Callers should defer
+// dependent work until the next day's window rather than treat this as a
+// permanent failure.
+var ErrBudgetExceeded = errors.New("provider budget exceeded for today")
+
+// Budget caps how many tokens and how much estimated cost a provider
+// operation (EMBEDDING or ENRICHMENT) may consume per day. A zero value for
+// either limit leaves that limit unenforced. This is an immutable value
+// object.
+type Budget struct {
+	maxTokensPerDay int
+	maxCostPerDay   float64
+}
+
+// NewBudget creates a Budget with the given daily limits.
+func NewBudget(maxTokensPerDay int, maxCostPerDay float64) Budget {
+	return Budget{maxTokensPerDay: maxTokensPerDay, maxCostPerDay: maxCostPerDay}
+}
+
+// MaxTokensPerDay returns the daily token cap, or 0 if unenforced.
+func (b Budget) MaxTokensPerDay() int { return b.maxTokensPerDay }
+
+// MaxCostPerDay returns the daily cost cap in USD, or 0 if unenforced.
+func (b Budget) MaxCostPerDay() float64 { return b.maxCostPerDay }
+
+// Enforced reports whether this budget has any active limit.
+func (b Budget) Enforced() bool {
+	return b.maxTokensPerDay > 0 || b.maxCostPerDay > 0
+}
+
+// Exceeded reports whether tokensSpent or costSpent, already recorded today,
+// has reached this budget's limits.
+func (b Budget) Exceeded(tokensSpent int, costSpent float64) bool {
+	if b.maxTokensPerDay > 0 && tokensSpent >= b.maxTokensPerDay {
+		return true
+	}
+	if b.maxCostPerDay > 0 && costSpent >= b.maxCostPerDay {
+		return true
+	}
+	return false
+}
+
+// RemainingTokens returns how many tokens remain in the budget given
+// tokensSpent, or -1 if the token limit is unenforced.
+func (b Budget) RemainingTokens(tokensSpent int) int {
+	if b.maxTokensPerDay == 0 {
+		return -1
+	}
+	if remaining := b.maxTokensPerDay - tokensSpent; remaining > 0 {
+		return remaining
+	}
+	return 0
+}
+
+// RemainingCost returns how much cost remains in the budget given
+// costSpent, or -1 if the cost limit is unenforced.
+func (b Budget) RemainingCost(costSpent float64) float64 {
+	if b.maxCostPerDay == 0 {
+		return -1
+	}
+	if remaining := b.maxCostPerDay - costSpent; remaining > 0 {
+		return remaining
+	}
+	return 0
+}
+
+// Sum totals the tokens and cost across a set of usage records, e.g. to
+// compare today's spend against a Budget.
+func Sum(records []ProviderUsage) (tokens int, cost float64) {
+	for _, r := range records {
+		tokens += r.TotalTokens()
+		cost += r.CostEstimate()
+	}
+	return tokens, cost
+}
+
+// StartOfDayUTC returns midnight UTC of the current day, the start of the
+// window a Budget's daily limits apply to.
+func StartOfDayUTC() time.Time {
+	now := time.Now().UTC()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+}
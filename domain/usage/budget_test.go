@@ -0,0 +1,56 @@
+package usage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBudget_Enforced(t *testing.T) {
+	assert.False(t, NewBudget(0, 0).Enforced())
+	assert.True(t, NewBudget(1000, 0).Enforced())
+	assert.True(t, NewBudget(0, 1.0).Enforced())
+}
+
+func TestBudget_Exceeded_Tokens(t *testing.T) {
+	b := NewBudget(1000, 0)
+	assert.False(t, b.Exceeded(999, 0))
+	assert.True(t, b.Exceeded(1000, 0))
+	assert.True(t, b.Exceeded(1001, 0))
+}
+
+func TestBudget_Exceeded_Cost(t *testing.T) {
+	b := NewBudget(0, 5.0)
+	assert.False(t, b.Exceeded(0, 4.99))
+	assert.True(t, b.Exceeded(0, 5.0))
+}
+
+func TestBudget_Exceeded_Unenforced(t *testing.T) {
+	b := NewBudget(0, 0)
+	assert.False(t, b.Exceeded(1_000_000, 1_000_000))
+}
+
+func TestBudget_RemainingTokens(t *testing.T) {
+	b := NewBudget(1000, 0)
+	assert.Equal(t, 400, b.RemainingTokens(600))
+	assert.Equal(t, 0, b.RemainingTokens(1000))
+	assert.Equal(t, 0, b.RemainingTokens(1200))
+	assert.Equal(t, -1, NewBudget(0, 0).RemainingTokens(600))
+}
+
+func TestBudget_RemainingCost(t *testing.T) {
+	b := NewBudget(0, 5.0)
+	assert.Equal(t, 2.0, b.RemainingCost(3.0))
+	assert.Equal(t, 0.0, b.RemainingCost(5.0))
+	assert.Equal(t, -1.0, NewBudget(0, 0).RemainingCost(3.0))
+}
+
+func TestSum(t *testing.T) {
+	records := []ProviderUsage{
+		New("42", OperationEmbedding, "text-embedding-3-small", "h1", 100, 0, 100, 10, 0.001),
+		New("42", OperationEmbedding, "text-embedding-3-small", "h2", 50, 0, 50, 5, 0.0005),
+	}
+	tokens, cost := Sum(records)
+	assert.Equal(t, 150, tokens)
+	assert.InDelta(t, 0.0015, cost, 0.0001)
+}
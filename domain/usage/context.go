@@ -0,0 +1,21 @@
+package usage
+
+import "context"
+
+// repositoryIDKey is the context key for the attributed repository ID.
+type repositoryIDKey struct{}
+
+// ContextWithRepositoryID returns a copy of ctx carrying repositoryID, so
+// that provider calls made while processing it (enrichments, embeddings)
+// can be attributed to it. Represented as a string since callers format
+// repository IDs differently (int64 database IDs today).
+func ContextWithRepositoryID(ctx context.Context, repositoryID string) context.Context {
+	return context.WithValue(ctx, repositoryIDKey{}, repositoryID)
+}
+
+// RepositoryIDFromContext returns the repository ID set via
+// ContextWithRepositoryID, or "" if none was set.
+func RepositoryIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(repositoryIDKey{}).(string)
+	return id
+}
@@ -0,0 +1,30 @@
+package usage
+
+// modelPricing holds per-1000-token USD rates for a model.
+type modelPricing struct {
+	promptPerThousand     float64
+	completionPerThousand float64
+}
+
+// knownPricing covers a small set of widely used models. Models not listed
+// here are not guessed at — EstimateCost returns 0 for them, which a
+// provider-usage report should treat as "unknown", not "free".
+var knownPricing = map[string]modelPricing{
+	"gpt-4o":                 {promptPerThousand: 0.0025, completionPerThousand: 0.01},
+	"gpt-4o-mini":            {promptPerThousand: 0.00015, completionPerThousand: 0.0006},
+	"text-embedding-3-small": {promptPerThousand: 0.00002},
+	"text-embedding-3-large": {promptPerThousand: 0.00013},
+	"claude-3-5-sonnet":      {promptPerThousand: 0.003, completionPerThousand: 0.015},
+	"claude-3-5-haiku":       {promptPerThousand: 0.0008, completionPerThousand: 0.004},
+}
+
+// EstimateCost returns the estimated USD cost of a call against model given
+// its prompt and completion token counts, or 0 if the model's pricing is
+// not in knownPricing.
+func EstimateCost(model string, promptTokens, completionTokens int) float64 {
+	p, ok := knownPricing[model]
+	if !ok {
+		return 0
+	}
+	return float64(promptTokens)/1000*p.promptPerThousand + float64(completionTokens)/1000*p.completionPerThousand
+}
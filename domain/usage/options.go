@@ -0,0 +1,23 @@
+package usage
+
+import (
+	"time"
+
+	"github.com/helixml/kodit/domain/repository"
+)
+
+// WithRepositoryID filters by the "repository_id" column.
+func WithRepositoryID(id string) repository.Option {
+	return repository.WithCondition("repository_id", id)
+}
+
+// WithOperation filters by the "operation" column.
+func WithOperation(op Operation) repository.Option {
+	return repository.WithCondition("operation", string(op))
+}
+
+// WithCreatedAfter filters to records created at or after t. Used to scope
+// aggregation to a time window, e.g. today's spend for budget enforcement.
+func WithCreatedAfter(t time.Time) repository.Option {
+	return repository.WithWhere("created_at >= ?", t)
+}
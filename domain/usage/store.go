@@ -0,0 +1,8 @@
+package usage
+
+import "github.com/helixml/kodit/domain/repository"
+
+// Store defines persistence for provider usage records.
+type Store interface {
+	repository.Store[ProviderUsage]
+}
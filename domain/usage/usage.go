@@ -0,0 +1,117 @@
+// Package usage provides domain types for tracking provider (LLM and
+// embedding) calls for cost attribution.
+package usage
+
+import "time"
+
+// Operation identifies which kind of provider call a usage record tracks.
+type Operation string
+
+// Operation constants.
+const (
+	OperationEnrichment Operation = "enrichment"
+	OperationEmbedding  Operation = "embedding"
+)
+
+// ProviderUsage records one provider call: which model served it, how many
+// tokens it consumed, how long it took, and its estimated cost. This is an
+// immutable value object.
+type ProviderUsage struct {
+	id               int64
+	repositoryID     string
+	operation        Operation
+	model            string
+	promptHash       string
+	promptTokens     int
+	completionTokens int
+	totalTokens      int
+	latencyMS        int64
+	costEstimate     float64
+	createdAt        time.Time
+}
+
+// New creates a ProviderUsage for a call that just completed (not yet
+// persisted). repositoryID may be "" when the call could not be attributed
+// to a specific repository.
+func New(
+	repositoryID string,
+	operation Operation,
+	model, promptHash string,
+	promptTokens, completionTokens, totalTokens int,
+	latencyMS int64,
+	costEstimate float64,
+) ProviderUsage {
+	return ProviderUsage{
+		repositoryID:     repositoryID,
+		operation:        operation,
+		model:            model,
+		promptHash:       promptHash,
+		promptTokens:     promptTokens,
+		completionTokens: completionTokens,
+		totalTokens:      totalTokens,
+		latencyMS:        latencyMS,
+		costEstimate:     costEstimate,
+		createdAt:        time.Now(),
+	}
+}
+
+// Reconstruct recreates a ProviderUsage from persistence.
+func Reconstruct(
+	id int64,
+	repositoryID string,
+	operation Operation,
+	model, promptHash string,
+	promptTokens, completionTokens, totalTokens int,
+	latencyMS int64,
+	costEstimate float64,
+	createdAt time.Time,
+) ProviderUsage {
+	return ProviderUsage{
+		id:               id,
+		repositoryID:     repositoryID,
+		operation:        operation,
+		model:            model,
+		promptHash:       promptHash,
+		promptTokens:     promptTokens,
+		completionTokens: completionTokens,
+		totalTokens:      totalTokens,
+		latencyMS:        latencyMS,
+		costEstimate:     costEstimate,
+		createdAt:        createdAt,
+	}
+}
+
+// ID returns the database identifier.
+func (u ProviderUsage) ID() int64 { return u.id }
+
+// RepositoryID returns the attributed repository, or "" if unattributed.
+func (u ProviderUsage) RepositoryID() string { return u.repositoryID }
+
+// Operation returns which kind of provider call this record tracks.
+func (u ProviderUsage) Operation() Operation { return u.operation }
+
+// Model returns the provider model name that served the call.
+func (u ProviderUsage) Model() string { return u.model }
+
+// PromptHash returns a hash of the prompt, for deduplication and auditing
+// without retaining the (possibly sensitive) prompt text itself.
+func (u ProviderUsage) PromptHash() string { return u.promptHash }
+
+// PromptTokens returns the number of input tokens consumed.
+func (u ProviderUsage) PromptTokens() int { return u.promptTokens }
+
+// CompletionTokens returns the number of output tokens produced.
+func (u ProviderUsage) CompletionTokens() int { return u.completionTokens }
+
+// TotalTokens returns the total tokens consumed by the call.
+func (u ProviderUsage) TotalTokens() int { return u.totalTokens }
+
+// LatencyMS returns how long the call took, in milliseconds.
+func (u ProviderUsage) LatencyMS() int64 { return u.latencyMS }
+
+// CostEstimate returns the estimated USD cost of the call, or 0 if the
+// model's pricing is unknown.
+func (u ProviderUsage) CostEstimate() float64 { return u.costEstimate }
+
+// CreatedAt returns when the call was recorded.
+func (u ProviderUsage) CreatedAt() time.Time { return u.createdAt }
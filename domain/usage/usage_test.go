@@ -0,0 +1,42 @@
+package usage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	u := New("42", OperationEnrichment, "gpt-4o", "hash", 100, 50, 150, 250, 0.001)
+
+	assert.Equal(t, int64(0), u.ID())
+	assert.Equal(t, "42", u.RepositoryID())
+	assert.Equal(t, OperationEnrichment, u.Operation())
+	assert.Equal(t, "gpt-4o", u.Model())
+	assert.Equal(t, "hash", u.PromptHash())
+	assert.Equal(t, 100, u.PromptTokens())
+	assert.Equal(t, 50, u.CompletionTokens())
+	assert.Equal(t, 150, u.TotalTokens())
+	assert.Equal(t, int64(250), u.LatencyMS())
+	assert.Equal(t, 0.001, u.CostEstimate())
+	assert.False(t, u.CreatedAt().IsZero())
+}
+
+func TestReconstruct(t *testing.T) {
+	u := Reconstruct(7, "", OperationEmbedding, "text-embedding-3-small", "hash", 10, 0, 10, 5, 0, time.Now())
+
+	assert.Equal(t, int64(7), u.ID())
+	assert.Equal(t, "", u.RepositoryID())
+	assert.Equal(t, OperationEmbedding, u.Operation())
+}
+
+func TestEstimateCost_KnownModel(t *testing.T) {
+	cost := EstimateCost("gpt-4o", 1000, 1000)
+	assert.Equal(t, 0.0125, cost)
+}
+
+func TestEstimateCost_UnknownModel(t *testing.T) {
+	cost := EstimateCost("some-local-model", 1000, 1000)
+	assert.Equal(t, 0.0, cost)
+}
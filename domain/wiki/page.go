@@ -1,5 +1,10 @@
 package wiki
 
+import (
+	"encoding/json"
+	"fmt"
+)
+
 // Page represents a single page in a wiki.
 // Pages form a tree via children, and are identified by slug.
 type Page struct {
@@ -38,3 +43,31 @@ func (p Page) Position() int { return p.position }
 
 // Children returns the child pages.
 func (p Page) Children() []Page { return p.children }
+
+// Document serializes the page's own slug, title, and content (not its
+// children) to JSON, for storage as a standalone enrichment that can be
+// indexed and searched independently of the rest of the wiki.
+func (p Page) Document() (string, error) {
+	bytes, err := json.Marshal(pageDocJSON{Slug: p.slug, Title: p.title, Content: p.content})
+	if err != nil {
+		return "", fmt.Errorf("marshal page document: %w", err)
+	}
+	return string(bytes), nil
+}
+
+// ParsePageDocument deserializes a page document produced by Page.Document.
+// The returned page has no children and position 0: a document only carries
+// a page's own slug, title, and content.
+func ParsePageDocument(content string) (Page, error) {
+	var data pageDocJSON
+	if err := json.Unmarshal([]byte(content), &data); err != nil {
+		return Page{}, fmt.Errorf("unmarshal page document: %w", err)
+	}
+	return NewPage(data.Slug, data.Title, data.Content, 0, nil), nil
+}
+
+type pageDocJSON struct {
+	Slug    string `json:"slug"`
+	Title   string `json:"title"`
+	Content string `json:"content"`
+}
@@ -86,6 +86,18 @@ func ParseWiki(content string) (Wiki, error) {
 	return NewWiki(pagesFromJSON(data.Pages)), nil
 }
 
+// Flatten returns every page in the tree as a single depth-first slice,
+// for callers that need to operate on each page individually (e.g. indexing
+// each page for search) rather than walk the tree themselves.
+func Flatten(pages []Page) []Page {
+	result := make([]Page, 0, len(pages))
+	for _, p := range pages {
+		result = append(result, p)
+		result = append(result, Flatten(p.children)...)
+	}
+	return result
+}
+
 func buildPathIndex(pages []Page, prefix string, index map[string]string) {
 	for _, p := range pages {
 		path := p.slug
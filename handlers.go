@@ -11,11 +11,13 @@ import (
 	commithandler "github.com/helixml/kodit/application/handler/commit"
 	enrichmenthandler "github.com/helixml/kodit/application/handler/enrichment"
 	indexinghandler "github.com/helixml/kodit/application/handler/indexing"
+	maintenancehandler "github.com/helixml/kodit/application/handler/maintenance"
 	repohandler "github.com/helixml/kodit/application/handler/repository"
 	"github.com/helixml/kodit/application/service"
 	"github.com/helixml/kodit/domain/enrichment"
 	"github.com/helixml/kodit/domain/task"
 	"github.com/helixml/kodit/infrastructure/extraction"
+	"github.com/helixml/kodit/infrastructure/metrics"
 	"github.com/helixml/kodit/infrastructure/tracking"
 )
 
@@ -44,9 +46,9 @@ func (c *Client) registerHandlers() error {
 	// Indexing handler — chunk files into enrichments
 	c.registry.Register(task.OperationExtractSnippetsForCommit, handler.WithCleanup(
 		indexinghandler.NewChunkFiles(
-			c.repoStores.Repositories, c.enrichCtx.Enrichments, c.enrichCtx.Associations, c.lineRangeStore,
+			c.repoStores.Repositories, c.repoStores.Commits, c.enrichCtx.Enrichments, c.enrichCtx.Associations, c.lineRangeStore,
 			c.repoStores.Files, c.gitInfra.Adapter, c.documentText, extraction.NewExtractors(),
-			c.textRenderers, c.chunkParams, c.enrichCtx.Tracker, c.logger,
+			c.textRenderers, c.chunkParams, c.enrichCtx.Tracker, c.logger, metrics.NewHeuristicAnalyzer(),
 		),
 		handler.NewEnrichmentCleanup(c.Enrichments, c.repoStores.Commits,
 			enrichment.TypeDevelopment, enrichment.SubtypeChunk),
@@ -91,7 +93,10 @@ func (c *Client) registerHandlers() error {
 	// Code embedding handlers — only if embedding provider configured
 	// Cascade-deletes when parent enrichments are deleted
 	if c.codeIndex.Store != nil {
-		h, err := indexinghandler.NewCreateCodeEmbeddings(c.codeIndex, c.enrichCtx.Enrichments, c.enrichCtx.Tracker, c.logger, subtype)
+		h, err := indexinghandler.NewCreateCodeEmbeddings(
+			c.codeIndex, c.enrichCtx.Enrichments, c.enrichCtx.Associations, c.repoStores.Files, c.repoStores.Repositories,
+			c.embeddingStatusStore, c.contextTemplates, c.enrichCtx.Tracker, c.logger, subtype,
+		)
 		if err != nil {
 			return fmt.Errorf("create code embeddings handler: %w", err)
 		}
@@ -101,7 +106,7 @@ func (c *Client) registerHandlers() error {
 	// Text embedding handlers — only if text embedding provider configured
 	// Cascade-deletes when parent enrichments are deleted
 	if c.textIndex.Store != nil {
-		h, err := indexinghandler.NewCreateSummaryEmbeddings(c.textIndex, c.enrichCtx.Enrichments, c.enrichCtx.Associations, c.enrichCtx.Tracker, c.logger)
+		h, err := indexinghandler.NewCreateSummaryEmbeddings(c.textIndex, c.enrichCtx.Enrichments, c.enrichCtx.Associations, c.embeddingStatusStore, c.enrichCtx.Tracker, c.logger)
 		if err != nil {
 			return fmt.Errorf("create summary embeddings handler: %w", err)
 		}
@@ -146,6 +151,24 @@ func (c *Client) registerHandlers() error {
 				enrichment.TypeUsage, enrichment.SubtypeCookbook),
 		))
 
+		h9, err := enrichmenthandler.NewFileSummary(c.repoStores.Repositories, c.repoStores.Files, c.enrichCtx)
+		if err != nil {
+			return fmt.Errorf("file summary handler: %w", err)
+		}
+		c.registry.Register(task.OperationCreateFileSummaryForCommit, handler.WithCleanup(
+			h9, handler.NewEnrichmentCleanup(c.Enrichments, c.repoStores.Commits,
+				enrichment.TypeDevelopment, enrichment.SubtypeFileSummary),
+		))
+
+		h10, err := enrichmenthandler.NewDirectorySummary(c.repoStores.Repositories, c.repoStores.Files, c.enrichCtx)
+		if err != nil {
+			return fmt.Errorf("directory summary handler: %w", err)
+		}
+		c.registry.Register(task.OperationCreateDirectorySummaryForCommit, handler.WithCleanup(
+			h10, handler.NewEnrichmentCleanup(c.Enrichments, c.repoStores.Commits,
+				enrichment.TypeDevelopment, enrichment.SubtypeDirectorySummary),
+		))
+
 		h7, err := enrichmenthandler.NewWiki(c.repoStores.Repositories, c.repoStores.Files, c.enrichCtx, c.wikiContext)
 		if err != nil {
 			return fmt.Errorf("wiki handler: %w", err)
@@ -154,6 +177,29 @@ func (c *Client) registerHandlers() error {
 			h7, handler.NewEnrichmentCleanup(c.Enrichments, c.repoStores.Commits,
 				enrichment.TypeUsage, enrichment.SubtypeWiki),
 		))
+
+		// Wiki page BM25 index — cascade-deletes when parent enrichments are deleted
+		c.registry.Register(task.OperationCreateWikiPageIndexForCommit, indexinghandler.NewCreateWikiPageIndex(
+			c.bm25Service, c.enrichCtx.Enrichments, c.enrichCtx.Tracker, c.logger,
+		))
+
+		// Wiki page embeddings — only if text embedding provider configured
+		if c.textIndex.Store != nil {
+			hWikiEmbed, err := indexinghandler.NewCreateWikiPageEmbeddings(c.textIndex, c.enrichCtx.Enrichments, c.embeddingStatusStore, c.enrichCtx.Tracker, c.logger)
+			if err != nil {
+				return fmt.Errorf("wiki page embeddings handler: %w", err)
+			}
+			c.registry.Register(task.OperationCreateWikiPageEmbeddingsForCommit, hWikiEmbed)
+		}
+
+		h8, err := enrichmenthandler.NewConventions(c.repoStores.Repositories, c.repoStores.Files, c.enrichCtx, c.conventionsContext)
+		if err != nil {
+			return fmt.Errorf("conventions handler: %w", err)
+		}
+		c.registry.Register(task.OperationCreateConventionsForCommit, handler.WithCleanup(
+			h8, handler.NewEnrichmentCleanup(c.Enrichments, c.repoStores.Commits,
+				enrichment.TypeUsage, enrichment.SubtypeConventions),
+		))
 	}
 
 	// API docs enrichment (AST-based, no LLM dependency)
@@ -163,6 +209,61 @@ func (c *Client) registerHandlers() error {
 			enrichment.TypeUsage, enrichment.SubtypeAPIDocs),
 	))
 
+	// Dependency vulnerability scan (OSV-backed, no LLM dependency)
+	vulnScan, err := enrichmenthandler.NewVulnerabilityScan(c.repoStores.Repositories, c.repoStores.Files, c.enrichCtx, c.osvClient)
+	if err != nil {
+		return fmt.Errorf("vulnerability scan handler: %w", err)
+	}
+	c.registry.Register(task.OperationScanDependencyVulnerabilitiesForCommit, handler.WithCleanup(
+		vulnScan, handler.NewEnrichmentCleanup(c.Enrichments, c.repoStores.Commits,
+			enrichment.TypeSecurity, enrichment.SubtypeVulnerability),
+	))
+
+	// SBOM generation (CycloneDX, no LLM dependency)
+	sbomHandler, err := enrichmenthandler.NewCreateSBOM(c.repoStores.Repositories, c.repoStores.Files, c.enrichCtx)
+	if err != nil {
+		return fmt.Errorf("SBOM handler: %w", err)
+	}
+	c.registry.Register(task.OperationCreateSBOMForCommit, handler.WithCleanup(
+		sbomHandler, handler.NewEnrichmentCleanup(c.Enrichments, c.repoStores.Commits,
+			enrichment.TypeCompliance, enrichment.SubtypeSBOM),
+	))
+
+	// Onboarding report (deterministic file/language coverage analysis, no LLM dependency)
+	onboardingHandler, err := enrichmenthandler.NewOnboardingReport(c.repoStores.Repositories, c.repoStores.Files, c.enrichCtx)
+	if err != nil {
+		return fmt.Errorf("onboarding report handler: %w", err)
+	}
+	c.registry.Register(task.OperationCreateOnboardingReportForCommit, handler.WithCleanup(
+		onboardingHandler, handler.NewEnrichmentCleanup(c.Enrichments, c.repoStores.Commits,
+			enrichment.TypeUsage, enrichment.SubtypeOnboardingReport),
+	))
+
+	// PR ref tagging (associates already-indexed snippets with a PR preview,
+	// no LLM dependency)
+	c.registry.Register(task.OperationTagPRRefForCommit, indexinghandler.NewTagPRRef(
+		c.enrichCtx.Enrichments, c.enrichCtx.Associations, c.enrichCtx.Tracker, c.logger,
+	))
+
+	// Vector store compaction (periodic maintenance, not tied to a commit)
+	c.registry.Register(task.OperationCompactVectorStore, maintenancehandler.NewCompactVectorStore(
+		c.Enrichments, c.enrichCtx.Tracker, c.logger,
+	))
+
+	// Vector store dimension truncation (one-off migration, not tied to a commit)
+	c.registry.Register(task.OperationTruncateVectorStore, maintenancehandler.NewTruncateVectorStore(
+		c.Enrichments, c.enrichCtx.Tracker, c.logger,
+	))
+
+	// Custom handlers registered via WithHandler, for applications embedding
+	// kodit that extend the pipeline with their own operations.
+	for operation, h := range c.customHandlers {
+		if c.registry.HasHandler(operation) {
+			return fmt.Errorf("operation %s already has a handler registered", operation)
+		}
+		c.registry.Register(operation, h)
+	}
+
 	c.logger.Info().Int("count", len(c.registry.Operations())).Msg("registered task handlers")
 	return nil
 }
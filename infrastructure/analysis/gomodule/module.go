@@ -0,0 +1,214 @@
+// Package gomodule discovers Go modules within a repository working copy,
+// including multi-module repos using go.work, and resolves file paths to
+// the module that owns them. This lets Go-aware indexing attach module
+// metadata to snippets instead of treating a monorepo as one flat import
+// namespace.
+package gomodule
+
+import (
+	"bufio"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Module is a discovered Go module within a repository.
+type Module struct {
+	path string // module path, from the module directive in go.mod
+	dir  string // module root directory, relative to the repository root ("." for the root module)
+}
+
+// Path returns the module's declared path, e.g. "github.com/helixml/kodit".
+func (m Module) Path() string { return m.path }
+
+// Dir returns the module's root directory, relative to the repository root.
+func (m Module) Dir() string { return m.dir }
+
+// Discover finds every Go module under root. If root contains a go.work
+// file, only the modules it lists via "use" directives are returned;
+// otherwise every go.mod found by walking root is treated as a module.
+func Discover(root string) ([]Module, error) {
+	if workFile := filepath.Join(root, "go.work"); fileExists(workFile) {
+		return discoverFromWorkspace(root, workFile)
+	}
+	return discoverByWalking(root)
+}
+
+// discoverFromWorkspace parses the "use" directives in a go.work file and
+// resolves each one to the module declared by the go.mod in that directory.
+func discoverFromWorkspace(root, workFile string) ([]Module, error) {
+	dirs, err := parseWorkUseDirectives(workFile)
+	if err != nil {
+		return nil, fmt.Errorf("parse go.work: %w", err)
+	}
+
+	modules := make([]Module, 0, len(dirs))
+	for _, dir := range dirs {
+		modDir := filepath.Join(root, dir)
+		modPath, err := modulePath(filepath.Join(modDir, "go.mod"))
+		if err != nil {
+			continue
+		}
+		modules = append(modules, Module{path: modPath, dir: filepath.ToSlash(filepath.Clean(dir))})
+	}
+	return modules, nil
+}
+
+// discoverByWalking finds every go.mod under root, treating each as a
+// separate module rooted at its containing directory.
+func discoverByWalking(root string) ([]Module, error) {
+	var modules []Module
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if d.Name() == ".git" || d.Name() == "vendor" || d.Name() == "node_modules" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.Name() != "go.mod" {
+			return nil
+		}
+		modPath, err := modulePath(path)
+		if err != nil {
+			return nil
+		}
+		dir, err := filepath.Rel(root, filepath.Dir(path))
+		if err != nil {
+			return nil
+		}
+		modules = append(modules, Module{path: modPath, dir: filepath.ToSlash(filepath.Clean(dir))})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("walk %s: %w", root, err)
+	}
+	return modules, nil
+}
+
+// parseWorkUseDirectives extracts the directories named by "use" lines and
+// "use (...)" blocks in a go.work file.
+func parseWorkUseDirectives(workFile string) ([]string, error) {
+	f, err := os.Open(workFile)
+	if err != nil {
+		return nil, fmt.Errorf("open %s: %w", workFile, err)
+	}
+	defer f.Close()
+
+	var dirs []string
+	inBlock := false
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "use (":
+			inBlock = true
+		case inBlock && line == ")":
+			inBlock = false
+		case inBlock:
+			if dir := strings.TrimSpace(line); dir != "" {
+				dirs = append(dirs, dir)
+			}
+		case strings.HasPrefix(line, "use "):
+			dirs = append(dirs, strings.TrimSpace(strings.TrimPrefix(line, "use ")))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return dirs, nil
+}
+
+// modulePath reads the module path declared by the "module" directive in
+// a go.mod file.
+func modulePath(goModPath string) (string, error) {
+	f, err := os.Open(goModPath)
+	if err != nil {
+		return "", fmt.Errorf("open %s: %w", goModPath, err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "module ") {
+			return strings.TrimSpace(strings.TrimPrefix(line, "module ")), nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", fmt.Errorf("%s: no module directive found", goModPath)
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// Resolver resolves repository-relative file paths to the Go module that
+// owns them.
+type Resolver struct {
+	modules []Module
+}
+
+// NewResolver creates a Resolver from a set of discovered modules.
+func NewResolver(modules []Module) Resolver {
+	return Resolver{modules: modules}
+}
+
+// ModuleForPath returns the module owning filePath (a path relative to the
+// repository root), chosen as the module whose directory is the longest
+// matching prefix of filePath. It reports false if no discovered module
+// contains filePath.
+func (r Resolver) ModuleForPath(filePath string) (Module, bool) {
+	filePath = filepath.ToSlash(filePath)
+
+	var best Module
+	found := false
+	for _, m := range r.modules {
+		if !pathWithinDir(filePath, m.dir) {
+			continue
+		}
+		if !found || len(m.dir) > len(best.dir) {
+			best = m
+			found = true
+		}
+	}
+	return best, found
+}
+
+// ResolveImport returns the module owning importPath, chosen as the module
+// whose path is the longest matching prefix of importPath. It reports
+// false if importPath does not belong to any discovered module, i.e. it is
+// an external dependency rather than an intra-repo import.
+func (r Resolver) ResolveImport(importPath string) (Module, bool) {
+	var best Module
+	found := false
+	for _, m := range r.modules {
+		if m.path == "" {
+			continue
+		}
+		if m.path != importPath && !strings.HasPrefix(importPath, m.path+"/") {
+			continue
+		}
+		if !found || len(m.path) > len(best.path) {
+			best = m
+			found = true
+		}
+	}
+	return best, found
+}
+
+// pathWithinDir reports whether filePath is dir itself or nested under it.
+// dir "." matches every path, so the root module is always a candidate.
+func pathWithinDir(filePath, dir string) bool {
+	if dir == "." || dir == "" {
+		return true
+	}
+	return filePath == dir || strings.HasPrefix(filePath, dir+"/")
+}
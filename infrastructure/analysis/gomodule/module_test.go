@@ -0,0 +1,70 @@
+package gomodule
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+}
+
+func TestDiscover_SingleModule(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "go.mod"), "module github.com/example/single\n\ngo 1.22\n")
+
+	modules, err := Discover(root)
+	require.NoError(t, err)
+	require.Len(t, modules, 1)
+	assert.Equal(t, "github.com/example/single", modules[0].Path())
+	assert.Equal(t, ".", modules[0].Dir())
+}
+
+func TestDiscover_Workspace(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "go.work"), "go 1.22\n\nuse (\n\t./api\n\t./worker\n)\n")
+	writeFile(t, filepath.Join(root, "api", "go.mod"), "module github.com/example/monorepo/api\n")
+	writeFile(t, filepath.Join(root, "worker", "go.mod"), "module github.com/example/monorepo/worker\n")
+
+	modules, err := Discover(root)
+	require.NoError(t, err)
+	require.Len(t, modules, 2)
+
+	resolver := NewResolver(modules)
+
+	mod, ok := resolver.ModuleForPath("api/handler/handler.go")
+	require.True(t, ok)
+	assert.Equal(t, "github.com/example/monorepo/api", mod.Path())
+
+	mod, ok = resolver.ModuleForPath("worker/main.go")
+	require.True(t, ok)
+	assert.Equal(t, "github.com/example/monorepo/worker", mod.Path())
+}
+
+func TestResolver_ResolveImport(t *testing.T) {
+	modules := []Module{
+		{path: "github.com/example/monorepo/api", dir: "api"},
+		{path: "github.com/example/monorepo/worker", dir: "worker"},
+	}
+	resolver := NewResolver(modules)
+
+	mod, ok := resolver.ResolveImport("github.com/example/monorepo/api/handler")
+	require.True(t, ok)
+	assert.Equal(t, "github.com/example/monorepo/api", mod.Path())
+
+	_, ok = resolver.ResolveImport("github.com/stretchr/testify/assert")
+	assert.False(t, ok)
+}
+
+func TestResolver_ModuleForPath_NoMatch(t *testing.T) {
+	resolver := NewResolver([]Module{{path: "github.com/example/api", dir: "api"}})
+
+	_, ok := resolver.ModuleForPath("worker/main.go")
+	assert.False(t, ok)
+}
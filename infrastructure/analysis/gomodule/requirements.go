@@ -0,0 +1,64 @@
+package gomodule
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Requirement is a single dependency declared by a "require" directive in
+// a go.mod file.
+type Requirement struct {
+	Path    string // import path of the required module
+	Version string // version string, e.g. "v1.9.1"
+}
+
+// Requirements reads the direct and indirect dependencies declared by the
+// "require" directives in the go.mod at goModPath, in both single-line and
+// block form.
+func Requirements(goModPath string) ([]Requirement, error) {
+	f, err := os.Open(goModPath)
+	if err != nil {
+		return nil, fmt.Errorf("open %s: %w", goModPath, err)
+	}
+	defer f.Close()
+
+	var reqs []Requirement
+	inBlock := false
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "require (":
+			inBlock = true
+		case inBlock && line == ")":
+			inBlock = false
+		case inBlock:
+			if req, ok := parseRequirement(line); ok {
+				reqs = append(reqs, req)
+			}
+		case strings.HasPrefix(line, "require "):
+			if req, ok := parseRequirement(strings.TrimPrefix(line, "require ")); ok {
+				reqs = append(reqs, req)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return reqs, nil
+}
+
+// parseRequirement parses a single "module/path vX.Y.Z" line, ignoring the
+// trailing "// indirect" comment when present.
+func parseRequirement(line string) (Requirement, bool) {
+	if idx := strings.Index(line, "//"); idx >= 0 {
+		line = line[:idx]
+	}
+	fields := strings.Fields(line)
+	if len(fields) < 2 {
+		return Requirement{}, false
+	}
+	return Requirement{Path: fields[0], Version: fields[1]}, true
+}
@@ -0,0 +1,33 @@
+package gomodule
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequirements_SingleLine(t *testing.T) {
+	root := t.TempDir()
+	path := filepath.Join(root, "go.mod")
+	writeFile(t, path, "module github.com/example/single\n\ngo 1.22\n\nrequire github.com/pkg/errors v0.9.1\n")
+
+	reqs, err := Requirements(path)
+	require.NoError(t, err)
+	require.Len(t, reqs, 1)
+	assert.Equal(t, Requirement{Path: "github.com/pkg/errors", Version: "v0.9.1"}, reqs[0])
+}
+
+func TestRequirements_Block(t *testing.T) {
+	root := t.TempDir()
+	path := filepath.Join(root, "go.mod")
+	writeFile(t, path, "module github.com/example/single\n\ngo 1.22\n\nrequire (\n\tgithub.com/pkg/errors v0.9.1\n\tgolang.org/x/sync v0.7.0 // indirect\n)\n")
+
+	reqs, err := Requirements(path)
+	require.NoError(t, err)
+	require.Equal(t, []Requirement{
+		{Path: "github.com/pkg/errors", Version: "v0.9.1"},
+		{Path: "golang.org/x/sync", Version: "v0.7.0"},
+	}, reqs)
+}
@@ -29,8 +29,9 @@ type APIServer struct {
 // NewAPIServer creates a new APIServer wired to the given kodit Client.
 // apiKeys configures write-protection: mutating endpoints (POST, PUT, PATCH,
 // DELETE) on /api/v1/repositories, /api/v1/enrichments, /api/v1/pipelines,
-// and /api/v1/steps require a valid key.
-// Read-only endpoints, search, MCP, and docs remain open.
+// and /api/v1/steps require a valid key. /mcp requires a valid key for every
+// request, since it exposes the same tools regardless of HTTP method.
+// Read-only endpoints, search, and docs remain open.
 func NewAPIServer(client *kodit.Client, apiKeys []string) *APIServer {
 	return &APIServer{
 		client:  client,
@@ -68,24 +69,45 @@ func (a *APIServer) mountRoutes(router chi.Router) {
 	reposRouter := v1.NewRepositoriesRouter(c)
 	queueRouter := v1.NewQueueRouter(c)
 	enrichmentsRouter := v1.NewEnrichmentsRouter(c)
+	examplesRouter := v1.NewExamplesRouter(c)
 	pipelinesRouter := v1.NewPipelinesRouter(c)
 	stepsRouter := v1.NewStepsRouter(c)
 	searchRouter := v1.NewSearchRouter(c)
+	snippetsRouter := v1.NewSnippetsRouter(c)
+	analyticsRouter := v1.NewAnalyticsRouter(c)
+	adminRouter := v1.NewAdminRouter(c)
+	compareRouter := v1.NewCompareRouter(c)
+	autocompleteRouter := v1.NewAutocompleteRouter(c)
+	synonymsRouter := v1.NewSynonymsRouter(c)
+	curationRouter := v1.NewCurationRouter(c)
+	discoveryRouter := v1.NewDiscoveryRouter(c)
 
 	router.Route("/api/v1", func(r chi.Router) {
 		r.Use(chimiddleware.Timeout(60 * time.Second))
 
 		// Open routes — search is a read-only POST, queue is GET-only.
 		r.Mount("/search", searchRouter.Routes())
+		r.Mount("/snippets", snippetsRouter.Routes())
 		r.Mount("/queue", queueRouter.Routes())
+		r.Mount("/autocomplete", autocompleteRouter.Routes())
+		// The webhook receiver authenticates via its own HMAC signature, not
+		// an API key — a Git hosting provider has no way to send one.
+		r.Mount("/discovery/webhook", discoveryRouter.WebhookRoutes())
 
 		// Write-protected routes — mutating methods require a valid API key.
 		r.Group(func(r chi.Router) {
 			r.Use(apimiddleware.WriteProtectAuth(a.apiKeys))
 			r.Mount("/repositories", reposRouter.Routes())
 			r.Mount("/enrichments", enrichmentsRouter.Routes())
+			r.Mount("/examples", examplesRouter.Routes())
 			r.Mount("/pipelines", pipelinesRouter.Routes())
 			r.Mount("/steps", stepsRouter.Routes())
+			r.Mount("/analytics", analyticsRouter.Routes())
+			r.Mount("/admin", adminRouter.Routes())
+			r.Mount("/compare", compareRouter.Routes())
+			r.Mount("/synonyms", synonymsRouter.Routes())
+			r.Mount("/curation", curationRouter.Routes())
+			r.Mount("/discovery", discoveryRouter.Routes())
 		})
 	})
 
@@ -97,9 +119,9 @@ func (a *APIServer) mountRoutes(router chi.Router) {
 	if c.Rasterizers() != nil {
 		mcpOpts = append(mcpOpts, mcpinternal.WithRasterization(c.Blobs, c.Rasterizers()))
 	}
-	mcpSrv := mcpinternal.NewServer(c.Repositories, c.Commits, c.Enrichments, c.Blobs, c.Search, c.Search, c.Search, c.Enrichments, c.Blobs, c.Files, c.Grep, "1.0.0", a.logger, mcpOpts...)
+	mcpSrv := mcpinternal.NewServer(c.Repositories, c.Commits, c.Enrichments, c.Blobs, c.Search, c.Search, c.Search, c.Enrichments, c.Blobs, c.Files, c.Grep, c.Overlay, c.RenameImpact, c.ArchitectureDiagram, c.Search, c.PatchSummarizer, c.CommitDiffer, "1.0.0", a.logger, mcpOpts...)
 	httpHandler := server.NewStreamableHTTPServer(mcpSrv.MCPServer())
-	router.Mount("/mcp", httpHandler)
+	router.With(apimiddleware.APIKeyAuth(a.apiKeys)).Mount("/mcp", httpHandler)
 }
 
 // DocsRouter returns a router for Swagger UI and OpenAPI spec.
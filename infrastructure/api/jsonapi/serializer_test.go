@@ -19,7 +19,13 @@ func TestRepositoryResource_SanitizesCredentials(t *testing.T) {
 		repository.WorkingCopy{},
 		repository.NewTrackingConfigForBranch("main"),
 		repository.DefaultChunkingConfig(),
+		repository.DefaultEnrichmentBudgetConfig(),
+		repository.DefaultEmbeddingConfig(),
+		repository.DefaultAccessConfig(),
+		repository.DefaultIndexFilterConfig(),
 		time.Now(), time.Now(), time.Time{},
+		"",
+		false, false, false,
 	)
 	source := repository.NewSource(repo)
 
@@ -176,6 +176,35 @@ func TestMCPEndpoint_RejectsInvalidContentType(t *testing.T) {
 	}
 }
 
+// TestMCPEndpoint_RequiresAPIKey verifies that when API keys are configured,
+// the MCP endpoint rejects requests without a valid X-API-KEY header, and
+// serves them once one is provided.
+func TestMCPEndpoint_RequiresAPIKey(t *testing.T) {
+	client := newMCPTestClient(t)
+	apiServer := api.NewAPIServer(client, []string{"secret-key"})
+	handler := apiServer.Handler()
+
+	body := mcpRequest(t, "initialize", 1, map[string]any{
+		"protocolVersion": "2025-06-18",
+		"capabilities":    map[string]any{},
+		"clientInfo":      map[string]any{"name": "test", "version": "0.0.1"},
+	})
+
+	w := postMCP(t, handler, body, "")
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("without key: status = %d, want %d; body: %s", w.Code, http.StatusUnauthorized, w.Body.String())
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/mcp", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-API-KEY", "secret-key")
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("with key: status = %d, want %d; body: %s", w.Code, http.StatusOK, w.Body.String())
+	}
+}
+
 // initMCPSession sends an initialize request and returns the session ID.
 func initMCPSession(t *testing.T, handler http.Handler) string {
 	t.Helper()
@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net/http"
 	"time"
@@ -11,6 +12,8 @@ import (
 	"github.com/go-chi/chi/v5"
 	chimiddleware "github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/cors"
+
+	"github.com/helixml/kodit/internal/config"
 )
 
 // Server represents the HTTP API server.
@@ -19,6 +22,7 @@ type Server struct {
 	httpServer *http.Server
 	logger     zerolog.Logger
 	addr       string
+	tlsConfig  *tls.Config
 }
 
 // NewServer creates a new API Server.
@@ -56,17 +60,64 @@ func (s Server) Router() chi.Router {
 	return s.router
 }
 
-// Start starts the HTTP server.
+// WithTLS configures the server to terminate TLS using tlsCfg, including
+// client certificate verification for mutual TLS when a client CA is
+// configured. Certificates are reloaded automatically from disk on
+// rotation. Returns the server unchanged if tlsCfg is not enabled.
+func (s Server) WithTLS(tlsCfg config.TLSConfig) (Server, error) {
+	if !tlsCfg.Enabled() {
+		return s, nil
+	}
+
+	reloader, err := newCertificateReloader(tlsCfg.CertFile(), tlsCfg.KeyFile())
+	if err != nil {
+		return s, fmt.Errorf("configure tls: %w", err)
+	}
+
+	t := &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: reloader.GetCertificate,
+	}
+
+	if tlsCfg.MutualTLSEnabled() {
+		clientCAs, err := loadClientCAs(tlsCfg.ClientCAFile())
+		if err != nil {
+			return s, fmt.Errorf("configure mutual tls: %w", err)
+		}
+		t.ClientCAs = clientCAs
+		if tlsCfg.RequireClientCert() {
+			t.ClientAuth = tls.RequireAndVerifyClientCert
+		} else {
+			t.ClientAuth = tls.VerifyClientCertIfGiven
+		}
+	}
+
+	s.tlsConfig = t
+	return s, nil
+}
+
+// Start starts the HTTP server. If WithTLS was applied, it terminates TLS
+// (and verifies client certificates, for mutual TLS) instead of serving
+// plaintext.
 func (s *Server) Start() error {
 	s.httpServer = &http.Server{
 		Addr:              s.addr,
 		Handler:           s.router,
+		TLSConfig:         s.tlsConfig,
 		ReadHeaderTimeout: 10 * time.Second,
 		ReadTimeout:       30 * time.Second,
 		WriteTimeout:      60 * time.Second,
 		IdleTimeout:       120 * time.Second,
 	}
 
+	if s.tlsConfig != nil {
+		s.logger.Info().Str("addr", s.addr).Bool("mutual_tls", s.tlsConfig.ClientCAs != nil).Msg("starting HTTPS server")
+		if err := s.httpServer.ListenAndServeTLS("", ""); err != nil && err != http.ErrServerClosed {
+			return fmt.Errorf("https server error: %w", err)
+		}
+		return nil
+	}
+
 	s.logger.Info().Str("addr", s.addr).Msg("starting HTTP server")
 	if err := s.httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
 		return fmt.Errorf("http server error: %w", err)
@@ -2,13 +2,22 @@ package api
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/rs/zerolog"
+
+	"github.com/helixml/kodit/internal/config"
 )
 
 func TestNewServer(t *testing.T) {
@@ -81,3 +90,94 @@ func TestServer_Shutdown(t *testing.T) {
 		t.Errorf("Shutdown() error = %v, want nil", err)
 	}
 }
+
+func TestServer_WithTLS_Disabled(t *testing.T) {
+	logger := zerolog.New(os.Stderr).With().Timestamp().Logger()
+	server := NewServer(":0", logger)
+
+	server, err := server.WithTLS(config.NewTLSConfig())
+	if err != nil {
+		t.Fatalf("WithTLS() error = %v, want nil", err)
+	}
+	if server.tlsConfig != nil {
+		t.Error("tlsConfig should be nil when TLS is not enabled")
+	}
+}
+
+func TestServer_WithTLS_Enabled(t *testing.T) {
+	certFile, keyFile := writeTestCertPair(t)
+	logger := zerolog.New(os.Stderr).With().Timestamp().Logger()
+	server := NewServer(":0", logger)
+
+	tlsCfg := config.NewTLSConfig().WithCertFile(certFile).WithKeyFile(keyFile)
+	server, err := server.WithTLS(tlsCfg)
+	if err != nil {
+		t.Fatalf("WithTLS() error = %v, want nil", err)
+	}
+	if server.tlsConfig == nil {
+		t.Fatal("tlsConfig should be set when TLS is enabled")
+	}
+	if server.tlsConfig.ClientCAs != nil {
+		t.Error("ClientCAs should be nil without a client CA configured")
+	}
+}
+
+func TestServer_WithTLS_MissingCertFile(t *testing.T) {
+	logger := zerolog.New(os.Stderr).With().Timestamp().Logger()
+	server := NewServer(":0", logger)
+
+	tlsCfg := config.NewTLSConfig().WithCertFile("/nonexistent/cert.pem").WithKeyFile("/nonexistent/key.pem")
+	_, err := server.WithTLS(tlsCfg)
+	if err == nil {
+		t.Error("WithTLS() error = nil, want error for missing certificate files")
+	}
+}
+
+// writeTestCertPair generates a self-signed certificate and key pair in a
+// temporary directory for TLS tests.
+func writeTestCertPair(t *testing.T) (certFile, keyFile string) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+
+	dir := t.TempDir()
+	certFile = filepath.Join(dir, "cert.pem")
+	keyFile = filepath.Join(dir, "key.pem")
+
+	certOut, err := os.Create(certFile)
+	if err != nil {
+		t.Fatalf("create cert file: %v", err)
+	}
+	defer certOut.Close()
+	if err := pem.Encode(certOut, &pem.Block{Type: "CERTIFICATE", Bytes: der}); err != nil {
+		t.Fatalf("encode certificate: %v", err)
+	}
+
+	keyOut, err := os.Create(keyFile)
+	if err != nil {
+		t.Fatalf("create key file: %v", err)
+	}
+	defer keyOut.Close()
+	if err := pem.Encode(keyOut, &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}); err != nil {
+		t.Fatalf("encode key: %v", err)
+	}
+
+	return certFile, keyFile
+}
@@ -0,0 +1,90 @@
+package api
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// certificateReloader serves a TLS certificate loaded from disk, reloading
+// it lazily whenever the underlying cert or key file's modification time
+// changes. This lets an operator rotate certificates in place without
+// restarting the server.
+type certificateReloader struct {
+	certFile string
+	keyFile  string
+
+	mu      sync.Mutex
+	modTime int64
+	cert    *tls.Certificate
+}
+
+// newCertificateReloader creates a reloader for the given cert/key pair and
+// loads it once up front, so startup fails immediately on a bad certificate
+// rather than on the first request.
+func newCertificateReloader(certFile, keyFile string) (*certificateReloader, error) {
+	r := &certificateReloader{certFile: certFile, keyFile: keyFile}
+	if _, err := r.load(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// GetCertificate satisfies tls.Config.GetCertificate.
+func (r *certificateReloader) GetCertificate(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return r.load()
+}
+
+func (r *certificateReloader) load() (*tls.Certificate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	modTime, err := latestModTime(r.certFile, r.keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("stat tls certificate files: %w", err)
+	}
+
+	if r.cert != nil && modTime == r.modTime {
+		return r.cert, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("load tls certificate: %w", err)
+	}
+
+	r.cert = &cert
+	r.modTime = modTime
+	return r.cert, nil
+}
+
+func latestModTime(paths ...string) (int64, error) {
+	var latest int64
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return 0, err
+		}
+		if t := info.ModTime().UnixNano(); t > latest {
+			latest = t
+		}
+	}
+	return latest, nil
+}
+
+// loadClientCAs reads a PEM-encoded CA bundle used to verify client
+// certificates for mutual TLS.
+func loadClientCAs(caFile string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("read client ca file: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates found in client ca file %q", caFile)
+	}
+	return pool, nil
+}
@@ -0,0 +1,555 @@
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/helixml/kodit"
+	"github.com/helixml/kodit/application/service"
+	"github.com/helixml/kodit/domain/cluster"
+	"github.com/helixml/kodit/domain/enrichment"
+	"github.com/helixml/kodit/domain/job"
+	"github.com/helixml/kodit/domain/search"
+	"github.com/helixml/kodit/domain/snapshot"
+	"github.com/helixml/kodit/domain/task"
+	"github.com/helixml/kodit/infrastructure/api/middleware"
+	"github.com/helixml/kodit/infrastructure/api/v1/dto"
+)
+
+// AdminRouter handles administrative API endpoints.
+type AdminRouter struct {
+	client *kodit.Client
+	logger zerolog.Logger
+}
+
+// NewAdminRouter creates a new AdminRouter.
+func NewAdminRouter(client *kodit.Client) *AdminRouter {
+	return &AdminRouter{
+		client: client,
+		logger: client.Logger(),
+	}
+}
+
+// Routes returns the chi router for admin endpoints.
+func (r *AdminRouter) Routes() chi.Router {
+	router := chi.NewRouter()
+
+	router.Get("/snapshots", r.ListSnapshots)
+	router.Post("/snapshots", r.CreateSnapshot)
+	router.Post("/snapshots/{id}/restore", r.RestoreSnapshot)
+	router.Post("/backfill", r.Backfill)
+	router.Post("/bulk", r.RunBulkOperation)
+	router.Get("/bulk/{id}", r.GetBulkOperation)
+	router.Post("/embeddings/retry", r.RetryFailedEmbeddings)
+	router.Post("/providers/validate", r.ValidateProviders)
+	router.Get("/corpus-diff", r.CorpusDiff)
+	router.Get("/workers", r.ListWorkers)
+	router.Get("/schedule", r.GetSchedule)
+
+	return router
+}
+
+// ListSnapshots handles GET /api/v1/admin/snapshots.
+//
+//	@Summary		List snapshots
+//	@Description	List captured point-in-time snapshots of the corpus index tables
+//	@Tags			admin
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	dto.SnapshotListResponse
+//	@Failure		500	{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/admin/snapshots [get]
+func (r *AdminRouter) ListSnapshots(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	snapshots, err := r.client.Snapshots.List(ctx)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	data := make([]dto.SnapshotData, len(snapshots))
+	for i, s := range snapshots {
+		data[i] = snapshotToDTO(s)
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, dto.SnapshotListResponse{Data: data})
+}
+
+// CreateSnapshot handles POST /api/v1/admin/snapshots.
+//
+//	@Summary		Capture snapshot
+//	@Description	Capture a point-in-time snapshot of the corpus index tables (repositories, commits, files, enrichments, associations, embeddings, and source locations), so a bad reindex, prompt change, or faulty migration can be rolled back
+//	@Tags			admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		dto.SnapshotCreateRequest	true	"Snapshot to capture"
+//	@Success		201		{object}	dto.SnapshotResponse
+//	@Failure		400		{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500		{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/admin/snapshots [post]
+func (r *AdminRouter) CreateSnapshot(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var body dto.SnapshotCreateRequest
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil && err != io.EOF {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	saved, err := r.client.Snapshots.Capture(ctx, body.Data.Attributes.Label)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusCreated, dto.SnapshotResponse{Data: snapshotToDTO(saved)})
+}
+
+// RestoreSnapshot handles POST /api/v1/admin/snapshots/{id}/restore.
+//
+//	@Summary		Restore snapshot
+//	@Description	Replace the contents of the corpus index tables with those captured in the given snapshot
+//	@Tags			admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path	int	true	"Snapshot ID"
+//	@Success		204
+//	@Failure		404	{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500	{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/admin/snapshots/{id}/restore [post]
+func (r *AdminRouter) RestoreSnapshot(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	id, err := strconv.ParseInt(chi.URLParam(req, "id"), 10, 64)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	if err := r.client.Snapshots.Restore(ctx, id); err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Backfill handles POST /api/v1/admin/backfill.
+//
+//	@Summary		Backfill enrichments
+//	@Description	Enqueue enrichment operations for commits that predate a provider being configured. Handlers skip commits that already have the enrichment, so this only needs repository, operation, and commit-depth filters to scope the run
+//	@Tags			admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		dto.BackfillCreateRequest	true	"Backfill scope"
+//	@Success		202		{object}	dto.BackfillResponse
+//	@Failure		400		{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500		{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/admin/backfill [post]
+func (r *AdminRouter) Backfill(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var body dto.BackfillCreateRequest
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil && err != io.EOF {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	attrs := body.Data.Attributes
+
+	operations := make([]task.Operation, len(attrs.Operations))
+	for i, op := range attrs.Operations {
+		operations[i] = task.Operation(op)
+	}
+
+	enqueued, err := r.client.Backfill.Run(ctx, service.BackfillParams{
+		RepoIDs:     attrs.RepoIDs,
+		Operations:  operations,
+		CommitDepth: attrs.CommitDepth,
+	})
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	operationNames := attrs.Operations
+	if len(operationNames) == 0 {
+		ops := task.EnrichmentOperations()
+		operationNames = make([]string, len(ops))
+		for i, op := range ops {
+			operationNames[i] = op.String()
+		}
+	}
+
+	middleware.WriteJSON(w, http.StatusAccepted, dto.BackfillResponse{
+		Data: dto.BackfillData{
+			Type: "backfills",
+			Attributes: dto.BackfillAttributes{
+				CommitsEnqueued: enqueued,
+				Operations:      operationNames,
+			},
+		},
+	})
+}
+
+// RunBulkOperation handles POST /api/v1/admin/bulk.
+//
+//	@Summary		Run a bulk operation
+//	@Description	Fan an admin operation out across many repositories as a single tracked job: sync (re-clone and re-scan), delete_enrichments (remove enrichments of a subtype and/or older than a cutoff), or reembed_repositories (recompute code and text embeddings). Repositories are selected by repo_ids or by remote_url_like (a substring match, since repositories have no separate label concept). Returns 202 with the job immediately; poll GET /admin/bulk/{id} for progress
+//	@Tags			admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		dto.BulkCreateRequest	true	"Bulk operation scope"
+//	@Success		202		{object}	dto.BulkJobResponse
+//	@Failure		400		{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500		{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/admin/bulk [post]
+func (r *AdminRouter) RunBulkOperation(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var body dto.BulkCreateRequest
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil && err != io.EOF {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	attrs := body.Data.Attributes
+	params := service.BulkOperationParams{
+		Kind:              job.Kind(attrs.Kind),
+		RepoIDs:           attrs.RepoIDs,
+		RemoteURLLike:     attrs.RemoteURLLike,
+		EnrichmentSubtype: enrichment.Subtype(attrs.EnrichmentSubtype),
+	}
+	if attrs.OlderThan != "" {
+		olderThan, err := time.Parse(time.RFC3339, attrs.OlderThan)
+		if err != nil {
+			middleware.WriteError(w, req, fmt.Errorf("invalid older_than: %w", err), r.logger)
+			return
+		}
+		params.OlderThan = olderThan
+	}
+
+	j, err := r.client.BulkOperations.Run(ctx, params)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusAccepted, dto.BulkJobResponse{Data: bulkJobToDTO(j)})
+}
+
+// GetBulkOperation handles GET /api/v1/admin/bulk/{id}.
+//
+//	@Summary		Get bulk operation progress
+//	@Description	Get the aggregate progress of a bulk operation started via POST /admin/bulk
+//	@Tags			admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		int	true	"Job ID"
+//	@Success		200	{object}	dto.BulkJobResponse
+//	@Failure		404	{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500	{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/admin/bulk/{id} [get]
+func (r *AdminRouter) GetBulkOperation(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	id, err := strconv.ParseInt(chi.URLParam(req, "id"), 10, 64)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	j, err := r.client.BulkOperations.Progress(ctx, id)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, dto.BulkJobResponse{Data: bulkJobToDTO(j)})
+}
+
+// RetryFailedEmbeddings handles POST /api/v1/admin/embeddings/retry.
+//
+//	@Summary		Retry failed embeddings
+//	@Description	Re-enqueue operation for every commit that owns a snippet with a failed embedding status for the given task. Handlers re-embed the whole commit and skip snippets that already succeeded, so already-succeeded snippets are not re-attempted
+//	@Tags			admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		dto.EmbeddingRetryCreateRequest	true	"Retry scope"
+//	@Success		202		{object}	dto.EmbeddingRetryResponse
+//	@Failure		400		{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500		{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/admin/embeddings/retry [post]
+func (r *AdminRouter) RetryFailedEmbeddings(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var body dto.EmbeddingRetryCreateRequest
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil && err != io.EOF {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	attrs := body.Data.Attributes
+	taskName := search.TaskName(attrs.Task)
+	operation := task.Operation(attrs.Operation)
+
+	enqueued, err := r.client.EmbeddingStatus.RetryFailed(ctx, taskName, operation)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusAccepted, dto.EmbeddingRetryResponse{
+		Data: dto.EmbeddingRetryData{
+			Type: "embedding_retries",
+			Attributes: dto.EmbeddingRetryAttributes{
+				CommitsEnqueued: enqueued,
+				Task:            attrs.Task,
+				Operation:       attrs.Operation,
+			},
+		},
+	})
+}
+
+// ValidateProviders handles POST /api/v1/admin/providers/validate.
+//
+//	@Summary		Validate provider configuration
+//	@Description	Perform a live round-trip against the configured embedding and enrichment endpoints (a small embed and a tiny completion), reporting latency, model, token usage, and (for embedding) the actual vector dimension returned. Intended for use during setup to catch misconfigured keys, unreachable endpoints, or a model swap that would change the embedding dimension before they surface as failed indexing or enrichment tasks
+//	@Tags			admin
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	dto.ProviderValidationResponse
+//	@Failure		500	{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/admin/providers/validate [post]
+func (r *AdminRouter) ValidateProviders(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	report := r.client.ProviderValidation.Validate(ctx)
+
+	middleware.WriteJSON(w, http.StatusOK, dto.ProviderValidationResponse{
+		Data: dto.ProviderValidationData{
+			Type: "provider_validations",
+			Attributes: dto.ProviderValidationAttributes{
+				Embedding:  endpointCheckToDTO(report.Embedding),
+				Enrichment: endpointCheckToDTO(report.Enrichment),
+			},
+		},
+	})
+}
+
+// CorpusDiff handles GET /api/v1/admin/corpus-diff.
+//
+//	@Summary		Diff two corpus generations
+//	@Description	Report snippets, enrichments, and vectors added, removed, and changed between two indexed commits ("generations") of a repository, to help debug why a search result disappeared or changed after the last sync
+//	@Tags			admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			repo_id		query		int		true	"Repository ID"
+//	@Param			from_gen	query		string	true	"Commit SHA of the earlier generation"
+//	@Param			to_gen		query		string	true	"Commit SHA of the later generation"
+//	@Success		200	{object}	dto.CorpusDiffResponse
+//	@Failure		400	{object}	middleware.JSONAPIErrorResponse
+//	@Failure		404	{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500	{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/admin/corpus-diff [get]
+func (r *AdminRouter) CorpusDiff(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	repoID, err := strconv.ParseInt(req.URL.Query().Get("repo_id"), 10, 64)
+	if err != nil {
+		middleware.WriteError(w, req, fmt.Errorf("invalid repo_id: %w", err), r.logger)
+		return
+	}
+	fromGen := req.URL.Query().Get("from_gen")
+	toGen := req.URL.Query().Get("to_gen")
+	if fromGen == "" || toGen == "" {
+		middleware.WriteError(w, req, fmt.Errorf("from_gen and to_gen are required"), r.logger)
+		return
+	}
+
+	report, err := r.client.CorpusDiff.Diff(ctx, repoID, fromGen, toGen)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, dto.CorpusDiffResponse{
+		Data: dto.CorpusDiffData{
+			Type:       "corpus_diffs",
+			Attributes: corpusDiffToDTO(report),
+		},
+	})
+}
+
+// ListWorkers handles GET /api/v1/admin/workers.
+//
+//	@Summary		List worker instances
+//	@Description	List queue worker processes currently registered against the shared task queue, including which task (if any) each is leasing and when it last reported a heartbeat
+//	@Tags			admin
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	dto.WorkerListResponse
+//	@Failure		500	{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/admin/workers [get]
+func (r *AdminRouter) ListWorkers(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	workers, err := r.client.Cluster.Workers(ctx)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	data := make([]dto.WorkerData, len(workers))
+	for i, wk := range workers {
+		data[i] = workerToDTO(wk)
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, dto.WorkerListResponse{Data: data})
+}
+
+// GetSchedule handles GET /api/v1/admin/schedule.
+//
+//	@Summary		Get upcoming scheduled work
+//	@Description	List predicted upcoming periodic work: next periodic sync per repository (which also expresses this codebase's per-repository refresh policy, since there is no separate refresh-policy engine) and the next janitor-style compaction sweep, with estimated enqueue times, so operators can predict load and verify the scheduler's behavior after config changes
+//	@Tags			admin
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	dto.ScheduleResponse
+//	@Failure		500	{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/admin/schedule [get]
+func (r *AdminRouter) GetSchedule(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	syncs, err := r.client.Schedule.UpcomingSyncs(ctx)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	syncAttrs := make([]dto.ScheduledSyncAttributes, len(syncs))
+	for i, s := range syncs {
+		syncAttrs[i] = dto.ScheduledSyncAttributes{
+			RepositoryID:  s.RepoID,
+			LastScannedAt: s.LastScannedAt,
+			NextSyncAt:    s.NextSyncAt,
+			Overdue:       s.Overdue,
+		}
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, dto.ScheduleResponse{
+		Data: dto.ScheduleData{
+			Type: "schedules",
+			Attributes: dto.ScheduleAttributes{
+				Syncs: syncAttrs,
+				Janitor: dto.ScheduledJanitorAttributes{
+					Enabled:   r.client.Compaction.Enabled(),
+					NextRunAt: r.client.Schedule.NextCompactionAt(),
+				},
+			},
+		},
+	})
+}
+
+// corpusDiffToDTO converts a domain CorpusDiffReport to its JSON:API representation.
+func corpusDiffToDTO(report service.CorpusDiffReport) dto.CorpusDiffAttributes {
+	return dto.CorpusDiffAttributes{
+		FromCommitSHA: report.FromCommitSHA,
+		ToCommitSHA:   report.ToCommitSHA,
+		Snippets:      diffCountsToDTO(report.Snippets),
+		Enrichments:   diffCountsToDTO(report.Enrichments),
+		Vectors:       diffCountsToDTO(report.Vectors),
+	}
+}
+
+// diffCountsToDTO converts a domain DiffCounts to its JSON:API representation.
+func diffCountsToDTO(c service.DiffCounts) dto.DiffCountsAttributes {
+	return dto.DiffCountsAttributes{
+		Added:   c.Added,
+		Removed: c.Removed,
+		Changed: c.Changed,
+	}
+}
+
+// endpointCheckToDTO converts a domain EndpointCheck to its JSON:API
+// representation, passing through nil for endpoints that aren't configured.
+func endpointCheckToDTO(c *service.EndpointCheck) *dto.EndpointCheckAttributes {
+	if c == nil {
+		return nil
+	}
+	return &dto.EndpointCheckAttributes{
+		OK:           c.OK,
+		Model:        c.Model,
+		LatencyMS:    c.Latency.Milliseconds(),
+		PromptTokens: c.PromptTokens,
+		TotalTokens:  c.TotalTokens,
+		Dimension:    c.Dimension,
+		Message:      c.Message,
+	}
+}
+
+// snapshotToDTO converts a domain Snapshot to its JSON:API representation.
+func snapshotToDTO(s snapshot.Snapshot) dto.SnapshotData {
+	return dto.SnapshotData{
+		Type: "snapshots",
+		ID:   strconv.FormatInt(s.ID(), 10),
+		Attributes: dto.SnapshotAttributes{
+			Label:     s.Label(),
+			Tables:    s.Tables(),
+			Rows:      s.Rows(),
+			CreatedAt: s.CreatedAt(),
+		},
+	}
+}
+
+// bulkJobToDTO converts a domain job.Job to its JSON:API representation.
+func bulkJobToDTO(j job.Job) dto.BulkJobData {
+	return dto.BulkJobData{
+		Type: "bulk_jobs",
+		ID:   strconv.FormatInt(j.ID(), 10),
+		Attributes: dto.BulkJobAttributes{
+			Kind:      string(j.Kind()),
+			Total:     j.Total(),
+			Completed: j.Completed(),
+			Failed:    j.Failed(),
+			Done:      j.Done(),
+			Error:     j.Error(),
+		},
+	}
+}
+
+// workerToDTO converts a domain cluster.Worker to its JSON:API representation.
+func workerToDTO(wk cluster.Worker) dto.WorkerData {
+	return dto.WorkerData{
+		Type: "workers",
+		ID:   strconv.FormatInt(wk.ID(), 10),
+		Attributes: dto.WorkerAttributes{
+			Hostname:        wk.Hostname(),
+			PID:             wk.PID(),
+			StartedAt:       wk.StartedAt(),
+			LastHeartbeat:   wk.LastHeartbeat(),
+			LeasedTaskID:    wk.LeasedTaskID(),
+			LeasedOperation: wk.LeasedOperation(),
+			ProcessedCount:  wk.ProcessedCount(),
+		},
+	}
+}
@@ -0,0 +1,187 @@
+package v1
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/rs/zerolog"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/helixml/kodit"
+	"github.com/helixml/kodit/domain/repository"
+	"github.com/helixml/kodit/domain/usage"
+	"github.com/helixml/kodit/infrastructure/api/middleware"
+	"github.com/helixml/kodit/infrastructure/api/v1/dto"
+)
+
+// AnalyticsRouter handles provider spend analytics endpoints.
+type AnalyticsRouter struct {
+	client *kodit.Client
+	logger zerolog.Logger
+}
+
+// NewAnalyticsRouter creates a new AnalyticsRouter.
+func NewAnalyticsRouter(client *kodit.Client) *AnalyticsRouter {
+	return &AnalyticsRouter{
+		client: client,
+		logger: client.Logger(),
+	}
+}
+
+// Routes returns the chi router for analytics endpoints.
+func (r *AnalyticsRouter) Routes() chi.Router {
+	router := chi.NewRouter()
+
+	router.Get("/provider-usage", r.ProviderUsage)
+	router.Get("/frequent-questions", r.FrequentQuestions)
+	router.Get("/budget-status", r.BudgetStatus)
+
+	return router
+}
+
+// ProviderUsage handles GET /api/v1/analytics/provider-usage.
+//
+//	@Summary		Provider usage report
+//	@Description	Aggregated provider (LLM and embedding) usage, tokens, and estimated cost, grouped by repository, operation, and model
+//	@Tags			analytics
+//	@Accept			json
+//	@Produce		json
+//	@Param			repository_id	query		string	false	"Filter by repository ID"
+//	@Param			operation		query		string	false	"Filter by operation (enrichment, embedding)"
+//	@Success		200				{object}	dto.ProviderUsageListResponse
+//	@Failure		500				{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/analytics/provider-usage [get]
+func (r *AnalyticsRouter) ProviderUsage(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var options []repository.Option
+	if repoID := req.URL.Query().Get("repository_id"); repoID != "" {
+		options = append(options, usage.WithRepositoryID(repoID))
+	}
+	if op := req.URL.Query().Get("operation"); op != "" {
+		options = append(options, usage.WithOperation(usage.Operation(op)))
+	}
+
+	summaries, err := r.client.Usage.Summarize(ctx, options...)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	data := make([]dto.ProviderUsageData, len(summaries))
+	for i, s := range summaries {
+		data[i] = dto.ProviderUsageData{
+			Type: "provider-usage",
+			ID:   fmt.Sprintf("%s:%s:%s", s.RepositoryID, s.Operation, s.Model),
+			Attributes: dto.ProviderUsageAttributes{
+				RepositoryID:     s.RepositoryID,
+				Operation:        string(s.Operation),
+				Model:            s.Model,
+				Calls:            s.Calls,
+				PromptTokens:     s.PromptTokens,
+				CompletionTokens: s.CompletionTokens,
+				TotalTokens:      s.TotalTokens,
+				CostEstimate:     s.CostEstimate,
+			},
+		}
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, dto.ProviderUsageListResponse{Data: data})
+}
+
+// FrequentQuestions handles GET /api/v1/analytics/frequent-questions.
+//
+// This surfaces recurring search queries as candidates for documentation
+// (e.g. cookbook or wiki pages); it does not itself feed the cookbook or
+// wiki generators — that wiring is a separate, larger change.
+//
+//	@Summary		Frequent questions report
+//	@Description	Recurring search queries, clustered by normalized text and ranked by frequency, for surfacing documentation candidates
+//	@Tags			analytics
+//	@Accept			json
+//	@Produce		json
+//	@Param			repository_id	query		string	false	"Filter by repository ID"
+//	@Param			limit			query		int		false	"Maximum number of results (default: all)"
+//	@Success		200				{object}	dto.FrequentQuestionListResponse
+//	@Failure		500				{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/analytics/frequent-questions [get]
+func (r *AnalyticsRouter) FrequentQuestions(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	repoID := req.URL.Query().Get("repository_id")
+
+	limit := 0
+	if l := req.URL.Query().Get("limit"); l != "" {
+		parsed, err := strconv.Atoi(l)
+		if err != nil {
+			middleware.WriteError(w, req, fmt.Errorf("invalid limit: %w", err), r.logger)
+			return
+		}
+		limit = parsed
+	}
+
+	questions, err := r.client.QueryLog.FrequentQuestions(ctx, repoID, limit)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	data := make([]dto.FrequentQuestionData, len(questions))
+	for i, q := range questions {
+		data[i] = dto.FrequentQuestionData{
+			Type: "frequent-question",
+			ID:   q.Normalized,
+			Attributes: dto.FrequentQuestionAttributes{
+				RepositoryID: repoID,
+				Query:        q.Sample,
+				Count:        q.Count,
+				LastAsked:    q.LastAsked,
+			},
+		}
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, dto.FrequentQuestionListResponse{Data: data})
+}
+
+// BudgetStatus handles GET /api/v1/analytics/budget-status.
+//
+//	@Summary		Provider budget status
+//	@Description	Today's recorded spend against each operation's configured daily token/cost budget, and how much headroom remains before it cuts off new calls
+//	@Tags			analytics
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	dto.BudgetStatusListResponse
+//	@Failure		500	{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/analytics/budget-status [get]
+func (r *AnalyticsRouter) BudgetStatus(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	statuses, err := r.client.Usage.BudgetStatuses(ctx)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	data := make([]dto.BudgetStatusData, len(statuses))
+	for i, s := range statuses {
+		data[i] = dto.BudgetStatusData{
+			Type: "budget-status",
+			ID:   string(s.Operation),
+			Attributes: dto.BudgetStatusAttributes{
+				Operation:        string(s.Operation),
+				MaxTokensPerDay:  s.Budget.MaxTokensPerDay(),
+				MaxCostPerDay:    s.Budget.MaxCostPerDay(),
+				TokensSpentToday: s.TokensSpent,
+				CostSpentToday:   s.CostSpent,
+				RemainingTokens:  s.RemainingTokens,
+				RemainingCost:    s.RemainingCost,
+			},
+		}
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, dto.BudgetStatusListResponse{Data: data})
+}
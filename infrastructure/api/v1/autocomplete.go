@@ -0,0 +1,119 @@
+package v1
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/rs/zerolog"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/helixml/kodit"
+	"github.com/helixml/kodit/infrastructure/api/middleware"
+	"github.com/helixml/kodit/infrastructure/api/v1/dto"
+)
+
+// AutocompleteRouter handles type-ahead suggestion endpoints.
+type AutocompleteRouter struct {
+	client *kodit.Client
+	logger zerolog.Logger
+}
+
+// NewAutocompleteRouter creates a new AutocompleteRouter.
+func NewAutocompleteRouter(client *kodit.Client) *AutocompleteRouter {
+	return &AutocompleteRouter{
+		client: client,
+		logger: client.Logger(),
+	}
+}
+
+// Routes returns the chi router for autocomplete endpoints.
+func (r *AutocompleteRouter) Routes() chi.Router {
+	router := chi.NewRouter()
+
+	router.Get("/repositories", r.Repositories)
+	router.Get("/paths", r.Paths)
+
+	return router
+}
+
+// Repositories handles GET /api/v1/autocomplete/repositories.
+//
+//	@Summary		Autocomplete repositories
+//	@Description	Suggest indexed repository URLs matching a partial query, for type-ahead UIs and MCP elicitation flows
+//	@Tags			autocomplete
+//	@Accept			json
+//	@Produce		json
+//	@Param			q		query		string	false	"Partial repository URL"
+//	@Param			limit	query		int		false	"Maximum suggestions (default 10)"
+//	@Success		200		{object}	dto.AutocompleteResponse
+//	@Failure		500		{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/autocomplete/repositories [get]
+func (r *AutocompleteRouter) Repositories(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	q := req.URL.Query().Get("q")
+	limit := parseAutocompleteLimit(req)
+
+	suggestions, err := r.client.Autocomplete.Repositories(ctx, q, limit)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, dto.AutocompleteResponse{
+		Data: dto.AutocompleteData{
+			Type:       "autocomplete-repositories",
+			Attributes: dto.AutocompleteAttributes{Suggestions: suggestions},
+		},
+	})
+}
+
+// Paths handles GET /api/v1/autocomplete/paths.
+//
+//	@Summary		Autocomplete file paths
+//	@Description	Suggest file paths within a repository matching a partial query, for type-ahead UIs and MCP elicitation flows
+//	@Tags			autocomplete
+//	@Accept			json
+//	@Produce		json
+//	@Param			repo_id	query		int		true	"Repository ID"
+//	@Param			q		query		string	false	"Partial file path"
+//	@Param			limit	query		int		false	"Maximum suggestions (default 10)"
+//	@Success		200		{object}	dto.AutocompleteResponse
+//	@Failure		400		{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500		{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/autocomplete/paths [get]
+func (r *AutocompleteRouter) Paths(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	repoID, err := strconv.ParseInt(req.URL.Query().Get("repo_id"), 10, 64)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	q := req.URL.Query().Get("q")
+	limit := parseAutocompleteLimit(req)
+
+	suggestions, err := r.client.Autocomplete.Paths(ctx, repoID, q, limit)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, dto.AutocompleteResponse{
+		Data: dto.AutocompleteData{
+			Type:       "autocomplete-paths",
+			Attributes: dto.AutocompleteAttributes{Suggestions: suggestions},
+		},
+	})
+}
+
+func parseAutocompleteLimit(req *http.Request) int {
+	limit, err := strconv.Atoi(req.URL.Query().Get("limit"))
+	if err != nil {
+		return 0
+	}
+	return limit
+}
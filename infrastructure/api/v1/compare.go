@@ -0,0 +1,100 @@
+package v1
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/rs/zerolog"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/helixml/kodit"
+	"github.com/helixml/kodit/application/service"
+	"github.com/helixml/kodit/infrastructure/api/middleware"
+	"github.com/helixml/kodit/infrastructure/api/v1/dto"
+)
+
+// CompareRouter handles repository comparison API endpoints.
+type CompareRouter struct {
+	client *kodit.Client
+	logger zerolog.Logger
+}
+
+// NewCompareRouter creates a new CompareRouter.
+func NewCompareRouter(client *kodit.Client) *CompareRouter {
+	return &CompareRouter{
+		client: client,
+		logger: client.Logger(),
+	}
+}
+
+// Routes returns the chi router for comparison endpoints.
+func (r *CompareRouter) Routes() chi.Router {
+	router := chi.NewRouter()
+
+	router.Get("/", r.Compare)
+
+	return router
+}
+
+// Compare handles GET /api/v1/compare.
+//
+//	@Summary		Compare repositories
+//	@Description	Report shared files, near-duplicate snippets, and shared Go module dependencies between two indexed repositories
+//	@Tags			compare
+//	@Accept			json
+//	@Produce		json
+//	@Param			repo_a	query		int	true	"First repository ID"
+//	@Param			repo_b	query		int	true	"Second repository ID"
+//	@Success		200		{object}	dto.CompareResponse
+//	@Failure		400		{object}	middleware.JSONAPIErrorResponse
+//	@Failure		404		{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500		{object}	middleware.JSONAPIErrorResponse
+//	@Router			/compare [get]
+func (r *CompareRouter) Compare(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	repoAID, err := strconv.ParseInt(req.URL.Query().Get("repo_a"), 10, 64)
+	if err != nil {
+		middleware.WriteError(w, req, fmt.Errorf("repo_a: %w", middleware.ErrValidation), r.logger)
+		return
+	}
+	repoBID, err := strconv.ParseInt(req.URL.Query().Get("repo_b"), 10, 64)
+	if err != nil {
+		middleware.WriteError(w, req, fmt.Errorf("repo_b: %w", middleware.ErrValidation), r.logger)
+		return
+	}
+
+	report, err := r.client.Comparisons.Compare(ctx, repoAID, repoBID)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, dto.CompareResponse{
+		Data: dto.CompareData{
+			Type:       "comparisons",
+			ID:         fmt.Sprintf("%d-%d", repoAID, repoBID),
+			Attributes: compareReportToDTO(report),
+		},
+	})
+}
+
+// compareReportToDTO converts a ComparisonReport to its JSON:API representation.
+func compareReportToDTO(report service.ComparisonReport) dto.CompareAttributes {
+	sharedFiles := make([]dto.SharedFileSchema, len(report.SharedFiles))
+	for i, f := range report.SharedFiles {
+		sharedFiles[i] = dto.SharedFileSchema{PathA: f.PathA, PathB: f.PathB, BlobSHA: f.BlobSHA}
+	}
+
+	duplicateSnippets := make([]dto.DuplicateSnippetSchema, len(report.DuplicateSnippets))
+	for i, s := range report.DuplicateSnippets {
+		duplicateSnippets[i] = dto.DuplicateSnippetSchema{SnippetIDA: s.SnippetIDA, SnippetIDB: s.SnippetIDB}
+	}
+
+	return dto.CompareAttributes{
+		SharedFiles:        sharedFiles,
+		DuplicateSnippets:  duplicateSnippets,
+		SharedDependencies: report.SharedDependencies,
+	}
+}
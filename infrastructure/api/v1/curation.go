@@ -0,0 +1,226 @@
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/rs/zerolog"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/helixml/kodit"
+	"github.com/helixml/kodit/domain/curation"
+	"github.com/helixml/kodit/infrastructure/api/middleware"
+	"github.com/helixml/kodit/infrastructure/api/v1/dto"
+)
+
+// CurationRouter handles search ranking curation rule API endpoints.
+type CurationRouter struct {
+	client *kodit.Client
+	logger zerolog.Logger
+}
+
+// NewCurationRouter creates a new CurationRouter.
+func NewCurationRouter(client *kodit.Client) *CurationRouter {
+	return &CurationRouter{
+		client: client,
+		logger: client.Logger(),
+	}
+}
+
+// Routes returns the chi router for curation rule endpoints.
+func (r *CurationRouter) Routes() chi.Router {
+	router := chi.NewRouter()
+
+	router.Get("/", r.List)
+	router.Post("/", r.Create)
+	router.Put("/{id}", r.Update)
+	router.Delete("/{id}", r.Delete)
+
+	return router
+}
+
+// List handles GET /api/v1/curation.
+//
+//	@Summary		List curation rules
+//	@Description	List maintainer-authored ranking rules that pin, boost, or bury snippets for matching queries
+//	@Tags			curation
+//	@Accept			json
+//	@Produce		json
+//	@Param			page		query		int	false	"Page number (default: 1)"
+//	@Param			page_size	query		int	false	"Results per page (default: 20, max: 100)"
+//	@Success		200			{object}	dto.CurationRuleListResponse
+//	@Failure		500			{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/curation [get]
+func (r *CurationRouter) List(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	pagination, err := ParsePagination(req)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	rules, err := r.client.Curation.List(ctx)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	data := make([]dto.CurationRuleData, len(rules))
+	for i, rule := range rules {
+		data[i] = curationRuleToDTO(rule)
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, dto.CurationRuleListResponse{
+		Data: data,
+		Meta: PaginationMeta(pagination, int64(len(rules))),
+	})
+}
+
+// Create handles POST /api/v1/curation.
+//
+//	@Summary		Create curation rule
+//	@Description	Add a rule that pins, boosts, or buries a snippet for queries matching a pattern
+//	@Tags			curation
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		dto.CurationRuleCreateRequest	true	"Curation rule to create"
+//	@Success		201		{object}	dto.CurationRuleResponse
+//	@Failure		400		{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500		{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/curation [post]
+func (r *CurationRouter) Create(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var body dto.CurationRuleCreateRequest
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	attrs := body.Data.Attributes
+	if attrs.Pattern == "" || attrs.SnippetID == "" {
+		middleware.WriteError(w, req, fmt.Errorf("pattern and snippet_id are required: %w", middleware.ErrValidation), r.logger)
+		return
+	}
+
+	action, err := parseCurationAction(attrs.Action)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	saved, err := r.client.Curation.Create(ctx, attrs.Pattern, attrs.SnippetID, action, attrs.Weight)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusCreated, dto.CurationRuleResponse{Data: curationRuleToDTO(saved)})
+}
+
+// Update handles PUT /api/v1/curation/{id}.
+//
+//	@Summary		Update curation rule
+//	@Description	Replace the action and weight of an existing curation rule
+//	@Tags			curation
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int								true	"Curation rule ID"
+//	@Param			body	body		dto.CurationRuleUpdateRequest	true	"Curation rule update"
+//	@Success		200		{object}	dto.CurationRuleResponse
+//	@Failure		400		{object}	middleware.JSONAPIErrorResponse
+//	@Failure		404		{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500		{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/curation/{id} [put]
+func (r *CurationRouter) Update(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	id, err := strconv.ParseInt(chi.URLParam(req, "id"), 10, 64)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	var body dto.CurationRuleUpdateRequest
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	action, err := parseCurationAction(body.Data.Attributes.Action)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	saved, err := r.client.Curation.Update(ctx, id, action, body.Data.Attributes.Weight)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, dto.CurationRuleResponse{Data: curationRuleToDTO(saved)})
+}
+
+// Delete handles DELETE /api/v1/curation/{id}.
+//
+//	@Summary		Delete curation rule
+//	@Description	Remove a curation rule
+//	@Tags			curation
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path	int	true	"Curation rule ID"
+//	@Success		204
+//	@Failure		404	{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500	{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/curation/{id} [delete]
+func (r *CurationRouter) Delete(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	id, err := strconv.ParseInt(chi.URLParam(req, "id"), 10, 64)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	if err := r.client.Curation.Delete(ctx, id); err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func parseCurationAction(action string) (curation.Action, error) {
+	switch curation.Action(action) {
+	case curation.ActionPin, curation.ActionBoost, curation.ActionBury:
+		return curation.Action(action), nil
+	default:
+		return "", fmt.Errorf("action must be one of pin, boost, bury: %w", middleware.ErrValidation)
+	}
+}
+
+func curationRuleToDTO(rule curation.Rule) dto.CurationRuleData {
+	return dto.CurationRuleData{
+		Type: "curation_rule",
+		ID:   rule.ID(),
+		Attributes: dto.CurationRuleAttributes{
+			Pattern:   rule.Pattern(),
+			SnippetID: rule.SnippetID(),
+			Action:    string(rule.Action()),
+			Weight:    rule.Weight(),
+			CreatedAt: rule.CreatedAt(),
+			UpdatedAt: rule.UpdatedAt(),
+		},
+		Links: dto.CurationRuleLinks{
+			Self: fmt.Sprintf("/api/v1/curation/%d", rule.ID()),
+		},
+	}
+}
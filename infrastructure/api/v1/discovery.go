@@ -0,0 +1,211 @@
+package v1
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/rs/zerolog"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/helixml/kodit"
+	"github.com/helixml/kodit/application/service"
+	"github.com/helixml/kodit/domain/discovery"
+	"github.com/helixml/kodit/infrastructure/api/middleware"
+	"github.com/helixml/kodit/infrastructure/api/v1/dto"
+)
+
+// DiscoveryRouter handles automatic repository discovery API endpoints: the
+// inbound webhook receiver and the manual review queue for candidates that
+// were not auto-registered.
+type DiscoveryRouter struct {
+	client *kodit.Client
+	logger zerolog.Logger
+}
+
+// NewDiscoveryRouter creates a new DiscoveryRouter.
+func NewDiscoveryRouter(client *kodit.Client) *DiscoveryRouter {
+	return &DiscoveryRouter{
+		client: client,
+		logger: client.Logger(),
+	}
+}
+
+// WebhookRoutes returns the chi router for the inbound webhook endpoint.
+// This is mounted separately from Routes because it authenticates via
+// HMAC signature rather than an API key.
+func (r *DiscoveryRouter) WebhookRoutes() chi.Router {
+	router := chi.NewRouter()
+
+	router.Post("/", r.Webhook)
+
+	return router
+}
+
+// Routes returns the chi router for the discovery review queue endpoints.
+func (r *DiscoveryRouter) Routes() chi.Router {
+	router := chi.NewRouter()
+
+	router.Get("/", r.List)
+	router.Post("/{id}/approve", r.Approve)
+	router.Post("/{id}/exclude", r.Exclude)
+
+	return router
+}
+
+// Webhook handles POST /api/v1/discovery/webhook.
+//
+//	@Summary		Receive discovery webhook
+//	@Description	Receive a "repository created" event from a Git hosting webhook firehose. The request body's HMAC-SHA256 signature is verified against the configured webhook secret; matching repositories are either registered immediately or queued for manual review, depending on configuration.
+//	@Tags			discovery
+//	@Accept			json
+//	@Produce		json
+//	@Param			X-Hub-Signature-256	header		string						true	"HMAC-SHA256 signature of the request body, prefixed with sha256="
+//	@Param			body				body		dto.DiscoveryWebhookPayload	true	"Repository created event"
+//	@Success		202					{object}	dto.DiscoveryWebhookResponse
+//	@Failure		400					{object}	middleware.JSONAPIErrorResponse
+//	@Failure		401					{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500					{object}	middleware.JSONAPIErrorResponse
+//	@Router			/discovery/webhook [post]
+func (r *DiscoveryRouter) Webhook(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	if err := r.client.Discovery.VerifySignature(body, req.Header.Get("X-Hub-Signature-256")); err != nil {
+		middleware.WriteError(w, req, middleware.NewAuthenticationError(err.Error()), r.logger)
+		return
+	}
+
+	var payload dto.DiscoveryWebhookPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	event := service.RepositoryEvent{
+		Org:       payload.Repository.Owner,
+		Name:      payload.Repository.Name,
+		RemoteURL: payload.Repository.CloneURL,
+		Topics:    payload.Repository.Topics,
+		Language:  payload.Repository.Language,
+	}
+
+	if _, err := r.client.Discovery.HandleEvent(ctx, event); err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusAccepted, dto.DiscoveryWebhookResponse{Status: "accepted"})
+}
+
+// List handles GET /api/v1/discovery.
+//
+//	@Summary		List discovery candidates
+//	@Description	List repositories surfaced by the discovery webhook that matched a configured name pattern but await manual review
+//	@Tags			discovery
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	dto.DiscoveryCandidateListResponse
+//	@Failure		500	{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/discovery [get]
+func (r *DiscoveryRouter) List(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	candidates, err := r.client.Discovery.ReviewQueue(ctx)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	data := make([]dto.DiscoveryCandidateData, len(candidates))
+	for i, candidate := range candidates {
+		data[i] = discoveryCandidateToDTO(candidate)
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, dto.DiscoveryCandidateListResponse{Data: data})
+}
+
+// Approve handles POST /api/v1/discovery/{id}/approve.
+//
+//	@Summary		Approve discovery candidate
+//	@Description	Register a pending discovery candidate as a tracked repository
+//	@Tags			discovery
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path	int	true	"Discovery candidate ID"
+//	@Success		204
+//	@Failure		404	{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500	{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/discovery/{id}/approve [post]
+func (r *DiscoveryRouter) Approve(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	id, err := strconv.ParseInt(chi.URLParam(req, "id"), 10, 64)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	if _, err := r.client.Discovery.Approve(ctx, id); err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Exclude handles POST /api/v1/discovery/{id}/exclude.
+//
+//	@Summary		Exclude discovery candidate
+//	@Description	Reject a pending discovery candidate without registering it
+//	@Tags			discovery
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path	int	true	"Discovery candidate ID"
+//	@Success		204
+//	@Failure		404	{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500	{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/discovery/{id}/exclude [post]
+func (r *DiscoveryRouter) Exclude(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	id, err := strconv.ParseInt(chi.URLParam(req, "id"), 10, 64)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	if err := r.client.Discovery.Exclude(ctx, id); err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func discoveryCandidateToDTO(candidate discovery.Candidate) dto.DiscoveryCandidateData {
+	return dto.DiscoveryCandidateData{
+		Type: "discovery_candidate",
+		ID:   candidate.ID(),
+		Attributes: dto.DiscoveryCandidateAttributes{
+			Org:            candidate.Org(),
+			Name:           candidate.Name(),
+			RemoteURL:      candidate.RemoteURL(),
+			Topics:         candidate.Topics(),
+			Language:       candidate.Language(),
+			MatchedPattern: candidate.MatchedPattern(),
+			Status:         string(candidate.Status()),
+			CreatedAt:      candidate.CreatedAt(),
+			UpdatedAt:      candidate.UpdatedAt(),
+		},
+	}
+}
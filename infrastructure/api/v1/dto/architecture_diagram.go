@@ -0,0 +1,17 @@
+package dto
+
+// ArchitectureDiagramAttributes holds the Mermaid source for an architecture diagram.
+type ArchitectureDiagramAttributes struct {
+	Mermaid string `json:"mermaid"`
+}
+
+// ArchitectureDiagramData represents an architecture diagram in JSON:API format.
+type ArchitectureDiagramData struct {
+	Type       string                        `json:"type"`
+	Attributes ArchitectureDiagramAttributes `json:"attributes"`
+}
+
+// ArchitectureDiagramResponse represents an architecture diagram response in JSON:API format.
+type ArchitectureDiagramResponse struct {
+	Data ArchitectureDiagramData `json:"data"`
+}
@@ -0,0 +1,17 @@
+package dto
+
+// AutocompleteAttributes holds a single type-ahead suggestion list.
+type AutocompleteAttributes struct {
+	Suggestions []string `json:"suggestions"`
+}
+
+// AutocompleteData represents an autocomplete result in JSON:API format.
+type AutocompleteData struct {
+	Type       string                 `json:"type"`
+	Attributes AutocompleteAttributes `json:"attributes"`
+}
+
+// AutocompleteResponse represents an autocomplete response in JSON:API format.
+type AutocompleteResponse struct {
+	Data AutocompleteData `json:"data"`
+}
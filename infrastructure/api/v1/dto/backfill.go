@@ -0,0 +1,35 @@
+package dto
+
+// BackfillCreateAttributes holds the attributes for a backfill create request.
+type BackfillCreateAttributes struct {
+	RepoIDs     []int64  `json:"repo_ids,omitempty"`
+	Operations  []string `json:"operations,omitempty"`
+	CommitDepth int      `json:"commit_depth,omitempty"`
+}
+
+// BackfillCreateData represents the data payload for a backfill create request.
+type BackfillCreateData struct {
+	Attributes BackfillCreateAttributes `json:"attributes"`
+}
+
+// BackfillCreateRequest represents the request body to start an enrichment backfill.
+type BackfillCreateRequest struct {
+	Data BackfillCreateData `json:"data"`
+}
+
+// BackfillAttributes holds attributes describing a backfill run's outcome.
+type BackfillAttributes struct {
+	CommitsEnqueued int      `json:"commits_enqueued"`
+	Operations      []string `json:"operations"`
+}
+
+// BackfillData represents a backfill run result in JSON:API format.
+type BackfillData struct {
+	Type       string             `json:"type"`
+	Attributes BackfillAttributes `json:"attributes"`
+}
+
+// BackfillResponse represents a backfill run response in JSON:API format.
+type BackfillResponse struct {
+	Data BackfillData `json:"data"`
+}
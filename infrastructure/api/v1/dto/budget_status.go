@@ -0,0 +1,27 @@
+package dto
+
+// BudgetStatusAttributes reports today's recorded spend against an
+// operation's configured daily budget.
+type BudgetStatusAttributes struct {
+	Operation        string  `json:"operation"`
+	MaxTokensPerDay  int     `json:"max_tokens_per_day,omitempty"`
+	MaxCostPerDay    float64 `json:"max_cost_per_day,omitempty"`
+	TokensSpentToday int     `json:"tokens_spent_today"`
+	CostSpentToday   float64 `json:"cost_spent_today"`
+	RemainingTokens  int     `json:"remaining_tokens"` // -1 if unenforced
+	RemainingCost    float64 `json:"remaining_cost"`   // -1 if unenforced
+}
+
+// BudgetStatusData represents a single operation's budget status in
+// JSON:API format.
+type BudgetStatusData struct {
+	Type       string                 `json:"type"`
+	ID         string                 `json:"id"`
+	Attributes BudgetStatusAttributes `json:"attributes"`
+}
+
+// BudgetStatusListResponse represents a list of budget statuses in
+// JSON:API format.
+type BudgetStatusListResponse struct {
+	Data []BudgetStatusData `json:"data"`
+}
@@ -0,0 +1,45 @@
+package dto
+
+// BulkCreateAttributes holds the attributes for a bulk operation request.
+// RepoIDs and RemoteURLLike are alternative ways to select target
+// repositories; EnrichmentSubtype and OlderThan apply only to the
+// delete_enrichments kind.
+type BulkCreateAttributes struct {
+	Kind              string  `json:"kind"`
+	RepoIDs           []int64 `json:"repo_ids,omitempty"`
+	RemoteURLLike     string  `json:"remote_url_like,omitempty"`
+	EnrichmentSubtype string  `json:"enrichment_subtype,omitempty"`
+	OlderThan         string  `json:"older_than,omitempty"`
+}
+
+// BulkCreateData represents the data payload for a bulk operation request.
+type BulkCreateData struct {
+	Attributes BulkCreateAttributes `json:"attributes"`
+}
+
+// BulkCreateRequest represents the request body to start an admin bulk operation.
+type BulkCreateRequest struct {
+	Data BulkCreateData `json:"data"`
+}
+
+// BulkJobAttributes holds attributes describing a bulk job's progress.
+type BulkJobAttributes struct {
+	Kind      string `json:"kind"`
+	Total     int    `json:"total"`
+	Completed int    `json:"completed"`
+	Failed    int    `json:"failed"`
+	Done      bool   `json:"done"`
+	Error     string `json:"error,omitempty"`
+}
+
+// BulkJobData represents a bulk job in JSON:API format.
+type BulkJobData struct {
+	Type       string            `json:"type"`
+	ID         string            `json:"id"`
+	Attributes BulkJobAttributes `json:"attributes"`
+}
+
+// BulkJobResponse represents a bulk job response in JSON:API format.
+type BulkJobResponse struct {
+	Data BulkJobData `json:"data"`
+}
@@ -0,0 +1,35 @@
+package dto
+
+// SharedFileSchema describes a file with identical content in both
+// compared repositories.
+type SharedFileSchema struct {
+	PathA   string `json:"path_a"`
+	PathB   string `json:"path_b"`
+	BlobSHA string `json:"blob_sha"`
+}
+
+// DuplicateSnippetSchema describes a pair of near-duplicate snippets found
+// in both compared repositories.
+type DuplicateSnippetSchema struct {
+	SnippetIDA string `json:"snippet_id_a"`
+	SnippetIDB string `json:"snippet_id_b"`
+}
+
+// CompareAttributes holds the comparison results in JSON:API format.
+type CompareAttributes struct {
+	SharedFiles        []SharedFileSchema       `json:"shared_files"`
+	DuplicateSnippets  []DuplicateSnippetSchema `json:"duplicate_snippets"`
+	SharedDependencies []string                 `json:"shared_dependencies"`
+}
+
+// CompareData represents the comparison resource in JSON:API format.
+type CompareData struct {
+	Type       string            `json:"type"`
+	ID         string            `json:"id"`
+	Attributes CompareAttributes `json:"attributes"`
+}
+
+// CompareResponse represents a repository comparison response in JSON:API format.
+type CompareResponse struct {
+	Data CompareData `json:"data"`
+}
@@ -0,0 +1,29 @@
+package dto
+
+// DiffCountsAttributes reports how many items were added, removed, or
+// changed between two corpus generations.
+type DiffCountsAttributes struct {
+	Added   int `json:"added"`
+	Removed int `json:"removed"`
+	Changed int `json:"changed"`
+}
+
+// CorpusDiffAttributes holds attributes describing a corpus diff result.
+type CorpusDiffAttributes struct {
+	FromCommitSHA string               `json:"from_commit_sha"`
+	ToCommitSHA   string               `json:"to_commit_sha"`
+	Snippets      DiffCountsAttributes `json:"snippets"`
+	Enrichments   DiffCountsAttributes `json:"enrichments"`
+	Vectors       DiffCountsAttributes `json:"vectors"`
+}
+
+// CorpusDiffData represents a corpus diff result in JSON:API format.
+type CorpusDiffData struct {
+	Type       string               `json:"type"`
+	Attributes CorpusDiffAttributes `json:"attributes"`
+}
+
+// CorpusDiffResponse represents a corpus diff response in JSON:API format.
+type CorpusDiffResponse struct {
+	Data CorpusDiffData `json:"data"`
+}
@@ -0,0 +1,78 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/helixml/kodit/infrastructure/api/jsonapi"
+)
+
+// CurationRuleAttributes represents curation rule attributes in JSON:API format.
+type CurationRuleAttributes struct {
+	Pattern   string    `json:"pattern"`
+	SnippetID string    `json:"snippet_id"`
+	Action    string    `json:"action"`
+	Weight    float64   `json:"weight"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CurationRuleLinks holds links for a curation rule resource.
+type CurationRuleLinks struct {
+	Self string `json:"self"`
+}
+
+// CurationRuleData represents curation rule data in JSON:API format.
+type CurationRuleData struct {
+	Type       string                 `json:"type"`
+	ID         int64                  `json:"id"`
+	Attributes CurationRuleAttributes `json:"attributes"`
+	Links      CurationRuleLinks      `json:"links"`
+}
+
+// CurationRuleResponse represents a single curation rule response in JSON:API format.
+type CurationRuleResponse struct {
+	Data CurationRuleData `json:"data"`
+}
+
+// CurationRuleListResponse represents a list of curation rules in JSON:API format.
+type CurationRuleListResponse struct {
+	Data  []CurationRuleData `json:"data"`
+	Meta  *jsonapi.Meta      `json:"meta,omitempty"`
+	Links *jsonapi.Links     `json:"links,omitempty"`
+}
+
+// CurationRuleCreateAttributes holds the attributes for creating a curation rule.
+type CurationRuleCreateAttributes struct {
+	Pattern   string  `json:"pattern"`
+	SnippetID string  `json:"snippet_id"`
+	Action    string  `json:"action"`
+	Weight    float64 `json:"weight"`
+}
+
+// CurationRuleCreateData holds the data for creating a curation rule.
+type CurationRuleCreateData struct {
+	Type       string                       `json:"type"`
+	Attributes CurationRuleCreateAttributes `json:"attributes"`
+}
+
+// CurationRuleCreateRequest represents a JSON:API request to create a curation rule.
+type CurationRuleCreateRequest struct {
+	Data CurationRuleCreateData `json:"data"`
+}
+
+// CurationRuleUpdateAttributes holds the attributes for updating a curation rule.
+type CurationRuleUpdateAttributes struct {
+	Action string  `json:"action"`
+	Weight float64 `json:"weight"`
+}
+
+// CurationRuleUpdateData holds the data for updating a curation rule.
+type CurationRuleUpdateData struct {
+	Type       string                       `json:"type"`
+	Attributes CurationRuleUpdateAttributes `json:"attributes"`
+}
+
+// CurationRuleUpdateRequest represents a JSON:API request to update a curation rule.
+type CurationRuleUpdateRequest struct {
+	Data CurationRuleUpdateData `json:"data"`
+}
@@ -0,0 +1,62 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/helixml/kodit/infrastructure/api/jsonapi"
+)
+
+// DiscoveryWebhookRepository holds the repository fields read from an
+// inbound "repository created" webhook event.
+type DiscoveryWebhookRepository struct {
+	Owner    string   `json:"owner"`
+	Name     string   `json:"name"`
+	CloneURL string   `json:"clone_url"`
+	Topics   []string `json:"topics,omitempty"`
+	Language string   `json:"language,omitempty"`
+}
+
+// DiscoveryWebhookPayload represents an inbound Git hosting webhook event.
+type DiscoveryWebhookPayload struct {
+	Action     string                     `json:"action"`
+	Repository DiscoveryWebhookRepository `json:"repository"`
+}
+
+// DiscoveryWebhookResponse acknowledges receipt of a discovery webhook event.
+type DiscoveryWebhookResponse struct {
+	Status string `json:"status"`
+}
+
+// DiscoveryCandidateAttributes represents a discovery candidate's attributes
+// in JSON:API format.
+type DiscoveryCandidateAttributes struct {
+	Org            string    `json:"org"`
+	Name           string    `json:"name"`
+	RemoteURL      string    `json:"remote_url"`
+	Topics         []string  `json:"topics,omitempty"`
+	Language       string    `json:"language,omitempty"`
+	MatchedPattern string    `json:"matched_pattern,omitempty"`
+	Status         string    `json:"status"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// DiscoveryCandidateData represents a discovery candidate in JSON:API format.
+type DiscoveryCandidateData struct {
+	Type       string                       `json:"type"`
+	ID         int64                        `json:"id"`
+	Attributes DiscoveryCandidateAttributes `json:"attributes"`
+}
+
+// DiscoveryCandidateResponse represents a single discovery candidate response.
+type DiscoveryCandidateResponse struct {
+	Data DiscoveryCandidateData `json:"data"`
+}
+
+// DiscoveryCandidateListResponse represents the discovery review queue in
+// JSON:API format.
+type DiscoveryCandidateListResponse struct {
+	Data  []DiscoveryCandidateData `json:"data"`
+	Meta  *jsonapi.Meta            `json:"meta,omitempty"`
+	Links *jsonapi.Links           `json:"links,omitempty"`
+}
@@ -0,0 +1,35 @@
+package dto
+
+// EmbeddingRetryCreateAttributes holds the attributes for an embedding retry request.
+type EmbeddingRetryCreateAttributes struct {
+	Task      string `json:"task"`      // One of "code", "text", "vision"
+	Operation string `json:"operation"` // Operation to re-enqueue for each commit with a failed embedding
+}
+
+// EmbeddingRetryCreateData represents the data payload for an embedding retry request.
+type EmbeddingRetryCreateData struct {
+	Attributes EmbeddingRetryCreateAttributes `json:"attributes"`
+}
+
+// EmbeddingRetryCreateRequest represents the request body to retry failed embeddings.
+type EmbeddingRetryCreateRequest struct {
+	Data EmbeddingRetryCreateData `json:"data"`
+}
+
+// EmbeddingRetryAttributes holds attributes describing an embedding retry run's outcome.
+type EmbeddingRetryAttributes struct {
+	CommitsEnqueued int    `json:"commits_enqueued"`
+	Task            string `json:"task"`
+	Operation       string `json:"operation"`
+}
+
+// EmbeddingRetryData represents an embedding retry run result in JSON:API format.
+type EmbeddingRetryData struct {
+	Type       string                   `json:"type"`
+	Attributes EmbeddingRetryAttributes `json:"attributes"`
+}
+
+// EmbeddingRetryResponse represents an embedding retry run response in JSON:API format.
+type EmbeddingRetryResponse struct {
+	Data EmbeddingRetryData `json:"data"`
+}
@@ -0,0 +1,38 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/helixml/kodit/infrastructure/api/jsonapi"
+)
+
+// ExampleAttributes represents example attributes in JSON:API format.
+// Examples are code samples extracted from documentation (enrichment.SubtypeExample).
+type ExampleAttributes struct {
+	Content   string    `json:"content"`
+	Language  string    `json:"language,omitempty"`
+	Path      string    `json:"path,omitempty"`
+	StartLine *int      `json:"start_line,omitempty"`
+	EndLine   *int      `json:"end_line,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ExampleData represents example data in JSON:API format.
+type ExampleData struct {
+	Type       string            `json:"type"`
+	ID         string            `json:"id"`
+	Attributes ExampleAttributes `json:"attributes"`
+}
+
+// ExampleJSONAPIResponse represents a single example in JSON:API format.
+type ExampleJSONAPIResponse struct {
+	Data ExampleData `json:"data"`
+}
+
+// ExampleJSONAPIListResponse represents a list of examples in JSON:API format.
+type ExampleJSONAPIListResponse struct {
+	Data  []ExampleData  `json:"data"`
+	Meta  *jsonapi.Meta  `json:"meta,omitempty"`
+	Links *jsonapi.Links `json:"links,omitempty"`
+}
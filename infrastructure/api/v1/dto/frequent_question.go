@@ -0,0 +1,23 @@
+package dto
+
+import "time"
+
+// FrequentQuestionAttributes holds a recurring search query cluster.
+type FrequentQuestionAttributes struct {
+	RepositoryID string    `json:"repository_id,omitempty"`
+	Query        string    `json:"query"`
+	Count        int       `json:"count"`
+	LastAsked    time.Time `json:"last_asked"`
+}
+
+// FrequentQuestionData represents a single frequent question in JSON:API format.
+type FrequentQuestionData struct {
+	Type       string                     `json:"type"`
+	ID         string                     `json:"id"`
+	Attributes FrequentQuestionAttributes `json:"attributes"`
+}
+
+// FrequentQuestionListResponse represents a list of frequent questions in JSON:API format.
+type FrequentQuestionListResponse struct {
+	Data []FrequentQuestionData `json:"data"`
+}
@@ -0,0 +1,20 @@
+package dto
+
+// ImpactFileSchema is the reference count for a single file.
+type ImpactFileSchema struct {
+	Path           string `json:"path"`
+	ReferenceCount int    `json:"reference_count"`
+}
+
+// ImpactRepoSchema is the reference count for a symbol within one repository.
+type ImpactRepoSchema struct {
+	RepositoryID   string             `json:"repository_id"`
+	RepositoryURL  string             `json:"repository_url"`
+	ReferenceCount int                `json:"reference_count"`
+	Files          []ImpactFileSchema `json:"files"`
+}
+
+// ImpactResponse is the response body for the rename impact analysis endpoint.
+type ImpactResponse struct {
+	Data []ImpactRepoSchema `json:"data"`
+}
@@ -0,0 +1,14 @@
+package dto
+
+import "github.com/helixml/kodit/infrastructure/onboarding"
+
+// OnboardingReportData represents an onboarding report in JSON:API format.
+type OnboardingReportData struct {
+	Type       string            `json:"type"`
+	Attributes onboarding.Report `json:"attributes"`
+}
+
+// OnboardingReportResponse represents an onboarding report response in JSON:API format.
+type OnboardingReportResponse struct {
+	Data OnboardingReportData `json:"data"`
+}
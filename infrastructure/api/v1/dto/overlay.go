@@ -0,0 +1,16 @@
+package dto
+
+// OverlaySnippetSchema represents a single chunk from a repository's
+// uncommitted working tree changes.
+type OverlaySnippetSchema struct {
+	Path      string `json:"path"`
+	Language  string `json:"language"`
+	Content   string `json:"content"`
+	StartLine int    `json:"start_line"`
+	EndLine   int    `json:"end_line"`
+}
+
+// OverlayResponse is the response body for the overlay endpoint.
+type OverlayResponse struct {
+	Data []OverlaySnippetSchema `json:"data"`
+}
@@ -0,0 +1,34 @@
+package dto
+
+import "time"
+
+// PRPreviewRequest requests indexing of a pull request branch ref for preview.
+type PRPreviewRequest struct {
+	Ref string `json:"ref"`
+}
+
+// PRPreviewAttributes represents a PR preview's attributes in JSON:API format.
+type PRPreviewAttributes struct {
+	RepoID        int64     `json:"repo_id"`
+	Ref           string    `json:"ref"`
+	HeadCommitSHA string    `json:"head_commit_sha"`
+	CreatedAt     time.Time `json:"created_at"`
+	ExpiresAt     time.Time `json:"expires_at"`
+}
+
+// PRPreviewData represents a PR preview in JSON:API format.
+type PRPreviewData struct {
+	Type       string              `json:"type"`
+	ID         int64               `json:"id"`
+	Attributes PRPreviewAttributes `json:"attributes"`
+}
+
+// PRPreviewResponse represents a single PR preview response.
+type PRPreviewResponse struct {
+	Data PRPreviewData `json:"data"`
+}
+
+// PRPreviewListResponse represents a repository's active PR previews.
+type PRPreviewListResponse struct {
+	Data []PRPreviewData `json:"data"`
+}
@@ -0,0 +1,31 @@
+package dto
+
+// EndpointCheckAttributes describes the outcome of a live round-trip
+// against one provider endpoint.
+type EndpointCheckAttributes struct {
+	OK           bool   `json:"ok"`
+	Model        string `json:"model,omitempty"`
+	LatencyMS    int64  `json:"latency_ms"`
+	PromptTokens int    `json:"prompt_tokens,omitempty"`
+	TotalTokens  int    `json:"total_tokens,omitempty"`
+	Dimension    int    `json:"dimension,omitempty"`
+	Message      string `json:"message,omitempty"`
+}
+
+// ProviderValidationAttributes holds the per-endpoint results of a provider
+// validation run. A nil field means that endpoint isn't configured.
+type ProviderValidationAttributes struct {
+	Embedding  *EndpointCheckAttributes `json:"embedding,omitempty"`
+	Enrichment *EndpointCheckAttributes `json:"enrichment,omitempty"`
+}
+
+// ProviderValidationData represents a provider validation result in JSON:API format.
+type ProviderValidationData struct {
+	Type       string                       `json:"type"`
+	Attributes ProviderValidationAttributes `json:"attributes"`
+}
+
+// ProviderValidationResponse represents a provider validation response in JSON:API format.
+type ProviderValidationResponse struct {
+	Data ProviderValidationData `json:"data"`
+}
@@ -55,6 +55,26 @@ type LegacyTaskListResponse struct {
 	TotalCount int                  `json:"total_count"`
 }
 
+// TaskLogAttributes represents a single captured task log entry in JSON:API
+// format.
+type TaskLogAttributes struct {
+	Level     string    `json:"level"`
+	Message   string    `json:"message"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// TaskLogData represents a task log entry in JSON:API format.
+type TaskLogData struct {
+	Type       string            `json:"type"`
+	Attributes TaskLogAttributes `json:"attributes"`
+}
+
+// TaskLogListResponse represents a task's captured log entries in JSON:API
+// format, oldest first.
+type TaskLogListResponse struct {
+	Data []TaskLogData `json:"data"`
+}
+
 // TaskStatusResponse represents a task status in API responses.
 type TaskStatusResponse struct {
 	ID           int64      `json:"id"`
@@ -9,17 +9,20 @@ import (
 
 // RepositoryAttributes represents repository attributes in JSON:API format.
 type RepositoryAttributes struct {
-	RemoteURI      string     `json:"remote_uri"`
-	UpstreamURL    string     `json:"upstream_url"` // The canonical upstream URL (e.g. github.com/org/repo); falls back to remote_uri when not set
-	PipelineID     int64      `json:"pipeline_id"`
-	CreatedAt      *time.Time `json:"created_at,omitempty"`
-	UpdatedAt      *time.Time `json:"updated_at,omitempty"`
-	LastScannedAt  *time.Time `json:"last_scanned_at,omitempty"`
-	ClonedPath     *string    `json:"cloned_path,omitempty"`
-	TrackingBranch *string    `json:"tracking_branch,omitempty"`
-	NumCommits     int        `json:"num_commits"`
-	NumBranches    int        `json:"num_branches"`
-	NumTags        int        `json:"num_tags"`
+	RemoteURI        string     `json:"remote_uri"`
+	UpstreamURL      string     `json:"upstream_url"` // The canonical upstream URL (e.g. github.com/org/repo); falls back to remote_uri when not set
+	PipelineID       int64      `json:"pipeline_id"`
+	CreatedAt        *time.Time `json:"created_at,omitempty"`
+	UpdatedAt        *time.Time `json:"updated_at,omitempty"`
+	LastScannedAt    *time.Time `json:"last_scanned_at,omitempty"`
+	ClonedPath       *string    `json:"cloned_path,omitempty"`
+	TrackingBranch   *string    `json:"tracking_branch,omitempty"`
+	NumCommits       int        `json:"num_commits"`
+	NumBranches      int        `json:"num_branches"`
+	NumTags          int        `json:"num_tags"`
+	ExcludedPatterns []string   `json:"excluded_patterns,omitempty"` // Effective exclusion patterns from the repository's .koditignore file, if any
+	HealthScore      *float64   `json:"health_score,omitempty"`      // Indexing health score from 0 (unhealthy) to 100 (fully healthy)
+	Archived         bool       `json:"archived"`                    // True if periodic sync and new enrichment work are halted; existing data remains searchable
 }
 
 // RepositoryLinks holds links for a repository resource.
@@ -116,9 +119,32 @@ type TaskStatusListResponse struct {
 
 // RepositoryStatusSummaryAttributes represents status summary attributes.
 type RepositoryStatusSummaryAttributes struct {
-	Status    string    `json:"status"`
-	Message   string    `json:"message"`
-	UpdatedAt time.Time `json:"updated_at"`
+	Status     string                    `json:"status"`
+	Message    string                    `json:"message"`
+	UpdatedAt  time.Time                 `json:"updated_at"`
+	Embeddings RepositoryEmbeddingCounts `json:"embeddings"`
+
+	// IntegrityStatus reports whether the tracked ref still matches its
+	// remote ("ok", "diverged", or "deleted_upstream").
+	IntegrityStatus string `json:"integrity_status"`
+	// IntegrityIssue describes a non-ok integrity status, empty otherwise.
+	IntegrityIssue string `json:"integrity_issue,omitempty"`
+}
+
+// RepositoryEmbeddingCounts reports embedding outcomes recorded for a
+// repository, broken down by embedding task. Snippets with no recorded
+// outcome are implicitly pending and are not counted here.
+type RepositoryEmbeddingCounts struct {
+	Code   EmbeddingTaskCounts `json:"code"`
+	Text   EmbeddingTaskCounts `json:"text"`
+	Vision EmbeddingTaskCounts `json:"vision"`
+}
+
+// EmbeddingTaskCounts reports the embedded and failed counts for a single
+// embedding task.
+type EmbeddingTaskCounts struct {
+	Embedded int64 `json:"embedded"`
+	Failed   int64 `json:"failed"`
 }
 
 // RepositoryStatusSummaryData represents status summary data in JSON:API format.
@@ -204,6 +230,169 @@ type ChunkingConfigUpdateRequest struct {
 	Data ChunkingConfigUpdateData `json:"data"`
 }
 
+// EnrichmentBudgetAttributes represents enrichment budget attributes in JSON:API format.
+type EnrichmentBudgetAttributes struct {
+	MaxFileSummaries int `json:"max_file_summaries"`
+}
+
+// EnrichmentBudgetData represents enrichment budget data in JSON:API format.
+type EnrichmentBudgetData struct {
+	Type       string                     `json:"type"`
+	Attributes EnrichmentBudgetAttributes `json:"attributes"`
+}
+
+// EnrichmentBudgetResponse represents an enrichment budget response in JSON:API format.
+type EnrichmentBudgetResponse struct {
+	Data EnrichmentBudgetData `json:"data"`
+}
+
+// EnrichmentBudgetUpdateData represents enrichment budget update data.
+type EnrichmentBudgetUpdateData struct {
+	Type       string                     `json:"type"`
+	Attributes EnrichmentBudgetAttributes `json:"attributes"`
+}
+
+// EnrichmentBudgetUpdateRequest represents an enrichment budget update request.
+type EnrichmentBudgetUpdateRequest struct {
+	Data EnrichmentBudgetUpdateData `json:"data"`
+}
+
+// EmbeddingConfigAttributes represents embedding configuration attributes in JSON:API format.
+type EmbeddingConfigAttributes struct {
+	StripComments bool `json:"strip_comments"`
+}
+
+// EmbeddingConfigData represents embedding configuration data in JSON:API format.
+type EmbeddingConfigData struct {
+	Type       string                    `json:"type"`
+	Attributes EmbeddingConfigAttributes `json:"attributes"`
+}
+
+// EmbeddingConfigResponse represents an embedding configuration response in JSON:API format.
+type EmbeddingConfigResponse struct {
+	Data EmbeddingConfigData `json:"data"`
+}
+
+// EmbeddingConfigUpdateData represents embedding configuration update data.
+type EmbeddingConfigUpdateData struct {
+	Type       string                    `json:"type"`
+	Attributes EmbeddingConfigAttributes `json:"attributes"`
+}
+
+// EmbeddingConfigUpdateRequest represents an embedding configuration update request.
+type EmbeddingConfigUpdateRequest struct {
+	Data EmbeddingConfigUpdateData `json:"data"`
+}
+
+// AccessConfigAttributes represents access control attributes in JSON:API format.
+type AccessConfigAttributes struct {
+	DenyGlobs []string `json:"deny_globs"`
+}
+
+// AccessConfigData represents access control data in JSON:API format.
+type AccessConfigData struct {
+	Type       string                 `json:"type"`
+	Attributes AccessConfigAttributes `json:"attributes"`
+}
+
+// AccessConfigResponse represents an access control response in JSON:API format.
+type AccessConfigResponse struct {
+	Data AccessConfigData `json:"data"`
+}
+
+// AccessConfigUpdateData represents access control update data.
+type AccessConfigUpdateData struct {
+	Type       string                 `json:"type"`
+	Attributes AccessConfigAttributes `json:"attributes"`
+}
+
+// AccessConfigUpdateRequest represents an access control update request.
+type AccessConfigUpdateRequest struct {
+	Data AccessConfigUpdateData `json:"data"`
+}
+
+// IndexFilterConfigAttributes represents index filter attributes in JSON:API format.
+type IndexFilterConfigAttributes struct {
+	IndexPaths  []string `json:"index_paths"`
+	IgnorePaths []string `json:"ignore_paths"`
+}
+
+// IndexFilterConfigData represents index filter data in JSON:API format.
+type IndexFilterConfigData struct {
+	Type       string                      `json:"type"`
+	Attributes IndexFilterConfigAttributes `json:"attributes"`
+}
+
+// IndexFilterConfigResponse represents an index filter response in JSON:API format.
+type IndexFilterConfigResponse struct {
+	Data IndexFilterConfigData `json:"data"`
+}
+
+// IndexFilterConfigUpdateData represents index filter update data.
+type IndexFilterConfigUpdateData struct {
+	Type       string                      `json:"type"`
+	Attributes IndexFilterConfigAttributes `json:"attributes"`
+}
+
+// IndexFilterConfigUpdateRequest represents an index filter update request.
+type IndexFilterConfigUpdateRequest struct {
+	Data IndexFilterConfigUpdateData `json:"data"`
+}
+
+// EnrichmentLanguageAttributes represents enrichment language attributes in JSON:API format.
+type EnrichmentLanguageAttributes struct {
+	Language string `json:"language"`
+}
+
+// EnrichmentLanguageData represents enrichment language data in JSON:API format.
+type EnrichmentLanguageData struct {
+	Type       string                       `json:"type"`
+	Attributes EnrichmentLanguageAttributes `json:"attributes"`
+}
+
+// EnrichmentLanguageResponse represents an enrichment language response in JSON:API format.
+type EnrichmentLanguageResponse struct {
+	Data EnrichmentLanguageData `json:"data"`
+}
+
+// EnrichmentLanguageUpdateData represents enrichment language update data.
+type EnrichmentLanguageUpdateData struct {
+	Type       string                       `json:"type"`
+	Attributes EnrichmentLanguageAttributes `json:"attributes"`
+}
+
+// EnrichmentLanguageUpdateRequest represents an enrichment language update request.
+type EnrichmentLanguageUpdateRequest struct {
+	Data EnrichmentLanguageUpdateData `json:"data"`
+}
+
+// AutoRepairTrackingAttributes represents auto-repair-tracking attributes in JSON:API format.
+type AutoRepairTrackingAttributes struct {
+	Enabled bool `json:"enabled"`
+}
+
+// AutoRepairTrackingData represents auto-repair-tracking data in JSON:API format.
+type AutoRepairTrackingData struct {
+	Type       string                       `json:"type"`
+	Attributes AutoRepairTrackingAttributes `json:"attributes"`
+}
+
+// AutoRepairTrackingResponse represents an auto-repair-tracking response in JSON:API format.
+type AutoRepairTrackingResponse struct {
+	Data AutoRepairTrackingData `json:"data"`
+}
+
+// AutoRepairTrackingUpdateData represents auto-repair-tracking update data.
+type AutoRepairTrackingUpdateData struct {
+	Type       string                       `json:"type"`
+	Attributes AutoRepairTrackingAttributes `json:"attributes"`
+}
+
+// AutoRepairTrackingUpdateRequest represents an auto-repair-tracking update request.
+type AutoRepairTrackingUpdateRequest struct {
+	Data AutoRepairTrackingUpdateData `json:"data"`
+}
+
 // PipelineConfigAttributes represents pipeline configuration attributes in JSON:API format.
 type PipelineConfigAttributes struct {
 	PipelineID int64 `json:"pipeline_id"`
@@ -227,6 +416,28 @@ type PipelineConfigResponse struct {
 	Included []PipelineData     `json:"included,omitempty"`
 }
 
+// ActivityEventAttributes represents a single activity feed entry in JSON:API format.
+type ActivityEventAttributes struct {
+	EventType string    `json:"event_type"`
+	Timestamp time.Time `json:"timestamp"`
+	Message   string    `json:"message"`
+	CommitSHA string    `json:"commit_sha,omitempty"`
+}
+
+// ActivityEventData represents an activity feed entry in JSON:API format.
+type ActivityEventData struct {
+	Type       string                  `json:"type"`
+	ID         string                  `json:"id"`
+	Attributes ActivityEventAttributes `json:"attributes"`
+}
+
+// ActivityFeedListResponse represents a paginated repository activity feed response.
+type ActivityFeedListResponse struct {
+	Data  []ActivityEventData `json:"data"`
+	Meta  *jsonapi.Meta       `json:"meta,omitempty"`
+	Links *jsonapi.Links      `json:"links,omitempty"`
+}
+
 // Legacy types for backwards compatibility during migration
 
 // RepositoryRequest represents a legacy request to add a repository.
@@ -0,0 +1,38 @@
+package dto
+
+import "time"
+
+// ScheduledSyncAttributes describes the predicted next periodic sync for a
+// single repository. Since periodic sync is this codebase's only
+// per-repository refresh policy, this also doubles as the "pending refresh
+// policy" view operators need.
+type ScheduledSyncAttributes struct {
+	RepositoryID  int64     `json:"repository_id"`
+	LastScannedAt time.Time `json:"last_scanned_at,omitempty"`
+	NextSyncAt    time.Time `json:"next_sync_at"`
+	Overdue       bool      `json:"overdue"`
+}
+
+// ScheduledJanitorAttributes describes the predicted next periodic
+// compaction sweep (orphaned vector/BM25 row cleanup).
+type ScheduledJanitorAttributes struct {
+	Enabled   bool      `json:"enabled"`
+	NextRunAt time.Time `json:"next_run_at,omitempty"`
+}
+
+// ScheduleAttributes holds all upcoming scheduled work.
+type ScheduleAttributes struct {
+	Syncs   []ScheduledSyncAttributes  `json:"syncs"`
+	Janitor ScheduledJanitorAttributes `json:"janitor"`
+}
+
+// ScheduleData represents upcoming scheduled work in JSON:API format.
+type ScheduleData struct {
+	Type       string             `json:"type"`
+	Attributes ScheduleAttributes `json:"attributes"`
+}
+
+// ScheduleResponse represents a schedule response in JSON:API format.
+type ScheduleResponse struct {
+	Data ScheduleData `json:"data"`
+}
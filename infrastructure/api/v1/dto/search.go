@@ -8,24 +8,34 @@ import (
 
 // SearchFilters represents search filters in JSON:API format.
 type SearchFilters struct {
-	Languages          []string   `json:"languages,omitempty"`
-	Authors            []string   `json:"authors,omitempty"`
-	StartDate          *time.Time `json:"start_date,omitempty"`
-	EndDate            *time.Time `json:"end_date,omitempty"`
-	Sources            []string   `json:"sources,omitempty"`
-	FilePatterns       []string   `json:"file_patterns,omitempty"`
-	EnrichmentTypes    []string   `json:"enrichment_types,omitempty"`
-	EnrichmentSubtypes []string   `json:"enrichment_subtypes,omitempty"`
-	CommitSHA          []string   `json:"commit_sha,omitempty"`
+	Languages           []string   `json:"languages,omitempty"`
+	Authors             []string   `json:"authors,omitempty"`
+	StartDate           *time.Time `json:"start_date,omitempty"`
+	EndDate             *time.Time `json:"end_date,omitempty"`
+	Sources             []string   `json:"sources,omitempty"`
+	FilePatterns        []string   `json:"file_patterns,omitempty"`
+	EnrichmentTypes     []string   `json:"enrichment_types,omitempty"`
+	EnrichmentSubtypes  []string   `json:"enrichment_subtypes,omitempty"`
+	CommitSHA           []string   `json:"commit_sha,omitempty"`
+	ExcludeKeywords     []string   `json:"exclude_keywords,omitempty"`
+	ExcludePathPrefixes []string   `json:"exclude_path_prefixes,omitempty"`
+	ExcludeRepoIDs      []string   `json:"exclude_repo_ids,omitempty"`
+	PRRef               string     `json:"pr_ref,omitempty"`
 }
 
 // SearchAttributes represents search request attributes in JSON:API format.
 type SearchAttributes struct {
-	Keywords []string       `json:"keywords,omitempty"`
-	Code     *string        `json:"code,omitempty"`
-	Text     *string        `json:"text,omitempty"`
-	Limit    *int           `json:"limit,omitempty"`
-	Filters  *SearchFilters `json:"filters,omitempty"`
+	Keywords       []string       `json:"keywords,omitempty"`
+	Code           *string        `json:"code,omitempty"`
+	Text           *string        `json:"text,omitempty"`
+	Limit          *int           `json:"limit,omitempty"`
+	Filters        *SearchFilters `json:"filters,omitempty"`
+	GroupBy        *string        `json:"group_by,omitempty"`
+	Namespace      *string        `json:"namespace,omitempty"`
+	Watermark      *bool          `json:"watermark,omitempty"`
+	Highlight      *bool          `json:"highlight,omitempty"`
+	SemanticWeight *float64       `json:"semantic_weight,omitempty"`
+	AutoWeight     *bool          `json:"auto_weight,omitempty"`
 }
 
 // SearchData represents search request data in JSON:API format.
@@ -39,13 +49,34 @@ type SearchRequest struct {
 	Data SearchData `json:"data"`
 }
 
+// SimilarAttributes represents similar-code search request attributes in
+// JSON:API format.
+type SimilarAttributes struct {
+	Code    string         `json:"code"`
+	Limit   *int           `json:"limit,omitempty"`
+	Filters *SearchFilters `json:"filters,omitempty"`
+}
+
+// SimilarData represents similar-code search request data in JSON:API format.
+type SimilarData struct {
+	Type       string            `json:"type"`
+	Attributes SimilarAttributes `json:"attributes"`
+}
+
+// SimilarRequest represents a JSON:API request to find snippets similar to a
+// provided code block.
+type SimilarRequest struct {
+	Data SimilarData `json:"data"`
+}
+
 // SnippetContentSchema represents snippet content in search results.
 type SnippetContentSchema struct {
-	Value     string `json:"value"`
-	Language  string `json:"language"`
-	StartLine *int   `json:"start_line,omitempty"`
-	EndLine   *int   `json:"end_line,omitempty"`
-	Page      *int   `json:"page,omitempty"`
+	Value     string  `json:"value"`
+	Language  string  `json:"language"`
+	StartLine *int    `json:"start_line,omitempty"`
+	EndLine   *int    `json:"end_line,omitempty"`
+	Page      *int    `json:"page,omitempty"`
+	HTML      *string `json:"html,omitempty"`
 }
 
 // EnrichmentSchema represents an enrichment in search results.
@@ -54,13 +85,33 @@ type EnrichmentSchema struct {
 	Content string `json:"content"`
 }
 
+// SnippetMetricsSchema represents readability and complexity metrics for a snippet.
+type SnippetMetricsSchema struct {
+	CyclomaticComplexity int `json:"cyclomatic_complexity"`
+	NestingDepth         int `json:"nesting_depth"`
+	LineCount            int `json:"line_count"`
+}
+
 // SnippetAttributes represents snippet attributes in search results.
 type SnippetAttributes struct {
-	CreatedAt      *time.Time           `json:"created_at,omitempty"`
-	UpdatedAt      *time.Time           `json:"updated_at,omitempty"`
-	Content        SnippetContentSchema `json:"content"`
-	Enrichments    []EnrichmentSchema   `json:"enrichments"`
-	OriginalScores []float64            `json:"original_scores"`
+	CreatedAt      *time.Time            `json:"created_at,omitempty"`
+	UpdatedAt      *time.Time            `json:"updated_at,omitempty"`
+	Content        SnippetContentSchema  `json:"content"`
+	Enrichments    []EnrichmentSchema    `json:"enrichments"`
+	OriginalScores []float64             `json:"original_scores"`
+	Metrics        *SnippetMetricsSchema `json:"metrics,omitempty"`
+	Watermark      *ProvenanceWatermark  `json:"watermark,omitempty"`
+}
+
+// ProvenanceWatermark records where a search result came from, so a
+// downstream pipeline that exports results (e.g. for LLM grounding or
+// training data) can trace content back to its source. License is left
+// for a future request — the repository model does not track licenses yet.
+type ProvenanceWatermark struct {
+	RepoURL     string    `json:"repo_url"`
+	CommitSHA   string    `json:"commit_sha"`
+	RetrievedAt time.Time `json:"retrieved_at"`
+	QueryHash   string    `json:"query_hash"`
 }
 
 // SnippetLinks holds API path links for a search result snippet.
@@ -81,6 +132,75 @@ type SnippetData struct {
 // SearchResponse represents a search API response in JSON:API format.
 type SearchResponse struct {
 	Data []SnippetData `json:"data"`
+	Meta *jsonapi.Meta `json:"meta,omitempty"`
+}
+
+// SnippetJSONAPIResponse represents a single snippet in JSON:API format.
+type SnippetJSONAPIResponse struct {
+	Data SnippetData `json:"data"`
+}
+
+// SearchStreamEvent represents one message of a streamed search response.
+// A stream carries a "partial" event with fast keyword-only results,
+// followed by a "final" event once the refined hybrid results are ready, or
+// an "error" event in place of "final" if the refined search fails. Data
+// holds a SearchResponse or GroupedSearchResponse depending on the request's
+// group_by, matching whichever shape POST /search would have returned.
+type SearchStreamEvent struct {
+	Stage string `json:"stage"`
+	Data  any    `json:"data,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+// SnippetLineRange identifies one matched range of lines within a grouped
+// file result.
+type SnippetLineRange struct {
+	StartLine int `json:"start_line"`
+	EndLine   int `json:"end_line"`
+}
+
+// GroupedSnippetAttributes represents the aggregated attributes of every
+// snippet that matched within a single file, produced when a search
+// request sets group_by=file.
+type GroupedSnippetAttributes struct {
+	Path            string             `json:"path"`
+	Language        string             `json:"language,omitempty"`
+	MatchCount      int                `json:"match_count"`
+	AggregatedScore float64            `json:"aggregated_score"`
+	LineRanges      []SnippetLineRange `json:"line_ranges,omitempty"`
+}
+
+// GroupedSnippetData represents one file's aggregated search result.
+type GroupedSnippetData struct {
+	Type       string                   `json:"type"`
+	ID         string                   `json:"id"`
+	Attributes GroupedSnippetAttributes `json:"attributes"`
+	Links      *SnippetLinks            `json:"links,omitempty"`
+}
+
+// GroupedSearchResponse represents a file-grouped search API response,
+// returned in place of SearchResponse when group_by=file is requested.
+type GroupedSearchResponse struct {
+	Data []GroupedSnippetData `json:"data"`
+	Meta *jsonapi.Meta        `json:"meta,omitempty"`
+}
+
+// SearchTraceStepSchema represents one recorded stage of a debugged search
+// pipeline (query expansion, candidate retrieval, fusion, reranking, or the
+// final ordering).
+type SearchTraceStepSchema struct {
+	Stage       string   `json:"stage"`
+	Description string   `json:"description"`
+	Count       int      `json:"count"`
+	SampleIDs   []string `json:"sample_ids,omitempty"`
+}
+
+// SearchDebugResponse represents the result of POST /search/debug: the same
+// results POST /search would return, plus the step-by-step trace that
+// produced them.
+type SearchDebugResponse struct {
+	Result any                     `json:"result"`
+	Trace  []SearchTraceStepSchema `json:"trace"`
 }
 
 // SnippetListResponse represents a list of snippets in JSON:API format.
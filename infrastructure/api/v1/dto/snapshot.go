@@ -0,0 +1,43 @@
+package dto
+
+import "time"
+
+// SnapshotCreateAttributes holds the attributes for a snapshot create request.
+type SnapshotCreateAttributes struct {
+	Label string `json:"label"`
+}
+
+// SnapshotCreateData represents the data payload for a snapshot create request.
+type SnapshotCreateData struct {
+	Attributes SnapshotCreateAttributes `json:"attributes"`
+}
+
+// SnapshotCreateRequest represents the request body to capture a snapshot.
+type SnapshotCreateRequest struct {
+	Data SnapshotCreateData `json:"data"`
+}
+
+// SnapshotAttributes holds attributes for a snapshot in JSON:API format.
+type SnapshotAttributes struct {
+	Label     string    `json:"label"`
+	Tables    int       `json:"tables"`
+	Rows      int       `json:"rows"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// SnapshotData represents a single snapshot in JSON:API format.
+type SnapshotData struct {
+	Type       string             `json:"type"`
+	ID         string             `json:"id"`
+	Attributes SnapshotAttributes `json:"attributes"`
+}
+
+// SnapshotResponse represents a single snapshot response in JSON:API format.
+type SnapshotResponse struct {
+	Data SnapshotData `json:"data"`
+}
+
+// SnapshotListResponse represents a list of snapshots in JSON:API format.
+type SnapshotListResponse struct {
+	Data []SnapshotData `json:"data"`
+}
@@ -0,0 +1,75 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/helixml/kodit/infrastructure/api/jsonapi"
+)
+
+// SynonymAttributes represents synonym attributes in JSON:API format.
+type SynonymAttributes struct {
+	Namespace string    `json:"namespace"`
+	Term      string    `json:"term"`
+	Aliases   []string  `json:"aliases"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// SynonymLinks holds links for a synonym resource.
+type SynonymLinks struct {
+	Self string `json:"self"`
+}
+
+// SynonymData represents synonym data in JSON:API format.
+type SynonymData struct {
+	Type       string            `json:"type"`
+	ID         int64             `json:"id"`
+	Attributes SynonymAttributes `json:"attributes"`
+	Links      SynonymLinks      `json:"links"`
+}
+
+// SynonymResponse represents a single synonym response in JSON:API format.
+type SynonymResponse struct {
+	Data SynonymData `json:"data"`
+}
+
+// SynonymListResponse represents a list of synonyms in JSON:API format.
+type SynonymListResponse struct {
+	Data  []SynonymData  `json:"data"`
+	Meta  *jsonapi.Meta  `json:"meta,omitempty"`
+	Links *jsonapi.Links `json:"links,omitempty"`
+}
+
+// SynonymCreateAttributes holds the attributes for creating a synonym.
+type SynonymCreateAttributes struct {
+	Namespace string   `json:"namespace"`
+	Term      string   `json:"term"`
+	Aliases   []string `json:"aliases"`
+}
+
+// SynonymCreateData holds the data for creating a synonym.
+type SynonymCreateData struct {
+	Type       string                  `json:"type"`
+	Attributes SynonymCreateAttributes `json:"attributes"`
+}
+
+// SynonymCreateRequest represents a JSON:API request to create a synonym.
+type SynonymCreateRequest struct {
+	Data SynonymCreateData `json:"data"`
+}
+
+// SynonymUpdateAttributes holds the attributes for updating a synonym.
+type SynonymUpdateAttributes struct {
+	Aliases []string `json:"aliases"`
+}
+
+// SynonymUpdateData holds the data for updating a synonym.
+type SynonymUpdateData struct {
+	Type       string                  `json:"type"`
+	Attributes SynonymUpdateAttributes `json:"attributes"`
+}
+
+// SynonymUpdateRequest represents a JSON:API request to update a synonym.
+type SynonymUpdateRequest struct {
+	Data SynonymUpdateData `json:"data"`
+}
@@ -0,0 +1,26 @@
+package dto
+
+// ProviderUsageAttributes holds aggregated provider usage for one
+// repository, operation, and model combination.
+type ProviderUsageAttributes struct {
+	RepositoryID     string  `json:"repository_id"`
+	Operation        string  `json:"operation"`
+	Model            string  `json:"model"`
+	Calls            int     `json:"calls"`
+	PromptTokens     int     `json:"prompt_tokens"`
+	CompletionTokens int     `json:"completion_tokens"`
+	TotalTokens      int     `json:"total_tokens"`
+	CostEstimate     float64 `json:"cost_estimate"`
+}
+
+// ProviderUsageData represents a single provider usage summary in JSON:API format.
+type ProviderUsageData struct {
+	Type       string                  `json:"type"`
+	ID         string                  `json:"id"`
+	Attributes ProviderUsageAttributes `json:"attributes"`
+}
+
+// ProviderUsageListResponse represents a list of provider usage summaries in JSON:API format.
+type ProviderUsageListResponse struct {
+	Data []ProviderUsageData `json:"data"`
+}
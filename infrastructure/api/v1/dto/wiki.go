@@ -12,3 +12,16 @@ type WikiTreeNode struct {
 type WikiTreeResponse struct {
 	Data []WikiTreeNode `json:"data"`
 }
+
+// WikiSearchResult is a single wiki page match from a wiki search.
+type WikiSearchResult struct {
+	Slug    string  `json:"slug"`
+	Title   string  `json:"title"`
+	Content string  `json:"content"`
+	Score   float64 `json:"score"`
+}
+
+// WikiSearchResponse is the JSON response for the wiki search endpoint.
+type WikiSearchResponse struct {
+	Data []WikiSearchResult `json:"data"`
+}
@@ -0,0 +1,27 @@
+package dto
+
+import "time"
+
+// WorkerAttributes holds attributes for a registered worker instance in
+// JSON:API format.
+type WorkerAttributes struct {
+	Hostname        string    `json:"hostname"`
+	PID             int       `json:"pid"`
+	StartedAt       time.Time `json:"started_at"`
+	LastHeartbeat   time.Time `json:"last_heartbeat"`
+	LeasedTaskID    int64     `json:"leased_task_id,omitempty"`
+	LeasedOperation string    `json:"leased_operation,omitempty"`
+	ProcessedCount  int64     `json:"processed_count"`
+}
+
+// WorkerData represents a single worker instance in JSON:API format.
+type WorkerData struct {
+	Type       string           `json:"type"`
+	ID         string           `json:"id"`
+	Attributes WorkerAttributes `json:"attributes"`
+}
+
+// WorkerListResponse represents a list of worker instances in JSON:API format.
+type WorkerListResponse struct {
+	Data []WorkerData `json:"data"`
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/helixml/kodit/domain/enrichment"
 	"github.com/helixml/kodit/domain/repository"
 	"github.com/helixml/kodit/domain/sourcelocation"
+	"github.com/helixml/kodit/infrastructure/api/jsonapi"
 	"github.com/helixml/kodit/infrastructure/api/middleware"
 	"github.com/helixml/kodit/infrastructure/api/v1/dto"
 )
@@ -40,6 +41,7 @@ func (r *EnrichmentsRouter) Routes() chi.Router {
 	router.Get("/{id}", r.Get)
 	router.Patch("/{id}", r.Update)
 	router.Delete("/{id}", r.Delete)
+	router.Delete("/{id}/override", r.Reset)
 
 	return router
 }
@@ -56,6 +58,8 @@ func (r *EnrichmentsRouter) Routes() chi.Router {
 //	@Param			enrichment_subtype	query		string	false	"Filter by enrichment subtype"
 //	@Param			page				query		int		false	"Page number (default: 1)"
 //	@Param			page_size			query		int		false	"Results per page (default: 20, max: 100)"
+//	@Param			fields[enrichment]	query		string	false	"Comma-separated sparse fieldset, e.g. type,subtype,created_at"
+//	@Param			exclude_content		query		bool	false	"Omit the content field from each resource"
 //	@Success		200					{object}	dto.EnrichmentJSONAPIListResponse
 //	@Failure		500					{object}	middleware.JSONAPIErrorResponse
 //	@Security		APIKeyAuth
@@ -98,6 +102,12 @@ func (r *EnrichmentsRouter) List(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	enrichments, err = r.client.Enrichments.ResolveOverrides(ctx, enrichments)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
 	ids := make([]int64, len(enrichments))
 	for i, e := range enrichments {
 		ids[i] = e.ID()
@@ -108,8 +118,20 @@ func (r *EnrichmentsRouter) List(w http.ResponseWriter, req *http.Request) {
 		lineRanges = map[string]sourcelocation.SourceLocation{}
 	}
 
-	response := dto.EnrichmentJSONAPIListResponse{
-		Data:  enrichmentsToJSONAPIDTO(enrichments, lineRanges),
+	data := enrichmentsToJSONAPIDTO(enrichments, lineRanges)
+
+	filteredData, err := filterEnrichmentFields(req, data)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	response := struct {
+		Data  any            `json:"data"`
+		Meta  *jsonapi.Meta  `json:"meta,omitempty"`
+		Links *jsonapi.Links `json:"links,omitempty"`
+	}{
+		Data:  filteredData,
 		Meta:  PaginationMeta(pagination, total),
 		Links: PaginationLinks(req, pagination, total),
 	}
@@ -124,10 +146,13 @@ func (r *EnrichmentsRouter) List(w http.ResponseWriter, req *http.Request) {
 //	@Tags			enrichments
 //	@Accept			json
 //	@Produce		json
-//	@Param			id	path		int	true	"Enrichment ID"
-//	@Success		200	{object}	dto.EnrichmentJSONAPIResponse
-//	@Failure		404	{object}	middleware.JSONAPIErrorResponse
-//	@Failure		500	{object}	middleware.JSONAPIErrorResponse
+//	@Param			id					path		int		true	"Enrichment ID"
+//	@Param			lang				query		string	false	"Target language code (e.g. ja, fr); returns a cached or newly generated translation instead of the original content"
+//	@Param			fields[enrichment]	query		string	false	"Comma-separated sparse fieldset, e.g. type,subtype,created_at"
+//	@Param			exclude_content		query		bool	false	"Omit the content field from the resource"
+//	@Success		200					{object}	dto.EnrichmentJSONAPIResponse
+//	@Failure		404					{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500					{object}	middleware.JSONAPIErrorResponse
 //	@Security		APIKeyAuth
 //	@Router			/enrichments/{id} [get]
 func (r *EnrichmentsRouter) Get(w http.ResponseWriter, req *http.Request) {
@@ -146,14 +171,39 @@ func (r *EnrichmentsRouter) Get(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	resolved, err := r.client.Enrichments.ResolveOverrides(ctx, []enrichment.Enrichment{e})
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+	e = resolved[0]
+
+	if lang := req.URL.Query().Get("lang"); lang != "" {
+		translated, err := r.client.Translations.Translate(ctx, id, lang)
+		if err != nil {
+			middleware.WriteError(w, req, err, r.logger)
+			return
+		}
+		e = translated
+	}
+
 	lineRanges, err := r.client.Enrichments.SourceLocations(ctx, []int64{id})
 	if err != nil {
 		r.logger.Warn().Err(err).Msg("failed to fetch line ranges")
 		lineRanges = map[string]sourcelocation.SourceLocation{}
 	}
 
-	middleware.WriteJSON(w, http.StatusOK, dto.EnrichmentJSONAPIResponse{
-		Data: enrichmentToJSONAPIDTO(e, lineRanges),
+	data := enrichmentToJSONAPIDTO(e, lineRanges)
+	filteredData, err := filterEnrichmentFields(req, []dto.EnrichmentData{data})
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, struct {
+		Data any `json:"data"`
+	}{
+		Data: filteredData[0],
 	})
 }
 
@@ -165,6 +215,31 @@ func enrichmentsToJSONAPIDTO(enrichments []enrichment.Enrichment, lineRanges map
 	return result
 }
 
+// filterEnrichmentFields applies JSON:API sparse fieldsets ("fields[enrichment]=...")
+// and the "exclude_content=true" shortcut to a set of enrichment resources,
+// returning each as a generic map so only the requested attribute keys survive.
+func filterEnrichmentFields(req *http.Request, data []dto.EnrichmentData) ([]map[string]any, error) {
+	fields := ParseFieldsParam(req, "enrichment")
+	excludeContent := ExcludeContent(req)
+
+	result := make([]map[string]any, len(data))
+	for i, d := range data {
+		attrs, err := FilterJSONFields(d.Attributes, fields)
+		if err != nil {
+			return nil, fmt.Errorf("filter enrichment attributes: %w", err)
+		}
+		if excludeContent {
+			delete(attrs, "content")
+		}
+		result[i] = map[string]any{
+			"type":       d.Type,
+			"id":         d.ID,
+			"attributes": attrs,
+		}
+	}
+	return result, nil
+}
+
 func enrichmentToJSONAPIDTO(e enrichment.Enrichment, lineRanges map[string]sourcelocation.SourceLocation) dto.EnrichmentData {
 	attrs := dto.EnrichmentAttributes{
 		Type:      string(e.Type()),
@@ -198,7 +273,7 @@ func enrichmentToJSONAPIDTO(e enrichment.Enrichment, lineRanges map[string]sourc
 // Update handles PATCH /api/v1/enrichments/{id}.
 //
 //	@Summary		Update enrichment
-//	@Description	Update an enrichment's content
+//	@Description	Store an edited version of an enrichment's content as a human override linked to the original. The original is kept so regeneration logic is unaffected and Reset can restore it; read APIs always prefer the override.
 //	@Tags			enrichments
 //	@Accept			json
 //	@Produce		json
@@ -225,13 +300,17 @@ func (r *EnrichmentsRouter) Update(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	existing, err := r.client.Enrichments.Get(ctx, repository.WithID(id))
-	if err != nil {
+	if _, err := r.client.Enrichments.Override(ctx, id, body.Data.Attributes.Content); err != nil {
 		middleware.WriteError(w, req, err, r.logger)
 		return
 	}
 
-	saved, err := r.client.Enrichments.Save(ctx, existing.WithContent(body.Data.Attributes.Content))
+	original, err := r.client.Enrichments.Get(ctx, repository.WithID(id))
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+	resolved, err := r.client.Enrichments.ResolveOverrides(ctx, []enrichment.Enrichment{original})
 	if err != nil {
 		middleware.WriteError(w, req, err, r.logger)
 		return
@@ -244,10 +323,41 @@ func (r *EnrichmentsRouter) Update(w http.ResponseWriter, req *http.Request) {
 	}
 
 	middleware.WriteJSON(w, http.StatusOK, dto.EnrichmentJSONAPIResponse{
-		Data: enrichmentToJSONAPIDTO(saved, lineRanges),
+		Data: enrichmentToJSONAPIDTO(resolved[0], lineRanges),
 	})
 }
 
+// Reset handles DELETE /api/v1/enrichments/{id}/override.
+//
+//	@Summary		Reset enrichment override
+//	@Description	Remove any human override of an enrichment, restoring the original AI-generated content and allowing future regeneration to replace it
+//	@Tags			enrichments
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path	int	true	"Enrichment ID"
+//	@Success		204
+//	@Failure		404	{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500	{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/enrichments/{id}/override [delete]
+func (r *EnrichmentsRouter) Reset(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	idStr := chi.URLParam(req, "id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	if err := r.client.Enrichments.ResetOverride(ctx, id); err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
 // Delete handles DELETE /api/v1/enrichments/{id}.
 //
 //	@Summary		Delete enrichment
@@ -0,0 +1,299 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/helixml/kodit"
+	"github.com/helixml/kodit/application/service"
+	"github.com/helixml/kodit/domain/enrichment"
+	"github.com/helixml/kodit/domain/repository"
+	"github.com/helixml/kodit/domain/sourcelocation"
+	"github.com/helixml/kodit/infrastructure/api/middleware"
+	"github.com/helixml/kodit/infrastructure/api/v1/dto"
+)
+
+// ExamplesRouter handles the examples API endpoints. Examples are code
+// samples extracted from documentation (enrichment.SubtypeExample) and are
+// exposed as a dedicated, filterable resource rather than through the
+// generic /enrichments endpoint.
+type ExamplesRouter struct {
+	client *kodit.Client
+	logger zerolog.Logger
+}
+
+// NewExamplesRouter creates a new ExamplesRouter.
+func NewExamplesRouter(client *kodit.Client) *ExamplesRouter {
+	return &ExamplesRouter{
+		client: client,
+		logger: client.Logger(),
+	}
+}
+
+// Routes returns the chi router for example endpoints.
+func (r *ExamplesRouter) Routes() chi.Router {
+	router := chi.NewRouter()
+
+	router.Get("/", r.List)
+	router.Get("/{id}", r.Get)
+
+	return router
+}
+
+// List handles GET /api/v1/examples.
+//
+//	@Summary		List examples
+//	@Description	List code examples extracted from documentation, with optional language, path, and full-text filters
+//	@Tags			examples
+//	@Accept			json
+//	@Produce		json
+//	@Param			language	query		string	false	"Filter by source language (e.g. go, python)"
+//	@Param			path		query		string	false	"Filter by a substring of the source file path"
+//	@Param			query		query		string	false	"Filter by a substring of the example content"
+//	@Param			page		query		int		false	"Page number (default: 1)"
+//	@Param			page_size	query		int		false	"Results per page (default: 20, max: 100)"
+//	@Success		200			{object}	dto.ExampleJSONAPIListResponse
+//	@Failure		500			{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/examples [get]
+func (r *ExamplesRouter) List(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	pagination, err := ParsePagination(req)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	pathFilter := req.URL.Query().Get("path")
+	params := r.listParams(req)
+
+	// When filtering by path, examples have no direct file column to filter
+	// on (they associate to a commit, not a file), so pull every match and
+	// filter in memory by resolved path. Otherwise paginate normally.
+	if pathFilter != "" {
+		params.Limit = 0
+		params.Offset = 0
+	} else {
+		params.Limit = pagination.Limit()
+		params.Offset = pagination.Offset()
+	}
+
+	examples, err := r.client.Enrichments.List(ctx, params)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	paths, err := r.resolvePaths(ctx, examples)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	total := int64(len(examples))
+	if pathFilter != "" {
+		examples, paths = filterExamplesByPath(examples, paths, pathFilter)
+		total = int64(len(examples))
+		examples, paths = paginateExamples(examples, paths, pagination.Limit(), pagination.Offset())
+	} else {
+		total, err = r.client.Enrichments.Count(ctx, params)
+		if err != nil {
+			middleware.WriteError(w, req, err, r.logger)
+			return
+		}
+	}
+
+	ids := make([]int64, len(examples))
+	for i, e := range examples {
+		ids[i] = e.ID()
+	}
+	lineRanges, err := r.client.Enrichments.SourceLocations(ctx, ids)
+	if err != nil {
+		r.logger.Warn().Err(err).Msg("failed to fetch line ranges")
+		lineRanges = map[string]sourcelocation.SourceLocation{}
+	}
+
+	data := examplesToJSONAPIDTO(examples, paths, lineRanges)
+
+	middleware.WriteJSON(w, http.StatusOK, dto.ExampleJSONAPIListResponse{
+		Data:  data,
+		Meta:  PaginationMeta(pagination, total),
+		Links: PaginationLinks(req, pagination, total),
+	})
+}
+
+// Get handles GET /api/v1/examples/{id}.
+//
+//	@Summary		Get example
+//	@Description	Get a single code example by ID, including its source file path and line range when known
+//	@Tags			examples
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		int	true	"Enrichment ID"
+//	@Success		200	{object}	dto.ExampleJSONAPIResponse
+//	@Failure		404	{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500	{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/examples/{id} [get]
+func (r *ExamplesRouter) Get(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	idStr := chi.URLParam(req, "id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	typ := enrichment.TypeDevelopment
+	subtype := enrichment.SubtypeExample
+	e, err := r.client.Enrichments.Get(ctx, repository.WithID(id), enrichment.WithType(typ), enrichment.WithSubtype(subtype))
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	paths, err := r.resolvePaths(ctx, []enrichment.Enrichment{e})
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	lineRanges, err := r.client.Enrichments.SourceLocations(ctx, []int64{id})
+	if err != nil {
+		r.logger.Warn().Err(err).Msg("failed to fetch line ranges")
+		lineRanges = map[string]sourcelocation.SourceLocation{}
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, dto.ExampleJSONAPIResponse{
+		Data: exampleToJSONAPIDTO(e, paths, lineRanges),
+	})
+}
+
+// listParams builds the enrichment list params shared by the example
+// endpoints, always scoped to development/example enrichments.
+func (r *ExamplesRouter) listParams(req *http.Request) *service.EnrichmentListParams {
+	typ := enrichment.TypeDevelopment
+	subtype := enrichment.SubtypeExample
+	return &service.EnrichmentListParams{
+		Type:     &typ,
+		Subtype:  &subtype,
+		Language: req.URL.Query().Get("language"),
+		Query:    req.URL.Query().Get("query"),
+	}
+}
+
+// resolvePaths resolves the source file path for each example, keyed by
+// enrichment ID string. Examples without a file association (e.g. extracted
+// from a top-level README rather than a specific source file) are omitted.
+func (r *ExamplesRouter) resolvePaths(ctx context.Context, examples []enrichment.Enrichment) (map[string]string, error) {
+	if len(examples) == 0 {
+		return map[string]string{}, nil
+	}
+
+	ids := make([]int64, len(examples))
+	for i, e := range examples {
+		ids[i] = e.ID()
+	}
+
+	fileIDsByEnrichment, err := r.client.Enrichments.SourceFiles(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("resolve example file associations: %w", err)
+	}
+	if len(fileIDsByEnrichment) == 0 {
+		return map[string]string{}, nil
+	}
+
+	var allFileIDs []int64
+	for _, fileIDs := range fileIDsByEnrichment {
+		allFileIDs = append(allFileIDs, fileIDs...)
+	}
+
+	files, err := r.client.Files.Find(ctx, repository.WithIDIn(allFileIDs))
+	if err != nil {
+		return nil, fmt.Errorf("find example source files: %w", err)
+	}
+	pathByFileID := make(map[int64]string, len(files))
+	for _, f := range files {
+		pathByFileID[f.ID()] = f.Path()
+	}
+
+	paths := make(map[string]string, len(fileIDsByEnrichment))
+	for enrichmentID, fileIDs := range fileIDsByEnrichment {
+		if len(fileIDs) == 0 {
+			continue
+		}
+		if p, ok := pathByFileID[fileIDs[0]]; ok {
+			paths[enrichmentID] = p
+		}
+	}
+	return paths, nil
+}
+
+// filterExamplesByPath keeps only the examples whose resolved path contains
+// the given case-insensitive substring.
+func filterExamplesByPath(examples []enrichment.Enrichment, paths map[string]string, pathFilter string) ([]enrichment.Enrichment, map[string]string) {
+	needle := strings.ToLower(pathFilter)
+	filtered := make([]enrichment.Enrichment, 0, len(examples))
+	for _, e := range examples {
+		p, ok := paths[strconv.FormatInt(e.ID(), 10)]
+		if !ok || !strings.Contains(strings.ToLower(p), needle) {
+			continue
+		}
+		filtered = append(filtered, e)
+	}
+	return filtered, paths
+}
+
+// paginateExamples applies an offset/limit slice over an in-memory result
+// set, used when filtering happened in memory (see the path filter above).
+func paginateExamples(examples []enrichment.Enrichment, paths map[string]string, limit, offset int) ([]enrichment.Enrichment, map[string]string) {
+	if offset >= len(examples) {
+		return []enrichment.Enrichment{}, paths
+	}
+	end := offset + limit
+	if limit <= 0 || end > len(examples) {
+		end = len(examples)
+	}
+	return examples[offset:end], paths
+}
+
+func examplesToJSONAPIDTO(examples []enrichment.Enrichment, paths map[string]string, lineRanges map[string]sourcelocation.SourceLocation) []dto.ExampleData {
+	result := make([]dto.ExampleData, len(examples))
+	for i, e := range examples {
+		result[i] = exampleToJSONAPIDTO(e, paths, lineRanges)
+	}
+	return result
+}
+
+func exampleToJSONAPIDTO(e enrichment.Enrichment, paths map[string]string, lineRanges map[string]sourcelocation.SourceLocation) dto.ExampleData {
+	idStr := strconv.FormatInt(e.ID(), 10)
+
+	attrs := dto.ExampleAttributes{
+		Content:   e.Content(),
+		Language:  e.Language(),
+		Path:      paths[idStr],
+		CreatedAt: e.CreatedAt(),
+		UpdatedAt: e.UpdatedAt(),
+	}
+
+	if lr, ok := lineRanges[idStr]; ok && lr.StartLine() > 0 {
+		startLine := lr.StartLine()
+		endLine := lr.EndLine()
+		attrs.StartLine = &startLine
+		attrs.EndLine = &endLine
+	}
+
+	return dto.ExampleData{
+		Type:       "example",
+		ID:         idStr,
+		Attributes: attrs,
+	}
+}
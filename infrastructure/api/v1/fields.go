@@ -0,0 +1,68 @@
+package v1
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// ParseFieldsParam parses a JSON:API sparse fieldset query parameter
+// (e.g. "?fields[enrichment]=type,subtype,created_at") for the given
+// resource type. It returns nil if the parameter was not supplied, which
+// callers should treat as "no filtering".
+func ParseFieldsParam(req *http.Request, resourceType string) []string {
+	raw := req.URL.Query().Get("fields[" + resourceType + "]")
+	if raw == "" {
+		return nil
+	}
+
+	fields := strings.Split(raw, ",")
+	for i, f := range fields {
+		fields[i] = strings.TrimSpace(f)
+	}
+	return fields
+}
+
+// ExcludeContent reports whether the request asked to drop large content
+// fields via "?exclude_content=true". This is a shortcut for the common
+// case of omitting multi-megabyte enrichment/snippet content from list views.
+func ExcludeContent(req *http.Request) bool {
+	return req.URL.Query().Get("exclude_content") == "true"
+}
+
+// FilterJSONFields restricts data (typically a DTO's Attributes struct) to
+// only the given top-level JSON keys, plus any keys in alwaysKeep. It returns
+// data unmodified (as map[string]any) when fields is nil. Filtering is done
+// via a JSON marshal/unmarshal round trip so it works against any struct that
+// already defines its wire representation through json tags.
+func FilterJSONFields(data any, fields []string, alwaysKeep ...string) (map[string]any, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var full map[string]any
+	if err := json.Unmarshal(raw, &full); err != nil {
+		return nil, err
+	}
+
+	if fields == nil {
+		return full, nil
+	}
+
+	keep := make(map[string]bool, len(fields)+len(alwaysKeep))
+	for _, f := range fields {
+		keep[f] = true
+	}
+	for _, f := range alwaysKeep {
+		keep[f] = true
+	}
+
+	filtered := make(map[string]any, len(keep))
+	for k, v := range full {
+		if keep[k] {
+			filtered[k] = v
+		}
+	}
+	return filtered, nil
+}
@@ -1,6 +1,7 @@
 package v1
 
 import (
+	"encoding/base64"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -11,9 +12,20 @@ import (
 )
 
 // PaginationParams holds pagination parameters parsed from query strings.
+//
+// Two mutually exclusive modes are supported: page/offset (the default) and
+// opaque cursor-based keyset pagination, selected by supplying a "cursor"
+// query parameter. Page/offset pagination re-runs the full ORDER BY/OFFSET
+// scan on every request, which gets slower as the offset grows and can skip
+// or repeat rows when the underlying table is written to concurrently.
+// Cursor mode instead orders by id and filters on "id > <cursor>", so each
+// page is a cheap index seek that stays correct regardless of table size or
+// concurrent writes.
 type PaginationParams struct {
 	page     int
 	pageSize int
+	cursor   bool
+	afterID  int64
 }
 
 // DefaultPageSize is the default number of items per page.
@@ -33,20 +45,15 @@ func NewPaginationParams() PaginationParams {
 // ParsePagination parses pagination parameters from an HTTP request.
 // Default: page=1, page_size=20
 // Max page_size: 100
-// Returns an error if page or page_size is explicitly provided but less than 1.
+// Returns an error if page or page_size is explicitly provided but less than
+// 1, or if a "cursor" value is present but cannot be decoded.
+//
+// A "cursor" query parameter switches the result to keyset pagination: "page"
+// is ignored, and results are ordered by id starting after the position the
+// cursor encodes. An empty cursor value ("cursor=") requests the first page.
 func ParsePagination(r *http.Request) (PaginationParams, error) {
 	params := NewPaginationParams()
 
-	// Parse page parameter
-	if pageStr := r.URL.Query().Get("page"); pageStr != "" {
-		page, err := strconv.Atoi(pageStr)
-		if err != nil || page < 1 {
-			return params, fmt.Errorf("page must be at least 1: %w", middleware.ErrValidation)
-		}
-		params.page = page
-	}
-
-	// Parse page_size parameter
 	if sizeStr := r.URL.Query().Get("page_size"); sizeStr != "" {
 		size, err := strconv.Atoi(sizeStr)
 		if err != nil || size < 1 {
@@ -58,6 +65,26 @@ func ParsePagination(r *http.Request) (PaginationParams, error) {
 		}
 	}
 
+	if r.URL.Query().Has("cursor") {
+		params.cursor = true
+		if cursor := r.URL.Query().Get("cursor"); cursor != "" {
+			afterID, err := decodeCursor(cursor)
+			if err != nil {
+				return params, fmt.Errorf("invalid cursor: %w", middleware.ErrValidation)
+			}
+			params.afterID = afterID
+		}
+		return params, nil
+	}
+
+	if pageStr := r.URL.Query().Get("page"); pageStr != "" {
+		page, err := strconv.Atoi(pageStr)
+		if err != nil || page < 1 {
+			return params, fmt.Errorf("page must be at least 1: %w", middleware.ErrValidation)
+		}
+		params.page = page
+	}
+
 	return params, nil
 }
 
@@ -77,6 +104,13 @@ func (p PaginationParams) Limit() int {
 	return p.pageSize
 }
 
+// Cursor returns true if the request selected keyset (cursor-based) pagination.
+func (p PaginationParams) Cursor() bool { return p.cursor }
+
+// AfterID returns the id the request's cursor is positioned after, or 0 for
+// the first page. Only meaningful when Cursor() is true.
+func (p PaginationParams) AfterID() int64 { return p.afterID }
+
 // WithPage returns a copy with the specified page.
 func (p PaginationParams) WithPage(page int) PaginationParams {
 	if page < 1 {
@@ -98,11 +132,34 @@ func (p PaginationParams) WithPageSize(size int) PaginationParams {
 	return p
 }
 
-// Options returns repository options for database pagination.
+// Options returns repository options for database pagination. In cursor mode
+// this orders by id ascending and filters to id > AfterID instead of applying
+// an offset.
 func (p PaginationParams) Options() []repository.Option {
+	if p.cursor {
+		opts := []repository.Option{repository.WithOrderAsc("id"), repository.WithLimit(p.Limit())}
+		if p.afterID > 0 {
+			opts = append(opts, repository.WithWhere("id > ?", p.afterID))
+		}
+		return opts
+	}
 	return repository.WithPagination(p.Limit(), p.Offset())
 }
 
+// encodeCursor produces an opaque cursor token addressing the row after id.
+func encodeCursor(id int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(id, 10)))
+}
+
+// decodeCursor decodes a cursor token produced by encodeCursor.
+func decodeCursor(token string) (int64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(string(raw), 10, 64)
+}
+
 // PaginationMeta builds a JSON:API meta object from pagination params and total count.
 func PaginationMeta(params PaginationParams, totalCount int64) *jsonapi.Meta {
 	totalPages := 0
@@ -150,3 +207,48 @@ func PaginationLinks(r *http.Request, params PaginationParams, totalCount int64)
 
 	return &links
 }
+
+// Identifiable is implemented by domain entities with a numeric identity.
+// CursorMeta and CursorLinks use it to derive keyset cursors from a page of
+// results.
+type Identifiable interface {
+	ID() int64
+}
+
+// CursorMeta builds a JSON:API meta object for a cursor-paginated response.
+// Unlike PaginationMeta, it has no page number or page count to report, since
+// keyset pagination has no notion of a page index.
+func CursorMeta(params PaginationParams, totalCount int64) *jsonapi.Meta {
+	return &jsonapi.Meta{
+		"page_size":   params.PageSize(),
+		"total_count": totalCount,
+	}
+}
+
+// CursorLinks builds JSON:API links for a cursor-paginated response. items is
+// the page of results just fetched; when it fills the requested page size,
+// Next is set to a cursor addressing the row after the last item.
+func CursorLinks[T Identifiable](r *http.Request, params PaginationParams, items []T) *jsonapi.Links {
+	buildURL := func(cursor string) string {
+		q := r.URL.Query()
+		q.Set("cursor", cursor)
+		q.Set("page_size", strconv.Itoa(params.PageSize()))
+		return fmt.Sprintf("%s?%s", r.URL.Path, q.Encode())
+	}
+
+	links := jsonapi.Links{Self: buildURL(r.URL.Query().Get("cursor"))}
+	if len(items) > 0 && len(items) >= params.Limit() {
+		links.Next = buildURL(encodeCursor(items[len(items)-1].ID()))
+	}
+	return &links
+}
+
+// PaginatedResponse builds the JSON:API meta and links for a page of results,
+// choosing page/offset or cursor-based pagination based on which mode the
+// request selected.
+func PaginatedResponse[T Identifiable](r *http.Request, params PaginationParams, items []T, totalCount int64) (*jsonapi.Meta, *jsonapi.Links) {
+	if params.Cursor() {
+		return CursorMeta(params, totalCount), CursorLinks(r, params, items)
+	}
+	return PaginationMeta(params, totalCount), PaginationLinks(r, params, totalCount)
+}
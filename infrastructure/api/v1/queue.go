@@ -35,6 +35,7 @@ func (r *QueueRouter) Routes() chi.Router {
 
 	router.Get("/", r.ListTasks)
 	router.Get("/{task_id}", r.GetTask)
+	router.Get("/{task_id}/logs", r.GetTaskLogs)
 
 	return router
 }
@@ -47,8 +48,9 @@ func (r *QueueRouter) Routes() chi.Router {
 //	@Tags			queue
 //	@Accept			json
 //	@Produce		json
-//	@Param			page		query		int		false	"Page number (default: 1)"
+//	@Param			page		query		int		false	"Page number (default: 1); ignored if cursor is set"
 //	@Param			page_size	query		int		false	"Results per page (default: 20, max: 100)"
+//	@Param			cursor		query		string	false	"Opaque keyset cursor; switches to cursor-based pagination and orders by id instead of priority"
 //	@Param			task_type	query		string	false	"Filter by task type"
 //	@Success		200			{object}	dto.TaskListResponse
 //	@Failure		500			{object}	middleware.JSONAPIErrorResponse
@@ -63,8 +65,10 @@ func (r *QueueRouter) ListTasks(w http.ResponseWriter, req *http.Request) {
 	}
 
 	params := &service.TaskListParams{
-		Limit:  pagination.Limit(),
-		Offset: pagination.Offset(),
+		Limit:   pagination.Limit(),
+		Offset:  pagination.Offset(),
+		Cursor:  pagination.Cursor(),
+		AfterID: pagination.AfterID(),
 	}
 
 	if taskType := req.URL.Query().Get("task_type"); taskType != "" {
@@ -84,10 +88,11 @@ func (r *QueueRouter) ListTasks(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	meta, links := PaginatedResponse(req, pagination, tasks, total)
 	response := dto.TaskListResponse{
 		Data:  tasksToDTO(tasks),
-		Meta:  PaginationMeta(pagination, total),
-		Links: PaginationLinks(req, pagination, total),
+		Meta:  meta,
+		Links: links,
 	}
 
 	middleware.WriteJSON(w, http.StatusOK, response)
@@ -125,6 +130,52 @@ func (r *QueueRouter) GetTask(w http.ResponseWriter, req *http.Request) {
 	middleware.WriteJSON(w, http.StatusOK, dto.TaskResponse{Data: taskToDTO(t)})
 }
 
+// GetTaskLogs handles GET /api/v1/queue/{task_id}/logs.
+// Returns the structured log entries captured while the task ran, oldest
+// first. This works even after the task itself has been dequeued and
+// deleted, since that's exactly when a failure is investigated. An unknown
+// or never-run task ID returns an empty list rather than a 404, since the
+// log ring has no independent notion of task existence.
+//
+//	@Summary		Get task logs
+//	@Description	Get the structured log entries captured while a task ran, for failure investigation without grepping host logs
+//	@Tags			queue
+//	@Accept			json
+//	@Produce		json
+//	@Param			task_id	path		int	true	"Task ID"
+//	@Success		200		{object}	dto.TaskLogListResponse
+//	@Failure		400		{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500		{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/queue/{task_id}/logs [get]
+func (r *QueueRouter) GetTaskLogs(w http.ResponseWriter, req *http.Request) {
+	idStr := chi.URLParam(req, "task_id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	entries := r.client.TaskLogs.Get(id)
+
+	middleware.WriteJSON(w, http.StatusOK, dto.TaskLogListResponse{Data: taskLogsToDTO(entries)})
+}
+
+func taskLogsToDTO(entries []task.LogEntry) []dto.TaskLogData {
+	result := make([]dto.TaskLogData, len(entries))
+	for i, e := range entries {
+		result[i] = dto.TaskLogData{
+			Type: "task_log",
+			Attributes: dto.TaskLogAttributes{
+				Level:     string(e.Level()),
+				Message:   e.Message(),
+				Timestamp: e.Timestamp(),
+			},
+		}
+	}
+	return result
+}
+
 func tasksToDTO(tasks []task.Task) []dto.TaskData {
 	result := make([]dto.TaskData, len(tasks))
 	for i, t := range tasks {
@@ -21,12 +21,16 @@ import (
 	"github.com/helixml/kodit"
 	"github.com/helixml/kodit/application/service"
 	"github.com/helixml/kodit/domain/enrichment"
+	"github.com/helixml/kodit/domain/prindex"
 	"github.com/helixml/kodit/domain/repository"
+	"github.com/helixml/kodit/domain/search"
 	"github.com/helixml/kodit/domain/sourcelocation"
 	"github.com/helixml/kodit/domain/task"
 	"github.com/helixml/kodit/domain/wiki"
 	"github.com/helixml/kodit/infrastructure/api/middleware"
 	"github.com/helixml/kodit/infrastructure/api/v1/dto"
+	"github.com/helixml/kodit/infrastructure/git"
+	"github.com/helixml/kodit/infrastructure/onboarding"
 	"github.com/helixml/kodit/internal/database"
 )
 
@@ -60,28 +64,53 @@ func (r *RepositoriesRouter) Routes() chi.Router {
 	router.Get("/{id}/commits/{commit_sha}/files/{blob_sha}", r.GetCommitFile)
 	router.Get("/{id}/commits/{commit_sha}/enrichments", r.ListCommitEnrichments)
 	router.Delete("/{id}/commits/{commit_sha}/enrichments", r.DeleteCommitEnrichments)
+	router.Delete("/{id}/commits/{commit_sha}/index", r.DeleteCommitIndex)
 	router.Get("/{id}/commits/{commit_sha}/enrichments/{enrichment_id}", r.GetCommitEnrichment)
 	router.Delete("/{id}/commits/{commit_sha}/enrichments/{enrichment_id}", r.DeleteCommitEnrichment)
 	router.Get("/{id}/commits/{commit_sha}/snippets", r.ListCommitSnippets)
 	router.Get("/{id}/commits/{commit_sha}/embeddings", r.ListCommitEmbeddingsDeprecated)
 	router.Post("/{id}/sync", r.Sync)
+	router.Post("/{id}/archive", r.Archive)
+	router.Post("/{id}/unarchive", r.Unarchive)
 	router.Post("/{id}/commits/{commit_sha}/rescan", r.RescanCommit)
 	router.Get("/{id}/tags", r.ListTags)
 	router.Get("/{id}/tags/{tag_name}", r.GetTag)
 	router.Get("/{id}/enrichments", r.ListRepositoryEnrichments)
+	router.Get("/{id}/vulnerabilities", r.ListRepositoryVulnerabilities)
+	router.Get("/{id}/activity", r.ListRepositoryActivity)
+	router.Get("/{id}/examples", r.ListRepositoryExamples)
 	router.Post("/{id}/wiki/rescan", r.RescanWiki)
 	router.Get("/{id}/wiki", r.GetWikiTree)
+	router.Get("/{id}/wiki/search", r.SearchWiki)
 	router.Get("/{id}/wiki/*", r.GetWikiPage)
 	router.Get("/{id}/tracking-config", r.GetTrackingConfig)
 	router.Put("/{id}/tracking-config", r.UpdateTrackingConfig)
 	router.Route("/{id}/config", func(cr chi.Router) {
 		cr.Get("/chunking", r.GetChunkingConfig)
 		cr.Put("/chunking", r.UpdateChunkingConfig)
+		cr.Get("/enrichment-budget", r.GetEnrichmentBudget)
+		cr.Put("/enrichment-budget", r.UpdateEnrichmentBudget)
+		cr.Get("/embedding", r.GetEmbeddingConfig)
+		cr.Put("/embedding", r.UpdateEmbeddingConfig)
+		cr.Get("/access", r.GetAccessConfig)
+		cr.Put("/access", r.UpdateAccessConfig)
+		cr.Get("/index-filter", r.GetIndexFilterConfig)
+		cr.Put("/index-filter", r.UpdateIndexFilterConfig)
+		cr.Get("/enrichment-language", r.GetEnrichmentLanguage)
+		cr.Put("/enrichment-language", r.UpdateEnrichmentLanguage)
+		cr.Get("/auto-repair-tracking", r.GetAutoRepairTracking)
+		cr.Put("/auto-repair-tracking", r.UpdateAutoRepairTracking)
 		cr.Get("/pipeline", r.GetPipelineConfig)
 		cr.Put("/pipeline", r.UpdatePipelineConfig)
 	})
 	router.Get("/{id}/blob/{blob_name}/*", r.GetBlob)
 	router.Get("/{id}/grep", r.Grep)
+	router.Get("/{id}/architecture/diagram", r.GetArchitectureDiagram)
+	router.Post("/{id}/pr-preview", r.CreatePRPreview)
+	router.Get("/{id}/pr-preview", r.ListPRPreviews)
+	router.Get("/{id}/enrichments/export", r.ExportEnrichments)
+	router.Get("/{id}/commits/{commit_sha}/sbom", r.GetCommitSBOM)
+	router.Get("/{id}/onboarding-report", r.GetOnboardingReport)
 
 	return router
 }
@@ -105,12 +134,17 @@ func (r *RepositoriesRouter) repositoryID(req *http.Request) (int64, error) {
 // List handles GET /api/v1/repositories.
 //
 //	@Summary		List repositories
-//	@Description	Get all tracked Git repositories
+//	@Description	Get all tracked Git repositories. Supports page/offset pagination
+//	@Description	(page, page_size) or, for large tables and concurrent writes,
+//	@Description	opaque cursor pagination — pass "cursor" (empty for the first
+//	@Description	page, or the value from the previous response's links.next)
+//	@Description	instead of "page".
 //	@Tags			repositories
 //	@Accept			json
 //	@Produce		json
-//	@Param			page		query	int	false	"Page number (default: 1)"
-//	@Param			page_size	query	int	false	"Results per page (default: 20, max: 100)"
+//	@Param			page		query	int		false	"Page number (default: 1); ignored if cursor is set"
+//	@Param			page_size	query	int		false	"Results per page (default: 20, max: 100)"
+//	@Param			cursor		query	string	false	"Opaque keyset cursor; switches to cursor-based pagination"
 //	@Success		200	{object}	dto.RepositoryListResponse
 //	@Failure		500	{object}	middleware.JSONAPIErrorResponse
 //	@Security		APIKeyAuth
@@ -141,13 +175,21 @@ func (r *RepositoriesRouter) List(w http.ResponseWriter, req *http.Request) {
 		numCommits, _ := r.client.Commits.Count(ctx, repository.WithRepoID(repoID))
 		branches, _ := r.client.Repositories.BranchesForRepository(ctx, repoID)
 		numTags, _ := r.client.Tags.Count(ctx, repository.WithRepoID(repoID))
-		data = append(data, repoToDTO(repo, numCommits, int64(len(branches)), numTags))
+
+		var healthScore *float64
+		if health, healthErr := r.client.Health.Health(ctx, repo); healthErr == nil {
+			score := health.Score()
+			healthScore = &score
+		}
+
+		data = append(data, repoToDTO(repo, numCommits, int64(len(branches)), numTags, nil, healthScore))
 	}
 
+	meta, links := PaginatedResponse(req, pagination, repos, total)
 	response := dto.RepositoryListResponse{
 		Data:  data,
-		Meta:  PaginationMeta(pagination, total),
-		Links: PaginationLinks(req, pagination, total),
+		Meta:  meta,
+		Links: links,
 	}
 
 	middleware.WriteJSON(w, http.StatusOK, response)
@@ -223,8 +265,21 @@ func (r *RepositoriesRouter) Get(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	var excludedPatterns []string
+	if clonedPath := repo.WorkingCopy().Path(); clonedPath != "" {
+		if koditIgnore, ignoreErr := git.LoadKoditIgnore(clonedPath); ignoreErr == nil {
+			excludedPatterns = koditIgnore.Patterns()
+		}
+	}
+
+	var healthScore *float64
+	if health, healthErr := r.client.Health.Health(ctx, repo); healthErr == nil {
+		score := health.Score()
+		healthScore = &score
+	}
+
 	middleware.WriteJSON(w, http.StatusOK, dto.RepositoryDetailsResponse{
-		Data:          repoToDTO(repo, numCommits, int64(len(branches)), numTags),
+		Data:          repoToDTO(repo, numCommits, int64(len(branches)), numTags, excludedPatterns, healthScore),
 		Branches:      branchData,
 		RecentCommits: commitData,
 	})
@@ -284,7 +339,7 @@ func (r *RepositoriesRouter) Add(w http.ResponseWriter, req *http.Request) {
 		status = http.StatusCreated
 	}
 
-	middleware.WriteJSON(w, status, dto.RepositoryResponse{Data: repoToDTO(source.Repo(), 0, 0, 0)})
+	middleware.WriteJSON(w, status, dto.RepositoryResponse{Data: repoToDTO(source.Repo(), 0, 0, 0, nil, nil)})
 }
 
 // Delete handles DELETE /api/v1/repositories/{id}.
@@ -373,7 +428,7 @@ func (r *RepositoriesRouter) GetStatus(w http.ResponseWriter, req *http.Request)
 // GetStatusSummary handles GET /api/v1/repositories/{id}/status/summary.
 //
 //	@Summary		Get repository status summary
-//	@Description	Get aggregated indexing status summary for a repository
+//	@Description	Get aggregated indexing status summary for a repository, including per-task embedding counts
 //	@Tags			repositories
 //	@Accept			json
 //	@Produce		json
@@ -398,19 +453,62 @@ func (r *RepositoriesRouter) GetStatusSummary(w http.ResponseWriter, req *http.R
 		return
 	}
 
+	embeddings, err := r.embeddingCounts(ctx, id)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	repo, err := r.client.Repositories.Get(ctx, repository.WithID(id))
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+	integrity, err := r.client.Integrity.Check(ctx, repo)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+	summary = summary.WithIntegrity(integrity)
+
 	middleware.WriteJSON(w, http.StatusOK, dto.RepositoryStatusSummaryResponse{
 		Data: dto.RepositoryStatusSummaryData{
 			Type: "repository_status_summary",
 			ID:   fmt.Sprintf("%d", id),
 			Attributes: dto.RepositoryStatusSummaryAttributes{
-				Status:    string(summary.Status()),
-				Message:   summary.Message(),
-				UpdatedAt: summary.UpdatedAt(),
+				Status:          string(summary.Status()),
+				Message:         summary.Message(),
+				UpdatedAt:       summary.UpdatedAt(),
+				Embeddings:      embeddings,
+				IntegrityStatus: string(summary.Integrity().Status()),
+				IntegrityIssue:  summary.Integrity().Message(),
 			},
 		},
 	})
 }
 
+// embeddingCounts gathers the embedded/failed embedding status counts for
+// a repository across all three embedding tasks.
+func (r *RepositoriesRouter) embeddingCounts(ctx context.Context, repoID int64) (dto.RepositoryEmbeddingCounts, error) {
+	code, err := r.client.EmbeddingStatus.Counts(ctx, repoID, search.TaskNameCode)
+	if err != nil {
+		return dto.RepositoryEmbeddingCounts{}, fmt.Errorf("count code embedding statuses: %w", err)
+	}
+	text, err := r.client.EmbeddingStatus.Counts(ctx, repoID, search.TaskNameText)
+	if err != nil {
+		return dto.RepositoryEmbeddingCounts{}, fmt.Errorf("count text embedding statuses: %w", err)
+	}
+	vision, err := r.client.EmbeddingStatus.Counts(ctx, repoID, search.TaskNameVision)
+	if err != nil {
+		return dto.RepositoryEmbeddingCounts{}, fmt.Errorf("count vision embedding statuses: %w", err)
+	}
+	return dto.RepositoryEmbeddingCounts{
+		Code:   dto.EmbeddingTaskCounts{Embedded: code.Embedded, Failed: code.Failed},
+		Text:   dto.EmbeddingTaskCounts{Embedded: text.Embedded, Failed: text.Failed},
+		Vision: dto.EmbeddingTaskCounts{Embedded: vision.Embedded, Failed: vision.Failed},
+	}, nil
+}
+
 // ListCommits handles GET /api/v1/repositories/{id}/commits.
 //
 //	@Summary		List commits
@@ -418,9 +516,10 @@ func (r *RepositoriesRouter) GetStatusSummary(w http.ResponseWriter, req *http.R
 //	@Tags			repositories
 //	@Accept			json
 //	@Produce		json
-//	@Param			id			path		int	true	"Repository ID"
-//	@Param			page		query		int	false	"Page number (default: 1)"
-//	@Param			page_size	query		int	false	"Results per page (default: 20, max: 100)"
+//	@Param			id			path		int		true	"Repository ID"
+//	@Param			page		query		int		false	"Page number (default: 1); ignored if cursor is set"
+//	@Param			page_size	query		int		false	"Results per page (default: 20, max: 100)"
+//	@Param			cursor		query		string	false	"Opaque keyset cursor; switches to cursor-based pagination"
 //	@Success		200	{object}	dto.CommitJSONAPIListResponse
 //	@Failure		404	{object}	middleware.JSONAPIErrorResponse
 //	@Failure		500	{object}	middleware.JSONAPIErrorResponse
@@ -468,10 +567,11 @@ func (r *RepositoriesRouter) ListCommits(w http.ResponseWriter, req *http.Reques
 		})
 	}
 
+	meta, links := PaginatedResponse(req, pagination, commits, total)
 	middleware.WriteJSON(w, http.StatusOK, dto.CommitJSONAPIListResponse{
 		Data:  data,
-		Meta:  PaginationMeta(pagination, total),
-		Links: PaginationLinks(req, pagination, total),
+		Meta:  meta,
+		Links: links,
 	})
 }
 
@@ -530,8 +630,13 @@ func (r *RepositoriesRouter) GetCommit(w http.ResponseWriter, req *http.Request)
 //	@Produce		json
 //	@Param			id			path		int		true	"Repository ID"
 //	@Param			commit_sha	path		string	true	"Commit SHA"
-//	@Param			page		query		int		false	"Page number (default: 1)"
+//	@Param			page		query		int		false	"Page number (default: 1); ignored if cursor is set"
 //	@Param			page_size	query		int		false	"Results per page (default: 20, max: 100)"
+//	@Param			cursor		query		string	false	"Opaque keyset cursor; switches to cursor-based pagination and forces id ordering, ignoring sort/order"
+//	@Param			path_prefix	query		string	false	"Only include files whose path starts with this prefix"
+//	@Param			extension	query		string	false	"Only include files with this extension"
+//	@Param			sort		query		string	false	"Sort field: path or size (default: path)"
+//	@Param			order		query		string	false	"Sort order: asc or desc (default: asc)"
 //	@Success		200			{object}	dto.FileJSONAPIListResponse
 //	@Failure		404			{object}	middleware.JSONAPIErrorResponse
 //	@Failure		500			{object}	middleware.JSONAPIErrorResponse
@@ -561,6 +666,25 @@ func (r *RepositoriesRouter) ListCommitFiles(w http.ResponseWriter, req *http.Re
 	}
 
 	filterOpts := []repository.Option{repository.WithCommitSHA(commitSHA)}
+	if prefix := req.URL.Query().Get("path_prefix"); prefix != "" {
+		filterOpts = append(filterOpts, repository.WithPathPrefix(prefix))
+	}
+	if extension := req.URL.Query().Get("extension"); extension != "" {
+		filterOpts = append(filterOpts, repository.WithExtension(extension))
+	}
+
+	// Cursor mode requires a stable id ordering to keep pages consistent, so
+	// it overrides any requested sort field.
+	if !pagination.Cursor() {
+		ascending := req.URL.Query().Get("order") != "desc"
+		switch req.URL.Query().Get("sort") {
+		case "size":
+			filterOpts = append(filterOpts, repository.WithOrderBySize(ascending))
+		default:
+			filterOpts = append(filterOpts, repository.WithOrderByPath(ascending))
+		}
+	}
+
 	files, err := r.client.Files.Find(ctx, append(filterOpts, pagination.Options()...)...)
 	if err != nil {
 		middleware.WriteError(w, req, err, r.logger)
@@ -588,10 +712,11 @@ func (r *RepositoriesRouter) ListCommitFiles(w http.ResponseWriter, req *http.Re
 		})
 	}
 
+	meta, links := PaginatedResponse(req, pagination, files, total)
 	middleware.WriteJSON(w, http.StatusOK, dto.FileJSONAPIListResponse{
 		Data:  data,
-		Meta:  PaginationMeta(pagination, total),
-		Links: PaginationLinks(req, pagination, total),
+		Meta:  meta,
+		Links: links,
 	})
 }
 
@@ -661,8 +786,9 @@ func (r *RepositoriesRouter) GetCommitFile(w http.ResponseWriter, req *http.Requ
 //	@Param			commit_sha			path		string	true	"Commit SHA"
 //	@Param			enrichment_type		query		string	false	"Filter by enrichment type"
 //	@Param			enrichment_subtype	query		string	false	"Filter by enrichment subtype"
-//	@Param			page				query		int		false	"Page number (default: 1)"
+//	@Param			page				query		int		false	"Page number (default: 1); ignored if cursor is set"
 //	@Param			page_size			query		int		false	"Results per page (default: 20, max: 100)"
+//	@Param			cursor				query		string	false	"Opaque keyset cursor; switches to cursor-based pagination"
 //	@Success		200					{object}	dto.EnrichmentJSONAPIListResponse
 //	@Failure		404					{object}	middleware.JSONAPIErrorResponse
 //	@Failure		500					{object}	middleware.JSONAPIErrorResponse
@@ -696,6 +822,8 @@ func (r *RepositoriesRouter) ListCommitEnrichments(w http.ResponseWriter, req *h
 		CommitSHA: commitSHA,
 		Limit:     pagination.Limit(),
 		Offset:    pagination.Offset(),
+		Cursor:    pagination.Cursor(),
+		AfterID:   pagination.AfterID(),
 	}
 	if typeStr := req.URL.Query().Get("enrichment_type"); typeStr != "" {
 		t := enrichment.Type(typeStr)
@@ -728,10 +856,11 @@ func (r *RepositoriesRouter) ListCommitEnrichments(w http.ResponseWriter, req *h
 		lineRanges = map[string]sourcelocation.SourceLocation{}
 	}
 
+	meta, links := PaginatedResponse(req, pagination, enrichments, total)
 	middleware.WriteJSON(w, http.StatusOK, dto.EnrichmentJSONAPIListResponse{
 		Data:  enrichmentsToJSONAPIDTO(enrichments, lineRanges),
-		Meta:  PaginationMeta(pagination, total),
-		Links: PaginationLinks(req, pagination, total),
+		Meta:  meta,
+		Links: links,
 	})
 }
 
@@ -823,26 +952,74 @@ func (r *RepositoriesRouter) DeleteCommitEnrichments(w http.ResponseWriter, req
 		return
 	}
 
-	enrichments, err := r.client.Enrichments.List(ctx, &service.EnrichmentListParams{CommitSHA: commitSHA})
+	if err := r.deleteCommitEnrichments(ctx, commitSHA); err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DeleteCommitIndex handles DELETE /api/v1/repositories/{id}/commits/{commit_sha}/index.
+//
+//	@Summary		Delete commit index
+//	@Description	Remove a single commit's index data (snippets, associations, and vectors), without deleting the repository or commit record
+//	@Tags			repositories
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path	int		true	"Repository ID"
+//	@Param			commit_sha	path	string	true	"Commit SHA"
+//	@Success		204
+//	@Failure		404	{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500	{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/repositories/{id}/commits/{commit_sha}/index [delete]
+func (r *RepositoriesRouter) DeleteCommitIndex(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	id, err := r.repositoryID(req)
 	if err != nil {
 		middleware.WriteError(w, req, err, r.logger)
 		return
 	}
 
-	if len(enrichments) > 0 {
-		ids := make([]int64, len(enrichments))
-		for i, e := range enrichments {
-			ids[i] = e.ID()
-		}
-		if err := r.client.Enrichments.DeleteBy(ctx, repository.WithIDIn(ids)); err != nil {
-			middleware.WriteError(w, req, err, r.logger)
-			return
-		}
+	commitSHA := chi.URLParam(req, "commit_sha")
+
+	// Check commit exists and belongs to this repo
+	_, err = r.client.Commits.Get(ctx, repository.WithRepoID(id), repository.WithSHA(commitSHA))
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	if err := r.deleteCommitEnrichments(ctx, commitSHA); err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// deleteCommitEnrichments removes all enrichments for a commit (snippets,
+// AI-generated enrichments, and overrides). Enrichment.DeleteBy cascades the
+// deletion to associations and to any BM25/vector store indexes, so this
+// covers a commit's full index footprint, not just the enrichment rows.
+func (r *RepositoriesRouter) deleteCommitEnrichments(ctx context.Context, commitSHA string) error {
+	enrichments, err := r.client.Enrichments.List(ctx, &service.EnrichmentListParams{CommitSHA: commitSHA})
+	if err != nil {
+		return err
+	}
+	if len(enrichments) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, len(enrichments))
+	for i, e := range enrichments {
+		ids[i] = e.ID()
+	}
+	return r.client.Enrichments.DeleteBy(ctx, repository.WithIDIn(ids))
+}
+
 // DeleteCommitEnrichment handles DELETE /api/v1/repositories/{id}/commits/{commit_sha}/enrichments/{enrichment_id}.
 //
 //	@Summary		Delete commit enrichment
@@ -989,6 +1166,7 @@ func (r *RepositoriesRouter) ListCommitSnippets(w http.ResponseWriter, req *http
 				},
 				Enrichments:    enrichmentSchemas,
 				OriginalScores: []float64{},
+				Metrics:        snippetMetrics(e),
 			},
 		})
 	}
@@ -1071,6 +1249,7 @@ func (r *RepositoriesRouter) RescanCommit(w http.ResponseWriter, req *http.Reque
 //	@Param			id	path	int	true	"Repository ID"
 //	@Success		202
 //	@Failure		404	{object}	middleware.JSONAPIErrorResponse
+//	@Failure		409	{object}	middleware.JSONAPIErrorResponse
 //	@Failure		500	{object}	middleware.JSONAPIErrorResponse
 //	@Security		APIKeyAuth
 //	@Router			/repositories/{id}/sync [post]
@@ -1084,6 +1263,10 @@ func (r *RepositoriesRouter) Sync(w http.ResponseWriter, req *http.Request) {
 	}
 
 	if err := r.client.Repositories.Sync(ctx, id); err != nil {
+		if errors.Is(err, service.ErrRepositoryArchived) {
+			middleware.WriteError(w, req, fmt.Errorf("%w: %w", err, middleware.ErrConflict), r.logger)
+			return
+		}
 		middleware.WriteError(w, req, err, r.logger)
 		return
 	}
@@ -1091,6 +1274,68 @@ func (r *RepositoriesRouter) Sync(w http.ResponseWriter, req *http.Request) {
 	w.WriteHeader(http.StatusAccepted)
 }
 
+// Archive handles POST /api/v1/repositories/{id}/archive.
+//
+//	@Summary		Archive repository
+//	@Description	Archive a repository: periodic sync and new manual syncs stop, but its existing snippets, embeddings, and enrichments remain searchable. Distinct from deletion, which removes the repository and all of its data.
+//	@Tags			repositories
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		int	true	"Repository ID"
+//	@Success		200	{object}	dto.RepositoryResponse
+//	@Failure		404	{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500	{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/repositories/{id}/archive [post]
+func (r *RepositoriesRouter) Archive(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	id, err := r.repositoryID(req)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	repo, err := r.client.Repositories.Archive(ctx, id)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, dto.RepositoryResponse{Data: repoToDTO(repo, 0, 0, 0, nil, nil)})
+}
+
+// Unarchive handles POST /api/v1/repositories/{id}/unarchive.
+//
+//	@Summary		Unarchive repository
+//	@Description	Clear a repository's archived state, allowing periodic sync and enrichment to resume on its normal schedule
+//	@Tags			repositories
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		int	true	"Repository ID"
+//	@Success		200	{object}	dto.RepositoryResponse
+//	@Failure		404	{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500	{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/repositories/{id}/unarchive [post]
+func (r *RepositoriesRouter) Unarchive(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	id, err := r.repositoryID(req)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	repo, err := r.client.Repositories.Unarchive(ctx, id)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, dto.RepositoryResponse{Data: repoToDTO(repo, 0, 0, 0, nil, nil)})
+}
+
 // ListRepositoryEnrichments handles GET /api/v1/repositories/{id}/enrichments.
 // Lists the most recent enrichments for a repository across commits.
 //
@@ -1102,8 +1347,9 @@ func (r *RepositoriesRouter) Sync(w http.ResponseWriter, req *http.Request) {
 //	@Param			id					path		int		true	"Repository ID"
 //	@Param			enrichment_type		query		string	false	"Filter by enrichment type"
 //	@Param			max_commits_to_check	query		int		false	"Max commits to check (default: 100)"
-//	@Param			page				query		int		false	"Page number (default: 1)"
+//	@Param			page				query		int		false	"Page number (default: 1); ignored if cursor is set"
 //	@Param			page_size			query		int		false	"Results per page (default: 20, max: 100)"
+//	@Param			cursor				query		string	false	"Opaque keyset cursor; switches to cursor-based pagination"
 //	@Success		200					{object}	dto.EnrichmentJSONAPIListResponse
 //	@Failure		404					{object}	middleware.JSONAPIErrorResponse
 //	@Failure		500					{object}	middleware.JSONAPIErrorResponse
@@ -1156,6 +1402,8 @@ func (r *RepositoriesRouter) ListRepositoryEnrichments(w http.ResponseWriter, re
 		Type:       typ,
 		Limit:      pagination.Limit(),
 		Offset:     pagination.Offset(),
+		Cursor:     pagination.Cursor(),
+		AfterID:    pagination.AfterID(),
 	}
 
 	enrichments, err := r.client.Enrichments.List(ctx, params)
@@ -1181,337 +1429,1146 @@ func (r *RepositoriesRouter) ListRepositoryEnrichments(w http.ResponseWriter, re
 		lineRanges = map[string]sourcelocation.SourceLocation{}
 	}
 
+	meta, links := PaginatedResponse(req, pagination, enrichments, total)
 	middleware.WriteJSON(w, http.StatusOK, dto.EnrichmentJSONAPIListResponse{
 		Data:  enrichmentsToJSONAPIDTO(enrichments, lineRanges),
-		Meta:  PaginationMeta(pagination, total),
-		Links: PaginationLinks(req, pagination, total),
+		Meta:  meta,
+		Links: links,
 	})
 }
 
-// GetWikiTree handles GET /api/v1/repositories/{id}/wiki.
+// ListRepositoryVulnerabilities handles GET /api/v1/repositories/{id}/vulnerabilities.
+// Lists known dependency vulnerabilities found across a repository's recent
+// commits, as discovered by the OSV-backed dependency scan.
 //
-//	@Summary		Get wiki tree
-//	@Description	Get the wiki navigation tree (titles and paths, no content)
+//	@Summary		List repository dependency vulnerabilities
+//	@Description	List known dependency vulnerabilities found across a repository's recent commits
 //	@Tags			repositories
 //	@Accept			json
 //	@Produce		json
-//	@Param			id	path		int	true	"Repository ID"
-//	@Success		200	{object}	dto.WikiTreeResponse
-//	@Failure		404	{object}	middleware.JSONAPIErrorResponse
-//	@Failure		500	{object}	middleware.JSONAPIErrorResponse
+//	@Param			id					path		int		true	"Repository ID"
+//	@Param			max_commits_to_check	query		int		false	"Max commits to check (default: 100)"
+//	@Param			page				query		int		false	"Page number (default: 1)"
+//	@Param			page_size			query		int		false	"Results per page (default: 20, max: 100)"
+//	@Success		200					{object}	dto.EnrichmentJSONAPIListResponse
+//	@Failure		404					{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500					{object}	middleware.JSONAPIErrorResponse
 //	@Security		APIKeyAuth
-//	@Router			/repositories/{id}/wiki [get]
-func (r *RepositoriesRouter) GetWikiTree(w http.ResponseWriter, req *http.Request) {
+//	@Router			/repositories/{id}/vulnerabilities [get]
+func (r *RepositoriesRouter) ListRepositoryVulnerabilities(w http.ResponseWriter, req *http.Request) {
 	ctx := req.Context()
-
-	id, err := r.repositoryID(req)
+	pagination, err := ParsePagination(req)
 	if err != nil {
 		middleware.WriteError(w, req, err, r.logger)
 		return
 	}
 
-	parsed, err := r.latestWiki(ctx, id)
+	id, err := r.repositoryID(req)
 	if err != nil {
 		middleware.WriteError(w, req, err, r.logger)
 		return
 	}
 
-	pathIndex := parsed.PathIndex()
-	data := make([]dto.WikiTreeNode, 0, len(parsed.Pages()))
-	for _, p := range parsed.Pages() {
-		data = append(data, wikiTreeNode(p, pathIndex))
+	maxCommits := 100
+	if maxStr := req.URL.Query().Get("max_commits_to_check"); maxStr != "" {
+		if parsed, err := strconv.Atoi(maxStr); err == nil && parsed > 0 {
+			maxCommits = parsed
+		}
 	}
 
-	middleware.WriteJSON(w, http.StatusOK, dto.WikiTreeResponse{Data: data})
-}
-
-// GetWikiPage handles GET /api/v1/repositories/{id}/wiki/*.
-// Serves a single wiki page as raw markdown with rewritten links.
-//
-//	@Summary		Get wiki page
-//	@Description	Get a wiki page by hierarchical path as raw markdown
-//	@Tags			repositories
-//	@Produce		text/markdown
-//	@Param			id		path		int		true	"Repository ID"
-//	@Param			path	path		string	true	"Wiki page path (e.g. architecture/database-layer.md)"
-//	@Success		200		{string}	string
-//	@Failure		404		{object}	middleware.JSONAPIErrorResponse
-//	@Failure		500		{object}	middleware.JSONAPIErrorResponse
-//	@Security		APIKeyAuth
-//	@Router			/repositories/{id}/wiki/{path} [get]
-func (r *RepositoriesRouter) GetWikiPage(w http.ResponseWriter, req *http.Request) {
-	ctx := req.Context()
-
-	id, err := r.repositoryID(req)
+	commits, err := r.client.Commits.Find(ctx, repository.WithRepoID(id), repository.WithLimit(maxCommits))
 	if err != nil {
 		middleware.WriteError(w, req, err, r.logger)
 		return
 	}
 
-	pagePath := strings.TrimPrefix(chi.URLParam(req, "*"), "/")
-	pagePath = strings.TrimSuffix(pagePath, ".md")
-	pagePath = strings.TrimSuffix(pagePath, "/")
+	commitSHAs := make([]string, 0, len(commits))
+	for _, c := range commits {
+		commitSHAs = append(commitSHAs, c.SHA())
+	}
 
-	parsed, err := r.latestWiki(ctx, id)
+	securityType := enrichment.TypeSecurity
+	params := &service.EnrichmentListParams{
+		CommitSHAs: commitSHAs,
+		Type:       &securityType,
+		Limit:      pagination.Limit(),
+		Offset:     pagination.Offset(),
+	}
+
+	vulnerabilities, err := r.client.Enrichments.List(ctx, params)
 	if err != nil {
 		middleware.WriteError(w, req, err, r.logger)
 		return
 	}
 
-	page, ok := parsed.PageByPath(pagePath)
-	if !ok {
-		middleware.WriteError(w, req, fmt.Errorf("wiki page %q not found: %w", pagePath, database.ErrNotFound), r.logger)
+	total, err := r.client.Enrichments.Count(ctx, params)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
 		return
 	}
 
-	pathIndex := parsed.PathIndex()
-	urlPrefix := fmt.Sprintf("/api/v1/repositories/%d/wiki", id)
-	rewritten := wiki.NewRewrittenContent(page.Content(), pathIndex, urlPrefix, ".md")
-
-	w.Header().Set("Content-Type", "text/markdown; charset=utf-8")
-	w.WriteHeader(http.StatusOK)
-	if _, err := fmt.Fprint(w, rewritten.String()); err != nil {
-		r.logger.Error().Err(err).Msg("failed to write wiki page response")
+	ids := make([]int64, len(vulnerabilities))
+	for i, e := range vulnerabilities {
+		ids[i] = e.ID()
+	}
+	lineRanges, err := r.client.Enrichments.SourceLocations(ctx, ids)
+	if err != nil {
+		r.logger.Warn().Err(err).Msg("failed to fetch line ranges")
+		lineRanges = map[string]sourcelocation.SourceLocation{}
 	}
+
+	middleware.WriteJSON(w, http.StatusOK, dto.EnrichmentJSONAPIListResponse{
+		Data:  enrichmentsToJSONAPIDTO(vulnerabilities, lineRanges),
+		Meta:  PaginationMeta(pagination, total),
+		Links: PaginationLinks(req, pagination, total),
+	})
 }
 
-// RescanWiki handles POST /api/v1/repositories/{id}/wiki/rescan.
+// ListRepositoryActivity handles GET /api/v1/repositories/{id}/activity.
+// Aggregates commits indexed, enrichments generated, syncs, and failures
+// across a repository's recent commits into a single reverse-chronological
+// feed, for powering dashboards and the repo page of a future web UI.
 //
-//	@Summary		Rescan wiki
-//	@Description	Delete the existing wiki and regenerate it from scratch
+//	@Summary		List repository activity feed
+//	@Description	Get a unified, reverse-chronological feed of recent repository events: commits indexed, enrichments generated, syncs, and failures
 //	@Tags			repositories
 //	@Accept			json
 //	@Produce		json
-//	@Param			id	path	int	true	"Repository ID"
-//	@Success		202
-//	@Failure		404	{object}	middleware.JSONAPIErrorResponse
-//	@Failure		500	{object}	middleware.JSONAPIErrorResponse
+//	@Param			id						path		int		true	"Repository ID"
+//	@Param			max_commits_to_check	query		int		false	"Max commits to check (default: 100)"
+//	@Param			page					query		int		false	"Page number (default: 1)"
+//	@Param			page_size				query		int		false	"Results per page (default: 20, max: 100)"
+//	@Success		200						{object}	dto.ActivityFeedListResponse
+//	@Failure		404						{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500						{object}	middleware.JSONAPIErrorResponse
 //	@Security		APIKeyAuth
-//	@Router			/repositories/{id}/wiki/rescan [post]
-func (r *RepositoriesRouter) RescanWiki(w http.ResponseWriter, req *http.Request) {
+//	@Router			/repositories/{id}/activity [get]
+func (r *RepositoriesRouter) ListRepositoryActivity(w http.ResponseWriter, req *http.Request) {
 	ctx := req.Context()
-
-	id, err := r.repositoryID(req)
+	pagination, err := ParsePagination(req)
 	if err != nil {
 		middleware.WriteError(w, req, err, r.logger)
 		return
 	}
 
-	// Find the latest commit for this repository.
-	commits, err := r.client.Commits.Find(ctx, repository.WithRepoID(id), repository.WithLimit(1))
+	id, err := r.repositoryID(req)
 	if err != nil {
 		middleware.WriteError(w, req, err, r.logger)
 		return
 	}
-	if len(commits) == 0 {
+
+	maxCommits := 100
+	if maxStr := req.URL.Query().Get("max_commits_to_check"); maxStr != "" {
+		if parsed, parseErr := strconv.Atoi(maxStr); parseErr == nil && parsed > 0 {
+			maxCommits = parsed
+		}
+	}
+
+	events, err := r.client.Activity.Feed(ctx, id, maxCommits, 0)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	total := int64(len(events))
+	page := events
+	if offset := pagination.Offset(); offset < len(page) {
+		page = page[offset:]
+	} else {
+		page = nil
+	}
+	if limit := pagination.Limit(); limit > 0 && limit < len(page) {
+		page = page[:limit]
+	}
+
+	data := make([]dto.ActivityEventData, 0, len(page))
+	for _, e := range page {
+		data = append(data, dto.ActivityEventData{
+			Type: "activity_event",
+			ID:   fmt.Sprintf("%s-%d", e.Type(), e.Timestamp().UnixNano()),
+			Attributes: dto.ActivityEventAttributes{
+				EventType: string(e.Type()),
+				Timestamp: e.Timestamp(),
+				Message:   e.Message(),
+				CommitSHA: e.CommitSHA(),
+			},
+		})
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, dto.ActivityFeedListResponse{
+		Data:  data,
+		Meta:  PaginationMeta(pagination, total),
+		Links: PaginationLinks(req, pagination, total),
+	})
+}
+
+// ListRepositoryExamples handles GET /api/v1/repositories/{id}/examples.
+// Lists code examples extracted from documentation across a repository's
+// recent commits, with the same language/path/query filters as the
+// top-level /examples endpoint.
+//
+//	@Summary		List repository examples
+//	@Description	List code examples extracted from documentation across a repository's recent commits
+//	@Tags			repositories
+//	@Accept			json
+//	@Produce		json
+//	@Param			id						path		int		true	"Repository ID"
+//	@Param			language				query		string	false	"Filter by source language (e.g. go, python)"
+//	@Param			path					query		string	false	"Filter by a substring of the source file path"
+//	@Param			query					query		string	false	"Filter by a substring of the example content"
+//	@Param			max_commits_to_check	query		int		false	"Max commits to check (default: 100)"
+//	@Param			page					query		int		false	"Page number (default: 1)"
+//	@Param			page_size				query		int		false	"Results per page (default: 20, max: 100)"
+//	@Success		200						{object}	dto.ExampleJSONAPIListResponse
+//	@Failure		404						{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500						{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/repositories/{id}/examples [get]
+func (r *RepositoriesRouter) ListRepositoryExamples(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	pagination, err := ParsePagination(req)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	id, err := r.repositoryID(req)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	maxCommits := 100
+	if maxStr := req.URL.Query().Get("max_commits_to_check"); maxStr != "" {
+		if parsed, err := strconv.Atoi(maxStr); err == nil && parsed > 0 {
+			maxCommits = parsed
+		}
+	}
+
+	commits, err := r.client.Commits.Find(ctx, repository.WithRepoID(id), repository.WithLimit(maxCommits))
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+	commitSHAs := make([]string, 0, len(commits))
+	for _, c := range commits {
+		commitSHAs = append(commitSHAs, c.SHA())
+	}
+
+	typ := enrichment.TypeDevelopment
+	subtype := enrichment.SubtypeExample
+	params := &service.EnrichmentListParams{
+		CommitSHAs: commitSHAs,
+		Type:       &typ,
+		Subtype:    &subtype,
+		Language:   req.URL.Query().Get("language"),
+		Query:      req.URL.Query().Get("query"),
+	}
+
+	pathFilter := req.URL.Query().Get("path")
+	if pathFilter == "" {
+		params.Limit = pagination.Limit()
+		params.Offset = pagination.Offset()
+	}
+
+	examples, err := r.client.Enrichments.List(ctx, params)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	paths, err := r.examplePaths(ctx, examples)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	var total int64
+	if pathFilter != "" {
+		examples, paths = filterExamplesByPath(examples, paths, pathFilter)
+		total = int64(len(examples))
+		examples, paths = paginateExamples(examples, paths, pagination.Limit(), pagination.Offset())
+	} else {
+		total, err = r.client.Enrichments.Count(ctx, params)
+		if err != nil {
+			middleware.WriteError(w, req, err, r.logger)
+			return
+		}
+	}
+
+	ids := make([]int64, len(examples))
+	for i, e := range examples {
+		ids[i] = e.ID()
+	}
+	lineRanges, err := r.client.Enrichments.SourceLocations(ctx, ids)
+	if err != nil {
+		r.logger.Warn().Err(err).Msg("failed to fetch line ranges")
+		lineRanges = map[string]sourcelocation.SourceLocation{}
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, dto.ExampleJSONAPIListResponse{
+		Data:  examplesToJSONAPIDTO(examples, paths, lineRanges),
+		Meta:  PaginationMeta(pagination, total),
+		Links: PaginationLinks(req, pagination, total),
+	})
+}
+
+// examplePaths resolves source file paths for the given examples, keyed by
+// enrichment ID string. Delegates to the same resolution ExamplesRouter uses
+// so the two surfaces stay consistent.
+func (r *RepositoriesRouter) examplePaths(ctx context.Context, examples []enrichment.Enrichment) (map[string]string, error) {
+	if len(examples) == 0 {
+		return map[string]string{}, nil
+	}
+
+	ids := make([]int64, len(examples))
+	for i, e := range examples {
+		ids[i] = e.ID()
+	}
+
+	fileIDsByEnrichment, err := r.client.Enrichments.SourceFiles(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("resolve example file associations: %w", err)
+	}
+	if len(fileIDsByEnrichment) == 0 {
+		return map[string]string{}, nil
+	}
+
+	var allFileIDs []int64
+	for _, fileIDs := range fileIDsByEnrichment {
+		allFileIDs = append(allFileIDs, fileIDs...)
+	}
+
+	files, err := r.client.Files.Find(ctx, repository.WithIDIn(allFileIDs))
+	if err != nil {
+		return nil, fmt.Errorf("find example source files: %w", err)
+	}
+	pathByFileID := make(map[int64]string, len(files))
+	for _, f := range files {
+		pathByFileID[f.ID()] = f.Path()
+	}
+
+	paths := make(map[string]string, len(fileIDsByEnrichment))
+	for enrichmentID, fileIDs := range fileIDsByEnrichment {
+		if len(fileIDs) == 0 {
+			continue
+		}
+		if p, ok := pathByFileID[fileIDs[0]]; ok {
+			paths[enrichmentID] = p
+		}
+	}
+	return paths, nil
+}
+
+// GetWikiTree handles GET /api/v1/repositories/{id}/wiki.
+//
+//	@Summary		Get wiki tree
+//	@Description	Get the wiki navigation tree (titles and paths, no content)
+//	@Tags			repositories
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		int	true	"Repository ID"
+//	@Success		200	{object}	dto.WikiTreeResponse
+//	@Failure		404	{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500	{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/repositories/{id}/wiki [get]
+func (r *RepositoriesRouter) GetWikiTree(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	id, err := r.repositoryID(req)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	parsed, err := r.latestWiki(ctx, id)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	pathIndex := parsed.PathIndex()
+	data := make([]dto.WikiTreeNode, 0, len(parsed.Pages()))
+	for _, p := range parsed.Pages() {
+		data = append(data, wikiTreeNode(p, pathIndex))
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, dto.WikiTreeResponse{Data: data})
+}
+
+// SearchWiki handles GET /api/v1/repositories/{id}/wiki/search.
+//
+//	@Summary		Search wiki pages
+//	@Description	Hybrid (text vector + keyword) search over a repository's indexed wiki pages
+//	@Tags			repositories
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int		true	"Repository ID"
+//	@Param			query	query		string	true	"Search query"
+//	@Param			limit	query		int		false	"Maximum number of results (default 10)"
+//	@Success		200		{object}	dto.WikiSearchResponse
+//	@Failure		400		{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500		{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/repositories/{id}/wiki/search [get]
+func (r *RepositoriesRouter) SearchWiki(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	id, err := r.repositoryID(req)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	query := req.URL.Query().Get("query")
+	if query == "" {
+		middleware.WriteError(w, req, fmt.Errorf("query parameter is required"), r.logger)
+		return
+	}
+
+	limit := 10
+	if limitStr := req.URL.Query().Get("limit"); limitStr != "" {
+		if parsed, err := strconv.Atoi(limitStr); err == nil && parsed > 0 {
+			limit = parsed
+		}
+	}
+
+	matches, err := r.client.Search.SearchWiki(ctx, id, query, limit)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	data := make([]dto.WikiSearchResult, 0, len(matches))
+	for _, m := range matches {
+		data = append(data, dto.WikiSearchResult{
+			Slug:    m.Slug,
+			Title:   m.Title,
+			Content: m.Content,
+			Score:   m.Score,
+		})
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, dto.WikiSearchResponse{Data: data})
+}
+
+// GetWikiPage handles GET /api/v1/repositories/{id}/wiki/*.
+// Serves a single wiki page as raw markdown with rewritten links.
+//
+//	@Summary		Get wiki page
+//	@Description	Get a wiki page by hierarchical path as raw markdown
+//	@Tags			repositories
+//	@Produce		text/markdown
+//	@Param			id		path		int		true	"Repository ID"
+//	@Param			path	path		string	true	"Wiki page path (e.g. architecture/database-layer.md)"
+//	@Success		200		{string}	string
+//	@Failure		404		{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500		{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/repositories/{id}/wiki/{path} [get]
+func (r *RepositoriesRouter) GetWikiPage(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	id, err := r.repositoryID(req)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	pagePath := strings.TrimPrefix(chi.URLParam(req, "*"), "/")
+	pagePath = strings.TrimSuffix(pagePath, ".md")
+	pagePath = strings.TrimSuffix(pagePath, "/")
+
+	parsed, err := r.latestWiki(ctx, id)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	page, ok := parsed.PageByPath(pagePath)
+	if !ok {
+		middleware.WriteError(w, req, fmt.Errorf("wiki page %q not found: %w", pagePath, database.ErrNotFound), r.logger)
+		return
+	}
+
+	pathIndex := parsed.PathIndex()
+	urlPrefix := fmt.Sprintf("/api/v1/repositories/%d/wiki", id)
+	rewritten := wiki.NewRewrittenContent(page.Content(), pathIndex, urlPrefix, ".md")
+
+	w.Header().Set("Content-Type", "text/markdown; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	if _, err := fmt.Fprint(w, rewritten.String()); err != nil {
+		r.logger.Error().Err(err).Msg("failed to write wiki page response")
+	}
+}
+
+// RescanWiki handles POST /api/v1/repositories/{id}/wiki/rescan.
+//
+//	@Summary		Rescan wiki
+//	@Description	Delete the existing wiki and regenerate it from scratch
+//	@Tags			repositories
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path	int	true	"Repository ID"
+//	@Success		202
+//	@Failure		404	{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500	{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/repositories/{id}/wiki/rescan [post]
+func (r *RepositoriesRouter) RescanWiki(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	id, err := r.repositoryID(req)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	// Find the latest commit for this repository.
+	commits, err := r.client.Commits.Find(ctx, repository.WithRepoID(id), repository.WithLimit(1))
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+	if len(commits) == 0 {
 		middleware.WriteError(w, req, fmt.Errorf("no commits found: %w", database.ErrNotFound), r.logger)
 		return
 	}
 
-	payload := map[string]any{
-		"repository_id": id,
-		"commit_sha":    commits[0].SHA(),
+	payload := map[string]any{
+		"repository_id": id,
+		"commit_sha":    commits[0].SHA(),
+	}
+	operations := []task.Operation{task.OperationGenerateWikiForCommit}
+	if err := r.client.Tasks.EnqueueOperations(ctx, operations, task.PriorityUserInitiated, payload); err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// latestWiki finds the most recent wiki enrichment for a repository.
+func (r *RepositoriesRouter) latestWiki(ctx context.Context, repoID int64) (wiki.Wiki, error) {
+	commits, err := r.client.Commits.Find(ctx, repository.WithRepoID(repoID))
+	if err != nil {
+		return wiki.Wiki{}, fmt.Errorf("find commits: %w", err)
+	}
+
+	shas := make([]string, 0, len(commits))
+	for _, c := range commits {
+		shas = append(shas, c.SHA())
+	}
+
+	if len(shas) == 0 {
+		return wiki.Wiki{}, fmt.Errorf("no commits found for repository: %w", database.ErrNotFound)
+	}
+
+	wikiType := enrichment.TypeUsage
+	wikiSubtype := enrichment.SubtypeWiki
+	enrichments, err := r.client.Enrichments.List(ctx, &service.EnrichmentListParams{
+		CommitSHAs: shas,
+		Type:       &wikiType,
+		Subtype:    &wikiSubtype,
+		Limit:      1,
+	})
+	if err != nil {
+		return wiki.Wiki{}, fmt.Errorf("find wiki enrichment: %w", err)
+	}
+
+	if len(enrichments) == 0 {
+		return wiki.Wiki{}, fmt.Errorf("no wiki found for repository: %w", database.ErrNotFound)
+	}
+
+	parsed, err := wiki.ParseWiki(enrichments[0].Content())
+	if err != nil {
+		return wiki.Wiki{}, fmt.Errorf("parse wiki content: %w", err)
+	}
+
+	return parsed, nil
+}
+
+func wikiTreeNode(p wiki.Page, pathIndex map[string]string) dto.WikiTreeNode {
+	children := make([]dto.WikiTreeNode, 0, len(p.Children()))
+	for _, child := range p.Children() {
+		children = append(children, wikiTreeNode(child, pathIndex))
+	}
+
+	return dto.WikiTreeNode{
+		Slug:     p.Slug(),
+		Title:    p.Title(),
+		Path:     pathIndex[p.Slug()] + ".md",
+		Children: children,
+	}
+}
+
+// ListTags handles GET /api/v1/repositories/{id}/tags.
+//
+//	@Summary		List tags
+//	@Description	List tags for a repository
+//	@Tags			repositories
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		int	true	"Repository ID"
+//	@Param			page		query		int	false	"Page number (default: 1)"
+//	@Param			page_size	query		int	false	"Results per page (default: 20, max: 100)"
+//	@Success		200			{object}	dto.TagJSONAPIListResponse
+//	@Failure		404	{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500	{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/repositories/{id}/tags [get]
+func (r *RepositoriesRouter) ListTags(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	pagination, err := ParsePagination(req)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	id, err := r.repositoryID(req)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	filterOpts := []repository.Option{repository.WithRepoID(id)}
+	tags, err := r.client.Tags.Find(ctx, append(filterOpts, pagination.Options()...)...)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	total, err := r.client.Tags.Count(ctx, filterOpts...)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	data := make([]dto.TagData, 0, len(tags))
+	for _, tag := range tags {
+		data = append(data, dto.TagData{
+			Type: "tag",
+			ID:   tag.Name(),
+			Attributes: dto.TagAttributes{
+				Name:            tag.Name(),
+				TargetCommitSHA: tag.CommitSHA(),
+				IsVersionTag:    isVersionTag(tag.Name()),
+			},
+		})
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, dto.TagJSONAPIListResponse{
+		Data:  data,
+		Meta:  PaginationMeta(pagination, total),
+		Links: PaginationLinks(req, pagination, total),
+	})
+}
+
+// GetTag handles GET /api/v1/repositories/{id}/tags/{tag_name}.
+//
+//	@Summary		Get tag
+//	@Description	Get a tag by name
+//	@Tags			repositories
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		int		true	"Repository ID"
+//	@Param			tag_name	path		string	true	"Tag name"
+//	@Success		200			{object}	dto.TagJSONAPIResponse
+//	@Failure		404			{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500			{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/repositories/{id}/tags/{tag_name} [get]
+func (r *RepositoriesRouter) GetTag(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	id, err := r.repositoryID(req)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	tagName := chi.URLParam(req, "tag_name")
+
+	tag, err := r.client.Tags.Get(ctx, repository.WithName(tagName), repository.WithRepoID(id))
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
 	}
-	operations := []task.Operation{task.OperationGenerateWikiForCommit}
-	if err := r.client.Tasks.EnqueueOperations(ctx, operations, task.PriorityUserInitiated, payload); err != nil {
+
+	middleware.WriteJSON(w, http.StatusOK, dto.TagJSONAPIResponse{
+		Data: dto.TagData{
+			Type: "tag",
+			ID:   tag.Name(),
+			Attributes: dto.TagAttributes{
+				Name:            tag.Name(),
+				TargetCommitSHA: tag.CommitSHA(),
+				IsVersionTag:    isVersionTag(tag.Name()),
+			},
+		},
+	})
+}
+
+// isVersionTag returns true if the tag name looks like a version tag.
+// Version tags typically start with 'v' followed by a digit, or match semver patterns.
+func isVersionTag(name string) bool {
+	if len(name) == 0 {
+		return false
+	}
+	// Check for v-prefix version tags (v1.0.0, v2, etc.)
+	if name[0] == 'v' && len(name) > 1 && name[1] >= '0' && name[1] <= '9' {
+		return true
+	}
+	// Check for plain numeric version tags (1.0.0, 2.0, etc.)
+	if name[0] >= '0' && name[0] <= '9' {
+		return true
+	}
+	return false
+}
+
+// GetTrackingConfig handles GET /api/v1/repositories/{id}/tracking-config.
+//
+//	@Summary		Get tracking config
+//	@Description	Get current tracking configuration for a repository
+//	@Tags			repositories
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		int	true	"Repository ID"
+//	@Success		200	{object}	dto.TrackingConfigResponse
+//	@Failure		404	{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500	{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/repositories/{id}/tracking-config [get]
+func (r *RepositoriesRouter) GetTrackingConfig(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	idStr := chi.URLParam(req, "id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
 		middleware.WriteError(w, req, err, r.logger)
 		return
 	}
 
-	w.WriteHeader(http.StatusAccepted)
+	repo, err := r.client.Repositories.Get(ctx, repository.WithID(id))
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	tc := repo.TrackingConfig()
+	middleware.WriteJSON(w, http.StatusOK, trackingConfigToResponse(tc))
 }
 
-// latestWiki finds the most recent wiki enrichment for a repository.
-func (r *RepositoriesRouter) latestWiki(ctx context.Context, repoID int64) (wiki.Wiki, error) {
-	commits, err := r.client.Commits.Find(ctx, repository.WithRepoID(repoID))
+// UpdateTrackingConfig handles PUT /api/v1/repositories/{id}/tracking-config.
+//
+//	@Summary		Update tracking config
+//	@Description	Update tracking configuration for a repository
+//	@Tags			repositories
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int									true	"Repository ID"
+//	@Param			body	body		dto.TrackingConfigUpdateRequest		true	"Tracking config"
+//	@Success		200		{object}	dto.TrackingConfigResponse
+//	@Failure		404		{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500		{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/repositories/{id}/tracking-config [put]
+func (r *RepositoriesRouter) UpdateTrackingConfig(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	idStr := chi.URLParam(req, "id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
-		return wiki.Wiki{}, fmt.Errorf("find commits: %w", err)
+		middleware.WriteError(w, req, err, r.logger)
+		return
 	}
 
-	shas := make([]string, 0, len(commits))
-	for _, c := range commits {
-		shas = append(shas, c.SHA())
+	var body dto.TrackingConfigUpdateRequest
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
 	}
 
-	if len(shas) == 0 {
-		return wiki.Wiki{}, fmt.Errorf("no commits found for repository: %w", database.ErrNotFound)
+	// Convert JSON:API request to tracking config params
+	var branch, tag string
+	switch body.Data.Attributes.Mode {
+	case dto.TrackingModeBranch:
+		if body.Data.Attributes.Value != nil {
+			branch = *body.Data.Attributes.Value
+		}
+	case dto.TrackingModeTag:
+		if body.Data.Attributes.Value != nil {
+			tag = *body.Data.Attributes.Value
+		}
+	}
+
+	source, err := r.client.Repositories.UpdateTrackingConfig(ctx, id, &service.TrackingConfigParams{
+		Branch: branch,
+		Tag:    tag,
+	})
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	updatedTC := source.Repo().TrackingConfig()
+	middleware.WriteJSON(w, http.StatusOK, trackingConfigToResponse(updatedTC))
+}
+
+// GetChunkingConfig handles GET /api/v1/repositories/{id}/config/chunking.
+//
+//	@Summary		Get chunking config
+//	@Description	Get current chunking configuration for a repository
+//	@Tags			repositories
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		int	true	"Repository ID"
+//	@Success		200	{object}	dto.ChunkingConfigResponse
+//	@Failure		404	{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500	{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/repositories/{id}/config/chunking [get]
+func (r *RepositoriesRouter) GetChunkingConfig(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	idStr := chi.URLParam(req, "id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	repo, err := r.client.Repositories.Get(ctx, repository.WithID(id))
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, chunkingConfigToResponse(repo.ChunkingConfig()))
+}
+
+// UpdateChunkingConfig handles PUT /api/v1/repositories/{id}/config/chunking.
+//
+//	@Summary		Update chunking config
+//	@Description	Update chunking configuration for a repository
+//	@Tags			repositories
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int								true	"Repository ID"
+//	@Param			body	body		dto.ChunkingConfigUpdateRequest	true	"Chunking config"
+//	@Success		200		{object}	dto.ChunkingConfigResponse
+//	@Failure		400		{object}	middleware.JSONAPIErrorResponse
+//	@Failure		404		{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500		{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/repositories/{id}/config/chunking [put]
+func (r *RepositoriesRouter) UpdateChunkingConfig(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	idStr := chi.URLParam(req, "id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	var body dto.ChunkingConfigUpdateRequest
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	saved, err := r.client.Repositories.UpdateChunkingConfig(ctx, id, &service.ChunkingConfigParams{
+		Size:    body.Data.Attributes.ChunkSize,
+		Overlap: body.Data.Attributes.ChunkOverlap,
+		MinSize: body.Data.Attributes.MinChunkSize,
+	})
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, chunkingConfigToResponse(saved.ChunkingConfig()))
+}
+
+func chunkingConfigToResponse(cc repository.ChunkingConfig) dto.ChunkingConfigResponse {
+	return dto.ChunkingConfigResponse{
+		Data: dto.ChunkingConfigData{
+			Type: "chunking-config",
+			Attributes: dto.ChunkingConfigAttributes{
+				ChunkSize:    cc.Size(),
+				ChunkOverlap: cc.Overlap(),
+				MinChunkSize: cc.MinSize(),
+			},
+		},
+	}
+}
+
+// GetEnrichmentBudget handles GET /api/v1/repositories/{id}/config/enrichment-budget.
+//
+//	@Summary		Get enrichment budget
+//	@Description	Get the current enrichment budget for a repository (the cap on how many files are AI-summarized per commit)
+//	@Tags			repositories
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		int	true	"Repository ID"
+//	@Success		200	{object}	dto.EnrichmentBudgetResponse
+//	@Failure		404	{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500	{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/repositories/{id}/config/enrichment-budget [get]
+func (r *RepositoriesRouter) GetEnrichmentBudget(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	idStr := chi.URLParam(req, "id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	repo, err := r.client.Repositories.Get(ctx, repository.WithID(id))
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
 	}
 
-	wikiType := enrichment.TypeUsage
-	wikiSubtype := enrichment.SubtypeWiki
-	enrichments, err := r.client.Enrichments.List(ctx, &service.EnrichmentListParams{
-		CommitSHAs: shas,
-		Type:       &wikiType,
-		Subtype:    &wikiSubtype,
-		Limit:      1,
-	})
+	middleware.WriteJSON(w, http.StatusOK, enrichmentBudgetToResponse(repo.EnrichmentBudget()))
+}
+
+// UpdateEnrichmentBudget handles PUT /api/v1/repositories/{id}/config/enrichment-budget.
+//
+//	@Summary		Update enrichment budget
+//	@Description	Set the cap on how many files are AI-summarized per commit for a repository. The largest files (the closest available proxy for importance, since no import-graph centrality is tracked) are prioritized; a cap of 0 means unlimited.
+//	@Tags			repositories
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int									true	"Repository ID"
+//	@Param			body	body		dto.EnrichmentBudgetUpdateRequest	true	"Enrichment budget"
+//	@Success		200		{object}	dto.EnrichmentBudgetResponse
+//	@Failure		400		{object}	middleware.JSONAPIErrorResponse
+//	@Failure		404		{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500		{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/repositories/{id}/config/enrichment-budget [put]
+func (r *RepositoriesRouter) UpdateEnrichmentBudget(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	idStr := chi.URLParam(req, "id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
-		return wiki.Wiki{}, fmt.Errorf("find wiki enrichment: %w", err)
+		middleware.WriteError(w, req, err, r.logger)
+		return
 	}
 
-	if len(enrichments) == 0 {
-		return wiki.Wiki{}, fmt.Errorf("no wiki found for repository: %w", database.ErrNotFound)
+	var body dto.EnrichmentBudgetUpdateRequest
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
 	}
 
-	parsed, err := wiki.ParseWiki(enrichments[0].Content())
+	saved, err := r.client.Repositories.UpdateEnrichmentBudget(ctx, id, &service.EnrichmentBudgetParams{
+		MaxFileSummaries: body.Data.Attributes.MaxFileSummaries,
+	})
 	if err != nil {
-		return wiki.Wiki{}, fmt.Errorf("parse wiki content: %w", err)
+		middleware.WriteError(w, req, err, r.logger)
+		return
 	}
 
-	return parsed, nil
+	middleware.WriteJSON(w, http.StatusOK, enrichmentBudgetToResponse(saved.EnrichmentBudget()))
 }
 
-func wikiTreeNode(p wiki.Page, pathIndex map[string]string) dto.WikiTreeNode {
-	children := make([]dto.WikiTreeNode, 0, len(p.Children()))
-	for _, child := range p.Children() {
-		children = append(children, wikiTreeNode(child, pathIndex))
-	}
-
-	return dto.WikiTreeNode{
-		Slug:     p.Slug(),
-		Title:    p.Title(),
-		Path:     pathIndex[p.Slug()] + ".md",
-		Children: children,
+func enrichmentBudgetToResponse(b repository.EnrichmentBudgetConfig) dto.EnrichmentBudgetResponse {
+	return dto.EnrichmentBudgetResponse{
+		Data: dto.EnrichmentBudgetData{
+			Type: "enrichment-budget",
+			Attributes: dto.EnrichmentBudgetAttributes{
+				MaxFileSummaries: b.MaxFileSummaries(),
+			},
+		},
 	}
 }
 
-// ListTags handles GET /api/v1/repositories/{id}/tags.
+// GetEmbeddingConfig handles GET /api/v1/repositories/{id}/config/embedding.
 //
-//	@Summary		List tags
-//	@Description	List tags for a repository
+//	@Summary		Get embedding config
+//	@Description	Get whether comments and docstrings are stripped from chunk content before it is sent for embedding. The displayed snippet always keeps the original content.
 //	@Tags			repositories
 //	@Accept			json
 //	@Produce		json
-//	@Param			id			path		int	true	"Repository ID"
-//	@Param			page		query		int	false	"Page number (default: 1)"
-//	@Param			page_size	query		int	false	"Results per page (default: 20, max: 100)"
-//	@Success		200			{object}	dto.TagJSONAPIListResponse
+//	@Param			id	path		int	true	"Repository ID"
+//	@Success		200	{object}	dto.EmbeddingConfigResponse
 //	@Failure		404	{object}	middleware.JSONAPIErrorResponse
 //	@Failure		500	{object}	middleware.JSONAPIErrorResponse
 //	@Security		APIKeyAuth
-//	@Router			/repositories/{id}/tags [get]
-func (r *RepositoriesRouter) ListTags(w http.ResponseWriter, req *http.Request) {
+//	@Router			/repositories/{id}/config/embedding [get]
+func (r *RepositoriesRouter) GetEmbeddingConfig(w http.ResponseWriter, req *http.Request) {
 	ctx := req.Context()
-	pagination, err := ParsePagination(req)
+
+	idStr := chi.URLParam(req, "id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
 		middleware.WriteError(w, req, err, r.logger)
 		return
 	}
 
-	id, err := r.repositoryID(req)
+	repo, err := r.client.Repositories.Get(ctx, repository.WithID(id))
 	if err != nil {
 		middleware.WriteError(w, req, err, r.logger)
 		return
 	}
 
-	filterOpts := []repository.Option{repository.WithRepoID(id)}
-	tags, err := r.client.Tags.Find(ctx, append(filterOpts, pagination.Options()...)...)
+	middleware.WriteJSON(w, http.StatusOK, embeddingConfigToResponse(repo.EmbeddingConfig()))
+}
+
+// UpdateEmbeddingConfig handles PUT /api/v1/repositories/{id}/config/embedding.
+//
+//	@Summary		Update embedding config
+//	@Description	Set whether comments and docstrings are stripped from chunk content before it is sent for embedding. Re-embed the repository afterward for the change to take effect on existing snippets.
+//	@Tags			repositories
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int								true	"Repository ID"
+//	@Param			body	body		dto.EmbeddingConfigUpdateRequest	true	"Embedding config"
+//	@Success		200		{object}	dto.EmbeddingConfigResponse
+//	@Failure		400		{object}	middleware.JSONAPIErrorResponse
+//	@Failure		404		{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500		{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/repositories/{id}/config/embedding [put]
+func (r *RepositoriesRouter) UpdateEmbeddingConfig(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	idStr := chi.URLParam(req, "id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
 		middleware.WriteError(w, req, err, r.logger)
 		return
 	}
 
-	total, err := r.client.Tags.Count(ctx, filterOpts...)
+	var body dto.EmbeddingConfigUpdateRequest
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	saved, err := r.client.Repositories.UpdateEmbeddingConfig(ctx, id, body.Data.Attributes.StripComments)
 	if err != nil {
 		middleware.WriteError(w, req, err, r.logger)
 		return
 	}
 
-	data := make([]dto.TagData, 0, len(tags))
-	for _, tag := range tags {
-		data = append(data, dto.TagData{
-			Type: "tag",
-			ID:   tag.Name(),
-			Attributes: dto.TagAttributes{
-				Name:            tag.Name(),
-				TargetCommitSHA: tag.CommitSHA(),
-				IsVersionTag:    isVersionTag(tag.Name()),
+	middleware.WriteJSON(w, http.StatusOK, embeddingConfigToResponse(saved.EmbeddingConfig()))
+}
+
+func embeddingConfigToResponse(c repository.EmbeddingConfig) dto.EmbeddingConfigResponse {
+	return dto.EmbeddingConfigResponse{
+		Data: dto.EmbeddingConfigData{
+			Type: "embedding-config",
+			Attributes: dto.EmbeddingConfigAttributes{
+				StripComments: c.StripComments(),
 			},
-		})
+		},
 	}
-
-	middleware.WriteJSON(w, http.StatusOK, dto.TagJSONAPIListResponse{
-		Data:  data,
-		Meta:  PaginationMeta(pagination, total),
-		Links: PaginationLinks(req, pagination, total),
-	})
 }
 
-// GetTag handles GET /api/v1/repositories/{id}/tags/{tag_name}.
+// GetAccessConfig handles GET /api/v1/repositories/{id}/config/access.
 //
-//	@Summary		Get tag
-//	@Description	Get a tag by name
+//	@Summary		Get access config
+//	@Description	Get the deny globs that block matching file paths from being read back through the blob API and MCP file resource. Denied files remain indexed and searchable, but their content cannot be fetched.
 //	@Tags			repositories
 //	@Accept			json
 //	@Produce		json
-//	@Param			id			path		int		true	"Repository ID"
-//	@Param			tag_name	path		string	true	"Tag name"
-//	@Success		200			{object}	dto.TagJSONAPIResponse
-//	@Failure		404			{object}	middleware.JSONAPIErrorResponse
-//	@Failure		500			{object}	middleware.JSONAPIErrorResponse
+//	@Param			id	path		int	true	"Repository ID"
+//	@Success		200	{object}	dto.AccessConfigResponse
+//	@Failure		404	{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500	{object}	middleware.JSONAPIErrorResponse
 //	@Security		APIKeyAuth
-//	@Router			/repositories/{id}/tags/{tag_name} [get]
-func (r *RepositoriesRouter) GetTag(w http.ResponseWriter, req *http.Request) {
+//	@Router			/repositories/{id}/config/access [get]
+func (r *RepositoriesRouter) GetAccessConfig(w http.ResponseWriter, req *http.Request) {
 	ctx := req.Context()
 
-	id, err := r.repositoryID(req)
+	idStr := chi.URLParam(req, "id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
 		middleware.WriteError(w, req, err, r.logger)
 		return
 	}
 
-	tagName := chi.URLParam(req, "tag_name")
-
-	tag, err := r.client.Tags.Get(ctx, repository.WithName(tagName), repository.WithRepoID(id))
+	repo, err := r.client.Repositories.Get(ctx, repository.WithID(id))
 	if err != nil {
 		middleware.WriteError(w, req, err, r.logger)
 		return
 	}
 
-	middleware.WriteJSON(w, http.StatusOK, dto.TagJSONAPIResponse{
-		Data: dto.TagData{
-			Type: "tag",
-			ID:   tag.Name(),
-			Attributes: dto.TagAttributes{
-				Name:            tag.Name(),
-				TargetCommitSHA: tag.CommitSHA(),
-				IsVersionTag:    isVersionTag(tag.Name()),
-			},
-		},
-	})
+	middleware.WriteJSON(w, http.StatusOK, accessConfigToResponse(repo.AccessConfig()))
 }
 
-// isVersionTag returns true if the tag name looks like a version tag.
-// Version tags typically start with 'v' followed by a digit, or match semver patterns.
-func isVersionTag(name string) bool {
-	if len(name) == 0 {
-		return false
+// UpdateAccessConfig handles PUT /api/v1/repositories/{id}/config/access.
+//
+//	@Summary		Update access config
+//	@Description	Set the deny globs (e.g. "**/secrets/**") that block matching file paths from being read back through the blob API and MCP file resource.
+//	@Tags			repositories
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int								true	"Repository ID"
+//	@Param			body	body		dto.AccessConfigUpdateRequest	true	"Access config"
+//	@Success		200		{object}	dto.AccessConfigResponse
+//	@Failure		400		{object}	middleware.JSONAPIErrorResponse
+//	@Failure		404		{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500		{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/repositories/{id}/config/access [put]
+func (r *RepositoriesRouter) UpdateAccessConfig(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	idStr := chi.URLParam(req, "id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
 	}
-	// Check for v-prefix version tags (v1.0.0, v2, etc.)
-	if name[0] == 'v' && len(name) > 1 && name[1] >= '0' && name[1] <= '9' {
-		return true
+
+	var body dto.AccessConfigUpdateRequest
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
 	}
-	// Check for plain numeric version tags (1.0.0, 2.0, etc.)
-	if name[0] >= '0' && name[0] <= '9' {
-		return true
+
+	saved, err := r.client.Repositories.UpdateAccessConfig(ctx, id, body.Data.Attributes.DenyGlobs)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
 	}
-	return false
+
+	middleware.WriteJSON(w, http.StatusOK, accessConfigToResponse(saved.AccessConfig()))
 }
 
-// GetTrackingConfig handles GET /api/v1/repositories/{id}/tracking-config.
+func accessConfigToResponse(c repository.AccessConfig) dto.AccessConfigResponse {
+	return dto.AccessConfigResponse{
+		Data: dto.AccessConfigData{
+			Type: "access-config",
+			Attributes: dto.AccessConfigAttributes{
+				DenyGlobs: c.DenyGlobs(),
+			},
+		},
+	}
+}
+
+// GetIndexFilterConfig handles GET /api/v1/repositories/{id}/config/index-filter.
 //
-//	@Summary		Get tracking config
-//	@Description	Get current tracking configuration for a repository
+//	@Summary		Get index filter config
+//	@Description	Get the index_paths and ignore_paths globs that control which files are eligible for snippet extraction and enrichment. An empty index_paths means all files are considered, subject to ignore_paths.
 //	@Tags			repositories
 //	@Accept			json
 //	@Produce		json
 //	@Param			id	path		int	true	"Repository ID"
-//	@Success		200	{object}	dto.TrackingConfigResponse
+//	@Success		200	{object}	dto.IndexFilterConfigResponse
 //	@Failure		404	{object}	middleware.JSONAPIErrorResponse
 //	@Failure		500	{object}	middleware.JSONAPIErrorResponse
 //	@Security		APIKeyAuth
-//	@Router			/repositories/{id}/tracking-config [get]
-func (r *RepositoriesRouter) GetTrackingConfig(w http.ResponseWriter, req *http.Request) {
+//	@Router			/repositories/{id}/config/index-filter [get]
+func (r *RepositoriesRouter) GetIndexFilterConfig(w http.ResponseWriter, req *http.Request) {
 	ctx := req.Context()
 
 	idStr := chi.URLParam(req, "id")
@@ -1527,25 +2584,25 @@ func (r *RepositoriesRouter) GetTrackingConfig(w http.ResponseWriter, req *http.
 		return
 	}
 
-	tc := repo.TrackingConfig()
-	middleware.WriteJSON(w, http.StatusOK, trackingConfigToResponse(tc))
+	middleware.WriteJSON(w, http.StatusOK, indexFilterConfigToResponse(repo.IndexFilterConfig()))
 }
 
-// UpdateTrackingConfig handles PUT /api/v1/repositories/{id}/tracking-config.
+// UpdateIndexFilterConfig handles PUT /api/v1/repositories/{id}/config/index-filter.
 //
-//	@Summary		Update tracking config
-//	@Description	Update tracking configuration for a repository
+//	@Summary		Update index filter config
+//	@Description	Set the index_paths and ignore_paths globs (e.g. "vendor/**", "**/*.gen.go") that exclude vendored code, generated files, and test fixtures from snippet extraction and enrichment.
 //	@Tags			repositories
 //	@Accept			json
 //	@Produce		json
 //	@Param			id		path		int									true	"Repository ID"
-//	@Param			body	body		dto.TrackingConfigUpdateRequest		true	"Tracking config"
-//	@Success		200		{object}	dto.TrackingConfigResponse
+//	@Param			body	body		dto.IndexFilterConfigUpdateRequest	true	"Index filter config"
+//	@Success		200		{object}	dto.IndexFilterConfigResponse
+//	@Failure		400		{object}	middleware.JSONAPIErrorResponse
 //	@Failure		404		{object}	middleware.JSONAPIErrorResponse
 //	@Failure		500		{object}	middleware.JSONAPIErrorResponse
 //	@Security		APIKeyAuth
-//	@Router			/repositories/{id}/tracking-config [put]
-func (r *RepositoriesRouter) UpdateTrackingConfig(w http.ResponseWriter, req *http.Request) {
+//	@Router			/repositories/{id}/config/index-filter [put]
+func (r *RepositoriesRouter) UpdateIndexFilterConfig(w http.ResponseWriter, req *http.Request) {
 	ctx := req.Context()
 
 	idStr := chi.URLParam(req, "id")
@@ -1555,52 +2612,130 @@ func (r *RepositoriesRouter) UpdateTrackingConfig(w http.ResponseWriter, req *ht
 		return
 	}
 
-	var body dto.TrackingConfigUpdateRequest
+	var body dto.IndexFilterConfigUpdateRequest
 	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
 		middleware.WriteError(w, req, err, r.logger)
 		return
 	}
 
-	// Convert JSON:API request to tracking config params
-	var branch, tag string
-	switch body.Data.Attributes.Mode {
-	case dto.TrackingModeBranch:
-		if body.Data.Attributes.Value != nil {
-			branch = *body.Data.Attributes.Value
-		}
-	case dto.TrackingModeTag:
-		if body.Data.Attributes.Value != nil {
-			tag = *body.Data.Attributes.Value
-		}
+	saved, err := r.client.Repositories.UpdateIndexFilterConfig(ctx, id, body.Data.Attributes.IndexPaths, body.Data.Attributes.IgnorePaths)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, indexFilterConfigToResponse(saved.IndexFilterConfig()))
+}
+
+func indexFilterConfigToResponse(c repository.IndexFilterConfig) dto.IndexFilterConfigResponse {
+	return dto.IndexFilterConfigResponse{
+		Data: dto.IndexFilterConfigData{
+			Type: "index-filter-config",
+			Attributes: dto.IndexFilterConfigAttributes{
+				IndexPaths:  c.IndexPaths(),
+				IgnorePaths: c.IgnorePaths(),
+			},
+		},
+	}
+}
+
+// GetEnrichmentLanguage handles GET /api/v1/repositories/{id}/config/enrichment-language.
+//
+//	@Summary		Get enrichment language
+//	@Description	Get the human language enrichments are written in for this repository. An empty language means it is detected automatically from the repository's own comments and docs.
+//	@Tags			repositories
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		int	true	"Repository ID"
+//	@Success		200	{object}	dto.EnrichmentLanguageResponse
+//	@Failure		404	{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500	{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/repositories/{id}/config/enrichment-language [get]
+func (r *RepositoriesRouter) GetEnrichmentLanguage(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	idStr := chi.URLParam(req, "id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	repo, err := r.client.Repositories.Get(ctx, repository.WithID(id))
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, enrichmentLanguageToResponse(repo.EnrichmentLanguage()))
+}
+
+// UpdateEnrichmentLanguage handles PUT /api/v1/repositories/{id}/config/enrichment-language.
+//
+//	@Summary		Update enrichment language
+//	@Description	Override the human language enrichments are written in for this repository. Pass an empty language to fall back to automatic detection from the repository's own comments and docs.
+//	@Tags			repositories
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int									true	"Repository ID"
+//	@Param			body	body		dto.EnrichmentLanguageUpdateRequest	true	"Enrichment language"
+//	@Success		200		{object}	dto.EnrichmentLanguageResponse
+//	@Failure		400		{object}	middleware.JSONAPIErrorResponse
+//	@Failure		404		{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500		{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/repositories/{id}/config/enrichment-language [put]
+func (r *RepositoriesRouter) UpdateEnrichmentLanguage(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	idStr := chi.URLParam(req, "id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
 	}
 
-	source, err := r.client.Repositories.UpdateTrackingConfig(ctx, id, &service.TrackingConfigParams{
-		Branch: branch,
-		Tag:    tag,
-	})
+	var body dto.EnrichmentLanguageUpdateRequest
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	saved, err := r.client.Repositories.UpdateEnrichmentLanguage(ctx, id, body.Data.Attributes.Language)
 	if err != nil {
 		middleware.WriteError(w, req, err, r.logger)
 		return
 	}
 
-	updatedTC := source.Repo().TrackingConfig()
-	middleware.WriteJSON(w, http.StatusOK, trackingConfigToResponse(updatedTC))
+	middleware.WriteJSON(w, http.StatusOK, enrichmentLanguageToResponse(saved.EnrichmentLanguage()))
 }
 
-// GetChunkingConfig handles GET /api/v1/repositories/{id}/config/chunking.
+func enrichmentLanguageToResponse(language string) dto.EnrichmentLanguageResponse {
+	return dto.EnrichmentLanguageResponse{
+		Data: dto.EnrichmentLanguageData{
+			Type: "enrichment-language",
+			Attributes: dto.EnrichmentLanguageAttributes{
+				Language: language,
+			},
+		},
+	}
+}
+
+// GetAutoRepairTracking handles GET /api/v1/repositories/{id}/config/auto-repair-tracking.
 //
-//	@Summary		Get chunking config
-//	@Description	Get current chunking configuration for a repository
+//	@Summary		Get auto-repair-tracking setting
+//	@Description	Get whether Sync automatically updates this repository's tracking branch when the remote's default branch changes (e.g. master renamed to main). When disabled, a mismatch is only logged.
 //	@Tags			repositories
 //	@Accept			json
 //	@Produce		json
 //	@Param			id	path		int	true	"Repository ID"
-//	@Success		200	{object}	dto.ChunkingConfigResponse
+//	@Success		200	{object}	dto.AutoRepairTrackingResponse
 //	@Failure		404	{object}	middleware.JSONAPIErrorResponse
 //	@Failure		500	{object}	middleware.JSONAPIErrorResponse
 //	@Security		APIKeyAuth
-//	@Router			/repositories/{id}/config/chunking [get]
-func (r *RepositoriesRouter) GetChunkingConfig(w http.ResponseWriter, req *http.Request) {
+//	@Router			/repositories/{id}/config/auto-repair-tracking [get]
+func (r *RepositoriesRouter) GetAutoRepairTracking(w http.ResponseWriter, req *http.Request) {
 	ctx := req.Context()
 
 	idStr := chi.URLParam(req, "id")
@@ -1616,25 +2751,25 @@ func (r *RepositoriesRouter) GetChunkingConfig(w http.ResponseWriter, req *http.
 		return
 	}
 
-	middleware.WriteJSON(w, http.StatusOK, chunkingConfigToResponse(repo.ChunkingConfig()))
+	middleware.WriteJSON(w, http.StatusOK, autoRepairTrackingToResponse(repo.AutoRepairTracking()))
 }
 
-// UpdateChunkingConfig handles PUT /api/v1/repositories/{id}/config/chunking.
+// UpdateAutoRepairTracking handles PUT /api/v1/repositories/{id}/config/auto-repair-tracking.
 //
-//	@Summary		Update chunking config
-//	@Description	Update chunking configuration for a repository
+//	@Summary		Update auto-repair-tracking setting
+//	@Description	Enable or disable automatically updating this repository's tracking branch when the remote's default branch changes. Only applies to a tracking config that was detected automatically, not one set explicitly via the tracking-config endpoint.
 //	@Tags			repositories
 //	@Accept			json
 //	@Produce		json
-//	@Param			id		path		int								true	"Repository ID"
-//	@Param			body	body		dto.ChunkingConfigUpdateRequest	true	"Chunking config"
-//	@Success		200		{object}	dto.ChunkingConfigResponse
+//	@Param			id		path		int									true	"Repository ID"
+//	@Param			body	body		dto.AutoRepairTrackingUpdateRequest	true	"Auto-repair-tracking setting"
+//	@Success		200		{object}	dto.AutoRepairTrackingResponse
 //	@Failure		400		{object}	middleware.JSONAPIErrorResponse
 //	@Failure		404		{object}	middleware.JSONAPIErrorResponse
 //	@Failure		500		{object}	middleware.JSONAPIErrorResponse
 //	@Security		APIKeyAuth
-//	@Router			/repositories/{id}/config/chunking [put]
-func (r *RepositoriesRouter) UpdateChunkingConfig(w http.ResponseWriter, req *http.Request) {
+//	@Router			/repositories/{id}/config/auto-repair-tracking [put]
+func (r *RepositoriesRouter) UpdateAutoRepairTracking(w http.ResponseWriter, req *http.Request) {
 	ctx := req.Context()
 
 	idStr := chi.URLParam(req, "id")
@@ -1644,33 +2779,27 @@ func (r *RepositoriesRouter) UpdateChunkingConfig(w http.ResponseWriter, req *ht
 		return
 	}
 
-	var body dto.ChunkingConfigUpdateRequest
+	var body dto.AutoRepairTrackingUpdateRequest
 	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
 		middleware.WriteError(w, req, err, r.logger)
 		return
 	}
 
-	saved, err := r.client.Repositories.UpdateChunkingConfig(ctx, id, &service.ChunkingConfigParams{
-		Size:    body.Data.Attributes.ChunkSize,
-		Overlap: body.Data.Attributes.ChunkOverlap,
-		MinSize: body.Data.Attributes.MinChunkSize,
-	})
+	saved, err := r.client.Repositories.UpdateAutoRepairTracking(ctx, id, body.Data.Attributes.Enabled)
 	if err != nil {
 		middleware.WriteError(w, req, err, r.logger)
 		return
 	}
 
-	middleware.WriteJSON(w, http.StatusOK, chunkingConfigToResponse(saved.ChunkingConfig()))
+	middleware.WriteJSON(w, http.StatusOK, autoRepairTrackingToResponse(saved.AutoRepairTracking()))
 }
 
-func chunkingConfigToResponse(cc repository.ChunkingConfig) dto.ChunkingConfigResponse {
-	return dto.ChunkingConfigResponse{
-		Data: dto.ChunkingConfigData{
-			Type: "chunking-config",
-			Attributes: dto.ChunkingConfigAttributes{
-				ChunkSize:    cc.Size(),
-				ChunkOverlap: cc.Overlap(),
-				MinChunkSize: cc.MinSize(),
+func autoRepairTrackingToResponse(enabled bool) dto.AutoRepairTrackingResponse {
+	return dto.AutoRepairTrackingResponse{
+		Data: dto.AutoRepairTrackingData{
+			Type: "auto-repair-tracking",
+			Attributes: dto.AutoRepairTrackingAttributes{
+				Enabled: enabled,
 			},
 		},
 	}
@@ -1822,21 +2951,24 @@ func wantsInclude(req *http.Request, resource string) bool {
 	return false
 }
 
-func repoToDTO(repo repository.Repository, numCommits, numBranches, numTags int64) dto.RepositoryData {
+func repoToDTO(repo repository.Repository, numCommits, numBranches, numTags int64, excludedPatterns []string, healthScore *float64) dto.RepositoryData {
 	createdAt := repo.CreatedAt()
 	updatedAt := repo.UpdatedAt()
 	clonedPath := repo.WorkingCopy().Path()
 
 	attrs := dto.RepositoryAttributes{
-		RemoteURI:   repo.SanitizedURL(),
-		UpstreamURL: repo.UpstreamURL(),
-		PipelineID:  repo.PipelineID(),
-		CreatedAt:   &createdAt,
-		UpdatedAt:   &updatedAt,
-		ClonedPath:  &clonedPath,
-		NumCommits:  int(numCommits),
-		NumBranches: int(numBranches),
-		NumTags:     int(numTags),
+		RemoteURI:        repo.SanitizedURL(),
+		UpstreamURL:      repo.UpstreamURL(),
+		PipelineID:       repo.PipelineID(),
+		CreatedAt:        &createdAt,
+		UpdatedAt:        &updatedAt,
+		ClonedPath:       &clonedPath,
+		NumCommits:       int(numCommits),
+		NumBranches:      int(numBranches),
+		NumTags:          int(numTags),
+		ExcludedPatterns: excludedPatterns,
+		HealthScore:      healthScore,
+		Archived:         repo.Archived(),
 	}
 
 	if tc := repo.TrackingConfig(); tc.Branch() != "" {
@@ -2152,3 +3284,311 @@ func (r *RepositoriesRouter) Grep(w http.ResponseWriter, req *http.Request) {
 	w.WriteHeader(http.StatusOK)
 	_ = json.NewEncoder(w).Encode(response)
 }
+
+// GetArchitectureDiagram handles GET /api/v1/repositories/{id}/architecture/diagram.
+//
+//	@Summary		Get architecture diagram
+//	@Description	Get a Mermaid flowchart of the repository's components and startup dependencies, derived from its Docker Compose configuration
+//	@Tags			repositories
+//	@Produce		json
+//	@Param			id	path		int	true	"Repository ID"
+//	@Success		200	{object}	dto.ArchitectureDiagramResponse
+//	@Failure		404	{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500	{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/repositories/{id}/architecture/diagram [get]
+func (r *RepositoriesRouter) GetArchitectureDiagram(w http.ResponseWriter, req *http.Request) {
+	repoID, err := r.repositoryID(req)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	mermaid, err := r.client.ArchitectureDiagram.Generate(req.Context(), repoID)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, dto.ArchitectureDiagramResponse{
+		Data: dto.ArchitectureDiagramData{
+			Type: "architecture_diagrams",
+			Attributes: dto.ArchitectureDiagramAttributes{
+				Mermaid: mermaid,
+			},
+		},
+	})
+}
+
+// CreatePRPreview handles POST /api/v1/repositories/{id}/pr-preview.
+//
+//	@Summary		Index a pull request branch preview
+//	@Description	Fetch a pull request ref and index it into an ephemeral namespace overlaying the repository's regular index, searchable via the pr_ref filter until it is garbage-collected after its TTL. Re-indexing an already-previewed ref refreshes its head commit and TTL.
+//	@Tags			repositories
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int							true	"Repository ID"
+//	@Param			body	body		dto.PRPreviewRequest		true	"PR ref to preview"
+//	@Success		202		{object}	dto.PRPreviewResponse
+//	@Failure		400		{object}	middleware.JSONAPIErrorResponse
+//	@Failure		404		{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500		{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/repositories/{id}/pr-preview [post]
+func (r *RepositoriesRouter) CreatePRPreview(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	repoID, err := r.repositoryID(req)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	var body dto.PRPreviewRequest
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	if body.Ref == "" {
+		middleware.WriteError(w, req, fmt.Errorf("ref is required: %w", middleware.ErrValidation), r.logger)
+		return
+	}
+
+	index, err := r.client.PRPreview.Index(ctx, repoID, body.Ref)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusAccepted, dto.PRPreviewResponse{Data: prIndexToDTO(index)})
+}
+
+// ListPRPreviews handles GET /api/v1/repositories/{id}/pr-preview.
+//
+//	@Summary		List pull request branch previews
+//	@Description	List the active pull request branch previews indexed for a repository
+//	@Tags			repositories
+//	@Produce		json
+//	@Param			id	path		int	true	"Repository ID"
+//	@Success		200	{object}	dto.PRPreviewListResponse
+//	@Failure		404	{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500	{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/repositories/{id}/pr-preview [get]
+func (r *RepositoriesRouter) ListPRPreviews(w http.ResponseWriter, req *http.Request) {
+	repoID, err := r.repositoryID(req)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	indexes, err := r.client.PRPreview.List(req.Context(), repoID)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	data := make([]dto.PRPreviewData, len(indexes))
+	for i, idx := range indexes {
+		data[i] = prIndexToDTO(idx)
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, dto.PRPreviewListResponse{Data: data})
+}
+
+func prIndexToDTO(idx prindex.PRIndex) dto.PRPreviewData {
+	return dto.PRPreviewData{
+		Type: "pr_previews",
+		ID:   idx.ID(),
+		Attributes: dto.PRPreviewAttributes{
+			RepoID:        idx.RepoID(),
+			Ref:           idx.Ref(),
+			HeadCommitSHA: idx.HeadCommitSHA(),
+			CreatedAt:     idx.CreatedAt(),
+			ExpiresAt:     idx.ExpiresAt(),
+		},
+	}
+}
+
+// ExportEnrichments handles GET /api/v1/repositories/{id}/enrichments/export.
+//
+//	@Summary		Export enrichments as a document bundle
+//	@Description	Bundle a repository's architecture docs, wiki pages, commit descriptions, and file summaries into a zip of Markdown files organized into a folder structure suitable for publishing to a static docs site or committing back to the repo. Currently only format=markdown is supported.
+//	@Tags			repositories
+//	@Produce		zip
+//	@Param			id						path	int		true	"Repository ID"
+//	@Param			format					query	string	true	"Export format (only \"markdown\" is currently supported)"
+//	@Param			max_commits_to_check	query	int		false	"Max commits to check (default: 100)"
+//	@Success		200
+//	@Failure		400	{object}	middleware.JSONAPIErrorResponse
+//	@Failure		404	{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500	{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/repositories/{id}/enrichments/export [get]
+func (r *RepositoriesRouter) ExportEnrichments(w http.ResponseWriter, req *http.Request) {
+	repoID, err := r.repositoryID(req)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	format := req.URL.Query().Get("format")
+	if format != "markdown" {
+		middleware.WriteError(w, req, fmt.Errorf("unsupported format %q, valid formats: markdown: %w", format, middleware.ErrValidation), r.logger)
+		return
+	}
+
+	maxCommits := 100
+	if maxStr := req.URL.Query().Get("max_commits_to_check"); maxStr != "" {
+		if parsed, err := strconv.Atoi(maxStr); err == nil && parsed > 0 {
+			maxCommits = parsed
+		}
+	}
+
+	bundle, err := r.client.Export.MarkdownBundle(req.Context(), repoID, maxCommits)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("repository-%d-enrichments.zip", repoID)))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(bundle)
+}
+
+// GetCommitSBOM handles GET /api/v1/repositories/{id}/commits/{commit_sha}/sbom.
+//
+//	@Summary		Get commit SBOM
+//	@Description	Download the software bill of materials generated for a commit's dependencies. Currently only format=cyclonedx is supported.
+//	@Tags			repositories
+//	@Produce		json
+//	@Param			id			path	int		true	"Repository ID"
+//	@Param			commit_sha	path	string	true	"Commit SHA"
+//	@Param			format		query	string	false	"SBOM format (only \"cyclonedx\" is currently supported, default: cyclonedx)"
+//	@Success		200
+//	@Failure		400	{object}	middleware.JSONAPIErrorResponse
+//	@Failure		404	{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500	{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/repositories/{id}/commits/{commit_sha}/sbom [get]
+func (r *RepositoriesRouter) GetCommitSBOM(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	id, err := r.repositoryID(req)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	format := req.URL.Query().Get("format")
+	if format == "" {
+		format = "cyclonedx"
+	}
+	if format != "cyclonedx" {
+		middleware.WriteError(w, req, fmt.Errorf("unsupported format %q, valid formats: cyclonedx: %w", format, middleware.ErrValidation), r.logger)
+		return
+	}
+
+	commitSHA := chi.URLParam(req, "commit_sha")
+
+	if _, err := r.client.Commits.Get(ctx, repository.WithRepoID(id), repository.WithSHA(commitSHA)); err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	complianceType := enrichment.TypeCompliance
+	enrichments, err := r.client.Enrichments.List(ctx, &service.EnrichmentListParams{
+		CommitSHA: commitSHA,
+		Type:      &complianceType,
+	})
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	if len(enrichments) == 0 {
+		middleware.WriteError(w, req, fmt.Errorf("no SBOM generated for commit %s: %w", commitSHA, database.ErrNotFound), r.logger)
+		return
+	}
+	sbomEnrichment := enrichments[0]
+	for _, e := range enrichments[1:] {
+		if e.CreatedAt().After(sbomEnrichment.CreatedAt()) {
+			sbomEnrichment = e
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("commit-%s-sbom.cyclonedx.json", commitSHA)))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(sbomEnrichment.Content()))
+}
+
+// GetOnboardingReport handles GET /api/v1/repositories/{id}/onboarding-report.
+//
+//	@Summary		Get repository onboarding report
+//	@Description	Get the preflight analysis (size, language breakdown, analyzer coverage, skipped files, projected snippet and embedding token counts) computed for the repository's latest commit, so users know what to expect before indexing completes.
+//	@Tags			repositories
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		int	true	"Repository ID"
+//	@Success		200	{object}	dto.OnboardingReportResponse
+//	@Failure		404	{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500	{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/repositories/{id}/onboarding-report [get]
+func (r *RepositoriesRouter) GetOnboardingReport(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	id, err := r.repositoryID(req)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	commits, err := r.client.Commits.Find(ctx, repository.WithRepoID(id), repository.WithLimit(1))
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+	if len(commits) == 0 {
+		middleware.WriteError(w, req, fmt.Errorf("no commits found: %w", database.ErrNotFound), r.logger)
+		return
+	}
+
+	usageType := enrichment.TypeUsage
+	onboardingSubtype := enrichment.SubtypeOnboardingReport
+	enrichments, err := r.client.Enrichments.List(ctx, &service.EnrichmentListParams{
+		CommitSHA: commits[0].SHA(),
+		Type:      &usageType,
+		Subtype:   &onboardingSubtype,
+	})
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+	if len(enrichments) == 0 {
+		middleware.WriteError(w, req, fmt.Errorf("no onboarding report generated for repository %d: %w", id, database.ErrNotFound), r.logger)
+		return
+	}
+	reportEnrichment := enrichments[0]
+	for _, e := range enrichments[1:] {
+		if e.CreatedAt().After(reportEnrichment.CreatedAt()) {
+			reportEnrichment = e
+		}
+	}
+
+	var report onboarding.Report
+	if err := json.Unmarshal([]byte(reportEnrichment.Content()), &report); err != nil {
+		middleware.WriteError(w, req, fmt.Errorf("decode onboarding report: %w", err), r.logger)
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, dto.OnboardingReportResponse{
+		Data: dto.OnboardingReportData{
+			Type:       "onboarding-reports",
+			Attributes: report,
+		},
+	})
+}
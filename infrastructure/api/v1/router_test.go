@@ -69,6 +69,113 @@ func newTestClientWithSeededEnrichment(t *testing.T) (*kodit.Client, enrichment.
 	return client, saved
 }
 
+// newTestClientWithSeededExample creates a client with a pre-seeded example enrichment.
+func newTestClientWithSeededExample(t *testing.T) (*kodit.Client, enrichment.Enrichment) {
+	t.Helper()
+	tmpDir := t.TempDir()
+	dbPath := filepath.Join(tmpDir, "test.db")
+
+	db := openTestDB(t, dbPath)
+	store := persistence.NewEnrichmentStore(db)
+	e := enrichment.NewExample("print(\"hello\")")
+	ctx := httptest.NewRequest(http.MethodGet, "/", nil).Context()
+	saved, err := store.Save(ctx, e)
+	if err != nil {
+		t.Fatalf("save example: %v", err)
+	}
+	_ = db.Close()
+
+	client, err := kodit.New(
+		kodit.WithSQLite(dbPath),
+		kodit.WithDataDir(tmpDir),
+		kodit.WithSkipProviderValidation(),
+	)
+	if err != nil {
+		t.Fatalf("create client: %v", err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+	return client, saved
+}
+
+func TestExamplesRouter_List(t *testing.T) {
+	client, _ := newTestClientWithSeededExample(t)
+
+	router := v1.NewExamplesRouter(client)
+	routes := router.Routes()
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	w := httptest.NewRecorder()
+
+	routes.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status code = %v, want %v", w.Code, http.StatusOK)
+	}
+
+	var response dto.ExampleJSONAPIListResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if len(response.Data) != 1 {
+		t.Fatalf("len(Data) = %v, want 1", len(response.Data))
+	}
+	if response.Data[0].Type != "example" {
+		t.Errorf("type = %v, want example", response.Data[0].Type)
+	}
+}
+
+func TestExamplesRouter_List_QueryFilter(t *testing.T) {
+	client, _ := newTestClientWithSeededExample(t)
+
+	router := v1.NewExamplesRouter(client)
+	routes := router.Routes()
+
+	req := httptest.NewRequest(http.MethodGet, "/?query=nonexistent", nil)
+	w := httptest.NewRecorder()
+
+	routes.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status code = %v, want %v", w.Code, http.StatusOK)
+	}
+
+	var response dto.ExampleJSONAPIListResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if len(response.Data) != 0 {
+		t.Errorf("len(Data) = %v, want 0 (query does not match)", len(response.Data))
+	}
+}
+
+func TestExamplesRouter_Get(t *testing.T) {
+	client, saved := newTestClientWithSeededExample(t)
+
+	router := v1.NewExamplesRouter(client)
+	routes := router.Routes()
+
+	idStr := fmt.Sprintf("%d", saved.ID())
+	req := httptest.NewRequest(http.MethodGet, "/"+idStr, nil)
+	w := httptest.NewRecorder()
+
+	routes.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status code = %v, want %v", w.Code, http.StatusOK)
+	}
+
+	var response dto.ExampleJSONAPIResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if response.Data.ID != idStr {
+		t.Errorf("id = %v, want %v", response.Data.ID, idStr)
+	}
+}
+
 func TestEnrichmentsRouter_List(t *testing.T) {
 	client, _ := newTestClientWithSeededEnrichment(t)
 
@@ -151,6 +258,70 @@ func TestEnrichmentsRouter_Get(t *testing.T) {
 	}
 }
 
+func TestEnrichmentsRouter_Update_CreatesOverride(t *testing.T) {
+	client, saved := newTestClientWithSeededEnrichment(t)
+
+	router := v1.NewEnrichmentsRouter(client)
+	routes := router.Routes()
+
+	idStr := fmt.Sprintf("%d", saved.ID())
+	body := strings.NewReader(`{"data":{"attributes":{"content":"edited content"}}}`)
+	req := httptest.NewRequest(http.MethodPatch, "/"+idStr, body)
+	w := httptest.NewRecorder()
+
+	routes.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status code = %v, want %v, body = %s", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var response dto.EnrichmentJSONAPIResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if response.Data.Attributes.Content != "edited content" {
+		t.Errorf("Content = %v, want %v", response.Data.Attributes.Content, "edited content")
+	}
+	if response.Data.ID != idStr {
+		t.Errorf("ID = %v, want %v (override must resolve under the original ID)", response.Data.ID, idStr)
+	}
+
+	// A subsequent GET of the original ID must also return the override.
+	getReq := httptest.NewRequest(http.MethodGet, "/"+idStr, nil)
+	getW := httptest.NewRecorder()
+	routes.ServeHTTP(getW, getReq)
+
+	var getResponse dto.EnrichmentJSONAPIResponse
+	if err := json.NewDecoder(getW.Body).Decode(&getResponse); err != nil {
+		t.Fatalf("failed to decode get response: %v", err)
+	}
+	if getResponse.Data.Attributes.Content != "edited content" {
+		t.Errorf("Content = %v, want %v", getResponse.Data.Attributes.Content, "edited content")
+	}
+
+	// Resetting the override restores the original content.
+	resetReq := httptest.NewRequest(http.MethodDelete, "/"+idStr+"/override", nil)
+	resetW := httptest.NewRecorder()
+	routes.ServeHTTP(resetW, resetReq)
+
+	if resetW.Code != http.StatusNoContent {
+		t.Fatalf("reset status code = %v, want %v", resetW.Code, http.StatusNoContent)
+	}
+
+	afterResetReq := httptest.NewRequest(http.MethodGet, "/"+idStr, nil)
+	afterResetW := httptest.NewRecorder()
+	routes.ServeHTTP(afterResetW, afterResetReq)
+
+	var afterResetResponse dto.EnrichmentJSONAPIResponse
+	if err := json.NewDecoder(afterResetW.Body).Decode(&afterResetResponse); err != nil {
+		t.Fatalf("failed to decode post-reset response: %v", err)
+	}
+	if afterResetResponse.Data.Attributes.Content != saved.Content() {
+		t.Errorf("Content = %v, want %v (original content after reset)", afterResetResponse.Data.Attributes.Content, saved.Content())
+	}
+}
+
 func TestEnrichmentsRouter_Get_NotFound(t *testing.T) {
 	client := newTestClient(t)
 
@@ -457,6 +628,165 @@ func TestRepositoriesRouter_UpdateChunkingConfig_InvalidParams(t *testing.T) {
 	}
 }
 
+func TestRepositoriesRouter_GetEnrichmentBudget(t *testing.T) {
+	client, repoID := newTestClientWithSeededRepository(t)
+
+	router := v1.NewRepositoriesRouter(client)
+	routes := router.Routes()
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/%d/config/enrichment-budget", repoID), nil)
+	w := httptest.NewRecorder()
+	routes.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status code = %v, want %v; body: %s", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var response dto.EnrichmentBudgetResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if response.Data.Type != "enrichment-budget" {
+		t.Errorf("type = %v, want enrichment-budget", response.Data.Type)
+	}
+	if response.Data.Attributes.MaxFileSummaries != 0 {
+		t.Errorf("max_file_summaries = %v, want 0 (unlimited)", response.Data.Attributes.MaxFileSummaries)
+	}
+}
+
+func TestRepositoriesRouter_UpdateEnrichmentBudget(t *testing.T) {
+	client, repoID := newTestClientWithSeededRepository(t)
+
+	router := v1.NewRepositoriesRouter(client)
+	routes := router.Routes()
+
+	body := `{"data":{"type":"enrichment-budget","attributes":{"max_file_summaries":25}}}`
+	req := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/%d/config/enrichment-budget", repoID), strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	routes.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status code = %v, want %v; body: %s", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var response dto.EnrichmentBudgetResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if response.Data.Attributes.MaxFileSummaries != 25 {
+		t.Errorf("max_file_summaries = %v, want 25", response.Data.Attributes.MaxFileSummaries)
+	}
+
+	// Verify GET returns updated value
+	getReq := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/%d/config/enrichment-budget", repoID), nil)
+	getW := httptest.NewRecorder()
+	routes.ServeHTTP(getW, getReq)
+
+	if getW.Code != http.StatusOK {
+		t.Fatalf("GET status code = %v, want %v", getW.Code, http.StatusOK)
+	}
+
+	var getResponse dto.EnrichmentBudgetResponse
+	if err := json.NewDecoder(getW.Body).Decode(&getResponse); err != nil {
+		t.Fatalf("failed to decode GET response: %v", err)
+	}
+
+	if getResponse.Data.Attributes.MaxFileSummaries != 25 {
+		t.Errorf("GET max_file_summaries = %v, want 25", getResponse.Data.Attributes.MaxFileSummaries)
+	}
+}
+
+func TestRepositoriesRouter_UpdateEnrichmentBudget_InvalidParams(t *testing.T) {
+	client, repoID := newTestClientWithSeededRepository(t)
+
+	router := v1.NewRepositoriesRouter(client)
+	routes := router.Routes()
+
+	body := `{"data":{"type":"enrichment-budget","attributes":{"max_file_summaries":-1}}}`
+	req := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/%d/config/enrichment-budget", repoID), strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	routes.ServeHTTP(w, req)
+
+	if w.Code == http.StatusOK {
+		t.Errorf("expected error status for invalid params, got %v", w.Code)
+	}
+}
+
+func TestRepositoriesRouter_GetEnrichmentLanguage(t *testing.T) {
+	client, repoID := newTestClientWithSeededRepository(t)
+
+	router := v1.NewRepositoriesRouter(client)
+	routes := router.Routes()
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/%d/config/enrichment-language", repoID), nil)
+	w := httptest.NewRecorder()
+	routes.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status code = %v, want %v; body: %s", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var response dto.EnrichmentLanguageResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if response.Data.Type != "enrichment-language" {
+		t.Errorf("type = %v, want enrichment-language", response.Data.Type)
+	}
+	if response.Data.Attributes.Language != "" {
+		t.Errorf("language = %v, want \"\" (auto-detect)", response.Data.Attributes.Language)
+	}
+}
+
+func TestRepositoriesRouter_UpdateEnrichmentLanguage(t *testing.T) {
+	client, repoID := newTestClientWithSeededRepository(t)
+
+	router := v1.NewRepositoriesRouter(client)
+	routes := router.Routes()
+
+	body := `{"data":{"type":"enrichment-language","attributes":{"language":"Spanish"}}}`
+	req := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/%d/config/enrichment-language", repoID), strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	routes.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status code = %v, want %v; body: %s", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var response dto.EnrichmentLanguageResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if response.Data.Attributes.Language != "Spanish" {
+		t.Errorf("language = %v, want Spanish", response.Data.Attributes.Language)
+	}
+
+	// Verify GET returns updated value
+	getReq := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/%d/config/enrichment-language", repoID), nil)
+	getW := httptest.NewRecorder()
+	routes.ServeHTTP(getW, getReq)
+
+	if getW.Code != http.StatusOK {
+		t.Fatalf("GET status code = %v, want %v", getW.Code, http.StatusOK)
+	}
+
+	var getResponse dto.EnrichmentLanguageResponse
+	if err := json.NewDecoder(getW.Body).Decode(&getResponse); err != nil {
+		t.Fatalf("failed to decode GET response: %v", err)
+	}
+
+	if getResponse.Data.Attributes.Language != "Spanish" {
+		t.Errorf("GET language = %v, want Spanish", getResponse.Data.Attributes.Language)
+	}
+}
+
 func TestRepositoriesRouter_Get_SanitizesCredentials(t *testing.T) {
 	tmpDir := t.TempDir()
 	dbPath := filepath.Join(tmpDir, "test.db")
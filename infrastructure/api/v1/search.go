@@ -2,6 +2,8 @@ package v1
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -9,6 +11,7 @@ import (
 	"regexp/syntax"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/rs/zerolog"
 
@@ -19,6 +22,7 @@ import (
 	"github.com/helixml/kodit/domain/repository"
 	"github.com/helixml/kodit/domain/search"
 	"github.com/helixml/kodit/domain/sourcelocation"
+	"github.com/helixml/kodit/infrastructure/api/jsonapi"
 	"github.com/helixml/kodit/infrastructure/api/middleware"
 	"github.com/helixml/kodit/infrastructure/api/v1/dto"
 )
@@ -42,11 +46,16 @@ func (r *SearchRouter) Routes() chi.Router {
 	router := chi.NewRouter()
 
 	router.Post("/", r.Search)
+	router.Post("/stream", r.StreamSearch)
+	router.Post("/debug", r.SearchDebug)
+	router.Post("/similar", r.Similar)
 	router.Get("/semantic", r.SemanticSearch)
 	router.Get("/keyword", r.KeywordSearch)
 	router.Get("/visual", r.VisualSearch)
 	router.Get("/ls", r.Ls)
 	router.Get("/grep", r.Grep)
+	router.Get("/overlay", r.Overlay)
+	router.Get("/impact", r.Impact)
 
 	return router
 }
@@ -58,7 +67,7 @@ func (r *SearchRouter) Routes() chi.Router {
 //	@Tags			search
 //	@Accept			json
 //	@Produce		json
-//	@Param			body	body		dto.SearchRequest	true	"Search request"
+//	@Param			body	body		dto.SearchRequest	true	"Search request (set data.attributes.group_by=file to collapse results per file; data.attributes.namespace selects a synonym dictionary for query expansion; data.attributes.watermark=true attaches a provenance watermark to each result for compliance exports; data.attributes.highlight=true attaches a pre-rendered syntax-highlighted HTML fragment to each result's content; data.attributes.semantic_weight fixes the semantic-vs-keyword fusion balance in [0,1], and data.attributes.auto_weight=true infers it from the query's shape instead — either way the weight actually used is reported in the response's meta.fusion_weight)"
 //	@Success		200		{object}	dto.SearchResponse
 //	@Failure		400		{object}	middleware.JSONAPIErrorResponse
 //	@Failure		500		{object}	middleware.JSONAPIErrorResponse
@@ -81,13 +90,28 @@ func (r *SearchRouter) Search(w http.ResponseWriter, req *http.Request) {
 		middleware.WriteError(w, req, err, r.logger)
 		return
 	}
+
+	groupBy, err := validateGroupBy(body.Data.Attributes.GroupBy)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	r.logQuery(ctx, searchQueryRepoID(body.Data.Attributes.Filters), searchQueryText(body.Data.Attributes))
+
 	result, err := r.client.Search.Search(ctx, searchReq)
 	if err != nil {
 		middleware.WriteError(w, req, err, r.logger)
 		return
 	}
 
-	response, err := r.resolveAndBuildResponse(ctx, result.Enrichments(), result.OriginalScores())
+	var queryHash string
+	if body.Data.Attributes.Watermark != nil && *body.Data.Attributes.Watermark {
+		queryHash = hashSearchQuery(body.Data.Attributes)
+	}
+	highlight := body.Data.Attributes.Highlight != nil && *body.Data.Attributes.Highlight
+
+	response, err := r.resolveAndBuildResponse(ctx, result.Enrichments(), result.OriginalScores(), groupBy, queryHash, highlight, fusionWeightMeta(result))
 	if err != nil {
 		middleware.WriteError(w, req, err, r.logger)
 		return
@@ -95,6 +119,255 @@ func (r *SearchRouter) Search(w http.ResponseWriter, req *http.Request) {
 	middleware.WriteJSON(w, http.StatusOK, response)
 }
 
+// hashSearchQuery returns a stable hex-encoded SHA-256 hash of the query
+// portion of attrs, for provenance watermarks — so a downstream consumer
+// can prove which query produced a given exported result without storing
+// the query text itself. The watermark flag is excluded so requesting a
+// watermark doesn't change the hash of an otherwise identical query.
+func hashSearchQuery(attrs dto.SearchAttributes) string {
+	attrs.Watermark = nil
+	// Marshaling cannot fail: every field of SearchAttributes is JSON-safe.
+	encoded, _ := json.Marshal(attrs)
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:])
+}
+
+// StreamSearch handles POST /api/v1/search/stream.
+//
+//	@Summary		Streaming search
+//	@Description	Hybrid search across code snippets and enrichments, streamed as newline-delimited JSON. Emits a "partial" event with fast keyword-only results as soon as they're available, followed by a "final" event once the refined hybrid results are ready (or an "error" event in its place), so an interactive UI can show instant feedback without waiting for embeddings and reranking.
+//	@Tags			search
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		dto.SearchRequest	true	"Search request"
+//	@Success		200		{object}	dto.SearchStreamEvent	"Stream of newline-delimited SearchStreamEvent objects"
+//	@Failure		400		{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500		{object}	middleware.JSONAPIErrorResponse
+//	@Router			/search/stream [post]
+func (r *SearchRouter) StreamSearch(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var body dto.SearchRequest
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		if err == io.EOF {
+			middleware.WriteError(w, req, fmt.Errorf("request body is required: %w", middleware.ErrValidation), r.logger)
+			return
+		}
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	searchReq, err := buildSearchRequest(body)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	groupBy, err := validateGroupBy(body.Data.Attributes.GroupBy)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		middleware.WriteError(w, req, fmt.Errorf("streaming not supported by this connection: %w", middleware.ErrValidation), r.logger)
+		return
+	}
+
+	r.logQuery(ctx, searchQueryRepoID(body.Data.Attributes.Filters), searchQueryText(body.Data.Attributes))
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(http.StatusOK)
+	encoder := json.NewEncoder(w)
+
+	r.writePartialResults(ctx, encoder, flusher, body, searchReq, groupBy)
+
+	result, err := r.client.Search.Search(ctx, searchReq)
+	if err != nil {
+		_ = encoder.Encode(dto.SearchStreamEvent{Stage: "error", Error: err.Error()})
+		flusher.Flush()
+		return
+	}
+
+	final, err := r.resolveAndBuildResponse(ctx, result.Enrichments(), result.OriginalScores(), groupBy, "", false, fusionWeightMeta(result))
+	if err != nil {
+		_ = encoder.Encode(dto.SearchStreamEvent{Stage: "error", Error: err.Error()})
+		flusher.Flush()
+		return
+	}
+	_ = encoder.Encode(dto.SearchStreamEvent{Stage: "final", Data: final})
+	flusher.Flush()
+}
+
+// SearchDebug handles POST /api/v1/search/debug.
+//
+//	@Summary		Debug the search pipeline
+//	@Description	Executes a hybrid search like POST /search, but additionally captures a step-by-step trace of query expansion, BM25 candidates, vector candidates, fusion, filters applied, and reranking, so relevance engineers can see exactly where a desired result drops out.
+//	@Tags			search
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		dto.SearchRequest	true	"Search request, same shape as POST /search"
+//	@Success		200		{object}	dto.SearchDebugResponse
+//	@Failure		400		{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500		{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/search/debug [post]
+func (r *SearchRouter) SearchDebug(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var body dto.SearchRequest
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		if err == io.EOF {
+			middleware.WriteError(w, req, fmt.Errorf("request body is required: %w", middleware.ErrValidation), r.logger)
+			return
+		}
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	searchReq, err := buildSearchRequest(body)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	groupBy, err := validateGroupBy(body.Data.Attributes.GroupBy)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	trace, result, err := r.client.Search.Debug(ctx, searchReq)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	response, err := r.resolveAndBuildResponse(ctx, result.Enrichments(), result.OriginalScores(), groupBy, "", false, fusionWeightMeta(result))
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, dto.SearchDebugResponse{
+		Result: response,
+		Trace:  traceStepSchemas(trace),
+	})
+}
+
+// traceStepSchemas converts a service.SearchTrace into its DTO form.
+func traceStepSchemas(trace service.SearchTrace) []dto.SearchTraceStepSchema {
+	steps := trace.Steps()
+	schemas := make([]dto.SearchTraceStepSchema, len(steps))
+	for i, step := range steps {
+		schemas[i] = dto.SearchTraceStepSchema{
+			Stage:       string(step.Stage()),
+			Description: step.Description(),
+			Count:       step.Count(),
+			SampleIDs:   step.SampleIDs(),
+		}
+	}
+	return schemas
+}
+
+// Similar handles POST /api/v1/search/similar.
+//
+//	@Summary		Find similar code
+//	@Description	Embed an arbitrary code block and return the nearest existing snippets across repositories, ranked by semantic similarity — the "have we already written this?" workflow.
+//	@Tags			search
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		dto.SimilarRequest	true	"Similar-code search request"
+//	@Success		200		{object}	dto.SearchResponse
+//	@Failure		400		{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500		{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/search/similar [post]
+func (r *SearchRouter) Similar(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var body dto.SimilarRequest
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		if err == io.EOF {
+			middleware.WriteError(w, req, fmt.Errorf("request body is required: %w", middleware.ErrValidation), r.logger)
+			return
+		}
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	code := strings.TrimSpace(body.Data.Attributes.Code)
+	if code == "" {
+		middleware.WriteError(w, req, fmt.Errorf("code is required: %w", middleware.ErrValidation), r.logger)
+		return
+	}
+
+	limit := 10
+	if body.Data.Attributes.Limit != nil && *body.Data.Attributes.Limit > 0 {
+		limit = *body.Data.Attributes.Limit
+	}
+
+	filterOpts, err := buildFilterOptions(body.Data.Attributes.Filters)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+	filters := search.NewFilters(filterOpts...)
+
+	r.logQuery(ctx, searchQueryRepoID(body.Data.Attributes.Filters), "similar-code")
+
+	enrichments, scores, err := r.client.Search.SearchCodeWithScores(ctx, code, limit, filters)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	if len(enrichments) > limit {
+		enrichments = enrichments[:limit]
+	}
+
+	scoreMap := enrichmentScoreMap(enrichments, scores)
+	response, err := r.resolveAndBuildResponse(ctx, enrichments, scoreMap, "", "", false, nil)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+	middleware.WriteJSON(w, http.StatusOK, response)
+}
+
+// writePartialResults runs the fast BM25-only pass and streams it as a
+// "partial" event. Failures are logged and swallowed — the client still
+// gets its "final" event even if the fast path errors.
+func (r *SearchRouter) writePartialResults(
+	ctx context.Context,
+	encoder *json.Encoder,
+	flusher http.Flusher,
+	body dto.SearchRequest,
+	searchReq search.MultiRequest,
+	groupBy string,
+) {
+	keywords := searchQueryText(body.Data.Attributes)
+	if keywords == "" {
+		return
+	}
+
+	enrichments, scores, err := r.client.Search.SearchKeywordsWithScores(ctx, keywords, searchReq.TopK(), searchReq.Filters())
+	if err != nil {
+		r.logger.Warn().Err(err).Msg("streaming search: fast keyword pass failed")
+		return
+	}
+
+	partial, err := r.resolveAndBuildResponse(ctx, enrichments, enrichmentScoreMap(enrichments, scores), groupBy, "", false, nil)
+	if err != nil {
+		r.logger.Warn().Err(err).Msg("streaming search: failed to build partial response")
+		return
+	}
+
+	_ = encoder.Encode(dto.SearchStreamEvent{Stage: "partial", Data: partial})
+	flusher.Flush()
+}
+
 // SemanticSearch handles GET /api/v1/search/semantic.
 //
 //	@Summary		Semantic code search
@@ -105,6 +378,7 @@ func (r *SearchRouter) Search(w http.ResponseWriter, req *http.Request) {
 //	@Param			language		query		string	false	"Language filter (e.g. py, go)"
 //	@Param			repository_id	query		int		false	"Repository ID filter"
 //	@Param			limit			query		int		false	"Maximum results (default 10)"
+//	@Param			group_by		query		string	false	"Collapse results per file (file)"
 //	@Success		200				{object}	dto.SearchResponse
 //	@Failure		400				{object}	middleware.JSONAPIErrorResponse
 //	@Failure		500				{object}	middleware.JSONAPIErrorResponse
@@ -141,10 +415,18 @@ func (r *SearchRouter) SemanticSearch(w http.ResponseWriter, req *http.Request)
 		limit = &parsed
 	}
 
-	r.handleSemanticSearch(w, req, query, language, repositoryID, limit)
+	groupByStr := req.URL.Query().Get("group_by")
+	groupBy, err := validateGroupBy(&groupByStr)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	r.logQuery(req.Context(), repositoryIDString(repositoryID), query)
+	r.handleSemanticSearch(w, req, query, language, repositoryID, limit, groupBy)
 }
 
-func (r *SearchRouter) handleSemanticSearch(w http.ResponseWriter, req *http.Request, query string, languagePtr *string, repositoryID *int64, limitPtr *int) {
+func (r *SearchRouter) handleSemanticSearch(w http.ResponseWriter, req *http.Request, query string, languagePtr *string, repositoryID *int64, limitPtr *int, groupBy string) {
 	ctx := req.Context()
 
 	limit := 10
@@ -181,7 +463,7 @@ func (r *SearchRouter) handleSemanticSearch(w http.ResponseWriter, req *http.Req
 	}
 
 	scoreMap := enrichmentScoreMap(enrichments, scores)
-	response, err := r.resolveAndBuildResponse(ctx, enrichments, scoreMap)
+	response, err := r.resolveAndBuildResponse(ctx, enrichments, scoreMap, groupBy, "", false, nil)
 	if err != nil {
 		middleware.WriteError(w, req, err, r.logger)
 		return
@@ -199,6 +481,7 @@ func (r *SearchRouter) handleSemanticSearch(w http.ResponseWriter, req *http.Req
 //	@Param			language		query		string	false	"Language filter (e.g. py, go)"
 //	@Param			repository_id	query		int		false	"Repository ID filter"
 //	@Param			limit			query		int		false	"Maximum results (default 10)"
+//	@Param			group_by		query		string	false	"Collapse results per file (file)"
 //	@Success		200				{object}	dto.SearchResponse
 //	@Failure		400				{object}	middleware.JSONAPIErrorResponse
 //	@Failure		500				{object}	middleware.JSONAPIErrorResponse
@@ -235,10 +518,18 @@ func (r *SearchRouter) KeywordSearch(w http.ResponseWriter, req *http.Request) {
 		limit = &parsed
 	}
 
-	r.handleKeywordSearch(w, req, keywords, language, repositoryID, limit)
+	groupByStr := req.URL.Query().Get("group_by")
+	groupBy, err := validateGroupBy(&groupByStr)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	r.logQuery(req.Context(), repositoryIDString(repositoryID), keywords)
+	r.handleKeywordSearch(w, req, keywords, language, repositoryID, limit, groupBy)
 }
 
-func (r *SearchRouter) handleKeywordSearch(w http.ResponseWriter, req *http.Request, keywords string, languagePtr *string, repositoryID *int64, limitPtr *int) {
+func (r *SearchRouter) handleKeywordSearch(w http.ResponseWriter, req *http.Request, keywords string, languagePtr *string, repositoryID *int64, limitPtr *int, groupBy string) {
 	ctx := req.Context()
 
 	limit := 10
@@ -279,7 +570,7 @@ func (r *SearchRouter) handleKeywordSearch(w http.ResponseWriter, req *http.Requ
 	}
 
 	scoreMap := enrichmentScoreMap(enrichments, scores)
-	response, err := r.resolveAndBuildResponse(ctx, enrichments, scoreMap)
+	response, err := r.resolveAndBuildResponse(ctx, enrichments, scoreMap, groupBy, "", false, nil)
 	if err != nil {
 		middleware.WriteError(w, req, err, r.logger)
 		return
@@ -296,6 +587,7 @@ func (r *SearchRouter) handleKeywordSearch(w http.ResponseWriter, req *http.Requ
 //	@Param			query			query		string	true	"Natural language search query"
 //	@Param			repository_id	query		int		false	"Repository ID filter"
 //	@Param			limit			query		int		false	"Maximum results (default 10)"
+//	@Param			group_by		query		string	false	"Collapse results per file (file)"
 //	@Success		200				{object}	dto.SearchResponse
 //	@Failure		400				{object}	middleware.JSONAPIErrorResponse
 //	@Failure		500				{object}	middleware.JSONAPIErrorResponse
@@ -328,10 +620,17 @@ func (r *SearchRouter) VisualSearch(w http.ResponseWriter, req *http.Request) {
 		limit = &parsed
 	}
 
-	r.handleVisualSearch(w, req, query, repositoryID, limit)
+	groupByStr := req.URL.Query().Get("group_by")
+	groupBy, err := validateGroupBy(&groupByStr)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	r.handleVisualSearch(w, req, query, repositoryID, limit, groupBy)
 }
 
-func (r *SearchRouter) handleVisualSearch(w http.ResponseWriter, req *http.Request, query string, repositoryID *int64, limitPtr *int) {
+func (r *SearchRouter) handleVisualSearch(w http.ResponseWriter, req *http.Request, query string, repositoryID *int64, limitPtr *int, groupBy string) {
 	ctx := req.Context()
 
 	topK := 10
@@ -363,7 +662,7 @@ func (r *SearchRouter) handleVisualSearch(w http.ResponseWriter, req *http.Reque
 	}
 
 	scoreMap := enrichmentScoreMap(enrichments, scores)
-	response, err := r.resolveAndBuildResponse(ctx, enrichments, scoreMap)
+	response, err := r.resolveAndBuildResponse(ctx, enrichments, scoreMap, groupBy, "", false, nil)
 	if err != nil {
 		middleware.WriteError(w, req, err, r.logger)
 		return
@@ -571,6 +870,181 @@ func (r *SearchRouter) Grep(w http.ResponseWriter, req *http.Request) {
 	middleware.WriteJSON(w, http.StatusOK, response)
 }
 
+// Impact handles GET /api/v1/search/impact.
+//
+//	@Summary		Analyze rename impact
+//	@Description	Counts references to a symbol across every indexed repository's working copy, grouped by repository and file, so engineers can assess the blast radius of a rename or deprecation before doing it. References are found via a word-boundary git grep, not a resolved symbol index, so results are textual matches rather than type-checked references.
+//	@Tags			search
+//	@Produce		json
+//	@Param			symbol			query		string	true	"Symbol name to search for"
+//	@Param			max_files		query		int		false	"Maximum number of files inspected per repository (default 50, max 200)"
+//	@Success		200				{object}	dto.ImpactResponse
+//	@Failure		400				{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500				{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/search/impact [get]
+func (r *SearchRouter) Impact(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	symbol := strings.TrimSpace(req.URL.Query().Get("symbol"))
+	if symbol == "" {
+		middleware.WriteError(w, req, fmt.Errorf("symbol query parameter is required: %w", middleware.ErrValidation), r.logger)
+		return
+	}
+
+	maxFiles := 50
+	if maxStr := req.URL.Query().Get("max_files"); maxStr != "" {
+		parsed, parseErr := strconv.Atoi(maxStr)
+		if parseErr != nil || parsed < 1 {
+			middleware.WriteError(w, req, fmt.Errorf("max_files must be at least 1: %w", middleware.ErrValidation), r.logger)
+			return
+		}
+		maxFiles = parsed
+	}
+	if maxFiles > 200 {
+		maxFiles = 200
+	}
+
+	impacts, err := r.client.RenameImpact.Analyze(ctx, symbol, maxFiles)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	response := dto.ImpactResponse{Data: make([]dto.ImpactRepoSchema, 0, len(impacts))}
+	for _, impact := range impacts {
+		files := make([]dto.ImpactFileSchema, 0, len(impact.Files))
+		for _, f := range impact.Files {
+			files = append(files, dto.ImpactFileSchema{Path: f.Path, ReferenceCount: f.ReferenceCount})
+		}
+		response.Data = append(response.Data, dto.ImpactRepoSchema{
+			RepositoryID:   strconv.FormatInt(impact.RepoID, 10),
+			RepositoryURL:  impact.RepoURL,
+			ReferenceCount: impact.ReferenceCount,
+			Files:          files,
+		})
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, response)
+}
+
+// Overlay handles GET /api/v1/search/overlay.
+//
+//	@Summary		Search uncommitted working tree changes
+//	@Description	Chunks a repository's current uncommitted working tree changes and, when query is set, keyword-matches against them. Results are computed fresh from disk on every call and are never persisted, so they reflect in-progress edits rather than the indexed history searched by the other search endpoints.
+//	@Tags			search
+//	@Produce		json
+//	@Param			repository_id	query		int		true	"Repository ID"
+//	@Param			query			query		string	false	"Keyword to match against uncommitted file content; returns every chunk when omitted"
+//	@Success		200				{object}	dto.OverlayResponse
+//	@Failure		400				{object}	middleware.JSONAPIErrorResponse
+//	@Failure		404				{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500				{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/search/overlay [get]
+func (r *SearchRouter) Overlay(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	repoIDStr := req.URL.Query().Get("repository_id")
+	if repoIDStr == "" {
+		middleware.WriteError(w, req, fmt.Errorf("repository_id query parameter is required: %w", middleware.ErrValidation), r.logger)
+		return
+	}
+	repoID, err := strconv.ParseInt(repoIDStr, 10, 64)
+	if err != nil {
+		middleware.WriteError(w, req, fmt.Errorf("invalid repository_id: %w", middleware.ErrValidation), r.logger)
+		return
+	}
+
+	query := req.URL.Query().Get("query")
+
+	snippets, err := r.client.Overlay.Search(ctx, repoID, query)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	response := dto.OverlayResponse{Data: make([]dto.OverlaySnippetSchema, 0, len(snippets))}
+	for _, s := range snippets {
+		response.Data = append(response.Data, dto.OverlaySnippetSchema{
+			Path:      s.Path,
+			Language:  s.Language,
+			Content:   s.Content,
+			StartLine: s.StartLine,
+			EndLine:   s.EndLine,
+		})
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, response)
+}
+
+// buildFilterOptions converts a dto.SearchFilters into search.FiltersOption
+// values, or nil if f is nil.
+func buildFilterOptions(f *dto.SearchFilters) ([]search.FiltersOption, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	var opts []search.FiltersOption
+	if len(f.Languages) > 0 {
+		opts = append(opts, search.WithLanguages(f.Languages))
+	}
+	if len(f.Authors) > 0 {
+		opts = append(opts, search.WithAuthors(f.Authors))
+	}
+	if f.StartDate != nil {
+		opts = append(opts, search.WithCreatedAfter(*f.StartDate))
+	}
+	if f.EndDate != nil {
+		opts = append(opts, search.WithCreatedBefore(*f.EndDate))
+	}
+	if len(f.Sources) > 0 {
+		ids := make([]int64, 0, len(f.Sources))
+		for _, s := range f.Sources {
+			id, err := strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid source repository ID %q: %w", s, middleware.ErrValidation)
+			}
+			ids = append(ids, id)
+		}
+		opts = append(opts, search.WithSourceRepos(ids))
+	}
+	if len(f.FilePatterns) > 0 {
+		opts = append(opts, search.WithFilePaths(f.FilePatterns))
+	}
+	if len(f.EnrichmentTypes) > 0 {
+		opts = append(opts, search.WithEnrichmentTypes(f.EnrichmentTypes))
+	}
+	if len(f.EnrichmentSubtypes) > 0 {
+		opts = append(opts, search.WithEnrichmentSubtypes(f.EnrichmentSubtypes))
+	}
+	if len(f.CommitSHA) > 0 {
+		opts = append(opts, search.WithCommitSHAs(f.CommitSHA))
+	}
+	if len(f.ExcludeKeywords) > 0 {
+		opts = append(opts, search.WithExcludeKeywords(f.ExcludeKeywords))
+	}
+	if len(f.ExcludePathPrefixes) > 0 {
+		opts = append(opts, search.WithExcludePathPrefixes(f.ExcludePathPrefixes))
+	}
+	if len(f.ExcludeRepoIDs) > 0 {
+		ids := make([]int64, 0, len(f.ExcludeRepoIDs))
+		for _, s := range f.ExcludeRepoIDs {
+			id, err := strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid exclude_repo_ids entry %q: %w", s, middleware.ErrValidation)
+			}
+			ids = append(ids, id)
+		}
+		opts = append(opts, search.WithExcludeRepoIDs(ids))
+	}
+	if f.PRRef != "" {
+		opts = append(opts, search.WithPRRef(f.PRRef))
+	}
+
+	return opts, nil
+}
+
 func buildSearchRequest(body dto.SearchRequest) (search.MultiRequest, error) {
 	attrs := body.Data.Attributes
 
@@ -589,94 +1063,125 @@ func buildSearchRequest(body dto.SearchRequest) (search.MultiRequest, error) {
 		codeQuery = *attrs.Code
 	}
 
-	// Build filters
-	var opts []search.FiltersOption
-	if attrs.Filters != nil {
-		f := attrs.Filters
-		if len(f.Languages) > 0 {
-			opts = append(opts, search.WithLanguages(f.Languages))
-		}
-		if len(f.Authors) > 0 {
-			opts = append(opts, search.WithAuthors(f.Authors))
-		}
-		if f.StartDate != nil {
-			opts = append(opts, search.WithCreatedAfter(*f.StartDate))
-		}
-		if f.EndDate != nil {
-			opts = append(opts, search.WithCreatedBefore(*f.EndDate))
-		}
-		if len(f.Sources) > 0 {
-			ids := make([]int64, 0, len(f.Sources))
-			for _, s := range f.Sources {
-				id, err := strconv.ParseInt(s, 10, 64)
-				if err != nil {
-					return search.MultiRequest{}, fmt.Errorf("invalid source repository ID %q: %w", s, middleware.ErrValidation)
-				}
-				ids = append(ids, id)
-			}
-			opts = append(opts, search.WithSourceRepos(ids))
-		}
-		if len(f.FilePatterns) > 0 {
-			opts = append(opts, search.WithFilePaths(f.FilePatterns))
-		}
-		if len(f.EnrichmentTypes) > 0 {
-			opts = append(opts, search.WithEnrichmentTypes(f.EnrichmentTypes))
-		}
-		if len(f.EnrichmentSubtypes) > 0 {
-			opts = append(opts, search.WithEnrichmentSubtypes(f.EnrichmentSubtypes))
-		}
-		if len(f.CommitSHA) > 0 {
-			opts = append(opts, search.WithCommitSHAs(f.CommitSHA))
-		}
+	opts, err := buildFilterOptions(attrs.Filters)
+	if err != nil {
+		return search.MultiRequest{}, err
 	}
 
 	filters := search.NewFilters(opts...)
 
-	return search.NewMultiRequest(topK, textQuery, codeQuery, attrs.Keywords, filters), nil
+	var namespace string
+	if attrs.Namespace != nil {
+		namespace = *attrs.Namespace
+	}
+
+	var requestOpts []search.MultiRequestOption
+	if attrs.SemanticWeight != nil {
+		requestOpts = append(requestOpts, search.WithSemanticWeight(*attrs.SemanticWeight))
+	} else if attrs.AutoWeight != nil && *attrs.AutoWeight {
+		requestOpts = append(requestOpts, search.WithAutoWeight())
+	}
+
+	return search.NewMultiRequest(topK, textQuery, codeQuery, attrs.Keywords, filters, namespace, requestOpts...), nil
 }
 
 // resolveAndBuildResponse resolves enrichment metadata (related enrichments,
 // source files, line ranges, commits, repos) and builds a SearchResponse.
+// When groupBy is "file", the flat result is collapsed into a
+// GroupedSearchResponse instead. When queryHash is non-empty, each snippet
+// carries a provenance watermark for compliance exports. When highlight is
+// true, each snippet's content carries a pre-rendered syntax-highlighted
+// HTML fragment. meta, if non-nil, is attached to the response (e.g. the
+// fusion weights actually used).
 func (r *SearchRouter) resolveAndBuildResponse(
 	ctx context.Context,
 	enrichments []enrichment.Enrichment,
 	originalScores map[string][]float64,
-) (dto.SearchResponse, error) {
+	groupBy string,
+	queryHash string,
+	highlight bool,
+	meta *jsonapi.Meta,
+) (any, error) {
+	data, fileMap, err := buildSnippetData(ctx, r.client, r.logger, enrichments, originalScores, queryHash, highlight)
+	if err != nil {
+		return nil, err
+	}
+
+	if groupBy == "file" {
+		grouped := groupSnippetsByFile(data, fileMap)
+		grouped.Meta = meta
+		return grouped, nil
+	}
+	return dto.SearchResponse{Data: data, Meta: meta}, nil
+}
+
+// fusionWeightMeta returns response metadata reporting the semantic vs.
+// keyword fusion weight actually used for a search, or nil if the search
+// used the default unweighted fusion (no semantic_weight/auto_weight
+// requested).
+func fusionWeightMeta(result service.MultiSearchResult) *jsonapi.Meta {
+	weight, ok := result.ResolvedSemanticWeight()
+	if !ok {
+		return nil
+	}
+	return &jsonapi.Meta{
+		"fusion_weight": map[string]float64{
+			"semantic": weight,
+			"keyword":  1 - weight,
+		},
+	}
+}
+
+// buildSnippetData resolves enrichment metadata (related enrichments, source
+// files, line ranges, commits, repositories) for a set of enrichments and
+// converts each into a dto.SnippetData. It also returns the resolved source
+// file map so callers that group by file don't need to fetch it again.
+func buildSnippetData(
+	ctx context.Context,
+	client *kodit.Client,
+	logger zerolog.Logger,
+	enrichments []enrichment.Enrichment,
+	originalScores map[string][]float64,
+	queryHash string,
+	highlight bool,
+) ([]dto.SnippetData, map[string][]repository.File, error) {
 	ids := make([]int64, len(enrichments))
 	for i, e := range enrichments {
 		ids[i] = e.ID()
 	}
 
-	related, err := r.client.Enrichments.RelatedEnrichments(ctx, ids)
+	related, err := client.Enrichments.RelatedEnrichments(ctx, ids)
 	if err != nil {
-		r.logger.Warn().Err(err).Msg("failed to fetch related enrichments")
+		logger.Warn().Err(err).Msg("failed to fetch related enrichments")
 		related = map[string][]enrichment.Enrichment{}
 	}
 
-	fileMap, err := sourceFileMap(ctx, r.client, ids)
+	fileMap, err := sourceFileMap(ctx, client, ids)
 	if err != nil {
-		r.logger.Warn().Err(err).Msg("failed to fetch source files")
+		logger.Warn().Err(err).Msg("failed to fetch source files")
 		fileMap = map[string][]repository.File{}
 	}
 
-	lineRanges, err := r.client.Enrichments.SourceLocations(ctx, ids)
+	lineRanges, err := client.Enrichments.SourceLocations(ctx, ids)
 	if err != nil {
-		r.logger.Warn().Err(err).Msg("failed to fetch line ranges")
+		logger.Warn().Err(err).Msg("failed to fetch line ranges")
 		lineRanges = map[string]sourcelocation.SourceLocation{}
 	}
 
-	commits, err := r.commitMap(ctx, fileMap)
+	commits, err := commitMap(ctx, client, fileMap)
 	if err != nil {
-		r.logger.Warn().Err(err).Msg("failed to fetch commits")
+		logger.Warn().Err(err).Msg("failed to fetch commits")
 		commits = map[string]repository.Commit{}
 	}
 
-	repos, err := r.repositoryMap(ctx, commits)
+	repos, err := repositoryMap(ctx, client, commits)
 	if err != nil {
-		r.logger.Warn().Err(err).Msg("failed to fetch repositories")
+		logger.Warn().Err(err).Msg("failed to fetch repositories")
 		repos = map[int64]repository.Repository{}
 	}
 
+	retrievedAt := time.Now()
+
 	data := make([]dto.SnippetData, len(enrichments))
 	for i, e := range enrichments {
 		idStr := strconv.FormatInt(e.ID(), 10)
@@ -691,7 +1196,7 @@ func (r *SearchRouter) resolveAndBuildResponse(
 			// failed silently (e.g. unparseable PDF — issue #553). Surface
 			// the failure so it shows up in logs rather than as an empty
 			// string in the consumer's response.
-			event := r.logger.Warn().
+			event := logger.Warn().
 				Int64("enrichment_id", e.ID()).
 				Str("subtype", string(e.Subtype()))
 			if len(files) > 0 {
@@ -699,10 +1204,87 @@ func (r *SearchRouter) resolveAndBuildResponse(
 			}
 			event.Msg("search hit has empty content — source file may have failed extraction")
 		}
-		data[i] = enrichmentToSearchResult(e, originalScores[idStr], related[idStr], files, lrPtr, commits, repos)
+
+		var html *string
+		if highlight {
+			rendered, err := client.Highlighter().Highlight(e.Content(), e.Language())
+			if err != nil {
+				logger.Warn().Err(err).Int64("enrichment_id", e.ID()).Msg("failed to highlight snippet")
+			} else {
+				html = &rendered
+			}
+		}
+
+		data[i] = enrichmentToSearchResult(e, originalScores[idStr], related[idStr], files, lrPtr, commits, repos, queryHash, retrievedAt, html)
 	}
 
-	return dto.SearchResponse{Data: data}, nil
+	return data, fileMap, nil
+}
+
+// validateGroupBy normalizes the group_by query/body parameter. "file" is
+// the only supported value so far; anything else is a validation error.
+// A nil or empty value means the caller wants the ungrouped response.
+func validateGroupBy(groupBy *string) (string, error) {
+	if groupBy == nil || *groupBy == "" {
+		return "", nil
+	}
+	if *groupBy != "file" {
+		return "", fmt.Errorf("unsupported group_by value %q, only \"file\" is supported: %w", *groupBy, middleware.ErrValidation)
+	}
+	return *groupBy, nil
+}
+
+// groupSnippetsByFile collapses data into one GroupedSnippetData per file,
+// aggregating score as the best of its constituent snippet scores and
+// collecting every matched line range. Grouping order follows each file's
+// first appearance in data, preserving the ranking of the original results.
+func groupSnippetsByFile(data []dto.SnippetData, fileMap map[string][]repository.File) dto.GroupedSearchResponse {
+	var order []string
+	groups := make(map[string]*dto.GroupedSnippetData)
+
+	for _, d := range data {
+		files := fileMap[d.ID]
+		path := ""
+		if len(files) > 0 {
+			path = files[0].Path()
+		} else if d.Links != nil {
+			path = d.Links.File
+		}
+
+		group, ok := groups[path]
+		if !ok {
+			group = &dto.GroupedSnippetData{
+				Type: "file",
+				ID:   path,
+				Attributes: dto.GroupedSnippetAttributes{
+					Path:     path,
+					Language: d.Attributes.Content.Language,
+				},
+				Links: d.Links,
+			}
+			groups[path] = group
+			order = append(order, path)
+		}
+
+		group.Attributes.MatchCount++
+		for _, score := range d.Attributes.OriginalScores {
+			if score > group.Attributes.AggregatedScore {
+				group.Attributes.AggregatedScore = score
+			}
+		}
+		if d.Attributes.Content.StartLine != nil && d.Attributes.Content.EndLine != nil {
+			group.Attributes.LineRanges = append(group.Attributes.LineRanges, dto.SnippetLineRange{
+				StartLine: *d.Attributes.Content.StartLine,
+				EndLine:   *d.Attributes.Content.EndLine,
+			})
+		}
+	}
+
+	result := make([]dto.GroupedSnippetData, 0, len(order))
+	for _, path := range order {
+		result = append(result, *groups[path])
+	}
+	return dto.GroupedSearchResponse{Data: result}
 }
 
 // normalizeExtension strips a leading dot so that ".py" and "py" compare equal.
@@ -746,6 +1328,9 @@ func enrichmentToSearchResult(
 	lr *sourcelocation.SourceLocation,
 	commits map[string]repository.Commit,
 	repos map[int64]repository.Repository,
+	queryHash string,
+	retrievedAt time.Time,
+	html *string,
 ) dto.SnippetData {
 	createdAt := e.CreatedAt()
 	updatedAt := e.UpdatedAt()
@@ -763,6 +1348,7 @@ func enrichmentToSearchResult(
 	content := dto.SnippetContentSchema{
 		Value:    e.Content(),
 		Language: e.Language(),
+		HTML:     html,
 	}
 	if lr != nil {
 		if lr.StartLine() > 0 {
@@ -786,19 +1372,21 @@ func enrichmentToSearchResult(
 			Content:        content,
 			Enrichments:    enrichmentSchemas,
 			OriginalScores: scores,
+			Metrics:        snippetMetrics(e),
+			Watermark:      snippetWatermark(files, commits, repos, queryHash, retrievedAt),
 		},
 		Links: links,
 	}
 }
 
 // commitMap returns commits keyed by SHA for the given file map.
-func (r *SearchRouter) commitMap(ctx context.Context, fileMap map[string][]repository.File) (map[string]repository.Commit, error) {
+func commitMap(ctx context.Context, client *kodit.Client, fileMap map[string][]repository.File) (map[string]repository.Commit, error) {
 	shas := uniqueCommitSHAs(fileMap)
 	if len(shas) == 0 {
 		return map[string]repository.Commit{}, nil
 	}
 
-	commits, err := r.client.Commits.Find(ctx, repository.WithCommitSHAIn(shas))
+	commits, err := client.Commits.Find(ctx, repository.WithCommitSHAIn(shas))
 	if err != nil {
 		return nil, err
 	}
@@ -811,13 +1399,13 @@ func (r *SearchRouter) commitMap(ctx context.Context, fileMap map[string][]repos
 }
 
 // repositoryMap returns repositories keyed by ID for the given commit map.
-func (r *SearchRouter) repositoryMap(ctx context.Context, commits map[string]repository.Commit) (map[int64]repository.Repository, error) {
+func repositoryMap(ctx context.Context, client *kodit.Client, commits map[string]repository.Commit) (map[int64]repository.Repository, error) {
 	ids := uniqueRepoIDs(commits)
 	if len(ids) == 0 {
 		return map[int64]repository.Repository{}, nil
 	}
 
-	repos, err := r.client.Repositories.Find(ctx, repository.WithIDIn(ids))
+	repos, err := client.Repositories.Find(ctx, repository.WithIDIn(ids))
 	if err != nil {
 		return nil, err
 	}
@@ -857,6 +1445,46 @@ func uniqueRepoIDs(commits map[string]repository.Commit) []int64 {
 	return ids
 }
 
+// snippetMetrics converts an enrichment's readability and complexity
+// metrics to their DTO form, or nil if they have not been computed.
+func snippetMetrics(e enrichment.Enrichment) *dto.SnippetMetricsSchema {
+	metrics := e.Metrics()
+	if metrics.IsZero() {
+		return nil
+	}
+	return &dto.SnippetMetricsSchema{
+		CyclomaticComplexity: metrics.CyclomaticComplexity(),
+		NestingDepth:         metrics.NestingDepth(),
+		LineCount:            metrics.LineCount(),
+	}
+}
+
+// snippetWatermark builds a provenance watermark for a snippet, or nil if
+// watermarking wasn't requested (queryHash is empty) or the snippet's
+// source repository can't be resolved.
+func snippetWatermark(files []repository.File, commits map[string]repository.Commit, repos map[int64]repository.Repository, queryHash string, retrievedAt time.Time) *dto.ProvenanceWatermark {
+	if queryHash == "" || len(files) == 0 {
+		return nil
+	}
+
+	commit, ok := commits[files[0].CommitSHA()]
+	if !ok {
+		return nil
+	}
+
+	repo, ok := repos[commit.RepoID()]
+	if !ok {
+		return nil
+	}
+
+	return &dto.ProvenanceWatermark{
+		RepoURL:     repo.RemoteURL(),
+		CommitSHA:   commit.SHA(),
+		RetrievedAt: retrievedAt,
+		QueryHash:   queryHash,
+	}
+}
+
 func snippetLinks(files []repository.File, lr *sourcelocation.SourceLocation, commits map[string]repository.Commit, repos map[int64]repository.Repository) *dto.SnippetLinks {
 	if len(files) == 0 {
 		return nil
@@ -890,3 +1518,46 @@ func snippetLinks(files []repository.File, lr *sourcelocation.SourceLocation, co
 		File:       fileLink,
 	}
 }
+
+// logQuery records a search query for later duplicate-question analysis.
+// Logging failures are warned and swallowed, since a search should still
+// succeed even if its query could not be recorded.
+func (r *SearchRouter) logQuery(ctx context.Context, repositoryID, query string) {
+	if err := r.client.QueryLog.Record(ctx, repositoryID, query); err != nil {
+		r.logger.Warn().Err(err).Msg("failed to record query log")
+	}
+}
+
+// repositoryIDString formats an optional repository ID filter for the query log, or "" if unscoped.
+func repositoryIDString(id *int64) string {
+	if id == nil {
+		return ""
+	}
+	return strconv.FormatInt(*id, 10)
+}
+
+// searchQueryText builds a single representative query string from a
+// hybrid search request's text/code/keyword fields for the query log.
+func searchQueryText(attrs dto.SearchAttributes) string {
+	var parts []string
+	if attrs.Text != nil && *attrs.Text != "" {
+		parts = append(parts, *attrs.Text)
+	}
+	if attrs.Code != nil && *attrs.Code != "" {
+		parts = append(parts, *attrs.Code)
+	}
+	if len(attrs.Keywords) > 0 {
+		parts = append(parts, strings.Join(attrs.Keywords, " "))
+	}
+	return strings.Join(parts, " ")
+}
+
+// searchQueryRepoID extracts a single repository ID from a hybrid search
+// request's source filter, for the query log. Requests scoped to multiple
+// repositories are logged as unscoped.
+func searchQueryRepoID(filters *dto.SearchFilters) string {
+	if filters == nil || len(filters.Sources) != 1 {
+		return ""
+	}
+	return filters.Sources[0]
+}
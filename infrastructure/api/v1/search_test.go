@@ -116,6 +116,171 @@ func TestSearchRouter_LineRanges(t *testing.T) {
 	}
 }
 
+// TestSearchRouter_GroupByFile verifies that group_by=file collapses
+// multiple matching snippets from the same file into a single grouped
+// result with an aggregated score and the set of matched line ranges.
+func TestSearchRouter_GroupByFile(t *testing.T) {
+	tmpDir := t.TempDir()
+	dbPath := filepath.Join(tmpDir, "test.db")
+
+	db := openTestDB(t, dbPath)
+	ctx := httptest.NewRequest(http.MethodGet, "/", nil).Context()
+
+	enrichmentStore := persistence.NewEnrichmentStore(db)
+	bm25Store, err := persistence.NewSQLiteBM25Store(db, zerolog.New(os.Stderr))
+	if err != nil {
+		t.Fatalf("create bm25 store: %v", err)
+	}
+	lineRangeStore := persistence.NewSourceLocationStore(db)
+
+	commitSHA := "deadbeef00000000000000000000000000000000"
+	repoStore := persistence.NewRepositoryStore(db)
+	repo, err := repository.NewRepository("https://github.com/test/repo")
+	if err != nil {
+		t.Fatalf("new repo: %v", err)
+	}
+	savedRepo, err := repoStore.Save(ctx, repo)
+	if err != nil {
+		t.Fatalf("save repo: %v", err)
+	}
+
+	commitStore := persistence.NewCommitStore(db)
+	author := repository.NewAuthor("phil", "phil@winder.ai")
+	now := time.Now()
+	commit := repository.NewCommit(commitSHA, savedRepo.ID(), "msg", author, author, now, now)
+	if _, err := commitStore.Save(ctx, commit); err != nil {
+		t.Fatalf("save commit: %v", err)
+	}
+
+	fileStore := persistence.NewFileStore(db)
+	f := repository.NewFileWithDetails(commitSHA, "src/shared.go", "abc123", "text/plain", ".go", 100)
+	savedFile, err := fileStore.Save(ctx, f)
+	if err != nil {
+		t.Fatalf("save file: %v", err)
+	}
+
+	associationStore := persistence.NewAssociationStore(db)
+
+	lines := []struct {
+		content string
+		start   int
+		end     int
+	}{
+		{"func hello() { fmt.Println(\"hello\") }", 1, 3},
+		{"func world() { fmt.Println(\"world\") }", 10, 12},
+	}
+	for _, l := range lines {
+		e := enrichment.NewEnrichment(
+			enrichment.TypeDevelopment,
+			enrichment.SubtypeSnippet,
+			enrichment.EntityTypeSnippet,
+			l.content,
+		)
+		saved, saveErr := enrichmentStore.Save(ctx, e)
+		if saveErr != nil {
+			t.Fatalf("save enrichment: %v", saveErr)
+		}
+
+		snippetID := strconv.FormatInt(saved.ID(), 10)
+		doc := search.NewDocument(snippetID, l.content)
+		if indexErr := bm25Store.Index(ctx, []search.Document{doc}); indexErr != nil {
+			t.Fatalf("index bm25: %v", indexErr)
+		}
+
+		lr := sourcelocation.New(saved.ID(), l.start, l.end)
+		if _, saveErr := lineRangeStore.Save(ctx, lr); saveErr != nil {
+			t.Fatalf("save line range: %v", saveErr)
+		}
+
+		if _, saveErr := associationStore.Save(ctx, enrichment.CommitAssociation(saved.ID(), commitSHA)); saveErr != nil {
+			t.Fatalf("save commit association: %v", saveErr)
+		}
+		if _, saveErr := associationStore.Save(ctx, enrichment.FileAssociation(saved.ID(), strconv.FormatInt(savedFile.ID(), 10))); saveErr != nil {
+			t.Fatalf("save file association: %v", saveErr)
+		}
+	}
+
+	_ = db.Close()
+
+	client, err := kodit.New(
+		kodit.WithSQLite(dbPath),
+		kodit.WithDataDir(tmpDir),
+		kodit.WithSkipProviderValidation(),
+	)
+	if err != nil {
+		t.Fatalf("create client: %v", err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+
+	router := v1.NewSearchRouter(client)
+	routes := router.Routes()
+
+	body := `{"data":{"type":"search","attributes":{"keywords":["hello","world"],"group_by":"file"}}}`
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	routes.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d; body: %s", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var response dto.GroupedSearchResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+
+	if len(response.Data) != 1 {
+		t.Fatalf("expected 1 grouped result, got %d", len(response.Data))
+	}
+
+	group := response.Data[0]
+	if group.Attributes.Path != "src/shared.go" {
+		t.Errorf("path = %q, want %q", group.Attributes.Path, "src/shared.go")
+	}
+	if group.Attributes.MatchCount != 2 {
+		t.Errorf("match_count = %d, want 2", group.Attributes.MatchCount)
+	}
+	if len(group.Attributes.LineRanges) != 2 {
+		t.Errorf("expected 2 line ranges, got %d", len(group.Attributes.LineRanges))
+	}
+}
+
+// TestSearchRouter_GroupByRejectsUnknownValue verifies that an unsupported
+// group_by value is rejected as a validation error rather than silently
+// ignored.
+func TestSearchRouter_GroupByRejectsUnknownValue(t *testing.T) {
+	tmpDir := t.TempDir()
+	dbPath := filepath.Join(tmpDir, "test.db")
+	db := openTestDB(t, dbPath)
+	_ = db.Close()
+
+	client, err := kodit.New(
+		kodit.WithSQLite(dbPath),
+		kodit.WithDataDir(tmpDir),
+		kodit.WithSkipProviderValidation(),
+	)
+	if err != nil {
+		t.Fatalf("create client: %v", err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+
+	router := v1.NewSearchRouter(client)
+	routes := router.Routes()
+
+	body := `{"data":{"type":"search","attributes":{"keywords":["hello"],"group_by":"repository"}}}`
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	routes.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want %d; body: %s", w.Code, http.StatusBadRequest, w.Body.String())
+	}
+}
+
 // TestSearchRouter_WarnsOnEmptyContent verifies that when a search hit has
 // empty Content (e.g. because chunk persistence failed silently for a PDF —
 // see issue #553) the search path logs a warning identifying the source file
@@ -240,3 +405,167 @@ func TestSearchRouter_WarnsOnEmptyContent(t *testing.T) {
 		t.Errorf("expected warning to mention empty content. log output: %s", logOutput)
 	}
 }
+
+// TestSearchRouter_Highlight verifies that requesting highlight=true attaches
+// a syntax-highlighted HTML fragment to each result, and that it's omitted
+// by default.
+func TestSearchRouter_Highlight(t *testing.T) {
+	tmpDir := t.TempDir()
+	dbPath := filepath.Join(tmpDir, "test.db")
+
+	db := openTestDB(t, dbPath)
+	ctx := httptest.NewRequest(http.MethodGet, "/", nil).Context()
+
+	enrichmentStore := persistence.NewEnrichmentStore(db)
+	e := enrichment.NewEnrichment(
+		enrichment.TypeDevelopment,
+		enrichment.SubtypeSnippet,
+		enrichment.EntityTypeSnippet,
+		"func hello() { fmt.Println(\"hello\") }",
+	)
+	saved, err := enrichmentStore.Save(ctx, e)
+	if err != nil {
+		t.Fatalf("save enrichment: %v", err)
+	}
+
+	bm25Store, err := persistence.NewSQLiteBM25Store(db, zerolog.New(os.Stderr))
+	if err != nil {
+		t.Fatalf("create bm25 store: %v", err)
+	}
+	snippetID := strconv.FormatInt(saved.ID(), 10)
+	doc := search.NewDocument(snippetID, "func hello")
+	if err := bm25Store.Index(ctx, []search.Document{doc}); err != nil {
+		t.Fatalf("index bm25: %v", err)
+	}
+
+	_ = db.Close()
+
+	client, err := kodit.New(
+		kodit.WithSQLite(dbPath),
+		kodit.WithDataDir(tmpDir),
+		kodit.WithSkipProviderValidation(),
+	)
+	if err != nil {
+		t.Fatalf("create client: %v", err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+
+	router := v1.NewSearchRouter(client)
+	routes := router.Routes()
+
+	doSearch := func(t *testing.T, highlightAttr string) dto.SearchResponse {
+		t.Helper()
+		body := fmt.Sprintf(`{"data":{"type":"search","attributes":{"keywords":["hello"]%s}}}`, highlightAttr)
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		routes.ServeHTTP(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("status = %d, want %d; body: %s", w.Code, http.StatusOK, w.Body.String())
+		}
+		var response dto.SearchResponse
+		if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		if len(response.Data) == 0 {
+			t.Fatal("expected at least one search result")
+		}
+		return response
+	}
+
+	t.Run("omitted by default", func(t *testing.T) {
+		response := doSearch(t, "")
+		if response.Data[0].Attributes.Content.HTML != nil {
+			t.Errorf("content.html = %q, want nil", *response.Data[0].Attributes.Content.HTML)
+		}
+	})
+
+	t.Run("attached when requested", func(t *testing.T) {
+		response := doSearch(t, `,"highlight":true`)
+		html := response.Data[0].Attributes.Content.HTML
+		if html == nil || *html == "" {
+			t.Fatal("expected content.html to be a non-empty highlighted fragment")
+		}
+		if !strings.Contains(*html, "<span") {
+			t.Errorf("expected highlighted HTML to contain <span> tags, got: %s", *html)
+		}
+	})
+}
+
+// TestSearchRouter_Debug verifies that POST /search/debug returns both the
+// same results POST /search would and a non-empty step-by-step trace of
+// how the pipeline produced them.
+func TestSearchRouter_Debug(t *testing.T) {
+	tmpDir := t.TempDir()
+	dbPath := filepath.Join(tmpDir, "test.db")
+
+	db := openTestDB(t, dbPath)
+	ctx := httptest.NewRequest(http.MethodGet, "/", nil).Context()
+
+	enrichmentStore := persistence.NewEnrichmentStore(db)
+	e := enrichment.NewEnrichment(
+		enrichment.TypeDevelopment,
+		enrichment.SubtypeSnippet,
+		enrichment.EntityTypeSnippet,
+		"func hello() { fmt.Println(\"hello\") }",
+	)
+	saved, err := enrichmentStore.Save(ctx, e)
+	if err != nil {
+		t.Fatalf("save enrichment: %v", err)
+	}
+
+	bm25Store, err := persistence.NewSQLiteBM25Store(db, zerolog.New(os.Stderr))
+	if err != nil {
+		t.Fatalf("create bm25 store: %v", err)
+	}
+	snippetID := fmt.Sprintf("%d", saved.ID())
+	if err := bm25Store.Index(ctx, []search.Document{search.NewDocument(snippetID, "func hello")}); err != nil {
+		t.Fatalf("index bm25: %v", err)
+	}
+
+	_ = db.Close()
+
+	client, err := kodit.New(
+		kodit.WithSQLite(dbPath),
+		kodit.WithDataDir(tmpDir),
+		kodit.WithSkipProviderValidation(),
+	)
+	if err != nil {
+		t.Fatalf("create client: %v", err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+
+	router := v1.NewSearchRouter(client)
+	routes := router.Routes()
+
+	body := `{"data":{"type":"search","attributes":{"keywords":["hello"]}}}`
+	req := httptest.NewRequest(http.MethodPost, "/debug", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	routes.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d; body: %s", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var response dto.SearchDebugResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+
+	if len(response.Trace) == 0 {
+		t.Fatal("expected a non-empty trace")
+	}
+	if response.Trace[0].Stage != "query_expansion" {
+		t.Errorf("trace[0].stage = %q, want %q", response.Trace[0].Stage, "query_expansion")
+	}
+	last := response.Trace[len(response.Trace)-1]
+	if last.Stage != "final_order" {
+		t.Errorf("last trace stage = %q, want %q", last.Stage, "final_order")
+	}
+	if last.Count != 1 {
+		t.Errorf("final stage count = %d, want 1", last.Count)
+	}
+}
@@ -0,0 +1,88 @@
+package v1
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/rs/zerolog"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/helixml/kodit"
+	"github.com/helixml/kodit/domain/enrichment"
+	"github.com/helixml/kodit/domain/repository"
+	"github.com/helixml/kodit/infrastructure/api/middleware"
+	"github.com/helixml/kodit/infrastructure/api/v1/dto"
+)
+
+// SnippetsRouter handles snippet API endpoints. It gives clients a canonical
+// way to hydrate a single search result by ID instead of over-fetching
+// everything up front in the search response.
+type SnippetsRouter struct {
+	client *kodit.Client
+	logger zerolog.Logger
+}
+
+// NewSnippetsRouter creates a new SnippetsRouter.
+func NewSnippetsRouter(client *kodit.Client) *SnippetsRouter {
+	return &SnippetsRouter{
+		client: client,
+		logger: client.Logger(),
+	}
+}
+
+// Routes returns the chi router for snippet endpoints.
+func (r *SnippetsRouter) Routes() chi.Router {
+	router := chi.NewRouter()
+
+	router.Get("/{id}", r.Get)
+
+	return router
+}
+
+// Get handles GET /api/v1/snippets/{id}.
+//
+//	@Summary		Get snippet
+//	@Description	Get a snippet by ID, with content, derived-from files and line ranges, associated commits, related enrichments, and links — the same shape search results carry, for clients that want to hydrate a result lazily
+//	@Tags			search
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		int		true	"Snippet (enrichment) ID"
+//	@Param			highlight	query		bool	false	"Attach a pre-rendered syntax-highlighted HTML fragment to the content"
+//	@Success		200			{object}	dto.SnippetJSONAPIResponse
+//	@Failure		404			{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500			{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/snippets/{id} [get]
+func (r *SnippetsRouter) Get(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	idStr := chi.URLParam(req, "id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	e, err := r.client.Enrichments.Get(ctx, repository.WithID(id))
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	resolved, err := r.client.Enrichments.ResolveOverrides(ctx, []enrichment.Enrichment{e})
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+	e = resolved[0]
+
+	highlight := req.URL.Query().Get("highlight") == "true"
+
+	data, _, err := buildSnippetData(ctx, r.client, r.logger, []enrichment.Enrichment{e}, nil, "", highlight)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, dto.SnippetJSONAPIResponse{Data: data[0]})
+}
@@ -0,0 +1,153 @@
+package v1_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/helixml/kodit"
+	"github.com/helixml/kodit/domain/enrichment"
+	v1 "github.com/helixml/kodit/infrastructure/api/v1"
+	"github.com/helixml/kodit/infrastructure/api/v1/dto"
+	"github.com/helixml/kodit/infrastructure/persistence"
+)
+
+func TestSnippetsRouter_Get(t *testing.T) {
+	tmpDir := t.TempDir()
+	dbPath := filepath.Join(tmpDir, "test.db")
+
+	db := openTestDB(t, dbPath)
+	ctx := httptest.NewRequest(http.MethodGet, "/", nil).Context()
+
+	enrichmentStore := persistence.NewEnrichmentStore(db)
+	e := enrichment.NewEnrichment(
+		enrichment.TypeDevelopment,
+		enrichment.SubtypeSnippet,
+		enrichment.EntityTypeSnippet,
+		"func hello() { fmt.Println(\"hello\") }",
+	)
+	saved, err := enrichmentStore.Save(ctx, e)
+	if err != nil {
+		t.Fatalf("save enrichment: %v", err)
+	}
+
+	_ = db.Close()
+
+	client, err := kodit.New(
+		kodit.WithSQLite(dbPath),
+		kodit.WithDataDir(tmpDir),
+		kodit.WithSkipProviderValidation(),
+	)
+	if err != nil {
+		t.Fatalf("create client: %v", err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+
+	router := v1.NewSnippetsRouter(client)
+	routes := router.Routes()
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/%d", saved.ID()), nil)
+	w := httptest.NewRecorder()
+
+	routes.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d; body: %s", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var response dto.SnippetJSONAPIResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+
+	if response.Data.Attributes.Content.Value != "func hello() { fmt.Println(\"hello\") }" {
+		t.Errorf("content.value = %q, want snippet content", response.Data.Attributes.Content.Value)
+	}
+	if response.Data.Attributes.Content.HTML != nil {
+		t.Error("content.html should be nil when highlight is not requested")
+	}
+}
+
+func TestSnippetsRouter_GetHighlight(t *testing.T) {
+	tmpDir := t.TempDir()
+	dbPath := filepath.Join(tmpDir, "test.db")
+
+	db := openTestDB(t, dbPath)
+	ctx := httptest.NewRequest(http.MethodGet, "/", nil).Context()
+
+	enrichmentStore := persistence.NewEnrichmentStore(db)
+	e := enrichment.NewEnrichment(
+		enrichment.TypeDevelopment,
+		enrichment.SubtypeSnippet,
+		enrichment.EntityTypeSnippet,
+		"func hello() {}",
+	)
+	saved, err := enrichmentStore.Save(ctx, e)
+	if err != nil {
+		t.Fatalf("save enrichment: %v", err)
+	}
+
+	_ = db.Close()
+
+	client, err := kodit.New(
+		kodit.WithSQLite(dbPath),
+		kodit.WithDataDir(tmpDir),
+		kodit.WithSkipProviderValidation(),
+	)
+	if err != nil {
+		t.Fatalf("create client: %v", err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+
+	router := v1.NewSnippetsRouter(client)
+	routes := router.Routes()
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/%d?highlight=true", saved.ID()), nil)
+	w := httptest.NewRecorder()
+
+	routes.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d; body: %s", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var response dto.SnippetJSONAPIResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+
+	if response.Data.Attributes.Content.HTML == nil || *response.Data.Attributes.Content.HTML == "" {
+		t.Error("content.html should be populated when highlight=true")
+	}
+}
+
+func TestSnippetsRouter_GetNotFound(t *testing.T) {
+	tmpDir := t.TempDir()
+	dbPath := filepath.Join(tmpDir, "test.db")
+	_ = openTestDB(t, dbPath).Close()
+
+	client, err := kodit.New(
+		kodit.WithSQLite(dbPath),
+		kodit.WithDataDir(tmpDir),
+		kodit.WithSkipProviderValidation(),
+	)
+	if err != nil {
+		t.Fatalf("create client: %v", err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+
+	router := v1.NewSnippetsRouter(client)
+	routes := router.Routes()
+
+	req := httptest.NewRequest(http.MethodGet, "/999", nil)
+	w := httptest.NewRecorder()
+
+	routes.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want %d; body: %s", w.Code, http.StatusNotFound, w.Body.String())
+	}
+}
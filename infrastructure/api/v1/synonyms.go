@@ -0,0 +1,207 @@
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/rs/zerolog"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/helixml/kodit"
+	"github.com/helixml/kodit/domain/synonym"
+	"github.com/helixml/kodit/infrastructure/api/middleware"
+	"github.com/helixml/kodit/infrastructure/api/v1/dto"
+)
+
+// SynonymsRouter handles synonym dictionary API endpoints.
+type SynonymsRouter struct {
+	client *kodit.Client
+	logger zerolog.Logger
+}
+
+// NewSynonymsRouter creates a new SynonymsRouter.
+func NewSynonymsRouter(client *kodit.Client) *SynonymsRouter {
+	return &SynonymsRouter{
+		client: client,
+		logger: client.Logger(),
+	}
+}
+
+// Routes returns the chi router for synonym endpoints.
+func (r *SynonymsRouter) Routes() chi.Router {
+	router := chi.NewRouter()
+
+	router.Get("/", r.List)
+	router.Post("/", r.Create)
+	router.Put("/{id}", r.Update)
+	router.Delete("/{id}", r.Delete)
+
+	return router
+}
+
+// List handles GET /api/v1/synonyms.
+//
+//	@Summary		List synonyms
+//	@Description	List synonym dictionary entries, optionally filtered by namespace
+//	@Tags			synonyms
+//	@Accept			json
+//	@Produce		json
+//	@Param			namespace	query		string	false	"Restrict to a single namespace"
+//	@Param			page		query		int		false	"Page number (default: 1)"
+//	@Param			page_size	query		int		false	"Results per page (default: 20, max: 100)"
+//	@Success		200			{object}	dto.SynonymListResponse
+//	@Failure		500			{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/synonyms [get]
+func (r *SynonymsRouter) List(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	pagination, err := ParsePagination(req)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	namespace := req.URL.Query().Get("namespace")
+
+	entries, err := r.client.Synonyms.List(ctx, namespace)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	data := make([]dto.SynonymData, len(entries))
+	for i, e := range entries {
+		data[i] = synonymToDTO(e)
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, dto.SynonymListResponse{
+		Data: data,
+		Meta: PaginationMeta(pagination, int64(len(entries))),
+	})
+}
+
+// Create handles POST /api/v1/synonyms.
+//
+//	@Summary		Create synonym
+//	@Description	Add a term and its aliases to a namespace's synonym dictionary
+//	@Tags			synonyms
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		dto.SynonymCreateRequest	true	"Synonym to create"
+//	@Success		201		{object}	dto.SynonymResponse
+//	@Failure		400		{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500		{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/synonyms [post]
+func (r *SynonymsRouter) Create(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	var body dto.SynonymCreateRequest
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	attrs := body.Data.Attributes
+	if attrs.Namespace == "" || attrs.Term == "" {
+		middleware.WriteError(w, req, fmt.Errorf("namespace and term are required: %w", middleware.ErrValidation), r.logger)
+		return
+	}
+
+	saved, err := r.client.Synonyms.Create(ctx, attrs.Namespace, attrs.Term, attrs.Aliases)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusCreated, dto.SynonymResponse{Data: synonymToDTO(saved)})
+}
+
+// Update handles PUT /api/v1/synonyms/{id}.
+//
+//	@Summary		Update synonym
+//	@Description	Replace the aliases for an existing synonym entry
+//	@Tags			synonyms
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int							true	"Synonym ID"
+//	@Param			body	body		dto.SynonymUpdateRequest	true	"Synonym update"
+//	@Success		200		{object}	dto.SynonymResponse
+//	@Failure		400		{object}	middleware.JSONAPIErrorResponse
+//	@Failure		404		{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500		{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/synonyms/{id} [put]
+func (r *SynonymsRouter) Update(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	id, err := strconv.ParseInt(chi.URLParam(req, "id"), 10, 64)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	var body dto.SynonymUpdateRequest
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	saved, err := r.client.Synonyms.Update(ctx, id, body.Data.Attributes.Aliases)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	middleware.WriteJSON(w, http.StatusOK, dto.SynonymResponse{Data: synonymToDTO(saved)})
+}
+
+// Delete handles DELETE /api/v1/synonyms/{id}.
+//
+//	@Summary		Delete synonym
+//	@Description	Remove a synonym entry from its namespace's dictionary
+//	@Tags			synonyms
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path	int	true	"Synonym ID"
+//	@Success		204
+//	@Failure		404	{object}	middleware.JSONAPIErrorResponse
+//	@Failure		500	{object}	middleware.JSONAPIErrorResponse
+//	@Security		APIKeyAuth
+//	@Router			/synonyms/{id} [delete]
+func (r *SynonymsRouter) Delete(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	id, err := strconv.ParseInt(chi.URLParam(req, "id"), 10, 64)
+	if err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	if err := r.client.Synonyms.Delete(ctx, id); err != nil {
+		middleware.WriteError(w, req, err, r.logger)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func synonymToDTO(s synonym.Synonym) dto.SynonymData {
+	return dto.SynonymData{
+		Type: "synonym",
+		ID:   s.ID(),
+		Attributes: dto.SynonymAttributes{
+			Namespace: s.Namespace(),
+			Term:      s.Term(),
+			Aliases:   s.Aliases(),
+			CreatedAt: s.CreatedAt(),
+			UpdatedAt: s.UpdatedAt(),
+		},
+		Links: dto.SynonymLinks{
+			Self: fmt.Sprintf("/api/v1/synonyms/%d", s.ID()),
+		},
+	}
+}
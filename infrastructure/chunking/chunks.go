@@ -1,4 +1,7 @@
-// Package chunking provides fixed-size text chunking with overlap for RAG indexing.
+// Package chunking provides fixed-size text chunking with overlap for RAG
+// indexing, plus a heading-aware variant for prose documents (Markdown,
+// reStructuredText, AsciiDoc) that splits on section boundaries instead of
+// arbitrary windows.
 package chunking
 
 import (
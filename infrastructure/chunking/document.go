@@ -0,0 +1,199 @@
+package chunking
+
+import (
+	"regexp"
+	"strings"
+)
+
+// frontmatterDelimiters maps a frontmatter opening fence to the closing fence
+// that terminates it. YAML frontmatter uses "---" on both sides; TOML uses "+++".
+var frontmatterDelimiters = map[string]string{
+	"---": "---",
+	"+++": "+++",
+}
+
+// headingPattern matches Markdown ATX headings ("# Title") and AsciiDoc
+// section titles ("== Title"), both of which mark the start of a new section.
+var headingPattern = regexp.MustCompile(`^(#{1,6}|=+)\s+\S`)
+
+// rstUnderlineChars lists the punctuation characters reStructuredText allows
+// for section title underlines (docutils' recommended set).
+const rstUnderlineChars = "=-~^\"'`#*+.:_"
+
+// isRstUnderline reports whether line consists solely of one repeated
+// character from rstUnderlineChars, at least three long.
+func isRstUnderline(line string) bool {
+	trimmed := strings.TrimRight(line, "\r\n")
+	if len(trimmed) < 3 || !strings.ContainsRune(rstUnderlineChars, rune(trimmed[0])) {
+		return false
+	}
+	for i := 1; i < len(trimmed); i++ {
+		if trimmed[i] != trimmed[0] {
+			return false
+		}
+	}
+	return true
+}
+
+// Frontmatter holds metadata parsed from a leading YAML or TOML block, such as
+// a document's title, keyed by field name.
+type Frontmatter map[string]string
+
+// Title returns the frontmatter's "title" field, if present.
+func (f Frontmatter) Title() string { return f["title"] }
+
+// NewDocumentChunks splits a text/docs file (Markdown, reStructuredText, or
+// AsciiDoc) into chunks aligned to section headings rather than arbitrary
+// fixed-size windows. Any leading frontmatter block is extracted and removed
+// from the indexed text; each heading is carried into the chunks of its
+// section so that a chunk read on its own still identifies what it's part of.
+// Sections that still exceed Size are further split by NewTextChunks, so
+// Size, Overlap, and MinSize behave exactly as they do for code.
+func NewDocumentChunks(content string, params ChunkParams) (TextChunks, Frontmatter, error) {
+	frontmatter, body, bodyOffset, bodyLines := extractFrontmatter(content)
+
+	if body == "" {
+		return TextChunks{}, frontmatter, nil
+	}
+
+	var chunks []Chunk
+	for _, section := range splitSections(body) {
+		sectionChunks, err := NewTextChunks(section.text, params)
+		if err != nil {
+			return TextChunks{}, nil, err
+		}
+		lineOffset := bodyLines + section.startLine - 1
+		for _, c := range sectionChunks.All() {
+			c.offset += bodyOffset + section.offset
+			c.startLine += lineOffset
+			c.endLine += lineOffset
+			chunks = append(chunks, c)
+		}
+	}
+
+	return TextChunks{chunks: chunks}, frontmatter, nil
+}
+
+// extractFrontmatter removes a leading "---"/"+++" delimited frontmatter block
+// from content, if present, and parses its "key: value" lines. It returns the
+// remaining body, the byte offset at which the body begins in content, and
+// the number of lines consumed by the frontmatter block (0 if absent), so
+// callers can translate body-relative line numbers back to the original file.
+func extractFrontmatter(content string) (Frontmatter, string, int, int) {
+	lines := splitLines(content)
+	if len(lines) == 0 {
+		return nil, content, 0, 0
+	}
+
+	fence := strings.TrimSpace(lines[0])
+	closing, ok := frontmatterDelimiters[fence]
+	if !ok {
+		return nil, content, 0, 0
+	}
+
+	for i := 1; i < len(lines); i++ {
+		if strings.TrimSpace(lines[i]) != closing {
+			continue
+		}
+
+		frontmatter := parseFrontmatter(lines[1:i])
+		bodyOffset := byteLen(lines[:i+1])
+		return frontmatter, content[bodyOffset:], bodyOffset, i + 1
+	}
+
+	return nil, content, 0, 0
+}
+
+// parseFrontmatter reads simple "key: value" pairs out of a frontmatter block.
+// Nested structures and lists are not supported; those lines are skipped.
+func parseFrontmatter(lines []string) Frontmatter {
+	fm := Frontmatter{}
+	for _, line := range lines {
+		key, value, found := strings.Cut(line, ":")
+		if !found {
+			continue
+		}
+		key = strings.TrimSpace(key)
+		value = strings.Trim(strings.TrimSpace(value), `"'`)
+		if key == "" || value == "" {
+			continue
+		}
+		fm[key] = value
+	}
+	if len(fm) == 0 {
+		return nil
+	}
+	return fm
+}
+
+// section is a heading-delimited region of a document, along with the heading
+// text that introduces it.
+type section struct {
+	text      string
+	offset    int
+	startLine int
+}
+
+// splitSections splits body at heading boundaries (Markdown ATX, AsciiDoc
+// section titles, and reStructuredText underlined titles), keeping each
+// heading attached to the section it introduces. Content before the first
+// heading, if any, forms its own section.
+func splitSections(body string) []section {
+	lines := splitLines(body)
+	if len(lines) == 0 {
+		return nil
+	}
+
+	var sections []section
+	start := 0
+	byteOffset := 0
+	boundaries := []int{}
+
+	for i, line := range lines {
+		trimmed := strings.TrimRight(line, "\r\n")
+		if headingPattern.MatchString(trimmed) {
+			boundaries = append(boundaries, i)
+			continue
+		}
+		if i > 0 && isRstUnderline(trimmed) && isRstTitle(lines[i-1], trimmed) {
+			boundaries = append(boundaries, i-1)
+		}
+	}
+
+	offsets := make([]int, len(lines)+1)
+	for i, line := range lines {
+		offsets[i] = byteOffset
+		byteOffset += len(line)
+	}
+	offsets[len(lines)] = byteOffset
+
+	cut := func(end int) {
+		if end <= start {
+			return
+		}
+		sections = append(sections, section{
+			text:      strings.Join(lines[start:end], ""),
+			offset:    offsets[start],
+			startLine: start + 1,
+		})
+		start = end
+	}
+
+	for _, b := range boundaries {
+		cut(b)
+	}
+	cut(len(lines))
+
+	return sections
+}
+
+// isRstTitle reports whether title is a plausible reStructuredText section
+// title for the underline below it: non-blank, and no longer than the
+// underline (reST requires the underline to be at least as long as the title).
+func isRstTitle(title, underline string) bool {
+	t := strings.TrimSpace(title)
+	if t == "" || headingPattern.MatchString(t) {
+		return false
+	}
+	return len([]rune(t)) <= len([]rune(strings.TrimSpace(underline)))
+}
@@ -0,0 +1,93 @@
+package chunking
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDocumentChunks_FrontmatterExtracted(t *testing.T) {
+	content := "---\ntitle: Getting Started\nauthor: Ada\n---\n# Intro\nHello world.\n"
+	params := ChunkParams{Size: 1500, Overlap: 200, MinSize: 1}
+
+	chunks, fm, err := NewDocumentChunks(content, params)
+	require.NoError(t, err)
+
+	require.NotNil(t, fm)
+	assert.Equal(t, "Getting Started", fm.Title())
+	assert.Equal(t, "Ada", fm["author"])
+
+	result := chunks.All()
+	require.Len(t, result, 1)
+	assert.NotContains(t, result[0].Content(), "title:")
+	assert.Contains(t, result[0].Content(), "# Intro")
+}
+
+func TestNewDocumentChunks_NoFrontmatter(t *testing.T) {
+	content := "# Intro\nHello world.\n"
+	params := ChunkParams{Size: 1500, Overlap: 200, MinSize: 1}
+
+	chunks, fm, err := NewDocumentChunks(content, params)
+	require.NoError(t, err)
+	assert.Nil(t, fm)
+	assert.Len(t, chunks.All(), 1)
+}
+
+func TestNewDocumentChunks_SplitsOnHeadings(t *testing.T) {
+	content := "# One\nfirst section\n\n# Two\nsecond section\n"
+	params := ChunkParams{Size: 1500, Overlap: 0, MinSize: 1}
+
+	chunks, _, err := NewDocumentChunks(content, params)
+	require.NoError(t, err)
+
+	result := chunks.All()
+	require.Len(t, result, 2)
+	assert.True(t, strings.HasPrefix(result[0].Content(), "# One"))
+	assert.True(t, strings.HasPrefix(result[1].Content(), "# Two"))
+}
+
+func TestNewDocumentChunks_RstUnderlineHeading(t *testing.T) {
+	content := "Title\n=====\nfirst section\n\nNext\n----\nsecond section\n"
+	params := ChunkParams{Size: 1500, Overlap: 0, MinSize: 1}
+
+	chunks, _, err := NewDocumentChunks(content, params)
+	require.NoError(t, err)
+
+	result := chunks.All()
+	require.Len(t, result, 2)
+	assert.True(t, strings.HasPrefix(result[0].Content(), "Title\n====="))
+	assert.True(t, strings.HasPrefix(result[1].Content(), "Next\n----"))
+}
+
+func TestNewDocumentChunks_LineNumbersAlignToFile(t *testing.T) {
+	content := "---\ntitle: X\n---\n# One\nfirst\n\n# Two\nsecond\n"
+	params := ChunkParams{Size: 1500, Overlap: 0, MinSize: 1}
+
+	chunks, _, err := NewDocumentChunks(content, params)
+	require.NoError(t, err)
+
+	result := chunks.All()
+	require.Len(t, result, 2)
+	assert.Equal(t, 4, result[0].StartLine())
+	assert.Equal(t, 7, result[1].StartLine())
+}
+
+func TestNewDocumentChunks_LongSectionStillWindowed(t *testing.T) {
+	content := "# One\n" + strings.Repeat("A", 300) + "\n"
+	params := ChunkParams{Size: 100, Overlap: 0, MinSize: 1}
+
+	chunks, _, err := NewDocumentChunks(content, params)
+	require.NoError(t, err)
+	assert.True(t, len(chunks.All()) > 1)
+}
+
+func TestNewDocumentChunks_EmptyContent(t *testing.T) {
+	params := ChunkParams{Size: 100, Overlap: 0, MinSize: 1}
+
+	chunks, fm, err := NewDocumentChunks("", params)
+	require.NoError(t, err)
+	assert.Nil(t, fm)
+	assert.Empty(t, chunks.All())
+}
@@ -0,0 +1,128 @@
+package enricher
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// maxConventionsFilesPerLanguage bounds how many sample files are read per
+// language so the gathered context stays within a reasonable prompt size.
+const maxConventionsFilesPerLanguage = 3
+
+// maxConventionsFileBytes truncates each sampled file so a handful of large
+// generated files cannot dominate the context window.
+const maxConventionsFileBytes = 4000
+
+// ConventionsContextService gathers representative source samples per
+// language for code conventions generation.
+type ConventionsContextService struct{}
+
+// NewConventionsContextService creates a new ConventionsContextService.
+func NewConventionsContextService() *ConventionsContextService {
+	return &ConventionsContextService{}
+}
+
+// Gather walks repoPath and collects a handful of representative files for
+// each language present, so the LLM can infer naming, error handling, and
+// test conventions from real examples rather than a single file.
+func (s *ConventionsContextService) Gather(ctx context.Context, repoPath string, languages []string) (string, error) {
+	wanted := make(map[string]bool, len(languages))
+	for _, lang := range languages {
+		wanted[lang] = true
+	}
+
+	samples := make(map[string][]string)
+	err := filepath.Walk(repoPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if info.IsDir() {
+			if strings.HasPrefix(info.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		lang := languageForExtension(filepath.Ext(path))
+		if lang == "" || (len(wanted) > 0 && !wanted[lang]) {
+			return nil
+		}
+		if isTestFile(path) {
+			return nil
+		}
+		if len(samples[lang]) >= maxConventionsFilesPerLanguage {
+			return nil
+		}
+		samples[lang] = append(samples[lang], path)
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var sections []string
+	langs := make([]string, 0, len(samples))
+	for lang := range samples {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
+
+	for _, lang := range langs {
+		for _, path := range samples[lang] {
+			data, readErr := os.ReadFile(path)
+			if readErr != nil {
+				continue
+			}
+			content := string(data)
+			if len(content) > maxConventionsFileBytes {
+				content = content[:maxConventionsFileBytes] + "\n...[truncated]"
+			}
+			rel, relErr := filepath.Rel(repoPath, path)
+			if relErr != nil {
+				rel = path
+			}
+			sections = append(sections, "### "+rel+" ("+lang+")\n```"+lang+"\n"+content+"\n```")
+		}
+	}
+
+	if len(sections) == 0 {
+		return "No representative files found", nil
+	}
+	return strings.Join(sections, "\n\n"), nil
+}
+
+func isTestFile(path string) bool {
+	base := strings.ToLower(filepath.Base(path))
+	return strings.Contains(base, "test") || strings.Contains(base, "spec")
+}
+
+func languageForExtension(ext string) string {
+	switch ext {
+	case ".go":
+		return "go"
+	case ".py":
+		return "python"
+	case ".js", ".jsx":
+		return "javascript"
+	case ".ts", ".tsx":
+		return "typescript"
+	case ".java":
+		return "java"
+	case ".rs":
+		return "rust"
+	case ".rb":
+		return "ruby"
+	case ".c", ".h":
+		return "c"
+	case ".cpp", ".cc", ".hpp":
+		return "cpp"
+	default:
+		return ""
+	}
+}
@@ -99,7 +99,7 @@ func (e *ProviderEnricher) Enrich(ctx context.Context, requests []domainservice.
 			}()
 
 			req := requests[reqIdx]
-			resp, err := e.processRequest(ctx, req)
+			resp, err := e.processRequest(ctx, req, cfg.OutputLanguage())
 			if err != nil {
 				mu.Lock()
 				requestErrors = append(requestErrors, fmt.Errorf("enrich request %s: %w", req.ID(), err))
@@ -134,9 +134,14 @@ func (e *ProviderEnricher) Enrich(ctx context.Context, requests []domainservice.
 	return result, nil
 }
 
-func (e *ProviderEnricher) processRequest(ctx context.Context, req domainservice.EnrichmentRequest) (domainservice.EnrichmentResponse, error) {
+func (e *ProviderEnricher) processRequest(ctx context.Context, req domainservice.EnrichmentRequest, outputLanguage string) (domainservice.EnrichmentResponse, error) {
+	systemPrompt := req.SystemPrompt()
+	if outputLanguage != "" {
+		systemPrompt += fmt.Sprintf("\n\nRespond in %s.", outputLanguage)
+	}
+
 	messages := []provider.Message{
-		provider.SystemMessage(req.SystemPrompt()),
+		provider.SystemMessage(systemPrompt),
 		provider.UserMessage(req.Text()),
 	}
 
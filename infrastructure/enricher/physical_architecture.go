@@ -33,6 +33,91 @@ func (s *PhysicalArchitectureService) Discover(ctx context.Context, repoPath str
 	return s.formatForLLM(repoContext, componentNotes, connectionNotes, infrastructureNotes, metadata), nil
 }
 
+// DiscoverDiagram analyzes a repository's Docker Compose configuration and
+// renders its services and startup dependencies as Mermaid flowchart source.
+func (s *PhysicalArchitectureService) DiscoverDiagram(repoPath string) (string, error) {
+	nodes := make(map[string]bool)
+	var edges []string
+
+	for _, composeFile := range s.findDockerComposeFiles(repoPath) {
+		data, err := os.ReadFile(composeFile)
+		if err != nil {
+			continue
+		}
+
+		var composeData map[string]any
+		if err := yaml.Unmarshal(data, &composeData); err != nil {
+			continue
+		}
+
+		services, ok := composeData["services"].(map[string]any)
+		if !ok {
+			continue
+		}
+
+		for serviceName, serviceConfigAny := range services {
+			nodes[serviceName] = true
+
+			serviceConfig, ok := serviceConfigAny.(map[string]any)
+			if !ok {
+				continue
+			}
+			for _, dep := range s.extractDependsOn(serviceConfig) {
+				nodes[dep] = true
+				edges = append(edges, mermaidEdge(serviceName, dep))
+			}
+		}
+	}
+
+	return s.formatMermaid(nodes, edges), nil
+}
+
+// formatMermaid renders a set of service names and 'a --> b' edge lines as a
+// Mermaid flowchart, with nodes and edges sorted for deterministic output.
+func (s *PhysicalArchitectureService) formatMermaid(nodes map[string]bool, edges []string) string {
+	names := make([]string, 0, len(nodes))
+	for name := range nodes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	sort.Strings(edges)
+
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+	if len(names) == 0 {
+		b.WriteString("    no_services[\"No Docker Compose services found\"]\n")
+		return b.String()
+	}
+	for _, name := range names {
+		fmt.Fprintf(&b, "    %s[%q]\n", mermaidID(name), name)
+	}
+	for _, edge := range edges {
+		fmt.Fprintf(&b, "    %s\n", edge)
+	}
+	return b.String()
+}
+
+// mermaidEdge renders a 'from depends on to' relationship as a Mermaid edge
+// between the two services' sanitized node identifiers.
+func mermaidEdge(from, to string) string {
+	return fmt.Sprintf("%s --> %s", mermaidID(from), mermaidID(to))
+}
+
+// mermaidID sanitizes a Docker Compose service name into a Mermaid-safe node
+// identifier, since Mermaid node IDs cannot contain arbitrary characters.
+func mermaidID(name string) string {
+	var b strings.Builder
+	for _, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_':
+			b.WriteRune(r)
+		default:
+			b.WriteRune('_')
+		}
+	}
+	return b.String()
+}
+
 func (s *PhysicalArchitectureService) analyzeRepositoryContext(repoPath string) string {
 	var observations []string
 
@@ -129,6 +214,27 @@ func (s *PhysicalArchitectureService) analyzeDockerCompose(repoPath string) ([]s
 	return componentNotes, connectionNotes, infrastructureNotes
 }
 
+// extractDependsOn returns the names of services a service's Docker Compose
+// 'depends_on' configuration requires to start first.
+func (s *PhysicalArchitectureService) extractDependsOn(config map[string]any) []string {
+	var dependencies []string
+
+	switch dep := config["depends_on"].(type) {
+	case []any:
+		for _, d := range dep {
+			if depStr, ok := d.(string); ok {
+				dependencies = append(dependencies, depStr)
+			}
+		}
+	case map[string]any:
+		for d := range dep {
+			dependencies = append(dependencies, d)
+		}
+	}
+
+	return dependencies
+}
+
 func (s *PhysicalArchitectureService) analyzeService(serviceName string, config map[string]any, componentNotes *[]string) {
 	observation := fmt.Sprintf("Found '%s' service in Docker Compose configuration.", serviceName)
 
@@ -179,21 +285,7 @@ func (s *PhysicalArchitectureService) analyzeServiceDependencies(services map[st
 			continue
 		}
 
-		dependsOn := serviceConfig["depends_on"]
-		var dependencies []string
-
-		switch dep := dependsOn.(type) {
-		case []any:
-			for _, d := range dep {
-				if depStr, ok := d.(string); ok {
-					dependencies = append(dependencies, depStr)
-				}
-			}
-		case map[string]any:
-			for d := range dep {
-				dependencies = append(dependencies, d)
-			}
-		}
+		dependencies := s.extractDependsOn(serviceConfig)
 
 		if len(dependencies) > 0 {
 			*connectionNotes = append(*connectionNotes,
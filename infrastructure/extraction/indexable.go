@@ -0,0 +1,82 @@
+package extraction
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// indexableExtensions lists source code and documentation formats worth
+// indexing. Populated at init time with the document formats this package
+// can already extract text from, so the two lists never drift apart.
+var indexableExtensions = map[string]bool{
+	// Go
+	".go": true,
+	// Python
+	".py": true, ".pyi": true, ".pyx": true,
+	// JavaScript / TypeScript
+	".js": true, ".mjs": true, ".cjs": true, ".jsx": true,
+	".ts": true, ".mts": true, ".cts": true, ".tsx": true,
+	// Ruby
+	".rb": true, ".erb": true,
+	// Rust
+	".rs": true,
+	// Java / Kotlin / Scala / Groovy
+	".java": true, ".kt": true, ".kts": true, ".scala": true, ".groovy": true,
+	// C / C++ / Objective-C
+	".c": true, ".h": true, ".cpp": true, ".cc": true, ".cxx": true,
+	".hpp": true, ".hxx": true, ".m": true, ".mm": true,
+	// C# / F#
+	".cs": true, ".fs": true, ".fsx": true,
+	// PHP
+	".php": true,
+	// Swift
+	".swift": true,
+	// Shell
+	".sh": true, ".bash": true, ".zsh": true, ".fish": true,
+	// SQL
+	".sql": true,
+	// R
+	".r": true,
+	// Lua
+	".lua": true,
+	// Perl
+	".pl": true, ".pm": true,
+	// Elixir / Erlang
+	".ex": true, ".exs": true, ".erl": true, ".hrl": true,
+	// Haskell
+	".hs": true,
+	// Clojure
+	".clj": true, ".cljs": true, ".cljc": true,
+	// Dart
+	".dart": true,
+	// Zig / Nim
+	".zig": true, ".nim": true,
+	// Julia
+	".jl": true,
+	// OCaml
+	".ml": true, ".mli": true,
+	// V / D
+	".v": true, ".d": true,
+	// Web
+	".html": true, ".htm": true, ".css": true, ".scss": true,
+	".sass": true, ".less": true, ".vue": true, ".svelte": true,
+	// Documentation
+	".md": true, ".mdx": true, ".rst": true, ".adoc": true, ".tex": true,
+	// IDL / Schema
+	".proto": true, ".graphql": true, ".gql": true, ".thrift": true,
+	// Data
+	".csv": true,
+}
+
+func init() {
+	for _, ext := range Extensions() {
+		indexableExtensions[ext] = true
+	}
+}
+
+// IsIndexable returns true if the file extension is in the whitelist of
+// source code and documentation formats worth indexing.
+func IsIndexable(path string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	return indexableExtensions[ext]
+}
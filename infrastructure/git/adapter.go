@@ -67,11 +67,40 @@ type Adapter interface {
 	// AllTags returns all tags in repository.
 	AllTags(ctx context.Context, localPath string) ([]TagInfo, error)
 
-	// CommitDiff returns the diff for a specific commit.
-	CommitDiff(ctx context.Context, localPath string, commitSHA string) (string, error)
+	// CommitDiff returns the diff for a specific commit, optionally scoped
+	// to a single file path. An empty filePath returns the full commit diff.
+	CommitDiff(ctx context.Context, localPath string, commitSHA string, filePath string) (string, error)
+
+	// DiffRefs returns the diff between two refs (commits, branches, or
+	// tags) in the local clone, in the same form as `git diff base head`.
+	DiffRefs(ctx context.Context, localPath string, baseRef string, headRef string) (string, error)
 
 	// Grep searches for a pattern in tracked files at a specific commit using git grep.
 	Grep(ctx context.Context, localPath string, commitSHA string, pattern string, pathspec string, maxMatches int) ([]GrepMatch, error)
+
+	// UncommittedFiles returns every file with uncommitted working tree
+	// changes (modified, added, renamed, or untracked), excluding deletions.
+	UncommittedFiles(ctx context.Context, localPath string) ([]UncommittedFile, error)
+
+	// RemoteRefs lists the current branch and tag head SHAs on the remote,
+	// keyed by ref name (e.g. "main", "v1.0.0"), without requiring a local
+	// clone.
+	RemoteRefs(ctx context.Context, remoteURI string) (map[string]string, error)
+
+	// IsAncestor reports whether ancestorSHA is an ancestor of (or equal to)
+	// descendantSHA in the local clone's history.
+	IsAncestor(ctx context.Context, localPath string, ancestorSHA string, descendantSHA string) (bool, error)
+
+	// FetchRef fetches a single ref (e.g. a pull request ref like
+	// "refs/pull/42/head") from origin into the local clone without moving
+	// any tracked branch, and returns the commit SHA it resolved to.
+	FetchRef(ctx context.Context, localPath string, ref string) (string, error)
+}
+
+// UncommittedFile describes a single file with uncommitted working tree
+// changes, relative to the repository root.
+type UncommittedFile struct {
+	Path string
 }
 
 // GrepMatch holds a single line match from git grep.
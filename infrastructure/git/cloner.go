@@ -38,9 +38,10 @@ func isGitRepo(path string) bool {
 // RepositoryCloner handles repository cloning and updating operations.
 // Implements domain/service.Cloner interface.
 type RepositoryCloner struct {
-	adapter  Adapter
-	cloneDir string
-	logger   zerolog.Logger
+	adapter   Adapter
+	cloneDir  string
+	encryptor *WorkingCopyEncryptor
+	logger    zerolog.Logger
 }
 
 // NewRepositoryCloner creates a new RepositoryCloner with the specified adapter and clone directory.
@@ -52,6 +53,36 @@ func NewRepositoryCloner(adapter Adapter, cloneDir string, logger zerolog.Logger
 	}
 }
 
+// NewEncryptedRepositoryCloner creates a RepositoryCloner that seals working
+// copies at rest using encryptor. Update transparently opens a sealed
+// working copy before touching it; callers seal a working copy again via
+// SealWorkingCopy once they are done with it (e.g. after indexing).
+func NewEncryptedRepositoryCloner(adapter Adapter, cloneDir string, encryptor *WorkingCopyEncryptor, logger zerolog.Logger) *RepositoryCloner {
+	c := NewRepositoryCloner(adapter, cloneDir, logger)
+	c.encryptor = encryptor
+	return c
+}
+
+// SealWorkingCopy encrypts repo's working copy at rest, if this cloner was
+// configured with an encryptor. It is a no-op otherwise, and returns
+// repository.ErrNotCloned if the repository has no working copy.
+func (c *RepositoryCloner) SealWorkingCopy(repo repository.Repository) error {
+	if c.encryptor == nil {
+		return nil
+	}
+	if !repo.HasWorkingCopy() {
+		return repository.ErrNotCloned
+	}
+
+	clonePath := repo.WorkingCopy().Path()
+	if _, err := c.encryptor.Seal(clonePath); err != nil {
+		return fmt.Errorf("seal working copy: %w", err)
+	}
+
+	c.logger.Info().Int64("repo_id", repo.ID()).Str("path", clonePath).Msg("sealed working copy")
+	return nil
+}
+
 // ClonePathFromURI returns the local clone path for a given repository URI.
 // For file:// URIs the local path is returned directly; for all other URIs
 // a sanitized subdirectory of the configured clone directory is returned.
@@ -111,6 +142,13 @@ func (c *RepositoryCloner) Update(ctx context.Context, repo repository.Repositor
 
 	clonePath := repo.WorkingCopy().Path()
 
+	if c.encryptor != nil && IsSealed(clonePath) {
+		c.logger.Info().Int64("repo_id", repo.ID()).Str("path", clonePath).Msg("opening sealed working copy")
+		if err := c.encryptor.Open(clonePath); err != nil {
+			return "", fmt.Errorf("open sealed working copy: %w", err)
+		}
+	}
+
 	// For file:// repositories the directory is owned by the user; never
 	// attempt to re-clone it or run git network operations (fetch/pull) —
 	// there is no remote to fetch from.  The scanner will use git commands
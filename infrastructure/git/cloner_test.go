@@ -68,12 +68,27 @@ func (f *fakeAdapter) LatestCommitSHA(_ context.Context, _ string, _ string) (st
 	return "", nil
 }
 func (f *fakeAdapter) AllTags(_ context.Context, _ string) ([]TagInfo, error) { return nil, nil }
-func (f *fakeAdapter) CommitDiff(_ context.Context, _ string, _ string) (string, error) {
+func (f *fakeAdapter) CommitDiff(_ context.Context, _ string, _ string, _ string) (string, error) {
+	return "", nil
+}
+func (f *fakeAdapter) DiffRefs(_ context.Context, _ string, _ string, _ string) (string, error) {
 	return "", nil
 }
 func (f *fakeAdapter) Grep(_ context.Context, _ string, _ string, _ string, _ string, _ int) ([]GrepMatch, error) {
 	return nil, nil
 }
+func (f *fakeAdapter) UncommittedFiles(_ context.Context, _ string) ([]UncommittedFile, error) {
+	return nil, nil
+}
+func (f *fakeAdapter) FetchRef(_ context.Context, _ string, _ string) (string, error) {
+	return "", nil
+}
+func (f *fakeAdapter) RemoteRefs(_ context.Context, _ string) (map[string]string, error) {
+	return nil, nil
+}
+func (f *fakeAdapter) IsAncestor(_ context.Context, _ string, _ string, _ string) (bool, error) {
+	return false, nil
+}
 
 // ---- file:// URI helpers ----
 
@@ -137,7 +152,13 @@ func TestUpdate_FileURI_NonGitDir_SkipsGitOps(t *testing.T) {
 		repository.NewWorkingCopy(plainDir, uri),
 		repository.NewTrackingConfigForBranch("main"),
 		repository.DefaultChunkingConfig(),
+		repository.DefaultEnrichmentBudgetConfig(),
+		repository.DefaultEmbeddingConfig(),
+		repository.DefaultAccessConfig(),
+		repository.DefaultIndexFilterConfig(),
 		time.Now(), time.Now(), time.Time{},
+		"",
+		false, false, false,
 	)
 
 	gotPath, err := cloner.Update(context.Background(), repo)
@@ -175,7 +196,13 @@ func TestUpdate_FileURI_GitRepo_SkipsNetworkOps(t *testing.T) {
 		repository.NewWorkingCopy(repoDir, uri),
 		repository.NewTrackingConfigForBranch("main"),
 		repository.DefaultChunkingConfig(),
+		repository.DefaultEnrichmentBudgetConfig(),
+		repository.DefaultEmbeddingConfig(),
+		repository.DefaultAccessConfig(),
+		repository.DefaultIndexFilterConfig(),
 		time.Now(), time.Now(), time.Time{},
+		"",
+		false, false, false,
 	)
 
 	gotPath, err := cloner.Update(context.Background(), repo)
@@ -208,7 +235,13 @@ func TestUpdate_FileURI_MissingDir_DoesNotReclone(t *testing.T) {
 		repository.NewWorkingCopy(missingDir, uri),
 		repository.NewTrackingConfigForBranch("main"),
 		repository.DefaultChunkingConfig(),
+		repository.DefaultEnrichmentBudgetConfig(),
+		repository.DefaultEmbeddingConfig(),
+		repository.DefaultAccessConfig(),
+		repository.DefaultIndexFilterConfig(),
 		time.Now(), time.Now(), time.Time{},
+		"",
+		false, false, false,
 	)
 
 	// isGitRepo will return false for a missing dir, so we expect early return without cloning.
@@ -221,6 +254,91 @@ func TestUpdate_FileURI_MissingDir_DoesNotReclone(t *testing.T) {
 	}
 }
 
+// ---- encrypted working copies ----
+
+func TestUpdate_EncryptedCloner_OpensSealedWorkingCopy(t *testing.T) {
+	fake := &fakeAdapter{}
+	encryptor, err := NewWorkingCopyEncryptor(testKeyHex)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cloner := NewEncryptedRepositoryCloner(fake, t.TempDir(), encryptor, zerolog.Nop())
+
+	repoDir := t.TempDir()
+	initCmd := exec.Command("git", "init", repoDir)
+	if out, err := initCmd.CombinedOutput(); err != nil {
+		t.Fatalf("git init: %v: %s", err, out)
+	}
+
+	uri := "file://" + repoDir
+	repo := repository.ReconstructRepository(
+		6,
+		0,
+		uri, uri, "",
+		repository.NewWorkingCopy(repoDir, uri),
+		repository.NewTrackingConfigForBranch("main"),
+		repository.DefaultChunkingConfig(),
+		repository.DefaultEnrichmentBudgetConfig(),
+		repository.DefaultEmbeddingConfig(),
+		repository.DefaultAccessConfig(),
+		repository.DefaultIndexFilterConfig(),
+		time.Now(), time.Now(), time.Time{},
+		"",
+		false, false, false,
+	)
+
+	if err := cloner.SealWorkingCopy(repo); err != nil {
+		t.Fatalf("seal working copy: %v", err)
+	}
+	if !IsSealed(repoDir) {
+		t.Fatal("expected working copy to be sealed")
+	}
+
+	gotPath, err := cloner.Update(context.Background(), repo)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotPath != repoDir {
+		t.Fatalf("expected %q, got %q", repoDir, gotPath)
+	}
+	if IsSealed(repoDir) {
+		t.Fatal("expected Update to open the sealed working copy")
+	}
+	if !isGitRepo(repoDir) {
+		t.Fatal("expected the git repo to be restored intact")
+	}
+}
+
+func TestSealWorkingCopy_NoEncryptor_IsNoOp(t *testing.T) {
+	fake := &fakeAdapter{}
+	cloner := NewRepositoryCloner(fake, t.TempDir(), zerolog.Nop())
+
+	repoDir := t.TempDir()
+	uri := "file://" + repoDir
+	repo := repository.ReconstructRepository(
+		7,
+		0,
+		uri, uri, "",
+		repository.NewWorkingCopy(repoDir, uri),
+		repository.NewTrackingConfigForBranch("main"),
+		repository.DefaultChunkingConfig(),
+		repository.DefaultEnrichmentBudgetConfig(),
+		repository.DefaultEmbeddingConfig(),
+		repository.DefaultAccessConfig(),
+		repository.DefaultIndexFilterConfig(),
+		time.Now(), time.Now(), time.Time{},
+		"",
+		false, false, false,
+	)
+
+	if err := cloner.SealWorkingCopy(repo); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if IsSealed(repoDir) {
+		t.Fatal("expected no sealing to occur without a configured encryptor")
+	}
+}
+
 // ---- existing tests ----
 
 func TestUpdate_MissingDirectory(t *testing.T) {
@@ -239,7 +357,13 @@ func TestUpdate_MissingDirectory(t *testing.T) {
 		repository.NewWorkingCopy(missingPath, remoteURI),
 		repository.NewTrackingConfigForBranch("main"),
 		repository.DefaultChunkingConfig(),
+		repository.DefaultEnrichmentBudgetConfig(),
+		repository.DefaultEmbeddingConfig(),
+		repository.DefaultAccessConfig(),
+		repository.DefaultIndexFilterConfig(),
 		time.Now(), time.Now(), time.Time{},
+		"",
+		false, false, false,
 	)
 
 	newPath, err := cloner.Update(context.Background(), repo)
@@ -296,7 +420,13 @@ func TestUpdate_InaccessibleDirectory(t *testing.T) {
 		repository.NewWorkingCopy(child, "https://github.com/example/repo.git"),
 		repository.NewTrackingConfigForBranch("main"),
 		repository.DefaultChunkingConfig(),
+		repository.DefaultEnrichmentBudgetConfig(),
+		repository.DefaultEmbeddingConfig(),
+		repository.DefaultAccessConfig(),
+		repository.DefaultIndexFilterConfig(),
 		time.Now(), time.Now(), time.Time{},
+		"",
+		false, false, false,
 	)
 
 	newPath, err := cloner.Update(context.Background(), repo)
@@ -0,0 +1,233 @@
+package git
+
+import (
+	"archive/tar"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// sealedSuffix is appended to a working copy's directory path to name its
+// sealed (encrypted) archive on disk.
+const sealedSuffix = ".sealed"
+
+// WorkingCopyEncryptor seals a repository's on-disk working copy into a
+// single AES-256-GCM encrypted archive, and opens it back into a plain
+// directory on demand. This lets an operator keep clones encrypted at rest
+// between indexing runs, so a stolen disk does not leak proprietary source.
+type WorkingCopyEncryptor struct {
+	key []byte
+}
+
+// NewWorkingCopyEncryptor creates a WorkingCopyEncryptor from a hex-encoded
+// AES-256 key (64 hex characters), typically sourced from an environment
+// variable or KMS-backed secret. It fails fast if the key is malformed,
+// rather than silently falling back to storing clones unencrypted.
+func NewWorkingCopyEncryptor(keyHex string) (*WorkingCopyEncryptor, error) {
+	key, err := hex.DecodeString(keyHex)
+	if err != nil {
+		return nil, fmt.Errorf("decode clone encryption key: %w", err)
+	}
+	if len(key) != 32 {
+		return nil, fmt.Errorf("clone encryption key must be 32 bytes (64 hex characters), got %d bytes", len(key))
+	}
+	return &WorkingCopyEncryptor{key: key}, nil
+}
+
+// SealedPath returns the path a working copy at dirPath is stored under once
+// sealed.
+func SealedPath(dirPath string) string {
+	return dirPath + sealedSuffix
+}
+
+// Seal archives and encrypts the working copy at dirPath, writes it to
+// SealedPath(dirPath), and removes the plaintext directory. It returns the
+// sealed archive's path.
+func (e *WorkingCopyEncryptor) Seal(dirPath string) (string, error) {
+	var plaintext bytes.Buffer
+	if err := tarDirectory(dirPath, &plaintext); err != nil {
+		return "", fmt.Errorf("archive working copy: %w", err)
+	}
+
+	ciphertext, err := e.encrypt(plaintext.Bytes())
+	if err != nil {
+		return "", fmt.Errorf("encrypt working copy: %w", err)
+	}
+
+	sealedPath := SealedPath(dirPath)
+	if err := os.WriteFile(sealedPath, ciphertext, 0o600); err != nil {
+		return "", fmt.Errorf("write sealed working copy: %w", err)
+	}
+
+	if err := os.RemoveAll(dirPath); err != nil {
+		return "", fmt.Errorf("remove plaintext working copy: %w", err)
+	}
+
+	return sealedPath, nil
+}
+
+// Open decrypts the sealed archive at SealedPath(dirPath) and extracts it
+// back to dirPath, removing the sealed archive on success.
+func (e *WorkingCopyEncryptor) Open(dirPath string) error {
+	sealedPath := SealedPath(dirPath)
+
+	ciphertext, err := os.ReadFile(sealedPath)
+	if err != nil {
+		return fmt.Errorf("read sealed working copy: %w", err)
+	}
+
+	plaintext, err := e.decrypt(ciphertext)
+	if err != nil {
+		return fmt.Errorf("decrypt working copy: %w", err)
+	}
+
+	if err := untarDirectory(dirPath, bytes.NewReader(plaintext)); err != nil {
+		return fmt.Errorf("extract working copy: %w", err)
+	}
+
+	if err := os.Remove(sealedPath); err != nil {
+		return fmt.Errorf("remove sealed working copy: %w", err)
+	}
+
+	return nil
+}
+
+// IsSealed reports whether dirPath currently exists only in sealed form.
+func IsSealed(dirPath string) bool {
+	if _, err := os.Stat(dirPath); err == nil {
+		return false
+	}
+	_, err := os.Stat(SealedPath(dirPath))
+	return err == nil
+}
+
+func (e *WorkingCopyEncryptor) encrypt(plaintext []byte) ([]byte, error) {
+	gcm, err := e.gcm()
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("generate nonce: %w", err)
+	}
+
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func (e *WorkingCopyEncryptor) decrypt(ciphertext []byte) ([]byte, error) {
+	gcm, err := e.gcm()
+	if err != nil {
+		return nil, err
+	}
+
+	nonceSize := gcm.NonceSize()
+	if len(ciphertext) < nonceSize {
+		return nil, fmt.Errorf("sealed archive is truncated")
+	}
+
+	nonce, sealed := ciphertext[:nonceSize], ciphertext[nonceSize:]
+	return gcm.Open(nil, nonce, sealed, nil)
+}
+
+func (e *WorkingCopyEncryptor) gcm() (cipher.AEAD, error) {
+	block, err := aes.NewCipher(e.key)
+	if err != nil {
+		return nil, fmt.Errorf("create cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// tarDirectory writes a tar archive of dirPath's contents to w.
+func tarDirectory(dirPath string, w io.Writer) error {
+	tw := tar.NewWriter(w)
+
+	err := filepath.Walk(dirPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(dirPath, path)
+		if err != nil {
+			return err
+		}
+		if relPath == "." {
+			return nil
+		}
+
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(relPath)
+
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return tw.Close()
+}
+
+// untarDirectory extracts the tar archive read from r into dirPath, which is
+// created if it does not already exist.
+func untarDirectory(dirPath string, r io.Reader) error {
+	if err := os.MkdirAll(dirPath, 0o755); err != nil {
+		return err
+	}
+
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		target := filepath.Join(dirPath, filepath.FromSlash(header.Name))
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, os.FileMode(header.Mode)); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+				return err
+			}
+			f, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, os.FileMode(header.Mode))
+			if err != nil {
+				return err
+			}
+			if _, err := io.Copy(f, tr); err != nil {
+				f.Close()
+				return err
+			}
+			if err := f.Close(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package git
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testKeyHex = "000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"
+
+func TestNewWorkingCopyEncryptor_InvalidHex(t *testing.T) {
+	if _, err := NewWorkingCopyEncryptor("not-hex"); err == nil {
+		t.Fatal("expected error for non-hex key")
+	}
+}
+
+func TestNewWorkingCopyEncryptor_WrongLength(t *testing.T) {
+	if _, err := NewWorkingCopyEncryptor("aabb"); err == nil {
+		t.Fatal("expected error for key shorter than 32 bytes")
+	}
+}
+
+func TestWorkingCopyEncryptor_SealAndOpen_RoundTrips(t *testing.T) {
+	encryptor, err := NewWorkingCopyEncryptor(testKeyHex)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	dir := filepath.Join(t.TempDir(), "repo")
+	if err := os.MkdirAll(filepath.Join(dir, "nested"), 0o755); err != nil {
+		t.Fatalf("setup: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "README.md"), []byte("hello"), 0o644); err != nil {
+		t.Fatalf("setup: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "nested", "file.go"), []byte("package nested"), 0o644); err != nil {
+		t.Fatalf("setup: %v", err)
+	}
+
+	sealedPath, err := encryptor.Seal(dir)
+	if err != nil {
+		t.Fatalf("seal: %v", err)
+	}
+	if sealedPath != SealedPath(dir) {
+		t.Fatalf("expected sealed path %q, got %q", SealedPath(dir), sealedPath)
+	}
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Fatal("expected plaintext directory to be removed after sealing")
+	}
+	if !IsSealed(dir) {
+		t.Fatal("expected IsSealed to report true after sealing")
+	}
+
+	if err := encryptor.Open(dir); err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	if IsSealed(dir) {
+		t.Fatal("expected IsSealed to report false after opening")
+	}
+
+	got, err := os.ReadFile(filepath.Join(dir, "README.md"))
+	if err != nil {
+		t.Fatalf("read restored file: %v", err)
+	}
+	if string(got) != "hello" {
+		t.Fatalf("expected %q, got %q", "hello", got)
+	}
+
+	got, err = os.ReadFile(filepath.Join(dir, "nested", "file.go"))
+	if err != nil {
+		t.Fatalf("read restored nested file: %v", err)
+	}
+	if string(got) != "package nested" {
+		t.Fatalf("expected %q, got %q", "package nested", got)
+	}
+}
+
+func TestWorkingCopyEncryptor_Open_WrongKeyFails(t *testing.T) {
+	encryptor, err := NewWorkingCopyEncryptor(testKeyHex)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "file.txt"), []byte("secret"), 0o644); err != nil {
+		t.Fatalf("setup: %v", err)
+	}
+
+	if _, err := encryptor.Seal(dir); err != nil {
+		t.Fatalf("seal: %v", err)
+	}
+
+	wrongKeyHex := "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff"
+	wrongEncryptor, err := NewWorkingCopyEncryptor(wrongKeyHex)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := wrongEncryptor.Open(dir); err == nil {
+		t.Fatal("expected error opening sealed archive with the wrong key")
+	}
+}
+
+func TestIsSealed(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "repo")
+
+	if IsSealed(dir) {
+		t.Fatal("expected false when neither plaintext nor sealed copy exists")
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatalf("setup: %v", err)
+	}
+	if IsSealed(dir) {
+		t.Fatal("expected false when plaintext directory exists")
+	}
+}
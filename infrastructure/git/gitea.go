@@ -520,9 +520,35 @@ func (g *GiteaAdapter) AllTags(ctx context.Context, localPath string) ([]TagInfo
 	return tags, nil
 }
 
-// CommitDiff returns the diff for a specific commit.
+// CommitDiff returns the diff for a specific commit, optionally scoped to a
+// single file path. For non-git local directories there is no diff to return.
+func (g *GiteaAdapter) CommitDiff(ctx context.Context, localPath string, commitSHA string, filePath string) (string, error) {
+	if !isGitRepo(localPath) {
+		return "", nil
+	}
+
+	repo, err := giteagit.OpenRepository(ctx, localPath)
+	if err != nil {
+		return "", fmt.Errorf("open repository: %w", err)
+	}
+	defer func() { _ = repo.Close() }()
+
+	var buf bytes.Buffer
+	if filePath == "" {
+		err = giteagit.GetRawDiff(repo, commitSHA, giteagit.RawDiffNormal, &buf)
+	} else {
+		err = giteagit.GetRepoRawDiffForFile(repo, commitSHA+"~1", commitSHA, giteagit.RawDiffNormal, filePath, &buf)
+	}
+	if err != nil {
+		return "", fmt.Errorf("get diff: %w", err)
+	}
+
+	return buf.String(), nil
+}
+
+// DiffRefs returns the diff between baseRef and headRef in the local clone.
 // For non-git local directories there is no diff to return.
-func (g *GiteaAdapter) CommitDiff(ctx context.Context, localPath string, commitSHA string) (string, error) {
+func (g *GiteaAdapter) DiffRefs(ctx context.Context, localPath string, baseRef string, headRef string) (string, error) {
 	if !isGitRepo(localPath) {
 		return "", nil
 	}
@@ -534,7 +560,7 @@ func (g *GiteaAdapter) CommitDiff(ctx context.Context, localPath string, commitS
 	defer func() { _ = repo.Close() }()
 
 	var buf bytes.Buffer
-	err = giteagit.GetRawDiff(repo, commitSHA, giteagit.RawDiffNormal, &buf)
+	err = giteagit.GetRepoRawDiffForFile(repo, baseRef, headRef, giteagit.RawDiffNormal, "", &buf)
 	if err != nil {
 		return "", fmt.Errorf("get diff: %w", err)
 	}
@@ -685,5 +711,136 @@ func (g *GiteaAdapter) Grep(ctx context.Context, localPath string, commitSHA str
 	return matches, nil
 }
 
+// UncommittedFiles returns every file with uncommitted working tree changes
+// (modified, added, renamed, or untracked), excluding deletions.
+func (g *GiteaAdapter) UncommittedFiles(ctx context.Context, localPath string) ([]UncommittedFile, error) {
+	if !isGitRepo(localPath) {
+		return nil, nil
+	}
+
+	stdout, _, err := gitcmd.NewCommand("status", "--porcelain=v1", "-z", "--untracked-files=normal").
+		RunStdString(ctx, &gitcmd.RunOpts{Dir: localPath})
+	if err != nil {
+		return nil, fmt.Errorf("git status: %w", err)
+	}
+
+	return parseUncommittedStatus(stdout), nil
+}
+
+// parseUncommittedStatus parses NUL-separated `git status --porcelain=v1 -z`
+// output into UncommittedFile entries, skipping deleted files since there is
+// no longer any content on disk to index for them.
+func parseUncommittedStatus(stdout string) []UncommittedFile {
+	var files []UncommittedFile
+	entries := strings.Split(strings.TrimRight(stdout, "\x00"), "\x00")
+	for i := 0; i < len(entries); i++ {
+		entry := entries[i]
+		if len(entry) < 4 {
+			continue
+		}
+
+		status := entry[:2]
+		path := entry[3:]
+
+		// A rename/copy entry is followed by its original path as a
+		// separate NUL-terminated field; skip it without treating it as
+		// its own file.
+		if strings.ContainsAny(status, "RC") {
+			i++
+		}
+
+		if strings.Contains(status, "D") {
+			continue
+		}
+
+		files = append(files, UncommittedFile{Path: path})
+	}
+	return files
+}
+
+// RemoteRefs lists the current branch and tag head SHAs on the remote.
+func (g *GiteaAdapter) RemoteRefs(ctx context.Context, remoteURI string) (map[string]string, error) {
+	cmd := exec.CommandContext(ctx, "git", "ls-remote", "--heads", "--tags", remoteURI)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("git ls-remote: %w: %s", err, stderr.String())
+	}
+
+	return parseLsRemote(stdout.String()), nil
+}
+
+// parseLsRemote parses `git ls-remote --heads --tags` output into a map of
+// short ref name (e.g. "main", "v1.0.0") to commit SHA, stripping the
+// "refs/heads/"/"refs/tags/" prefix and ignoring dereferenced tag entries
+// (suffixed "^{}").
+func parseLsRemote(output string) map[string]string {
+	refs := make(map[string]string)
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		if line == "" {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		sha, ref := fields[0], fields[1]
+		if strings.HasSuffix(ref, "^{}") {
+			continue
+		}
+		switch {
+		case strings.HasPrefix(ref, "refs/heads/"):
+			refs[strings.TrimPrefix(ref, "refs/heads/")] = sha
+		case strings.HasPrefix(ref, "refs/tags/"):
+			refs[strings.TrimPrefix(ref, "refs/tags/")] = sha
+		}
+	}
+	return refs
+}
+
+// IsAncestor reports whether ancestorSHA is an ancestor of (or equal to)
+// descendantSHA in the local clone's history.
+func (g *GiteaAdapter) IsAncestor(ctx context.Context, localPath string, ancestorSHA string, descendantSHA string) (bool, error) {
+	cmd := exec.CommandContext(ctx, "git", "merge-base", "--is-ancestor", ancestorSHA, descendantSHA)
+	cmd.Dir = localPath
+	err := cmd.Run()
+	if err == nil {
+		return true, nil
+	}
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 {
+		// Exit code 1 means the commits exist but ancestorSHA is not an
+		// ancestor of descendantSHA; any other failure is a real error
+		// (e.g. one of the SHAs is unknown to this clone).
+		return false, nil
+	}
+	return false, fmt.Errorf("git merge-base --is-ancestor: %w", err)
+}
+
+// FetchRef fetches a single ref from origin into the local clone without
+// moving any tracked branch, and returns the commit SHA it resolved to.
+func (g *GiteaAdapter) FetchRef(ctx context.Context, localPath string, ref string) (string, error) {
+	_, _, fetchErr := gitcmd.NewCommand("fetch", "--force", "origin").
+		AddDynamicArguments(ref).
+		RunStdString(ctx, &gitcmd.RunOpts{Dir: localPath})
+	if fetchErr != nil {
+		return "", fmt.Errorf("fetch ref %s: %w", ref, fetchErr)
+	}
+
+	repo, err := giteagit.OpenRepository(ctx, localPath)
+	if err != nil {
+		return "", fmt.Errorf("open repository: %w", err)
+	}
+	defer func() { _ = repo.Close() }()
+
+	sha, err := repo.GetRefCommitID("FETCH_HEAD")
+	if err != nil {
+		return "", fmt.Errorf("resolve FETCH_HEAD for %s: %w", ref, err)
+	}
+	return sha, nil
+}
+
 // Ensure GiteaAdapter implements Adapter.
 var _ Adapter = (*GiteaAdapter)(nil)
@@ -0,0 +1,139 @@
+package git
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// koditIgnoreFile is the name of the repository-owner-controlled stop-file,
+// checked at the repository root.
+const koditIgnoreFile = ".koditignore"
+
+// koditIgnoreRule is a single non-blank, non-comment line from a
+// .koditignore file, parsed using gitignore pattern syntax.
+type koditIgnoreRule struct {
+	pattern  string
+	negate   bool
+	anchored bool
+}
+
+// KoditIgnore matches paths against the gitignore-syntax rules declared in a
+// repository's .koditignore file, letting repository owners exclude paths
+// from scanning and snippet extraction without any kodit-side configuration.
+type KoditIgnore struct {
+	rules []koditIgnoreRule
+}
+
+// LoadKoditIgnore reads the .koditignore file at the repository root, if
+// present. A missing file yields a zero-value KoditIgnore that matches
+// nothing.
+func LoadKoditIgnore(repoRoot string) (KoditIgnore, error) {
+	file, err := os.Open(filepath.Join(repoRoot, koditIgnoreFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return KoditIgnore{}, nil
+		}
+		return KoditIgnore{}, err
+	}
+	defer func() { _ = file.Close() }()
+
+	var rules []koditIgnoreRule
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		rule := koditIgnoreRule{pattern: line}
+		if strings.HasPrefix(rule.pattern, "!") {
+			rule.negate = true
+			rule.pattern = rule.pattern[1:]
+		}
+		if strings.HasPrefix(rule.pattern, "/") {
+			rule.anchored = true
+			rule.pattern = rule.pattern[1:]
+		}
+		rule.pattern = strings.TrimSuffix(rule.pattern, "/")
+		if rule.pattern == "" {
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	if err := scanner.Err(); err != nil {
+		return KoditIgnore{}, err
+	}
+
+	return KoditIgnore{rules: rules}, nil
+}
+
+// Match reports whether relPath (repository-root-relative) is excluded by
+// the .koditignore rules. Later rules take precedence over earlier ones,
+// mirroring gitignore's last-match-wins semantics, so a later "!pattern"
+// can re-include a path an earlier rule excluded.
+func (k KoditIgnore) Match(relPath string) bool {
+	relPath = filepath.ToSlash(relPath)
+
+	ignored := false
+	for _, rule := range k.rules {
+		if rule.matches(relPath) {
+			ignored = !rule.negate
+		}
+	}
+	return ignored
+}
+
+// Patterns returns the effective exclusion patterns, in file order, for
+// surfacing alongside a repository's details.
+func (k KoditIgnore) Patterns() []string {
+	patterns := make([]string, 0, len(k.rules))
+	for _, rule := range k.rules {
+		p := rule.pattern
+		if rule.anchored {
+			p = "/" + p
+		}
+		if rule.negate {
+			p = "!" + p
+		}
+		patterns = append(patterns, p)
+	}
+	return patterns
+}
+
+func (r koditIgnoreRule) matches(relPath string) bool {
+	if r.anchored {
+		return matchesPathOrDir(r.pattern, relPath)
+	}
+
+	if matchesPathOrDir(r.pattern, relPath) {
+		return true
+	}
+
+	// An unanchored pattern without a slash matches at any depth, so try
+	// each path component on its own (gitignore semantics for e.g. "*.log").
+	if !strings.Contains(r.pattern, "/") {
+		for _, part := range strings.Split(relPath, "/") {
+			if matched, _ := filepath.Match(r.pattern, part); matched {
+				return true
+			}
+		}
+		return false
+	}
+
+	// An unanchored pattern containing a slash can still match starting
+	// anywhere in the tree, not just at the root.
+	matched, _ := filepath.Match("*/"+r.pattern, relPath)
+	return matched
+}
+
+// matchesPathOrDir reports whether relPath matches pattern exactly, or lies
+// beneath a directory matching pattern — mirroring gitignore semantics
+// where a directory match excludes everything underneath it.
+func matchesPathOrDir(pattern, relPath string) bool {
+	if matched, _ := filepath.Match(pattern, relPath); matched {
+		return true
+	}
+	return strings.HasPrefix(relPath, pattern+"/")
+}
@@ -0,0 +1,64 @@
+package git
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadKoditIgnore_Missing(t *testing.T) {
+	dir := t.TempDir()
+
+	ignore, err := LoadKoditIgnore(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ignore.Match("main.go") {
+		t.Error("expected no patterns to match anything")
+	}
+	if len(ignore.Patterns()) != 0 {
+		t.Error("expected no patterns")
+	}
+}
+
+func TestKoditIgnore_Match(t *testing.T) {
+	dir := t.TempDir()
+	content := "# comment\n\n*.log\n/vendor\nbuild/output.bin\n!build/output.bin\n"
+	if err := os.WriteFile(filepath.Join(dir, ".koditignore"), []byte(content), 0o644); err != nil {
+		t.Fatalf("write .koditignore: %v", err)
+	}
+
+	ignore, err := LoadKoditIgnore(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	cases := []struct {
+		path string
+		want bool
+	}{
+		{"debug.log", true},
+		{"nested/debug.log", true},
+		{"vendor/pkg/file.go", true},
+		{"src/vendor/file.go", false}, // anchored pattern only matches at root
+		{"build/output.bin", false},   // re-included by the negation rule
+		{"main.go", false},
+	}
+
+	for _, c := range cases {
+		if got := ignore.Match(c.path); got != c.want {
+			t.Errorf("Match(%q) = %v, want %v", c.path, got, c.want)
+		}
+	}
+
+	patterns := ignore.Patterns()
+	want := []string{"*.log", "/vendor", "build/output.bin", "!build/output.bin"}
+	if len(patterns) != len(want) {
+		t.Fatalf("Patterns() = %v, want %v", patterns, want)
+	}
+	for i := range want {
+		if patterns[i] != want[i] {
+			t.Errorf("Patterns()[%d] = %q, want %q", i, patterns[i], want[i])
+		}
+	}
+}
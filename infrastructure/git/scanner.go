@@ -1,6 +1,7 @@
 package git
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
@@ -9,6 +10,7 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/rs/zerolog"
@@ -38,6 +40,11 @@ func NewRepositoryScanner(adapter Adapter, logger zerolog.Logger) *RepositorySca
 func (s *RepositoryScanner) ScanCommit(ctx context.Context, clonedPath string, commitSHA string, repoID int64) (service.ScanCommitResult, error) {
 	s.logger.Info().Str("sha", shortSHA(commitSHA)).Str("path", clonedPath).Msg("scanning commit")
 
+	koditIgnore, err := LoadKoditIgnore(clonedPath)
+	if err != nil {
+		return service.ScanCommitResult{}, fmt.Errorf("load .koditignore: %w", err)
+	}
+
 	if !isGitRepo(clonedPath) {
 		now := time.Now()
 		author := repository.NewAuthor("kodit", "kodit@local")
@@ -46,6 +53,7 @@ func (s *RepositoryScanner) ScanCommit(ctx context.Context, clonedPath string, c
 		if err != nil {
 			return service.ScanCommitResult{}, fmt.Errorf("list directory files: %w", err)
 		}
+		files = filterKoditIgnored(files, koditIgnore)
 		s.logger.Info().Str("sha", shortSHA(commitSHA)).Int("files", len(files)).Msg("scanned local directory")
 		return service.NewScanCommitResult(commit, files), nil
 	}
@@ -62,13 +70,25 @@ func (s *RepositoryScanner) ScanCommit(ctx context.Context, clonedPath string, c
 		return service.ScanCommitResult{}, fmt.Errorf("get commit files: %w", err)
 	}
 
-	files := s.filesFromInfo(filesInfo, commitSHA)
+	files := filterKoditIgnored(s.filesFromInfo(ctx, clonedPath, filesInfo, commitSHA), koditIgnore)
 
 	s.logger.Info().Str("sha", shortSHA(commitSHA)).Int("files", len(files)).Msg("scanned commit")
 
 	return service.NewScanCommitResult(commit, files), nil
 }
 
+// filterKoditIgnored removes files excluded by the repository's
+// .koditignore rules.
+func filterKoditIgnored(files []repository.File, koditIgnore KoditIgnore) []repository.File {
+	filtered := files[:0]
+	for _, f := range files {
+		if !koditIgnore.Match(f.Path()) {
+			filtered = append(filtered, f)
+		}
+	}
+	return filtered
+}
+
 // ScanBranch scans all commits on a branch.
 func (s *RepositoryScanner) ScanBranch(ctx context.Context, clonedPath string, branchName string, repoID int64) ([]repository.Commit, error) {
 	s.logger.Info().Str("branch", branchName).Str("path", clonedPath).Msg("scanning branch")
@@ -143,13 +163,18 @@ func (s *RepositoryScanner) ScanAllTags(ctx context.Context, clonedPath string,
 func (s *RepositoryScanner) FilesForCommitsBatch(ctx context.Context, clonedPath string, commitSHAs []string) ([]repository.File, error) {
 	s.logger.Info().Str("path", clonedPath).Int("commits", len(commitSHAs)).Msg("processing files for commit batch")
 
+	koditIgnore, err := LoadKoditIgnore(clonedPath)
+	if err != nil {
+		return nil, fmt.Errorf("load .koditignore: %w", err)
+	}
+
 	var files []repository.File
 	for _, sha := range commitSHAs {
 		filesInfo, err := s.adapter.CommitFiles(ctx, clonedPath, sha)
 		if err != nil {
 			return nil, fmt.Errorf("get commit files for %s: %w", shortSHA(sha), err)
 		}
-		files = append(files, s.filesFromInfo(filesInfo, sha)...)
+		files = append(files, filterKoditIgnored(s.filesFromInfo(ctx, clonedPath, filesInfo, sha), koditIgnore)...)
 	}
 
 	s.logger.Info().Int("commits", len(commitSHAs)).Int("files", len(files)).Msg("processed files for commit batch")
@@ -184,12 +209,12 @@ func (s *RepositoryScanner) tagFromInfo(info TagInfo, repoID int64) repository.T
 	return repository.NewTag(repoID, info.Name, info.TargetCommitSHA)
 }
 
-func (s *RepositoryScanner) filesFromInfo(infos []FileInfo, commitSHA string) []repository.File {
+func (s *RepositoryScanner) filesFromInfo(ctx context.Context, clonedPath string, infos []FileInfo, commitSHA string) []repository.File {
 	now := time.Now()
 	files := make([]repository.File, 0, len(infos))
 
 	for _, info := range infos {
-		language := languageFromPath(info.Path)
+		language := s.languageFor(ctx, clonedPath, commitSHA, info.Path)
 		extension := extensionFromPath(info.Path)
 		mimeType := mimeTypeFromExtension(extension)
 
@@ -210,6 +235,22 @@ func (s *RepositoryScanner) filesFromInfo(infos []FileInfo, commitSHA string) []
 	return files
 }
 
+// languageFor resolves a language for path using cheap, content-free signals
+// first (extension, then well-known tooling filenames). Only when neither
+// matches does it fetch the file's content to check for a shebang line,
+// keeping the common case free of an extra blob read.
+func (s *RepositoryScanner) languageFor(ctx context.Context, clonedPath, commitSHA, path string) string {
+	if lang := languageFromPathAndFilename(path); lang != "" {
+		return lang
+	}
+
+	content, err := s.adapter.FileContent(ctx, clonedPath, commitSHA, path)
+	if err != nil {
+		return ""
+	}
+	return languageFromShebang(content)
+}
+
 // dirHash computes a stable SHA-256 hash over the contents of a directory.
 // Files are processed in sorted order so the hash is deterministic.
 // Returns the first 40 hex characters (matching the length of a git SHA1).
@@ -283,7 +324,7 @@ func (s *RepositoryScanner) filesFromDir(dirPath, commitSHA string) ([]repositor
 		blobSum := sha256.Sum256(content)
 		blobSHA := hex.EncodeToString(blobSum[:])
 		ext := extensionFromPath(rel)
-		lang := languageFromPath(rel)
+		lang := detectLanguage(rel, content)
 		mime := mimeTypeFromExtension(ext)
 
 		files = append(files, repository.ReconstructFile(0, commitSHA, rel, blobSHA, mime, ext, lang, info.Size(), now))
@@ -370,6 +411,84 @@ func languageFromPath(path string) string {
 	}
 }
 
+// languageFromPathAndFilename resolves a language using signals that require
+// no file content: the extension, then well-known extension-less tooling
+// filenames (Dockerfile, Makefile, ...). Returns "" if neither matches, in
+// which case the caller may fall back to shebang sniffing via
+// languageFromShebang.
+func languageFromPathAndFilename(path string) string {
+	if lang := languageFromPath(path); lang != "" {
+		return lang
+	}
+	return filenameLanguages[filepath.Base(path)]
+}
+
+// detectLanguage identifies path's language from its extension, well-known
+// filename, or (for extension-less scripts) a "#!" shebang line in content.
+// Without this fallback, extension-less files like Dockerfiles, Makefiles,
+// and shebang scripts get an empty language and are excluded from
+// language-filtered search.
+func detectLanguage(path string, content []byte) string {
+	if lang := languageFromPathAndFilename(path); lang != "" {
+		return lang
+	}
+	return languageFromShebang(content)
+}
+
+// filenameLanguages maps well-known extension-less filenames to a language,
+// for tooling files that carry no extension.
+var filenameLanguages = map[string]string{
+	"Dockerfile":     "dockerfile",
+	"Makefile":       "makefile",
+	"GNUmakefile":    "makefile",
+	"Rakefile":       "ruby",
+	"Gemfile":        "ruby",
+	"Vagrantfile":    "ruby",
+	"Jenkinsfile":    "groovy",
+	"CMakeLists.txt": "cmake",
+}
+
+// shebangLanguages maps the interpreter named in a "#!" line to a language.
+var shebangLanguages = map[string]string{
+	"bash":    "shell",
+	"sh":      "shell",
+	"zsh":     "shell",
+	"python":  "python",
+	"python3": "python",
+	"python2": "python",
+	"node":    "javascript",
+	"ruby":    "ruby",
+	"perl":    "perl",
+}
+
+// languageFromShebang inspects the first line of content for a "#!"
+// interpreter directive (e.g. "#!/usr/bin/env python3") and returns the
+// language it names, or "" if content has no recognized shebang.
+func languageFromShebang(content []byte) string {
+	line := content
+	if idx := bytes.IndexByte(content, '\n'); idx >= 0 {
+		line = content[:idx]
+	}
+	line = bytes.TrimRight(line, "\r")
+	if !bytes.HasPrefix(line, []byte("#!")) {
+		return ""
+	}
+
+	fields := strings.Fields(string(line[2:]))
+	if len(fields) == 0 {
+		return ""
+	}
+
+	// "#!/usr/bin/env python3" names the interpreter as env's argument
+	// rather than the shebang path itself.
+	interpreter := fields[0]
+	if filepath.Base(interpreter) == "env" && len(fields) > 1 {
+		interpreter = fields[1]
+	}
+
+	return shebangLanguages[filepath.Base(interpreter)]
+}
+
 func extensionFromPath(path string) string {
 	ext := filepath.Ext(path)
 	if ext == "" {
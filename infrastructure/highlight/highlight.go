@@ -0,0 +1,81 @@
+// Package highlight renders source code to syntax-highlighted HTML
+// fragments, so search consumers can display code nicely without shipping
+// their own highlighter.
+package highlight
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	"github.com/alecthomas/chroma/v2"
+	"github.com/alecthomas/chroma/v2/formatters/html"
+	"github.com/alecthomas/chroma/v2/lexers"
+	"github.com/alecthomas/chroma/v2/styles"
+)
+
+// Highlighter renders content to HTML using inline styles, so the result is
+// a self-contained fragment that can be embedded directly into a page
+// without a separate stylesheet. Results are cached per (language, content)
+// pair since snippet content is immutable once indexed.
+type Highlighter struct {
+	formatter *html.Formatter
+	style     *chroma.Style
+	cache     sync.Map // string -> string
+}
+
+// New creates a Highlighter using the given chroma style name (e.g.
+// "github", "monokai"). An unrecognised or empty name falls back to
+// chroma's default style.
+func New(styleName string) *Highlighter {
+	style := styles.Get(styleName)
+	if style == nil {
+		style = styles.Fallback
+	}
+	return &Highlighter{
+		formatter: html.New(html.WithClasses(false), html.TabWidth(4)),
+		style:     style,
+	}
+}
+
+// Highlight returns a syntax-highlighted HTML fragment for content.
+// language may be a chroma lexer name or alias, or a file extension such as
+// ".go"; an unrecognised language falls back to analysing the content.
+func (h *Highlighter) Highlight(content, language string) (string, error) {
+	key := cacheKey(content, language)
+	if cached, ok := h.cache.Load(key); ok {
+		return cached.(string), nil
+	}
+
+	lexer := lexers.Get(language)
+	if lexer == nil {
+		lexer = lexers.Analyse(content)
+	}
+	if lexer == nil {
+		lexer = lexers.Fallback
+	}
+	lexer = chroma.Coalesce(lexer)
+
+	iterator, err := lexer.Tokenise(nil, content)
+	if err != nil {
+		return "", fmt.Errorf("tokenise content: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := h.formatter.Format(&buf, h.style, iterator); err != nil {
+		return "", fmt.Errorf("format highlighted html: %w", err)
+	}
+
+	result := buf.String()
+	h.cache.Store(key, result)
+	return result, nil
+}
+
+// cacheKey derives a cache key from language and a content hash, since
+// snippet source is too large to use directly as a map key.
+func cacheKey(content, language string) string {
+	sum := sha256.Sum256([]byte(content))
+	return language + ":" + hex.EncodeToString(sum[:])
+}
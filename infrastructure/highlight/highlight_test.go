@@ -0,0 +1,45 @@
+package highlight
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHighlighter_Highlight(t *testing.T) {
+	h := New("github")
+
+	html, err := h.Highlight("package main\n\nfunc main() {}\n", ".go")
+	require.NoError(t, err)
+	assert.Contains(t, html, "<span")
+}
+
+func TestHighlighter_CachesResult(t *testing.T) {
+	h := New("github")
+
+	first, err := h.Highlight("func main() {}", "go")
+	require.NoError(t, err)
+
+	second, err := h.Highlight("func main() {}", "go")
+	require.NoError(t, err)
+
+	assert.Equal(t, first, second)
+}
+
+func TestHighlighter_UnknownLanguageFallsBack(t *testing.T) {
+	h := New("github")
+
+	html, err := h.Highlight("some plain text with no obvious language", "not-a-real-language")
+	require.NoError(t, err)
+	assert.NotEmpty(t, html)
+}
+
+func TestHighlighter_UnknownStyleFallsBack(t *testing.T) {
+	h := New("not-a-real-style")
+
+	html, err := h.Highlight("func main() {}", "go")
+	require.NoError(t, err)
+	assert.True(t, strings.Contains(html, "<span"))
+}
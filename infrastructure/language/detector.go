@@ -0,0 +1,71 @@
+// Package language detects the dominant human language of prose text.
+package language
+
+import (
+	"regexp"
+	"strings"
+
+	domainservice "github.com/helixml/kodit/domain/service"
+)
+
+var wordPattern = regexp.MustCompile(`[a-zA-ZÀ-ÿ]+`)
+
+// stopWords lists a handful of very common, distinctive words per language.
+// Matching against stop words rather than a full dictionary keeps detection
+// fast and dependency-free; it is accurate enough to pick an output language
+// for AI-generated enrichments, not meant for general-purpose NLP.
+var stopWords = map[string]map[string]struct{}{
+	"English":    set("the", "and", "is", "are", "this", "that", "with", "for", "of", "to"),
+	"Spanish":    set("el", "la", "los", "las", "de", "que", "para", "con", "es", "una"),
+	"French":     set("le", "la", "les", "des", "est", "que", "pour", "avec", "une", "dans"),
+	"German":     set("der", "die", "das", "und", "ist", "nicht", "mit", "für", "eine", "den"),
+	"Portuguese": set("o", "a", "os", "as", "de", "que", "para", "com", "uma", "não"),
+}
+
+// languageOrder fixes the tie-breaking order when two languages score
+// equally, so Detect is deterministic.
+var languageOrder = []string{"English", "Spanish", "French", "German", "Portuguese"}
+
+func set(words ...string) map[string]struct{} {
+	m := make(map[string]struct{}, len(words))
+	for _, w := range words {
+		m[w] = struct{}{}
+	}
+	return m
+}
+
+// WordFrequencyDetector identifies the dominant human language of a text by
+// counting matches against a per-language stop-word list and picking the
+// highest scorer. It implements domainservice.LanguageDetector.
+type WordFrequencyDetector struct{}
+
+// NewWordFrequencyDetector creates a new WordFrequencyDetector.
+func NewWordFrequencyDetector() *WordFrequencyDetector {
+	return &WordFrequencyDetector{}
+}
+
+// Detect returns the language whose stop words appear most often in text, or
+// "" if no word in text matches any supported language's stop words.
+func (d *WordFrequencyDetector) Detect(text string) string {
+	scores := make(map[string]int, len(stopWords))
+	for _, word := range wordPattern.FindAllString(text, -1) {
+		lower := strings.ToLower(word)
+		for lang, words := range stopWords {
+			if _, ok := words[lower]; ok {
+				scores[lang]++
+			}
+		}
+	}
+
+	var best string
+	var bestScore int
+	for _, lang := range languageOrder {
+		if score := scores[lang]; score > bestScore {
+			best, bestScore = lang, score
+		}
+	}
+	return best
+}
+
+// Ensure WordFrequencyDetector implements domainservice.LanguageDetector.
+var _ domainservice.LanguageDetector = (*WordFrequencyDetector)(nil)
@@ -0,0 +1,42 @@
+package language
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	domainservice "github.com/helixml/kodit/domain/service"
+)
+
+func TestWordFrequencyDetector_Detect(t *testing.T) {
+	d := NewWordFrequencyDetector()
+
+	t.Run("English text", func(t *testing.T) {
+		assert.Equal(t, "English", d.Detect("This function reverses a string and returns the result to the caller."))
+	})
+
+	t.Run("Spanish text", func(t *testing.T) {
+		assert.Equal(t, "Spanish", d.Detect("Esta función invierte una cadena y devuelve el resultado para el usuario."))
+	})
+
+	t.Run("French text", func(t *testing.T) {
+		assert.Equal(t, "French", d.Detect("Cette fonction inverse une chaîne et retourne le résultat pour le client."))
+	})
+
+	t.Run("German text", func(t *testing.T) {
+		assert.Equal(t, "German", d.Detect("Diese Funktion ist nicht mit der Datenbank für den Import verbunden."))
+	})
+
+	t.Run("no matching stop words returns empty", func(t *testing.T) {
+		assert.Equal(t, "", d.Detect("xyzzy plugh qux quux"))
+	})
+
+	t.Run("empty text returns empty", func(t *testing.T) {
+		assert.Equal(t, "", d.Detect(""))
+	})
+}
+
+func TestWordFrequencyDetector_ImplementsLanguageDetector(t *testing.T) {
+	var d domainservice.LanguageDetector = NewWordFrequencyDetector()
+	assert.NotEmpty(t, d.Detect("The quick brown fox jumps over the lazy dog."))
+}
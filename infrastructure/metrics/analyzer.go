@@ -0,0 +1,77 @@
+// Package metrics computes readability and complexity signals for code snippets.
+package metrics
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/helixml/kodit/domain/enrichment"
+	domainservice "github.com/helixml/kodit/domain/service"
+)
+
+// branchPattern matches keywords that introduce a new branch in most
+// mainstream languages (C-family, Python, Ruby, Go, etc). Counting keyword
+// occurrences rather than parsing an AST keeps analysis fast and
+// language-agnostic; it approximates cyclomatic complexity well enough to
+// rank snippets by relative complexity, not to certify a precise metric.
+var branchPattern = regexp.MustCompile(`\b(if|elif|for|while|foreach|case|catch|except)\b|&&|\|\|`)
+
+// HeuristicAnalyzer computes SnippetMetrics by counting branch keywords,
+// tracking brace/indent nesting, and counting lines. It implements
+// domainservice.ComplexityAnalyzer.
+type HeuristicAnalyzer struct{}
+
+// NewHeuristicAnalyzer creates a new HeuristicAnalyzer.
+func NewHeuristicAnalyzer() *HeuristicAnalyzer {
+	return &HeuristicAnalyzer{}
+}
+
+// Analyze returns SnippetMetrics for content, or a zero SnippetMetrics if
+// content is empty.
+func (a *HeuristicAnalyzer) Analyze(content string) enrichment.SnippetMetrics {
+	if strings.TrimSpace(content) == "" {
+		return enrichment.SnippetMetrics{}
+	}
+
+	lines := strings.Split(content, "\n")
+	complexity := 1 + len(branchPattern.FindAllString(content, -1))
+	depth := deepestNesting(lines)
+
+	return enrichment.NewSnippetMetrics(complexity, depth, len(lines))
+}
+
+// deepestNesting tracks the deepest block nesting level across lines by
+// counting opening braces against closing ones. Languages that nest with
+// indentation alone (e.g. Python) are approximated by counting the deepest
+// leading-whitespace level in units of 4 spaces (or one tab), so the metric
+// stays meaningful across languages without per-language parsing.
+func deepestNesting(lines []string) int {
+	var braceDepth, deepestBrace int
+	var deepestIndent int
+	for _, line := range lines {
+		braceDepth += strings.Count(line, "{") - strings.Count(line, "}")
+		if braceDepth > deepestBrace {
+			deepestBrace = braceDepth
+		}
+
+		trimmed := strings.TrimLeft(line, " \t")
+		if trimmed == "" {
+			continue
+		}
+		indentChars := len(line) - len(trimmed)
+		tabs := strings.Count(line[:indentChars], "\t")
+		spaces := indentChars - tabs
+		indentLevel := tabs + spaces/4
+		if indentLevel > deepestIndent {
+			deepestIndent = indentLevel
+		}
+	}
+
+	if deepestBrace > deepestIndent {
+		return deepestBrace
+	}
+	return deepestIndent
+}
+
+// Ensure HeuristicAnalyzer implements domainservice.ComplexityAnalyzer.
+var _ domainservice.ComplexityAnalyzer = (*HeuristicAnalyzer)(nil)
@@ -0,0 +1,43 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	domainservice "github.com/helixml/kodit/domain/service"
+)
+
+func TestHeuristicAnalyzer_Analyze(t *testing.T) {
+	a := NewHeuristicAnalyzer()
+
+	t.Run("empty content returns zero metrics", func(t *testing.T) {
+		assert.True(t, a.Analyze("").IsZero())
+		assert.True(t, a.Analyze("   \n\t").IsZero())
+	})
+
+	t.Run("straight-line code has complexity one", func(t *testing.T) {
+		m := a.Analyze("x := 1\ny := 2\nreturn x + y")
+		assert.Equal(t, 1, m.CyclomaticComplexity())
+		assert.Equal(t, 3, m.LineCount())
+	})
+
+	t.Run("branches increase complexity", func(t *testing.T) {
+		content := "if x > 0 {\n\tif y > 0 {\n\t\treturn true\n\t}\n} else if z > 0 {\n\treturn false\n}"
+		m := a.Analyze(content)
+		assert.Equal(t, 4, m.CyclomaticComplexity())
+		assert.Equal(t, 2, m.NestingDepth())
+	})
+
+	t.Run("python-style indentation is measured without braces", func(t *testing.T) {
+		content := "def f():\n    if x:\n        return 1\n    return 0"
+		m := a.Analyze(content)
+		assert.Equal(t, 2, m.CyclomaticComplexity())
+		assert.Equal(t, 2, m.NestingDepth())
+	})
+}
+
+func TestHeuristicAnalyzer_ImplementsComplexityAnalyzer(t *testing.T) {
+	var a domainservice.ComplexityAnalyzer = NewHeuristicAnalyzer()
+	assert.False(t, a.Analyze("if x { return 1 }").IsZero())
+}
@@ -0,0 +1,251 @@
+// Package migration imports data from legacy Python-era kodit databases.
+package migration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/helixml/kodit/infrastructure/persistence"
+	"github.com/helixml/kodit/internal/database"
+)
+
+// progressLogInterval controls how often Import logs row-level progress.
+const progressLogInterval = 500
+
+// TableReport summarizes the outcome of importing or validating one legacy
+// table.
+type TableReport struct {
+	Table    string
+	Found    int
+	Imported int
+	Skipped  int
+	Warnings []string
+}
+
+// Report summarizes a full python migration run.
+type Report struct {
+	DryRun     bool
+	Embeddings TableReport
+	Indexes    TableReport
+	Snippets   TableReport
+}
+
+// String renders a human-readable summary of the report, suitable for
+// printing directly to a CLI.
+func (r Report) String() string {
+	var b strings.Builder
+	if r.DryRun {
+		b.WriteString("dry run — no changes were written\n\n")
+	}
+	for _, t := range []TableReport{r.Embeddings, r.Snippets, r.Indexes} {
+		fmt.Fprintf(&b, "%s: found %d, imported %d, skipped %d\n", t.Table, t.Found, t.Imported, t.Skipped)
+		for _, w := range t.Warnings {
+			fmt.Fprintf(&b, "  warning: %s\n", w)
+		}
+	}
+	return b.String()
+}
+
+// PythonImporter imports a legacy Python-era kodit database into a
+// Go-schema database. Unlike PreMigrate, which adapts a Python dump in
+// place, PythonImporter copies rows across two distinct connections, so it
+// also works when consolidating several old installations or migrating off
+// a database that predates the in-place conversions PreMigrate knows how
+// to apply.
+type PythonImporter struct {
+	source database.Database
+	target database.Database
+	logger zerolog.Logger
+}
+
+// NewPythonImporter creates a PythonImporter reading from source and
+// writing to target.
+func NewPythonImporter(source, target database.Database, logger zerolog.Logger) *PythonImporter {
+	return &PythonImporter{source: source, target: target, logger: logger}
+}
+
+// Import copies embeddings into the target database, then validates the
+// legacy snippets and indexes tables against it.
+//
+// Snippet content itself is never copied: the Go schema recomputes snippet
+// text from the Git blob at search time instead of storing it, so importing
+// "snippets" and "indexes" means counting what is present upstream and
+// recommending a resync, rather than writing rows — anything that can't be
+// mapped directly is surfaced as a warning instead of silently dropped.
+//
+// When dryRun is true, Import performs no writes; the returned report still
+// reflects exactly what would have been imported.
+func (m *PythonImporter) Import(ctx context.Context, dryRun bool) (Report, error) {
+	report := Report{DryRun: dryRun}
+
+	embeddings, err := m.importEmbeddings(ctx, dryRun)
+	if err != nil {
+		return report, fmt.Errorf("import embeddings: %w", err)
+	}
+	report.Embeddings = embeddings
+
+	snippets, err := m.validateLegacyTable(ctx, []string{"snippets", "snippets_v2"},
+		"kodit regenerates snippet text from the Git blob at query time; run a repository sync after migration to rebuild them")
+	if err != nil {
+		return report, fmt.Errorf("validate snippets: %w", err)
+	}
+	report.Snippets = snippets
+
+	indexes, err := m.validateLegacyTable(ctx, []string{"indexes"},
+		"per-commit index completion state is not migrated; run a repository sync after migration to rebuild it")
+	if err != nil {
+		return report, fmt.Errorf("validate indexes: %w", err)
+	}
+	report.Indexes = indexes
+
+	return report, nil
+}
+
+// legacyEmbeddingRow mirrors a row of the Python kodit "embeddings" table.
+// The embedding column is read as raw JSON rather than []float64 because
+// older dumps may have used a different vector encoding; rows that fail to
+// parse are skipped with a warning instead of failing the whole import.
+type legacyEmbeddingRow struct {
+	SnippetID string          `gorm:"column:snippet_id"`
+	Type      string          `gorm:"column:type"`
+	Embedding json.RawMessage `gorm:"column:embedding"`
+	CreatedAt time.Time       `gorm:"column:created_at"`
+	UpdatedAt time.Time       `gorm:"column:updated_at"`
+}
+
+func (m *PythonImporter) importEmbeddings(ctx context.Context, dryRun bool) (TableReport, error) {
+	report := TableReport{Table: "embeddings"}
+
+	exists, err := tableExists(m.source, "embeddings")
+	if err != nil {
+		return report, err
+	}
+	if !exists {
+		report.Warnings = append(report.Warnings, "source database has no embeddings table")
+		return report, nil
+	}
+
+	var rows []legacyEmbeddingRow
+	if err := m.source.Session(ctx).Table("embeddings").Find(&rows).Error; err != nil {
+		return report, fmt.Errorf("read legacy embeddings: %w", err)
+	}
+	report.Found = len(rows)
+	m.logger.Info().Int("found", report.Found).Bool("dry_run", dryRun).Msg("importing legacy embeddings")
+
+	for i, row := range rows {
+		if row.SnippetID == "" || len(row.Embedding) == 0 {
+			report.Skipped++
+			continue
+		}
+
+		var vector []float64
+		if err := json.Unmarshal(row.Embedding, &vector); err != nil {
+			report.Skipped++
+			report.Warnings = append(report.Warnings, fmt.Sprintf(
+				"skipped embedding for snippet %q: unrecognized embedding encoding: %v", row.SnippetID, err))
+			continue
+		}
+
+		if !dryRun {
+			imported, err := m.writeEmbedding(ctx, row, vector)
+			if err != nil {
+				return report, fmt.Errorf("write embedding for snippet %q: %w", row.SnippetID, err)
+			}
+			if !imported {
+				report.Skipped++
+				continue
+			}
+		}
+		report.Imported++
+
+		if (i+1)%progressLogInterval == 0 {
+			m.logger.Info().Int("processed", i+1).Int("total", report.Found).Msg("importing legacy embeddings")
+		}
+	}
+
+	m.logger.Info().Int("imported", report.Imported).Int("skipped", report.Skipped).Msg("finished importing legacy embeddings")
+	return report, nil
+}
+
+// writeEmbedding inserts row into the target embeddings table, skipping it
+// if a row for the same snippet and task type already exists.
+func (m *PythonImporter) writeEmbedding(ctx context.Context, row legacyEmbeddingRow, vector []float64) (bool, error) {
+	var count int64
+	err := m.target.Session(ctx).Table("embeddings").
+		Where("snippet_id = ? AND type = ?", row.SnippetID, row.Type).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	if count > 0 {
+		return false, nil
+	}
+
+	model := persistence.EmbeddingModel{
+		SnippetID: row.SnippetID,
+		Type:      row.Type,
+		Embedding: vector,
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+	}
+	if err := m.target.Session(ctx).Create(&model).Error; err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// validateLegacyTable counts rows across the given legacy table names (some
+// of which may not exist, e.g. "snippets_v2" on older dumps) and attaches
+// warning to the report for each table found.
+func (m *PythonImporter) validateLegacyTable(ctx context.Context, tables []string, warning string) (TableReport, error) {
+	report := TableReport{Table: tables[0]}
+
+	for _, table := range tables {
+		exists, err := tableExists(m.source, table)
+		if err != nil {
+			return report, err
+		}
+		if !exists {
+			continue
+		}
+
+		var count int64
+		if err := m.source.Session(ctx).Table(table).Count(&count).Error; err != nil {
+			return report, fmt.Errorf("count %s: %w", table, err)
+		}
+		report.Found += int(count)
+		if count > 0 {
+			report.Warnings = append(report.Warnings, fmt.Sprintf("%d rows in legacy %q table: %s", count, table, warning))
+		}
+	}
+
+	return report, nil
+}
+
+// tableExists reports whether table exists in db, supporting both the
+// Postgres and SQLite dialects that kodit's database package can open.
+func tableExists(db database.Database, table string) (bool, error) {
+	var exists bool
+	var err error
+	switch {
+	case db.IsPostgres():
+		err = db.GORM().Raw(
+			`SELECT EXISTS(SELECT 1 FROM information_schema.tables WHERE table_name = ?)`, table,
+		).Scan(&exists).Error
+	case db.IsSQLite():
+		err = db.GORM().Raw(
+			`SELECT EXISTS(SELECT 1 FROM sqlite_master WHERE type = 'table' AND name = ?)`, table,
+		).Scan(&exists).Error
+	default:
+		return false, fmt.Errorf("unsupported source database driver")
+	}
+	if err != nil {
+		return false, fmt.Errorf("check table %q exists: %w", table, err)
+	}
+	return exists, nil
+}
@@ -0,0 +1,55 @@
+// Package moderation provides content moderation for AI-generated enrichments.
+package moderation
+
+import (
+	"context"
+	"regexp"
+
+	domainservice "github.com/helixml/kodit/domain/service"
+)
+
+// rule matches a category of content that should be flagged for review.
+type rule struct {
+	reason  string
+	pattern *regexp.Regexp
+}
+
+// rules are checked in order; the first match determines the flag reason.
+var rules = []rule{
+	{
+		reason:  "possible leaked secret",
+		pattern: regexp.MustCompile(`(?i)-----BEGIN [A-Z ]*PRIVATE KEY-----|AKIA[0-9A-Z]{16}|sk-[A-Za-z0-9]{20,}|ghp_[A-Za-z0-9]{36}`),
+	},
+	{
+		reason:  "possible prompt injection artifact",
+		pattern: regexp.MustCompile(`(?i)ignore (all )?(previous|prior|above) instructions|disregard (all )?(previous|prior|above) instructions|you are now in developer mode|system prompt:`),
+	},
+	{
+		reason:  "profanity",
+		pattern: regexp.MustCompile(`(?i)\b(fuck|shit|asshole|bastard|bitch)\b`),
+	},
+}
+
+// RuleBasedModerator flags enrichment content using a fixed set of regular
+// expressions for profanity, leaked secrets, and prompt-injection
+// artifacts. It implements domainservice.Moderator.
+type RuleBasedModerator struct{}
+
+// NewRuleBasedModerator creates a new RuleBasedModerator.
+func NewRuleBasedModerator() *RuleBasedModerator {
+	return &RuleBasedModerator{}
+}
+
+// Moderate checks text against the registered rules and returns the first
+// match, if any.
+func (m *RuleBasedModerator) Moderate(_ context.Context, text string) (domainservice.ModerationResult, error) {
+	for _, r := range rules {
+		if r.pattern.MatchString(text) {
+			return domainservice.NewModerationResult(true, r.reason), nil
+		}
+	}
+	return domainservice.NewModerationResult(false, ""), nil
+}
+
+// Ensure RuleBasedModerator implements domainservice.Moderator.
+var _ domainservice.Moderator = (*RuleBasedModerator)(nil)
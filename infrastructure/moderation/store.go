@@ -0,0 +1,110 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog"
+
+	"github.com/helixml/kodit/domain/enrichment"
+	"github.com/helixml/kodit/domain/repository"
+	domainservice "github.com/helixml/kodit/domain/service"
+)
+
+// ModeratedStore wraps an enrichment.EnrichmentStore and screens content
+// through a Moderator before it is saved. Flagged content is not silently
+// stored or dropped: it is saved with Enrichment.WithFlag applied and the
+// hit logged, so it surfaces for review.
+type ModeratedStore struct {
+	inner     enrichment.EnrichmentStore
+	moderator domainservice.Moderator
+	logger    zerolog.Logger
+}
+
+// NewModeratedStore creates a ModeratedStore wrapping inner.
+func NewModeratedStore(inner enrichment.EnrichmentStore, moderator domainservice.Moderator, logger zerolog.Logger) (*ModeratedStore, error) {
+	if inner == nil {
+		return nil, fmt.Errorf("NewModeratedStore: nil inner store")
+	}
+	if moderator == nil {
+		return nil, fmt.Errorf("NewModeratedStore: nil moderator")
+	}
+	return &ModeratedStore{inner: inner, moderator: moderator, logger: logger}, nil
+}
+
+// Save moderates the enrichment's content, flagging it for review if the
+// moderator matches a rule, then delegates to the inner store.
+func (s *ModeratedStore) Save(ctx context.Context, e enrichment.Enrichment) (enrichment.Enrichment, error) {
+	result, err := s.moderator.Moderate(ctx, e.Content())
+	if err != nil {
+		return enrichment.Enrichment{}, fmt.Errorf("moderate enrichment content: %w", err)
+	}
+
+	if result.Flagged() {
+		s.logger.Warn().
+			Str("reason", result.Reason()).
+			Str("type", string(e.Type())).
+			Str("subtype", string(e.Subtype())).
+			Msg("enrichment content flagged by moderation filter")
+		e = e.WithFlag(result.Reason())
+	}
+
+	return s.inner.Save(ctx, e)
+}
+
+// SaveAll moderates each enrichment's content, flagging it for review if the
+// moderator matches a rule, then delegates to the inner store.
+func (s *ModeratedStore) SaveAll(ctx context.Context, enrichments []enrichment.Enrichment) ([]enrichment.Enrichment, error) {
+	moderated := make([]enrichment.Enrichment, len(enrichments))
+	for i, e := range enrichments {
+		result, err := s.moderator.Moderate(ctx, e.Content())
+		if err != nil {
+			return nil, fmt.Errorf("moderate enrichment content: %w", err)
+		}
+
+		if result.Flagged() {
+			s.logger.Warn().
+				Str("reason", result.Reason()).
+				Str("type", string(e.Type())).
+				Str("subtype", string(e.Subtype())).
+				Msg("enrichment content flagged by moderation filter")
+			e = e.WithFlag(result.Reason())
+		}
+		moderated[i] = e
+	}
+
+	return s.inner.SaveAll(ctx, moderated)
+}
+
+// Find delegates to the inner store.
+func (s *ModeratedStore) Find(ctx context.Context, options ...repository.Option) ([]enrichment.Enrichment, error) {
+	return s.inner.Find(ctx, options...)
+}
+
+// FindOne delegates to the inner store.
+func (s *ModeratedStore) FindOne(ctx context.Context, options ...repository.Option) (enrichment.Enrichment, error) {
+	return s.inner.FindOne(ctx, options...)
+}
+
+// Count delegates to the inner store.
+func (s *ModeratedStore) Count(ctx context.Context, options ...repository.Option) (int64, error) {
+	return s.inner.Count(ctx, options...)
+}
+
+// Delete delegates to the inner store.
+func (s *ModeratedStore) Delete(ctx context.Context, e enrichment.Enrichment) error {
+	return s.inner.Delete(ctx, e)
+}
+
+// DeleteBy delegates to the inner store.
+func (s *ModeratedStore) DeleteBy(ctx context.Context, options ...repository.Option) error {
+	return s.inner.DeleteBy(ctx, options...)
+}
+
+// Exists delegates to the inner store.
+func (s *ModeratedStore) Exists(ctx context.Context, options ...repository.Option) (bool, error) {
+	return s.inner.Exists(ctx, options...)
+}
+
+// Ensure ModeratedStore implements enrichment.EnrichmentStore.
+var _ enrichment.EnrichmentStore = (*ModeratedStore)(nil)
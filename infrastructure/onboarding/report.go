@@ -0,0 +1,110 @@
+// Package onboarding produces a deterministic preflight analysis of a
+// repository's file set — size, language breakdown, analyzer coverage, and
+// projected indexing cost — so a newly added repository's expected
+// coverage is visible before the full indexing pipeline finishes.
+package onboarding
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// bytesPerToken approximates the number of bytes per LLM token, matching
+// the heuristic used elsewhere for usage estimation (see
+// infrastructure/provider/metered.go).
+const bytesPerToken = 4
+
+// FileInfo is a minimal per-file view used to build an onboarding report,
+// decoupled from the domain repository package.
+type FileInfo struct {
+	Path      string
+	Language  string
+	Size      int64
+	Indexable bool
+}
+
+// LanguageStat summarizes how many files and bytes a single language
+// contributes to a repository.
+type LanguageStat struct {
+	Language string `json:"language"`
+	Files    int    `json:"files"`
+	Size     int64  `json:"size_bytes"`
+}
+
+// SkippedFile records a file that will not be chunked or indexed, and why.
+type SkippedFile struct {
+	Path   string `json:"path"`
+	Reason string `json:"reason"`
+}
+
+// Report is the onboarding preflight analysis for a single commit.
+type Report struct {
+	TotalFiles               int            `json:"total_files"`
+	TotalSize                int64          `json:"total_size_bytes"`
+	Languages                []LanguageStat `json:"languages"`
+	IndexableFiles           int            `json:"indexable_files"`
+	SkippedFiles             []SkippedFile  `json:"skipped_files,omitempty"`
+	EstimatedSnippets        int            `json:"estimated_snippets"`
+	EstimatedEmbeddingTokens int            `json:"estimated_embedding_tokens"`
+}
+
+// Generate analyzes files and returns the JSON-encoded onboarding report.
+// chunkSize and chunkOverlap are the repository's chunking parameters,
+// used to project how many snippets each indexable file will yield.
+func Generate(files []FileInfo, chunkSize, chunkOverlap int) ([]byte, error) {
+	report := Report{TotalFiles: len(files)}
+
+	stride := chunkSize - chunkOverlap
+	if stride <= 0 {
+		stride = chunkSize
+	}
+
+	languages := make(map[string]*LanguageStat)
+	for _, f := range files {
+		report.TotalSize += f.Size
+
+		lang := f.Language
+		if lang == "" {
+			lang = "unknown"
+		}
+		stat, ok := languages[lang]
+		if !ok {
+			stat = &LanguageStat{Language: lang}
+			languages[lang] = stat
+		}
+		stat.Files++
+		stat.Size += f.Size
+
+		if !f.Indexable {
+			report.SkippedFiles = append(report.SkippedFiles, SkippedFile{
+				Path:   f.Path,
+				Reason: "unsupported file extension",
+			})
+			continue
+		}
+
+		report.IndexableFiles++
+		if f.Size > 0 && stride > 0 {
+			report.EstimatedSnippets += int((f.Size + int64(stride) - 1) / int64(stride))
+		}
+		report.EstimatedEmbeddingTokens += int(f.Size / bytesPerToken)
+	}
+
+	report.Languages = make([]LanguageStat, 0, len(languages))
+	for _, stat := range languages {
+		report.Languages = append(report.Languages, *stat)
+	}
+	sort.Slice(report.Languages, func(i, j int) bool {
+		return report.Languages[i].Size > report.Languages[j].Size
+	})
+	sort.Slice(report.SkippedFiles, func(i, j int) bool {
+		return report.SkippedFiles[i].Path < report.SkippedFiles[j].Path
+	})
+
+	data, err := json.Marshal(report)
+	if err != nil {
+		return nil, fmt.Errorf("marshal onboarding report: %w", err)
+	}
+	return data, nil
+}
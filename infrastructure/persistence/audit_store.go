@@ -0,0 +1,18 @@
+package persistence
+
+import (
+	"github.com/helixml/kodit/domain/audit"
+	"github.com/helixml/kodit/internal/database"
+)
+
+// AuditStore implements audit.Store using GORM.
+type AuditStore struct {
+	database.Repository[audit.Event, AuditEventModel]
+}
+
+// NewAuditStore creates a new AuditStore.
+func NewAuditStore(db database.Database) AuditStore {
+	return AuditStore{
+		Repository: database.NewRepository[audit.Event, AuditEventModel](db, AuditMapper{}, "audit_event"),
+	}
+}
@@ -19,15 +19,22 @@ const (
 	sqliteCreateFTS5Table = `
 CREATE VIRTUAL TABLE IF NOT EXISTS kodit_bm25_documents USING fts5(
     snippet_id UNINDEXED,
+    language UNINDEXED,
     passage,
     tokenize='porter ascii'
 )`
 
 	sqliteInsertQuery = `
-INSERT INTO kodit_bm25_documents (rowid, snippet_id, passage)
-VALUES (?, ?, ?)`
+INSERT INTO kodit_bm25_documents (rowid, snippet_id, language, passage)
+VALUES (?, ?, ?, ?)`
 
 	sqliteMaxRowIDQuery = `SELECT COALESCE(MAX(rowid), 0) FROM kodit_bm25_documents`
+
+	// sqliteHasLanguageColumnQuery checks whether the language column has
+	// already been added, for the one-time migration below.
+	sqliteHasLanguageColumnQuery = `SELECT EXISTS(SELECT 1 FROM pragma_table_info('kodit_bm25_documents') WHERE name = 'language')`
+
+	sqliteAddLanguageColumn = `ALTER TABLE kodit_bm25_documents ADD COLUMN language UNINDEXED`
 )
 
 // ErrSQLiteBM25InitializationFailed indicates SQLite FTS5 initialization failed.
@@ -37,6 +44,7 @@ var ErrSQLiteBM25InitializationFailed = errors.New("failed to initialize SQLite
 // bm25() function during ranked queries; it is not a stored column.
 type SQLiteBM25Model struct {
 	SnippetID string  `gorm:"column:snippet_id"`
+	Language  string  `gorm:"column:language"`
 	Passage   string  `gorm:"column:passage"`
 	Score     float64 `gorm:"->;-:migration"`
 }
@@ -78,6 +86,18 @@ func NewSQLiteBM25Store(db database.Database, logger zerolog.Logger) (*SQLiteBM2
 		return nil, errors.Join(ErrSQLiteBM25InitializationFailed, fmt.Errorf("create fts5 table: %w", err))
 	}
 
+	// One-time migration: older databases were created before the language
+	// column existed. FTS5 supports adding UNINDEXED columns in place.
+	var hasLanguageColumn int
+	if err := s.DB(ctx).Raw(sqliteHasLanguageColumnQuery).Scan(&hasLanguageColumn).Error; err != nil {
+		return nil, errors.Join(ErrSQLiteBM25InitializationFailed, fmt.Errorf("check language column: %w", err))
+	}
+	if hasLanguageColumn == 0 {
+		if err := s.DB(ctx).Exec(sqliteAddLanguageColumn).Error; err != nil {
+			return nil, errors.Join(ErrSQLiteBM25InitializationFailed, fmt.Errorf("add language column: %w", err))
+		}
+	}
+
 	var maxRowID int64
 	if err := s.DB(ctx).Raw(sqliteMaxRowIDQuery).Scan(&maxRowID).Error; err != nil {
 		return nil, errors.Join(ErrSQLiteBM25InitializationFailed, fmt.Errorf("read max rowid: %w", err))
@@ -103,14 +123,24 @@ func (s *SQLiteBM25Store) Find(ctx context.Context, opts ...repository.Option) (
 	}
 
 	augmented := []repository.Option{
-		repository.WithSelect("snippet_id, bm25(kodit_bm25_documents) AS score"),
+		repository.WithSelect("snippet_id, language, bm25(kodit_bm25_documents) AS score"),
 		repository.WithWhere("kodit_bm25_documents MATCH ?", escapeFTS5Query(query)),
 		repository.WithRawOrder("score ASC"),
-		repository.WithLimit(limit),
+		repository.WithLimit(bm25CandidatePoolSize(limit)),
 	}
 	augmented = appendSearchFilters(augmented, q, "INTEGER")
 
-	return s.Repository.Find(ctx, augmented...)
+	var rows []SQLiteBM25Model
+	db := database.ApplyOptions(s.DB(ctx), augmented...)
+	if err := db.Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("find bm25 document: %w", err)
+	}
+
+	return normalizeByLanguage(rows, limit, func(r SQLiteBM25Model) (snippetID, language string, rawScore float64) {
+		// SQLite's bm25() returns negative scores (lower = better); negate
+		// to keep results positive for cross-store consistency.
+		return r.SnippetID, r.Language, -r.Score
+	}), nil
 }
 
 // Index adds documents to the BM25 index.
@@ -131,7 +161,7 @@ func (s *SQLiteBM25Store) Index(ctx context.Context, docs []search.Document) err
 		for _, doc := range toIndex {
 			rowID := s.nextRowID
 			s.nextRowID++
-			if err := tx.Exec(sqliteInsertQuery, rowID, doc.SnippetID(), doc.Text()).Error; err != nil {
+			if err := tx.Exec(sqliteInsertQuery, rowID, doc.SnippetID(), doc.Language(), doc.Text()).Error; err != nil {
 				return err
 			}
 		}
@@ -139,6 +169,26 @@ func (s *SQLiteBM25Store) Index(ctx context.Context, docs []search.Document) err
 	})
 }
 
+// SnippetIDs returns every snippet_id currently indexed.
+func (s *SQLiteBM25Store) SnippetIDs(ctx context.Context) ([]string, error) {
+	var ids []string
+	if err := s.DB(ctx).Table(sqliteBM25Table).Pluck("snippet_id", &ids).Error; err != nil {
+		return nil, fmt.Errorf("list snippet ids: %w", err)
+	}
+	return ids, nil
+}
+
+// Vacuum runs FTS5's 'optimize' command to merge index segments left behind
+// by deletes into an optimal b-tree, then VACUUMs the database file to
+// reclaim the freed space.
+func (s *SQLiteBM25Store) Vacuum(ctx context.Context) (search.VacuumStats, error) {
+	db := s.DB(ctx)
+	if err := db.Exec(fmt.Sprintf("INSERT INTO %s(%s) VALUES('optimize')", sqliteBM25Table, sqliteBM25Table)).Error; err != nil {
+		return search.VacuumStats{}, fmt.Errorf("optimize fts5 index: %w", err)
+	}
+	return vacuumSqliteDB(db, sqliteBM25Table)
+}
+
 // escapeFTS5Query escapes special characters for FTS5 queries.
 func escapeFTS5Query(query string) string {
 	// For simple queries, wrap in double quotes to treat as a phrase
@@ -0,0 +1,68 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/helixml/kodit/domain/repository"
+	"github.com/helixml/kodit/domain/search"
+	"github.com/helixml/kodit/infrastructure/persistence"
+	"github.com/helixml/kodit/internal/testdb"
+)
+
+// TestSQLiteBM25Store_Lifecycle exercises the full BM25 lifecycle (FTS5 table
+// creation, indexing, ranked search, filtering, and vacuum) against an
+// in-memory SQLite database, so single-binary deployments have keyword
+// search covered without needing an external service like VectorChord.
+func TestSQLiteBM25Store_Lifecycle(t *testing.T) {
+	db := testdb.NewPlain(t)
+	ctx := context.Background()
+
+	store, err := persistence.NewSQLiteBM25Store(db, zerolog.Nop())
+	require.NoError(t, err)
+
+	docs := []search.Document{
+		search.NewDocument("sqlite-bm25-1", "kubernetes deployment controller reconciles pods"),
+		search.NewDocument("sqlite-bm25-2", "http router handles incoming web requests"),
+		search.NewDocument("sqlite-bm25-3", "database migration runs schema changes automatically"),
+	}
+	require.NoError(t, store.Index(ctx, docs))
+
+	// escapeFTS5Query wraps the query in double quotes, so FTS5 treats it as
+	// an exact phrase — use a query matching consecutive words in the doc.
+	// Find should return relevant documents, ranked by BM25 score.
+	results, err := store.Find(ctx,
+		search.WithQuery("deployment controller"),
+		repository.WithLimit(10),
+	)
+	require.NoError(t, err)
+	require.NotEmpty(t, results, "expected BM25 search to return results")
+
+	ids := make(map[string]bool)
+	for _, r := range results {
+		ids[r.SnippetID()] = true
+	}
+	assert.True(t, ids["sqlite-bm25-1"], "expected kubernetes document in results")
+
+	// Re-indexing the same documents should not duplicate matches.
+	require.NoError(t, store.Index(ctx, docs))
+	afterReindex, err := store.Find(ctx,
+		search.WithQuery("deployment controller"),
+		repository.WithLimit(10),
+	)
+	require.NoError(t, err)
+	assert.Len(t, afterReindex, len(results), "re-indexing should not duplicate matches")
+
+	// SnippetIDs should reflect everything indexed.
+	snippetIDs, err := store.SnippetIDs(ctx)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"sqlite-bm25-1", "sqlite-bm25-2", "sqlite-bm25-3"}, snippetIDs)
+
+	// Vacuum should succeed and report stats without error.
+	_, err = store.Vacuum(ctx)
+	require.NoError(t, err)
+}
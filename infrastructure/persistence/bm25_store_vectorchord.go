@@ -24,11 +24,22 @@ const (
 CREATE TABLE IF NOT EXISTS vectorchord_bm25_documents (
     id SERIAL PRIMARY KEY,
     snippet_id VARCHAR(255) NOT NULL,
+    language VARCHAR(64) NOT NULL DEFAULT '',
     passage TEXT NOT NULL,
     embedding bm25vector,
     UNIQUE(snippet_id)
 )`
 
+	// addLanguageColumn is a one-time migration for tables created before
+	// the language column existed.
+	addLanguageColumn = `
+ALTER TABLE vectorchord_bm25_documents
+ADD COLUMN IF NOT EXISTS language VARCHAR(64) NOT NULL DEFAULT ''`
+
+	createLanguageIndex = `
+CREATE INDEX IF NOT EXISTS vectorchord_bm25_documents_language_idx
+ON vectorchord_bm25_documents (language)`
+
 	createBM25Index = `
 CREATE INDEX IF NOT EXISTS vectorchord_bm25_documents_idx
 ON vectorchord_bm25_documents
@@ -68,6 +79,7 @@ var ErrBM25InitializationFailed = errors.New("failed to initialize VectorChord B
 type VchordBM25Model struct {
 	ID        int64   `gorm:"column:id;primaryKey;autoIncrement"`
 	SnippetID string  `gorm:"column:snippet_id;uniqueIndex"`
+	Language  string  `gorm:"column:language"`
 	Passage   string  `gorm:"column:passage"`
 	Score     float64 `gorm:"->;-:migration"`
 }
@@ -158,6 +170,12 @@ func (s *VectorChordBM25Store) createTables(ctx context.Context) error {
 	if err := db.Exec(createBM25Table).Error; err != nil {
 		return fmt.Errorf("create bm25 table: %w", err)
 	}
+	if err := db.Exec(addLanguageColumn).Error; err != nil {
+		return fmt.Errorf("add language column: %w", err)
+	}
+	if err := db.Exec(createLanguageIndex).Error; err != nil {
+		return fmt.Errorf("create language index: %w", err)
+	}
 	if err := db.Exec(createBM25Index).Error; err != nil {
 		return fmt.Errorf("create bm25 index: %w", err)
 	}
@@ -179,13 +197,23 @@ func (s *VectorChordBM25Store) Find(ctx context.Context, opts ...repository.Opti
 	}
 
 	augmented := []repository.Option{
-		repository.WithSelect("snippet_id, embedding <&> to_bm25query('vectorchord_bm25_documents_idx', tokenize(?, 'bert')) AS score", query),
+		repository.WithSelect("snippet_id, language, embedding <&> to_bm25query('vectorchord_bm25_documents_idx', tokenize(?, 'bert')) AS score", query),
 		repository.WithRawOrder("score ASC"),
-		repository.WithLimit(limit),
+		repository.WithLimit(bm25CandidatePoolSize(limit)),
 	}
 	augmented = appendSearchFilters(augmented, q, "bigint")
 
-	return s.Repository.Find(ctx, augmented...)
+	var rows []VchordBM25Model
+	db := database.ApplyOptions(s.DB(ctx), augmented...)
+	if err := db.Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("find bm25 document: %w", err)
+	}
+
+	return normalizeByLanguage(rows, limit, func(r VchordBM25Model) (snippetID, language string, rawScore float64) {
+		// vchord_bm25 returns negative distances (more negative = better);
+		// negate to keep results positive for cross-store consistency.
+		return r.SnippetID, r.Language, -r.Score
+	}), nil
 }
 
 // Index adds documents to the BM25 index, then tokenizes the new rows.
@@ -210,22 +238,37 @@ func (s *VectorChordBM25Store) Index(ctx context.Context, docs []search.Document
 	})
 }
 
+// SnippetIDs returns every snippet_id currently indexed.
+func (s *VectorChordBM25Store) SnippetIDs(ctx context.Context) ([]string, error) {
+	var ids []string
+	if err := s.DB(ctx).Table(vchordBM25Table).Pluck("snippet_id", &ids).Error; err != nil {
+		return nil, fmt.Errorf("list snippet ids: %w", err)
+	}
+	return ids, nil
+}
+
+// Vacuum runs VACUUM ANALYZE on the BM25 documents table to reclaim space
+// left by deleted rows and refresh planner statistics.
+func (s *VectorChordBM25Store) Vacuum(ctx context.Context) (search.VacuumStats, error) {
+	return vacuumPgTable(s.DB(ctx), vchordBM25Table)
+}
+
 func (s *VectorChordBM25Store) batchInsert(tx *gorm.DB, documents []search.Document) error {
 	for start := 0; start < len(documents); start += bm25BatchSize {
 		end := min(start+bm25BatchSize, len(documents))
 		batch := documents[start:end]
 
 		var b strings.Builder
-		b.WriteString("INSERT INTO vectorchord_bm25_documents (snippet_id, passage, embedding) VALUES ")
-		args := make([]any, 0, len(batch)*2)
+		b.WriteString("INSERT INTO vectorchord_bm25_documents (snippet_id, language, passage, embedding) VALUES ")
+		args := make([]any, 0, len(batch)*3)
 		for i, doc := range batch {
 			if i > 0 {
 				b.WriteString(", ")
 			}
-			b.WriteString("(?, ?, NULL)")
-			args = append(args, doc.SnippetID(), doc.Text())
+			b.WriteString("(?, ?, ?, NULL)")
+			args = append(args, doc.SnippetID(), doc.Language(), doc.Text())
 		}
-		b.WriteString(" ON CONFLICT (snippet_id) DO UPDATE SET passage = EXCLUDED.passage, embedding = NULL")
+		b.WriteString(" ON CONFLICT (snippet_id) DO UPDATE SET language = EXCLUDED.language, passage = EXCLUDED.passage, embedding = NULL")
 
 		if err := tx.Exec(b.String(), args...).Error; err != nil {
 			return err
@@ -0,0 +1,18 @@
+package persistence
+
+import (
+	"github.com/helixml/kodit/domain/job"
+	"github.com/helixml/kodit/internal/database"
+)
+
+// BulkJobStore implements job.Store using GORM.
+type BulkJobStore struct {
+	database.Repository[job.Job, BulkJobModel]
+}
+
+// NewBulkJobStore creates a new BulkJobStore.
+func NewBulkJobStore(db database.Database) BulkJobStore {
+	return BulkJobStore{
+		Repository: database.NewRepository[job.Job, BulkJobModel](db, BulkJobMapper{}, "bulk_job"),
+	}
+}
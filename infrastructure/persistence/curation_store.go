@@ -0,0 +1,18 @@
+package persistence
+
+import (
+	"github.com/helixml/kodit/domain/curation"
+	"github.com/helixml/kodit/internal/database"
+)
+
+// CurationStore implements curation.Store using GORM.
+type CurationStore struct {
+	database.Repository[curation.Rule, CurationRuleModel]
+}
+
+// NewCurationStore creates a new CurationStore.
+func NewCurationStore(db database.Database) CurationStore {
+	return CurationStore{
+		Repository: database.NewRepository[curation.Rule, CurationRuleModel](db, CurationRuleMapper{}, "curation_rule"),
+	}
+}
@@ -132,9 +132,20 @@ func AutoMigrate(db database.Database) error {
 		&EnrichmentModel{},
 		&EnrichmentAssociationModel{},
 		&EmbeddingModel{},
+		&EmbeddingStatusModel{},
 		&SourceLocationModel{},
 		&TaskModel{},
 		&TaskStatusModel{},
+		&ProviderUsageModel{},
+		&QueryLogModel{},
+		&AuditEventModel{},
+		&PRIndexModel{},
+		&SynonymModel{},
+		&CurationRuleModel{},
+		&SnapshotModel{},
+		&BulkJobModel{},
+		&DiscoveryCandidateModel{},
+		&WorkerInstanceModel{},
 		&models.Pipeline{},
 		&models.Step{},
 		&models.PipelineStep{},
@@ -197,6 +208,19 @@ func postMigrate(db database.Database) error {
 		}
 	}
 
+	// Trigram indexes accelerate the autocomplete endpoints' substring and
+	// prefix LIKE queries. SQLite has no equivalent extension, so autocomplete
+	// falls back to an unindexed LIKE scan there.
+	if err := gdb.Exec(`CREATE EXTENSION IF NOT EXISTS pg_trgm`).Error; err != nil {
+		return fmt.Errorf("create pg_trgm extension: %w", err)
+	}
+	if err := gdb.Exec(`CREATE INDEX IF NOT EXISTS idx_git_repos_sanitized_remote_uri_trgm ON git_repos USING gin (sanitized_remote_uri gin_trgm_ops)`).Error; err != nil {
+		return fmt.Errorf("create repositories trigram index: %w", err)
+	}
+	if err := gdb.Exec(`CREATE INDEX IF NOT EXISTS idx_git_commit_files_path_trgm ON git_commit_files USING gin (path gin_trgm_ops)`).Error; err != nil {
+		return fmt.Errorf("create files trigram index: %w", err)
+	}
+
 	// Clean up duplicate Python-era FK constraints (superseded by GORM equivalents).
 	oldFKs := []struct{ table, name string }{
 		{"git_commits", "git_commits_repo_id_fkey"},
@@ -227,9 +251,20 @@ func allModels() []interface{} {
 		&EnrichmentModel{},
 		&EnrichmentAssociationModel{},
 		&EmbeddingModel{},
+		&EmbeddingStatusModel{},
 		&SourceLocationModel{},
 		&TaskModel{},
 		&TaskStatusModel{},
+		&ProviderUsageModel{},
+		&QueryLogModel{},
+		&AuditEventModel{},
+		&PRIndexModel{},
+		&SynonymModel{},
+		&CurationRuleModel{},
+		&SnapshotModel{},
+		&BulkJobModel{},
+		&DiscoveryCandidateModel{},
+		&WorkerInstanceModel{},
 		&models.Pipeline{},
 		&models.Step{},
 		&models.PipelineStep{},
@@ -0,0 +1,18 @@
+package persistence
+
+import (
+	"github.com/helixml/kodit/domain/discovery"
+	"github.com/helixml/kodit/internal/database"
+)
+
+// DiscoveryStore implements discovery.Store using GORM.
+type DiscoveryStore struct {
+	database.Repository[discovery.Candidate, DiscoveryCandidateModel]
+}
+
+// NewDiscoveryStore creates a new DiscoveryStore.
+func NewDiscoveryStore(db database.Database) DiscoveryStore {
+	return DiscoveryStore{
+		Repository: database.NewRepository[discovery.Candidate, DiscoveryCandidateModel](db, DiscoveryCandidateMapper{}, "discovery_candidate"),
+	}
+}
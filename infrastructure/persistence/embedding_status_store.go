@@ -0,0 +1,49 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/helixml/kodit/domain/search"
+	"github.com/helixml/kodit/internal/database"
+	"gorm.io/gorm/clause"
+)
+
+// EmbeddingStatusStore implements search.EmbeddingStatusStore using GORM.
+type EmbeddingStatusStore struct {
+	database.Repository[search.EmbeddingStatus, EmbeddingStatusModel]
+}
+
+// NewEmbeddingStatusStore creates a new EmbeddingStatusStore.
+func NewEmbeddingStatusStore(db database.Database) EmbeddingStatusStore {
+	return EmbeddingStatusStore{
+		Repository: database.NewRepository[search.EmbeddingStatus, EmbeddingStatusModel](db, EmbeddingStatusMapper{}, "embedding_status"),
+	}
+}
+
+// Save creates or updates an embedding status. Uses (snippet_id, task_name)
+// for conflict resolution so re-embedding a snippet overwrites its previous
+// outcome rather than accumulating stale rows.
+func (s EmbeddingStatusStore) Save(ctx context.Context, status search.EmbeddingStatus) (search.EmbeddingStatus, error) {
+	model := s.Mapper().ToModel(status)
+	model.UpdatedAt = time.Now()
+
+	result := s.DB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "snippet_id"}, {Name: "task_name"}},
+		DoUpdates: clause.AssignmentColumns([]string{"state", "error", "updated_at"}),
+	}).Create(&model)
+	if result.Error != nil {
+		return search.EmbeddingStatus{}, fmt.Errorf("save embedding status: %w", result.Error)
+	}
+	return s.Mapper().ToDomain(model), nil
+}
+
+// Delete removes an embedding status.
+func (s EmbeddingStatusStore) Delete(ctx context.Context, status search.EmbeddingStatus) error {
+	result := s.DB(ctx).Where("snippet_id = ? AND task_name = ?", status.SnippetID(), string(status.TaskName())).Delete(&EmbeddingStatusModel{})
+	if result.Error != nil {
+		return fmt.Errorf("delete embedding status: %w", result.Error)
+	}
+	return nil
+}
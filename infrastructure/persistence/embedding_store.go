@@ -20,16 +20,6 @@ const saveAllBatchSize = 100
 // bind parameters, well under PostgreSQL's 65 535-parameter limit.
 const gitBatchSize = 1000
 
-// TaskName represents the type of embeddings (code or text).
-type TaskName string
-
-// TaskName values.
-var (
-	TaskNameCode   = TaskName("code")
-	TaskNameText   = TaskName("text")
-	TaskNameVision = TaskName("vision")
-)
-
 // PgEmbeddingModel is a GORM model for PostgreSQL vector embedding tables.
 // Score is populated transiently during ranked search (`embedding <=> ?`);
 // it is never written.
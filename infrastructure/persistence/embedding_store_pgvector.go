@@ -0,0 +1,302 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/rs/zerolog"
+
+	"github.com/helixml/kodit/domain/repository"
+	"github.com/helixml/kodit/domain/search"
+	"github.com/helixml/kodit/internal/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SQL queries for the plain pgvector backend. Unlike VectorChord's vchordrq,
+// an hnsw index needs no per-query probe tuning and no lists training, so
+// there is far less machinery here than in embedding_store_vectorchord.go.
+const (
+	pgvecCreateExtension = `CREATE EXTENSION IF NOT EXISTS vector`
+
+	pgvecCheckDimensionTemplate = `
+SELECT a.atttypmod as dimension
+FROM pg_attribute a
+JOIN pg_class c ON a.attrelid = c.oid
+WHERE c.relname = '%s'
+AND a.attname = 'embedding'`
+
+	pgvecCheckIndexTemplate = `
+SELECT amname FROM pg_index i
+JOIN pg_class c ON c.oid = i.indexrelid
+JOIN pg_am a ON a.oid = c.relam
+WHERE c.relname = '%s_idx'`
+)
+
+// ErrPgVectorInitializationFailed indicates pgvector table/index setup failed.
+var ErrPgVectorInitializationFailed = errors.New("failed to initialize pgvector embedding repository")
+
+// PgVectorEmbeddingStore implements search.Store using the standard pgvector
+// PostgreSQL extension and an hnsw index. It is a lighter-weight alternative
+// to VectorChordEmbeddingStore for deployments that want plain Postgres
+// vector search without the vchord extension family.
+type PgVectorEmbeddingStore struct {
+	database.Repository[search.Result, PgEmbeddingModel]
+	logger  zerolog.Logger
+	indexMu sync.Mutex
+
+	onRebuilt  func(context.Context)
+	tableMu    sync.Mutex
+	tableReady atomic.Bool
+}
+
+// NewPgVectorEmbeddingStore creates a new PgVectorEmbeddingStore. As with
+// VectorChordEmbeddingStore, the extension, table, and index are created
+// lazily on the first Index call using the actual embedding dimension.
+func NewPgVectorEmbeddingStore(db database.Database, taskName search.TaskName, onRebuilt func(context.Context), logger zerolog.Logger) *PgVectorEmbeddingStore {
+	tableName := fmt.Sprintf("pgvector_%s_embeddings", taskName)
+	s := &PgVectorEmbeddingStore{
+		Repository: database.NewRepositoryForTable[search.Result, PgEmbeddingModel](
+			db, pgEmbeddingMapper{}, "embedding", tableName,
+		),
+		onRebuilt: onRebuilt,
+		logger:    logger,
+	}
+
+	var count int64
+	s.DB(context.Background()).Raw(
+		"SELECT count(*) FROM pg_class WHERE relname = ? AND relkind = 'r'", tableName,
+	).Scan(&count)
+	if count > 0 {
+		s.tableReady.Store(true)
+	} else {
+		logger.Warn().Str("table", tableName).Msg("embedding table does not exist yet; read/delete operations will return empty until first Index creates it")
+	}
+
+	return s
+}
+
+// Find performs vector similarity search when WithEmbedding is supplied;
+// otherwise delegates to the embedded Repository for plain snippet_id lookups.
+func (s *PgVectorEmbeddingStore) Find(ctx context.Context, opts ...repository.Option) ([]search.Result, error) {
+	if !s.tableReady.Load() {
+		return nil, nil
+	}
+
+	q := repository.Build(opts...)
+	embedding, ok := search.EmbeddingFrom(q)
+	if !ok || len(embedding) == 0 {
+		return s.Repository.Find(ctx, opts...)
+	}
+
+	limit := q.LimitValue()
+	if limit <= 0 {
+		limit = 10
+	}
+
+	queryEmbedding := database.NewPgVector(embedding).String()
+	augmented := []repository.Option{
+		repository.WithSelect("snippet_id, embedding <=> ? AS score", queryEmbedding),
+		repository.WithRawOrder("score ASC"),
+		repository.WithLimit(limit),
+	}
+	if filters, ok := search.FiltersFrom(q); ok {
+		augmented = append(augmented, filterJoinOptions(filters, "bigint")...)
+	}
+	if snippetIDs := search.SnippetIDsFrom(q); len(snippetIDs) > 0 {
+		augmented = append(augmented, search.WithSnippetIDs(snippetIDs))
+	}
+
+	var entities []PgEmbeddingModel
+	db := database.ApplyOptions(s.DB(ctx).Table(s.Table()), augmented...)
+	if err := db.Scan(&entities).Error; err != nil {
+		return nil, err
+	}
+	results := make([]search.Result, len(entities))
+	for i, e := range entities {
+		results[i] = s.Mapper().ToDomain(e)
+	}
+	return results, nil
+}
+
+// DeleteBy removes embeddings, silently succeeding if the table hasn't been created yet.
+func (s *PgVectorEmbeddingStore) DeleteBy(ctx context.Context, opts ...repository.Option) error {
+	if !s.tableReady.Load() {
+		return nil
+	}
+	return s.Repository.DeleteBy(ctx, opts...)
+}
+
+// Exists checks for matching embeddings, returning false if the table hasn't been created yet.
+func (s *PgVectorEmbeddingStore) Exists(ctx context.Context, opts ...repository.Option) (bool, error) {
+	if !s.tableReady.Load() {
+		return false, nil
+	}
+	return s.Repository.Exists(ctx, opts...)
+}
+
+// SnippetIDs returns every snippet_id currently stored, or nil if the table
+// hasn't been created yet.
+func (s *PgVectorEmbeddingStore) SnippetIDs(ctx context.Context) ([]string, error) {
+	if !s.tableReady.Load() {
+		return nil, nil
+	}
+	var ids []string
+	if err := s.DB(ctx).Table(s.Table()).Pluck("snippet_id", &ids).Error; err != nil {
+		return nil, fmt.Errorf("list snippet ids: %w", err)
+	}
+	return ids, nil
+}
+
+// Vacuum runs VACUUM ANALYZE on the embedding table to reclaim space left by
+// deleted rows and refresh planner statistics. A no-op if the table hasn't
+// been created yet.
+func (s *PgVectorEmbeddingStore) Vacuum(ctx context.Context) (search.VacuumStats, error) {
+	if !s.tableReady.Load() {
+		return search.VacuumStats{}, nil
+	}
+	return vacuumPgTable(s.DB(ctx), s.Table())
+}
+
+// ensureTable creates the pgvector extension and embedding table if they do
+// not already exist. If the table exists with a different vector dimension
+// it is dropped and recreated, and the onRebuilt callback fires.
+func (s *PgVectorEmbeddingStore) ensureTable(ctx context.Context, dimension int) error {
+	s.tableMu.Lock()
+	defer s.tableMu.Unlock()
+	if s.tableReady.Load() {
+		return nil
+	}
+
+	tableName := s.Table()
+	rawDB := s.DB(ctx)
+
+	if err := rawDB.Exec(pgvecCreateExtension).Error; err != nil {
+		return errors.Join(ErrPgVectorInitializationFailed, fmt.Errorf("create extension: %w", err))
+	}
+
+	createTableSQL := fmt.Sprintf(`
+CREATE TABLE IF NOT EXISTS %s (
+    id SERIAL PRIMARY KEY,
+    snippet_id VARCHAR(255) NOT NULL UNIQUE,
+    embedding VECTOR(%d) NOT NULL
+)`, tableName, dimension)
+
+	if err := rawDB.Exec(createTableSQL).Error; err != nil {
+		return errors.Join(ErrPgVectorInitializationFailed, fmt.Errorf("create table: %w", err))
+	}
+
+	var dbDimension int
+	checkDimensionSQL := fmt.Sprintf(pgvecCheckDimensionTemplate, tableName)
+	result := rawDB.Raw(checkDimensionSQL).Scan(&dbDimension)
+	if result.Error != nil && !errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return errors.Join(ErrPgVectorInitializationFailed, fmt.Errorf("check dimension: %w", result.Error))
+	}
+
+	if result.RowsAffected > 0 && dbDimension != dimension {
+		s.logger.Warn().Str("table", tableName).Int("old_dimension", dbDimension).Int("new_dimension", dimension).Msg("embedding dimension changed, dropping old table for re-indexing")
+
+		dropSQL := fmt.Sprintf("DROP TABLE %s CASCADE", tableName)
+		if err := rawDB.Exec(dropSQL).Error; err != nil {
+			return errors.Join(ErrPgVectorInitializationFailed, fmt.Errorf("drop table: %w", err))
+		}
+		if err := rawDB.Exec(createTableSQL).Error; err != nil {
+			return errors.Join(ErrPgVectorInitializationFailed, fmt.Errorf("recreate table: %w", err))
+		}
+
+		if sqlDB, dbErr := rawDB.DB(); dbErr == nil {
+			sqlDB.SetMaxIdleConns(0)
+			sqlDB.SetMaxIdleConns(10)
+		}
+
+		if s.onRebuilt != nil {
+			s.onRebuilt(ctx)
+		}
+	}
+
+	s.tableReady.Store(true)
+	return nil
+}
+
+// Index persists pre-computed vectors using batched upsert, then ensures
+// the hnsw index exists.
+func (s *PgVectorEmbeddingStore) Index(ctx context.Context, docs []search.Document) error {
+	models := make([]PgEmbeddingModel, 0, len(docs))
+	var dimension int
+	for _, doc := range docs {
+		vec := doc.Vector()
+		if doc.SnippetID() == "" || len(vec) == 0 {
+			continue
+		}
+		if dimension == 0 {
+			dimension = len(vec)
+		}
+		models = append(models, PgEmbeddingModel{
+			SnippetID: doc.SnippetID(),
+			Embedding: database.NewPgVector(vec),
+		})
+	}
+	if len(models) == 0 {
+		return nil
+	}
+	if err := s.ensureTable(ctx, dimension); err != nil {
+		return err
+	}
+
+	tableName := s.Table()
+	db := s.DB(ctx)
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		return tx.Table(tableName).Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "snippet_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"embedding"}),
+		}).CreateInBatches(models, saveAllBatchSize).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.ensureIndex(ctx)
+}
+
+// ensureIndex creates the hnsw index if it doesn't already exist. Unlike
+// vchordrq, hnsw needs no K-means training pass, so it can be created
+// immediately rather than waiting for a row-count-driven lists parameter.
+func (s *PgVectorEmbeddingStore) ensureIndex(ctx context.Context) error {
+	s.indexMu.Lock()
+	defer s.indexMu.Unlock()
+
+	tableName := s.Table()
+	db := s.DB(ctx)
+
+	var method string
+	query := fmt.Sprintf(pgvecCheckIndexTemplate, tableName)
+	result := db.Raw(query).Scan(&method)
+	if result.Error != nil && !errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("check index method: %w", result.Error)
+	}
+	if result.RowsAffected > 0 {
+		return nil
+	}
+
+	indexSQL := fmt.Sprintf(`
+CREATE INDEX IF NOT EXISTS %s_idx
+ON %s
+USING hnsw (embedding vector_cosine_ops)`, tableName, tableName)
+
+	s.logger.Info().Str("table", tableName).Msg("creating hnsw index")
+
+	if err := db.Exec(indexSQL).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil
+		}
+		return fmt.Errorf("create index: %w", err)
+	}
+	return nil
+}
+
+// Ensure PgVectorEmbeddingStore implements search.Store.
+var _ search.Store = (*PgVectorEmbeddingStore)(nil)
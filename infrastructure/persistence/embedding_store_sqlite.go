@@ -21,7 +21,7 @@ type SQLiteEmbeddingStore struct {
 }
 
 // NewSQLiteEmbeddingStore creates a new SQLiteEmbeddingStore.
-func NewSQLiteEmbeddingStore(db database.Database, taskName TaskName, logger zerolog.Logger) (*SQLiteEmbeddingStore, error) {
+func NewSQLiteEmbeddingStore(db database.Database, taskName search.TaskName, logger zerolog.Logger) (*SQLiteEmbeddingStore, error) {
 	tableName := fmt.Sprintf("kodit_%s_embeddings", taskName)
 	s := &SQLiteEmbeddingStore{
 		Repository: database.NewRepositoryForTable[search.Result, SQLiteEmbeddingModel](
@@ -106,6 +106,50 @@ func (s *SQLiteEmbeddingStore) Find(ctx context.Context, opts ...repository.Opti
 	return topKSimilar(queryEmbedding, rows, limit, allowed), nil
 }
 
+// SnippetIDs returns every snippet_id currently stored.
+func (s *SQLiteEmbeddingStore) SnippetIDs(ctx context.Context) ([]string, error) {
+	var ids []string
+	if err := s.DB(ctx).Table(s.Table()).Pluck("snippet_id", &ids).Error; err != nil {
+		return nil, fmt.Errorf("list snippet ids: %w", err)
+	}
+	return ids, nil
+}
+
+// Vacuum runs SQLite's VACUUM command to reclaim space left by deleted rows.
+// VACUUM rebuilds the whole database file, not just this table, since SQLite
+// has no per-table equivalent.
+func (s *SQLiteEmbeddingStore) Vacuum(ctx context.Context) (search.VacuumStats, error) {
+	return vacuumSqliteDB(s.DB(ctx), s.Table())
+}
+
+// TruncateVectors truncates every stored vector to its first dimensions
+// entries and re-normalizes it, in place, updating each row individually.
+// The embedding column is untyped JSON, so this works without a schema
+// change and without re-embedding through the provider.
+func (s *SQLiteEmbeddingStore) TruncateVectors(ctx context.Context, dimensions int) (int, error) {
+	rows, err := s.loadRows(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("load rows: %w", err)
+	}
+
+	tableName := s.Table()
+	db := s.DB(ctx)
+
+	var truncated int
+	for _, row := range rows {
+		if len(row.embedding) <= dimensions {
+			continue
+		}
+		cut := search.Truncate(row.embedding, dimensions)
+		if err := db.Table(tableName).Where("snippet_id = ?", row.snippetID).
+			Update("embedding", Float64Slice(cut)).Error; err != nil {
+			return truncated, fmt.Errorf("truncate snippet %s: %w", row.snippetID, err)
+		}
+		truncated++
+	}
+	return truncated, nil
+}
+
 // loadRows loads embedding rows from the database, applying any search
 // filters via JOINs.
 func (s *SQLiteEmbeddingStore) loadRows(ctx context.Context, opts ...repository.Option) ([]vectorRow, error) {
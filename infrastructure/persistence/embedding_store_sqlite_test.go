@@ -143,7 +143,7 @@ func TestTopKSimilar(t *testing.T) {
 
 func TestSQLiteEmbeddingStore_SaveAllAndSearch(t *testing.T) {
 	db := newTestDB(t)
-	store, err := NewSQLiteEmbeddingStore(db, TaskNameCode, zerolog.Nop())
+	store, err := NewSQLiteEmbeddingStore(db, search.TaskNameCode, zerolog.Nop())
 	require.NoError(t, err)
 	ctx := context.Background()
 
@@ -171,9 +171,48 @@ func TestSQLiteEmbeddingStore_SaveAllAndSearch(t *testing.T) {
 	assert.True(t, ids["snippet3"])
 }
 
+func TestSQLiteEmbeddingStore_TruncateVectors(t *testing.T) {
+	db := newTestDB(t)
+	store, err := NewSQLiteEmbeddingStore(db, search.TaskNameCode, zerolog.Nop())
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	err = store.Index(ctx, []search.Document{
+		search.NewVectorDocument("snippet1", []float64{1.0, 0.5, 0.0, 0.0}),
+		search.NewVectorDocument("snippet2", []float64{0.0, 1.0, 0.5, 0.0}),
+	})
+	require.NoError(t, err)
+
+	truncated, err := store.TruncateVectors(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 2, truncated)
+
+	rows, err := store.loadRows(ctx)
+	require.NoError(t, err)
+	for _, row := range rows {
+		assert.Len(t, row.embedding, 2)
+	}
+}
+
+func TestSQLiteEmbeddingStore_TruncateVectors_AlreadyShortLeftUnchanged(t *testing.T) {
+	db := newTestDB(t)
+	store, err := NewSQLiteEmbeddingStore(db, search.TaskNameCode, zerolog.Nop())
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	err = store.Index(ctx, []search.Document{
+		search.NewVectorDocument("snippet1", []float64{1.0, 0.0}),
+	})
+	require.NoError(t, err)
+
+	truncated, err := store.TruncateVectors(ctx, 4)
+	require.NoError(t, err)
+	assert.Equal(t, 0, truncated)
+}
+
 func TestSQLiteEmbeddingStore_SaveAllEmpty(t *testing.T) {
 	db := newTestDB(t)
-	store, err := NewSQLiteEmbeddingStore(db, TaskNameCode, zerolog.Nop())
+	store, err := NewSQLiteEmbeddingStore(db, search.TaskNameCode, zerolog.Nop())
 	require.NoError(t, err)
 	ctx := context.Background()
 
@@ -183,7 +222,7 @@ func TestSQLiteEmbeddingStore_SaveAllEmpty(t *testing.T) {
 
 func TestSQLiteEmbeddingStore_Search_NoEmbedding(t *testing.T) {
 	db := newTestDB(t)
-	store, err := NewSQLiteEmbeddingStore(db, TaskNameCode, zerolog.Nop())
+	store, err := NewSQLiteEmbeddingStore(db, search.TaskNameCode, zerolog.Nop())
 	require.NoError(t, err)
 	ctx := context.Background()
 
@@ -194,7 +233,7 @@ func TestSQLiteEmbeddingStore_Search_NoEmbedding(t *testing.T) {
 
 func TestSQLiteEmbeddingStore_SaveAllDuplicates(t *testing.T) {
 	db := newTestDB(t)
-	store, err := NewSQLiteEmbeddingStore(db, TaskNameCode, zerolog.Nop())
+	store, err := NewSQLiteEmbeddingStore(db, search.TaskNameCode, zerolog.Nop())
 	require.NoError(t, err)
 	ctx := context.Background()
 
@@ -214,7 +253,7 @@ func TestSQLiteEmbeddingStore_SaveAllDuplicates(t *testing.T) {
 
 func TestSQLiteEmbeddingStore_Exists(t *testing.T) {
 	db := newTestDB(t)
-	store, err := NewSQLiteEmbeddingStore(db, TaskNameCode, zerolog.Nop())
+	store, err := NewSQLiteEmbeddingStore(db, search.TaskNameCode, zerolog.Nop())
 	require.NoError(t, err)
 	ctx := context.Background()
 
@@ -237,7 +276,7 @@ func TestSQLiteEmbeddingStore_Exists(t *testing.T) {
 
 func TestSQLiteEmbeddingStore_DeleteBy(t *testing.T) {
 	db := newTestDB(t)
-	store, err := NewSQLiteEmbeddingStore(db, TaskNameCode, zerolog.Nop())
+	store, err := NewSQLiteEmbeddingStore(db, search.TaskNameCode, zerolog.Nop())
 	require.NoError(t, err)
 	ctx := context.Background()
 
@@ -265,7 +304,7 @@ func TestSQLiteEmbeddingStore_DeleteBy(t *testing.T) {
 
 func TestSQLiteEmbeddingStore_SearchWithFilter(t *testing.T) {
 	db := newTestDB(t)
-	store, err := NewSQLiteEmbeddingStore(db, TaskNameCode, zerolog.Nop())
+	store, err := NewSQLiteEmbeddingStore(db, search.TaskNameCode, zerolog.Nop())
 	require.NoError(t, err)
 	ctx := context.Background()
 
@@ -298,7 +337,7 @@ func TestSQLiteEmbeddingStore_SearchWithFilter(t *testing.T) {
 
 func TestSQLiteEmbeddingStore_Find(t *testing.T) {
 	db := newTestDB(t)
-	store, err := NewSQLiteEmbeddingStore(db, TaskNameCode, zerolog.Nop())
+	store, err := NewSQLiteEmbeddingStore(db, search.TaskNameCode, zerolog.Nop())
 	require.NoError(t, err)
 	ctx := context.Background()
 
@@ -322,6 +361,31 @@ func TestSQLiteEmbeddingStore_Find(t *testing.T) {
 	assert.True(t, ids["snippet2"])
 }
 
+func TestSQLiteEmbeddingStore_Search_DimensionMismatchScoresZero(t *testing.T) {
+	db := newTestDB(t)
+	store, err := NewSQLiteEmbeddingStore(db, search.TaskNameCode, zerolog.Nop())
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	// A stale row left over from a previous embedding model with a
+	// different output dimension shouldn't fail the search — cosineSimilarity
+	// scores mismatched-length vectors as 0 rather than erroring, so it just
+	// sorts to the bottom of the results.
+	err = store.Index(ctx, []search.Document{
+		search.NewVectorDocument("current-model", []float64{1.0, 0.0, 0.0, 0.0}),
+		search.NewVectorDocument("stale-model", []float64{1.0, 0.0}),
+	})
+	require.NoError(t, err)
+
+	results, err := store.Find(ctx, search.WithEmbedding([]float64{1.0, 0.0, 0.0, 0.0}), repository.WithLimit(10))
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, "current-model", results[0].SnippetID())
+	assert.InDelta(t, 1.0, results[0].Score(), 0.001)
+	assert.Equal(t, "stale-model", results[1].SnippetID())
+	assert.Equal(t, 0.0, results[1].Score())
+}
+
 func TestFloat64Slice_ScanValue(t *testing.T) {
 	t.Run("scan from bytes", func(t *testing.T) {
 		var f Float64Slice
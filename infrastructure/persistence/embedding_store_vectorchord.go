@@ -61,7 +61,7 @@ type VectorChordEmbeddingStore struct {
 //
 // onRebuilt is called (at most once) if an existing table had to be dropped
 // and recreated due to a dimension mismatch; pass nil if no action is needed.
-func NewVectorChordEmbeddingStore(db database.Database, taskName TaskName, onRebuilt func(context.Context), logger zerolog.Logger) *VectorChordEmbeddingStore {
+func NewVectorChordEmbeddingStore(db database.Database, taskName search.TaskName, onRebuilt func(context.Context), logger zerolog.Logger) *VectorChordEmbeddingStore {
 	tableName := fmt.Sprintf("vectorchord_%s_embeddings", taskName)
 	s := &VectorChordEmbeddingStore{
 		Repository: database.NewRepositoryForTable[search.Result, PgEmbeddingModel](
@@ -160,6 +160,29 @@ func (s *VectorChordEmbeddingStore) Exists(ctx context.Context, opts ...reposito
 	return s.Repository.Exists(ctx, opts...)
 }
 
+// SnippetIDs returns every snippet_id currently stored, or nil if the table
+// hasn't been created yet.
+func (s *VectorChordEmbeddingStore) SnippetIDs(ctx context.Context) ([]string, error) {
+	if !s.tableReady.Load() {
+		return nil, nil
+	}
+	var ids []string
+	if err := s.DB(ctx).Table(s.Table()).Pluck("snippet_id", &ids).Error; err != nil {
+		return nil, fmt.Errorf("list snippet ids: %w", err)
+	}
+	return ids, nil
+}
+
+// Vacuum runs VACUUM ANALYZE on the embedding table to reclaim space left by
+// deleted rows and refresh planner statistics. A no-op if the table hasn't
+// been created yet.
+func (s *VectorChordEmbeddingStore) Vacuum(ctx context.Context) (search.VacuumStats, error) {
+	if !s.tableReady.Load() {
+		return search.VacuumStats{}, nil
+	}
+	return vacuumPgTable(s.DB(ctx), s.Table())
+}
+
 // ensureTable creates the VectorChord extension and embedding table if they
 // do not already exist. If the table exists with a different vector dimension
 // it is dropped and recreated, and the onRebuilt callback fires.
@@ -9,6 +9,7 @@ import (
 	"github.com/helixml/kodit/domain/repository"
 	"github.com/helixml/kodit/internal/database"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // EnrichmentStore implements enrichment.EnrichmentStore using GORM.
@@ -46,6 +47,37 @@ func (s EnrichmentStore) Save(ctx context.Context, e enrichment.Enrichment) (enr
 	return s.Mapper().ToDomain(model), nil
 }
 
+// SaveAll creates multiple enrichments in a single batched insert, retrying
+// automatically on Postgres serialization failures. Enrichments have no
+// natural business key, so unlike the git entity stores' SaveAll this only
+// ever creates rows — every enrichment must be new (ID zero).
+func (s EnrichmentStore) SaveAll(ctx context.Context, enrichments []enrichment.Enrichment) ([]enrichment.Enrichment, error) {
+	if len(enrichments) == 0 {
+		return []enrichment.Enrichment{}, nil
+	}
+
+	models := make([]EnrichmentModel, len(enrichments))
+	now := time.Now()
+	for i, e := range enrichments {
+		models[i] = s.Mapper().ToModel(e)
+		models[i].CreatedAt = now
+		models[i].UpdatedAt = now
+	}
+
+	err := database.RetrySerializationFailure(func() error {
+		return s.DB(ctx).CreateInBatches(models, saveAllBatchSize).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("save enrichments: %w", err)
+	}
+
+	saved := make([]enrichment.Enrichment, len(models))
+	for i, m := range models {
+		saved[i] = s.Mapper().ToDomain(m)
+	}
+	return saved, nil
+}
+
 // Delete removes an enrichment.
 func (s EnrichmentStore) Delete(ctx context.Context, e enrichment.Enrichment) error {
 	model := s.Mapper().ToModel(e)
@@ -151,6 +183,40 @@ func (s AssociationStore) Save(ctx context.Context, assoc enrichment.Association
 	return s.Mapper().ToDomain(model), nil
 }
 
+// SaveAll upserts multiple associations in a single batched write, retrying
+// automatically on Postgres serialization failures. Associations that
+// already exist (same enrichment, entity type, and entity ID) are left
+// untouched rather than updated, since their columns carry no other state.
+func (s AssociationStore) SaveAll(ctx context.Context, associations []enrichment.Association) ([]enrichment.Association, error) {
+	if len(associations) == 0 {
+		return []enrichment.Association{}, nil
+	}
+
+	models := make([]EnrichmentAssociationModel, len(associations))
+	now := time.Now()
+	for i, a := range associations {
+		models[i] = s.Mapper().ToModel(a)
+		models[i].CreatedAt = now
+		models[i].UpdatedAt = now
+	}
+
+	err := database.RetrySerializationFailure(func() error {
+		return s.DB(ctx).Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "enrichment_id"}, {Name: "entity_type"}, {Name: "entity_id"}},
+			DoNothing: true,
+		}).CreateInBatches(models, saveAllBatchSize).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("save associations: %w", err)
+	}
+
+	saved := make([]enrichment.Association, len(models))
+	for i, m := range models {
+		saved[i] = s.Mapper().ToDomain(m)
+	}
+	return saved, nil
+}
+
 // Delete removes an association.
 func (s AssociationStore) Delete(ctx context.Context, assoc enrichment.Association) error {
 	model := s.Mapper().ToModel(assoc)
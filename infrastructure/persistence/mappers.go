@@ -5,10 +5,21 @@ import (
 	"net/url"
 	"time"
 
+	"github.com/helixml/kodit/domain/audit"
+	"github.com/helixml/kodit/domain/cluster"
+	"github.com/helixml/kodit/domain/curation"
+	"github.com/helixml/kodit/domain/discovery"
 	"github.com/helixml/kodit/domain/enrichment"
+	"github.com/helixml/kodit/domain/job"
+	"github.com/helixml/kodit/domain/prindex"
+	"github.com/helixml/kodit/domain/querylog"
 	"github.com/helixml/kodit/domain/repository"
+	"github.com/helixml/kodit/domain/search"
+	"github.com/helixml/kodit/domain/snapshot"
 	"github.com/helixml/kodit/domain/sourcelocation"
+	"github.com/helixml/kodit/domain/synonym"
 	"github.com/helixml/kodit/domain/task"
+	"github.com/helixml/kodit/domain/usage"
 )
 
 // Tracking type constants.
@@ -45,6 +56,11 @@ func (m RepositoryMapper) ToDomain(e RepositoryModel) repository.Repository {
 		cc = repository.ReconstructChunkingConfig(e.ChunkSize, e.ChunkOverlap, e.MinChunkSize)
 	}
 
+	eb := repository.ReconstructEnrichmentBudgetConfig(e.MaxFileSummaries)
+	ec := repository.ReconstructEmbeddingConfig(e.StripCommentsForEmbedding)
+	ac := repository.ReconstructAccessConfig(e.DenyGlobs)
+	ifc := repository.ReconstructIndexFilterConfig(e.IndexPaths, e.IgnorePaths)
+
 	var pid int64
 	if e.PipelineID != nil {
 		pid = *e.PipelineID
@@ -59,9 +75,17 @@ func (m RepositoryMapper) ToDomain(e RepositoryModel) repository.Repository {
 		wc,
 		tc,
 		cc,
+		eb,
+		ec,
+		ac,
+		ifc,
 		e.CreatedAt,
 		e.UpdatedAt,
 		lastSyncedAt,
+		e.EnrichmentLanguage,
+		e.TrackingAutoDetected,
+		e.AutoRepairTracking,
+		e.Archived,
 	)
 }
 
@@ -92,20 +116,29 @@ func (m RepositoryMapper) ToModel(r repository.Repository) RepositoryModel {
 	pid := r.PipelineID()
 
 	return RepositoryModel{
-		ID:                 r.ID(),
-		PipelineID:         &pid,
-		SanitizedRemoteURI: sanitizeURI(r.RemoteURL()),
-		RemoteURI:          r.RemoteURL(),
-		UpstreamURL:        upstreamURL,
-		ClonedPath:         clonedPath,
-		LastScannedAt:      lastScannedAt,
-		TrackingType:       trackingType,
-		TrackingName:       trackingName,
-		ChunkSize:          r.ChunkingConfig().Size(),
-		ChunkOverlap:       r.ChunkingConfig().Overlap(),
-		MinChunkSize:       r.ChunkingConfig().MinSize(),
-		CreatedAt:          r.CreatedAt(),
-		UpdatedAt:          r.UpdatedAt(),
+		ID:                        r.ID(),
+		PipelineID:                &pid,
+		SanitizedRemoteURI:        sanitizeURI(r.RemoteURL()),
+		RemoteURI:                 r.RemoteURL(),
+		UpstreamURL:               upstreamURL,
+		ClonedPath:                clonedPath,
+		LastScannedAt:             lastScannedAt,
+		TrackingType:              trackingType,
+		TrackingName:              trackingName,
+		ChunkSize:                 r.ChunkingConfig().Size(),
+		ChunkOverlap:              r.ChunkingConfig().Overlap(),
+		MinChunkSize:              r.ChunkingConfig().MinSize(),
+		MaxFileSummaries:          r.EnrichmentBudget().MaxFileSummaries(),
+		StripCommentsForEmbedding: r.EmbeddingConfig().StripComments(),
+		DenyGlobs:                 r.AccessConfig().DenyGlobs(),
+		IndexPaths:                r.IndexFilterConfig().IndexPaths(),
+		IgnorePaths:               r.IndexFilterConfig().IgnorePaths(),
+		EnrichmentLanguage:        r.EnrichmentLanguage(),
+		TrackingAutoDetected:      r.TrackingAutoDetected(),
+		AutoRepairTracking:        r.AutoRepairTracking(),
+		Archived:                  r.Archived(),
+		CreatedAt:                 r.CreatedAt(),
+		UpdatedAt:                 r.UpdatedAt(),
 	}
 }
 
@@ -363,6 +396,9 @@ func (m EnrichmentMapper) ToDomain(e EnrichmentModel) enrichment.Enrichment {
 		enrichment.EntityTypeCommit, // Default - actual entity type comes from associations
 		e.Content,
 		e.Language,
+		e.Flagged,
+		e.FlagReason,
+		enrichment.NewSnippetMetrics(e.CyclomaticComplexity, e.NestingDepth, e.LineCount),
 		e.CreatedAt,
 		e.UpdatedAt,
 	)
@@ -370,14 +406,20 @@ func (m EnrichmentMapper) ToDomain(e EnrichmentModel) enrichment.Enrichment {
 
 // ToModel converts a domain Enrichment to an EnrichmentModel.
 func (m EnrichmentMapper) ToModel(e enrichment.Enrichment) EnrichmentModel {
+	metrics := e.Metrics()
 	return EnrichmentModel{
-		ID:        e.ID(),
-		Type:      string(e.Type()),
-		Subtype:   string(e.Subtype()),
-		Content:   e.Content(),
-		Language:  e.Language(),
-		CreatedAt: e.CreatedAt(),
-		UpdatedAt: e.UpdatedAt(),
+		ID:                   e.ID(),
+		Type:                 string(e.Type()),
+		Subtype:              string(e.Subtype()),
+		Content:              e.Content(),
+		Language:             e.Language(),
+		Flagged:              e.Flagged(),
+		FlagReason:           e.FlagReason(),
+		CyclomaticComplexity: metrics.CyclomaticComplexity(),
+		NestingDepth:         metrics.NestingDepth(),
+		LineCount:            metrics.LineCount(),
+		CreatedAt:            e.CreatedAt(),
+		UpdatedAt:            e.UpdatedAt(),
 	}
 }
 
@@ -386,7 +428,7 @@ type SourceLocationMapper struct{}
 
 // ToDomain converts a SourceLocationModel to a domain SourceLocation.
 func (m SourceLocationMapper) ToDomain(e SourceLocationModel) sourcelocation.SourceLocation {
-	return sourcelocation.Reconstruct(e.ID, e.EnrichmentID, e.Page, e.StartLine, e.EndLine)
+	return sourcelocation.Reconstruct(e.ID, e.EnrichmentID, e.Page, e.StartLine, e.EndLine, e.Anchor)
 }
 
 // ToModel converts a domain SourceLocation to a SourceLocationModel.
@@ -397,6 +439,238 @@ func (m SourceLocationMapper) ToModel(s sourcelocation.SourceLocation) SourceLoc
 		Page:         s.Page(),
 		StartLine:    s.StartLine(),
 		EndLine:      s.EndLine(),
+		Anchor:       s.Anchor(),
+	}
+}
+
+// UsageMapper maps between domain ProviderUsage and persistence ProviderUsageModel.
+type UsageMapper struct{}
+
+// ToDomain converts a ProviderUsageModel to a domain ProviderUsage.
+func (m UsageMapper) ToDomain(u ProviderUsageModel) usage.ProviderUsage {
+	return usage.Reconstruct(
+		u.ID,
+		u.RepositoryID,
+		usage.Operation(u.Operation),
+		u.Model,
+		u.PromptHash,
+		u.PromptTokens,
+		u.CompletionTokens,
+		u.TotalTokens,
+		u.LatencyMS,
+		u.CostEstimate,
+		u.CreatedAt,
+	)
+}
+
+// ToModel converts a domain ProviderUsage to a ProviderUsageModel.
+func (m UsageMapper) ToModel(u usage.ProviderUsage) ProviderUsageModel {
+	return ProviderUsageModel{
+		ID:               u.ID(),
+		RepositoryID:     u.RepositoryID(),
+		Operation:        string(u.Operation()),
+		Model:            u.Model(),
+		PromptHash:       u.PromptHash(),
+		PromptTokens:     u.PromptTokens(),
+		CompletionTokens: u.CompletionTokens(),
+		TotalTokens:      u.TotalTokens(),
+		LatencyMS:        u.LatencyMS(),
+		CostEstimate:     u.CostEstimate(),
+		CreatedAt:        u.CreatedAt(),
+	}
+}
+
+// QueryLogMapper maps between domain QueryLog and persistence QueryLogModel.
+type QueryLogMapper struct{}
+
+// ToDomain converts a QueryLogModel to a domain QueryLog.
+func (m QueryLogMapper) ToDomain(q QueryLogModel) querylog.QueryLog {
+	return querylog.Reconstruct(q.ID, q.RepositoryID, q.Query, q.CreatedAt)
+}
+
+// ToModel converts a domain QueryLog to a QueryLogModel.
+func (m QueryLogMapper) ToModel(q querylog.QueryLog) QueryLogModel {
+	return QueryLogModel{
+		ID:           q.ID(),
+		RepositoryID: q.RepositoryID(),
+		Query:        q.Query(),
+		CreatedAt:    q.CreatedAt(),
+	}
+}
+
+// AuditMapper maps between domain audit.Event and persistence AuditEventModel.
+type AuditMapper struct{}
+
+// ToDomain converts an AuditEventModel to a domain audit.Event.
+func (m AuditMapper) ToDomain(e AuditEventModel) audit.Event {
+	return audit.Reconstruct(e.ID, e.RepositoryID, e.Path, e.Reason, e.CreatedAt)
+}
+
+// ToModel converts a domain audit.Event to an AuditEventModel.
+func (m AuditMapper) ToModel(e audit.Event) AuditEventModel {
+	return AuditEventModel{
+		ID:           e.ID(),
+		RepositoryID: e.RepositoryID(),
+		Path:         e.Path(),
+		Reason:       e.Reason(),
+		CreatedAt:    e.CreatedAt(),
+	}
+}
+
+// PRIndexMapper maps between domain prindex.PRIndex and persistence PRIndexModel.
+type PRIndexMapper struct{}
+
+// ToDomain converts a PRIndexModel to a domain prindex.PRIndex.
+func (m PRIndexMapper) ToDomain(p PRIndexModel) prindex.PRIndex {
+	return prindex.Reconstruct(p.ID, p.RepoID, p.Ref, p.HeadCommitSHA, p.CreatedAt, p.ExpiresAt)
+}
+
+// ToModel converts a domain prindex.PRIndex to a PRIndexModel.
+func (m PRIndexMapper) ToModel(p prindex.PRIndex) PRIndexModel {
+	return PRIndexModel{
+		ID:            p.ID(),
+		RepoID:        p.RepoID(),
+		Ref:           p.Ref(),
+		HeadCommitSHA: p.HeadCommitSHA(),
+		CreatedAt:     p.CreatedAt(),
+		ExpiresAt:     p.ExpiresAt(),
+	}
+}
+
+// SynonymMapper maps between domain Synonym and persistence SynonymModel.
+type SynonymMapper struct{}
+
+// ToDomain converts a SynonymModel to a domain Synonym.
+func (m SynonymMapper) ToDomain(s SynonymModel) synonym.Synonym {
+	return synonym.Reconstruct(s.ID, s.Namespace, s.Term, s.Aliases, s.CreatedAt, s.UpdatedAt)
+}
+
+// ToModel converts a domain Synonym to a SynonymModel.
+func (m SynonymMapper) ToModel(s synonym.Synonym) SynonymModel {
+	return SynonymModel{
+		ID:        s.ID(),
+		Namespace: s.Namespace(),
+		Term:      s.Term(),
+		Aliases:   s.Aliases(),
+		CreatedAt: s.CreatedAt(),
+		UpdatedAt: s.UpdatedAt(),
+	}
+}
+
+// CurationRuleMapper maps between domain curation.Rule and persistence
+// CurationRuleModel.
+type CurationRuleMapper struct{}
+
+// ToDomain converts a CurationRuleModel to a domain curation.Rule.
+func (m CurationRuleMapper) ToDomain(c CurationRuleModel) curation.Rule {
+	return curation.Reconstruct(c.ID, c.Pattern, c.SnippetID, curation.Action(c.Action), c.Weight, c.CreatedAt, c.UpdatedAt)
+}
+
+// ToModel converts a domain curation.Rule to a CurationRuleModel.
+func (m CurationRuleMapper) ToModel(c curation.Rule) CurationRuleModel {
+	return CurationRuleModel{
+		ID:        c.ID(),
+		Pattern:   c.Pattern(),
+		SnippetID: c.SnippetID(),
+		Action:    string(c.Action()),
+		Weight:    c.Weight(),
+		CreatedAt: c.CreatedAt(),
+		UpdatedAt: c.UpdatedAt(),
+	}
+}
+
+// DiscoveryCandidateMapper maps between domain discovery.Candidate and
+// persistence DiscoveryCandidateModel.
+type DiscoveryCandidateMapper struct{}
+
+// ToDomain converts a DiscoveryCandidateModel to a domain discovery.Candidate.
+func (m DiscoveryCandidateMapper) ToDomain(d DiscoveryCandidateModel) discovery.Candidate {
+	return discovery.Reconstruct(d.ID, d.Org, d.Name, d.RemoteURL, d.Topics, d.Language, d.MatchedPattern, discovery.Status(d.Status), d.CreatedAt, d.UpdatedAt)
+}
+
+// ToModel converts a domain discovery.Candidate to a DiscoveryCandidateModel.
+func (m DiscoveryCandidateMapper) ToModel(d discovery.Candidate) DiscoveryCandidateModel {
+	return DiscoveryCandidateModel{
+		ID:             d.ID(),
+		Org:            d.Org(),
+		Name:           d.Name(),
+		RemoteURL:      d.RemoteURL(),
+		Topics:         d.Topics(),
+		Language:       d.Language(),
+		MatchedPattern: d.MatchedPattern(),
+		Status:         string(d.Status()),
+		CreatedAt:      d.CreatedAt(),
+		UpdatedAt:      d.UpdatedAt(),
+	}
+}
+
+// WorkerInstanceMapper maps between domain cluster.Worker and persistence
+// WorkerInstanceModel.
+type WorkerInstanceMapper struct{}
+
+// ToDomain converts a WorkerInstanceModel to a domain cluster.Worker.
+func (m WorkerInstanceMapper) ToDomain(w WorkerInstanceModel) cluster.Worker {
+	return cluster.Reconstruct(w.ID, w.Hostname, w.PID, w.StartedAt, w.LastHeartbeat, w.LeasedTaskID, w.LeasedOperation, w.ProcessedCount)
+}
+
+// ToModel converts a domain cluster.Worker to a WorkerInstanceModel.
+func (m WorkerInstanceMapper) ToModel(w cluster.Worker) WorkerInstanceModel {
+	return WorkerInstanceModel{
+		ID:              w.ID(),
+		Hostname:        w.Hostname(),
+		PID:             w.PID(),
+		StartedAt:       w.StartedAt(),
+		LastHeartbeat:   w.LastHeartbeat(),
+		LeasedTaskID:    w.LeasedTaskID(),
+		LeasedOperation: w.LeasedOperation(),
+		ProcessedCount:  w.ProcessedCount(),
+	}
+}
+
+// SnapshotMapper maps between domain Snapshot and persistence SnapshotModel.
+type SnapshotMapper struct{}
+
+// ToDomain converts a SnapshotModel to a domain Snapshot.
+func (m SnapshotMapper) ToDomain(s SnapshotModel) snapshot.Snapshot {
+	return snapshot.Reconstruct(s.ID, s.Label, s.Tables, s.Rows, s.Data, s.CreatedAt)
+}
+
+// ToModel converts a domain Snapshot to a SnapshotModel.
+func (m SnapshotMapper) ToModel(s snapshot.Snapshot) SnapshotModel {
+	return SnapshotModel{
+		ID:        s.ID(),
+		Label:     s.Label(),
+		Tables:    s.Tables(),
+		Rows:      s.Rows(),
+		Data:      s.Data(),
+		CreatedAt: s.CreatedAt(),
+	}
+}
+
+// BulkJobMapper maps between domain Job and persistence BulkJobModel.
+type BulkJobMapper struct{}
+
+// ToDomain converts a BulkJobModel to a domain Job.
+func (m BulkJobMapper) ToDomain(b BulkJobModel) job.Job {
+	return job.Reconstruct(b.ID, job.Kind(b.Kind), b.Total, b.Completed, b.Failed, b.ErrorMessage, b.CreatedAt, b.UpdatedAt)
+}
+
+// ToModel converts a domain Job to a BulkJobModel.
+func (m BulkJobMapper) ToModel(j job.Job) BulkJobModel {
+	now := time.Now()
+	createdAt := j.CreatedAt()
+	if createdAt.IsZero() {
+		createdAt = now
+	}
+	return BulkJobModel{
+		ID:           j.ID(),
+		Kind:         string(j.Kind()),
+		Total:        j.Total(),
+		Completed:    j.Completed(),
+		Failed:       j.Failed(),
+		ErrorMessage: j.Error(),
+		CreatedAt:    createdAt,
+		UpdatedAt:    now,
 	}
 }
 
@@ -426,6 +700,33 @@ func (m AssociationMapper) ToModel(a enrichment.Association) EnrichmentAssociati
 	}
 }
 
+// EmbeddingStatusMapper maps between domain EmbeddingStatus and persistence EmbeddingStatusModel.
+type EmbeddingStatusMapper struct{}
+
+// ToDomain converts an EmbeddingStatusModel to a domain EmbeddingStatus.
+func (m EmbeddingStatusMapper) ToDomain(e EmbeddingStatusModel) search.EmbeddingStatus {
+	return search.NewEmbeddingStatusWithID(
+		e.ID,
+		e.SnippetID,
+		search.TaskName(e.TaskName),
+		search.EmbeddingStatusState(e.State),
+		e.Error,
+		e.UpdatedAt,
+	)
+}
+
+// ToModel converts a domain EmbeddingStatus to an EmbeddingStatusModel.
+func (m EmbeddingStatusMapper) ToModel(s search.EmbeddingStatus) EmbeddingStatusModel {
+	return EmbeddingStatusModel{
+		ID:        s.ID(),
+		SnippetID: s.SnippetID(),
+		TaskName:  string(s.TaskName()),
+		State:     string(s.State()),
+		Error:     s.Error(),
+		UpdatedAt: s.UpdatedAt(),
+	}
+}
+
 // TaskMapper maps between domain Task and persistence TaskModel.
 type TaskMapper struct{}
 
@@ -1,32 +1,77 @@
 package persistence
 
 import (
+	"database/sql/driver"
 	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/helixml/kodit/infrastructure/persistence/models"
 )
 
+// StringSlice is a custom type for JSON serialization of []string. GORM has
+// no built-in way to Scan a JSON text/blob column into a plain []string, so
+// any field storing one must use this type instead (mirrors Float64Slice in
+// embedding_store.go).
+type StringSlice []string
+
+// Scan implements sql.Scanner for reading JSON from the database.
+func (s *StringSlice) Scan(value any) error {
+	if value == nil {
+		*s = nil
+		return nil
+	}
+
+	var data []byte
+	switch v := value.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("cannot scan %T into StringSlice", value)
+	}
+
+	return json.Unmarshal(data, s)
+}
+
+// Value implements driver.Valuer for writing JSON to the database.
+func (s StringSlice) Value() (driver.Value, error) {
+	if s == nil {
+		return nil, nil
+	}
+	return json.Marshal(s)
+}
+
 // RepositoryModel represents a Git repository in the database.
 type RepositoryModel struct {
-	ID                 int64           `gorm:"primaryKey;autoIncrement"`
-	PipelineID         *int64          `gorm:"column:pipeline_id;index"`
-	Pipeline           models.Pipeline `gorm:"foreignKey:PipelineID;constraint:OnDelete:SET NULL"`
-	SanitizedRemoteURI string          `gorm:"column:sanitized_remote_uri;index;uniqueIndex;size:1024"`
-	RemoteURI          string          `gorm:"column:remote_uri;size:1024"`
-	UpstreamURL        *string         `gorm:"column:upstream_url;index;size:1024"`
-	ClonedPath         *string         `gorm:"column:cloned_path;size:1024"`
-	LastScannedAt      *time.Time      `gorm:"column:last_scanned_at"`
-	NumCommits         int             `gorm:"column:num_commits;default:0"`
-	NumBranches        int             `gorm:"column:num_branches;default:0"`
-	NumTags            int             `gorm:"column:num_tags;default:0"`
-	TrackingType       string          `gorm:"column:tracking_type;index;size:255"`
-	TrackingName       string          `gorm:"column:tracking_name;index;size:255"`
-	ChunkSize          int             `gorm:"column:chunk_size"`
-	ChunkOverlap       int             `gorm:"column:chunk_overlap"`
-	MinChunkSize       int             `gorm:"column:min_chunk_size"`
-	CreatedAt          time.Time       `gorm:"column:created_at"`
-	UpdatedAt          time.Time       `gorm:"column:updated_at"`
+	ID                        int64           `gorm:"primaryKey;autoIncrement"`
+	PipelineID                *int64          `gorm:"column:pipeline_id;index"`
+	Pipeline                  models.Pipeline `gorm:"foreignKey:PipelineID;constraint:OnDelete:SET NULL"`
+	SanitizedRemoteURI        string          `gorm:"column:sanitized_remote_uri;index;uniqueIndex;size:1024"`
+	RemoteURI                 string          `gorm:"column:remote_uri;size:1024"`
+	UpstreamURL               *string         `gorm:"column:upstream_url;index;size:1024"`
+	ClonedPath                *string         `gorm:"column:cloned_path;size:1024"`
+	LastScannedAt             *time.Time      `gorm:"column:last_scanned_at"`
+	NumCommits                int             `gorm:"column:num_commits;default:0"`
+	NumBranches               int             `gorm:"column:num_branches;default:0"`
+	NumTags                   int             `gorm:"column:num_tags;default:0"`
+	TrackingType              string          `gorm:"column:tracking_type;index;size:255"`
+	TrackingName              string          `gorm:"column:tracking_name;index;size:255"`
+	ChunkSize                 int             `gorm:"column:chunk_size"`
+	ChunkOverlap              int             `gorm:"column:chunk_overlap"`
+	MinChunkSize              int             `gorm:"column:min_chunk_size"`
+	MaxFileSummaries          int             `gorm:"column:max_file_summaries;default:0"`
+	StripCommentsForEmbedding bool            `gorm:"column:strip_comments_for_embedding;default:false"`
+	DenyGlobs                 StringSlice     `gorm:"column:deny_globs;type:json"`
+	IndexPaths                StringSlice     `gorm:"column:index_paths;type:json"`
+	IgnorePaths               StringSlice     `gorm:"column:ignore_paths;type:json"`
+	EnrichmentLanguage        string          `gorm:"column:enrichment_language;size:64"`
+	TrackingAutoDetected      bool            `gorm:"column:tracking_auto_detected;default:false"`
+	AutoRepairTracking        bool            `gorm:"column:auto_repair_tracking;default:false"`
+	Archived                  bool            `gorm:"column:archived;index;default:false"`
+	CreatedAt                 time.Time       `gorm:"column:created_at"`
+	UpdatedAt                 time.Time       `gorm:"column:updated_at"`
 }
 
 // TableName returns the table name.
@@ -107,11 +152,19 @@ func (FileModel) TableName() string {
 
 // EnrichmentModel represents an enrichment in the database.
 type EnrichmentModel struct {
-	ID        int64     `gorm:"column:id;primaryKey;autoIncrement"`
-	Type      string    `gorm:"column:type;not null;index"`
-	Subtype   string    `gorm:"column:subtype;not null;index"`
-	Content   string    `gorm:"column:content;type:text;not null"`
-	Language  string    `gorm:"column:language;size:50"`
+	ID         int64  `gorm:"column:id;primaryKey;autoIncrement"`
+	Type       string `gorm:"column:type;not null;index"`
+	Subtype    string `gorm:"column:subtype;not null;index"`
+	Content    string `gorm:"column:content;type:text;not null"`
+	Language   string `gorm:"column:language;size:50"`
+	Flagged    bool   `gorm:"column:flagged;not null;default:false;index"`
+	FlagReason string `gorm:"column:flag_reason;size:255"`
+
+	// Readability and complexity metrics, computed for snippet enrichments only.
+	CyclomaticComplexity int `gorm:"column:cyclomatic_complexity;default:0"`
+	NestingDepth         int `gorm:"column:nesting_depth;default:0"`
+	LineCount            int `gorm:"column:line_count;default:0"`
+
 	CreatedAt time.Time `gorm:"column:created_at;not null"`
 	UpdatedAt time.Time `gorm:"column:updated_at;not null"`
 }
@@ -147,11 +200,164 @@ type SourceLocationModel struct {
 	Page         int             `gorm:"column:page;not null;default:0"`
 	StartLine    int             `gorm:"column:start_line;not null"`
 	EndLine      int             `gorm:"column:end_line;not null"`
+	Anchor       string          `gorm:"column:anchor;not null;default:''"`
 }
 
 // TableName returns the table name.
 func (SourceLocationModel) TableName() string { return "source_locations" }
 
+// ProviderUsageModel records one provider (LLM or embedding) call for cost
+// attribution.
+type ProviderUsageModel struct {
+	ID               int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	RepositoryID     string    `gorm:"column:repository_id;size:64;index"`
+	Operation        string    `gorm:"column:operation;size:50;not null;index"`
+	Model            string    `gorm:"column:model;size:255;not null;index"`
+	PromptHash       string    `gorm:"column:prompt_hash;size:64"`
+	PromptTokens     int       `gorm:"column:prompt_tokens;not null;default:0"`
+	CompletionTokens int       `gorm:"column:completion_tokens;not null;default:0"`
+	TotalTokens      int       `gorm:"column:total_tokens;not null;default:0"`
+	LatencyMS        int64     `gorm:"column:latency_ms;not null;default:0"`
+	CostEstimate     float64   `gorm:"column:cost_estimate;not null;default:0"`
+	CreatedAt        time.Time `gorm:"column:created_at;not null;index"`
+}
+
+// TableName returns the table name.
+func (ProviderUsageModel) TableName() string { return "provider_usages" }
+
+// QueryLogModel records one search query as it was issued.
+type QueryLogModel struct {
+	ID           int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	RepositoryID string    `gorm:"column:repository_id;size:64;index"`
+	Query        string    `gorm:"column:query;size:2048;not null"`
+	CreatedAt    time.Time `gorm:"column:created_at;not null;index"`
+}
+
+// TableName returns the table name.
+func (QueryLogModel) TableName() string { return "query_logs" }
+
+// AuditEventModel records one denied access attempt (e.g. a repository path
+// ACL blocking a file read).
+type AuditEventModel struct {
+	ID           int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	RepositoryID string    `gorm:"column:repository_id;size:64;index"`
+	Path         string    `gorm:"column:path;size:1024;not null"`
+	Reason       string    `gorm:"column:reason;size:255;not null"`
+	CreatedAt    time.Time `gorm:"column:created_at;not null;index"`
+}
+
+// TableName returns the table name.
+func (AuditEventModel) TableName() string { return "audit_events" }
+
+// PRIndexModel records one ephemeral index of a pull request branch,
+// overlaying its base repository's index until its TTL expires.
+type PRIndexModel struct {
+	ID            int64           `gorm:"column:id;primaryKey;autoIncrement"`
+	RepoID        int64           `gorm:"column:repo_id;index;uniqueIndex:idx_pr_index_repo_ref"`
+	Repo          RepositoryModel `gorm:"foreignKey:RepoID;references:ID;constraint:OnDelete:CASCADE"`
+	Ref           string          `gorm:"column:ref;size:255;index;uniqueIndex:idx_pr_index_repo_ref"`
+	HeadCommitSHA string          `gorm:"column:head_commit_sha;size:64"`
+	CreatedAt     time.Time       `gorm:"column:created_at;not null"`
+	ExpiresAt     time.Time       `gorm:"column:expires_at;not null;index"`
+}
+
+// TableName returns the table name.
+func (PRIndexModel) TableName() string { return "pr_indexes" }
+
+// SynonymModel records one term-to-aliases mapping within a namespace.
+type SynonymModel struct {
+	ID        int64       `gorm:"column:id;primaryKey;autoIncrement"`
+	Namespace string      `gorm:"column:namespace;size:128;not null;index"`
+	Term      string      `gorm:"column:term;size:255;not null;index"`
+	Aliases   StringSlice `gorm:"column:aliases;type:json"`
+	CreatedAt time.Time   `gorm:"column:created_at;not null"`
+	UpdatedAt time.Time   `gorm:"column:updated_at;not null"`
+}
+
+// TableName returns the table name.
+func (SynonymModel) TableName() string { return "synonyms" }
+
+// CurationRuleModel records one maintainer-authored ranking rule that pins,
+// boosts, or buries a snippet for queries matching a pattern.
+type CurationRuleModel struct {
+	ID        int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	Pattern   string    `gorm:"column:pattern;size:255;not null;index"`
+	SnippetID string    `gorm:"column:snippet_id;size:64;not null;index"`
+	Action    string    `gorm:"column:action;size:16;not null"`
+	Weight    float64   `gorm:"column:weight;not null"`
+	CreatedAt time.Time `gorm:"column:created_at;not null"`
+	UpdatedAt time.Time `gorm:"column:updated_at;not null"`
+}
+
+// TableName returns the table name.
+func (CurationRuleModel) TableName() string { return "curation_rules" }
+
+// DiscoveryCandidateModel records one repository surfaced by an inbound
+// discovery webhook event that matched a configured name pattern but was
+// not auto-registered, awaiting maintainer review.
+type DiscoveryCandidateModel struct {
+	ID             int64       `gorm:"column:id;primaryKey;autoIncrement"`
+	Org            string      `gorm:"column:org;size:255;not null;index"`
+	Name           string      `gorm:"column:name;size:255;not null;index"`
+	RemoteURL      string      `gorm:"column:remote_url;size:1024;not null"`
+	Topics         StringSlice `gorm:"column:topics;type:json"`
+	Language       string      `gorm:"column:language;size:64"`
+	MatchedPattern string      `gorm:"column:matched_pattern;size:255"`
+	Status         string      `gorm:"column:status;size:16;not null;index"`
+	CreatedAt      time.Time   `gorm:"column:created_at;not null"`
+	UpdatedAt      time.Time   `gorm:"column:updated_at;not null"`
+}
+
+// TableName returns the table name.
+func (DiscoveryCandidateModel) TableName() string { return "discovery_candidates" }
+
+// WorkerInstanceModel records one registered queue worker process: where it
+// is running, when it last reported itself alive, the task it currently has
+// leased (if any), and how many tasks it has processed since it started.
+type WorkerInstanceModel struct {
+	ID              int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	Hostname        string    `gorm:"column:hostname;size:255;not null"`
+	PID             int       `gorm:"column:pid;not null"`
+	StartedAt       time.Time `gorm:"column:started_at;not null"`
+	LastHeartbeat   time.Time `gorm:"column:last_heartbeat;not null;index"`
+	LeasedTaskID    int64     `gorm:"column:leased_task_id;not null;default:0"`
+	LeasedOperation string    `gorm:"column:leased_operation;size:255"`
+	ProcessedCount  int64     `gorm:"column:processed_count;not null;default:0"`
+}
+
+// TableName returns the table name.
+func (WorkerInstanceModel) TableName() string { return "worker_instances" }
+
+// SnapshotModel records metadata and captured data for a point-in-time
+// copy of the corpus index tables.
+type SnapshotModel struct {
+	ID        int64           `gorm:"column:id;primaryKey;autoIncrement"`
+	Label     string          `gorm:"column:label;size:255;not null"`
+	Tables    int             `gorm:"column:tables;not null;default:0"`
+	Rows      int             `gorm:"column:rows;not null;default:0"`
+	Data      json.RawMessage `gorm:"column:data;type:jsonb"`
+	CreatedAt time.Time       `gorm:"column:created_at;not null;index"`
+}
+
+// TableName returns the table name.
+func (SnapshotModel) TableName() string { return "snapshots" }
+
+// BulkJobModel tracks the aggregate progress of an admin bulk operation
+// fanned out across many repositories.
+type BulkJobModel struct {
+	ID           int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	Kind         string    `gorm:"column:kind;size:255;not null"`
+	Total        int       `gorm:"column:total;not null;default:0"`
+	Completed    int       `gorm:"column:completed;not null;default:0"`
+	Failed       int       `gorm:"column:failed;not null;default:0"`
+	ErrorMessage string    `gorm:"column:error_message;type:text"`
+	CreatedAt    time.Time `gorm:"column:created_at;not null;index"`
+	UpdatedAt    time.Time `gorm:"column:updated_at;not null"`
+}
+
+// TableName returns the table name.
+func (BulkJobModel) TableName() string { return "bulk_jobs" }
+
 // EmbeddingModel represents a vector embedding in the database.
 type EmbeddingModel struct {
 	ID        int64     `gorm:"column:id;primaryKey;autoIncrement"`
@@ -167,6 +373,22 @@ func (EmbeddingModel) TableName() string {
 	return "embeddings"
 }
 
+// EmbeddingStatusModel represents the outcome of embedding a single snippet
+// for a given task (code, text, or vision) in the database.
+type EmbeddingStatusModel struct {
+	ID        int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	SnippetID string    `gorm:"column:snippet_id;uniqueIndex:idx_embedding_status_snippet_task;size:255"`
+	TaskName  string    `gorm:"column:task_name;uniqueIndex:idx_embedding_status_snippet_task;size:255"`
+	State     string    `gorm:"column:state;index;size:255"`
+	Error     string    `gorm:"column:error;type:text"`
+	UpdatedAt time.Time `gorm:"column:updated_at"`
+}
+
+// TableName returns the table name.
+func (EmbeddingStatusModel) TableName() string {
+	return "embedding_statuses"
+}
+
 // TaskModel represents a task in the database.
 type TaskModel struct {
 	ID        int64           `gorm:"column:id;primaryKey;autoIncrement"`
@@ -0,0 +1,18 @@
+package persistence
+
+import (
+	"github.com/helixml/kodit/domain/prindex"
+	"github.com/helixml/kodit/internal/database"
+)
+
+// PRIndexStore implements prindex.Store using GORM.
+type PRIndexStore struct {
+	database.Repository[prindex.PRIndex, PRIndexModel]
+}
+
+// NewPRIndexStore creates a new PRIndexStore.
+func NewPRIndexStore(db database.Database) PRIndexStore {
+	return PRIndexStore{
+		Repository: database.NewRepository[prindex.PRIndex, PRIndexModel](db, PRIndexMapper{}, "pr_index"),
+	}
+}
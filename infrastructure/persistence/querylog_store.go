@@ -0,0 +1,18 @@
+package persistence
+
+import (
+	"github.com/helixml/kodit/domain/querylog"
+	"github.com/helixml/kodit/internal/database"
+)
+
+// QueryLogStore implements querylog.Store using GORM.
+type QueryLogStore struct {
+	database.Repository[querylog.QueryLog, QueryLogModel]
+}
+
+// NewQueryLogStore creates a new QueryLogStore.
+func NewQueryLogStore(db database.Database) QueryLogStore {
+	return QueryLogStore{
+		Repository: database.NewRepository[querylog.QueryLog, QueryLogModel](db, QueryLogMapper{}, "query_log"),
+	}
+}
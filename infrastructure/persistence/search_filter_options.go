@@ -3,6 +3,7 @@ package persistence
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/rs/zerolog"
 
@@ -10,6 +11,66 @@ import (
 	"github.com/helixml/kodit/domain/search"
 )
 
+// bm25CandidatePoolOverfetch widens a BM25 candidate pool beyond the caller's
+// requested limit so normalizeByLanguage has enough rows per language to
+// re-rank fairly before truncating back down to limit.
+const bm25CandidatePoolOverfetch = 4
+
+// bm25MaxCandidatePool bounds how large a candidate pool bm25CandidatePoolSize
+// will request, keeping worst-case query cost predictable for large limits.
+const bm25MaxCandidatePool = 200
+
+// bm25CandidatePoolSize returns how many rows a BM25 store should fetch
+// before per-language normalization, given the caller's requested limit.
+func bm25CandidatePoolSize(limit int) int {
+	pool := limit * bm25CandidatePoolOverfetch
+	if pool > bm25MaxCandidatePool {
+		pool = bm25MaxCandidatePool
+	}
+	if pool < limit {
+		pool = limit
+	}
+	return pool
+}
+
+// normalizeByLanguage centers each row's raw BM25 score against the mean
+// score of its own language, then re-ranks by the adjusted score and
+// truncates to limit. This keeps one language family's IDF statistics from
+// skewing another's when both are indexed together — a document only
+// competes against its own language's score distribution.
+func normalizeByLanguage[T any](rows []T, limit int, extract func(T) (snippetID, language string, rawScore float64)) []search.Result {
+	type scored struct {
+		snippetID string
+		language  string
+		raw       float64
+	}
+
+	entries := make([]scored, len(rows))
+	sums := make(map[string]float64, len(rows))
+	counts := make(map[string]int, len(rows))
+	for i, row := range rows {
+		id, lang, raw := extract(row)
+		entries[i] = scored{snippetID: id, language: lang, raw: raw}
+		sums[lang] += raw
+		counts[lang]++
+	}
+
+	results := make([]search.Result, len(entries))
+	for i, e := range entries {
+		mean := sums[e.language] / float64(counts[e.language])
+		results[i] = search.NewResult(e.snippetID, e.raw-mean)
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Score() > results[j].Score()
+	})
+
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	return results
+}
+
 // filterJoinOptions translates search.Filters into base repository options
 // (JOINs + WHEREs) so that callers can compose them with their other Find
 // options. Each search store invokes this from its Find override, passing
@@ -26,7 +87,8 @@ func filterJoinOptions(filters search.Filters, castType string) []repository.Opt
 
 	needEnrichmentJoin := len(filters.Languages()) > 0 ||
 		len(filters.EnrichmentTypes()) > 0 ||
-		len(filters.EnrichmentSubtypes()) > 0
+		len(filters.EnrichmentSubtypes()) > 0 ||
+		len(filters.ExcludeKeywords()) > 0
 
 	if needEnrichmentJoin {
 		opts = append(opts, repository.WithJoin(fmt.Sprintf(
@@ -40,6 +102,9 @@ func filterJoinOptions(filters search.Filters, castType string) []repository.Opt
 		if subtypes := filters.EnrichmentSubtypes(); len(subtypes) > 0 {
 			opts = append(opts, repository.WithWhere("enrichments_v2.subtype IN ?", subtypes))
 		}
+		for _, kw := range filters.ExcludeKeywords() {
+			opts = append(opts, repository.WithWhere("LOWER(enrichments_v2.content) NOT LIKE LOWER(?)", "%"+kw+"%"))
+		}
 	}
 
 	if shas := filters.CommitSHAs(); len(shas) > 0 {
@@ -60,6 +125,36 @@ func filterJoinOptions(filters search.Filters, castType string) []repository.Opt
 		)
 	}
 
+	if excludedRepos := filters.ExcludeRepoIDs(); len(excludedRepos) > 0 {
+		opts = append(opts,
+			repository.WithJoin(fmt.Sprintf(
+				"LEFT JOIN enrichment_associations ea_exrepo ON ea_exrepo.enrichment_id = CAST(snippet_id AS %s) AND ea_exrepo.entity_type = 'git_commits'", castType)),
+			repository.WithJoin("LEFT JOIN git_commits gc_exrepo ON gc_exrepo.commit_sha = ea_exrepo.entity_id"),
+			repository.WithWhere("gc_exrepo.repo_id IS NULL OR gc_exrepo.repo_id NOT IN ?", excludedRepos),
+		)
+	}
+
+	if ref := filters.PRRef(); ref != "" {
+		opts = append(opts,
+			repository.WithJoin(fmt.Sprintf(
+				"JOIN enrichment_associations ea_pr ON ea_pr.enrichment_id = CAST(snippet_id AS %s)", castType)),
+			repository.WithWhere("ea_pr.entity_type = ?", "pr_refs"),
+			repository.WithWhere("ea_pr.entity_id = ?", ref),
+		)
+	}
+
+	if prefixes := filters.ExcludePathPrefixes(); len(prefixes) > 0 {
+		opts = append(opts,
+			repository.WithJoin(fmt.Sprintf(
+				"LEFT JOIN enrichment_associations ea_path ON ea_path.enrichment_id = CAST(snippet_id AS %s) AND ea_path.entity_type = 'git_commit_files'", castType)),
+			repository.WithJoin(fmt.Sprintf(
+				"LEFT JOIN git_commit_files gcf_path ON gcf_path.id = CAST(ea_path.entity_id AS %s)", castType)),
+		)
+		for _, prefix := range prefixes {
+			opts = append(opts, repository.WithWhere("gcf_path.path IS NULL OR gcf_path.path NOT LIKE ?", prefix+"%"))
+		}
+	}
+
 	return opts
 }
 
@@ -0,0 +1,98 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/helixml/kodit/domain/snapshot"
+	"github.com/helixml/kodit/internal/database"
+)
+
+// snapshotTables lists the corpus index tables captured by a snapshot, in
+// an order that respects their foreign keys so Restore can safely clear
+// and repopulate them. Queue state (tasks, task_status), pipeline
+// definitions, and usage analytics are intentionally excluded — a
+// snapshot rolls back indexed corpus data, not operational state.
+var snapshotTables = []string{
+	"git_repos",
+	"git_branches",
+	"git_tags",
+	"git_commits",
+	"git_commit_files",
+	"enrichments_v2",
+	"enrichment_associations",
+	"embeddings",
+	"source_locations",
+}
+
+// SnapshotArchiver implements snapshot.Archiver using raw GORM table
+// scans, so capturing and restoring the index doesn't require an external
+// pg_dump/pg_restore or filesystem backup.
+type SnapshotArchiver struct {
+	db database.Database
+}
+
+// NewSnapshotArchiver creates a new SnapshotArchiver.
+func NewSnapshotArchiver(db database.Database) SnapshotArchiver {
+	return SnapshotArchiver{db: db}
+}
+
+// Ensure SnapshotArchiver implements snapshot.Archiver.
+var _ snapshot.Archiver = SnapshotArchiver{}
+
+// Dump serializes every snapshot table to JSON, keyed by table name.
+func (a SnapshotArchiver) Dump(ctx context.Context) ([]byte, int, int, error) {
+	dump := make(map[string][]map[string]any, len(snapshotTables))
+	rows := 0
+
+	for _, table := range snapshotTables {
+		var tableRows []map[string]any
+		if err := a.db.GORM().WithContext(ctx).Table(table).Find(&tableRows).Error; err != nil {
+			return nil, 0, 0, fmt.Errorf("dump table %s: %w", table, err)
+		}
+		dump[table] = tableRows
+		rows += len(tableRows)
+	}
+
+	data, err := json.Marshal(dump)
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("marshal snapshot: %w", err)
+	}
+
+	return data, len(snapshotTables), rows, nil
+}
+
+// Restore replaces the contents of every snapshot table with the tables
+// and rows recorded in data, as previously returned by Dump. Tables are
+// cleared in reverse dependency order and repopulated in dependency
+// order, inside a single write.
+func (a SnapshotArchiver) Restore(ctx context.Context, data []byte) error {
+	var dump map[string][]map[string]any
+	if err := json.Unmarshal(data, &dump); err != nil {
+		return fmt.Errorf("unmarshal snapshot: %w", err)
+	}
+
+	return a.db.Write(func() error {
+		gdb := a.db.GORM().WithContext(ctx)
+
+		for i := len(snapshotTables) - 1; i >= 0; i-- {
+			table := snapshotTables[i]
+			if err := gdb.Exec(fmt.Sprintf("DELETE FROM %s", table)).Error; err != nil {
+				return fmt.Errorf("clear table %s: %w", table, err)
+			}
+		}
+
+		for _, table := range snapshotTables {
+			rows := dump[table]
+			if len(rows) == 0 {
+				continue
+			}
+			if err := gdb.Table(table).Create(rows).Error; err != nil {
+				return fmt.Errorf("restore table %s: %w", table, err)
+			}
+		}
+
+		return nil
+	})
+}
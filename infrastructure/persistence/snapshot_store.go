@@ -0,0 +1,18 @@
+package persistence
+
+import (
+	"github.com/helixml/kodit/domain/snapshot"
+	"github.com/helixml/kodit/internal/database"
+)
+
+// SnapshotStore implements snapshot.Store using GORM.
+type SnapshotStore struct {
+	database.Repository[snapshot.Snapshot, SnapshotModel]
+}
+
+// NewSnapshotStore creates a new SnapshotStore.
+func NewSnapshotStore(db database.Database) SnapshotStore {
+	return SnapshotStore{
+		Repository: database.NewRepository[snapshot.Snapshot, SnapshotModel](db, SnapshotMapper{}, "snapshot"),
+	}
+}
@@ -0,0 +1,18 @@
+package persistence
+
+import (
+	"github.com/helixml/kodit/domain/synonym"
+	"github.com/helixml/kodit/internal/database"
+)
+
+// SynonymStore implements synonym.Store using GORM.
+type SynonymStore struct {
+	database.Repository[synonym.Synonym, SynonymModel]
+}
+
+// NewSynonymStore creates a new SynonymStore.
+func NewSynonymStore(db database.Database) SynonymStore {
+	return SynonymStore{
+		Repository: database.NewRepository[synonym.Synonym, SynonymModel](db, SynonymMapper{}, "synonym"),
+	}
+}
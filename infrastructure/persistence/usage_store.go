@@ -0,0 +1,18 @@
+package persistence
+
+import (
+	"github.com/helixml/kodit/domain/usage"
+	"github.com/helixml/kodit/internal/database"
+)
+
+// UsageStore implements usage.Store using GORM.
+type UsageStore struct {
+	database.Repository[usage.ProviderUsage, ProviderUsageModel]
+}
+
+// NewUsageStore creates a new UsageStore.
+func NewUsageStore(db database.Database) UsageStore {
+	return UsageStore{
+		Repository: database.NewRepository[usage.ProviderUsage, ProviderUsageModel](db, UsageMapper{}, "provider_usage"),
+	}
+}
@@ -0,0 +1,90 @@
+package persistence
+
+import (
+	"fmt"
+
+	"github.com/helixml/kodit/domain/search"
+	"gorm.io/gorm"
+)
+
+// vacuumPgTable runs VACUUM ANALYZE on a Postgres table, shared by the
+// VectorChord and pgvector embedding stores. VACUUM ANALYZE reclaims space
+// for reuse by the table and refreshes planner statistics; it does not
+// shrink the file on disk the way VACUUM FULL would, which requires an
+// exclusive lock and is unsuitable for a background maintenance task.
+func vacuumPgTable(db *gorm.DB, table string) (search.VacuumStats, error) {
+	before, err := pgTableSizeBytes(db, table)
+	if err != nil {
+		return search.VacuumStats{}, err
+	}
+
+	if err := db.Exec(fmt.Sprintf("VACUUM ANALYZE %s", table)).Error; err != nil {
+		return search.VacuumStats{}, fmt.Errorf("vacuum table: %w", err)
+	}
+
+	after, err := pgTableSizeBytes(db, table)
+	if err != nil {
+		return search.VacuumStats{}, err
+	}
+
+	var rows int64
+	if err := db.Table(table).Count(&rows).Error; err != nil {
+		return search.VacuumStats{}, fmt.Errorf("count rows: %w", err)
+	}
+
+	return search.VacuumStats{
+		RowsRemaining:  rows,
+		ReclaimedBytes: max(before-after, 0),
+	}, nil
+}
+
+// pgTableSizeBytes returns the total on-disk size of a table, including indexes.
+func pgTableSizeBytes(db *gorm.DB, table string) (int64, error) {
+	var bytes int64
+	if err := db.Raw("SELECT pg_total_relation_size(?)", table).Scan(&bytes).Error; err != nil {
+		return 0, fmt.Errorf("measure table size: %w", err)
+	}
+	return bytes, nil
+}
+
+// vacuumSqliteDB runs SQLite's VACUUM command, which rebuilds the entire
+// database file to reclaim space left by deleted rows — SQLite has no
+// per-table equivalent. RowsRemaining is still reported per-table so callers
+// can log a meaningful count for the store being compacted.
+func vacuumSqliteDB(db *gorm.DB, table string) (search.VacuumStats, error) {
+	before, err := sqliteFileSizeBytes(db)
+	if err != nil {
+		return search.VacuumStats{}, err
+	}
+
+	if err := db.Exec("VACUUM").Error; err != nil {
+		return search.VacuumStats{}, fmt.Errorf("vacuum database: %w", err)
+	}
+
+	after, err := sqliteFileSizeBytes(db)
+	if err != nil {
+		return search.VacuumStats{}, err
+	}
+
+	var rows int64
+	if err := db.Table(table).Count(&rows).Error; err != nil {
+		return search.VacuumStats{}, fmt.Errorf("count rows: %w", err)
+	}
+
+	return search.VacuumStats{
+		RowsRemaining:  rows,
+		ReclaimedBytes: max(before-after, 0),
+	}, nil
+}
+
+// sqliteFileSizeBytes returns the size of the SQLite database file.
+func sqliteFileSizeBytes(db *gorm.DB) (int64, error) {
+	var pageCount, pageSize int64
+	if err := db.Raw("PRAGMA page_count").Scan(&pageCount).Error; err != nil {
+		return 0, fmt.Errorf("read page_count: %w", err)
+	}
+	if err := db.Raw("PRAGMA page_size").Scan(&pageSize).Error; err != nil {
+		return 0, fmt.Errorf("read page_size: %w", err)
+	}
+	return pageCount * pageSize, nil
+}
@@ -0,0 +1,18 @@
+package persistence
+
+import (
+	"github.com/helixml/kodit/domain/cluster"
+	"github.com/helixml/kodit/internal/database"
+)
+
+// WorkerInstanceStore persists registered queue worker instances.
+type WorkerInstanceStore struct {
+	database.Repository[cluster.Worker, WorkerInstanceModel]
+}
+
+// NewWorkerInstanceStore creates a new WorkerInstanceStore.
+func NewWorkerInstanceStore(db database.Database) WorkerInstanceStore {
+	return WorkerInstanceStore{
+		Repository: database.NewRepository[cluster.Worker, WorkerInstanceModel](db, WorkerInstanceMapper{}, "worker_instance"),
+	}
+}
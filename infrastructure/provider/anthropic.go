@@ -136,6 +136,9 @@ func NewAnthropicProviderFromConfig(cfg AnthropicConfig) *AnthropicProvider {
 	}
 }
 
+// ChatModelName returns the model used for chat completions.
+func (p *AnthropicProvider) ChatModelName() string { return p.model }
+
 // SupportsTextGeneration returns true.
 func (p *AnthropicProvider) SupportsTextGeneration() bool {
 	return true
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"net/http"
+	"time"
+
+	openai "github.com/sashabaranov/go-openai"
+)
+
+// defaultAzureAPIVersion is used when AzureOpenAIConfig.APIVersion is unset.
+const defaultAzureAPIVersion = "2024-06-01"
+
+// AzureOpenAIConfig holds configuration for the Azure OpenAI provider. Azure
+// OpenAI speaks the same wire protocol as OpenAI, so this only needs the
+// handful of fields Azure adds on top of OpenAIConfig: an api-version query
+// parameter and per-deployment model routing.
+type AzureOpenAIConfig struct {
+	OpenAIConfig
+
+	// APIVersion is the Azure OpenAI REST API version (default: "2024-06-01").
+	APIVersion string
+}
+
+// NewAzureOpenAIProviderFromConfig creates an OpenAIProvider configured to
+// call an Azure OpenAI resource. Azure OpenAI is wire-compatible with OpenAI
+// once the client is pointed at the resource's endpoint with the api-version
+// query parameter and api-key header set, so this reuses OpenAIProvider
+// rather than duplicating its chat/embedding logic.
+func NewAzureOpenAIProviderFromConfig(cfg AzureOpenAIConfig) *OpenAIProvider {
+	apiVersion := cfg.APIVersion
+	if apiVersion == "" {
+		apiVersion = defaultAzureAPIVersion
+	}
+
+	config := openai.DefaultAzureConfig(cfg.APIKey, cfg.BaseURL)
+	config.APIVersion = apiVersion
+
+	if cfg.HTTPClient != nil {
+		config.HTTPClient = cfg.HTTPClient
+	} else if cfg.Timeout > 0 {
+		config.HTTPClient = &http.Client{Timeout: cfg.Timeout}
+	}
+
+	client := openai.NewClientWithConfig(config)
+
+	chatModel := cfg.ChatModel
+	embeddingModel := cfg.EmbeddingModel
+	if embeddingModel == "" {
+		embeddingModel = "text-embedding-3-small"
+	}
+
+	maxRetries := cfg.MaxRetries
+	if maxRetries == 0 {
+		maxRetries = 5
+	}
+
+	initialDelay := cfg.InitialDelay
+	if initialDelay == 0 {
+		initialDelay = 2 * time.Second
+	}
+
+	backoffFactor := cfg.BackoffFactor
+	if backoffFactor == 0 {
+		backoffFactor = 2.0
+	}
+
+	return &OpenAIProvider{
+		client:              client,
+		chatModel:           chatModel,
+		embeddingModel:      embeddingModel,
+		maxRetries:          maxRetries,
+		initialDelay:        initialDelay,
+		backoffFactor:       backoffFactor,
+		extraParams:         cfg.ExtraParams,
+		queryInstruction:    cfg.QueryInstruction,
+		documentInstruction: cfg.DocumentInstruction,
+		supportsText:        chatModel != "",
+		supportsEmbedding:   true,
+	}
+}
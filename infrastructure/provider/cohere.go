@@ -0,0 +1,262 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/helixml/kodit/domain/search"
+)
+
+// CohereProvider implements embedding generation using the Cohere Embed API.
+// Cohere does not provide chat completions in the same request shape as
+// OpenAI/Anthropic, so this provider only supports embedding.
+type CohereProvider struct {
+	apiKey        string
+	baseURL       string
+	model         string
+	maxRetries    int
+	initialDelay  time.Duration
+	backoffFactor float64
+	httpClient    *http.Client
+}
+
+// CohereConfig holds configuration for the Cohere provider.
+type CohereConfig struct {
+	APIKey        string
+	BaseURL       string
+	Model         string
+	Timeout       time.Duration
+	MaxRetries    int
+	InitialDelay  time.Duration
+	BackoffFactor float64
+}
+
+// NewCohereProviderFromConfig creates a provider from configuration.
+func NewCohereProviderFromConfig(cfg CohereConfig) *CohereProvider {
+	baseURL := cfg.BaseURL
+	if baseURL == "" {
+		baseURL = "https://api.cohere.com"
+	}
+
+	model := cfg.Model
+	if model == "" {
+		model = "embed-english-v3.0"
+	}
+
+	timeout := cfg.Timeout
+	if timeout == 0 {
+		timeout = 60 * time.Second
+	}
+
+	maxRetries := cfg.MaxRetries
+	if maxRetries == 0 {
+		maxRetries = 5
+	}
+
+	initialDelay := cfg.InitialDelay
+	if initialDelay == 0 {
+		initialDelay = 2 * time.Second
+	}
+
+	backoffFactor := cfg.BackoffFactor
+	if backoffFactor == 0 {
+		backoffFactor = 2.0
+	}
+
+	return &CohereProvider{
+		apiKey:        cfg.APIKey,
+		baseURL:       baseURL,
+		model:         model,
+		maxRetries:    maxRetries,
+		initialDelay:  initialDelay,
+		backoffFactor: backoffFactor,
+		httpClient: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+// EmbeddingModelName returns the model used for embeddings.
+func (p *CohereProvider) EmbeddingModelName() string { return p.model }
+
+// SupportsTextGeneration returns false (Cohere's chat API is not wired up here).
+func (p *CohereProvider) SupportsTextGeneration() bool { return false }
+
+// SupportsEmbedding returns true.
+func (p *CohereProvider) SupportsEmbedding() bool { return true }
+
+// Close is a no-op for the Cohere provider.
+func (p *CohereProvider) Close() error { return nil }
+
+// cohereEmbedRequest represents the Cohere Embed API request body.
+type cohereEmbedRequest struct {
+	Model     string   `json:"model"`
+	Texts     []string `json:"texts"`
+	InputType string   `json:"input_type"`
+}
+
+// cohereEmbedResponse represents the Cohere Embed API response.
+type cohereEmbedResponse struct {
+	Embeddings [][]float64 `json:"embeddings"`
+}
+
+// cohereErrorResponse represents a Cohere API error response.
+type cohereErrorResponse struct {
+	Message string `json:"message"`
+}
+
+// Embed generates embeddings for the given text items in a single API call.
+// Items without a text payload return an error — Cohere's Embed endpoint
+// does not accept image inputs.
+func (p *CohereProvider) Embed(ctx context.Context, items []search.EmbeddingItem) ([][]float64, error) {
+	if len(items) == 0 {
+		return [][]float64{}, nil
+	}
+
+	texts := make([]string, len(items))
+	for i, item := range items {
+		if !item.HasText() {
+			return nil, fmt.Errorf("cohere embedding requires text, got item %d with no text", i)
+		}
+		texts[i] = string(item.Text())
+	}
+
+	// A batch is either all queries or all documents — the two are never mixed.
+	inputType := "search_document"
+	if len(items) > 0 && items[0].IsQuery() {
+		inputType = "search_query"
+	}
+
+	apiReq := cohereEmbedRequest{
+		Model:     p.model,
+		Texts:     texts,
+		InputType: inputType,
+	}
+
+	var resp cohereEmbedResponse
+	var err error
+
+	err = p.withRetry(ctx, func() error {
+		resp, err = p.doRequest(ctx, apiReq)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Embeddings) != len(texts) {
+		return nil, NewProviderError("embedding", 0, fmt.Sprintf("got %d vectors for %d texts", len(resp.Embeddings), len(texts)), nil)
+	}
+
+	return resp.Embeddings, nil
+}
+
+// doRequest performs the HTTP request to the Cohere Embed API.
+func (p *CohereProvider) doRequest(ctx context.Context, req cohereEmbedRequest) (cohereEmbedResponse, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return cohereEmbedResponse{}, NewProviderError("embedding", 0, "failed to marshal request", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, p.baseURL+"/v1/embed", bytes.NewReader(body))
+	if err != nil {
+		return cohereEmbedResponse{}, NewProviderError("embedding", 0, "failed to create request", err)
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+p.apiKey)
+
+	resp, err := p.httpClient.Do(httpReq)
+	if err != nil {
+		return cohereEmbedResponse{}, NewProviderError("embedding", 0, "request failed", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return cohereEmbedResponse{}, NewProviderError("embedding", resp.StatusCode, "failed to read response", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var apiErr cohereErrorResponse
+		if err := json.Unmarshal(respBody, &apiErr); err == nil && apiErr.Message != "" {
+			return cohereEmbedResponse{}, NewProviderError("embedding", resp.StatusCode, apiErr.Message, nil)
+		}
+		return cohereEmbedResponse{}, NewProviderError("embedding", resp.StatusCode, string(respBody), nil)
+	}
+
+	var apiResp cohereEmbedResponse
+	if err := json.Unmarshal(respBody, &apiResp); err != nil {
+		return cohereEmbedResponse{}, NewProviderError("embedding", 0, "failed to unmarshal response", err)
+	}
+
+	return apiResp, nil
+}
+
+// withRetry executes the function with exponential backoff retry.
+func (p *CohereProvider) withRetry(ctx context.Context, fn func() error) error {
+	delay := p.initialDelay
+	var lastErr error
+
+	for attempt := 0; attempt <= p.maxRetries; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		lastErr = fn()
+		if lastErr == nil {
+			return nil
+		}
+
+		if !p.isRetryable(lastErr) {
+			return lastErr
+		}
+
+		if attempt < p.maxRetries {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(delay):
+				delay = time.Duration(float64(delay) * p.backoffFactor)
+			}
+		}
+	}
+
+	return fmt.Errorf("max retries exceeded: %w", lastErr)
+}
+
+// isRetryable determines if an error should be retried.
+func (p *CohereProvider) isRetryable(err error) bool {
+	var provErr *ProviderError
+	if !errors.As(err, &provErr) {
+		return false
+	}
+
+	switch provErr.StatusCode() {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+
+	if provErr.cause != nil {
+		var netErr net.Error
+		if errors.As(provErr.cause, &netErr) && netErr.Timeout() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Ensure CohereProvider implements the embedding interface.
+var _ search.Embedder = (*CohereProvider)(nil)
@@ -0,0 +1,310 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+)
+
+// GeminiProvider implements text generation using the Google Gemini API.
+// Note: this provider only supports text generation; embeddings are served
+// through the OpenAI-compatible providers instead.
+type GeminiProvider struct {
+	apiKey        string
+	baseURL       string
+	model         string
+	maxRetries    int
+	initialDelay  time.Duration
+	backoffFactor float64
+	httpClient    *http.Client
+}
+
+// GeminiConfig holds configuration for the Gemini provider.
+type GeminiConfig struct {
+	APIKey        string
+	BaseURL       string
+	Model         string
+	Timeout       time.Duration
+	MaxRetries    int
+	InitialDelay  time.Duration
+	BackoffFactor float64
+}
+
+// NewGeminiProviderFromConfig creates a provider from configuration.
+func NewGeminiProviderFromConfig(cfg GeminiConfig) *GeminiProvider {
+	baseURL := cfg.BaseURL
+	if baseURL == "" {
+		baseURL = "https://generativelanguage.googleapis.com"
+	}
+
+	model := cfg.Model
+	if model == "" {
+		model = "gemini-2.0-flash"
+	}
+
+	timeout := cfg.Timeout
+	if timeout == 0 {
+		timeout = 60 * time.Second
+	}
+
+	maxRetries := cfg.MaxRetries
+	if maxRetries == 0 {
+		maxRetries = 5
+	}
+
+	initialDelay := cfg.InitialDelay
+	if initialDelay == 0 {
+		initialDelay = 2 * time.Second
+	}
+
+	backoffFactor := cfg.BackoffFactor
+	if backoffFactor == 0 {
+		backoffFactor = 2.0
+	}
+
+	return &GeminiProvider{
+		apiKey:        cfg.APIKey,
+		baseURL:       baseURL,
+		model:         model,
+		maxRetries:    maxRetries,
+		initialDelay:  initialDelay,
+		backoffFactor: backoffFactor,
+		httpClient: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+// ChatModelName returns the model used for chat completions.
+func (p *GeminiProvider) ChatModelName() string { return p.model }
+
+// SupportsTextGeneration returns true.
+func (p *GeminiProvider) SupportsTextGeneration() bool { return true }
+
+// SupportsEmbedding returns false (embeddings are served by the OpenAI-compatible providers).
+func (p *GeminiProvider) SupportsEmbedding() bool { return false }
+
+// Close is a no-op for the Gemini provider.
+func (p *GeminiProvider) Close() error { return nil }
+
+// geminiRequest represents the Gemini generateContent request body.
+type geminiRequest struct {
+	Contents          []geminiContent        `json:"contents"`
+	SystemInstruction *geminiContent         `json:"systemInstruction,omitempty"`
+	GenerationConfig  geminiGenerationConfig `json:"generationConfig"`
+}
+
+// geminiContent represents a single turn of content in the Gemini API.
+type geminiContent struct {
+	Role  string       `json:"role,omitempty"`
+	Parts []geminiPart `json:"parts"`
+}
+
+// geminiPart represents a single content part.
+type geminiPart struct {
+	Text string `json:"text"`
+}
+
+// geminiGenerationConfig configures the generation call.
+type geminiGenerationConfig struct {
+	MaxOutputTokens int     `json:"maxOutputTokens,omitempty"`
+	Temperature     float64 `json:"temperature,omitempty"`
+}
+
+// geminiResponse represents the Gemini generateContent response.
+type geminiResponse struct {
+	Candidates []geminiCandidate `json:"candidates"`
+	UsageMeta  geminiUsageMeta   `json:"usageMetadata"`
+}
+
+// geminiCandidate represents a single generated candidate.
+type geminiCandidate struct {
+	Content      geminiContent `json:"content"`
+	FinishReason string        `json:"finishReason"`
+}
+
+// geminiUsageMeta represents token usage in the response.
+type geminiUsageMeta struct {
+	PromptTokenCount     int `json:"promptTokenCount"`
+	CandidatesTokenCount int `json:"candidatesTokenCount"`
+	TotalTokenCount      int `json:"totalTokenCount"`
+}
+
+// geminiError represents a Gemini API error response.
+type geminiError struct {
+	Error struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// ChatCompletion generates a chat completion using Gemini. Gemini uses "user"
+// and "model" roles rather than "user"/"assistant", so assistant messages are
+// remapped; a leading system message is passed via systemInstruction.
+func (p *GeminiProvider) ChatCompletion(ctx context.Context, req ChatCompletionRequest) (ChatCompletionResponse, error) {
+	messages := req.Messages()
+	if len(messages) == 0 {
+		return ChatCompletionResponse{}, NewProviderError("chat_completion", 0, "no messages provided", nil)
+	}
+
+	var systemInstruction *geminiContent
+	var contents []geminiContent
+
+	for _, m := range messages {
+		switch m.Role() {
+		case "system":
+			systemInstruction = &geminiContent{Parts: []geminiPart{{Text: m.Content()}}}
+		case "assistant":
+			contents = append(contents, geminiContent{Role: "model", Parts: []geminiPart{{Text: m.Content()}}})
+		default:
+			contents = append(contents, geminiContent{Role: "user", Parts: []geminiPart{{Text: m.Content()}}})
+		}
+	}
+
+	apiReq := geminiRequest{
+		Contents:          contents,
+		SystemInstruction: systemInstruction,
+		GenerationConfig: geminiGenerationConfig{
+			MaxOutputTokens: req.MaxTokens(),
+			Temperature:     req.Temperature(),
+		},
+	}
+
+	var resp geminiResponse
+	var err error
+
+	err = p.withRetry(ctx, func() error {
+		resp, err = p.doRequest(ctx, apiReq)
+		return err
+	})
+
+	if err != nil {
+		return ChatCompletionResponse{}, err
+	}
+
+	if len(resp.Candidates) == 0 {
+		return ChatCompletionResponse{}, NewProviderError("chat_completion", 0, "no candidates returned", nil)
+	}
+
+	var content string
+	for _, part := range resp.Candidates[0].Content.Parts {
+		content += part.Text
+	}
+
+	usage := NewUsage(
+		resp.UsageMeta.PromptTokenCount,
+		resp.UsageMeta.CandidatesTokenCount,
+		resp.UsageMeta.TotalTokenCount,
+	)
+
+	return NewChatCompletionResponse(content, resp.Candidates[0].FinishReason, usage), nil
+}
+
+// doRequest performs the HTTP request to the Gemini generateContent endpoint.
+func (p *GeminiProvider) doRequest(ctx context.Context, req geminiRequest) (geminiResponse, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return geminiResponse{}, NewProviderError("chat_completion", 0, "failed to marshal request", err)
+	}
+
+	url := fmt.Sprintf("%s/v1beta/models/%s:generateContent", p.baseURL, p.model)
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return geminiResponse{}, NewProviderError("chat_completion", 0, "failed to create request", err)
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("x-goog-api-key", p.apiKey)
+
+	resp, err := p.httpClient.Do(httpReq)
+	if err != nil {
+		return geminiResponse{}, NewProviderError("chat_completion", 0, "request failed", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return geminiResponse{}, NewProviderError("chat_completion", resp.StatusCode, "failed to read response", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var apiErr geminiError
+		if err := json.Unmarshal(respBody, &apiErr); err == nil && apiErr.Error.Message != "" {
+			return geminiResponse{}, NewProviderError("chat_completion", resp.StatusCode, apiErr.Error.Message, nil)
+		}
+		return geminiResponse{}, NewProviderError("chat_completion", resp.StatusCode, string(respBody), nil)
+	}
+
+	var apiResp geminiResponse
+	if err := json.Unmarshal(respBody, &apiResp); err != nil {
+		return geminiResponse{}, NewProviderError("chat_completion", 0, "failed to unmarshal response", err)
+	}
+
+	return apiResp, nil
+}
+
+// withRetry executes the function with exponential backoff retry.
+func (p *GeminiProvider) withRetry(ctx context.Context, fn func() error) error {
+	delay := p.initialDelay
+	var lastErr error
+
+	for attempt := 0; attempt <= p.maxRetries; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		lastErr = fn()
+		if lastErr == nil {
+			return nil
+		}
+
+		if !p.isRetryable(lastErr) {
+			return lastErr
+		}
+
+		if attempt < p.maxRetries {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(delay):
+				delay = time.Duration(float64(delay) * p.backoffFactor)
+			}
+		}
+	}
+
+	return fmt.Errorf("max retries exceeded: %w", lastErr)
+}
+
+// isRetryable determines if an error should be retried.
+func (p *GeminiProvider) isRetryable(err error) bool {
+	var provErr *ProviderError
+	if !errors.As(err, &provErr) {
+		return false
+	}
+
+	switch provErr.StatusCode() {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+
+	if provErr.cause != nil {
+		var netErr net.Error
+		if errors.As(provErr.cause, &netErr) && netErr.Timeout() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Ensure GeminiProvider implements the text generation interface.
+var _ TextGenerator = (*GeminiProvider)(nil)
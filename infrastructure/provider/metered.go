@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/helixml/kodit/domain/search"
+	"github.com/helixml/kodit/domain/usage"
+)
+
+// chatModelNamed is implemented by providers that can report which model
+// serves their chat completions. Providers that don't implement it are
+// recorded with an empty model name.
+type chatModelNamed interface {
+	ChatModelName() string
+}
+
+// embeddingModelNamed is implemented by providers that can report which
+// model serves their embeddings.
+type embeddingModelNamed interface {
+	EmbeddingModelName() string
+}
+
+// MeteredGenerator wraps a TextGenerator and records a usage.ProviderUsage
+// for every call, attributing it to the repository set via
+// usage.ContextWithRepositoryID on the call's context, if any.
+type MeteredGenerator struct {
+	generator TextGenerator
+	store     usage.Store
+	operation usage.Operation
+	model     string
+	budget    usage.Budget
+	logger    zerolog.Logger
+}
+
+// NewMeteredGenerator creates a new MeteredGenerator.
+func NewMeteredGenerator(generator TextGenerator, store usage.Store, operation usage.Operation, logger zerolog.Logger) *MeteredGenerator {
+	model := ""
+	if named, ok := generator.(chatModelNamed); ok {
+		model = named.ChatModelName()
+	}
+	return &MeteredGenerator{
+		generator: generator,
+		store:     store,
+		operation: operation,
+		model:     model,
+		logger:    logger,
+	}
+}
+
+// WithBudget returns a copy of g that refuses calls once today's recorded
+// spend for its operation reaches budget's limits, returning
+// usage.ErrBudgetExceeded instead of calling the wrapped generator. Pass the
+// zero usage.Budget (the default) to leave the operation unenforced.
+func (g *MeteredGenerator) WithBudget(budget usage.Budget) *MeteredGenerator {
+	clone := *g
+	clone.budget = budget
+	return &clone
+}
+
+// ChatModelName returns the wrapped generator's model name, or "" if it
+// doesn't report one.
+func (g *MeteredGenerator) ChatModelName() string { return g.model }
+
+// ChatCompletion delegates to the wrapped generator and records its usage.
+func (g *MeteredGenerator) ChatCompletion(ctx context.Context, req ChatCompletionRequest) (ChatCompletionResponse, error) {
+	if g.budget.Enforced() {
+		if err := checkBudget(ctx, g.store, g.operation, g.budget); err != nil {
+			return ChatCompletionResponse{}, err
+		}
+	}
+
+	start := time.Now()
+	resp, err := g.generator.ChatCompletion(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+
+	u := resp.Usage()
+	record := usage.New(
+		usage.RepositoryIDFromContext(ctx),
+		g.operation,
+		g.model,
+		promptHash(req.Messages()),
+		u.PromptTokens(),
+		u.CompletionTokens(),
+		u.TotalTokens(),
+		time.Since(start).Milliseconds(),
+		usage.EstimateCost(g.model, u.PromptTokens(), u.CompletionTokens()),
+	)
+	if _, saveErr := g.store.Save(ctx, record); saveErr != nil {
+		g.logger.Warn().Err(saveErr).Msg("failed to record provider usage")
+	}
+
+	return resp, nil
+}
+
+// checkBudget returns usage.ErrBudgetExceeded if budget's daily limits for
+// operation have already been reached by today's recorded spend.
+func checkBudget(ctx context.Context, store usage.Store, operation usage.Operation, budget usage.Budget) error {
+	records, err := store.Find(ctx, usage.WithOperation(operation), usage.WithCreatedAfter(usage.StartOfDayUTC()))
+	if err != nil {
+		return fmt.Errorf("check provider budget: %w", err)
+	}
+	tokens, cost := usage.Sum(records)
+	if budget.Exceeded(tokens, cost) {
+		return usage.ErrBudgetExceeded
+	}
+	return nil
+}
+
+// promptHash hashes the message contents, so prompts can be deduplicated
+// and audited without retaining their (possibly sensitive) text.
+func promptHash(messages []Message) string {
+	h := sha256.New()
+	for _, m := range messages {
+		h.Write([]byte(m.Content()))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Ensure MeteredGenerator implements TextGenerator.
+var _ TextGenerator = (*MeteredGenerator)(nil)
+
+// MeteredEmbedder wraps a search.Embedder and records a usage.ProviderUsage
+// for every call. Embedder implementations don't report provider-side token
+// counts, so prompt tokens are estimated from input length (roughly 4
+// characters per token).
+type MeteredEmbedder struct {
+	embedder  search.Embedder
+	store     usage.Store
+	operation usage.Operation
+	model     string
+	budget    usage.Budget
+	logger    zerolog.Logger
+}
+
+// NewMeteredEmbedder creates a new MeteredEmbedder.
+func NewMeteredEmbedder(embedder search.Embedder, store usage.Store, operation usage.Operation, logger zerolog.Logger) *MeteredEmbedder {
+	model := ""
+	if named, ok := embedder.(embeddingModelNamed); ok {
+		model = named.EmbeddingModelName()
+	}
+	return &MeteredEmbedder{
+		embedder:  embedder,
+		store:     store,
+		operation: operation,
+		model:     model,
+		logger:    logger,
+	}
+}
+
+// WithBudget returns a copy of e that refuses calls once today's recorded
+// spend for its operation reaches budget's limits, returning
+// usage.ErrBudgetExceeded instead of calling the wrapped embedder. Pass the
+// zero usage.Budget (the default) to leave the operation unenforced.
+func (e *MeteredEmbedder) WithBudget(budget usage.Budget) *MeteredEmbedder {
+	clone := *e
+	clone.budget = budget
+	return &clone
+}
+
+// EmbeddingModelName returns the wrapped embedder's model name, or "" if it
+// doesn't report one.
+func (e *MeteredEmbedder) EmbeddingModelName() string { return e.model }
+
+// Embed delegates to the wrapped embedder and records its estimated usage.
+func (e *MeteredEmbedder) Embed(ctx context.Context, items []search.EmbeddingItem) ([][]float64, error) {
+	if e.budget.Enforced() {
+		if err := checkBudget(ctx, e.store, e.operation, e.budget); err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	vectors, err := e.embedder.Embed(ctx, items)
+	if err != nil {
+		return vectors, err
+	}
+
+	h := sha256.New()
+	chars := 0
+	for _, item := range items {
+		h.Write(item.Text())
+		chars += len(item.Text())
+	}
+	estimatedTokens := chars / 4
+
+	record := usage.New(
+		usage.RepositoryIDFromContext(ctx),
+		e.operation,
+		e.model,
+		hex.EncodeToString(h.Sum(nil)),
+		estimatedTokens,
+		0,
+		estimatedTokens,
+		time.Since(start).Milliseconds(),
+		usage.EstimateCost(e.model, estimatedTokens, 0),
+	)
+	if _, saveErr := e.store.Save(ctx, record); saveErr != nil {
+		e.logger.Warn().Err(saveErr).Msg("failed to record provider usage")
+	}
+
+	return vectors, nil
+}
+
+// Ensure MeteredEmbedder implements search.Embedder.
+var _ search.Embedder = (*MeteredEmbedder)(nil)
@@ -0,0 +1,251 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/helixml/kodit/domain/search"
+)
+
+// OllamaProvider implements embedding generation against a local or
+// self-hosted Ollama server. Ollama has no API key model, so authentication
+// is skipped entirely; this provider only supports embedding.
+type OllamaProvider struct {
+	baseURL       string
+	model         string
+	maxRetries    int
+	initialDelay  time.Duration
+	backoffFactor float64
+	httpClient    *http.Client
+}
+
+// OllamaConfig holds configuration for the Ollama provider.
+type OllamaConfig struct {
+	BaseURL       string
+	Model         string
+	Timeout       time.Duration
+	MaxRetries    int
+	InitialDelay  time.Duration
+	BackoffFactor float64
+}
+
+// NewOllamaProviderFromConfig creates a provider from configuration.
+func NewOllamaProviderFromConfig(cfg OllamaConfig) *OllamaProvider {
+	baseURL := cfg.BaseURL
+	if baseURL == "" {
+		baseURL = "http://localhost:11434"
+	}
+
+	model := cfg.Model
+	if model == "" {
+		model = "nomic-embed-text"
+	}
+
+	timeout := cfg.Timeout
+	if timeout == 0 {
+		timeout = 60 * time.Second
+	}
+
+	maxRetries := cfg.MaxRetries
+	if maxRetries == 0 {
+		maxRetries = 5
+	}
+
+	initialDelay := cfg.InitialDelay
+	if initialDelay == 0 {
+		initialDelay = 2 * time.Second
+	}
+
+	backoffFactor := cfg.BackoffFactor
+	if backoffFactor == 0 {
+		backoffFactor = 2.0
+	}
+
+	return &OllamaProvider{
+		baseURL:       baseURL,
+		model:         model,
+		maxRetries:    maxRetries,
+		initialDelay:  initialDelay,
+		backoffFactor: backoffFactor,
+		httpClient: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+// EmbeddingModelName returns the model used for embeddings.
+func (p *OllamaProvider) EmbeddingModelName() string { return p.model }
+
+// SupportsTextGeneration returns false (chat generation is not wired up here).
+func (p *OllamaProvider) SupportsTextGeneration() bool { return false }
+
+// SupportsEmbedding returns true.
+func (p *OllamaProvider) SupportsEmbedding() bool { return true }
+
+// Close is a no-op for the Ollama provider.
+func (p *OllamaProvider) Close() error { return nil }
+
+// ollamaEmbedRequest represents the Ollama /api/embed request body.
+type ollamaEmbedRequest struct {
+	Model string   `json:"model"`
+	Input []string `json:"input"`
+}
+
+// ollamaEmbedResponse represents the Ollama /api/embed response.
+type ollamaEmbedResponse struct {
+	Embeddings [][]float64 `json:"embeddings"`
+}
+
+// ollamaErrorResponse represents an Ollama API error response.
+type ollamaErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// Embed generates embeddings for the given text items in a single API call.
+// Items without a text payload return an error — Ollama's embedding models
+// do not accept image inputs. Ollama has no notion of asymmetric
+// query/document instructions, so the query flag is ignored.
+func (p *OllamaProvider) Embed(ctx context.Context, items []search.EmbeddingItem) ([][]float64, error) {
+	if len(items) == 0 {
+		return [][]float64{}, nil
+	}
+
+	texts := make([]string, len(items))
+	for i, item := range items {
+		if !item.HasText() {
+			return nil, fmt.Errorf("ollama embedding requires text, got item %d with no text", i)
+		}
+		texts[i] = string(item.Text())
+	}
+
+	apiReq := ollamaEmbedRequest{
+		Model: p.model,
+		Input: texts,
+	}
+
+	var resp ollamaEmbedResponse
+	var err error
+
+	err = p.withRetry(ctx, func() error {
+		resp, err = p.doRequest(ctx, apiReq)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Embeddings) != len(texts) {
+		return nil, NewProviderError("embedding", 0, fmt.Sprintf("got %d vectors for %d texts", len(resp.Embeddings), len(texts)), nil)
+	}
+
+	return resp.Embeddings, nil
+}
+
+// doRequest performs the HTTP request to the Ollama /api/embed endpoint.
+func (p *OllamaProvider) doRequest(ctx context.Context, req ollamaEmbedRequest) (ollamaEmbedResponse, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return ollamaEmbedResponse{}, NewProviderError("embedding", 0, "failed to marshal request", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, p.baseURL+"/api/embed", bytes.NewReader(body))
+	if err != nil {
+		return ollamaEmbedResponse{}, NewProviderError("embedding", 0, "failed to create request", err)
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.httpClient.Do(httpReq)
+	if err != nil {
+		return ollamaEmbedResponse{}, NewProviderError("embedding", 0, "request failed", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return ollamaEmbedResponse{}, NewProviderError("embedding", resp.StatusCode, "failed to read response", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var apiErr ollamaErrorResponse
+		if err := json.Unmarshal(respBody, &apiErr); err == nil && apiErr.Error != "" {
+			return ollamaEmbedResponse{}, NewProviderError("embedding", resp.StatusCode, apiErr.Error, nil)
+		}
+		return ollamaEmbedResponse{}, NewProviderError("embedding", resp.StatusCode, string(respBody), nil)
+	}
+
+	var apiResp ollamaEmbedResponse
+	if err := json.Unmarshal(respBody, &apiResp); err != nil {
+		return ollamaEmbedResponse{}, NewProviderError("embedding", 0, "failed to unmarshal response", err)
+	}
+
+	return apiResp, nil
+}
+
+// withRetry executes the function with exponential backoff retry.
+func (p *OllamaProvider) withRetry(ctx context.Context, fn func() error) error {
+	delay := p.initialDelay
+	var lastErr error
+
+	for attempt := 0; attempt <= p.maxRetries; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		lastErr = fn()
+		if lastErr == nil {
+			return nil
+		}
+
+		if !p.isRetryable(lastErr) {
+			return lastErr
+		}
+
+		if attempt < p.maxRetries {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(delay):
+				delay = time.Duration(float64(delay) * p.backoffFactor)
+			}
+		}
+	}
+
+	return fmt.Errorf("max retries exceeded: %w", lastErr)
+}
+
+// isRetryable determines if an error should be retried.
+func (p *OllamaProvider) isRetryable(err error) bool {
+	var provErr *ProviderError
+	if !errors.As(err, &provErr) {
+		return false
+	}
+
+	switch provErr.StatusCode() {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+
+	if provErr.cause != nil {
+		var netErr net.Error
+		if errors.As(provErr.cause, &netErr) && netErr.Timeout() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Ensure OllamaProvider implements the embedding interface.
+var _ search.Embedder = (*OllamaProvider)(nil)
@@ -172,6 +172,12 @@ func NewOpenAIProviderFromConfig(cfg OpenAIConfig) *OpenAIProvider {
 	}
 }
 
+// ChatModelName returns the model used for chat completions.
+func (p *OpenAIProvider) ChatModelName() string { return p.chatModel }
+
+// EmbeddingModelName returns the model used for embeddings.
+func (p *OpenAIProvider) EmbeddingModelName() string { return p.embeddingModel }
+
 // SupportsTextGeneration returns true.
 func (p *OpenAIProvider) SupportsTextGeneration() bool {
 	return p.supportsText
@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/helixml/kodit/domain/search"
+)
+
+// VoyageProvider implements embedding generation using the Voyage AI
+// Embeddings API. Voyage does not provide chat completions, so this
+// provider only supports embedding.
+type VoyageProvider struct {
+	apiKey        string
+	baseURL       string
+	model         string
+	maxRetries    int
+	initialDelay  time.Duration
+	backoffFactor float64
+	httpClient    *http.Client
+}
+
+// VoyageConfig holds configuration for the Voyage provider.
+type VoyageConfig struct {
+	APIKey        string
+	BaseURL       string
+	Model         string
+	Timeout       time.Duration
+	MaxRetries    int
+	InitialDelay  time.Duration
+	BackoffFactor float64
+}
+
+// NewVoyageProviderFromConfig creates a provider from configuration.
+func NewVoyageProviderFromConfig(cfg VoyageConfig) *VoyageProvider {
+	baseURL := cfg.BaseURL
+	if baseURL == "" {
+		baseURL = "https://api.voyageai.com"
+	}
+
+	model := cfg.Model
+	if model == "" {
+		model = "voyage-3"
+	}
+
+	timeout := cfg.Timeout
+	if timeout == 0 {
+		timeout = 60 * time.Second
+	}
+
+	maxRetries := cfg.MaxRetries
+	if maxRetries == 0 {
+		maxRetries = 5
+	}
+
+	initialDelay := cfg.InitialDelay
+	if initialDelay == 0 {
+		initialDelay = 2 * time.Second
+	}
+
+	backoffFactor := cfg.BackoffFactor
+	if backoffFactor == 0 {
+		backoffFactor = 2.0
+	}
+
+	return &VoyageProvider{
+		apiKey:        cfg.APIKey,
+		baseURL:       baseURL,
+		model:         model,
+		maxRetries:    maxRetries,
+		initialDelay:  initialDelay,
+		backoffFactor: backoffFactor,
+		httpClient: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+// EmbeddingModelName returns the model used for embeddings.
+func (p *VoyageProvider) EmbeddingModelName() string { return p.model }
+
+// SupportsTextGeneration returns false (Voyage doesn't provide chat completions).
+func (p *VoyageProvider) SupportsTextGeneration() bool { return false }
+
+// SupportsEmbedding returns true.
+func (p *VoyageProvider) SupportsEmbedding() bool { return true }
+
+// Close is a no-op for the Voyage provider.
+func (p *VoyageProvider) Close() error { return nil }
+
+// voyageEmbedRequest represents the Voyage Embeddings API request body.
+type voyageEmbedRequest struct {
+	Model     string   `json:"model"`
+	Input     []string `json:"input"`
+	InputType string   `json:"input_type"`
+}
+
+// voyageEmbedData represents a single embedding in the Voyage response.
+type voyageEmbedData struct {
+	Embedding []float64 `json:"embedding"`
+}
+
+// voyageEmbedResponse represents the Voyage Embeddings API response.
+type voyageEmbedResponse struct {
+	Data []voyageEmbedData `json:"data"`
+}
+
+// voyageErrorResponse represents a Voyage API error response.
+type voyageErrorResponse struct {
+	Detail string `json:"detail"`
+}
+
+// Embed generates embeddings for the given text items in a single API call.
+// Items without a text payload return an error — Voyage's Embeddings
+// endpoint does not accept image inputs.
+func (p *VoyageProvider) Embed(ctx context.Context, items []search.EmbeddingItem) ([][]float64, error) {
+	if len(items) == 0 {
+		return [][]float64{}, nil
+	}
+
+	texts := make([]string, len(items))
+	for i, item := range items {
+		if !item.HasText() {
+			return nil, fmt.Errorf("voyage embedding requires text, got item %d with no text", i)
+		}
+		texts[i] = string(item.Text())
+	}
+
+	// A batch is either all queries or all documents — the two are never mixed.
+	inputType := "document"
+	if len(items) > 0 && items[0].IsQuery() {
+		inputType = "query"
+	}
+
+	apiReq := voyageEmbedRequest{
+		Model:     p.model,
+		Input:     texts,
+		InputType: inputType,
+	}
+
+	var resp voyageEmbedResponse
+	var err error
+
+	err = p.withRetry(ctx, func() error {
+		resp, err = p.doRequest(ctx, apiReq)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Data) != len(texts) {
+		return nil, NewProviderError("embedding", 0, fmt.Sprintf("got %d vectors for %d texts", len(resp.Data), len(texts)), nil)
+	}
+
+	embeddings := make([][]float64, len(resp.Data))
+	for i, d := range resp.Data {
+		embeddings[i] = d.Embedding
+	}
+
+	return embeddings, nil
+}
+
+// doRequest performs the HTTP request to the Voyage Embeddings API.
+func (p *VoyageProvider) doRequest(ctx context.Context, req voyageEmbedRequest) (voyageEmbedResponse, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return voyageEmbedResponse{}, NewProviderError("embedding", 0, "failed to marshal request", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, p.baseURL+"/v1/embeddings", bytes.NewReader(body))
+	if err != nil {
+		return voyageEmbedResponse{}, NewProviderError("embedding", 0, "failed to create request", err)
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+p.apiKey)
+
+	resp, err := p.httpClient.Do(httpReq)
+	if err != nil {
+		return voyageEmbedResponse{}, NewProviderError("embedding", 0, "request failed", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return voyageEmbedResponse{}, NewProviderError("embedding", resp.StatusCode, "failed to read response", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var apiErr voyageErrorResponse
+		if err := json.Unmarshal(respBody, &apiErr); err == nil && apiErr.Detail != "" {
+			return voyageEmbedResponse{}, NewProviderError("embedding", resp.StatusCode, apiErr.Detail, nil)
+		}
+		return voyageEmbedResponse{}, NewProviderError("embedding", resp.StatusCode, string(respBody), nil)
+	}
+
+	var apiResp voyageEmbedResponse
+	if err := json.Unmarshal(respBody, &apiResp); err != nil {
+		return voyageEmbedResponse{}, NewProviderError("embedding", 0, "failed to unmarshal response", err)
+	}
+
+	return apiResp, nil
+}
+
+// withRetry executes the function with exponential backoff retry.
+func (p *VoyageProvider) withRetry(ctx context.Context, fn func() error) error {
+	delay := p.initialDelay
+	var lastErr error
+
+	for attempt := 0; attempt <= p.maxRetries; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		lastErr = fn()
+		if lastErr == nil {
+			return nil
+		}
+
+		if !p.isRetryable(lastErr) {
+			return lastErr
+		}
+
+		if attempt < p.maxRetries {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(delay):
+				delay = time.Duration(float64(delay) * p.backoffFactor)
+			}
+		}
+	}
+
+	return fmt.Errorf("max retries exceeded: %w", lastErr)
+}
+
+// isRetryable determines if an error should be retried.
+func (p *VoyageProvider) isRetryable(err error) bool {
+	var provErr *ProviderError
+	if !errors.As(err, &provErr) {
+		return false
+	}
+
+	switch provErr.StatusCode() {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+
+	if provErr.cause != nil {
+		var netErr net.Error
+		if errors.As(provErr.cause, &netErr) && netErr.Timeout() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Ensure VoyageProvider implements the embedding interface.
+var _ search.Embedder = (*VoyageProvider)(nil)
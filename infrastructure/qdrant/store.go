@@ -0,0 +1,280 @@
+// Package qdrant implements search.Store against a Qdrant vector database
+// over its HTTP REST API. It is a bring-your-own-vector-database alternative
+// to the Postgres-backed stores in infrastructure/persistence, for
+// deployments that already run Qdrant and don't want the SQL database
+// doubling as the vector index.
+package qdrant
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"net/http"
+	"time"
+
+	"github.com/helixml/kodit/domain/repository"
+	"github.com/helixml/kodit/domain/search"
+)
+
+// defaultDistance is the Qdrant distance metric used for new collections.
+// Kodit's embedding providers and existing pgvector/VectorChord stores all
+// rank by cosine similarity, so Qdrant collections use the same metric.
+const defaultDistance = "Cosine"
+
+// ErrCollectionCreationFailed indicates the Qdrant collection could not be created.
+var ErrCollectionCreationFailed = errors.New("failed to create qdrant collection")
+
+// Store implements search.Store against a single Qdrant collection, reached
+// over HTTP. The collection is created lazily on the first Index call, once
+// the embedding dimension is known.
+type Store struct {
+	baseURL        string
+	apiKey         string
+	collectionName string
+	httpClient     *http.Client
+
+	ready bool
+}
+
+// NewStore creates a Store backed by the named Qdrant collection. baseURL is
+// the Qdrant REST endpoint (e.g. "http://localhost:6333"); apiKey may be
+// empty if the Qdrant instance does not require authentication.
+func NewStore(baseURL, apiKey, collectionName string) *Store {
+	return &Store{
+		baseURL:        baseURL,
+		apiKey:         apiKey,
+		collectionName: collectionName,
+		httpClient:     &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// Index upserts pre-computed vectors into the collection, creating it first
+// if this is the first call. Documents without a vector are skipped.
+func (s *Store) Index(ctx context.Context, docs []search.Document) error {
+	points := make([]qdrantPoint, 0, len(docs))
+	var dimension int
+	for _, doc := range docs {
+		vec := doc.Vector()
+		if doc.SnippetID() == "" || len(vec) == 0 {
+			continue
+		}
+		if dimension == 0 {
+			dimension = len(vec)
+		}
+		points = append(points, qdrantPoint{
+			ID:      snippetPointID(doc.SnippetID()),
+			Vector:  vec,
+			Payload: map[string]any{"snippet_id": doc.SnippetID()},
+		})
+	}
+	if len(points) == 0 {
+		return nil
+	}
+
+	if !s.ready {
+		if err := s.ensureCollection(ctx, dimension); err != nil {
+			return err
+		}
+	}
+
+	_, err := s.do(ctx, http.MethodPut, fmt.Sprintf("/collections/%s/points", s.collectionName), qdrantUpsertRequest{Points: points})
+	if err != nil {
+		return fmt.Errorf("upsert points: %w", err)
+	}
+	return nil
+}
+
+// Find performs vector similarity search when WithEmbedding is supplied;
+// otherwise it scrolls the collection filtered by the supplied snippet IDs.
+func (s *Store) Find(ctx context.Context, opts ...repository.Option) ([]search.Result, error) {
+	q := repository.Build(opts...)
+
+	embedding, ok := search.EmbeddingFrom(q)
+	if ok && len(embedding) > 0 {
+		return s.search(ctx, q, embedding)
+	}
+	return s.scroll(ctx, q)
+}
+
+func (s *Store) search(ctx context.Context, q repository.Query, embedding []float64) ([]search.Result, error) {
+	limit := q.LimitValue()
+	if limit <= 0 {
+		limit = 10
+	}
+
+	req := qdrantSearchRequest{
+		Vector:      embedding,
+		Limit:       limit,
+		WithPayload: true,
+	}
+	if snippetIDs := search.SnippetIDsFrom(q); len(snippetIDs) > 0 {
+		req.Filter = snippetIDFilter(snippetIDs)
+	}
+
+	var resp qdrantSearchResponse
+	if err := s.doInto(ctx, http.MethodPost, fmt.Sprintf("/collections/%s/points/search", s.collectionName), req, &resp); err != nil {
+		if errors.Is(err, errCollectionNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("search points: %w", err)
+	}
+
+	results := make([]search.Result, 0, len(resp.Result))
+	for _, hit := range resp.Result {
+		results = append(results, search.NewResult(hit.Payload["snippet_id"].(string), hit.Score))
+	}
+	return results, nil
+}
+
+func (s *Store) scroll(ctx context.Context, q repository.Query) ([]search.Result, error) {
+	req := qdrantScrollRequest{WithPayload: true, Limit: 1000}
+	if snippetIDs := search.SnippetIDsFrom(q); len(snippetIDs) > 0 {
+		req.Filter = snippetIDFilter(snippetIDs)
+	}
+
+	var resp qdrantScrollResponse
+	if err := s.doInto(ctx, http.MethodPost, fmt.Sprintf("/collections/%s/points/scroll", s.collectionName), req, &resp); err != nil {
+		if errors.Is(err, errCollectionNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("scroll points: %w", err)
+	}
+
+	results := make([]search.Result, 0, len(resp.Result.Points))
+	for _, p := range resp.Result.Points {
+		results = append(results, search.NewResult(p.Payload["snippet_id"].(string), 0))
+	}
+	return results, nil
+}
+
+// Count returns the number of points matching the given snippet IDs, or the
+// total point count if no snippet IDs are specified.
+func (s *Store) Count(ctx context.Context, opts ...repository.Option) (int64, error) {
+	q := repository.Build(opts...)
+	req := qdrantCountRequest{Exact: true}
+	if snippetIDs := search.SnippetIDsFrom(q); len(snippetIDs) > 0 {
+		req.Filter = snippetIDFilter(snippetIDs)
+	}
+
+	var resp qdrantCountResponse
+	if err := s.doInto(ctx, http.MethodPost, fmt.Sprintf("/collections/%s/points/count", s.collectionName), req, &resp); err != nil {
+		if errors.Is(err, errCollectionNotFound) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("count points: %w", err)
+	}
+	return resp.Result.Count, nil
+}
+
+// Exists reports whether any point matches the given options.
+func (s *Store) Exists(ctx context.Context, opts ...repository.Option) (bool, error) {
+	count, err := s.Count(ctx, opts...)
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// DeleteBy removes points matching the given snippet IDs.
+func (s *Store) DeleteBy(ctx context.Context, opts ...repository.Option) error {
+	q := repository.Build(opts...)
+	snippetIDs := search.SnippetIDsFrom(q)
+	if len(snippetIDs) == 0 {
+		return nil
+	}
+
+	req := qdrantDeleteRequest{Filter: snippetIDFilter(snippetIDs)}
+	_, err := s.do(ctx, http.MethodPost, fmt.Sprintf("/collections/%s/points/delete", s.collectionName), req)
+	if err != nil && !errors.Is(err, errCollectionNotFound) {
+		return fmt.Errorf("delete points: %w", err)
+	}
+	return nil
+}
+
+// ensureCollection creates the collection with the given vector dimension if
+// it does not already exist.
+func (s *Store) ensureCollection(ctx context.Context, dimension int) error {
+	req := qdrantCreateCollectionRequest{
+		Vectors: qdrantVectorParams{Size: dimension, Distance: defaultDistance},
+	}
+	if _, err := s.do(ctx, http.MethodPut, fmt.Sprintf("/collections/%s", s.collectionName), req); err != nil {
+		return errors.Join(ErrCollectionCreationFailed, err)
+	}
+	s.ready = true
+	return nil
+}
+
+var errCollectionNotFound = errors.New("qdrant collection not found")
+
+// do sends a request to the Qdrant REST API and returns the raw response body.
+func (s *Store) do(ctx context.Context, method, path string, body any) ([]byte, error) {
+	var out json.RawMessage
+	if err := s.doInto(ctx, method, path, body, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// doInto sends a request to the Qdrant REST API and decodes the response body into out.
+func (s *Store) doInto(ctx context.Context, method, path string, body, out any) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, s.baseURL+path, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.apiKey != "" {
+		req.Header.Set("api-key", s.apiKey)
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return errCollectionNotFound
+	}
+	if resp.StatusCode >= 300 {
+		var errBody struct {
+			Status struct {
+				Error string `json:"error"`
+			} `json:"status"`
+		}
+		_ = json.NewDecoder(resp.Body).Decode(&errBody)
+		return fmt.Errorf("qdrant returned %s: %s", resp.Status, errBody.Status.Error)
+	}
+
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// snippetPointID derives a stable Qdrant point ID from a snippet ID. Qdrant
+// points require a UUID or unsigned integer ID, so the snippet ID itself is
+// hashed into a uint64 and carried separately in the payload for lookups.
+func snippetPointID(snippetID string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(snippetID))
+	return h.Sum64()
+}
+
+func snippetIDFilter(snippetIDs []string) *qdrantFilter {
+	return &qdrantFilter{
+		Must: []qdrantCondition{
+			{Key: "snippet_id", Match: &qdrantMatch{Any: snippetIDs}},
+		},
+	}
+}
+
+// Ensure Store implements search.Store.
+var _ search.Store = (*Store)(nil)
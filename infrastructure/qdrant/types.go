@@ -0,0 +1,85 @@
+package qdrant
+
+// Request/response shapes for the subset of the Qdrant REST API this store
+// uses. See https://qdrant.tech/documentation/concepts/ for the full schema.
+
+type qdrantVectorParams struct {
+	Size     int    `json:"size"`
+	Distance string `json:"distance"`
+}
+
+type qdrantCreateCollectionRequest struct {
+	Vectors qdrantVectorParams `json:"vectors"`
+}
+
+type qdrantPoint struct {
+	ID      uint64         `json:"id"`
+	Vector  []float64      `json:"vector"`
+	Payload map[string]any `json:"payload"`
+}
+
+type qdrantUpsertRequest struct {
+	Points []qdrantPoint `json:"points"`
+}
+
+type qdrantMatch struct {
+	Any []string `json:"any"`
+}
+
+type qdrantCondition struct {
+	Key   string       `json:"key"`
+	Match *qdrantMatch `json:"match,omitempty"`
+}
+
+type qdrantFilter struct {
+	Must []qdrantCondition `json:"must"`
+}
+
+type qdrantSearchRequest struct {
+	Vector      []float64     `json:"vector"`
+	Limit       int           `json:"limit"`
+	Filter      *qdrantFilter `json:"filter,omitempty"`
+	WithPayload bool          `json:"with_payload"`
+}
+
+type qdrantSearchHit struct {
+	ID      uint64         `json:"id"`
+	Score   float64        `json:"score"`
+	Payload map[string]any `json:"payload"`
+}
+
+type qdrantSearchResponse struct {
+	Result []qdrantSearchHit `json:"result"`
+}
+
+type qdrantScrollRequest struct {
+	Limit       int           `json:"limit"`
+	Filter      *qdrantFilter `json:"filter,omitempty"`
+	WithPayload bool          `json:"with_payload"`
+}
+
+type qdrantScrollPoint struct {
+	ID      uint64         `json:"id"`
+	Payload map[string]any `json:"payload"`
+}
+
+type qdrantScrollResponse struct {
+	Result struct {
+		Points []qdrantScrollPoint `json:"points"`
+	} `json:"result"`
+}
+
+type qdrantCountRequest struct {
+	Filter *qdrantFilter `json:"filter,omitempty"`
+	Exact  bool          `json:"exact"`
+}
+
+type qdrantCountResponse struct {
+	Result struct {
+		Count int64 `json:"count"`
+	} `json:"result"`
+}
+
+type qdrantDeleteRequest struct {
+	Filter *qdrantFilter `json:"filter,omitempty"`
+}
@@ -0,0 +1,15 @@
+package tracking
+
+import (
+	"context"
+
+	"github.com/helixml/kodit/domain/tracking"
+)
+
+// Alerter defines the interface for repository health alert delivery.
+// Implementations are notified when a repository's health score falls to
+// or below the configured threshold.
+type Alerter interface {
+	// Alert delivers a health alert for the given repository.
+	Alert(ctx context.Context, repositoryID int64, upstreamURL string, health tracking.RepositoryHealth) error
+}
@@ -0,0 +1,30 @@
+package tracking
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+
+	"github.com/helixml/kodit/domain/tracking"
+)
+
+// LoggingAlerter implements Alerter by logging health alerts.
+type LoggingAlerter struct {
+	logger zerolog.Logger
+}
+
+// NewLoggingAlerter creates a new LoggingAlerter.
+func NewLoggingAlerter(logger zerolog.Logger) *LoggingAlerter {
+	return &LoggingAlerter{logger: logger}
+}
+
+// Alert logs the health alert.
+func (a *LoggingAlerter) Alert(_ context.Context, repositoryID int64, upstreamURL string, health tracking.RepositoryHealth) error {
+	a.logger.Warn().
+		Int64("repository_id", repositoryID).
+		Str("upstream_url", upstreamURL).
+		Float64("health_score", health.Score()).
+		Bool("stale", health.Stale()).
+		Msg("repository health below threshold")
+	return nil
+}
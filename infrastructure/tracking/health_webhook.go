@@ -0,0 +1,64 @@
+package tracking
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/helixml/kodit/domain/tracking"
+)
+
+// WebhookAlerter implements Alerter by POSTing a JSON payload to a
+// configured URL.
+type WebhookAlerter struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookAlerter creates a new WebhookAlerter posting to url.
+func NewWebhookAlerter(url string) *WebhookAlerter {
+	return &WebhookAlerter{
+		url:    url,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+type webhookHealthPayload struct {
+	RepositoryID int64   `json:"repository_id"`
+	UpstreamURL  string  `json:"upstream_url"`
+	HealthScore  float64 `json:"health_score"`
+	Stale        bool    `json:"stale"`
+}
+
+// Alert posts the health alert payload to the configured webhook URL.
+func (a *WebhookAlerter) Alert(ctx context.Context, repositoryID int64, upstreamURL string, health tracking.RepositoryHealth) error {
+	body, err := json.Marshal(webhookHealthPayload{
+		RepositoryID: repositoryID,
+		UpstreamURL:  upstreamURL,
+		HealthScore:  health.Score(),
+		Stale:        health.Stale(),
+	})
+	if err != nil {
+		return fmt.Errorf("marshal health alert payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build health alert request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("send health alert webhook: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("health alert webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+// Package osv queries the OSV (Open Source Vulnerabilities) database for
+// known vulnerabilities affecting specific package versions.
+package osv
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// defaultBaseURL is the OSV API root.
+const defaultBaseURL = "https://api.osv.dev/v1"
+
+// Package identifies a package version to check for known vulnerabilities.
+type Package struct {
+	Name      string
+	Version   string
+	Ecosystem string
+}
+
+// Vulnerability describes a known vulnerability affecting a queried package.
+type Vulnerability struct {
+	ID      string
+	Summary string
+}
+
+// Client queries the OSV API.
+type Client struct {
+	httpClient *http.Client
+	baseURL    string
+}
+
+// Option is a functional option for Client.
+type Option func(*Client)
+
+// WithBaseURL overrides the OSV API root (for testing or proxies).
+func WithBaseURL(url string) Option {
+	return func(c *Client) { c.baseURL = url }
+}
+
+// WithTimeout overrides the HTTP client timeout.
+func WithTimeout(d time.Duration) Option {
+	return func(c *Client) { c.httpClient.Timeout = d }
+}
+
+// NewClient creates an OSV API client.
+func NewClient(opts ...Option) *Client {
+	c := &Client{
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		baseURL:    defaultBaseURL,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+type batchQueryRequest struct {
+	Queries []batchQuery `json:"queries"`
+}
+
+type batchQuery struct {
+	Package batchPackage `json:"package"`
+	Version string       `json:"version"`
+}
+
+type batchPackage struct {
+	Name      string `json:"name"`
+	Ecosystem string `json:"ecosystem"`
+}
+
+type batchQueryResponse struct {
+	Results []struct {
+		Vulns []struct {
+			ID string `json:"id"`
+		} `json:"vulns"`
+	} `json:"results"`
+}
+
+type vulnDetail struct {
+	ID      string `json:"id"`
+	Summary string `json:"summary"`
+}
+
+// Query checks each package for known vulnerabilities via OSV's batch
+// endpoint, then fetches the summary for every distinct vulnerability found.
+// The returned map is keyed by the index into packages, so callers can
+// re-associate findings with the package that triggered them. Packages with
+// no known vulnerabilities have no entry.
+func (c *Client) Query(ctx context.Context, packages []Package) (map[int][]Vulnerability, error) {
+	if len(packages) == 0 {
+		return nil, nil
+	}
+
+	reqBody := batchQueryRequest{Queries: make([]batchQuery, len(packages))}
+	for i, p := range packages {
+		reqBody.Queries[i] = batchQuery{
+			Package: batchPackage{Name: p.Name, Ecosystem: p.Ecosystem},
+			Version: p.Version,
+		}
+	}
+
+	var batchResp batchQueryResponse
+	if err := c.post(ctx, "/querybatch", reqBody, &batchResp); err != nil {
+		return nil, fmt.Errorf("query OSV batch API: %w", err)
+	}
+
+	details := make(map[string]Vulnerability)
+	findings := make(map[int][]Vulnerability)
+	for i, result := range batchResp.Results {
+		for _, v := range result.Vulns {
+			detail, ok := details[v.ID]
+			if !ok {
+				fetched, err := c.vulnDetail(ctx, v.ID)
+				if err != nil {
+					return nil, fmt.Errorf("fetch vulnerability %s: %w", v.ID, err)
+				}
+				detail = fetched
+				details[v.ID] = detail
+			}
+			findings[i] = append(findings[i], detail)
+		}
+	}
+	return findings, nil
+}
+
+// vulnDetail fetches the summary for a single vulnerability ID.
+func (c *Client) vulnDetail(ctx context.Context, id string) (Vulnerability, error) {
+	var detail vulnDetail
+	if err := c.get(ctx, "/vulns/"+id, &detail); err != nil {
+		return Vulnerability{}, err
+	}
+	return Vulnerability{ID: detail.ID, Summary: detail.Summary}, nil
+}
+
+func (c *Client) post(ctx context.Context, path string, body, out any) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+path, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	return c.do(req, out)
+}
+
+func (c *Client) get(ctx context.Context, path string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	return c.do(req, out)
+}
+
+func (c *Client) do(req *http.Request, out any) error {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("read response: %w", err)
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package osv
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func fakeOSVServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/querybatch", func(w http.ResponseWriter, r *http.Request) {
+		var req batchQueryRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		results := make([]map[string]any, len(req.Queries))
+		for i, q := range req.Queries {
+			if q.Package.Name == "github.com/vulnerable/pkg" {
+				results[i] = map[string]any{"vulns": []map[string]any{{"id": "GHSA-xxxx-yyyy-zzzz"}}}
+			} else {
+				results[i] = map[string]any{}
+			}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"results": results})
+	})
+	mux.HandleFunc("/vulns/GHSA-xxxx-yyyy-zzzz", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(vulnDetail{ID: "GHSA-xxxx-yyyy-zzzz", Summary: "example vulnerability"})
+	})
+
+	return httptest.NewServer(mux)
+}
+
+func TestClient_Query(t *testing.T) {
+	server := fakeOSVServer(t)
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL))
+
+	packages := []Package{
+		{Name: "github.com/vulnerable/pkg", Version: "v1.0.0", Ecosystem: "Go"},
+		{Name: "github.com/safe/pkg", Version: "v1.0.0", Ecosystem: "Go"},
+	}
+
+	findings, err := client.Query(context.Background(), packages)
+	require.NoError(t, err)
+	require.Len(t, findings, 1)
+	require.Equal(t, []Vulnerability{{ID: "GHSA-xxxx-yyyy-zzzz", Summary: "example vulnerability"}}, findings[0])
+	require.Empty(t, findings[1])
+}
+
+func TestClient_Query_NoPackages(t *testing.T) {
+	client := NewClient()
+	findings, err := client.Query(context.Background(), nil)
+	require.NoError(t, err)
+	require.Nil(t, findings)
+}
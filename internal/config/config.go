@@ -18,6 +18,7 @@ const (
 	DefaultLogLevel                  = "INFO"
 	DefaultWorkerCount               = 1
 	DefaultSearchLimit               = 10
+	DefaultSearchProvider            = "vectorchord"
 	DefaultCloneSubdir               = "repos"
 	DefaultEndpointParallelTasks     = 1
 	DefaultEndpointTimeout           = 60 * time.Second
@@ -33,6 +34,13 @@ const (
 	DefaultRemoteTimeout             = 30 * time.Second
 	DefaultRemoteMaxRetries          = 3
 	DefaultReportingInterval         = 5 * time.Second
+	DefaultHealthAlertThreshold      = 50.0
+	DefaultHealthAlertCheckInterval  = 300.0 // seconds
+	DefaultWorkerDrainTimeout        = 30 * time.Second
+	DefaultWarmUpRepoLimit           = 5
+	DefaultWarmUpQuery               = "function"
+	DefaultIntegrityCheckInterval    = 3600.0  // seconds
+	DefaultCompactionCheckInterval   = 86400.0 // seconds
 )
 
 // LogFormat represents the log output format.
@@ -92,6 +100,7 @@ func (c LiteLLMCacheConfig) WithEnabled(enabled bool) LiteLLMCacheConfig {
 
 // Endpoint configures an AI service endpoint.
 type Endpoint struct {
+	providerType        string
 	baseURL             string
 	model               string
 	apiKey              string
@@ -112,6 +121,7 @@ type Endpoint struct {
 // NewEndpoint creates a new Endpoint with defaults.
 func NewEndpoint() Endpoint {
 	return Endpoint{
+		providerType:     "openai",
 		numParallelTasks: DefaultEndpointParallelTasks,
 		timeout:          DefaultEndpointTimeout,
 		maxRetries:       DefaultEndpointMaxRetries,
@@ -123,6 +133,10 @@ func NewEndpoint() Endpoint {
 	}
 }
 
+// ProviderType returns the selected provider implementation
+// (e.g. "openai", "azure_openai", "cohere", "voyage", "ollama").
+func (e Endpoint) ProviderType() string { return e.providerType }
+
 // BaseURL returns the base URL for the endpoint.
 func (e Endpoint) BaseURL() string { return e.baseURL }
 
@@ -185,6 +199,15 @@ func (e Endpoint) IsConfigured() bool {
 // EndpointOption is a functional option for Endpoint.
 type EndpointOption func(*Endpoint)
 
+// WithProviderType sets the provider implementation to use.
+func WithProviderType(t string) EndpointOption {
+	return func(e *Endpoint) {
+		if t != "" {
+			e.providerType = t
+		}
+	}
+}
+
 // WithBaseURL sets the base URL.
 func WithBaseURL(url string) EndpointOption {
 	return func(e *Endpoint) { e.baseURL = url }
@@ -334,6 +357,333 @@ func (p PeriodicSyncConfig) WithRetryAttempts(attempts int) PeriodicSyncConfig {
 	return p
 }
 
+// HealthAlertConfig configures repository health alerting.
+type HealthAlertConfig struct {
+	enabled              bool
+	scoreThreshold       float64
+	checkIntervalSeconds float64
+	webhookURL           string
+}
+
+// NewHealthAlertConfig creates a new HealthAlertConfig with defaults.
+func NewHealthAlertConfig() HealthAlertConfig {
+	return HealthAlertConfig{
+		enabled:              true,
+		scoreThreshold:       DefaultHealthAlertThreshold,
+		checkIntervalSeconds: DefaultHealthAlertCheckInterval,
+	}
+}
+
+// Enabled returns whether health alerting is enabled.
+func (h HealthAlertConfig) Enabled() bool { return h.enabled }
+
+// ScoreThreshold returns the health score at or below which a repository triggers an alert.
+func (h HealthAlertConfig) ScoreThreshold() float64 { return h.scoreThreshold }
+
+// CheckInterval returns how often repository health is recomputed.
+func (h HealthAlertConfig) CheckInterval() time.Duration {
+	return time.Duration(h.checkIntervalSeconds * float64(time.Second))
+}
+
+// WebhookURL returns the webhook URL to notify, or "" to alert via logging only.
+func (h HealthAlertConfig) WebhookURL() string { return h.webhookURL }
+
+// WithEnabled returns a new config with the specified enabled state.
+func (h HealthAlertConfig) WithEnabled(enabled bool) HealthAlertConfig {
+	h.enabled = enabled
+	return h
+}
+
+// WithScoreThreshold returns a new config with the specified alert threshold.
+func (h HealthAlertConfig) WithScoreThreshold(threshold float64) HealthAlertConfig {
+	h.scoreThreshold = threshold
+	return h
+}
+
+// WithCheckIntervalSeconds returns a new config with the specified check interval.
+func (h HealthAlertConfig) WithCheckIntervalSeconds(seconds float64) HealthAlertConfig {
+	h.checkIntervalSeconds = seconds
+	return h
+}
+
+// WithWebhookURL returns a new config with the specified webhook URL.
+func (h HealthAlertConfig) WithWebhookURL(url string) HealthAlertConfig {
+	h.webhookURL = url
+	return h
+}
+
+// IntegrityConfig configures periodic verification of tracked repositories
+// against their upstream remote.
+type IntegrityConfig struct {
+	enabled              bool
+	autoReset            bool
+	checkIntervalSeconds float64
+}
+
+// NewIntegrityConfig creates a new IntegrityConfig with defaults.
+func NewIntegrityConfig() IntegrityConfig {
+	return IntegrityConfig{
+		enabled:              true,
+		checkIntervalSeconds: DefaultIntegrityCheckInterval,
+	}
+}
+
+// Enabled returns whether integrity verification is enabled.
+func (i IntegrityConfig) Enabled() bool { return i.enabled }
+
+// AutoReset returns whether a diverged or force-pushed repository is
+// automatically resynced to match its remote.
+func (i IntegrityConfig) AutoReset() bool { return i.autoReset }
+
+// CheckInterval returns how often tracked refs are compared against the remote.
+func (i IntegrityConfig) CheckInterval() time.Duration {
+	return time.Duration(i.checkIntervalSeconds * float64(time.Second))
+}
+
+// WithEnabled returns a new config with the specified enabled state.
+func (i IntegrityConfig) WithEnabled(enabled bool) IntegrityConfig {
+	i.enabled = enabled
+	return i
+}
+
+// WithAutoReset returns a new config with the specified auto-reset state.
+func (i IntegrityConfig) WithAutoReset(autoReset bool) IntegrityConfig {
+	i.autoReset = autoReset
+	return i
+}
+
+// WithCheckIntervalSeconds returns a new config with the specified check interval.
+func (i IntegrityConfig) WithCheckIntervalSeconds(seconds float64) IntegrityConfig {
+	i.checkIntervalSeconds = seconds
+	return i
+}
+
+// CompactionConfig configures periodic vector store compaction — removing
+// orphaned vector/BM25 rows and vacuuming the underlying stores.
+type CompactionConfig struct {
+	enabled              bool
+	checkIntervalSeconds float64
+}
+
+// NewCompactionConfig creates a new CompactionConfig with defaults.
+func NewCompactionConfig() CompactionConfig {
+	return CompactionConfig{
+		enabled:              true,
+		checkIntervalSeconds: DefaultCompactionCheckInterval,
+	}
+}
+
+// Enabled returns whether periodic compaction is enabled.
+func (c CompactionConfig) Enabled() bool { return c.enabled }
+
+// CheckInterval returns how often the vector stores are compacted.
+func (c CompactionConfig) CheckInterval() time.Duration {
+	return time.Duration(c.checkIntervalSeconds * float64(time.Second))
+}
+
+// WithEnabled returns a new config with the specified enabled state.
+func (c CompactionConfig) WithEnabled(enabled bool) CompactionConfig {
+	c.enabled = enabled
+	return c
+}
+
+// WithCheckIntervalSeconds returns a new config with the specified check interval.
+func (c CompactionConfig) WithCheckIntervalSeconds(seconds float64) CompactionConfig {
+	c.checkIntervalSeconds = seconds
+	return c
+}
+
+// WarmUpConfig configures index warm-up on startup.
+type WarmUpConfig struct {
+	enabled   bool
+	repoLimit int
+	queries   []string
+}
+
+// NewWarmUpConfig creates a new WarmUpConfig with defaults.
+func NewWarmUpConfig() WarmUpConfig {
+	return WarmUpConfig{
+		enabled:   false,
+		repoLimit: DefaultWarmUpRepoLimit,
+		queries:   []string{DefaultWarmUpQuery},
+	}
+}
+
+// Enabled returns whether index warm-up is enabled.
+func (w WarmUpConfig) Enabled() bool { return w.enabled }
+
+// RepoLimit returns the maximum number of repositories to warm up.
+func (w WarmUpConfig) RepoLimit() int { return w.repoLimit }
+
+// Queries returns the representative queries run against each warmed-up
+// repository.
+func (w WarmUpConfig) Queries() []string { return w.queries }
+
+// WithEnabled returns a new config with the specified enabled state.
+func (w WarmUpConfig) WithEnabled(enabled bool) WarmUpConfig {
+	w.enabled = enabled
+	return w
+}
+
+// WithRepoLimit returns a new config with the specified repository limit.
+func (w WarmUpConfig) WithRepoLimit(limit int) WarmUpConfig {
+	w.repoLimit = limit
+	return w
+}
+
+// WithQueries returns a new config with the specified representative queries.
+func (w WarmUpConfig) WithQueries(queries []string) WarmUpConfig {
+	w.queries = queries
+	return w
+}
+
+// DiscoveryConfig configures automatic repository discovery from an inbound
+// Git hosting webhook firehose: when a "repository created" event's org/name
+// matches one of NamePatterns, the repository is either registered
+// immediately or queued as a candidate for manual review.
+type DiscoveryConfig struct {
+	enabled       bool
+	webhookSecret string
+	namePatterns  []string
+	autoRegister  bool
+}
+
+// NewDiscoveryConfig creates a new DiscoveryConfig with defaults.
+func NewDiscoveryConfig() DiscoveryConfig {
+	return DiscoveryConfig{
+		enabled:      false,
+		autoRegister: false,
+	}
+}
+
+// Enabled returns whether the discovery webhook endpoint is enabled.
+func (d DiscoveryConfig) Enabled() bool { return d.enabled }
+
+// WebhookSecret returns the shared secret used to verify inbound webhook
+// signatures.
+func (d DiscoveryConfig) WebhookSecret() string { return d.webhookSecret }
+
+// NamePatterns returns the glob patterns matched against "org/name" and
+// "name" for incoming events.
+func (d DiscoveryConfig) NamePatterns() []string { return d.namePatterns }
+
+// AutoRegister returns whether matching repositories are registered
+// immediately instead of queued for manual review.
+func (d DiscoveryConfig) AutoRegister() bool { return d.autoRegister }
+
+// WithEnabled returns a new config with the specified enabled state.
+func (d DiscoveryConfig) WithEnabled(enabled bool) DiscoveryConfig {
+	d.enabled = enabled
+	return d
+}
+
+// WithWebhookSecret returns a new config with the specified webhook secret.
+func (d DiscoveryConfig) WithWebhookSecret(secret string) DiscoveryConfig {
+	d.webhookSecret = secret
+	return d
+}
+
+// WithNamePatterns returns a new config with the specified name patterns.
+func (d DiscoveryConfig) WithNamePatterns(patterns []string) DiscoveryConfig {
+	d.namePatterns = patterns
+	return d
+}
+
+// WithAutoRegister returns a new config with the specified auto-register state.
+func (d DiscoveryConfig) WithAutoRegister(autoRegister bool) DiscoveryConfig {
+	d.autoRegister = autoRegister
+	return d
+}
+
+// TLSConfig configures native TLS termination for the HTTP server,
+// including optional mutual TLS client certificate verification.
+type TLSConfig struct {
+	certFile          string
+	keyFile           string
+	clientCAFile      string
+	requireClientCert bool
+}
+
+// NewTLSConfig creates a new TLSConfig with defaults (TLS disabled).
+func NewTLSConfig() TLSConfig {
+	return TLSConfig{}
+}
+
+// CertFile returns the server certificate file path.
+func (t TLSConfig) CertFile() string { return t.certFile }
+
+// KeyFile returns the server private key file path.
+func (t TLSConfig) KeyFile() string { return t.keyFile }
+
+// ClientCAFile returns the CA bundle used to verify client certificates, if
+// mutual TLS is configured.
+func (t TLSConfig) ClientCAFile() string { return t.clientCAFile }
+
+// RequireClientCert returns whether a verified client certificate is
+// mandatory when ClientCAFile is set. If false, a client certificate is
+// verified when presented but not required.
+func (t TLSConfig) RequireClientCert() bool { return t.requireClientCert }
+
+// Enabled returns whether TLS termination is configured.
+func (t TLSConfig) Enabled() bool {
+	return t.certFile != "" && t.keyFile != ""
+}
+
+// MutualTLSEnabled returns whether client certificate verification is configured.
+func (t TLSConfig) MutualTLSEnabled() bool {
+	return t.clientCAFile != ""
+}
+
+// WithCertFile returns a new config with the specified server certificate file.
+func (t TLSConfig) WithCertFile(path string) TLSConfig {
+	t.certFile = path
+	return t
+}
+
+// WithKeyFile returns a new config with the specified server private key file.
+func (t TLSConfig) WithKeyFile(path string) TLSConfig {
+	t.keyFile = path
+	return t
+}
+
+// WithClientCAFile returns a new config with the specified client CA bundle.
+func (t TLSConfig) WithClientCAFile(path string) TLSConfig {
+	t.clientCAFile = path
+	return t
+}
+
+// WithRequireClientCert returns a new config with the specified requirement.
+func (t TLSConfig) WithRequireClientCert(required bool) TLSConfig {
+	t.requireClientCert = required
+	return t
+}
+
+// CloneEncryptionConfig configures at-rest encryption of Git working copies.
+// When configured, KeyHex sources the AES-256 key used to seal and open
+// working copies; the caller (git.NewWorkingCopyEncryptor) decodes and
+// validates it.
+type CloneEncryptionConfig struct {
+	keyHex string
+}
+
+// NewCloneEncryptionConfig creates a new CloneEncryptionConfig with defaults
+// (encryption disabled).
+func NewCloneEncryptionConfig() CloneEncryptionConfig {
+	return CloneEncryptionConfig{}
+}
+
+// KeyHex returns the hex-encoded AES-256 key, or empty if unconfigured.
+func (c CloneEncryptionConfig) KeyHex() string { return c.keyHex }
+
+// Enabled returns whether clone encryption is configured.
+func (c CloneEncryptionConfig) Enabled() bool { return c.keyHex != "" }
+
+// WithKeyHex returns a new config with the specified hex-encoded key.
+func (c CloneEncryptionConfig) WithKeyHex(keyHex string) CloneEncryptionConfig {
+	c.keyHex = keyHex
+	return c
+}
+
 // RemoteConfig configures remote server connection.
 type RemoteConfig struct {
 	serverURL  string
@@ -411,28 +761,42 @@ func NewRemoteConfigWithOptions(opts ...RemoteConfigOption) RemoteConfig {
 
 // AppConfig holds the main application configuration.
 type AppConfig struct {
-	host                    string
-	port                    int
-	dataDir                 string
-	dbURL                   string
-	logLevel                string
-	logFormat               LogFormat
-	disableTelemetry        bool
-	skipProviderValidation  bool
-	embeddingEndpoint       *Endpoint
-	enrichmentEndpoint      *Endpoint
-	visionEmbeddingEndpoint *Endpoint
-	periodicSync            PeriodicSyncConfig
-	apiKeys                 []string
-	remote                  RemoteConfig
-	reporting               ReportingConfig
-	litellmCache            LiteLLMCacheConfig
-	workerCount             int
-	searchLimit             int
-	httpCacheDir            string
-	chunkSize               int
-	chunkOverlap            int
-	chunkMinSize            int
+	host                              string
+	port                              int
+	dataDir                           string
+	dbURL                             string
+	logLevel                          string
+	logFormat                         LogFormat
+	disableTelemetry                  bool
+	skipProviderValidation            bool
+	embeddingEndpoint                 *Endpoint
+	enrichmentEndpoint                *Endpoint
+	visionEmbeddingEndpoint           *Endpoint
+	periodicSync                      PeriodicSyncConfig
+	healthAlert                       HealthAlertConfig
+	integrity                         IntegrityConfig
+	compaction                        CompactionConfig
+	warmUp                            WarmUpConfig
+	discovery                         DiscoveryConfig
+	tls                               TLSConfig
+	cloneEncryption                   CloneEncryptionConfig
+	apiKeys                           []string
+	remote                            RemoteConfig
+	reporting                         ReportingConfig
+	litellmCache                      LiteLLMCacheConfig
+	workerCount                       int
+	workerDrainTimeout                time.Duration
+	searchLimit                       int
+	httpCacheDir                      string
+	chunkSize                         int
+	chunkOverlap                      int
+	chunkMinSize                      int
+	embeddingContextTemplate          string
+	embeddingContextLanguageTemplates map[string]string
+	embeddingDimensions               int
+	searchProvider                    string
+	qdrantURL                         string
+	qdrantAPIKey                      string
 }
 
 // DefaultDataDir returns the default data directory.
@@ -477,20 +841,27 @@ func PrepareCloneDir(cloneDir, dataDir string) (string, error) {
 func NewAppConfig() AppConfig {
 	dataDir := DefaultDataDir()
 	return AppConfig{
-		host:             DefaultHost,
-		port:             DefaultPort,
-		dataDir:          dataDir,
-		dbURL:            "sqlite:///" + filepath.Join(dataDir, "kodit.db"),
-		logLevel:         DefaultLogLevel,
-		logFormat:        LogFormatPretty,
-		disableTelemetry: false,
-		periodicSync:     NewPeriodicSyncConfig(),
-		apiKeys:          []string{},
-		remote:           NewRemoteConfig(),
-		reporting:        NewReportingConfig(),
-		litellmCache:     NewLiteLLMCacheConfig(),
-		workerCount:      DefaultWorkerCount,
-		searchLimit:      DefaultSearchLimit,
+		host:               DefaultHost,
+		port:               DefaultPort,
+		dataDir:            dataDir,
+		dbURL:              "sqlite:///" + filepath.Join(dataDir, "kodit.db"),
+		logLevel:           DefaultLogLevel,
+		logFormat:          LogFormatPretty,
+		disableTelemetry:   false,
+		periodicSync:       NewPeriodicSyncConfig(),
+		healthAlert:        NewHealthAlertConfig(),
+		integrity:          NewIntegrityConfig(),
+		warmUp:             NewWarmUpConfig(),
+		discovery:          NewDiscoveryConfig(),
+		tls:                NewTLSConfig(),
+		apiKeys:            []string{},
+		remote:             NewRemoteConfig(),
+		reporting:          NewReportingConfig(),
+		litellmCache:       NewLiteLLMCacheConfig(),
+		workerCount:        DefaultWorkerCount,
+		workerDrainTimeout: DefaultWorkerDrainTimeout,
+		searchLimit:        DefaultSearchLimit,
+		searchProvider:     DefaultSearchProvider,
 	}
 }
 
@@ -536,6 +907,27 @@ func (c AppConfig) VisionEmbeddingEndpoint() *Endpoint { return c.visionEmbeddin
 // PeriodicSync returns the periodic sync config.
 func (c AppConfig) PeriodicSync() PeriodicSyncConfig { return c.periodicSync }
 
+// HealthAlert returns the repository health alerting config.
+func (c AppConfig) HealthAlert() HealthAlertConfig { return c.healthAlert }
+
+// Integrity returns the repository integrity verification config.
+func (c AppConfig) Integrity() IntegrityConfig { return c.integrity }
+
+// Compaction returns the periodic vector store compaction config.
+func (c AppConfig) Compaction() CompactionConfig { return c.compaction }
+
+// TLS returns the TLS termination config.
+func (c AppConfig) TLS() TLSConfig { return c.tls }
+
+// CloneEncryption returns the working copy at-rest encryption config.
+func (c AppConfig) CloneEncryption() CloneEncryptionConfig { return c.cloneEncryption }
+
+// WarmUp returns the index warm-up config.
+func (c AppConfig) WarmUp() WarmUpConfig { return c.warmUp }
+
+// Discovery returns the automatic repository discovery config.
+func (c AppConfig) Discovery() DiscoveryConfig { return c.discovery }
+
 // APIKeys returns the configured API keys.
 func (c AppConfig) APIKeys() []string {
 	keys := make([]string, len(c.apiKeys))
@@ -555,6 +947,10 @@ func (c AppConfig) LiteLLMCache() LiteLLMCacheConfig { return c.litellmCache }
 // WorkerCount returns the number of background workers.
 func (c AppConfig) WorkerCount() int { return c.workerCount }
 
+// WorkerDrainTimeout returns how long the worker waits for an in-flight task
+// to finish on shutdown before giving up and requeuing it.
+func (c AppConfig) WorkerDrainTimeout() time.Duration { return c.workerDrainTimeout }
+
 // SearchLimit returns the default search result limit.
 func (c AppConfig) SearchLimit() int { return c.searchLimit }
 
@@ -570,6 +966,35 @@ func (c AppConfig) ChunkOverlap() int { return c.chunkOverlap }
 // ChunkMinSize returns the minimum chunk size in characters.
 func (c AppConfig) ChunkMinSize() int { return c.chunkMinSize }
 
+// EmbeddingContextTemplate returns the default header template prepended to
+// code before it is sent for embedding, or "" if context headers are
+// disabled.
+func (c AppConfig) EmbeddingContextTemplate() string { return c.embeddingContextTemplate }
+
+// EmbeddingContextLanguageTemplates returns the per-language context header
+// template overrides, keyed by language.
+func (c AppConfig) EmbeddingContextLanguageTemplates() map[string]string {
+	templates := make(map[string]string, len(c.embeddingContextLanguageTemplates))
+	for language, tmpl := range c.embeddingContextLanguageTemplates {
+		templates[language] = tmpl
+	}
+	return templates
+}
+
+// EmbeddingDimensions returns the configured truncated embedding dimension,
+// or 0 if truncation is disabled (the full model dimension is stored).
+func (c AppConfig) EmbeddingDimensions() int { return c.embeddingDimensions }
+
+// SearchProvider returns the selected vector search backend
+// ("vectorchord", "pgvector", or "qdrant").
+func (c AppConfig) SearchProvider() string { return c.searchProvider }
+
+// QdrantURL returns the Qdrant REST endpoint, when SearchProvider is "qdrant".
+func (c AppConfig) QdrantURL() string { return c.qdrantURL }
+
+// QdrantAPIKey returns the Qdrant API key, when SearchProvider is "qdrant".
+func (c AppConfig) QdrantAPIKey() string { return c.qdrantAPIKey }
+
 // IsRemote returns true if running in remote mode.
 func (c AppConfig) IsRemote() bool {
 	return c.remote.IsConfigured()
@@ -670,6 +1095,41 @@ func WithPeriodicSyncConfig(p PeriodicSyncConfig) AppConfigOption {
 	return func(c *AppConfig) { c.periodicSync = p }
 }
 
+// WithHealthAlertConfig sets the repository health alerting config.
+func WithHealthAlertConfig(h HealthAlertConfig) AppConfigOption {
+	return func(c *AppConfig) { c.healthAlert = h }
+}
+
+// WithIntegrityConfig sets the repository integrity verification config.
+func WithIntegrityConfig(i IntegrityConfig) AppConfigOption {
+	return func(c *AppConfig) { c.integrity = i }
+}
+
+// WithCompactionConfig sets the periodic vector store compaction config.
+func WithCompactionConfig(comp CompactionConfig) AppConfigOption {
+	return func(c *AppConfig) { c.compaction = comp }
+}
+
+// WithWarmUpConfig sets the index warm-up config.
+func WithWarmUpConfig(w WarmUpConfig) AppConfigOption {
+	return func(c *AppConfig) { c.warmUp = w }
+}
+
+// WithDiscoveryConfig sets the automatic repository discovery config.
+func WithDiscoveryConfig(d DiscoveryConfig) AppConfigOption {
+	return func(c *AppConfig) { c.discovery = d }
+}
+
+// WithTLSConfig sets the TLS termination config.
+func WithTLSConfig(t TLSConfig) AppConfigOption {
+	return func(c *AppConfig) { c.tls = t }
+}
+
+// WithCloneEncryptionConfig sets the working copy at-rest encryption config.
+func WithCloneEncryptionConfig(e CloneEncryptionConfig) AppConfigOption {
+	return func(c *AppConfig) { c.cloneEncryption = e }
+}
+
 // WithAPIKeys sets the API keys.
 func WithAPIKeys(keys []string) AppConfigOption {
 	return func(c *AppConfig) {
@@ -702,6 +1162,16 @@ func WithWorkerCount(n int) AppConfigOption {
 	}
 }
 
+// WithWorkerDrainTimeout sets how long the worker waits for an in-flight
+// task to finish on shutdown before giving up and requeuing it.
+func WithWorkerDrainTimeout(d time.Duration) AppConfigOption {
+	return func(c *AppConfig) {
+		if d > 0 {
+			c.workerDrainTimeout = d
+		}
+	}
+}
+
 // WithSearchLimit sets the default search result limit.
 func WithSearchLimit(n int) AppConfigOption {
 	return func(c *AppConfig) {
@@ -731,6 +1201,38 @@ func WithChunkMinSize(minSize int) AppConfigOption {
 	return func(c *AppConfig) { c.chunkMinSize = minSize }
 }
 
+// WithEmbeddingDimensions sets the truncated embedding dimension.
+func WithEmbeddingDimensions(dimensions int) AppConfigOption {
+	return func(c *AppConfig) { c.embeddingDimensions = dimensions }
+}
+
+// WithEmbeddingContextTemplate sets the default context header template
+// prepended to code before it is sent for embedding.
+func WithEmbeddingContextTemplate(template string) AppConfigOption {
+	return func(c *AppConfig) { c.embeddingContextTemplate = template }
+}
+
+// WithEmbeddingContextLanguageTemplates sets the per-language context
+// header template overrides, keyed by language.
+func WithEmbeddingContextLanguageTemplates(templates map[string]string) AppConfigOption {
+	return func(c *AppConfig) { c.embeddingContextLanguageTemplates = templates }
+}
+
+// WithSearchProvider sets the vector search backend.
+func WithSearchProvider(provider string) AppConfigOption {
+	return func(c *AppConfig) { c.searchProvider = provider }
+}
+
+// WithQdrantURL sets the Qdrant REST endpoint.
+func WithQdrantURL(url string) AppConfigOption {
+	return func(c *AppConfig) { c.qdrantURL = url }
+}
+
+// WithQdrantAPIKey sets the Qdrant API key.
+func WithQdrantAPIKey(key string) AppConfigOption {
+	return func(c *AppConfig) { c.qdrantAPIKey = key }
+}
+
 // NewAppConfigWithOptions creates an AppConfig with functional options.
 func NewAppConfigWithOptions(opts ...AppConfigOption) AppConfig {
 	c := NewAppConfig()
@@ -767,7 +1269,11 @@ func (c AppConfig) LogConfig(event *zerolog.Event) *zerolog.Event {
 		Int("api_keys_count", len(c.apiKeys)).
 		Bool("skip_provider_validation", c.skipProviderValidation).
 		Bool("periodic_sync_enabled", c.periodicSync.Enabled()).
-		Dur("periodic_sync_interval", c.periodicSync.Interval())
+		Dur("periodic_sync_interval", c.periodicSync.Interval()).
+		Bool("warm_up_enabled", c.warmUp.Enabled()).
+		Bool("discovery_enabled", c.discovery.Enabled()).
+		Bool("tls_enabled", c.tls.Enabled()).
+		Bool("mutual_tls_enabled", c.tls.MutualTLSEnabled())
 }
 
 func (c AppConfig) maskedDBURL() string {
@@ -204,6 +204,92 @@ func TestPeriodicSyncConfig(t *testing.T) {
 	}
 }
 
+func TestWarmUpConfig(t *testing.T) {
+	cfg := NewWarmUpConfig()
+
+	if cfg.Enabled() {
+		t.Error("Enabled() should be false by default")
+	}
+	if cfg.RepoLimit() != DefaultWarmUpRepoLimit {
+		t.Errorf("RepoLimit() = %v, want %v", cfg.RepoLimit(), DefaultWarmUpRepoLimit)
+	}
+	if len(cfg.Queries()) != 1 || cfg.Queries()[0] != DefaultWarmUpQuery {
+		t.Errorf("Queries() = %v, want [%v]", cfg.Queries(), DefaultWarmUpQuery)
+	}
+
+	cfg = cfg.WithEnabled(true).WithRepoLimit(10).WithQueries([]string{"error handling", "main"})
+	if !cfg.Enabled() {
+		t.Error("Enabled() should be true")
+	}
+	if cfg.RepoLimit() != 10 {
+		t.Errorf("RepoLimit() = %v, want 10", cfg.RepoLimit())
+	}
+	if len(cfg.Queries()) != 2 {
+		t.Errorf("Queries() = %v, want 2 entries", cfg.Queries())
+	}
+}
+
+func TestDiscoveryConfig(t *testing.T) {
+	cfg := NewDiscoveryConfig()
+
+	if cfg.Enabled() {
+		t.Error("Enabled() should be false by default")
+	}
+	if cfg.AutoRegister() {
+		t.Error("AutoRegister() should be false by default")
+	}
+	if cfg.WebhookSecret() != "" {
+		t.Errorf("WebhookSecret() = %v, want empty", cfg.WebhookSecret())
+	}
+
+	cfg = cfg.WithEnabled(true).WithWebhookSecret("s3cr3t").WithNamePatterns([]string{"acme/*"}).WithAutoRegister(true)
+	if !cfg.Enabled() {
+		t.Error("Enabled() should be true")
+	}
+	if cfg.WebhookSecret() != "s3cr3t" {
+		t.Errorf("WebhookSecret() = %v, want s3cr3t", cfg.WebhookSecret())
+	}
+	if len(cfg.NamePatterns()) != 1 || cfg.NamePatterns()[0] != "acme/*" {
+		t.Errorf("NamePatterns() = %v, want [acme/*]", cfg.NamePatterns())
+	}
+	if !cfg.AutoRegister() {
+		t.Error("AutoRegister() should be true")
+	}
+}
+
+func TestTLSConfig(t *testing.T) {
+	cfg := NewTLSConfig()
+
+	if cfg.Enabled() {
+		t.Error("Enabled() should be false by default")
+	}
+	if cfg.MutualTLSEnabled() {
+		t.Error("MutualTLSEnabled() should be false by default")
+	}
+
+	cfg = cfg.WithCertFile("/etc/kodit/tls.crt").WithKeyFile("/etc/kodit/tls.key")
+	if !cfg.Enabled() {
+		t.Error("Enabled() should be true once cert and key are set")
+	}
+	if cfg.CertFile() != "/etc/kodit/tls.crt" {
+		t.Errorf("CertFile() = %v, want /etc/kodit/tls.crt", cfg.CertFile())
+	}
+	if cfg.KeyFile() != "/etc/kodit/tls.key" {
+		t.Errorf("KeyFile() = %v, want /etc/kodit/tls.key", cfg.KeyFile())
+	}
+
+	cfg = cfg.WithClientCAFile("/etc/kodit/ca.crt").WithRequireClientCert(true)
+	if !cfg.MutualTLSEnabled() {
+		t.Error("MutualTLSEnabled() should be true once a client CA is set")
+	}
+	if cfg.ClientCAFile() != "/etc/kodit/ca.crt" {
+		t.Errorf("ClientCAFile() = %v, want /etc/kodit/ca.crt", cfg.ClientCAFile())
+	}
+	if !cfg.RequireClientCert() {
+		t.Error("RequireClientCert() should be true")
+	}
+}
+
 func TestRemoteConfig(t *testing.T) {
 	cfg := NewRemoteConfig()
 
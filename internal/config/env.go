@@ -65,9 +65,35 @@ type EnvConfig struct {
 	// PeriodicSync configures periodic repository syncing.
 	PeriodicSync PeriodicSyncEnv `envconfig:"PERIODIC_SYNC"`
 
+	// HealthAlert configures repository health alerting.
+	HealthAlert HealthAlertEnv `envconfig:"HEALTH_ALERT"`
+
+	// Integrity configures periodic verification of tracked repositories
+	// against their upstream remote.
+	Integrity IntegrityEnv `envconfig:"INTEGRITY"`
+
+	// Compaction configures periodic vector store compaction.
+	Compaction CompactionEnv `envconfig:"COMPACTION"`
+
+	// WarmUp configures index warm-up on startup.
+	WarmUp WarmUpEnv `envconfig:"WARM_UP"`
+
+	// Discovery configures automatic repository discovery from an inbound
+	// Git hosting webhook.
+	Discovery DiscoveryEnv `envconfig:"DISCOVERY"`
+
 	// Remote configures remote server connection.
 	Remote RemoteEnv `envconfig:"REMOTE"`
 
+	// TLS configures native TLS termination and optional mutual TLS.
+	TLS TLSEnv `envconfig:"TLS"`
+
+	// CloneEncryptionKey is the hex-encoded AES-256 key used to encrypt Git
+	// working copies at rest. Empty disables clone encryption; if set, it
+	// must decode to exactly 32 bytes.
+	// Env: CLONE_ENCRYPTION_KEY
+	CloneEncryptionKey string `envconfig:"CLONE_ENCRYPTION_KEY"`
+
 	// Reporting configures progress reporting.
 	Reporting ReportingEnv `envconfig:"REPORTING"`
 
@@ -78,6 +104,11 @@ type EnvConfig struct {
 	// Env: WORKER_COUNT (default: 1)
 	WorkerCount int `envconfig:"WORKER_COUNT" default:"1"`
 
+	// WorkerDrainTimeout is how long the worker waits for an in-flight task
+	// to finish on shutdown before giving up and requeuing it.
+	// Env: WORKER_DRAIN_TIMEOUT (default: 30s)
+	WorkerDrainTimeout time.Duration `envconfig:"WORKER_DRAIN_TIMEOUT" default:"30s"`
+
 	// SearchLimit is the default search result limit.
 	// Env: SEARCH_LIMIT (default: 10)
 	SearchLimit int `envconfig:"SEARCH_LIMIT" default:"10"`
@@ -98,10 +129,52 @@ type EnvConfig struct {
 	// ChunkMinSize is the minimum chunk size in characters; smaller chunks are dropped.
 	// Env: CHUNK_MIN_SIZE (default: 50)
 	ChunkMinSize int `envconfig:"CHUNK_MIN_SIZE" default:"50"`
+
+	// EmbeddingContextTemplate is the default header template prepended to
+	// code before it is sent for embedding. Supports the placeholders
+	// {repo}, {path}, {language}, and {docstring}. Empty disables context
+	// headers; the raw code is embedded as before.
+	// Env: EMBEDDING_CONTEXT_TEMPLATE
+	EmbeddingContextTemplate string `envconfig:"EMBEDDING_CONTEXT_TEMPLATE"`
+
+	// EmbeddingContextLanguageTemplates is a JSON-encoded map of language to
+	// context header template, overriding EmbeddingContextTemplate for those
+	// languages.
+	// Env: EMBEDDING_CONTEXT_LANGUAGE_TEMPLATES
+	EmbeddingContextLanguageTemplates string `envconfig:"EMBEDDING_CONTEXT_LANGUAGE_TEMPLATES"`
+
+	// EmbeddingDimensions truncates stored and query embedding vectors to
+	// this many leading dimensions, for Matryoshka-capable models. 0 disables
+	// truncation and stores the model's full dimension.
+	// Env: EMBEDDING_DIMENSIONS (default: 0)
+	EmbeddingDimensions int `envconfig:"EMBEDDING_DIMENSIONS" default:"0"`
+
+	// SearchProvider selects the vector search backend: "vectorchord" (the
+	// default, requires Postgres), "pgvector" (plain Postgres, requires
+	// DB_URL to point at Postgres), or "qdrant" (external vector database,
+	// requires QdrantURL).
+	// Env: DEFAULT_SEARCH_PROVIDER (default: vectorchord)
+	SearchProvider string `envconfig:"DEFAULT_SEARCH_PROVIDER" default:"vectorchord"`
+
+	// QdrantURL is the Qdrant REST endpoint, required when SearchProvider is "qdrant".
+	// Env: QDRANT_URL
+	QdrantURL string `envconfig:"QDRANT_URL"`
+
+	// QdrantAPIKey authenticates against the Qdrant instance, if required.
+	// Env: QDRANT_API_KEY
+	QdrantAPIKey string `envconfig:"QDRANT_API_KEY"`
 }
 
 // EndpointEnv holds environment configuration for an AI endpoint.
 type EndpointEnv struct {
+	// Type selects the provider implementation: "openai" (also used for any
+	// OpenAI-compatible endpoint, the default), "azure_openai", "cohere",
+	// "voyage", or "ollama" for the embedding endpoint; "openai", "anthropic",
+	// or "gemini" for the enrichment endpoint. The vision embedding endpoint
+	// only honors "openai".
+	// Env: *_TYPE (default: openai)
+	Type string `envconfig:"TYPE" default:"openai"`
+
 	// BaseURL is the base URL for the endpoint.
 	// Env: *_BASE_URL
 	BaseURL string `envconfig:"BASE_URL"`
@@ -181,6 +254,175 @@ type PeriodicSyncEnv struct {
 	RetryAttempts int `envconfig:"RETRY_ATTEMPTS" default:"3"`
 }
 
+// HealthAlertEnv holds environment configuration for repository health alerting.
+type HealthAlertEnv struct {
+	// Enabled controls whether health alerting is enabled.
+	// Env: HEALTH_ALERT_ENABLED (default: true)
+	Enabled bool `envconfig:"ENABLED" default:"true"`
+
+	// ScoreThreshold is the health score at or below which a repository triggers an alert.
+	// Env: HEALTH_ALERT_SCORE_THRESHOLD (default: 50)
+	ScoreThreshold float64 `envconfig:"SCORE_THRESHOLD" default:"50"`
+
+	// CheckIntervalSeconds is how often repository health is recomputed.
+	// Env: HEALTH_ALERT_CHECK_INTERVAL_SECONDS (default: 300)
+	CheckIntervalSeconds float64 `envconfig:"CHECK_INTERVAL_SECONDS" default:"300"`
+
+	// WebhookURL is the webhook to notify on alert; empty logs alerts instead.
+	// Env: HEALTH_ALERT_WEBHOOK_URL
+	WebhookURL string `envconfig:"WEBHOOK_URL"`
+}
+
+// ToHealthAlertConfig converts HealthAlertEnv to HealthAlertConfig.
+func (h HealthAlertEnv) ToHealthAlertConfig() HealthAlertConfig {
+	return NewHealthAlertConfig().
+		WithEnabled(h.Enabled).
+		WithScoreThreshold(h.ScoreThreshold).
+		WithCheckIntervalSeconds(h.CheckIntervalSeconds).
+		WithWebhookURL(h.WebhookURL)
+}
+
+// IntegrityEnv holds environment configuration for repository integrity verification.
+type IntegrityEnv struct {
+	// Enabled controls whether integrity verification is enabled.
+	// Env: INTEGRITY_ENABLED (default: true)
+	Enabled bool `envconfig:"ENABLED" default:"true"`
+
+	// AutoReset controls whether a diverged or force-pushed repository is
+	// automatically resynced to match its remote.
+	// Env: INTEGRITY_AUTO_RESET (default: false)
+	AutoReset bool `envconfig:"AUTO_RESET" default:"false"`
+
+	// CheckIntervalSeconds is how often tracked refs are compared against the remote.
+	// Env: INTEGRITY_CHECK_INTERVAL_SECONDS (default: 3600)
+	CheckIntervalSeconds float64 `envconfig:"CHECK_INTERVAL_SECONDS" default:"3600"`
+}
+
+// ToIntegrityConfig converts IntegrityEnv to IntegrityConfig.
+func (i IntegrityEnv) ToIntegrityConfig() IntegrityConfig {
+	return NewIntegrityConfig().
+		WithEnabled(i.Enabled).
+		WithAutoReset(i.AutoReset).
+		WithCheckIntervalSeconds(i.CheckIntervalSeconds)
+}
+
+// CompactionEnv holds environment configuration for periodic vector store compaction.
+type CompactionEnv struct {
+	// Enabled controls whether periodic compaction is enabled.
+	// Env: COMPACTION_ENABLED (default: true)
+	Enabled bool `envconfig:"ENABLED" default:"true"`
+
+	// CheckIntervalSeconds is how often the vector stores are compacted.
+	// Env: COMPACTION_CHECK_INTERVAL_SECONDS (default: 86400)
+	CheckIntervalSeconds float64 `envconfig:"CHECK_INTERVAL_SECONDS" default:"86400"`
+}
+
+// ToCompactionConfig converts CompactionEnv to CompactionConfig.
+func (c CompactionEnv) ToCompactionConfig() CompactionConfig {
+	return NewCompactionConfig().
+		WithEnabled(c.Enabled).
+		WithCheckIntervalSeconds(c.CheckIntervalSeconds)
+}
+
+// WarmUpEnv holds environment configuration for index warm-up.
+type WarmUpEnv struct {
+	// Enabled controls whether index warm-up runs on startup.
+	// Env: WARM_UP_ENABLED (default: false)
+	Enabled bool `envconfig:"ENABLED" default:"false"`
+
+	// RepoLimit is the maximum number of repositories to warm up, most
+	// recently synced first.
+	// Env: WARM_UP_REPO_LIMIT (default: 5)
+	RepoLimit int `envconfig:"REPO_LIMIT" default:"5"`
+
+	// Queries is a comma-separated list of representative queries to run
+	// against each warmed-up repository.
+	// Env: WARM_UP_QUERIES (default: "function")
+	Queries string `envconfig:"QUERIES" default:"function"`
+}
+
+// ToWarmUpConfig converts WarmUpEnv to WarmUpConfig.
+func (w WarmUpEnv) ToWarmUpConfig() WarmUpConfig {
+	cfg := NewWarmUpConfig().
+		WithEnabled(w.Enabled).
+		WithRepoLimit(w.RepoLimit)
+
+	if w.Queries != "" {
+		cfg = cfg.WithQueries(parseQueries(w.Queries))
+	}
+
+	return cfg
+}
+
+// DiscoveryEnv holds environment configuration for automatic repository
+// discovery from an inbound Git hosting webhook.
+type DiscoveryEnv struct {
+	// Enabled controls whether the discovery webhook endpoint is enabled.
+	// Env: DISCOVERY_ENABLED (default: false)
+	Enabled bool `envconfig:"ENABLED" default:"false"`
+
+	// WebhookSecret is the shared secret used to verify inbound webhook signatures.
+	// Env: DISCOVERY_WEBHOOK_SECRET
+	WebhookSecret string `envconfig:"WEBHOOK_SECRET"`
+
+	// NamePatterns is a comma-separated list of glob patterns matched
+	// against "org/name" and "name" for incoming events.
+	// Env: DISCOVERY_NAME_PATTERNS
+	NamePatterns string `envconfig:"NAME_PATTERNS"`
+
+	// AutoRegister controls whether matching repositories are registered
+	// immediately instead of queued for manual review.
+	// Env: DISCOVERY_AUTO_REGISTER (default: false)
+	AutoRegister bool `envconfig:"AUTO_REGISTER" default:"false"`
+}
+
+// ToDiscoveryConfig converts DiscoveryEnv to DiscoveryConfig.
+func (d DiscoveryEnv) ToDiscoveryConfig() DiscoveryConfig {
+	cfg := NewDiscoveryConfig().
+		WithEnabled(d.Enabled).
+		WithWebhookSecret(d.WebhookSecret).
+		WithAutoRegister(d.AutoRegister)
+
+	if d.NamePatterns != "" {
+		cfg = cfg.WithNamePatterns(parseQueries(d.NamePatterns))
+	}
+
+	return cfg
+}
+
+// TLSEnv holds environment configuration for native TLS termination.
+type TLSEnv struct {
+	// CertFile is the path to the server TLS certificate. Reloaded
+	// automatically when the file changes on disk, so certificates can be
+	// rotated without a restart.
+	// Env: TLS_CERT_FILE
+	CertFile string `envconfig:"CERT_FILE"`
+
+	// KeyFile is the path to the server TLS private key, reloaded alongside
+	// CertFile on rotation.
+	// Env: TLS_KEY_FILE
+	KeyFile string `envconfig:"KEY_FILE"`
+
+	// ClientCAFile is the path to a CA bundle used to verify client
+	// certificates for mutual TLS. Empty disables client verification.
+	// Env: TLS_CLIENT_CA_FILE
+	ClientCAFile string `envconfig:"CLIENT_CA_FILE"`
+
+	// RequireClientCert makes a verified client certificate mandatory when
+	// ClientCAFile is set, rather than merely verified-if-presented.
+	// Env: TLS_REQUIRE_CLIENT_CERT (default: false)
+	RequireClientCert bool `envconfig:"REQUIRE_CLIENT_CERT" default:"false"`
+}
+
+// ToTLSConfig converts TLSEnv to TLSConfig.
+func (t TLSEnv) ToTLSConfig() TLSConfig {
+	return NewTLSConfig().
+		WithCertFile(t.CertFile).
+		WithKeyFile(t.KeyFile).
+		WithClientCAFile(t.ClientCAFile).
+		WithRequireClientCert(t.RequireClientCert)
+}
+
 // RemoteEnv holds environment configuration for remote server.
 type RemoteEnv struct {
 	// ServerURL is the remote server URL.
@@ -286,11 +528,34 @@ func (e EnvConfig) ToAppConfig() AppConfig {
 	// Periodic sync config
 	cfg = applyOption(cfg, WithPeriodicSyncConfig(e.PeriodicSync.ToPeriodicSyncConfig()))
 
+	// Health alert config
+	cfg = applyOption(cfg, WithHealthAlertConfig(e.HealthAlert.ToHealthAlertConfig()))
+
+	// Integrity verification config
+	cfg = applyOption(cfg, WithIntegrityConfig(e.Integrity.ToIntegrityConfig()))
+
+	// Compaction config
+	cfg = applyOption(cfg, WithCompactionConfig(e.Compaction.ToCompactionConfig()))
+
+	// Warm-up config
+	cfg = applyOption(cfg, WithWarmUpConfig(e.WarmUp.ToWarmUpConfig()))
+
+	// Discovery config
+	cfg = applyOption(cfg, WithDiscoveryConfig(e.Discovery.ToDiscoveryConfig()))
+
 	// Remote config
 	if e.Remote.IsConfigured() {
 		cfg = applyOption(cfg, WithRemoteConfig(e.Remote.ToRemoteConfig()))
 	}
 
+	// TLS config
+	cfg = applyOption(cfg, WithTLSConfig(e.TLS.ToTLSConfig()))
+
+	// Clone encryption config
+	if e.CloneEncryptionKey != "" {
+		cfg = applyOption(cfg, WithCloneEncryptionConfig(NewCloneEncryptionConfig().WithKeyHex(e.CloneEncryptionKey)))
+	}
+
 	// Reporting config
 	cfg = applyOption(cfg, WithReportingConfig(e.Reporting.ToReportingConfig()))
 
@@ -302,6 +567,11 @@ func (e EnvConfig) ToAppConfig() AppConfig {
 		cfg = applyOption(cfg, WithWorkerCount(e.WorkerCount))
 	}
 
+	// Worker drain timeout
+	if e.WorkerDrainTimeout > 0 {
+		cfg = applyOption(cfg, WithWorkerDrainTimeout(e.WorkerDrainTimeout))
+	}
+
 	// Search limit
 	if e.SearchLimit > 0 {
 		cfg = applyOption(cfg, WithSearchLimit(e.SearchLimit))
@@ -322,6 +592,31 @@ func (e EnvConfig) ToAppConfig() AppConfig {
 	if e.ChunkMinSize > 0 {
 		cfg = applyOption(cfg, WithChunkMinSize(e.ChunkMinSize))
 	}
+	if e.EmbeddingDimensions > 0 {
+		cfg = applyOption(cfg, WithEmbeddingDimensions(e.EmbeddingDimensions))
+	}
+
+	// Embedding context header templates
+	if e.EmbeddingContextTemplate != "" {
+		cfg = applyOption(cfg, WithEmbeddingContextTemplate(e.EmbeddingContextTemplate))
+	}
+	if e.EmbeddingContextLanguageTemplates != "" {
+		templates := parseLanguageTemplates(e.EmbeddingContextLanguageTemplates)
+		if templates != nil {
+			cfg = applyOption(cfg, WithEmbeddingContextLanguageTemplates(templates))
+		}
+	}
+
+	// Search provider
+	if e.SearchProvider != "" {
+		cfg = applyOption(cfg, WithSearchProvider(e.SearchProvider))
+	}
+	if e.QdrantURL != "" {
+		cfg = applyOption(cfg, WithQdrantURL(e.QdrantURL))
+	}
+	if e.QdrantAPIKey != "" {
+		cfg = applyOption(cfg, WithQdrantAPIKey(e.QdrantAPIKey))
+	}
 
 	return cfg
 }
@@ -340,6 +635,7 @@ func (e EndpointEnv) IsConfigured() bool {
 // ToEndpoint converts EndpointEnv to Endpoint.
 func (e EndpointEnv) ToEndpoint() Endpoint {
 	opts := []EndpointOption{
+		WithProviderType(e.Type),
 		WithModel(e.Model),
 		WithNumParallelTasks(e.NumParallelTasks),
 		WithTimeout(time.Duration(e.Timeout * float64(time.Second))),
@@ -439,3 +735,29 @@ func parseExtraParams(s string) map[string]any {
 	}
 	return params
 }
+
+// parseLanguageTemplates parses a JSON-encoded map of language to context
+// header template.
+func parseLanguageTemplates(s string) map[string]string {
+	if s == "" {
+		return nil
+	}
+	var templates map[string]string
+	if err := json.Unmarshal([]byte(s), &templates); err != nil {
+		return nil
+	}
+	return templates
+}
+
+// parseQueries parses a comma-separated list of warm-up queries.
+func parseQueries(s string) []string {
+	parts := strings.Split(s, ",")
+	queries := make([]string, 0, len(parts))
+	for _, p := range parts {
+		trimmed := strings.TrimSpace(p)
+		if trimmed != "" {
+			queries = append(queries, trimmed)
+		}
+	}
+	return queries
+}
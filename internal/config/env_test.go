@@ -164,6 +164,49 @@ func TestLoadFromEnv_PeriodicSync(t *testing.T) {
 	assert.Equal(t, 5, cfg.PeriodicSync.RetryAttempts)
 }
 
+func TestLoadFromEnv_WarmUp(t *testing.T) {
+	clearEnvVars(t)
+
+	t.Setenv("WARM_UP_ENABLED", "true")
+	t.Setenv("WARM_UP_REPO_LIMIT", "10")
+	t.Setenv("WARM_UP_QUERIES", "error handling, main function")
+
+	cfg, err := LoadFromEnv()
+	require.NoError(t, err)
+
+	assert.True(t, cfg.WarmUp.Enabled)
+	assert.Equal(t, 10, cfg.WarmUp.RepoLimit)
+	assert.Equal(t, "error handling, main function", cfg.WarmUp.Queries)
+
+	appCfg := cfg.ToAppConfig()
+	assert.True(t, appCfg.WarmUp().Enabled())
+	assert.Equal(t, 10, appCfg.WarmUp().RepoLimit())
+	assert.Equal(t, []string{"error handling", "main function"}, appCfg.WarmUp().Queries())
+}
+
+func TestLoadFromEnv_Discovery(t *testing.T) {
+	clearEnvVars(t)
+
+	t.Setenv("DISCOVERY_ENABLED", "true")
+	t.Setenv("DISCOVERY_WEBHOOK_SECRET", "s3cr3t")
+	t.Setenv("DISCOVERY_NAME_PATTERNS", "acme/*, other-org/tools-*")
+	t.Setenv("DISCOVERY_AUTO_REGISTER", "true")
+
+	cfg, err := LoadFromEnv()
+	require.NoError(t, err)
+
+	assert.True(t, cfg.Discovery.Enabled)
+	assert.Equal(t, "s3cr3t", cfg.Discovery.WebhookSecret)
+	assert.Equal(t, "acme/*, other-org/tools-*", cfg.Discovery.NamePatterns)
+	assert.True(t, cfg.Discovery.AutoRegister)
+
+	appCfg := cfg.ToAppConfig()
+	assert.True(t, appCfg.Discovery().Enabled())
+	assert.Equal(t, "s3cr3t", appCfg.Discovery().WebhookSecret())
+	assert.Equal(t, []string{"acme/*", "other-org/tools-*"}, appCfg.Discovery().NamePatterns())
+	assert.True(t, appCfg.Discovery().AutoRegister())
+}
+
 func TestLoadFromEnv_Remote(t *testing.T) {
 	clearEnvVars(t)
 
@@ -184,6 +227,28 @@ func TestLoadFromEnv_Remote(t *testing.T) {
 	assert.False(t, cfg.Remote.VerifySSL)
 }
 
+func TestLoadFromEnv_TLS(t *testing.T) {
+	clearEnvVars(t)
+
+	t.Setenv("TLS_CERT_FILE", "/etc/kodit/tls.crt")
+	t.Setenv("TLS_KEY_FILE", "/etc/kodit/tls.key")
+	t.Setenv("TLS_CLIENT_CA_FILE", "/etc/kodit/ca.crt")
+	t.Setenv("TLS_REQUIRE_CLIENT_CERT", "true")
+
+	cfg, err := LoadFromEnv()
+	require.NoError(t, err)
+
+	assert.Equal(t, "/etc/kodit/tls.crt", cfg.TLS.CertFile)
+	assert.Equal(t, "/etc/kodit/tls.key", cfg.TLS.KeyFile)
+	assert.Equal(t, "/etc/kodit/ca.crt", cfg.TLS.ClientCAFile)
+	assert.True(t, cfg.TLS.RequireClientCert)
+
+	appCfg := cfg.ToAppConfig()
+	assert.True(t, appCfg.TLS().Enabled())
+	assert.True(t, appCfg.TLS().MutualTLSEnabled())
+	assert.True(t, appCfg.TLS().RequireClientCert())
+}
+
 func TestLoadFromEnv_Reporting(t *testing.T) {
 	clearEnvVars(t)
 
@@ -483,6 +548,13 @@ func clearEnvVars(t *testing.T) {
 		"PERIODIC_SYNC_ENABLED",
 		"PERIODIC_SYNC_INTERVAL_SECONDS",
 		"PERIODIC_SYNC_RETRY_ATTEMPTS",
+		"WARM_UP_ENABLED",
+		"WARM_UP_REPO_LIMIT",
+		"WARM_UP_QUERIES",
+		"DISCOVERY_ENABLED",
+		"DISCOVERY_WEBHOOK_SECRET",
+		"DISCOVERY_NAME_PATTERNS",
+		"DISCOVERY_AUTO_REGISTER",
 		"REMOTE_SERVER_URL",
 		"REMOTE_API_KEY",
 		"REMOTE_TIMEOUT",
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"gorm.io/driver/postgres"
@@ -16,9 +17,21 @@ import (
 // ErrUnsupportedDriver indicates the database URL uses an unsupported driver.
 var ErrUnsupportedDriver = errors.New("unsupported database driver")
 
+// sqliteBusyTimeout bounds how long SQLite waits for a lock held by another
+// connection before returning SQLITE_BUSY. Combined with the single-connection
+// pool below, this turns transient lock contention into a short wait instead
+// of an error.
+const sqliteBusyTimeout = 5000 * time.Millisecond
+
 // Database wraps a GORM connection with lifecycle management.
 type Database struct {
 	db *gorm.DB
+
+	// writeMu serializes write operations for SQLite, which only supports one
+	// writer at a time. It is shared across every copy of Database produced
+	// from the same NewDatabase call (via the pointer) and left nil for
+	// PostgreSQL, where MVCC already allows concurrent writers.
+	writeMu *sync.Mutex
 }
 
 // NewDatabase creates a new Database from a connection URL.
@@ -54,11 +67,15 @@ func NewDatabase(ctx context.Context, url string) (Database, error) {
 	// SQLite supports only one writer at a time. Limiting the pool to a
 	// single connection serializes all access through one handle, which
 	// avoids "database is locked" errors under concurrent load.
+	var writeMu *sync.Mutex
 	if db.Name() == "sqlite" {
 		sqlDB.SetMaxOpenConns(1)
+		sqlDB.SetMaxIdleConns(1)
+		sqlDB.SetConnMaxLifetime(0)
+		writeMu = &sync.Mutex{}
 	}
 
-	return Database{db: db}, nil
+	return Database{db: db, writeMu: writeMu}, nil
 }
 
 // NewDatabaseWithConfig creates a Database with custom GORM configuration.
@@ -82,11 +99,15 @@ func NewDatabaseWithConfig(ctx context.Context, url string, config *gorm.Config)
 		return Database{}, fmt.Errorf("ping database: %w", err)
 	}
 
+	var writeMu *sync.Mutex
 	if db.Name() == "sqlite" {
 		sqlDB.SetMaxOpenConns(1)
+		sqlDB.SetMaxIdleConns(1)
+		sqlDB.SetConnMaxLifetime(0)
+		writeMu = &sync.Mutex{}
 	}
 
-	return Database{db: db}, nil
+	return Database{db: db, writeMu: writeMu}, nil
 }
 
 // Session returns a GORM session with the given context.
@@ -131,13 +152,27 @@ func (d Database) IsSQLite() bool {
 	return d.db.Name() == "sqlite"
 }
 
+// Write runs fn with exclusive access to the database's writer. For SQLite,
+// this serializes writes through a single in-process goroutine-safe gate so
+// concurrent workers and API requests queue instead of racing for the one
+// writable connection and failing with SQLITE_BUSY. For PostgreSQL, fn runs
+// immediately since the server already handles concurrent writers.
+func (d Database) Write(fn func() error) error {
+	if d.writeMu == nil {
+		return fn()
+	}
+	d.writeMu.Lock()
+	defer d.writeMu.Unlock()
+	return fn()
+}
+
 func parseDialector(url string) (gorm.Dialector, error) {
 	switch {
 	case strings.HasPrefix(url, "sqlite:///"):
 		path := strings.TrimPrefix(url, "sqlite:///")
 		// WAL mode allows concurrent reads during writes.
 		// busy_timeout makes SQLite wait for locks instead of failing immediately.
-		dsn := path + "?_journal_mode=WAL&_busy_timeout=5000"
+		dsn := fmt.Sprintf("%s?_journal_mode=WAL&_busy_timeout=%d", path, sqliteBusyTimeout.Milliseconds())
 		return sqlite.Open(dsn), nil
 	case strings.HasPrefix(url, "postgresql://"), strings.HasPrefix(url, "postgres://"):
 		return postgres.Open(url), nil
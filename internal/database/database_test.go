@@ -4,6 +4,7 @@ import (
 	"context"
 	"os"
 	"path/filepath"
+	"sync"
 	"testing"
 	"time"
 )
@@ -150,3 +151,52 @@ func TestParseDialector(t *testing.T) {
 		})
 	}
 }
+
+func TestDatabase_Write_SerializesSQLiteWriters(t *testing.T) {
+	ctx := context.Background()
+	tmpDir := t.TempDir()
+	dbPath := filepath.Join(tmpDir, "test.db")
+
+	db, err := NewDatabase(ctx, "sqlite:///"+dbPath)
+	if err != nil {
+		t.Fatalf("NewDatabase: %v", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	var active int
+	var maxActive int
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = db.Write(func() error {
+				active++
+				if active > maxActive {
+					maxActive = active
+				}
+				active--
+				return nil
+			})
+		}()
+	}
+	wg.Wait()
+
+	if maxActive > 1 {
+		t.Errorf("expected at most one concurrent writer, observed %d", maxActive)
+	}
+}
+
+func TestDatabase_Write_PostgresPassesThrough(t *testing.T) {
+	db := Database{}
+	called := false
+	if err := db.Write(func() error {
+		called = true
+		return nil
+	}); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if !called {
+		t.Error("expected fn to be called")
+	}
+}
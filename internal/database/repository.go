@@ -145,10 +145,13 @@ func (r Repository[D, E]) DeleteBy(ctx context.Context, options ...repository.Op
 	if len(q.Conditions()) == 0 && len(q.Clauses()) == 0 {
 		return fmt.Errorf("%w: %s", ErrUnscopedDelete, r.label)
 	}
-	db := ApplyOptions(r.sessionDB(ctx), options...)
-	result := db.Delete(new(E))
-	if result.Error != nil {
-		return fmt.Errorf("delete %s: %w", r.label, result.Error)
+	err := r.db.Write(func() error {
+		db := ApplyOptions(r.sessionDB(ctx), options...)
+		result := db.Delete(new(E))
+		return result.Error
+	})
+	if err != nil {
+		return fmt.Errorf("delete %s: %w", r.label, err)
 	}
 	return nil
 }
@@ -156,10 +159,12 @@ func (r Repository[D, E]) DeleteBy(ctx context.Context, options ...repository.Op
 // Save creates or updates the given domain entity.
 func (r Repository[D, E]) Save(ctx context.Context, domain D) (D, error) {
 	model := r.mapper.ToModel(domain)
-	result := r.sessionDB(ctx).Save(&model)
-	if result.Error != nil {
+	err := r.db.Write(func() error {
+		return r.sessionDB(ctx).Save(&model).Error
+	})
+	if err != nil {
 		var zero D
-		return zero, fmt.Errorf("save %s: %w", r.label, result.Error)
+		return zero, fmt.Errorf("save %s: %w", r.label, err)
 	}
 	return r.mapper.ToDomain(model), nil
 }
@@ -167,9 +172,11 @@ func (r Repository[D, E]) Save(ctx context.Context, domain D) (D, error) {
 // Delete removes the given domain entity from the database.
 func (r Repository[D, E]) Delete(ctx context.Context, domain D) error {
 	model := r.mapper.ToModel(domain)
-	result := r.sessionDB(ctx).Delete(&model)
-	if result.Error != nil {
-		return fmt.Errorf("delete %s: %w", r.label, result.Error)
+	err := r.db.Write(func() error {
+		return r.sessionDB(ctx).Delete(&model).Error
+	})
+	if err != nil {
+		return fmt.Errorf("delete %s: %w", r.label, err)
 	}
 	return nil
 }
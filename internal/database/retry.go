@@ -0,0 +1,48 @@
+package database
+
+import (
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// serializationRetries bounds how many times RetrySerializationFailure
+// re-executes fn after a Postgres serialization failure or deadlock before
+// giving up and returning the last error.
+const serializationRetries = 3
+
+// serializationRetryDelay is the fixed pause between retries. Short because
+// these failures resolve as soon as the conflicting transaction commits.
+const serializationRetryDelay = 10 * time.Millisecond
+
+// isSerializationFailure reports whether err is a Postgres serialization
+// failure (40001) or deadlock (40P01) — the two conditions under which
+// retrying an otherwise-identical write is expected to eventually succeed.
+// Other database engines never produce these codes, so the check is always
+// false for them and fn simply runs once.
+func isSerializationFailure(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == "40001" || pgErr.Code == "40P01"
+	}
+	return false
+}
+
+// RetrySerializationFailure runs fn, retrying it if it fails with a Postgres
+// serialization failure or deadlock. It's meant to wrap a single batched
+// write (e.g. CreateInBatches) that GORM already executes transactionally,
+// adding resilience for concurrent writers racing on the same rows.
+func RetrySerializationFailure(fn func() error) error {
+	var err error
+	for attempt := 0; attempt <= serializationRetries; attempt++ {
+		err = fn()
+		if err == nil || !isSerializationFailure(err) {
+			return err
+		}
+		if attempt < serializationRetries {
+			time.Sleep(serializationRetryDelay)
+		}
+	}
+	return err
+}
@@ -0,0 +1,54 @@
+package database
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+func TestRetrySerializationFailure_SucceedsAfterRetries(t *testing.T) {
+	attempts := 0
+	err := RetrySerializationFailure(func() error {
+		attempts++
+		if attempts < 3 {
+			return &pgconn.PgError{Code: "40001"}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestRetrySerializationFailure_GivesUpAfterMaxRetries(t *testing.T) {
+	attempts := 0
+	err := RetrySerializationFailure(func() error {
+		attempts++
+		return &pgconn.PgError{Code: "40P01"}
+	})
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if attempts != serializationRetries+1 {
+		t.Errorf("expected %d attempts, got %d", serializationRetries+1, attempts)
+	}
+}
+
+func TestRetrySerializationFailure_NonRetryableErrorReturnsImmediately(t *testing.T) {
+	attempts := 0
+	wantErr := errors.New("not a serialization failure")
+	err := RetrySerializationFailure(func() error {
+		attempts++
+		return wantErr
+	})
+	if !errors.Is(err, wantErr) {
+		t.Errorf("expected %v, got %v", wantErr, err)
+	}
+	if attempts != 1 {
+		t.Errorf("expected 1 attempt, got %d", attempts)
+	}
+}
@@ -0,0 +1,58 @@
+package mcp
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// Args wraps an MCP tool call request with typed, validated accessors, so
+// handlers don't hand-parse the request's map[string]any and repeat the same
+// "required", "must not be empty", and "must not be negative" checks with
+// slightly different wording each time.
+type Args struct {
+	request mcp.CallToolRequest
+}
+
+// NewArgs wraps request for typed argument access.
+func NewArgs(request mcp.CallToolRequest) Args {
+	return Args{request: request}
+}
+
+// RequiredString returns the named string argument. It returns an error if
+// the argument is missing or contains only whitespace.
+func (a Args) RequiredString(name string) (string, error) {
+	v, err := a.request.RequireString(name)
+	if err != nil {
+		return "", fmt.Errorf("%s is required", name)
+	}
+	if strings.TrimSpace(v) == "" {
+		return "", fmt.Errorf("%s must not be empty", name)
+	}
+	return v, nil
+}
+
+// OptionalString returns the named string argument, or def if it is absent.
+func (a Args) OptionalString(name, def string) string {
+	return a.request.GetString(name, def)
+}
+
+// Language returns the named string argument as a normalized file extension,
+// stripping a leading dot so ".go" and "go" compare equal.
+func (a Args) Language(name string) string {
+	return normalizeExtension(a.request.GetString(name, ""))
+}
+
+// Limit returns the named numeric argument, defaulting to def when absent.
+// It returns an error for a negative value, and clamps to max when max > 0.
+func (a Args) Limit(name string, def, max int) (int, error) {
+	limit := int(a.request.GetFloat(name, float64(def)))
+	if limit < 0 {
+		return 0, fmt.Errorf("%s must not be negative", name)
+	}
+	if max > 0 && limit > max {
+		limit = max
+	}
+	return limit, nil
+}
@@ -0,0 +1,91 @@
+package mcp
+
+import (
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func newCallToolRequest(args map[string]any) mcp.CallToolRequest {
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = args
+	return req
+}
+
+func TestArgs_RequiredString(t *testing.T) {
+	t.Run("missing argument errors", func(t *testing.T) {
+		_, err := NewArgs(newCallToolRequest(nil)).RequiredString("query")
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+		if !containsStr(err.Error(), "query is required") {
+			t.Errorf("expected 'query is required' error, got: %v", err)
+		}
+	})
+
+	t.Run("blank argument errors", func(t *testing.T) {
+		_, err := NewArgs(newCallToolRequest(map[string]any{"query": "   "})).RequiredString("query")
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+		if !containsStr(err.Error(), "query must not be empty") {
+			t.Errorf("expected 'query must not be empty' error, got: %v", err)
+		}
+	})
+
+	t.Run("present argument returns value", func(t *testing.T) {
+		v, err := NewArgs(newCallToolRequest(map[string]any{"query": "hello"})).RequiredString("query")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if v != "hello" {
+			t.Errorf("expected %q, got %q", "hello", v)
+		}
+	})
+}
+
+func TestArgs_Language(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{".go", "go"},
+		{"go", "go"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		got := NewArgs(newCallToolRequest(map[string]any{"language": tt.value})).Language("language")
+		if got != tt.want {
+			t.Errorf("Language(%q) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestArgs_Limit(t *testing.T) {
+	t.Run("defaults when absent", func(t *testing.T) {
+		limit, err := NewArgs(newCallToolRequest(nil)).Limit("limit", 10, 0)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if limit != 10 {
+			t.Errorf("expected default 10, got %d", limit)
+		}
+	})
+
+	t.Run("rejects negative", func(t *testing.T) {
+		_, err := NewArgs(newCallToolRequest(map[string]any{"limit": float64(-1)})).Limit("limit", 10, 0)
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+
+	t.Run("clamps to max", func(t *testing.T) {
+		limit, err := NewArgs(newCallToolRequest(map[string]any{"limit": float64(500)})).Limit("limit", 10, 200)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if limit != 200 {
+			t.Errorf("expected clamp to 200, got %d", limit)
+		}
+	})
+}
@@ -0,0 +1,94 @@
+package mcp
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// resultCacheTTL bounds how long a cached search result may be served
+// before being recomputed, even if the corpus generation has not changed.
+const resultCacheTTL = 30 * time.Second
+
+// corpusPollInterval controls how often the background refresh loop checks
+// the corpus generation for changes.
+const corpusPollInterval = 5 * time.Second
+
+type cacheEntry struct {
+	result    *mcp.CallToolResult
+	expiresAt time.Time
+}
+
+// resultCache is an in-process TTL cache for MCP search results, with
+// background invalidation when the corpus generation changes. The stdio
+// server opens one process per editor session, and an editor agent issuing
+// many near-identical searches while working a single task would otherwise
+// hit the database on every one of them.
+type resultCache struct {
+	mu         sync.RWMutex
+	entries    map[string]cacheEntry
+	generation int64
+}
+
+// newResultCache creates an empty result cache.
+func newResultCache() *resultCache {
+	return &resultCache{entries: make(map[string]cacheEntry)}
+}
+
+// get returns the cached result for key, if present and not expired.
+func (c *resultCache) get(key string) (*mcp.CallToolResult, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	entry, ok := c.entries[key]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return nil, false
+	}
+	return entry.result, true
+}
+
+// set stores result under key with the standard TTL.
+func (c *resultCache) set(key string, result *mcp.CallToolResult) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = cacheEntry{result: result, expiresAt: time.Now().Add(resultCacheTTL)}
+}
+
+// refreshGeneration drops all cached entries if generation has advanced
+// since the last check, and records the new generation.
+func (c *resultCache) refreshGeneration(generation int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation == c.generation {
+		return
+	}
+	c.generation = generation
+	c.entries = make(map[string]cacheEntry)
+}
+
+// pollCorpusGeneration periodically checks the corpus generation — the
+// total enrichment count, which changes whenever commits are scanned or
+// enrichments are (re)computed — and invalidates the result cache when it
+// changes. It runs until ctx is cancelled, for the lifetime of the stdio
+// server process.
+func (s *Server) pollCorpusGeneration(ctx context.Context) {
+	ticker := time.NewTicker(corpusPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			generation, err := s.enrichmentQuery.Count(ctx, nil)
+			if err != nil {
+				s.logger.Warn().Interface("error", err).Msg("failed to poll corpus generation for cache invalidation")
+				continue
+			}
+			s.cache.refreshGeneration(generation)
+		}
+	}
+}
@@ -96,6 +96,14 @@ func tools() []ToolDefinition {
 				{name: "commit_sha", description: "The commit SHA to get docs for (defaults to latest)", typ: "string"},
 			},
 		},
+		{
+			name:        "kodit_conventions",
+			description: "Get the code conventions document (naming, error handling, test patterns) for a repository",
+			params: []ParamDefinition{
+				{name: "repo_url", description: "The remote URL of the repository", typ: "string", required: true},
+				{name: "commit_sha", description: "The commit SHA to get docs for (defaults to latest)", typ: "string"},
+			},
+		},
 		{
 			name:        "kodit_wiki",
 			description: "Get the table of contents for a repository's wiki",
@@ -123,6 +131,16 @@ func tools() []ToolDefinition {
 				{name: "limit", description: "Maximum number of results (default 10)", typ: "number"},
 			},
 		},
+		{
+			name:        "kodit_find_similar_code",
+			description: "Find snippets similar to a provided code block using semantic similarity — the \"have we already written this?\" workflow. Returns file resource URIs with similarity scores.",
+			params: []ParamDefinition{
+				{name: "code", description: "The code block to find similar snippets for", typ: "string", required: true},
+				{name: "language", description: "Filter by file extension (e.g. .go, .py)", typ: "string"},
+				{name: "source_repo", description: "Filter by source repository URL", typ: "string"},
+				{name: "limit", description: "Maximum number of results (default 10)", typ: "number"},
+			},
+		},
 		{
 			name:        "kodit_keyword_search",
 			description: "Search indexed files using keyword-based BM25 search and return file resource URIs",
@@ -152,6 +170,14 @@ func tools() []ToolDefinition {
 				{name: "limit", description: "Maximum number of file results (default 50)", typ: "number"},
 			},
 		},
+		{
+			name:        "kodit_overlay",
+			description: "Search a repository's current uncommitted working tree changes (modified, added, renamed, or untracked files). Content is chunked fresh from disk on every call, not persisted, and disappears once changes are committed or discarded — use this to check in-progress edits, not to search indexed history.",
+			params: []ParamDefinition{
+				{name: "repo_url", description: "The remote URL of the repository", typ: "string", required: true},
+				{name: "query", description: "Keyword to match against uncommitted file content (case-insensitive substring match); returns every chunk when omitted", typ: "string"},
+			},
+		},
 		{
 			name:        "kodit_read_resource",
 			description: "Read the contents of a file resource URI. Use this to fetch file content from URIs returned by kodit_semantic_search, kodit_keyword_search, kodit_grep, and kodit_ls.",
@@ -167,6 +193,68 @@ func tools() []ToolDefinition {
 				{name: "pattern", description: "Glob pattern to match files (e.g. **/*.go, src/*.py)", typ: "string", required: true},
 			},
 		},
+		{
+			name:        "kodit_architecture_diagram",
+			description: "Get a Mermaid flowchart of a repository's components and startup dependencies, derived from its Docker Compose configuration",
+			params: []ParamDefinition{
+				{name: "repo_url", description: "The remote URL of the repository", typ: "string", required: true},
+			},
+		},
+		{
+			name:        "kodit_rename_impact",
+			description: "Counts references to a symbol across every indexed repository, grouped by repository and file, so you can assess the blast radius of a rename or deprecation before doing it. References are found via a word-boundary text search, not a resolved symbol index, so results are textual matches rather than type-checked references.",
+			params: []ParamDefinition{
+				{name: "symbol", description: "The symbol name to search for", typ: "string", required: true},
+				{name: "max_files", description: "Maximum number of files inspected per repository (default 50)", typ: "number"},
+			},
+		},
+		{
+			name:        "kodit_explain_path",
+			description: "Get the summary chain for a file path: the directory summary for each ancestor directory from the repository root down to the file, followed by the file's own summary",
+			params: []ParamDefinition{
+				{name: "repo_url", description: "The remote URL of the repository", typ: "string", required: true},
+				{name: "path", description: "The file path relative to the repository root", typ: "string", required: true},
+				{name: "commit_sha", description: "The commit SHA to get summaries for (defaults to latest)", typ: "string"},
+			},
+		},
+		{
+			name:        "kodit_search_wiki",
+			description: "Search a repository's indexed wiki pages by meaning and keyword, returning the best-matching pages with a content excerpt. Use kodit_wiki_page to fetch a matched page's full content by slug.",
+			params: []ParamDefinition{
+				{name: "repo_url", description: "The remote URL of the repository", typ: "string", required: true},
+				{name: "query", description: "Natural language or keyword query to match against wiki page content", typ: "string", required: true},
+				{name: "limit", description: "Maximum number of results (default 10)", typ: "number"},
+			},
+		},
+		{
+			name:        "kodit_summarize_patch",
+			description: "Summarize a patch for code review: intent, risk, and affected areas. Accepts either a raw unified diff, or a repo_url with base and head refs to diff. Nothing is persisted.",
+			params: []ParamDefinition{
+				{name: "diff", description: "A unified diff to summarize; if omitted, repo_url, base, and head are used instead", typ: "string"},
+				{name: "repo_url", description: "The remote URL of the repository (required when diff is omitted)", typ: "string"},
+				{name: "base", description: "The base ref to diff from (required when diff is omitted)", typ: "string"},
+				{name: "head", description: "The head ref to diff to (required when diff is omitted)", typ: "string"},
+			},
+		},
+		{
+			name:        "kodit_list_files",
+			description: "List the tracked files in a repository, optionally scoped to a path prefix or filtered by glob pattern, to discover what exists before reading it",
+			params: []ParamDefinition{
+				{name: "repo_url", description: "The remote URL of the repository", typ: "string", required: true},
+				{name: "path", description: "Restrict results to files under this path prefix", typ: "string"},
+				{name: "pattern", description: "Glob pattern to match file paths (e.g. **/*.go, src/*.py)", typ: "string"},
+				{name: "commit_sha", description: "The commit SHA to list files for (defaults to latest)", typ: "string"},
+			},
+		},
+		{
+			name:        "kodit_get_commit_diff",
+			description: "Get the unified diff for a specific commit, optionally scoped to a single file path",
+			params: []ParamDefinition{
+				{name: "repo_url", description: "The remote URL of the repository", typ: "string", required: true},
+				{name: "commit_sha", description: "The commit SHA to diff", typ: "string", required: true},
+				{name: "path", description: "Restrict the diff to a single file path relative to the repository root", typ: "string"},
+			},
+		},
 	}
 }
 
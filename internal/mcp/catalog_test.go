@@ -8,12 +8,12 @@ import (
 func TestToolDefinitions_Count(t *testing.T) {
 	defs := ToolDefinitions()
 
-	if len(defs) != 15 {
+	if len(defs) != 25 {
 		names := make([]string, len(defs))
 		for i, def := range defs {
 			names[i] = def.Name()
 		}
-		t.Fatalf("ToolDefinitions() length = %d, want 15; got %v", len(defs), names)
+		t.Fatalf("ToolDefinitions() length = %d, want 25; got %v", len(defs), names)
 	}
 }
 
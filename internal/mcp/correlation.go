@@ -0,0 +1,45 @@
+package mcp
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// correlationIDKey is the context key for a tool call's correlation ID.
+type correlationIDKey struct{}
+
+// correlationIDFromContext returns the correlation ID stored in ctx, or ""
+// if none was set.
+func correlationIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(correlationIDKey{}).(string)
+	return id
+}
+
+// correlationMiddleware generates a correlation ID for each tools/call
+// request, logs it alongside the tool name, and returns it in the result's
+// `_meta` field so an agent trace can be joined with server logs when
+// debugging a bad answer.
+func (s *Server) correlationMiddleware(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		correlationID := uuid.NewString()
+		ctx = context.WithValue(ctx, correlationIDKey{}, correlationID)
+
+		logger := s.logger.With().Str("correlation_id", correlationID).Str("tool", request.Params.Name).Logger()
+		logger.Info().Msg("tool call started")
+
+		result, err := next(ctx, request)
+		if err != nil {
+			logger.Error().Interface("error", err).Msg("tool call failed")
+			return result, err
+		}
+
+		logger.Info().Msg("tool call completed")
+		if result != nil {
+			result.Meta = mcp.NewMetaFromMap(map[string]any{"correlation_id": correlationID})
+		}
+		return result, nil
+	}
+}
@@ -11,6 +11,7 @@ type FileURI struct {
 	startLine int
 	endLine   int
 	page      int
+	anchor    string
 }
 
 // NewFileURI creates a FileURI with the required fields.
@@ -35,6 +36,13 @@ func (u FileURI) WithPage(page int) FileURI {
 	return u
 }
 
+// WithAnchor returns a copy carrying a content anchor for the line range, so
+// a later read can re-locate it if the file has drifted since indexing.
+func (u FileURI) WithAnchor(anchor string) FileURI {
+	u.anchor = anchor
+	return u
+}
+
 // String builds the file:// URI string.
 func (u FileURI) String() string {
 	base := fmt.Sprintf("file://%d/%s/%s", u.repoID, u.blobName, u.path)
@@ -42,7 +50,11 @@ func (u FileURI) String() string {
 		return fmt.Sprintf("%s?page=%d&mode=raster", base, u.page)
 	}
 	if u.startLine > 0 {
-		return fmt.Sprintf("%s?lines=L%d-L%d&line_numbers=true", base, u.startLine, u.endLine)
+		uri := fmt.Sprintf("%s?lines=L%d-L%d&line_numbers=true", base, u.startLine, u.endLine)
+		if u.anchor != "" {
+			uri += "&anchor=" + u.anchor
+		}
+		return uri
 	}
 	return base
 }
@@ -85,6 +85,101 @@ func (s *scopedFileLister) ListFiles(ctx context.Context, repoID int64, pattern
 	return s.inner.ListFiles(ctx, repoID, pattern)
 }
 
+// scopedOverlayer decorates an Overlayer, rejecting requests for
+// repositories outside the allowed set.
+type scopedOverlayer struct {
+	inner   Overlayer
+	allowed map[int64]struct{}
+}
+
+func (s *scopedOverlayer) Search(ctx context.Context, repoID int64, query string) ([]service.OverlaySnippet, error) {
+	if _, ok := s.allowed[repoID]; !ok {
+		return nil, fmt.Errorf("repository %d is not in scope", repoID)
+	}
+	return s.inner.Search(ctx, repoID, query)
+}
+
+// scopedImpactAnalyzer decorates a RenameImpactAnalyzer, filtering results
+// down to repositories in the allowed set.
+type scopedImpactAnalyzer struct {
+	inner   RenameImpactAnalyzer
+	allowed map[int64]struct{}
+}
+
+func (s *scopedImpactAnalyzer) Analyze(ctx context.Context, symbol string, maxFilesPerRepo int) ([]service.RepoImpact, error) {
+	impacts, err := s.inner.Analyze(ctx, symbol, maxFilesPerRepo)
+	if err != nil {
+		return nil, err
+	}
+	scoped := make([]service.RepoImpact, 0, len(impacts))
+	for _, impact := range impacts {
+		if _, ok := s.allowed[impact.RepoID]; ok {
+			scoped = append(scoped, impact)
+		}
+	}
+	return scoped, nil
+}
+
+// scopedArchitectureDiagram decorates an ArchitectureDiagramGenerator,
+// rejecting requests for repositories outside the allowed set.
+type scopedArchitectureDiagram struct {
+	inner   ArchitectureDiagramGenerator
+	allowed map[int64]struct{}
+}
+
+func (s *scopedArchitectureDiagram) Generate(ctx context.Context, repoID int64) (string, error) {
+	if _, ok := s.allowed[repoID]; !ok {
+		return "", fmt.Errorf("repository %d is not in scope", repoID)
+	}
+	return s.inner.Generate(ctx, repoID)
+}
+
+// scopedWikier decorates a Wikier, rejecting requests for repositories
+// outside the allowed set.
+type scopedWikier struct {
+	inner   Wikier
+	allowed map[int64]struct{}
+}
+
+func (s *scopedWikier) SearchWiki(ctx context.Context, repoID int64, query string, limit int) ([]service.WikiPageMatch, error) {
+	if _, ok := s.allowed[repoID]; !ok {
+		return nil, fmt.Errorf("repository %d is not in scope", repoID)
+	}
+	return s.inner.SearchWiki(ctx, repoID, query, limit)
+}
+
+// scopedPatchSummarizer decorates a PatchSummarizer, rejecting requests for
+// repositories outside the allowed set. A repoID of 0 is always permitted,
+// since it means the caller supplied a raw diff rather than a repository ref
+// range and no scoped data is being read.
+type scopedPatchSummarizer struct {
+	inner   PatchSummarizer
+	allowed map[int64]struct{}
+}
+
+func (s *scopedPatchSummarizer) Summarize(ctx context.Context, repoID int64, diff, baseRef, headRef string) (service.PatchSummary, error) {
+	if repoID != 0 {
+		if _, ok := s.allowed[repoID]; !ok {
+			return service.PatchSummary{}, fmt.Errorf("repository %d is not in scope", repoID)
+		}
+	}
+	return s.inner.Summarize(ctx, repoID, diff, baseRef, headRef)
+}
+
+// scopedCommitDiffer decorates a CommitDiffer, rejecting requests for
+// repositories outside the allowed set.
+type scopedCommitDiffer struct {
+	inner   CommitDiffer
+	allowed map[int64]struct{}
+}
+
+func (s *scopedCommitDiffer) Diff(ctx context.Context, repoID int64, commitSHA, filePath string) (string, error) {
+	if _, ok := s.allowed[repoID]; !ok {
+		return "", fmt.Errorf("repository %d is not in scope", repoID)
+	}
+	return s.inner.Diff(ctx, repoID, commitSHA, filePath)
+}
+
 // Scope wraps the given dependencies with scoping decorators that restrict
 // access to only the specified repository IDs.
 func Scope(
@@ -93,9 +188,15 @@ func Scope(
 	semanticSearch SemanticSearcher,
 	keywordSearch KeywordSearcher,
 	grepper Grepper,
+	overlayer Overlayer,
 	fileLister FileLister,
+	diagramGenerator ArchitectureDiagramGenerator,
+	impactAnalyzer RenameImpactAnalyzer,
+	wikier Wikier,
+	patchSummarizer PatchSummarizer,
+	commitDiffer CommitDiffer,
 	repoIDs []int64,
-) (RepositoryLister, FileContentReader, SemanticSearcher, KeywordSearcher, Grepper, FileLister) {
+) (RepositoryLister, FileContentReader, SemanticSearcher, KeywordSearcher, Grepper, Overlayer, FileLister, ArchitectureDiagramGenerator, RenameImpactAnalyzer, Wikier, PatchSummarizer, CommitDiffer) {
 	allowed := make(map[int64]struct{}, len(repoIDs))
 	for _, id := range repoIDs {
 		allowed[id] = struct{}{}
@@ -108,7 +209,13 @@ func Scope(
 		&scopedSemanticSearch{inner: semanticSearch, ids: ids},
 		&scopedKeywordSearch{inner: keywordSearch, ids: ids},
 		&scopedGrepper{inner: grepper, allowed: allowed},
-		&scopedFileLister{inner: fileLister, allowed: allowed}
+		&scopedOverlayer{inner: overlayer, allowed: allowed},
+		&scopedFileLister{inner: fileLister, allowed: allowed},
+		&scopedArchitectureDiagram{inner: diagramGenerator, allowed: allowed},
+		&scopedImpactAnalyzer{inner: impactAnalyzer, allowed: allowed},
+		&scopedWikier{inner: wikier, allowed: allowed},
+		&scopedPatchSummarizer{inner: patchSummarizer, allowed: allowed},
+		&scopedCommitDiffer{inner: commitDiffer, allowed: allowed}
 }
 
 // scopeFilters returns filters with source repos restricted to the allowed set.
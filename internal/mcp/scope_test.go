@@ -22,26 +22,38 @@ import (
 //   - EnrichmentResolver: operates on enrichment IDs returned by scoped search results
 //   - FileFinder:         operates on file IDs returned by the scoped EnrichmentResolver
 var (
-	_ RepositoryLister  = scopeReturn[RepositoryLister]()
-	_ FileContentReader = scopeReturn[FileContentReader]()
-	_ SemanticSearcher  = scopeReturn[SemanticSearcher]()
-	_ KeywordSearcher   = scopeReturn[KeywordSearcher]()
-	_ Grepper           = scopeReturn[Grepper]()
-	_ FileLister        = scopeReturn[FileLister]()
+	_ RepositoryLister             = scopeReturn[RepositoryLister]()
+	_ FileContentReader            = scopeReturn[FileContentReader]()
+	_ SemanticSearcher             = scopeReturn[SemanticSearcher]()
+	_ KeywordSearcher              = scopeReturn[KeywordSearcher]()
+	_ Grepper                      = scopeReturn[Grepper]()
+	_ Overlayer                    = scopeReturn[Overlayer]()
+	_ FileLister                   = scopeReturn[FileLister]()
+	_ ArchitectureDiagramGenerator = scopeReturn[ArchitectureDiagramGenerator]()
+	_ RenameImpactAnalyzer         = scopeReturn[RenameImpactAnalyzer]()
+	_ Wikier                       = scopeReturn[Wikier]()
+	_ PatchSummarizer              = scopeReturn[PatchSummarizer]()
+	_ CommitDiffer                 = scopeReturn[CommitDiffer]()
 )
 
 // scopeReturn is a compile-time helper that extracts a typed return value
 // from Scope. It panics at runtime but is only used in var declarations
 // that the compiler evaluates for type-checking without executing.
 func scopeReturn[T any]() T {
-	repos, fc, ss, ks, g, fl := Scope(nil, nil, nil, nil, nil, nil, nil)
+	repos, fc, ss, ks, g, ov, fl, dg, ia, w, ps, cd := Scope(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	m := map[string]any{
-		"RepositoryLister":  repos,
-		"FileContentReader": fc,
-		"SemanticSearcher":  ss,
-		"KeywordSearcher":   ks,
-		"Grepper":           g,
-		"FileLister":        fl,
+		"RepositoryLister":             repos,
+		"FileContentReader":            fc,
+		"SemanticSearcher":             ss,
+		"KeywordSearcher":              ks,
+		"Grepper":                      g,
+		"Overlayer":                    ov,
+		"FileLister":                   fl,
+		"ArchitectureDiagramGenerator": dg,
+		"RenameImpactAnalyzer":         ia,
+		"Wikier":                       w,
+		"PatchSummarizer":              ps,
+		"CommitDiffer":                 cd,
 	}
 	for _, v := range m {
 		if t, ok := v.(T); ok {
@@ -59,13 +71,25 @@ func TestScopedRepositories_FindReturnsOnlyScopedRepos(t *testing.T) {
 		1, 0, "https://github.com/org/repo1", "https://github.com/org/repo1", "",
 		repository.WorkingCopy{}, repository.TrackingConfig{},
 		repository.DefaultChunkingConfig(),
+		repository.DefaultEnrichmentBudgetConfig(),
+		repository.DefaultEmbeddingConfig(),
+		repository.DefaultAccessConfig(),
+		repository.DefaultIndexFilterConfig(),
 		time.Now(), time.Now(), time.Time{},
+		"",
+		false, false, false,
 	)
 	repo2 := repository.ReconstructRepository(
 		2, 0, "https://github.com/org/repo2", "https://github.com/org/repo2", "",
 		repository.WorkingCopy{}, repository.TrackingConfig{},
 		repository.DefaultChunkingConfig(),
+		repository.DefaultEnrichmentBudgetConfig(),
+		repository.DefaultEmbeddingConfig(),
+		repository.DefaultAccessConfig(),
+		repository.DefaultIndexFilterConfig(),
 		time.Now(), time.Now(), time.Time{},
+		"",
+		false, false, false,
 	)
 	inner := &recordingRepositoryLister{repos: []repository.Repository{repo1, repo2}}
 	scoped := &scopedRepositories{inner: inner, ids: []int64{1}}
@@ -230,6 +254,31 @@ func TestScopedGrepper_RejectsOutOfScope(t *testing.T) {
 	}
 }
 
+// --- scopedOverlayer ---
+
+func TestScopedOverlayer_AllowsInScope(t *testing.T) {
+	inner := &fakeOverlayer{results: []service.OverlaySnippet{{Path: "main.go"}}}
+	scoped := &scopedOverlayer{inner: inner, allowed: map[int64]struct{}{1: {}}}
+
+	results, err := scoped.Search(context.Background(), 1, "query")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 1 {
+		t.Errorf("expected 1 result, got %d", len(results))
+	}
+}
+
+func TestScopedOverlayer_RejectsOutOfScope(t *testing.T) {
+	inner := &fakeOverlayer{}
+	scoped := &scopedOverlayer{inner: inner, allowed: map[int64]struct{}{1: {}}}
+
+	_, err := scoped.Search(context.Background(), 99, "query")
+	if err == nil {
+		t.Fatal("expected error for out-of-scope repo")
+	}
+}
+
 // --- scopedFileLister ---
 
 func TestScopedFileLister_AllowsInScope(t *testing.T) {
@@ -10,6 +10,7 @@ import (
 	"image/jpeg"
 	"net/url"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -45,6 +46,7 @@ type CommitFinder interface {
 // EnrichmentQuery provides enrichment listing for MCP tools.
 type EnrichmentQuery interface {
 	List(ctx context.Context, params *service.EnrichmentListParams) ([]enrichment.Enrichment, error)
+	Count(ctx context.Context, params *service.EnrichmentListParams) (int64, error)
 }
 
 // FileContentReader provides raw file content from Git repositories.
@@ -77,6 +79,7 @@ type EnrichmentResolver interface {
 	SourceFiles(ctx context.Context, enrichmentIDs []int64) (map[string][]int64, error)
 	SourceLocations(ctx context.Context, enrichmentIDs []int64) (map[string]sourcelocation.SourceLocation, error)
 	RepositoryIDs(ctx context.Context, enrichmentIDs []int64) (map[string]int64, error)
+	ExplainPath(ctx context.Context, commitSHA string, fileID int64, filePath string) ([]service.PathSummary, error)
 }
 
 // FileLister provides pattern-based file listing from repository working copies.
@@ -94,6 +97,39 @@ type Grepper interface {
 	Search(ctx context.Context, repoID int64, pattern string, pathspec string, maxFiles int) ([]service.GrepResult, error)
 }
 
+// Overlayer provides keyword search over a repository's uncommitted working tree changes.
+type Overlayer interface {
+	Search(ctx context.Context, repoID int64, query string) ([]service.OverlaySnippet, error)
+}
+
+// RenameImpactAnalyzer counts references to a symbol across every indexed repository.
+type RenameImpactAnalyzer interface {
+	Analyze(ctx context.Context, symbol string, maxFilesPerRepo int) ([]service.RepoImpact, error)
+}
+
+// ArchitectureDiagramGenerator renders a Mermaid diagram of a repository's
+// components and startup dependencies.
+type ArchitectureDiagramGenerator interface {
+	Generate(ctx context.Context, repoID int64) (string, error)
+}
+
+// Wikier provides hybrid search over a repository's indexed wiki pages.
+type Wikier interface {
+	SearchWiki(ctx context.Context, repoID int64, query string, limit int) ([]service.WikiPageMatch, error)
+}
+
+// PatchSummarizer produces a structured, on-demand summary of a patch for
+// code review, without persisting anything.
+type PatchSummarizer interface {
+	Summarize(ctx context.Context, repoID int64, diff, baseRef, headRef string) (service.PatchSummary, error)
+}
+
+// CommitDiffer produces the unified diff for a single commit, optionally
+// scoped to a file path.
+type CommitDiffer interface {
+	Diff(ctx context.Context, repoID int64, commitSHA, filePath string) (string, error)
+}
+
 // Server wraps the MCP server with kodit-specific tools.
 type Server struct {
 	mcpServer          *server.MCPServer
@@ -111,8 +147,15 @@ type Server struct {
 	fileLister         FileLister
 	files              FileFinder
 	grepper            Grepper
+	overlayer          Overlayer
+	impactAnalyzer     RenameImpactAnalyzer
+	diagramGenerator   ArchitectureDiagramGenerator
+	wikier             Wikier
+	patchSummarizer    PatchSummarizer
+	commitDiffer       CommitDiffer
 	version            string
 	logger             zerolog.Logger
+	cache              *resultCache
 }
 
 const instructions = "This server provides access to code knowledge through multiple " +
@@ -129,11 +172,20 @@ const instructions = "This server provides access to code knowledge through mult
 	"- kodit_cookbook() - Complete usage examples\n" +
 	"- kodit_wiki() - Get the table of contents for a repository's wiki\n" +
 	"- kodit_wiki_page() - Get the content of a specific wiki page by slug\n" +
+	"- kodit_search_wiki() - Search a repository's wiki pages by meaning and keyword, returning the best-matching pages\n" +
+	"- kodit_summarize_patch() - Summarize a unified diff or a repo_url/base/head ref range for code review, without persisting anything\n" +
+	"- kodit_get_commit_diff() - Get the unified diff for a specific commit, optionally scoped to a file path\n" +
 	"- kodit_semantic_search() - Find files matching a natural language query (returns resource URIs)\n" +
+	"- kodit_find_similar_code() - Find snippets similar to a provided code block, the \"have we already written this?\" workflow (returns resource URIs)\n" +
 	"- kodit_keyword_search() - Find files matching keywords using BM25 search (returns resource URIs)\n" +
 	"- kodit_visual_search() - Find document pages (PDFs, etc.) matching a text query using visual similarity\n" +
 	"- kodit_grep() - Search file contents using git grep with regex patterns (returns resource URIs)\n" +
+	"- kodit_overlay() - Search a repository's current uncommitted working tree changes\n" +
 	"- kodit_ls() - List files matching a glob pattern in a repository\n" +
+	"- kodit_list_files() - List the tracked files in a repository, optionally scoped to a path prefix or glob pattern\n" +
+	"- kodit_architecture_diagram() - Get a Mermaid flowchart of the repository's components and startup dependencies\n" +
+	"- kodit_explain_path() - Get the directory/file summary chain for a path, from the repo root down to the file\n" +
+	"- kodit_rename_impact() - Count references to a symbol across every indexed repository, to assess blast radius before a rename\n" +
 	"- kodit_read_resource() - Read file content from a resource URI returned by search tools\n\n" +
 	"**Reading file content:**\n" +
 	"Use kodit_read_resource() with the URI returned by search tools, or the file resource " +
@@ -179,6 +231,12 @@ func NewServer(
 	fileLister FileLister,
 	files FileFinder,
 	grepper Grepper,
+	overlayer Overlayer,
+	impactAnalyzer RenameImpactAnalyzer,
+	diagramGenerator ArchitectureDiagramGenerator,
+	wikier Wikier,
+	patchSummarizer PatchSummarizer,
+	commitDiffer CommitDiffer,
 	version string,
 	logger zerolog.Logger,
 	opts ...ServerOption,
@@ -196,8 +254,15 @@ func NewServer(
 		fileLister:         fileLister,
 		files:              files,
 		grepper:            grepper,
+		overlayer:          overlayer,
+		impactAnalyzer:     impactAnalyzer,
+		diagramGenerator:   diagramGenerator,
+		wikier:             wikier,
+		patchSummarizer:    patchSummarizer,
+		commitDiffer:       commitDiffer,
 		version:            version,
 		logger:             logger,
+		cache:              newResultCache(),
 	}
 	for _, opt := range opts {
 		opt(s)
@@ -209,6 +274,7 @@ func NewServer(
 		server.WithToolCapabilities(true),
 		server.WithResourceCapabilities(false, false),
 		server.WithInstructions(instructions),
+		server.WithToolHandlerMiddleware(s.correlationMiddleware),
 	)
 
 	s.registerTools(mcpServer)
@@ -223,21 +289,31 @@ func NewServer(
 // this method pairs each tool name with its handler.
 func (s *Server) registerTools(mcpServer *server.MCPServer) {
 	handlers := map[string]server.ToolHandlerFunc{
-		"kodit_version":            s.handleGetVersion,
-		"kodit_repositories":       s.handleListRepositories,
-		"kodit_architecture_docs":  s.handleGetArchitectureDocs,
-		"kodit_api_docs":           s.handleGetAPIDocs,
-		"kodit_commit_description": s.handleGetCommitDescription,
-		"kodit_database_schema":    s.handleGetDatabaseSchema,
-		"kodit_cookbook":           s.handleGetCookbook,
-		"kodit_wiki":               s.handleGetWiki,
-		"kodit_wiki_page":          s.handleGetWikiPage,
-		"kodit_semantic_search":    s.handleSemanticSearch,
-		"kodit_keyword_search":     s.handleKeywordSearch,
-		"kodit_visual_search":      s.handleVisualSearch,
-		"kodit_grep":               s.handleGrep,
-		"kodit_read_resource":      s.handleReadResource,
-		"kodit_ls":                 s.handleLs,
+		"kodit_version":              s.handleGetVersion,
+		"kodit_repositories":         s.handleListRepositories,
+		"kodit_architecture_docs":    s.handleGetArchitectureDocs,
+		"kodit_api_docs":             s.handleGetAPIDocs,
+		"kodit_commit_description":   s.handleGetCommitDescription,
+		"kodit_database_schema":      s.handleGetDatabaseSchema,
+		"kodit_cookbook":             s.handleGetCookbook,
+		"kodit_conventions":          s.handleGetConventions,
+		"kodit_wiki":                 s.handleGetWiki,
+		"kodit_wiki_page":            s.handleGetWikiPage,
+		"kodit_semantic_search":      s.handleSemanticSearch,
+		"kodit_find_similar_code":    s.handleFindSimilarCode,
+		"kodit_keyword_search":       s.handleKeywordSearch,
+		"kodit_visual_search":        s.handleVisualSearch,
+		"kodit_grep":                 s.handleGrep,
+		"kodit_overlay":              s.handleOverlay,
+		"kodit_read_resource":        s.handleReadResource,
+		"kodit_ls":                   s.handleLs,
+		"kodit_list_files":           s.handleListFiles,
+		"kodit_explain_path":         s.handleExplainPath,
+		"kodit_architecture_diagram": s.handleGetArchitectureDiagram,
+		"kodit_rename_impact":        s.handleRenameImpact,
+		"kodit_search_wiki":          s.handleSearchWiki,
+		"kodit_summarize_patch":      s.handleSummarizePatch,
+		"kodit_get_commit_diff":      s.handleGetCommitDiff,
 	}
 
 	for _, def := range tools() {
@@ -311,12 +387,73 @@ func (s *Server) resolveRepository(ctx context.Context, repoURL string) ([]repos
 	return s.repositories.Find(ctx, repository.WithUpstreamURL(repoURL))
 }
 
+// resolveRepositoryOrError resolves repoURL to exactly one repository. When
+// the URL matches no repository or more than one, it returns a structured
+// tool error listing candidate repository URLs instead of guessing, so the
+// calling agent can retry with a corrected repo_url rather than silently
+// operating on the wrong repository or failing with no actionable hint.
+func (s *Server) resolveRepositoryOrError(ctx context.Context, repoURL string) (repository.Repository, *mcp.CallToolResult) {
+	repos, err := s.resolveRepository(ctx, repoURL)
+	if err != nil {
+		s.logger.Error().Str("repo_url", repoURL).Interface("error", err).Msg("failed to find repository")
+		return repository.Repository{}, mcp.NewToolResultError(fmt.Sprintf("failed to find repository: %v", err))
+	}
+	if len(repos) == 1 {
+		return repos[0], nil
+	}
+	if len(repos) > 1 {
+		return repository.Repository{}, mcp.NewToolResultError(fmt.Sprintf(
+			"repo_url %q matches %d repositories; pass one of these as repo_url to disambiguate: %s",
+			repoURL, len(repos), formatRepositoryCandidates(repos),
+		))
+	}
+
+	candidates, candErr := s.repositories.Find(ctx)
+	if candErr != nil || len(candidates) == 0 {
+		return repository.Repository{}, mcp.NewToolResultError(fmt.Sprintf("repository not found: %s", repoURL))
+	}
+	return repository.Repository{}, mcp.NewToolResultError(fmt.Sprintf(
+		"repository not found: %s. Known repositories: %s",
+		repoURL, formatRepositoryCandidates(candidates),
+	))
+}
+
+// formatRepositoryCandidates renders repositories as a comma-separated list
+// of upstream URLs for inclusion in a disambiguation error message.
+func formatRepositoryCandidates(repos []repository.Repository) string {
+	urls := make([]string, 0, len(repos))
+	for _, r := range repos {
+		urls = append(urls, r.UpstreamURL())
+	}
+	return strings.Join(urls, ", ")
+}
+
 func (s *Server) handleGetArchitectureDocs(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	typ := enrichment.TypeArchitecture
 	subtype := enrichment.SubtypePhysical
 	return s.handleEnrichmentDocs(ctx, request, typ, subtype)
 }
 
+func (s *Server) handleGetArchitectureDiagram(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	repoURL, err := NewArgs(request).RequiredString("repo_url")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	repo, errResult := s.resolveRepositoryOrError(ctx, repoURL)
+	if errResult != nil {
+		return errResult, nil
+	}
+
+	diagram, err := s.diagramGenerator.Generate(ctx, repo.ID())
+	if err != nil {
+		s.logger.Error().Interface("error", err).Msg("failed to generate architecture diagram")
+		return mcp.NewToolResultError(fmt.Sprintf("failed to generate architecture diagram: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText(diagram), nil
+}
+
 func (s *Server) handleGetAPIDocs(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	typ := enrichment.TypeUsage
 	subtype := enrichment.SubtypeAPIDocs
@@ -341,26 +478,29 @@ func (s *Server) handleGetCookbook(ctx context.Context, request mcp.CallToolRequ
 	return s.handleEnrichmentDocs(ctx, request, typ, subtype)
 }
 
+func (s *Server) handleGetConventions(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	typ := enrichment.TypeUsage
+	subtype := enrichment.SubtypeConventions
+	return s.handleEnrichmentDocs(ctx, request, typ, subtype)
+}
+
 // handleGetWiki returns the wiki table of contents for a repository.
 func (s *Server) handleGetWiki(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	repoURL, err := request.RequireString("repo_url")
+	args := NewArgs(request)
+	repoURL, err := args.RequiredString("repo_url")
 	if err != nil {
-		return mcp.NewToolResultError("repo_url is required"), nil
+		return mcp.NewToolResultError(err.Error()), nil
 	}
 
-	repos, err := s.resolveRepository(ctx, repoURL)
-	if err != nil {
-		s.logger.Error().Str("repo_url", repoURL).Interface("error", err).Msg("failed to find repository")
-		return mcp.NewToolResultError(fmt.Sprintf("failed to find repository: %v", err)), nil
-	}
-	if len(repos) == 0 {
-		return mcp.NewToolResultError(fmt.Sprintf("repository not found: %s", repoURL)), nil
+	repo, errResult := s.resolveRepositoryOrError(ctx, repoURL)
+	if errResult != nil {
+		return errResult, nil
 	}
 
-	commitSHA := request.GetString("commit_sha", "")
+	commitSHA := args.OptionalString("commit_sha", "")
 	if commitSHA == "" {
 		commits, commitErr := s.commits.Find(ctx,
-			repository.WithRepoID(repos[0].ID()),
+			repository.WithRepoID(repo.ID()),
 			repository.WithOrderDesc("date"),
 			repository.WithLimit(1),
 		)
@@ -404,29 +544,26 @@ func (s *Server) handleGetWiki(ctx context.Context, request mcp.CallToolRequest)
 
 // handleGetWikiPage returns the markdown content of a specific wiki page.
 func (s *Server) handleGetWikiPage(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	repoURL, err := request.RequireString("repo_url")
+	args := NewArgs(request)
+	repoURL, err := args.RequiredString("repo_url")
 	if err != nil {
-		return mcp.NewToolResultError("repo_url is required"), nil
+		return mcp.NewToolResultError(err.Error()), nil
 	}
 
-	pageSlug, err := request.RequireString("page_slug")
+	pageSlug, err := args.RequiredString("page_slug")
 	if err != nil {
-		return mcp.NewToolResultError("page_slug is required"), nil
+		return mcp.NewToolResultError(err.Error()), nil
 	}
 
-	repos, err := s.resolveRepository(ctx, repoURL)
-	if err != nil {
-		s.logger.Error().Str("repo_url", repoURL).Interface("error", err).Msg("failed to find repository")
-		return mcp.NewToolResultError(fmt.Sprintf("failed to find repository: %v", err)), nil
-	}
-	if len(repos) == 0 {
-		return mcp.NewToolResultError(fmt.Sprintf("repository not found: %s", repoURL)), nil
+	repo, errResult := s.resolveRepositoryOrError(ctx, repoURL)
+	if errResult != nil {
+		return errResult, nil
 	}
 
-	commitSHA := request.GetString("commit_sha", "")
+	commitSHA := args.OptionalString("commit_sha", "")
 	if commitSHA == "" {
 		commits, commitErr := s.commits.Find(ctx,
-			repository.WithRepoID(repos[0].ID()),
+			repository.WithRepoID(repo.ID()),
 			repository.WithOrderDesc("date"),
 			repository.WithLimit(1),
 		)
@@ -486,24 +623,21 @@ func (s *Server) handleEnrichmentDocs(
 	typ enrichment.Type,
 	subtype enrichment.Subtype,
 ) (*mcp.CallToolResult, error) {
-	repoURL, err := request.RequireString("repo_url")
+	args := NewArgs(request)
+	repoURL, err := args.RequiredString("repo_url")
 	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("repo_url is required: %v", err)), nil
+		return mcp.NewToolResultError(err.Error()), nil
 	}
 
-	repos, err := s.resolveRepository(ctx, repoURL)
-	if err != nil {
-		s.logger.Error().Str("repo_url", repoURL).Interface("error", err).Msg("failed to find repository")
-		return mcp.NewToolResultError(fmt.Sprintf("failed to find repository: %v", err)), nil
-	}
-	if len(repos) == 0 {
-		return mcp.NewToolResultError(fmt.Sprintf("repository not found: %s", repoURL)), nil
+	repo, errResult := s.resolveRepositoryOrError(ctx, repoURL)
+	if errResult != nil {
+		return errResult, nil
 	}
 
-	commitSHA := request.GetString("commit_sha", "")
+	commitSHA := args.OptionalString("commit_sha", "")
 	if commitSHA == "" {
 		commits, commitErr := s.commits.Find(ctx,
-			repository.WithRepoID(repos[0].ID()),
+			repository.WithRepoID(repo.ID()),
 			repository.WithOrderDesc("date"),
 			repository.WithLimit(1),
 		)
@@ -527,22 +661,31 @@ func (s *Server) handleEnrichmentDocs(
 		return mcp.NewToolResultError(fmt.Sprintf("failed to get enrichments: %v", err)), nil
 	}
 
-	// Filter out empty-content entries — handlers may persist sentinel
-	// markers (e.g. API docs attempt markers) with the same type/subtype to
-	// record that extraction was attempted but produced nothing.
-	parts := make([]string, 0, len(enrichments))
+	// A commit normally has at most one enrichment per type/subtype (handlers
+	// skip re-generating when one already exists), but retried tasks can
+	// leave duplicates behind with no defined order. Sort newest-first so a
+	// duplicate resolves deterministically to the most recent attempt rather
+	// than an arbitrary one.
+	sort.Slice(enrichments, func(i, j int) bool {
+		return enrichments[i].CreatedAt().After(enrichments[j].CreatedAt())
+	})
+
+	// Empty-content entries are sentinel markers (e.g. API docs attempt
+	// markers) recording that extraction was attempted but produced nothing.
+	var content string
 	for _, e := range enrichments {
-		if e.Content() == "" {
-			continue
+		if e.Content() != "" {
+			content = e.Content()
+			break
 		}
-		parts = append(parts, e.Content())
 	}
 
-	if len(parts) == 0 {
+	if content == "" {
 		return mcp.NewToolResultText(fmt.Sprintf("No %s/%s docs found for this commit.", typ, subtype)), nil
 	}
 
-	return mcp.NewToolResultText(strings.Join(parts, "\n\n")), nil
+	header := fmt.Sprintf("<!-- source commit: %s -->\n\n", commitSHA)
+	return mcp.NewToolResultText(header + content), nil
 }
 
 // fileResult holds the resolved file information for a search result.
@@ -643,6 +786,9 @@ func (s *Server) resolveFileResults(
 		if lr, found := lineRanges[idStr]; found {
 			if lr.StartLine() > 0 {
 				uri = uri.WithLineRange(lr.StartLine(), lr.EndLine())
+				if lr.Anchor() != "" {
+					uri = uri.WithAnchor(lr.Anchor())
+				}
 				lines = fmt.Sprintf("L%d-L%d", lr.StartLine(), lr.EndLine())
 			}
 			if lr.Page() > 0 {
@@ -672,28 +818,32 @@ func (s *Server) resolveFileResults(
 
 // handleSemanticSearch handles the semantic_search tool invocation.
 func (s *Server) handleSemanticSearch(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	query, err := request.RequireString("query")
+	args := NewArgs(request)
+	query, err := args.RequiredString("query")
 	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("query is required: %v", err)), nil
-	}
-	if strings.TrimSpace(query) == "" {
-		return mcp.NewToolResultError("query must not be empty"), nil
+		return mcp.NewToolResultError(err.Error()), nil
 	}
 
-	limit := int(request.GetFloat("limit", 10))
-	if limit < 0 {
-		return mcp.NewToolResultError("limit must not be negative"), nil
+	limit, err := args.Limit("limit", 10, 0)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
 	}
 	if limit == 0 {
 		return mcp.NewToolResultText("[]"), nil
 	}
 
-	language := normalizeExtension(request.GetString("language", ""))
+	language := args.Language("language")
+	sourceRepoURL := args.OptionalString("source_repo", "")
+
+	cacheKey := fmt.Sprintf("semantic_search|%s|%d|%s|%s", query, limit, language, sourceRepoURL)
+	if cached, ok := s.cache.get(cacheKey); ok {
+		return cached, nil
+	}
 
 	// Resolve source_repo URL to a repository ID for post-filtering.
 	var sourceRepoID int64
-	if repoURL := request.GetString("source_repo", ""); repoURL != "" {
-		repos, repoErr := s.resolveRepository(ctx, repoURL)
+	if sourceRepoURL != "" {
+		repos, repoErr := s.resolveRepository(ctx, sourceRepoURL)
 		if repoErr != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("resolve source_repo: %v", repoErr)), nil
 		}
@@ -741,33 +891,116 @@ func (s *Server) handleSemanticSearch(ctx context.Context, request mcp.CallToolR
 		return mcp.NewToolResultError(fmt.Sprintf("marshal results: %v", err)), nil
 	}
 
+	result := mcp.NewToolResultText(string(jsonBytes))
+	s.cache.set(cacheKey, result)
+	return result, nil
+}
+
+// handleFindSimilarCode handles the find_similar_code tool invocation. It
+// embeds the provided code block itself (rather than a natural language
+// description of it) and returns the nearest existing snippets, for the
+// "have we already written this?" workflow.
+func (s *Server) handleFindSimilarCode(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := NewArgs(request)
+	code, err := args.RequiredString("code")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	limit, err := args.Limit("limit", 10, 0)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if limit == 0 {
+		return mcp.NewToolResultText("[]"), nil
+	}
+
+	language := args.Language("language")
+	sourceRepoURL := args.OptionalString("source_repo", "")
+
+	// Not cached: unlike a natural language query, a code block is unlikely
+	// to repeat verbatim across calls, so caching would just waste memory.
+
+	var sourceRepoID int64
+	if sourceRepoURL != "" {
+		repos, repoErr := s.resolveRepository(ctx, sourceRepoURL)
+		if repoErr != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("resolve source_repo: %v", repoErr)), nil
+		}
+		if len(repos) == 0 {
+			return mcp.NewToolResultText("[]"), nil
+		}
+		sourceRepoID = repos[0].ID()
+	}
+
+	var filterOpts []search.FiltersOption
+	if language != "" {
+		filterOpts = append(filterOpts, search.WithLanguages([]string{language}))
+	}
+	if sourceRepoID > 0 {
+		filterOpts = append(filterOpts, search.WithSourceRepos([]int64{sourceRepoID}))
+	}
+	filters := search.NewFilters(filterOpts...)
+
+	enrichments, scores, err := s.semanticSearch.SearchCodeWithScores(ctx, code, limit, filters)
+	if err != nil {
+		s.logger.Error().Interface("error", err).Msg("find similar code failed")
+		return mcp.NewToolResultError(fmt.Sprintf("find similar code failed: %v", err)), nil
+	}
+
+	if len(enrichments) > limit {
+		enrichments = enrichments[:limit]
+	}
+
+	if len(enrichments) == 0 {
+		return mcp.NewToolResultText("[]"), nil
+	}
+
+	results, err := s.resolveFileResults(ctx, enrichments, scores, sourceRepoID)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	if len(results) == 0 {
+		return mcp.NewToolResultText("[]"), nil
+	}
+
+	jsonBytes, err := json.Marshal(results)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("marshal results: %v", err)), nil
+	}
+
 	return mcp.NewToolResultText(string(jsonBytes)), nil
 }
 
 // handleKeywordSearch handles the keyword_search tool invocation.
 func (s *Server) handleKeywordSearch(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	keywords, err := request.RequireString("keywords")
+	args := NewArgs(request)
+	keywords, err := args.RequiredString("keywords")
 	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("keywords is required: %v", err)), nil
-	}
-	if strings.TrimSpace(keywords) == "" {
-		return mcp.NewToolResultError("keywords must not be empty"), nil
+		return mcp.NewToolResultError(err.Error()), nil
 	}
 
-	limit := int(request.GetFloat("limit", 10))
-	if limit < 0 {
-		return mcp.NewToolResultError("limit must not be negative"), nil
+	limit, err := args.Limit("limit", 10, 0)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
 	}
 	if limit == 0 {
 		return mcp.NewToolResultText("[]"), nil
 	}
 
-	language := normalizeExtension(request.GetString("language", ""))
+	language := args.Language("language")
+	sourceRepoURL := args.OptionalString("source_repo", "")
+
+	cacheKey := fmt.Sprintf("keyword_search|%s|%d|%s|%s", keywords, limit, language, sourceRepoURL)
+	if cached, ok := s.cache.get(cacheKey); ok {
+		return cached, nil
+	}
 
 	// Resolve source_repo URL to a repository ID for post-filtering.
 	var sourceRepoID int64
-	if repoURL := request.GetString("source_repo", ""); repoURL != "" {
-		repos, repoErr := s.resolveRepository(ctx, repoURL)
+	if sourceRepoURL != "" {
+		repos, repoErr := s.resolveRepository(ctx, sourceRepoURL)
 		if repoErr != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("resolve source_repo: %v", repoErr)), nil
 		}
@@ -822,7 +1055,9 @@ func (s *Server) handleKeywordSearch(ctx context.Context, request mcp.CallToolRe
 		return mcp.NewToolResultError(fmt.Sprintf("marshal results: %v", err)), nil
 	}
 
-	return mcp.NewToolResultText(string(jsonBytes)), nil
+	result := mcp.NewToolResultText(string(jsonBytes))
+	s.cache.set(cacheKey, result)
+	return result, nil
 }
 
 // handleVisualSearch handles the visual_search tool invocation.
@@ -831,26 +1066,31 @@ func (s *Server) handleVisualSearch(ctx context.Context, request mcp.CallToolReq
 		return mcp.NewToolResultError("visual search is not available — vision model not configured"), nil
 	}
 
-	query, err := request.RequireString("query")
+	args := NewArgs(request)
+	query, err := args.RequiredString("query")
 	if err != nil {
-		return mcp.NewToolResultError(fmt.Sprintf("query is required: %v", err)), nil
-	}
-	if strings.TrimSpace(query) == "" {
-		return mcp.NewToolResultError("query must not be empty"), nil
+		return mcp.NewToolResultError(err.Error()), nil
 	}
 
-	limit := int(request.GetFloat("limit", 10))
-	if limit < 0 {
-		return mcp.NewToolResultError("limit must not be negative"), nil
+	limit, err := args.Limit("limit", 10, 0)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
 	}
 	if limit == 0 {
 		return mcp.NewToolResultText("[]"), nil
 	}
 
+	sourceRepoURL := args.OptionalString("source_repo", "")
+
+	cacheKey := fmt.Sprintf("visual_search|%s|%d|%s", query, limit, sourceRepoURL)
+	if cached, ok := s.cache.get(cacheKey); ok {
+		return cached, nil
+	}
+
 	// Resolve source_repo URL to a repository ID for post-filtering.
 	var sourceRepoID int64
-	if repoURL := request.GetString("source_repo", ""); repoURL != "" {
-		repos, repoErr := s.resolveRepository(ctx, repoURL)
+	if sourceRepoURL != "" {
+		repos, repoErr := s.resolveRepository(ctx, sourceRepoURL)
 		if repoErr != nil {
 			return mcp.NewToolResultError(fmt.Sprintf("resolve source_repo: %v", repoErr)), nil
 		}
@@ -894,46 +1134,39 @@ func (s *Server) handleVisualSearch(ctx context.Context, request mcp.CallToolReq
 		return mcp.NewToolResultError(fmt.Sprintf("marshal results: %v", err)), nil
 	}
 
-	return mcp.NewToolResultText(string(jsonBytes)), nil
+	result := mcp.NewToolResultText(string(jsonBytes))
+	s.cache.set(cacheKey, result)
+	return result, nil
 }
 
 // handleGrep handles the grep tool invocation.
 func (s *Server) handleGrep(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	repoURL, err := request.RequireString("repo_url")
+	args := NewArgs(request)
+	repoURL, err := args.RequiredString("repo_url")
 	if err != nil {
-		return mcp.NewToolResultError("repo_url is required"), nil
+		return mcp.NewToolResultError(err.Error()), nil
 	}
 
-	pattern, err := request.RequireString("pattern")
+	pattern, err := args.RequiredString("pattern")
 	if err != nil {
-		return mcp.NewToolResultError("pattern is required"), nil
-	}
-	if strings.TrimSpace(pattern) == "" {
-		return mcp.NewToolResultError("pattern must not be empty"), nil
+		return mcp.NewToolResultError(err.Error()), nil
 	}
 
-	repos, err := s.resolveRepository(ctx, repoURL)
-	if err != nil {
-		s.logger.Error().Str("repo_url", repoURL).Interface("error", err).Msg("failed to find repository")
-		return mcp.NewToolResultError(fmt.Sprintf("failed to find repository: %v", err)), nil
-	}
-	if len(repos) == 0 {
-		return mcp.NewToolResultError(fmt.Sprintf("repository not found: %s", repoURL)), nil
+	repo, errResult := s.resolveRepositoryOrError(ctx, repoURL)
+	if errResult != nil {
+		return errResult, nil
 	}
 
-	glob := request.GetString("glob", "")
-	limit := int(request.GetFloat("limit", 50))
-	if limit < 0 {
-		return mcp.NewToolResultError("limit must not be negative"), nil
+	glob := args.OptionalString("glob", "")
+	limit, err := args.Limit("limit", 50, 200)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
 	}
 	if limit == 0 {
 		return mcp.NewToolResultText("[]"), nil
 	}
-	if limit > 200 {
-		limit = 200
-	}
 
-	results, err := s.grepper.Search(ctx, repos[0].ID(), pattern, glob, limit)
+	results, err := s.grepper.Search(ctx, repo.ID(), pattern, glob, limit)
 	if err != nil {
 		s.logger.Error().Interface("error", err).Msg("grep failed")
 		return mcp.NewToolResultError(fmt.Sprintf("grep failed: %v", err)), nil
@@ -982,6 +1215,273 @@ func (s *Server) handleGrep(ctx context.Context, request mcp.CallToolRequest) (*
 	return mcp.NewToolResultText(string(jsonBytes)), nil
 }
 
+// overlayResult holds a single chunk from a repository's uncommitted working
+// tree changes. Unlike fileResult, it carries no resource URI: uncommitted
+// content has no commit SHA to address it by, so the content is returned
+// inline instead.
+type overlayResult struct {
+	Path     string `json:"path"`
+	Language string `json:"language"`
+	Lines    string `json:"lines"`
+	Content  string `json:"content"`
+}
+
+func (s *Server) handleOverlay(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := NewArgs(request)
+	repoURL, err := args.RequiredString("repo_url")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	repo, errResult := s.resolveRepositoryOrError(ctx, repoURL)
+	if errResult != nil {
+		return errResult, nil
+	}
+
+	query := args.OptionalString("query", "")
+
+	snippets, err := s.overlayer.Search(ctx, repo.ID(), query)
+	if err != nil {
+		s.logger.Error().Interface("error", err).Msg("overlay search failed")
+		return mcp.NewToolResultError(fmt.Sprintf("overlay search failed: %v", err)), nil
+	}
+
+	if len(snippets) == 0 {
+		return mcp.NewToolResultText("[]"), nil
+	}
+
+	results := make([]overlayResult, 0, len(snippets))
+	for _, sn := range snippets {
+		results = append(results, overlayResult{
+			Path:     sn.Path,
+			Language: sn.Language,
+			Lines:    fmt.Sprintf("L%d-L%d", sn.StartLine, sn.EndLine),
+			Content:  sn.Content,
+		})
+	}
+
+	jsonBytes, err := json.Marshal(results)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("marshal results: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText(string(jsonBytes)), nil
+}
+
+// wikiSearchResult is a single wiki page match, the MCP-facing rendering of
+// service.WikiPageMatch.
+type wikiSearchResult struct {
+	Slug    string  `json:"slug"`
+	Title   string  `json:"title"`
+	Score   float64 `json:"score"`
+	Excerpt string  `json:"excerpt"`
+}
+
+// maxWikiExcerptLen bounds the preview text returned alongside each wiki
+// search match, so a large page doesn't dominate the tool response.
+const maxWikiExcerptLen = 280
+
+// wikiExcerpt returns the leading portion of a wiki page's content for use
+// as a search result preview.
+func wikiExcerpt(content string) string {
+	if len(content) <= maxWikiExcerptLen {
+		return content
+	}
+	return content[:maxWikiExcerptLen] + "..."
+}
+
+// handleSearchWiki handles the search_wiki tool invocation.
+func (s *Server) handleSearchWiki(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := NewArgs(request)
+	repoURL, err := args.RequiredString("repo_url")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	query, err := args.RequiredString("query")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	limit, err := args.Limit("limit", 10, 0)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if limit == 0 {
+		return mcp.NewToolResultText("[]"), nil
+	}
+
+	repo, errResult := s.resolveRepositoryOrError(ctx, repoURL)
+	if errResult != nil {
+		return errResult, nil
+	}
+
+	matches, err := s.wikier.SearchWiki(ctx, repo.ID(), query, limit)
+	if err != nil {
+		s.logger.Error().Interface("error", err).Msg("wiki search failed")
+		return mcp.NewToolResultError(fmt.Sprintf("wiki search failed: %v", err)), nil
+	}
+
+	if len(matches) == 0 {
+		return mcp.NewToolResultText("[]"), nil
+	}
+
+	results := make([]wikiSearchResult, 0, len(matches))
+	for _, m := range matches {
+		results = append(results, wikiSearchResult{
+			Slug:    m.Slug,
+			Title:   m.Title,
+			Score:   m.Score,
+			Excerpt: wikiExcerpt(m.Content),
+		})
+	}
+
+	jsonBytes, err := json.Marshal(results)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("marshal results: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText(string(jsonBytes)), nil
+}
+
+// patchSummaryResult is the MCP-facing rendering of service.PatchSummary.
+type patchSummaryResult struct {
+	Intent        string   `json:"intent"`
+	Risk          string   `json:"risk"`
+	AffectedAreas []string `json:"affected_areas"`
+}
+
+// handleSummarizePatch handles the summarize_patch tool invocation. Callers
+// provide either a raw unified diff, or a repo_url with base and head refs to
+// diff. Nothing is persisted; the summary is generated fresh on every call.
+func (s *Server) handleSummarizePatch(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := NewArgs(request)
+	diff := args.OptionalString("diff", "")
+	repoURL := args.OptionalString("repo_url", "")
+	base := args.OptionalString("base", "")
+	head := args.OptionalString("head", "")
+
+	if diff == "" && repoURL == "" {
+		return mcp.NewToolResultError("either diff or repo_url with base and head is required"), nil
+	}
+
+	var repoID int64
+	if diff == "" {
+		repo, errResult := s.resolveRepositoryOrError(ctx, repoURL)
+		if errResult != nil {
+			return errResult, nil
+		}
+		repoID = repo.ID()
+	}
+
+	summary, err := s.patchSummarizer.Summarize(ctx, repoID, diff, base, head)
+	if err != nil {
+		s.logger.Error().Interface("error", err).Msg("patch summary failed")
+		return mcp.NewToolResultError(fmt.Sprintf("patch summary failed: %v", err)), nil
+	}
+
+	jsonBytes, err := json.Marshal(patchSummaryResult{
+		Intent:        summary.Intent(),
+		Risk:          summary.Risk(),
+		AffectedAreas: summary.AffectedAreas(),
+	})
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("marshal result: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText(string(jsonBytes)), nil
+}
+
+// handleGetCommitDiff handles the get_commit_diff tool invocation, returning
+// the unified diff for a commit, optionally scoped to a single file path.
+func (s *Server) handleGetCommitDiff(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := NewArgs(request)
+	repoURL, err := args.RequiredString("repo_url")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	commitSHA, err := args.RequiredString("commit_sha")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	path := args.OptionalString("path", "")
+
+	repo, errResult := s.resolveRepositoryOrError(ctx, repoURL)
+	if errResult != nil {
+		return errResult, nil
+	}
+
+	diff, err := s.commitDiffer.Diff(ctx, repo.ID(), commitSHA, path)
+	if err != nil {
+		s.logger.Error().Interface("error", err).Msg("failed to get commit diff")
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get commit diff: %v", err)), nil
+	}
+	if diff == "" {
+		return mcp.NewToolResultText("no changes found"), nil
+	}
+
+	return mcp.NewToolResultText(diff), nil
+}
+
+// renameImpactResult is a single repository's reference counts for a symbol,
+// the MCP-facing rendering of service.RepoImpact.
+type renameImpactResult struct {
+	RepoURL        string             `json:"repo_url"`
+	ReferenceCount int                `json:"reference_count"`
+	Files          []renameImpactFile `json:"files"`
+}
+
+type renameImpactFile struct {
+	Path           string `json:"path"`
+	ReferenceCount int    `json:"reference_count"`
+}
+
+// handleRenameImpact handles the rename impact analysis tool invocation.
+func (s *Server) handleRenameImpact(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := NewArgs(request)
+	symbol, err := args.RequiredString("symbol")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	maxFiles, err := args.Limit("max_files", 50, 200)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if maxFiles == 0 {
+		return mcp.NewToolResultText("[]"), nil
+	}
+
+	impacts, err := s.impactAnalyzer.Analyze(ctx, symbol, maxFiles)
+	if err != nil {
+		s.logger.Error().Interface("error", err).Msg("rename impact analysis failed")
+		return mcp.NewToolResultError(fmt.Sprintf("rename impact analysis failed: %v", err)), nil
+	}
+
+	if len(impacts) == 0 {
+		return mcp.NewToolResultText("[]"), nil
+	}
+
+	results := make([]renameImpactResult, 0, len(impacts))
+	for _, impact := range impacts {
+		files := make([]renameImpactFile, 0, len(impact.Files))
+		for _, f := range impact.Files {
+			files = append(files, renameImpactFile{Path: f.Path, ReferenceCount: f.ReferenceCount})
+		}
+		results = append(results, renameImpactResult{
+			RepoURL:        impact.RepoURL,
+			ReferenceCount: impact.ReferenceCount,
+			Files:          files,
+		})
+	}
+
+	jsonBytes, err := json.Marshal(results)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("marshal results: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText(string(jsonBytes)), nil
+}
+
 // lsResult holds the resolved file information for an ls match.
 type lsResult struct {
 	URI  string `json:"uri"`
@@ -990,30 +1490,24 @@ type lsResult struct {
 
 // handleLs handles the ls tool invocation.
 func (s *Server) handleLs(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	repoURL, err := request.RequireString("repo_url")
+	args := NewArgs(request)
+	repoURL, err := args.RequiredString("repo_url")
 	if err != nil {
-		return mcp.NewToolResultError("repo_url is required"), nil
+		return mcp.NewToolResultError(err.Error()), nil
 	}
 
-	pattern, err := request.RequireString("pattern")
+	pattern, err := args.RequiredString("pattern")
 	if err != nil {
-		return mcp.NewToolResultError("pattern is required"), nil
-	}
-	if strings.TrimSpace(pattern) == "" {
-		return mcp.NewToolResultError("pattern must not be empty"), nil
+		return mcp.NewToolResultError(err.Error()), nil
 	}
 
-	repos, err := s.resolveRepository(ctx, repoURL)
-	if err != nil {
-		s.logger.Error().Str("repo_url", repoURL).Interface("error", err).Msg("failed to find repository")
-		return mcp.NewToolResultError(fmt.Sprintf("failed to find repository: %v", err)), nil
-	}
-	if len(repos) == 0 {
-		return mcp.NewToolResultError(fmt.Sprintf("repository not found: %s", repoURL)), nil
+	repo, errResult := s.resolveRepositoryOrError(ctx, repoURL)
+	if errResult != nil {
+		return errResult, nil
 	}
 
 	commits, err := s.commits.Find(ctx,
-		repository.WithRepoID(repos[0].ID()),
+		repository.WithRepoID(repo.ID()),
 		repository.WithOrderDesc("date"),
 		repository.WithLimit(1),
 	)
@@ -1026,7 +1520,7 @@ func (s *Server) handleLs(ctx context.Context, request mcp.CallToolRequest) (*mc
 	}
 	commitSHA := commits[0].SHA()
 
-	files, err := s.fileLister.ListFiles(ctx, repos[0].ID(), pattern)
+	files, err := s.fileLister.ListFiles(ctx, repo.ID(), pattern)
 	if err != nil {
 		s.logger.Error().Interface("error", err).Msg("list files failed")
 		return mcp.NewToolResultError(fmt.Sprintf("ls failed: %v", err)), nil
@@ -1034,7 +1528,7 @@ func (s *Server) handleLs(ctx context.Context, request mcp.CallToolRequest) (*mc
 
 	results := make([]lsResult, 0, len(files))
 	for _, f := range files {
-		uri := NewFileURI(repos[0].ID(), commitSHA, f.Path)
+		uri := NewFileURI(repo.ID(), commitSHA, f.Path)
 		results = append(results, lsResult{
 			URI:  uri.String(),
 			Size: f.Size,
@@ -1053,13 +1547,152 @@ func (s *Server) handleLs(ctx context.Context, request mcp.CallToolRequest) (*mc
 	return mcp.NewToolResultText(string(jsonBytes)), nil
 }
 
+// fileTreeEntry holds the resolved file information for a list_files match.
+type fileTreeEntry struct {
+	Path string `json:"path"`
+	URI  string `json:"uri"`
+	Size int64  `json:"size"`
+}
+
+// handleListFiles handles the list_files tool invocation, listing the
+// tracked files for a commit, optionally scoped to a path prefix and/or
+// filtered by glob pattern.
+func (s *Server) handleListFiles(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := NewArgs(request)
+	repoURL, err := args.RequiredString("repo_url")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	pathPrefix := args.OptionalString("path", "")
+	pattern := args.OptionalString("pattern", "")
+
+	repo, errResult := s.resolveRepositoryOrError(ctx, repoURL)
+	if errResult != nil {
+		return errResult, nil
+	}
+
+	commitSHA := args.OptionalString("commit_sha", "")
+	if commitSHA == "" {
+		commits, commitErr := s.commits.Find(ctx,
+			repository.WithRepoID(repo.ID()),
+			repository.WithOrderDesc("date"),
+			repository.WithLimit(1),
+		)
+		if commitErr != nil {
+			s.logger.Error().Interface("error", commitErr).Msg("failed to find latest commit")
+			return mcp.NewToolResultError(fmt.Sprintf("failed to find latest commit: %v", commitErr)), nil
+		}
+		if len(commits) == 0 {
+			return mcp.NewToolResultError("no commits found for repository"), nil
+		}
+		commitSHA = commits[0].SHA()
+	}
+
+	options := []repository.Option{repository.WithCommitSHA(commitSHA)}
+	if pathPrefix != "" {
+		options = append(options, repository.WithPathPrefix(pathPrefix))
+	}
+
+	files, err := s.files.Find(ctx, options...)
+	if err != nil {
+		s.logger.Error().Interface("error", err).Msg("failed to list files")
+		return mcp.NewToolResultError(fmt.Sprintf("failed to list files: %v", err)), nil
+	}
+
+	results := make([]fileTreeEntry, 0, len(files))
+	for _, f := range files {
+		if pattern != "" && !service.MatchGlob(pattern, f.Path()) {
+			continue
+		}
+		uri := NewFileURI(repo.ID(), commitSHA, f.Path())
+		results = append(results, fileTreeEntry{
+			Path: f.Path(),
+			URI:  uri.String(),
+			Size: f.Size(),
+		})
+	}
+
+	if len(results) == 0 {
+		return mcp.NewToolResultText("[]"), nil
+	}
+
+	jsonBytes, err := json.Marshal(results)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("marshal results: %v", err)), nil
+	}
+
+	return mcp.NewToolResultText(string(jsonBytes)), nil
+}
+
+// handleExplainPath handles the explain_path tool invocation, returning the
+// directory and file summary chain for a path from the repository root down
+// to the file itself.
+func (s *Server) handleExplainPath(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := NewArgs(request)
+	repoURL, err := args.RequiredString("repo_url")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	filePath, err := args.RequiredString("path")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	repo, errResult := s.resolveRepositoryOrError(ctx, repoURL)
+	if errResult != nil {
+		return errResult, nil
+	}
+
+	commitSHA := args.OptionalString("commit_sha", "")
+	if commitSHA == "" {
+		commits, commitErr := s.commits.Find(ctx,
+			repository.WithRepoID(repo.ID()),
+			repository.WithOrderDesc("date"),
+			repository.WithLimit(1),
+		)
+		if commitErr != nil {
+			s.logger.Error().Interface("error", commitErr).Msg("failed to find latest commit")
+			return mcp.NewToolResultError(fmt.Sprintf("failed to find latest commit: %v", commitErr)), nil
+		}
+		if len(commits) == 0 {
+			return mcp.NewToolResultError("no commits found for repository"), nil
+		}
+		commitSHA = commits[0].SHA()
+	}
+
+	files, err := s.files.Find(ctx, repository.WithCommitSHA(commitSHA), repository.WithPath(filePath))
+	if err != nil {
+		s.logger.Error().Interface("error", err).Msg("failed to find file")
+		return mcp.NewToolResultError(fmt.Sprintf("failed to find file: %v", err)), nil
+	}
+	if len(files) == 0 {
+		return mcp.NewToolResultError(fmt.Sprintf("file not found: %s", filePath)), nil
+	}
+
+	chain, err := s.enrichmentResolver.ExplainPath(ctx, commitSHA, files[0].ID(), filePath)
+	if err != nil {
+		s.logger.Error().Interface("error", err).Msg("failed to explain path")
+		return mcp.NewToolResultError(fmt.Sprintf("failed to explain path: %v", err)), nil
+	}
+	if len(chain) == 0 {
+		return mcp.NewToolResultText("No summaries found for this path yet."), nil
+	}
+
+	var b strings.Builder
+	for _, entry := range chain {
+		fmt.Fprintf(&b, "## %s\n\n%s\n\n", entry.Path, entry.Content)
+	}
+	return mcp.NewToolResultText(b.String()), nil
+}
+
 // handleReadResource handles the read_resource tool invocation.
 // It delegates to the file resource handler, allowing clients that do not
 // support MCP resources to read file content through a tool call.
 func (s *Server) handleReadResource(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	uri, err := request.RequireString("uri")
+	uri, err := NewArgs(request).RequiredString("uri")
 	if err != nil {
-		return mcp.NewToolResultError("uri is required"), nil
+		return mcp.NewToolResultError(err.Error()), nil
 	}
 
 	resourceRequest := mcp.ReadResourceRequest{}
@@ -1105,6 +1738,10 @@ func (s *Server) registerResources(mcpServer *server.MCPServer) {
 // Supports optional query parameters:
 //   - lines: line ranges to extract (e.g. L17-L26,L45)
 //   - line_numbers: "true" to prefix each line with its 1-based number
+//   - anchor: a content fingerprint recorded at index time for a single
+//     "L<start>-L<end>" lines value; if the file has since changed such
+//     that the range no longer matches, the anchor is used to re-locate it
+//     and the corrected range is read instead
 func (s *Server) handleReadFile(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
 	uri := request.Params.URI
 
@@ -1166,6 +1803,17 @@ func (s *Server) handleReadFile(ctx context.Context, request mcp.ReadResourceReq
 	linesParam := query.Get("lines")
 	lineNumbers := query.Get("line_numbers") == "true"
 
+	if anchorParam := query.Get("anchor"); anchorParam != "" && linesParam != "" {
+		if start, end, ok := parseSingleLineRange(linesParam); ok {
+			fileLines := strings.Split(string(content), "\n")
+			if correctedStart, correctedEnd, resolved := sourcelocation.Resolve(
+				fileLines, anchorParam, start, end, sourcelocation.DefaultSearchWindow,
+			); resolved {
+				linesParam = fmt.Sprintf("L%d-L%d", correctedStart, correctedEnd)
+			}
+		}
+	}
+
 	if linesParam != "" || lineNumbers {
 		filter, filterErr := service.NewLineFilter(linesParam)
 		if filterErr != nil {
@@ -1188,6 +1836,33 @@ func (s *Server) handleReadFile(ctx context.Context, request mcp.ReadResourceReq
 	}, nil
 }
 
+// parseSingleLineRange parses a "L<start>-L<end>" lines value, the format
+// FileURI emits for a single contiguous range. It does not handle the
+// comma-separated multi-range syntax service.LineFilter otherwise accepts;
+// anchor resolution only ever applies to the one range an indexed snippet
+// recorded.
+func parseSingleLineRange(param string) (start, end int, ok bool) {
+	if strings.Contains(param, ",") {
+		return 0, 0, false
+	}
+
+	startStr, endStr, found := strings.Cut(param, "-L")
+	if !found {
+		return 0, 0, false
+	}
+	startStr = strings.TrimPrefix(startStr, "L")
+
+	start, err := strconv.Atoi(startStr)
+	if err != nil {
+		return 0, 0, false
+	}
+	end, err = strconv.Atoi(endStr)
+	if err != nil {
+		return 0, 0, false
+	}
+	return start, end, true
+}
+
 // handleRasterRead renders a document page and returns it as a base64-encoded PNG blob.
 func (s *Server) handleRasterRead(ctx context.Context, uri string, repoID int64, blobName, filePath, pageStr string) ([]mcp.ResourceContents, error) {
 	if s.diskPathResolver == nil || s.rasterizers == nil {
@@ -1334,5 +2009,9 @@ func (s *Server) MCPServer() *server.MCPServer {
 
 // ServeStdio runs the MCP server on stdio.
 func (s *Server) ServeStdio() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.pollCorpusGeneration(ctx)
+
 	return server.ServeStdio(s.mcpServer)
 }
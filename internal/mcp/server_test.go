@@ -84,6 +84,10 @@ func (f *fakeEnrichmentQuery) List(_ context.Context, _ *service.EnrichmentListP
 	return f.enrichments, nil
 }
 
+func (f *fakeEnrichmentQuery) Count(_ context.Context, _ *service.EnrichmentListParams) (int64, error) {
+	return int64(len(f.enrichments)), nil
+}
+
 // fakeFileContentReader implements FileContentReader with canned content.
 type fakeFileContentReader struct {
 	content   []byte
@@ -119,6 +123,7 @@ type fakeEnrichmentResolver struct {
 	sourceFiles   map[string][]int64
 	lineRanges    map[string]sourcelocation.SourceLocation
 	repositoryIDs map[string]int64
+	pathSummaries []service.PathSummary
 }
 
 func (f *fakeEnrichmentResolver) SourceFiles(_ context.Context, _ []int64) (map[string][]int64, error) {
@@ -133,6 +138,10 @@ func (f *fakeEnrichmentResolver) RepositoryIDs(_ context.Context, _ []int64) (ma
 	return f.repositoryIDs, nil
 }
 
+func (f *fakeEnrichmentResolver) ExplainPath(_ context.Context, _ string, _ int64, _ string) ([]service.PathSummary, error) {
+	return f.pathSummaries, nil
+}
+
 // fakeFileFinder implements FileFinder with canned files.
 type fakeFileFinder struct {
 	files []repository.File
@@ -151,6 +160,64 @@ func (f *fakeGrepper) Search(_ context.Context, _ int64, _ string, _ string, _ i
 	return f.results, nil
 }
 
+// fakeOverlayer implements Overlayer with canned results.
+type fakeOverlayer struct {
+	results []service.OverlaySnippet
+}
+
+func (f *fakeOverlayer) Search(_ context.Context, _ int64, _ string) ([]service.OverlaySnippet, error) {
+	return f.results, nil
+}
+
+// fakeImpactAnalyzer implements RenameImpactAnalyzer with canned results.
+type fakeImpactAnalyzer struct {
+	impacts []service.RepoImpact
+}
+
+func (f *fakeImpactAnalyzer) Analyze(_ context.Context, _ string, _ int) ([]service.RepoImpact, error) {
+	return f.impacts, nil
+}
+
+// fakeArchitectureDiagramGenerator implements ArchitectureDiagramGenerator with a canned diagram.
+type fakeArchitectureDiagramGenerator struct {
+	diagram string
+	err     error
+}
+
+func (f *fakeArchitectureDiagramGenerator) Generate(_ context.Context, _ int64) (string, error) {
+	return f.diagram, f.err
+}
+
+// fakeWikier implements Wikier with canned matches.
+type fakeWikier struct {
+	matches []service.WikiPageMatch
+	err     error
+}
+
+func (f *fakeWikier) SearchWiki(_ context.Context, _ int64, _ string, _ int) ([]service.WikiPageMatch, error) {
+	return f.matches, f.err
+}
+
+// fakePatchSummarizer implements PatchSummarizer with a canned summary.
+type fakePatchSummarizer struct {
+	summary service.PatchSummary
+	err     error
+}
+
+func (f *fakePatchSummarizer) Summarize(_ context.Context, _ int64, _, _, _ string) (service.PatchSummary, error) {
+	return f.summary, f.err
+}
+
+// fakeCommitDiffer implements CommitDiffer with a canned diff.
+type fakeCommitDiffer struct {
+	diff string
+	err  error
+}
+
+func (f *fakeCommitDiffer) Diff(_ context.Context, _ int64, _, _ string) (string, error) {
+	return f.diff, f.err
+}
+
 // fakeFileLister implements FileLister with canned files.
 type fakeFileLister struct {
 	files []service.FileEntry
@@ -209,6 +276,9 @@ func testEnrichment() enrichment.Enrichment {
 		enrichment.EntityTypeSnippet,
 		"func hello() string { return \"world\" }",
 		"go",
+		false,
+		"",
+		enrichment.SnippetMetrics{},
 		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 	)
@@ -222,6 +292,9 @@ func testArchEnrichment() enrichment.Enrichment {
 		enrichment.EntityTypeCommit,
 		"# Architecture\nThis is the architecture doc.",
 		"",
+		false,
+		"",
+		enrichment.SnippetMetrics{},
 		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 	)
@@ -237,9 +310,15 @@ func testRepo() repository.Repository {
 		repository.WorkingCopy{},
 		repository.NewTrackingConfigForBranch("main"),
 		repository.DefaultChunkingConfig(),
+		repository.DefaultEnrichmentBudgetConfig(),
+		repository.DefaultEmbeddingConfig(),
+		repository.DefaultAccessConfig(),
+		repository.DefaultIndexFilterConfig(),
 		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 		time.Time{},
+		"",
+		false, false, false,
 	)
 }
 
@@ -275,6 +354,12 @@ func testServer() *Server {
 		&fakeFileLister{},
 		&fakeFileFinder{},
 		&fakeGrepper{},
+		&fakeOverlayer{},
+		&fakeImpactAnalyzer{},
+		&fakeArchitectureDiagramGenerator{},
+		&fakeWikier{},
+		&fakePatchSummarizer{},
+		&fakeCommitDiffer{},
 		"1.0.0-test",
 		zerolog.Nop(),
 	)
@@ -367,6 +452,27 @@ func TestServer_GetVersion(t *testing.T) {
 	}
 }
 
+func TestServer_ToolCall_ReturnsCorrelationID(t *testing.T) {
+	srv := testServer()
+	sendMessage(t, srv, "initialize", 1, initializeParams())
+
+	resp := sendMessage(t, srv, "tools/call", 2, map[string]any{
+		"name":      "kodit_version",
+		"arguments": map[string]any{},
+	})
+
+	var result mcp.CallToolResult
+	resultJSON(t, resp, &result)
+
+	if result.Meta == nil {
+		t.Fatal("expected _meta to be set")
+	}
+	id, ok := result.Meta.AdditionalFields["correlation_id"].(string)
+	if !ok || id == "" {
+		t.Fatalf("expected non-empty correlation_id, got %v", result.Meta.AdditionalFields["correlation_id"])
+	}
+}
+
 func TestServer_ListRepositories(t *testing.T) {
 	srv := testServer()
 	sendMessage(t, srv, "initialize", 1, initializeParams())
@@ -405,9 +511,15 @@ func TestServer_ListRepositories_DisplaysUpstreamURL(t *testing.T) {
 		repository.WorkingCopy{},
 		repository.NewTrackingConfigForBranch("main"),
 		repository.DefaultChunkingConfig(),
+		repository.DefaultEnrichmentBudgetConfig(),
+		repository.DefaultEmbeddingConfig(),
+		repository.DefaultAccessConfig(),
+		repository.DefaultIndexFilterConfig(),
 		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 		time.Time{},
+		"",
+		false, false, false,
 	)
 
 	srv := NewServer(
@@ -426,6 +538,12 @@ func TestServer_ListRepositories_DisplaysUpstreamURL(t *testing.T) {
 		&fakeFileLister{},
 		&fakeFileFinder{},
 		&fakeGrepper{},
+		&fakeOverlayer{},
+		&fakeImpactAnalyzer{},
+		&fakeArchitectureDiagramGenerator{},
+		&fakeWikier{},
+		&fakePatchSummarizer{},
+		&fakeCommitDiffer{},
 		"1.0.0-test",
 		zerolog.Nop(),
 	)
@@ -459,9 +577,15 @@ func TestServer_ListRepositories_FallsBackToSanitizedURL(t *testing.T) {
 		repository.WorkingCopy{},
 		repository.NewTrackingConfigForBranch("main"),
 		repository.DefaultChunkingConfig(),
+		repository.DefaultEnrichmentBudgetConfig(),
+		repository.DefaultEmbeddingConfig(),
+		repository.DefaultAccessConfig(),
+		repository.DefaultIndexFilterConfig(),
 		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 		time.Time{},
+		"",
+		false, false, false,
 	)
 
 	srv := NewServer(
@@ -480,6 +604,12 @@ func TestServer_ListRepositories_FallsBackToSanitizedURL(t *testing.T) {
 		&fakeFileLister{},
 		&fakeFileFinder{},
 		&fakeGrepper{},
+		&fakeOverlayer{},
+		&fakeImpactAnalyzer{},
+		&fakeArchitectureDiagramGenerator{},
+		&fakeWikier{},
+		&fakePatchSummarizer{},
+		&fakeCommitDiffer{},
 		"1.0.0-test",
 		zerolog.Nop(),
 	)
@@ -679,6 +809,9 @@ func semanticSearchServer() *Server {
 		enrichment.EntityTypeCommit,
 		"func handleRequest(ctx context.Context, w http.ResponseWriter, r *http.Request) {\n\tw.WriteHeader(200)\n}",
 		".go",
+		false,
+		"",
+		enrichment.SnippetMetrics{},
 		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 	)
@@ -699,12 +832,18 @@ func semanticSearchServer() *Server {
 		nil,
 		&fakeEnrichmentResolver{
 			sourceFiles:   map[string][]int64{"99": {10}},
-			lineRanges:    map[string]sourcelocation.SourceLocation{"99": sourcelocation.Reconstruct(1, 99, 0, 10, 25)},
+			lineRanges:    map[string]sourcelocation.SourceLocation{"99": sourcelocation.Reconstruct(1, 99, 0, 10, 25, "")},
 			repositoryIDs: map[string]int64{"99": 1},
 		},
 		&fakeFileLister{},
 		&fakeFileFinder{files: []repository.File{testFile}},
 		&fakeGrepper{},
+		&fakeOverlayer{},
+		&fakeImpactAnalyzer{},
+		&fakeArchitectureDiagramGenerator{},
+		&fakeWikier{},
+		&fakePatchSummarizer{},
+		&fakeCommitDiffer{},
 		"1.0.0-test",
 		zerolog.Nop(),
 	)
@@ -798,6 +937,9 @@ func TestServer_SemanticSearch_AbsolutePathNormalized(t *testing.T) {
 		enrichment.EntityTypeCommit,
 		"from google.cloud import bigquery\nclient = bigquery.Client()",
 		".py",
+		false,
+		"",
+		enrichment.SnippetMetrics{},
 		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 	)
@@ -826,6 +968,12 @@ func TestServer_SemanticSearch_AbsolutePathNormalized(t *testing.T) {
 		&fakeFileLister{},
 		&fakeFileFinder{files: []repository.File{testFile}},
 		&fakeGrepper{},
+		&fakeOverlayer{},
+		&fakeImpactAnalyzer{},
+		&fakeArchitectureDiagramGenerator{},
+		&fakeWikier{},
+		&fakePatchSummarizer{},
+		&fakeCommitDiffer{},
 		"1.0.0-test",
 		zerolog.Nop(),
 	)
@@ -879,7 +1027,10 @@ func TestServer_SemanticSearch_LanguageFilterDotPrefix(t *testing.T) {
 		enrichment.SubtypeChunk,
 		enrichment.EntityTypeCommit,
 		"from google.cloud import bigquery\nclient = bigquery.Client()",
-		"py", // stored WITHOUT dot
+		"py",
+		false,
+		"", // stored WITHOUT dot
+		enrichment.SnippetMetrics{},
 		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 	)
@@ -906,6 +1057,12 @@ func TestServer_SemanticSearch_LanguageFilterDotPrefix(t *testing.T) {
 		&fakeFileLister{},
 		&fakeFileFinder{files: []repository.File{testFile}},
 		&fakeGrepper{},
+		&fakeOverlayer{},
+		&fakeImpactAnalyzer{},
+		&fakeArchitectureDiagramGenerator{},
+		&fakeWikier{},
+		&fakePatchSummarizer{},
+		&fakeCommitDiffer{},
 		"1.0.0-test",
 		zerolog.Nop(),
 	)
@@ -1026,16 +1183,25 @@ func TestServer_SemanticSearch_LimitCapsResults(t *testing.T) {
 	e1 := enrichment.ReconstructEnrichment(
 		61, enrichment.TypeDevelopment, enrichment.SubtypeChunk, enrichment.EntityTypeCommit,
 		"func one() {}", ".go",
+		false,
+		"",
+		enrichment.SnippetMetrics{},
 		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 	)
 	e2 := enrichment.ReconstructEnrichment(
 		62, enrichment.TypeDevelopment, enrichment.SubtypeChunk, enrichment.EntityTypeCommit,
 		"func two() {}", ".go",
+		false,
+		"",
+		enrichment.SnippetMetrics{},
 		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 	)
 	e3 := enrichment.ReconstructEnrichment(
 		63, enrichment.TypeDevelopment, enrichment.SubtypeChunk, enrichment.EntityTypeCommit,
 		"func three() {}", ".go",
+		false,
+		"",
+		enrichment.SnippetMetrics{},
 		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 	)
 	f1 := repository.ReconstructFile(101, "aaa", "a.go", "", "", ".go", ".go", 64, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
@@ -1061,6 +1227,12 @@ func TestServer_SemanticSearch_LimitCapsResults(t *testing.T) {
 		&fakeFileLister{},
 		&fakeFileFinder{files: []repository.File{f1, f2, f3}},
 		&fakeGrepper{},
+		&fakeOverlayer{},
+		&fakeImpactAnalyzer{},
+		&fakeArchitectureDiagramGenerator{},
+		&fakeWikier{},
+		&fakePatchSummarizer{},
+		&fakeCommitDiffer{},
 		"1.0.0-test",
 		zerolog.Nop(),
 	)
@@ -1162,6 +1334,12 @@ func TestServer_SemanticSearchThenReadFile(t *testing.T) {
 				time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
 		}},
 		&fakeGrepper{},
+		&fakeOverlayer{},
+		&fakeImpactAnalyzer{},
+		&fakeArchitectureDiagramGenerator{},
+		&fakeWikier{},
+		&fakePatchSummarizer{},
+		&fakeCommitDiffer{},
 		"1.0.0-test",
 		zerolog.Nop(),
 	)
@@ -1223,6 +1401,9 @@ func TestServer_SemanticSearchThenReadFile_AbsolutePath(t *testing.T) {
 	e := enrichment.ReconstructEnrichment(
 		77, enrichment.TypeDevelopment, enrichment.SubtypeChunk, enrichment.EntityTypeCommit,
 		"from google.cloud import bigquery", ".py",
+		false,
+		"",
+		enrichment.SnippetMetrics{},
 		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 	)
 	srv := NewServer(
@@ -1248,6 +1429,12 @@ func TestServer_SemanticSearchThenReadFile_AbsolutePath(t *testing.T) {
 				"", "", ".py", ".py", 256, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
 		}},
 		&fakeGrepper{},
+		&fakeOverlayer{},
+		&fakeImpactAnalyzer{},
+		&fakeArchitectureDiagramGenerator{},
+		&fakeWikier{},
+		&fakePatchSummarizer{},
+		&fakeCommitDiffer{},
 		"1.0.0-test",
 		zerolog.Nop(),
 	)
@@ -1304,6 +1491,9 @@ func TestServer_SemanticSearchThenReadFile_WithLineRange(t *testing.T) {
 	e := enrichment.ReconstructEnrichment(
 		88, enrichment.TypeDevelopment, enrichment.SubtypeChunk, enrichment.EntityTypeCommit,
 		"line3\nline4\nline5", ".go",
+		false,
+		"",
+		enrichment.SnippetMetrics{},
 		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 	)
 	srv := NewServer(
@@ -1319,7 +1509,7 @@ func TestServer_SemanticSearchThenReadFile_WithLineRange(t *testing.T) {
 		nil,
 		&fakeEnrichmentResolver{
 			sourceFiles:   map[string][]int64{"88": {15}},
-			lineRanges:    map[string]sourcelocation.SourceLocation{"88": sourcelocation.Reconstruct(1, 88, 0, 3, 5)},
+			lineRanges:    map[string]sourcelocation.SourceLocation{"88": sourcelocation.Reconstruct(1, 88, 0, 3, 5, "")},
 			repositoryIDs: map[string]int64{"88": 1},
 		},
 		&fakeFileLister{},
@@ -1328,6 +1518,12 @@ func TestServer_SemanticSearchThenReadFile_WithLineRange(t *testing.T) {
 				time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
 		}},
 		&fakeGrepper{},
+		&fakeOverlayer{},
+		&fakeImpactAnalyzer{},
+		&fakeArchitectureDiagramGenerator{},
+		&fakeWikier{},
+		&fakePatchSummarizer{},
+		&fakeCommitDiffer{},
 		"1.0.0-test",
 		zerolog.Nop(),
 	)
@@ -1388,6 +1584,12 @@ func TestServer_SemanticSearchNoResults(t *testing.T) {
 		&fakeFileLister{},
 		&fakeFileFinder{},
 		&fakeGrepper{},
+		&fakeOverlayer{},
+		&fakeImpactAnalyzer{},
+		&fakeArchitectureDiagramGenerator{},
+		&fakeWikier{},
+		&fakePatchSummarizer{},
+		&fakeCommitDiffer{},
 		"1.0.0-test",
 		zerolog.Nop(),
 	)
@@ -1421,6 +1623,9 @@ func keywordSearchServer() *Server {
 		enrichment.EntityTypeCommit,
 		"func handleRequest(ctx context.Context, w http.ResponseWriter, r *http.Request) {\n\tw.WriteHeader(200)\n}",
 		".go",
+		false,
+		"",
+		enrichment.SnippetMetrics{},
 		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 	)
@@ -1441,12 +1646,18 @@ func keywordSearchServer() *Server {
 		nil,
 		&fakeEnrichmentResolver{
 			sourceFiles:   map[string][]int64{"99": {10}},
-			lineRanges:    map[string]sourcelocation.SourceLocation{"99": sourcelocation.Reconstruct(1, 99, 0, 10, 25)},
+			lineRanges:    map[string]sourcelocation.SourceLocation{"99": sourcelocation.Reconstruct(1, 99, 0, 10, 25, "")},
 			repositoryIDs: map[string]int64{"99": 1},
 		},
 		&fakeFileLister{},
 		&fakeFileFinder{files: []repository.File{testFile}},
 		&fakeGrepper{},
+		&fakeOverlayer{},
+		&fakeImpactAnalyzer{},
+		&fakeArchitectureDiagramGenerator{},
+		&fakeWikier{},
+		&fakePatchSummarizer{},
+		&fakeCommitDiffer{},
 		"1.0.0-test",
 		zerolog.Nop(),
 	)
@@ -1665,6 +1876,12 @@ func TestServer_KeywordSearch_NoResults(t *testing.T) {
 		&fakeFileLister{},
 		&fakeFileFinder{},
 		&fakeGrepper{},
+		&fakeOverlayer{},
+		&fakeImpactAnalyzer{},
+		&fakeArchitectureDiagramGenerator{},
+		&fakeWikier{},
+		&fakePatchSummarizer{},
+		&fakeCommitDiffer{},
 		"1.0.0-test",
 		zerolog.Nop(),
 	)
@@ -1754,6 +1971,9 @@ func TestServer_KeywordSearchThenReadFile(t *testing.T) {
 		enrichment.EntityTypeCommit,
 		"func handleRequest(ctx context.Context) {}",
 		".go",
+		false,
+		"",
+		enrichment.SnippetMetrics{},
 		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 	)
@@ -1780,6 +2000,12 @@ func TestServer_KeywordSearchThenReadFile(t *testing.T) {
 		&fakeFileLister{},
 		&fakeFileFinder{files: []repository.File{testFile}},
 		&fakeGrepper{},
+		&fakeOverlayer{},
+		&fakeImpactAnalyzer{},
+		&fakeArchitectureDiagramGenerator{},
+		&fakeWikier{},
+		&fakePatchSummarizer{},
+		&fakeCommitDiffer{},
 		"1.0.0-test",
 		zerolog.Nop(),
 	)
@@ -1877,6 +2103,9 @@ func testWikiEnrichment() enrichment.Enrichment {
 		enrichment.EntityTypeCommit,
 		wikiJSON,
 		"",
+		false,
+		"",
+		enrichment.SnippetMetrics{},
 		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 	)
@@ -1899,6 +2128,12 @@ func wikiServer() *Server {
 		&fakeFileLister{},
 		&fakeFileFinder{},
 		&fakeGrepper{},
+		&fakeOverlayer{},
+		&fakeImpactAnalyzer{},
+		&fakeArchitectureDiagramGenerator{},
+		&fakeWikier{},
+		&fakePatchSummarizer{},
+		&fakeCommitDiffer{},
 		"1.0.0-test",
 		zerolog.Nop(),
 	)
@@ -1978,6 +2213,12 @@ func TestServer_GetWiki_NoWiki(t *testing.T) {
 		&fakeFileLister{},
 		&fakeFileFinder{},
 		&fakeGrepper{},
+		&fakeOverlayer{},
+		&fakeImpactAnalyzer{},
+		&fakeArchitectureDiagramGenerator{},
+		&fakeWikier{},
+		&fakePatchSummarizer{},
+		&fakeCommitDiffer{},
 		"1.0.0-test",
 		zerolog.Nop(),
 	)
@@ -2097,6 +2338,12 @@ func lsServer(files []service.FileEntry) *Server {
 		&fakeFileLister{files: files},
 		&fakeFileFinder{},
 		&fakeGrepper{},
+		&fakeOverlayer{},
+		&fakeImpactAnalyzer{},
+		&fakeArchitectureDiagramGenerator{},
+		&fakeWikier{},
+		&fakePatchSummarizer{},
+		&fakeCommitDiffer{},
 		"1.0.0-test",
 		zerolog.Nop(),
 	)
@@ -2278,6 +2525,12 @@ func TestServer_Ls_RepoNotFound(t *testing.T) {
 		&fakeFileLister{},
 		&fakeFileFinder{},
 		&fakeGrepper{},
+		&fakeOverlayer{},
+		&fakeImpactAnalyzer{},
+		&fakeArchitectureDiagramGenerator{},
+		&fakeWikier{},
+		&fakePatchSummarizer{},
+		&fakeCommitDiffer{},
 		"1.0.0-test",
 		zerolog.Nop(),
 	)
@@ -2318,9 +2571,15 @@ func testRepoWithCredentials() repository.Repository {
 		repository.WorkingCopy{},
 		repository.NewTrackingConfigForBranch("main"),
 		repository.DefaultChunkingConfig(),
+		repository.DefaultEnrichmentBudgetConfig(),
+		repository.DefaultEmbeddingConfig(),
+		repository.DefaultAccessConfig(),
+		repository.DefaultIndexFilterConfig(),
 		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
 		time.Time{},
+		"",
+		false, false, false,
 	)
 }
 
@@ -2341,6 +2600,12 @@ func credentialServer() *Server {
 		&fakeFileLister{},
 		&fakeFileFinder{},
 		&fakeGrepper{},
+		&fakeOverlayer{},
+		&fakeImpactAnalyzer{},
+		&fakeArchitectureDiagramGenerator{},
+		&fakeWikier{},
+		&fakePatchSummarizer{},
+		&fakeCommitDiffer{},
 		"1.0.0-test",
 		zerolog.Nop(),
 	)
@@ -2417,6 +2682,12 @@ func TestServer_GetWiki_SanitizesCredentials(t *testing.T) {
 		&fakeFileLister{},
 		&fakeFileFinder{},
 		&fakeGrepper{},
+		&fakeOverlayer{},
+		&fakeImpactAnalyzer{},
+		&fakeArchitectureDiagramGenerator{},
+		&fakeWikier{},
+		&fakePatchSummarizer{},
+		&fakeCommitDiffer{},
 		"1.0.0-test",
 		zerolog.Nop(),
 	)
@@ -2463,6 +2734,12 @@ func TestServer_GetWikiPage_SanitizesCredentials(t *testing.T) {
 		&fakeFileLister{},
 		&fakeFileFinder{},
 		&fakeGrepper{},
+		&fakeOverlayer{},
+		&fakeImpactAnalyzer{},
+		&fakeArchitectureDiagramGenerator{},
+		&fakeWikier{},
+		&fakePatchSummarizer{},
+		&fakeCommitDiffer{},
 		"1.0.0-test",
 		zerolog.Nop(),
 	)
@@ -2522,6 +2799,12 @@ func TestServer_Grep_SanitizesCredentials(t *testing.T) {
 				},
 			},
 		},
+		&fakeOverlayer{},
+		&fakeImpactAnalyzer{},
+		&fakeArchitectureDiagramGenerator{},
+		&fakeWikier{},
+		&fakePatchSummarizer{},
+		&fakeCommitDiffer{},
 		"1.0.0-test",
 		zerolog.Nop(),
 	)
@@ -2569,6 +2852,12 @@ func TestServer_Ls_SanitizesCredentials(t *testing.T) {
 		&fakeFileLister{files: []service.FileEntry{{Path: "README.md", Size: 100}}},
 		&fakeFileFinder{},
 		&fakeGrepper{},
+		&fakeOverlayer{},
+		&fakeImpactAnalyzer{},
+		&fakeArchitectureDiagramGenerator{},
+		&fakeWikier{},
+		&fakePatchSummarizer{},
+		&fakeCommitDiffer{},
 		"1.0.0-test",
 		zerolog.Nop(),
 	)
@@ -2611,6 +2900,7 @@ var (
 	_ FileLister         = (*fakeFileLister)(nil)
 	_ FileFinder         = (*fakeFileFinder)(nil)
 	_ Grepper            = (*fakeGrepper)(nil)
+	_ Overlayer          = (*fakeOverlayer)(nil)
 )
 
 // TestServer_KeywordSearch_HTTP exercises keyword_search through the full HTTP
@@ -2742,6 +3032,12 @@ func grepServer() *Server {
 				},
 			},
 		},
+		&fakeOverlayer{},
+		&fakeImpactAnalyzer{},
+		&fakeArchitectureDiagramGenerator{},
+		&fakeWikier{},
+		&fakePatchSummarizer{},
+		&fakeCommitDiffer{},
 		"1.0.0-test",
 		zerolog.Nop(),
 	)
@@ -2866,6 +3162,12 @@ func TestServer_Grep_NoResults(t *testing.T) {
 		&fakeFileLister{},
 		&fakeFileFinder{},
 		&fakeGrepper{},
+		&fakeOverlayer{},
+		&fakeImpactAnalyzer{},
+		&fakeArchitectureDiagramGenerator{},
+		&fakeWikier{},
+		&fakePatchSummarizer{},
+		&fakeCommitDiffer{},
 		"1.0.0-test",
 		zerolog.Nop(),
 	)
@@ -3052,6 +3354,12 @@ func textServerWithTextRenderers() *Server {
 		&fakeFileLister{},
 		&fakeFileFinder{},
 		&fakeGrepper{},
+		&fakeOverlayer{},
+		&fakeImpactAnalyzer{},
+		&fakeArchitectureDiagramGenerator{},
+		&fakeWikier{},
+		&fakePatchSummarizer{},
+		&fakeCommitDiffer{},
 		"1.0.0-test",
 		zerolog.Nop(),
 		WithTextRendering(&fakeDiskPathResolver{}, reg),
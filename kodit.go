@@ -49,6 +49,19 @@
 //	    kodit.WithOpenAI(os.Getenv("OPENAI_API_KEY")),
 //	    kodit.WithFullPipeline(),
 //	)
+//
+// # Embedded mode
+//
+// For CLI tools and other programs that want to index and search a
+// repository without running the HTTP API server, [NewEmbedded] wires up a
+// local SQLite database, the built-in local embedding model, and the
+// RAG-only pipeline in one call:
+//
+//	client, err := kodit.NewEmbedded(".kodit")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	defer client.Close()
 package kodit
 
 import (
@@ -65,17 +78,24 @@ import (
 
 	"github.com/helixml/kodit/application/handler"
 	"github.com/helixml/kodit/application/service"
+	"github.com/helixml/kodit/domain/enrichment"
 	"github.com/helixml/kodit/domain/search"
 	domainservice "github.com/helixml/kodit/domain/service"
 	"github.com/helixml/kodit/domain/task"
+	"github.com/helixml/kodit/domain/usage"
 	"github.com/helixml/kodit/infrastructure/chunking"
 	"github.com/helixml/kodit/infrastructure/enricher"
 	"github.com/helixml/kodit/infrastructure/extraction"
 	"github.com/helixml/kodit/infrastructure/git"
+	"github.com/helixml/kodit/infrastructure/highlight"
+	"github.com/helixml/kodit/infrastructure/language"
+	"github.com/helixml/kodit/infrastructure/moderation"
 	"github.com/helixml/kodit/infrastructure/persistence"
 	"github.com/helixml/kodit/infrastructure/provider"
+	"github.com/helixml/kodit/infrastructure/qdrant"
 	"github.com/helixml/kodit/infrastructure/rasterization"
 	"github.com/helixml/kodit/infrastructure/tracking"
+	"github.com/helixml/kodit/infrastructure/vulnerability/osv"
 	"github.com/helixml/kodit/internal/config"
 	"github.com/helixml/kodit/internal/database"
 )
@@ -90,17 +110,46 @@ import (
 //	client.Search.Query(ctx, "query")
 type Client struct {
 	// Public resource fields (direct service access)
-	Repositories *service.Repository
-	Commits      *service.Commit
-	Tags         *service.Tag
-	Files        *service.File
-	Blobs        *service.Blob
-	Enrichments  *service.Enrichment
-	Tasks        *service.Queue
-	Tracking     *service.Tracking
-	Search       *service.Search
-	Grep         *service.Grep
-	Pipelines    *service.Pipeline
+	Repositories        *service.Repository
+	Commits             *service.Commit
+	Tags                *service.Tag
+	Files               *service.File
+	Blobs               *service.Blob
+	Enrichments         *service.Enrichment
+	Translations        *service.Translation
+	Usage               *service.Usage
+	QueryLog            *service.QueryLog
+	Audit               *service.Audit
+	Synonyms            *service.Synonym
+	Curation            *service.Curation
+	Snapshots           *service.Snapshot
+	Backfill            *service.Backfill
+	BulkOperations      *service.BulkOperations
+	Autocomplete        *service.Autocomplete
+	EmbeddingStatus     *service.EmbeddingStatus
+	Tasks               *service.Queue
+	TaskLogs            *service.TaskLogStore
+	Tracking            *service.Tracking
+	Health              *service.HealthMonitor
+	Integrity           *service.Integrity
+	Compaction          *service.PeriodicCompaction
+	Schedule            *service.Schedule
+	ProviderValidation  *service.ProviderValidation
+	Search              *service.Search
+	Grep                *service.Grep
+	RenameImpact        *service.RenameImpact
+	Overlay             *service.Overlay
+	Comparisons         *service.Comparison
+	Pipelines           *service.Pipeline
+	ArchitectureDiagram *service.ArchitectureDiagram
+	CorpusDiff          *service.CorpusDiff
+	Discovery           *service.Discovery
+	Cluster             *service.Cluster
+	PRPreview           *service.PRPreview
+	Export              *service.Export
+	PatchSummarizer     *service.PatchSummarizer
+	CommitDiffer        *service.CommitDiffer
+	Activity            *service.Activity
 
 	// MCPServer describes the MCP server's tools and instructions.
 	MCPServer MCPServer
@@ -109,9 +158,10 @@ type Client struct {
 	repoStores handler.RepositoryStores
 
 	// Stores not grouped into aggregates
-	taskStore      persistence.TaskStore
-	statusStore    persistence.StatusStore
-	lineRangeStore persistence.SourceLocationStore
+	taskStore            persistence.TaskStore
+	statusStore          persistence.StatusStore
+	embeddingStatusStore persistence.EmbeddingStatusStore
+	lineRangeStore       persistence.SourceLocationStore
 
 	// Aggregate dependencies
 	enrichCtx   handler.EnrichmentContext
@@ -125,7 +175,13 @@ type Client struct {
 	queue        *service.Queue
 	worker       *service.Worker
 	periodicSync *service.PeriodicSync
+	warmUp       *service.WarmUp
 	registry     *service.Registry
+	prGC         *service.PeriodicPRGC
+
+	// customHandlers are registered via WithHandler and applied alongside
+	// the built-in handlers in registerHandlers.
+	customHandlers map[task.Operation]service.Handler
 
 	// Document text extraction (internal)
 	documentText *extraction.DocumentText
@@ -136,25 +192,32 @@ type Client struct {
 	// Document rasterization (internal)
 	rasterizers *rasterization.Registry
 
+	// Syntax highlighting for search result previews (internal)
+	highlighter *highlight.Highlighter
+
 	// Discovery services (each used by exactly one handler)
-	archDiscoverer   *enricher.PhysicalArchitectureService
-	schemaDiscoverer *enricher.DatabaseSchemaService
-	apiDocService    *enricher.APIDocService
-	cookbookContext  *enricher.CookbookContextService
-	wikiContext      *enricher.WikiContextService
+	archDiscoverer     *enricher.PhysicalArchitectureService
+	schemaDiscoverer   *enricher.DatabaseSchemaService
+	apiDocService      *enricher.APIDocService
+	cookbookContext    *enricher.CookbookContextService
+	wikiContext        *enricher.WikiContextService
+	conventionsContext *enricher.ConventionsContextService
+	osvClient          *osv.Client
 
 	hugotEmbedding  *provider.HugotEmbedding
 	visionEmbedding *provider.LocalVisionEmbedding
 	visionEmbedder  search.Embedder
 	closers         []io.Closer
 
-	logger      zerolog.Logger
-	dataDir     string
-	cloneDir    string
-	apiKeys     []string
-	chunkParams chunking.ChunkParams
-	closed      atomic.Bool
-	mu          sync.Mutex
+	logger             zerolog.Logger
+	dataDir            string
+	cloneDir           string
+	apiKeys            []string
+	chunkParams        chunking.ChunkParams
+	contextTemplates   search.ContextTemplateConfig
+	workerDrainTimeout time.Duration
+	closed             atomic.Bool
+	mu                 sync.Mutex
 }
 
 // New creates a new Client with the given options.
@@ -210,6 +273,20 @@ func New(opts ...Option) (*Client, error) {
 		}
 	}
 
+	// Truncate stored embeddings to a reduced dimension for MRL-capable
+	// models. Applies to both indexing and query embedding since both go
+	// through cfg.embeddingProvider. Existing vectors are truncated in place
+	// by a queued TruncateVectorStore task below, on stores that support it;
+	// on other stores a dimension change is picked up lazily by onRebuilt's
+	// full RescanAll instead.
+	if cfg.embeddingDimensions > 0 {
+		truncated, err := search.NewTruncatedEmbedder(cfg.embeddingProvider, cfg.embeddingDimensions)
+		if err != nil {
+			return nil, fmt.Errorf("configure embedding dimensions: %w", err)
+		}
+		cfg.embeddingProvider = truncated
+	}
+
 	// Create vision embedding (remote or local SigLIP2). A single embedder
 	// handles both image and text inputs — it routes per item.
 	var visionEmbedding *provider.LocalVisionEmbedding
@@ -271,6 +348,26 @@ func New(opts ...Option) (*Client, error) {
 	stepDependencyStore := persistence.NewStepDependencyStore(db)
 	taskStore := persistence.NewTaskStore(db)
 	statusStore := persistence.NewStatusStore(db)
+	embeddingStatusStore := persistence.NewEmbeddingStatusStore(db)
+	usageStore := persistence.NewUsageStore(db)
+	queryLogStore := persistence.NewQueryLogStore(db)
+	auditStore := persistence.NewAuditStore(db)
+	synonymStore := persistence.NewSynonymStore(db)
+	curationStore := persistence.NewCurationStore(db)
+	snapshotStore := persistence.NewSnapshotStore(db)
+	snapshotArchiver := persistence.NewSnapshotArchiver(db)
+	bulkJobStore := persistence.NewBulkJobStore(db)
+	discoveryStore := persistence.NewDiscoveryStore(db)
+	prIndexStore := persistence.NewPRIndexStore(db)
+
+	// Record token usage and cost for every provider call so spend can be
+	// attributed per repository and operation later (see Client.Usage).
+	if cfg.textProvider != nil {
+		cfg.textProvider = provider.NewMeteredGenerator(cfg.textProvider, usageStore, usage.OperationEnrichment, logger).WithBudget(cfg.enrichmentDailyBudget)
+	}
+	if cfg.embeddingProvider != nil {
+		cfg.embeddingProvider = provider.NewMeteredEmbedder(cfg.embeddingProvider, usageStore, usage.OperationEmbedding, logger).WithBudget(cfg.embeddingDailyBudget)
+	}
 
 	// Group repository stores
 	repoStores := handler.RepositoryStores{
@@ -336,17 +433,25 @@ func New(opts ...Option) (*Client, error) {
 		}
 	}
 
-	// Create vision embedding store and index.
+	// Create vision embedding store and index, following the same
+	// vectorProvider selection as the text/code embedding stores.
 	var visionEmbeddingStore search.Store
-	switch cfg.database {
-	case databaseSQLite:
-		vs, vsErr := persistence.NewSQLiteEmbeddingStore(db, persistence.TaskNameVision, logger)
-		if vsErr != nil {
-			return nil, fmt.Errorf("vision embedding store: %w", vsErr)
+	switch cfg.vectorProvider {
+	case vectorProviderPgVector:
+		visionEmbeddingStore = persistence.NewPgVectorEmbeddingStore(db, search.TaskNameVision, nil, logger)
+	case vectorProviderQdrant:
+		visionEmbeddingStore = qdrant.NewStore(cfg.qdrantURL, cfg.qdrantAPIKey, "kodit_vision_embeddings")
+	default:
+		switch cfg.database {
+		case databaseSQLite:
+			vs, vsErr := persistence.NewSQLiteEmbeddingStore(db, search.TaskNameVision, logger)
+			if vsErr != nil {
+				return nil, fmt.Errorf("vision embedding store: %w", vsErr)
+			}
+			visionEmbeddingStore = vs
+		case databasePostgresVectorchord:
+			visionEmbeddingStore = persistence.NewVectorChordEmbeddingStore(db, search.TaskNameVision, nil, logger)
 		}
-		visionEmbeddingStore = vs
-	case databasePostgresVectorchord:
-		visionEmbeddingStore = persistence.NewVectorChordEmbeddingStore(db, persistence.TaskNameVision, nil, logger)
 	}
 	visionIndex := handler.VectorIndex{
 		Store: visionEmbeddingStore,
@@ -354,7 +459,7 @@ func New(opts ...Option) (*Client, error) {
 
 	// Create application services
 	registry := service.NewRegistry()
-	queue = service.NewQueue(taskStore, logger)
+	queue = service.NewQueue(taskStore, statusStore, logger)
 
 	enrichQSvc := service.NewEnrichment(enrichmentStore, associationStore, bm25Store, codeEmbeddingStore, textEmbeddingStore, visionEmbeddingStore, lineRangeStore)
 	trackingSvc := service.NewTracking(statusStore, taskStore)
@@ -370,7 +475,16 @@ func New(opts ...Option) (*Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("create git adapter: %w", err)
 	}
-	clonerSvc := git.NewRepositoryCloner(gitAdapter, cloneDir, logger)
+	var clonerSvc *git.RepositoryCloner
+	if cfg.cloneEncryptionKeyHex != "" {
+		encryptor, err := git.NewWorkingCopyEncryptor(cfg.cloneEncryptionKeyHex)
+		if err != nil {
+			return nil, fmt.Errorf("clone encryption: %w", err)
+		}
+		clonerSvc = git.NewEncryptedRepositoryCloner(gitAdapter, cloneDir, encryptor, logger)
+	} else {
+		clonerSvc = git.NewRepositoryCloner(gitAdapter, cloneDir, logger)
+	}
 	scannerSvc := git.NewRepositoryScanner(gitAdapter, logger)
 
 	gitInfra := handler.GitInfrastructure{
@@ -389,7 +503,11 @@ func New(opts ...Option) (*Client, error) {
 		reporters: reporters,
 		logger:    logger,
 	}
-	worker := service.NewWorker(taskStore, statusStore, registry, &workerTrackerAdapter{trackerFactory}, logger)
+	workerInstanceStore := persistence.NewWorkerInstanceStore(db)
+	taskLogStore := service.NewTaskLogStore()
+	worker := service.NewWorker(taskStore, statusStore, registry, &workerTrackerAdapter{trackerFactory}, logger).
+		WithInstanceRegistry(workerInstanceStore).
+		WithTaskLogs(taskLogStore)
 	if cfg.workerPollPeriod > 0 {
 		worker.WithPollPeriod(cfg.workerPollPeriod)
 	}
@@ -407,18 +525,28 @@ func New(opts ...Option) (*Client, error) {
 
 	// Create enricher infrastructure (only if text provider is configured)
 	var enricherImpl domainservice.Enricher
+	var moderatedEnrichmentStore enrichment.EnrichmentStore = enrichmentStore
 	if cfg.textProvider != nil {
 		enricherImpl = enricher.NewProviderEnricher(cfg.textProvider).
 			WithParallelism(cfg.enricherParallelism)
+
+		moderated, err := moderation.NewModeratedStore(enrichmentStore, moderation.NewRuleBasedModerator(), logger)
+		if err != nil {
+			_ = db.Close()
+			return nil, fmt.Errorf("create moderated enrichment store: %w", err)
+		}
+		moderatedEnrichmentStore = moderated
 	}
+	translationSvc := service.NewTranslation(moderatedEnrichmentStore, associationStore, enricherImpl)
 
 	// Build enrichment context
 	enrichCtx := handler.EnrichmentContext{
-		Enrichments:  enrichmentStore,
-		Associations: associationStore,
-		Enricher:     enricherImpl,
-		Tracker:      trackerFactory,
-		Logger:       logger,
+		Enrichments:      moderatedEnrichmentStore,
+		Associations:     associationStore,
+		Enricher:         enricherImpl,
+		Tracker:          trackerFactory,
+		Logger:           logger,
+		LanguageDetector: language.NewWordFrequencyDetector(),
 	}
 
 	// Create document text extractor
@@ -468,43 +596,55 @@ func New(opts ...Option) (*Client, error) {
 	apiDocSvc := enricher.NewAPIDocService()
 	cookbookCtx := enricher.NewCookbookContextService()
 	wikiCtx := enricher.NewWikiContextService()
+	conventionsCtx := enricher.NewConventionsContextService()
+	osvClient := osv.NewClient()
+
+	// Create syntax highlighter for search result previews.
+	highlighter := highlight.New("github")
 
 	// Register cooldowns for cleanup on close so pending statuses are flushed.
 	cfg.closers = append(cfg.closers, dbCooldown, logCooldown)
 
 	client := &Client{
-		db:               db,
-		repoStores:       repoStores,
-		taskStore:        taskStore,
-		statusStore:      statusStore,
-		lineRangeStore:   lineRangeStore,
-		enrichCtx:        enrichCtx,
-		codeIndex:        codeIndex,
-		textIndex:        textIndex,
-		visionIndex:      visionIndex,
-		gitInfra:         gitInfra,
-		bm25Service:      bm25Svc,
-		queue:            queue,
-		worker:           worker,
-		periodicSync:     periodicSync,
-		registry:         registry,
-		documentText:     documentText,
-		textRenderers:    textRenderers,
-		rasterizers:      rasterizers,
-		archDiscoverer:   archDiscoverer,
-		schemaDiscoverer: schemaDiscoverer,
-		apiDocService:    apiDocSvc,
-		cookbookContext:  cookbookCtx,
-		wikiContext:      wikiCtx,
-		hugotEmbedding:   hugotEmbedding,
-		visionEmbedding:  visionEmbedding,
-		visionEmbedder:   visionEmbedder,
-		closers:          cfg.closers,
-		logger:           logger,
-		dataDir:          dataDir,
-		cloneDir:         cloneDir,
-		apiKeys:          cfg.apiKeys,
-		chunkParams:      cfg.chunkParams,
+		db:                   db,
+		repoStores:           repoStores,
+		taskStore:            taskStore,
+		statusStore:          statusStore,
+		embeddingStatusStore: embeddingStatusStore,
+		lineRangeStore:       lineRangeStore,
+		enrichCtx:            enrichCtx,
+		codeIndex:            codeIndex,
+		textIndex:            textIndex,
+		visionIndex:          visionIndex,
+		gitInfra:             gitInfra,
+		bm25Service:          bm25Svc,
+		queue:                queue,
+		worker:               worker,
+		periodicSync:         periodicSync,
+		registry:             registry,
+		documentText:         documentText,
+		textRenderers:        textRenderers,
+		rasterizers:          rasterizers,
+		highlighter:          highlighter,
+		archDiscoverer:       archDiscoverer,
+		schemaDiscoverer:     schemaDiscoverer,
+		apiDocService:        apiDocSvc,
+		cookbookContext:      cookbookCtx,
+		wikiContext:          wikiCtx,
+		conventionsContext:   conventionsCtx,
+		osvClient:            osvClient,
+		hugotEmbedding:       hugotEmbedding,
+		visionEmbedding:      visionEmbedding,
+		visionEmbedder:       visionEmbedder,
+		customHandlers:       cfg.customHandlers,
+		closers:              cfg.closers,
+		logger:               logger,
+		dataDir:              dataDir,
+		cloneDir:             cloneDir,
+		apiKeys:              cfg.apiKeys,
+		chunkParams:          cfg.chunkParams,
+		contextTemplates:     cfg.contextTemplates,
+		workerDrainTimeout:   cfg.workerDrainTimeout,
 	}
 
 	// Populate MCP server metadata
@@ -526,12 +666,64 @@ func New(opts ...Option) (*Client, error) {
 	client.Commits = service.NewCommit(commitStore)
 	client.Tags = service.NewTag(tagStore)
 	client.Files = service.NewFile(fileStore)
-	client.Blobs = service.NewBlob(repoStore, commitStore, tagStore, branchStore, gitAdapter)
+	client.Audit = service.NewAudit(auditStore)
+	client.Blobs = service.NewBlob(repoStore, commitStore, tagStore, branchStore, gitAdapter, client.Audit)
 	client.Enrichments = enrichQSvc
+	client.Translations = translationSvc
+	client.Usage = service.NewUsage(usageStore, map[usage.Operation]usage.Budget{
+		usage.OperationEmbedding:  cfg.embeddingDailyBudget,
+		usage.OperationEnrichment: cfg.enrichmentDailyBudget,
+	})
+	client.QueryLog = service.NewQueryLog(queryLogStore)
+	client.Synonyms = service.NewSynonym(synonymStore)
+	client.Curation = service.NewCuration(curationStore)
+	client.Snapshots = service.NewSnapshot(snapshotStore, snapshotArchiver)
+	client.Backfill = service.NewBackfill(repoStore, commitStore, queue, logger)
+	client.BulkOperations = service.NewBulkOperations(bulkJobStore, repoStore, commitStore, enrichmentStore, client.Backfill, queue, logger)
+	client.Autocomplete = service.NewAutocomplete(repoStore, fileStore)
+	client.EmbeddingStatus = service.NewEmbeddingStatus(embeddingStatusStore, associationStore, queue)
 	client.Tasks = queue
+	client.TaskLogs = taskLogStore
+
+	// Queue the cheap in-place truncation migration so vectors indexed
+	// before WithEmbeddingDimensions was set (or set to a different value)
+	// get shrunk to match without re-embedding the corpus.
+	if cfg.embeddingDimensions > 0 {
+		payload := map[string]any{"dimensions": cfg.embeddingDimensions}
+		truncateTask := task.NewTask(task.OperationTruncateVectorStore, int(task.PriorityBackground), payload)
+		if err := queue.Enqueue(ctx, truncateTask); err != nil {
+			return nil, fmt.Errorf("enqueue vector truncation migration: %w", err)
+		}
+	}
 	client.Tracking = trackingSvc
-	client.Search = service.NewSearch(cfg.embeddingProvider, textEmbeddingStore, codeEmbeddingStore, bm25Store, client.visionEmbedder, visionEmbeddingStore, enrichmentStore, &client.closed, logger)
+	client.Activity = service.NewActivity(client.Commits, client.Enrichments, trackingSvc)
+	client.Search = service.NewSearch(cfg.embeddingProvider, textEmbeddingStore, codeEmbeddingStore, bm25Store, client.visionEmbedder, visionEmbeddingStore, enrichmentStore, client.Synonyms, client.Curation, &client.closed, logger)
 	client.Grep = service.NewGrep(repoStore, commitStore, gitAdapter)
+	client.RenameImpact = service.NewRenameImpact(repoStore, client.Grep)
+	client.Overlay = service.NewOverlay(repoStore, gitAdapter, extraction.NewExtractors(), cfg.chunkParams)
+	client.ArchitectureDiagram = service.NewArchitectureDiagram(repoStore, archDiscoverer)
+	client.Comparisons = service.NewComparison(commitStore, fileStore, client.Enrichments, client.Blobs)
+	client.CorpusDiff = service.NewCorpusDiff(commitStore, enrichmentStore, embeddingStatusStore)
+	client.Discovery = service.NewDiscovery(discoveryStore, client.Repositories, cfg.discovery.WebhookSecret(), cfg.discovery.NamePatterns(), cfg.discovery.AutoRegister(), logger)
+	client.Cluster = service.NewCluster(workerInstanceStore)
+	client.PRPreview = service.NewPRPreview(repoStore, prIndexStore, gitAdapter, queue, client.Pipelines, cfg.prPreviewTTL)
+	client.prGC = service.NewPeriodicPRGC(prIndexStore, associationStore, logger)
+	client.Export = service.NewExport(commitStore, fileStore, client.Enrichments, associationStore)
+	client.PatchSummarizer = service.NewPatchSummarizer(repoStore, enricherImpl, gitAdapter)
+	client.CommitDiffer = service.NewCommitDiffer(repoStore, gitAdapter)
+
+	var healthAlerter tracking.Alerter
+	if url := cfg.healthAlert.WebhookURL(); url != "" {
+		healthAlerter = tracking.NewWebhookAlerter(url)
+	} else {
+		healthAlerter = tracking.NewLoggingAlerter(logger)
+	}
+	client.Health = service.NewHealthMonitor(cfg.healthAlert, client.Repositories, client.Commits, client.Enrichments, client.Tracking, healthAlerter, logger)
+	client.Integrity = service.NewIntegrity(cfg.integrity, client.Repositories, gitAdapter, logger)
+	client.Compaction = service.NewPeriodicCompaction(cfg.compaction, client.queue, logger)
+	client.Schedule = service.NewSchedule(repoStore, periodicSync, client.Compaction)
+	client.ProviderValidation = service.NewProviderValidation(cfg.textProvider, cfg.embeddingProvider)
+	client.warmUp = service.NewWarmUp(cfg.warmUp, repoStore, client.Search, logger)
 
 	// Register task handlers
 	if err := client.registerHandlers(); err != nil {
@@ -554,10 +746,36 @@ func New(opts ...Option) (*Client, error) {
 		return nil, fmt.Errorf("start worker: %w", err)
 	}
 	periodicSync.Start(ctx)
+	client.Health.Start(ctx)
+	client.Integrity.Start(ctx)
+	client.Compaction.Start(ctx)
+	client.prGC.Start(ctx)
+	client.warmUp.Start(ctx)
 
 	return client, nil
 }
 
+// NewEmbedded creates a Client configured to run entirely in-process: a
+// local SQLite database (FTS5 for BM25 keyword search), the built-in local
+// embedding model, and the RAG-only pipeline (snippet extraction, BM25,
+// and code embeddings, with LLM enrichments skipped). This is the
+// quickest way for a Go program or CLI tool to index a repository and run
+// hybrid search without an external embedding provider or the HTTP API
+// server.
+//
+// dataDir holds the SQLite database, clone checkouts, and downloaded
+// models. Pass additional options to override any default, e.g.
+// [WithOpenAI] for a remote embedding provider or [WithFullPipeline] to
+// enable LLM enrichments.
+func NewEmbedded(dataDir string, opts ...Option) (*Client, error) {
+	embeddedOpts := []Option{
+		WithDataDir(dataDir),
+		WithSQLite(filepath.Join(dataDir, "kodit.db")),
+		WithRAGPipeline(),
+	}
+	return New(append(embeddedOpts, opts...)...)
+}
+
 // Close releases all resources and stops the background worker.
 func (c *Client) Close() error {
 	if !c.closed.CompareAndSwap(false, true) {
@@ -567,9 +785,14 @@ func (c *Client) Close() error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	// Stop the periodic sync and worker
+	// Stop the periodic sync, health alerting, integrity verification, compaction, warm-up, and worker
 	c.periodicSync.Stop()
-	c.worker.Stop()
+	c.Health.Stop()
+	c.Integrity.Stop()
+	c.Compaction.Stop()
+	c.prGC.Stop()
+	c.warmUp.Stop()
+	c.worker.Stop(c.workerDrainTimeout)
 
 	// Close built-in embedding provider
 	if c.hugotEmbedding != nil {
@@ -599,11 +822,27 @@ func (c *Client) WorkerIdle() bool {
 	return !c.worker.Busy()
 }
 
+// StartupComplete returns a channel that closes once the periodic sync
+// scheduler has run its first pass (or immediately, if periodic sync is
+// disabled). Callers can use this alongside a successful [New] to gate
+// startup readiness on the scheduler having run at least once.
+func (c *Client) StartupComplete() <-chan struct{} {
+	return c.periodicSync.FirstPassComplete()
+}
+
 // Logger returns the client's logger.
 func (c *Client) Logger() zerolog.Logger {
 	return c.logger
 }
 
+// Simulate runs the handler registered for operation in planning mode,
+// returning what it would do for payload without making provider calls or
+// writes. Returns task.ErrNotSimulatable if the registered handler does not
+// support simulation.
+func (c *Client) Simulate(ctx context.Context, operation task.Operation, payload map[string]any) (task.Plan, error) {
+	return c.registry.Simulate(ctx, operation, payload)
+}
+
 // TextRenderers returns the document text rendering registry.
 func (c *Client) TextRenderers() *extraction.TextRendererRegistry {
 	return c.textRenderers
@@ -614,9 +853,17 @@ func (c *Client) Rasterizers() *rasterization.Registry {
 	return c.rasterizers
 }
 
-// buildSearchStores creates the search stores based on config.
-// VectorChord embedding stores are constructed without touching the database;
-// their dimension probe and DDL run lazily on first use.
+// Highlighter returns the syntax highlighter used to render search result previews.
+func (c *Client) Highlighter() *highlight.Highlighter {
+	return c.highlighter
+}
+
+// buildSearchStores creates the search stores based on config. BM25 keyword
+// search always follows databaseType. Vector (embedding) search follows
+// cfg.vectorProvider, which defaults to the database's native store but can
+// be overridden to pgvector or Qdrant independently of the SQL database.
+// VectorChord and pgvector embedding stores are constructed without touching
+// the database; their dimension probe and DDL run lazily on first use.
 func buildSearchStores(cfg *clientConfig, db database.Database, onRebuilt func(context.Context), logger zerolog.Logger) (textEmbeddingStore, codeEmbeddingStore search.Store, bm25Store search.Store, err error) {
 	switch cfg.database {
 	case databaseSQLite:
@@ -624,26 +871,46 @@ func buildSearchStores(cfg *clientConfig, db database.Database, onRebuilt func(c
 		if err != nil {
 			return nil, nil, nil, fmt.Errorf("bm25 store: %w", err)
 		}
-		if cfg.embeddingProvider != nil {
-			textStore, textErr := persistence.NewSQLiteEmbeddingStore(db, persistence.TaskNameText, logger)
+	case databasePostgresVectorchord:
+		bm25Store, err = persistence.NewVectorChordBM25Store(db, logger)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("bm25 store: %w", err)
+		}
+	}
+
+	if cfg.embeddingProvider == nil {
+		return
+	}
+
+	switch cfg.vectorProvider {
+	case vectorProviderPgVector:
+		if cfg.database != databasePostgresVectorchord {
+			return nil, nil, nil, fmt.Errorf("WithPgVector requires WithPostgresVectorchord for the underlying connection")
+		}
+		textEmbeddingStore = persistence.NewPgVectorEmbeddingStore(db, search.TaskNameText, onRebuilt, logger)
+		codeEmbeddingStore = persistence.NewPgVectorEmbeddingStore(db, search.TaskNameCode, onRebuilt, logger)
+	case vectorProviderQdrant:
+		if cfg.qdrantURL == "" {
+			return nil, nil, nil, fmt.Errorf("WithQdrant requires a non-empty url")
+		}
+		textEmbeddingStore = qdrant.NewStore(cfg.qdrantURL, cfg.qdrantAPIKey, "kodit_text_embeddings")
+		codeEmbeddingStore = qdrant.NewStore(cfg.qdrantURL, cfg.qdrantAPIKey, "kodit_code_embeddings")
+	default:
+		switch cfg.database {
+		case databaseSQLite:
+			textStore, textErr := persistence.NewSQLiteEmbeddingStore(db, search.TaskNameText, logger)
 			if textErr != nil {
 				return nil, nil, nil, fmt.Errorf("text embedding store: %w", textErr)
 			}
 			textEmbeddingStore = textStore
-			codeStore, codeErr := persistence.NewSQLiteEmbeddingStore(db, persistence.TaskNameCode, logger)
+			codeStore, codeErr := persistence.NewSQLiteEmbeddingStore(db, search.TaskNameCode, logger)
 			if codeErr != nil {
 				return nil, nil, nil, fmt.Errorf("code embedding store: %w", codeErr)
 			}
 			codeEmbeddingStore = codeStore
-		}
-	case databasePostgresVectorchord:
-		bm25Store, err = persistence.NewVectorChordBM25Store(db, logger)
-		if err != nil {
-			return nil, nil, nil, fmt.Errorf("bm25 store: %w", err)
-		}
-		if cfg.embeddingProvider != nil {
-			textEmbeddingStore = persistence.NewVectorChordEmbeddingStore(db, persistence.TaskNameText, onRebuilt, logger)
-			codeEmbeddingStore = persistence.NewVectorChordEmbeddingStore(db, persistence.TaskNameCode, onRebuilt, logger)
+		case databasePostgresVectorchord:
+			textEmbeddingStore = persistence.NewVectorChordEmbeddingStore(db, search.TaskNameText, onRebuilt, logger)
+			codeEmbeddingStore = persistence.NewVectorChordEmbeddingStore(db, search.TaskNameCode, onRebuilt, logger)
 		}
 	}
 	return
@@ -4,11 +4,13 @@ import (
 	"context"
 	"os"
 	"path/filepath"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/helixml/kodit"
 	"github.com/helixml/kodit/application/service"
+	"github.com/helixml/kodit/domain/task"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -177,6 +179,32 @@ func TestWithRAGPipeline_WorksWithTextProvider(t *testing.T) {
 	defer func() { _ = client.Close() }()
 }
 
+func TestNewEmbedded_WithSQLite(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	client, err := kodit.NewEmbedded(tmpDir,
+		kodit.WithEmbeddingProvider(&stubEmbedder{}),
+		kodit.WithWorkerPollPeriod(unitTestPollPeriod),
+	)
+	require.NoError(t, err)
+	defer func() { _ = client.Close() }()
+
+	// Verify database file was created inside dataDir
+	_, err = os.Stat(filepath.Join(tmpDir, "kodit.db"))
+	assert.NoError(t, err)
+}
+
+func TestNewEmbedded_AllowsFullPipelineOverride(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	_, err := kodit.NewEmbedded(tmpDir,
+		kodit.WithEmbeddingProvider(&stubEmbedder{}),
+		kodit.WithFullPipeline(),
+	)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "WithFullPipeline requires a text provider")
+}
+
 func TestWithDataDir_CreatesDirectory(t *testing.T) {
 	tmpDir := t.TempDir()
 	dataDir := filepath.Join(tmpDir, "custom_data")
@@ -196,3 +224,60 @@ func TestWithDataDir_CreatesDirectory(t *testing.T) {
 	require.NoError(t, err)
 	assert.True(t, info.IsDir())
 }
+
+// recordingHandler is a fake service.Handler that records every payload it
+// executes, for asserting a custom operation ran.
+type recordingHandler struct {
+	mu       sync.Mutex
+	payloads []map[string]any
+}
+
+func (h *recordingHandler) Execute(_ context.Context, payload map[string]any) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.payloads = append(h.payloads, payload)
+	return nil
+}
+
+func (h *recordingHandler) executionCount() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.payloads)
+}
+
+func TestWithHandler_RunsCustomOperation(t *testing.T) {
+	tmpDir := t.TempDir()
+	dbPath := filepath.Join(tmpDir, "test.db")
+
+	const notifySlack task.Operation = "acme.notify_slack"
+	h := &recordingHandler{}
+
+	client, err := kodit.New(
+		kodit.WithSQLite(dbPath),
+		kodit.WithSkipProviderValidation(),
+		kodit.WithWorkerPollPeriod(unitTestPollPeriod),
+		kodit.WithHandler(notifySlack, h),
+	)
+	require.NoError(t, err)
+	defer func() { _ = client.Close() }()
+
+	ctx := context.Background()
+	require.NoError(t, client.Tasks.EnqueueOperations(ctx, []task.Operation{notifySlack}, task.PriorityNormal, map[string]any{"channel": "#builds"}))
+
+	require.Eventually(t, func() bool {
+		return h.executionCount() == 1
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestWithHandler_RejectsOperationAlreadyRegistered(t *testing.T) {
+	tmpDir := t.TempDir()
+	dbPath := filepath.Join(tmpDir, "test.db")
+
+	_, err := kodit.New(
+		kodit.WithSQLite(dbPath),
+		kodit.WithSkipProviderValidation(),
+		kodit.WithHandler(task.OperationSyncRepository, &recordingHandler{}),
+	)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "already has a handler registered")
+}
@@ -0,0 +1,12 @@
+package kodittest
+
+import "net/http"
+
+// healthHandler mirrors the production /healthz endpoint (cmd/kodit) so
+// that WaitForCondition-based readiness checks work the same way against a
+// kodittest.Server as they do against a real deployment.
+func healthHandler(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(`{"status":"healthy"}`))
+}
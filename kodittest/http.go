@@ -0,0 +1,85 @@
+package kodittest
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+)
+
+// GET performs a GET request against the server and returns the response.
+func (s *Server) GET(path string) *http.Response {
+	s.t.Helper()
+	resp, err := http.Get(s.URL() + path)
+	if err != nil {
+		s.t.Fatalf("kodittest: GET %s: %v", path, err)
+	}
+	return resp
+}
+
+// POST performs a POST request with a JSON body and returns the response.
+func (s *Server) POST(path string, body any) *http.Response {
+	s.t.Helper()
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		s.t.Fatalf("kodittest: marshal body: %v", err)
+	}
+	resp, err := http.Post(s.URL()+path, "application/json", bytes.NewReader(jsonBody))
+	if err != nil {
+		s.t.Fatalf("kodittest: POST %s: %v", path, err)
+	}
+	return resp
+}
+
+// PUT performs a PUT request with a JSON body and returns the response.
+func (s *Server) PUT(path string, body any) *http.Response {
+	s.t.Helper()
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		s.t.Fatalf("kodittest: marshal body: %v", err)
+	}
+	req, err := http.NewRequest(http.MethodPut, s.URL()+path, bytes.NewReader(jsonBody))
+	if err != nil {
+		s.t.Fatalf("kodittest: create PUT request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		s.t.Fatalf("kodittest: PUT %s: %v", path, err)
+	}
+	return resp
+}
+
+// DELETE performs a DELETE request against the server and returns the response.
+func (s *Server) DELETE(path string) *http.Response {
+	s.t.Helper()
+	req, err := http.NewRequest(http.MethodDelete, s.URL()+path, nil)
+	if err != nil {
+		s.t.Fatalf("kodittest: create DELETE request: %v", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		s.t.Fatalf("kodittest: DELETE %s: %v", path, err)
+	}
+	return resp
+}
+
+// DecodeJSON decodes resp's body as JSON into v and closes the body.
+func (s *Server) DecodeJSON(resp *http.Response, v any) {
+	s.t.Helper()
+	defer func() { _ = resp.Body.Close() }()
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		s.t.Fatalf("kodittest: decode response: %v", err)
+	}
+}
+
+// ReadBody reads resp's body as a string and closes it.
+func (s *Server) ReadBody(resp *http.Response) string {
+	s.t.Helper()
+	defer func() { _ = resp.Body.Close() }()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		s.t.Fatalf("kodittest: read body: %v", err)
+	}
+	return string(body)
+}
@@ -0,0 +1,55 @@
+package kodittest
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/helixml/kodit/infrastructure/api/v1/dto"
+)
+
+// defaultPollInterval is how often WaitForCondition and WaitForIndexing
+// re-check their condition.
+const defaultPollInterval = 500 * time.Millisecond
+
+// WaitForCondition polls check every defaultPollInterval until it returns
+// true or timeout elapses, returning whether it succeeded.
+func (s *Server) WaitForCondition(timeout time.Duration, check func() bool) bool {
+	s.t.Helper()
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		if check() {
+			return true
+		}
+		time.Sleep(defaultPollInterval)
+	}
+	return check()
+}
+
+// terminalIndexingStatuses are the /status/summary values that mean a
+// repository's indexing run has finished, successfully or not.
+var terminalIndexingStatuses = map[string]bool{
+	"completed":             true,
+	"completed_with_errors": true,
+	"failed":                true,
+}
+
+// WaitForIndexing blocks until the repository's status summary reports a
+// terminal state, or fails the test if timeout elapses first.
+func (s *Server) WaitForIndexing(repoID int64, timeout time.Duration) {
+	s.t.Helper()
+
+	done := s.WaitForCondition(timeout, func() bool {
+		resp := s.GET("/api/v1/repositories/" + strconv.FormatInt(repoID, 10) + "/status/summary")
+		if resp.StatusCode != http.StatusOK {
+			_ = resp.Body.Close()
+			return false
+		}
+		var summary dto.RepositoryStatusSummaryResponse
+		s.DecodeJSON(resp, &summary)
+		return terminalIndexingStatuses[summary.Data.Attributes.Status]
+	})
+	if !done {
+		s.t.Fatalf("kodittest: indexing did not complete within %s for repository %d", timeout, repoID)
+	}
+}
@@ -6,8 +6,10 @@ import (
 
 	"github.com/rs/zerolog"
 
+	"github.com/helixml/kodit/application/service"
 	"github.com/helixml/kodit/domain/search"
 	"github.com/helixml/kodit/domain/task"
+	"github.com/helixml/kodit/domain/usage"
 	"github.com/helixml/kodit/infrastructure/chunking"
 	"github.com/helixml/kodit/infrastructure/provider"
 	"github.com/helixml/kodit/internal/config"
@@ -22,6 +24,20 @@ const (
 	databasePostgresVectorchord
 )
 
+// vectorProviderType identifies which backend serves vector (embedding)
+// search, independently of the SQL database selected via databaseType.
+// BM25 keyword search is unaffected — it always follows databaseType.
+type vectorProviderType int
+
+const (
+	// vectorProviderNative uses whatever vector store databaseType implies:
+	// SQLite's own embedding table, or VectorChord on Postgres. This is the
+	// default and preserves existing behavior.
+	vectorProviderNative vectorProviderType = iota
+	vectorProviderPgVector
+	vectorProviderQdrant
+)
+
 // clientConfig holds configuration for Client construction.
 // Use newClientConfig() to create with defaults from internal/config.
 type clientConfig struct {
@@ -30,6 +46,7 @@ type clientConfig struct {
 	dbDSN                  string
 	dataDir                string
 	cloneDir               string
+	cloneEncryptionKeyHex  string
 	modelDir               string
 	textProvider           provider.TextGenerator
 	embeddingProvider      search.Embedder
@@ -37,21 +54,40 @@ type clientConfig struct {
 	apiKeys                []string
 	workerCount            int
 	workerPollPeriod       time.Duration
+	workerDrainTimeout     time.Duration
 	skipProviderValidation bool
 	embeddingBudget        search.TokenBudget
 	enrichmentBudget       search.TokenBudget
+	embeddingDailyBudget   usage.Budget
+	enrichmentDailyBudget  usage.Budget
 	embeddingParallelism   int
 	enrichmentParallelism  int
 	enricherParallelism    int
+	embeddingDimensions    int
 	periodicSync           config.PeriodicSyncConfig
+	healthAlert            config.HealthAlertConfig
+	integrity              config.IntegrityConfig
+	compaction             config.CompactionConfig
+	warmUp                 config.WarmUpConfig
+	discovery              config.DiscoveryConfig
 	chunkParams            chunking.ChunkParams
+	contextTemplates       search.ContextTemplateConfig
+	prPreviewTTL           time.Duration
 	closers                []io.Closer
 
+	// Vector search backend (independent of database)
+	vectorProvider vectorProviderType
+	qdrantURL      string
+	qdrantAPIKey   string
+
 	// Vision embedding
 	visionEmbedder search.Embedder
 
 	// Pipeline configuration
 	prescribedOpsFactory func(hasTextProvider bool) task.PrescribedOperations
+
+	// Custom task handlers registered via WithHandler, keyed by operation.
+	customHandlers map[task.Operation]service.Handler
 }
 
 // newClientConfig creates a clientConfig with defaults from internal/config.
@@ -60,14 +96,23 @@ func newClientConfig() *clientConfig {
 	return &clientConfig{
 		dataDir:               config.DefaultDataDir(),
 		workerCount:           config.DefaultWorkerCount,
+		workerDrainTimeout:    config.DefaultWorkerDrainTimeout,
 		embeddingBudget:       search.DefaultTokenBudget(),
 		enrichmentBudget:      search.DefaultTokenBudget(),
 		embeddingParallelism:  1,
 		enrichmentParallelism: 1,
 		enricherParallelism:   1,
 		periodicSync:          config.NewPeriodicSyncConfig(),
+		healthAlert:           config.NewHealthAlertConfig(),
+		integrity:             config.NewIntegrityConfig(),
+		compaction:            config.NewCompactionConfig(),
+		warmUp:                config.NewWarmUpConfig(),
+		discovery:             config.NewDiscoveryConfig(),
 		chunkParams:           chunking.DefaultChunkParams(),
+		contextTemplates:      search.NewContextTemplateConfig("", nil),
+		prPreviewTTL:          service.DefaultPRPreviewTTL,
 		prescribedOpsFactory:  task.DefaultPrescribedOperations,
+		customHandlers:        make(map[task.Operation]service.Handler),
 	}
 }
 
@@ -92,6 +137,29 @@ func WithPostgresVectorchord(dsn string) Option {
 	}
 }
 
+// WithPgVector selects the plain pgvector extension, rather than
+// VectorChord's vchordrq, for vector (embedding) search. Requires
+// WithPostgresVectorchord for the underlying connection and BM25 keyword
+// search — only the vector store implementation changes.
+func WithPgVector() Option {
+	return func(c *clientConfig) {
+		c.vectorProvider = vectorProviderPgVector
+	}
+}
+
+// WithQdrant selects a Qdrant collection as the vector (embedding) search
+// backend, reached over its HTTP API at url. apiKey may be empty if the
+// Qdrant instance does not require authentication. The SQL database
+// configured via WithSQLite or WithPostgresVectorchord still serves BM25
+// keyword search and all non-vector data.
+func WithQdrant(url, apiKey string) Option {
+	return func(c *clientConfig) {
+		c.vectorProvider = vectorProviderQdrant
+		c.qdrantURL = url
+		c.qdrantAPIKey = apiKey
+	}
+}
+
 // WithOpenAI sets OpenAI as the AI provider (text + embeddings).
 func WithOpenAI(apiKey string) Option {
 	return func(c *clientConfig) {
@@ -155,6 +223,27 @@ func WithEnrichmentBudget(b search.TokenBudget) Option {
 	}
 }
 
+// WithEmbeddingDailyBudget sets a hard daily token/cost cap on the
+// embedding provider. Once reached, embedding calls fail with
+// usage.ErrBudgetExceeded until the next UTC day, instead of running up a
+// surprise invoice. Unset (the default) leaves embedding unenforced.
+func WithEmbeddingDailyBudget(b usage.Budget) Option {
+	return func(c *clientConfig) {
+		c.embeddingDailyBudget = b
+	}
+}
+
+// WithEnrichmentDailyBudget sets a hard daily token/cost cap on the
+// enrichment (text generation) provider. Once reached, enrichment calls
+// fail with usage.ErrBudgetExceeded until the next UTC day, instead of
+// running up a surprise invoice. Unset (the default) leaves enrichment
+// unenforced.
+func WithEnrichmentDailyBudget(b usage.Budget) Option {
+	return func(c *clientConfig) {
+		c.enrichmentDailyBudget = b
+	}
+}
+
 // WithEmbeddingParallelism sets how many embedding batches are dispatched concurrently.
 // Defaults to 1. Values <= 0 are ignored.
 func WithEmbeddingParallelism(n int) Option {
@@ -185,6 +274,19 @@ func WithEnricherParallelism(n int) Option {
 	}
 }
 
+// WithEmbeddingDimensions truncates stored and query embedding vectors to
+// the first n dimensions, for Matryoshka-capable embedding models. Values
+// <= 0 disable truncation (the default). Changing this setting does not
+// affect vectors already indexed; run RescanAll to re-embed a corpus under
+// the new dimension.
+func WithEmbeddingDimensions(n int) Option {
+	return func(c *clientConfig) {
+		if n > 0 {
+			c.embeddingDimensions = n
+		}
+	}
+}
+
 // WithDataDir sets the data directory for cloned repositories and database storage.
 func WithDataDir(dir string) Option {
 	return func(c *clientConfig) {
@@ -200,6 +302,17 @@ func WithCloneDir(dir string) Option {
 	}
 }
 
+// WithCloneEncryptionKey enables at-rest encryption of Git working copies,
+// using the given hex-encoded AES-256 key (64 hex characters). Working
+// copies are sealed into an encrypted archive between uses and transparently
+// opened again the next time they are updated, so a stolen disk does not
+// leak proprietary source.
+func WithCloneEncryptionKey(keyHex string) Option {
+	return func(c *clientConfig) {
+		c.cloneEncryptionKeyHex = keyHex
+	}
+}
+
 // WithLogger sets a custom logger.
 func WithLogger(l zerolog.Logger) Option {
 	return func(c *clientConfig) {
@@ -233,6 +346,16 @@ func WithWorkerPollPeriod(d time.Duration) Option {
 	}
 }
 
+// WithWorkerDrainTimeout sets how long Close waits for an in-flight task to
+// finish before giving up and requeuing it. Defaults to 30 seconds. Longer
+// values reduce the chance of a task being requeued and redone, at the cost
+// of a slower shutdown — tune to the platform's pod termination grace period.
+func WithWorkerDrainTimeout(d time.Duration) Option {
+	return func(c *clientConfig) {
+		c.workerDrainTimeout = d
+	}
+}
+
 // WithSkipProviderValidation skips the provider configuration validation.
 // This is intended for testing only. In production, embedding and text
 // providers are required for full functionality.
@@ -249,6 +372,50 @@ func WithPeriodicSyncConfig(cfg config.PeriodicSyncConfig) Option {
 	}
 }
 
+// WithHealthAlertConfig sets the repository health alerting configuration.
+func WithHealthAlertConfig(cfg config.HealthAlertConfig) Option {
+	return func(c *clientConfig) {
+		c.healthAlert = cfg
+	}
+}
+
+// WithIntegrityConfig sets the background clone integrity verification configuration.
+func WithIntegrityConfig(cfg config.IntegrityConfig) Option {
+	return func(c *clientConfig) {
+		c.integrity = cfg
+	}
+}
+
+// WithCompactionConfig sets the periodic vector store compaction configuration.
+func WithCompactionConfig(cfg config.CompactionConfig) Option {
+	return func(c *clientConfig) {
+		c.compaction = cfg
+	}
+}
+
+// WithPRPreviewTTL sets how long a pull request branch preview index remains
+// searchable before it becomes eligible for garbage collection. Defaults to
+// service.DefaultPRPreviewTTL.
+func WithPRPreviewTTL(ttl time.Duration) Option {
+	return func(c *clientConfig) {
+		c.prPreviewTTL = ttl
+	}
+}
+
+// WithWarmUpConfig sets the index warm-up configuration.
+func WithWarmUpConfig(cfg config.WarmUpConfig) Option {
+	return func(c *clientConfig) {
+		c.warmUp = cfg
+	}
+}
+
+// WithDiscoveryConfig sets the automatic repository discovery configuration.
+func WithDiscoveryConfig(cfg config.DiscoveryConfig) Option {
+	return func(c *clientConfig) {
+		c.discovery = cfg
+	}
+}
+
 // WithModelDir sets the directory where built-in model files are stored.
 // Defaults to {dataDir}/models if not specified.
 func WithModelDir(dir string) Option {
@@ -264,6 +431,15 @@ func WithChunkParams(params chunking.ChunkParams) Option {
 	}
 }
 
+// WithContextTemplates sets the context header templates prepended to code
+// before it is sent for embedding. An empty config (the default) leaves
+// embedding input unchanged.
+func WithContextTemplates(cfg search.ContextTemplateConfig) Option {
+	return func(c *clientConfig) {
+		c.contextTemplates = cfg
+	}
+}
+
 // WithVisionEmbedder sets the vision embedder. The embedder must accept
 // both image items and text items and produce vectors in the same
 // embedding space. When set, replaces the local SigLIP2 model.
@@ -302,3 +478,18 @@ func WithFullPipeline() Option {
 		}
 	}
 }
+
+// WithHandler registers a handler for a custom task operation, so an
+// application embedding kodit can add its own pipeline steps (e.g. "notify
+// Slack after index") that run inside the same worker, with the same
+// crash-recovery and tracking support as the built-in handlers. operation
+// should use a domain prefix other than "kodit." to avoid colliding with a
+// built-in operation; New returns an error if one is already registered.
+// If the handler's payload shape will change over time, register its
+// schema version with [task.RegisterPayloadVersion] and upgrades with
+// [task.RegisterPayloadUpgrade] so the worker can upgrade older payloads.
+func WithHandler(operation task.Operation, h service.Handler) Option {
+	return func(c *clientConfig) {
+		c.customHandlers[operation] = h
+	}
+}
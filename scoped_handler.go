@@ -19,11 +19,17 @@ func NewScopedMCPHandler(client *Client, repoIDs []int64) http.Handler {
 	semanticSearch := mcpinternal.SemanticSearcher(client.Search)
 	keywordSearch := mcpinternal.KeywordSearcher(client.Search)
 	grepper := mcpinternal.Grepper(client.Grep)
+	overlayer := mcpinternal.Overlayer(client.Overlay)
 	fileLister := mcpinternal.FileLister(client.Blobs)
+	diagramGenerator := mcpinternal.ArchitectureDiagramGenerator(client.ArchitectureDiagram)
+	impactAnalyzer := mcpinternal.RenameImpactAnalyzer(client.RenameImpact)
+	wikier := mcpinternal.Wikier(client.Search)
+	patchSummarizer := mcpinternal.PatchSummarizer(client.PatchSummarizer)
+	commitDiffer := mcpinternal.CommitDiffer(client.CommitDiffer)
 
 	if len(repoIDs) > 0 {
-		repositories, fileContent, semanticSearch, keywordSearch, grepper, fileLister =
-			mcpinternal.Scope(repositories, fileContent, semanticSearch, keywordSearch, grepper, fileLister, repoIDs)
+		repositories, fileContent, semanticSearch, keywordSearch, grepper, overlayer, fileLister, diagramGenerator, impactAnalyzer, wikier, patchSummarizer, commitDiffer =
+			mcpinternal.Scope(repositories, fileContent, semanticSearch, keywordSearch, grepper, overlayer, fileLister, diagramGenerator, impactAnalyzer, wikier, patchSummarizer, commitDiffer, repoIDs)
 	}
 
 	var mcpOpts []mcpinternal.ServerOption
@@ -45,6 +51,12 @@ func NewScopedMCPHandler(client *Client, repoIDs []int64) http.Handler {
 		fileLister,
 		client.Files,
 		grepper,
+		overlayer,
+		impactAnalyzer,
+		diagramGenerator,
+		wikier,
+		patchSummarizer,
+		commitDiffer,
 		"1.0.0",
 		client.logger,
 		mcpOpts...,
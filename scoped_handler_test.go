@@ -24,13 +24,25 @@ func TestScopedMCPServer_RepositoryListFiltered(t *testing.T) {
 		1, 0, "https://github.com/org/allowed", "https://github.com/org/allowed", "",
 		repository.WorkingCopy{}, repository.NewTrackingConfigForBranch("main"),
 		repository.DefaultChunkingConfig(),
+		repository.DefaultEnrichmentBudgetConfig(),
+		repository.DefaultEmbeddingConfig(),
+		repository.DefaultAccessConfig(),
+		repository.DefaultIndexFilterConfig(),
 		time.Now(), time.Now(), time.Time{},
+		"",
+		false, false, false,
 	)
 	repo2 := repository.ReconstructRepository(
 		2, 0, "https://github.com/org/forbidden", "https://github.com/org/forbidden", "",
 		repository.WorkingCopy{}, repository.NewTrackingConfigForBranch("main"),
 		repository.DefaultChunkingConfig(),
+		repository.DefaultEnrichmentBudgetConfig(),
+		repository.DefaultEmbeddingConfig(),
+		repository.DefaultAccessConfig(),
+		repository.DefaultIndexFilterConfig(),
 		time.Now(), time.Now(), time.Time{},
+		"",
+		false, false, false,
 	)
 	commit := repository.ReconstructCommit(
 		1, "abc123", 1, "init", repository.NewAuthor("A", "a@b.c"),
@@ -42,11 +54,17 @@ func TestScopedMCPServer_RepositoryListFiltered(t *testing.T) {
 	semantic := &scopedFakeSemanticSearcher{}
 	keyword := &scopedFakeKeywordSearcher{}
 	grepper := &scopedFakeGrepper{}
+	overlayer := &scopedFakeOverlayer{}
 	fileLister := &scopedFakeFileLister{}
+	diagramGenerator := &scopedFakeArchitectureDiagramGenerator{}
+	impactAnalyzer := &scopedFakeImpactAnalyzer{}
+	wikier := &scopedFakeWikier{}
+	patchSummarizer := &scopedFakePatchSummarizer{}
+	commitDiffer := &scopedFakeCommitDiffer{}
 
 	// Scope to only repo 1.
-	scopedRepos, scopedFC, scopedSS, scopedKS, scopedG, scopedFL :=
-		mcpinternal.Scope(repos, fileContent, semantic, keyword, grepper, fileLister, []int64{1})
+	scopedRepos, scopedFC, scopedSS, scopedKS, scopedG, scopedOV, scopedFL, scopedDG, scopedIA, scopedW, scopedPS, scopedCD :=
+		mcpinternal.Scope(repos, fileContent, semantic, keyword, grepper, overlayer, fileLister, diagramGenerator, impactAnalyzer, wikier, patchSummarizer, commitDiffer, []int64{1})
 
 	srv := mcpinternal.NewServer(
 		scopedRepos,
@@ -64,6 +82,12 @@ func TestScopedMCPServer_RepositoryListFiltered(t *testing.T) {
 		scopedFL,
 		&scopedFakeFileFinder{},
 		scopedG,
+		scopedOV,
+		scopedIA,
+		scopedDG,
+		scopedW,
+		scopedPS,
+		scopedCD,
 		"test",
 		zerolog.Nop(),
 	)
@@ -103,16 +127,22 @@ func TestScopedMCPServer_ReadResourceBlocked(t *testing.T) {
 		1, 0, "https://github.com/org/allowed", "https://github.com/org/allowed", "",
 		repository.WorkingCopy{}, repository.NewTrackingConfigForBranch("main"),
 		repository.DefaultChunkingConfig(),
+		repository.DefaultEnrichmentBudgetConfig(),
+		repository.DefaultEmbeddingConfig(),
+		repository.DefaultAccessConfig(),
+		repository.DefaultIndexFilterConfig(),
 		time.Now(), time.Now(), time.Time{},
+		"",
+		false, false, false,
 	)
 
 	repos := &scopedFakeRepositoryLister{repos: []repository.Repository{repo1}}
 	fileContent := &scopedFakeFileContentReader{content: []byte("secret")}
 
-	_, scopedFC, scopedSS, scopedKS, scopedG, scopedFL :=
+	_, scopedFC, scopedSS, scopedKS, scopedG, scopedOV, scopedFL, scopedDG, scopedIA, scopedW, scopedPS, scopedCD :=
 		mcpinternal.Scope(repos, fileContent,
 			&scopedFakeSemanticSearcher{}, &scopedFakeKeywordSearcher{},
-			&scopedFakeGrepper{}, &scopedFakeFileLister{}, []int64{1})
+			&scopedFakeGrepper{}, &scopedFakeOverlayer{}, &scopedFakeFileLister{}, &scopedFakeArchitectureDiagramGenerator{}, &scopedFakeImpactAnalyzer{}, &scopedFakeWikier{}, &scopedFakePatchSummarizer{}, &scopedFakeCommitDiffer{}, []int64{1})
 
 	srv := mcpinternal.NewServer(
 		repos, // unscoped repos is fine here — we test file content gating
@@ -129,6 +159,12 @@ func TestScopedMCPServer_ReadResourceBlocked(t *testing.T) {
 		scopedFL,
 		&scopedFakeFileFinder{},
 		scopedG,
+		scopedOV,
+		scopedIA,
+		scopedDG,
+		scopedW,
+		scopedPS,
+		scopedCD,
 		"test",
 		zerolog.Nop(),
 	)
@@ -161,13 +197,25 @@ func TestScopedMCPServer_NilRepoIDsNoScoping(t *testing.T) {
 		1, 0, "https://github.com/org/repo1", "https://github.com/org/repo1", "",
 		repository.WorkingCopy{}, repository.NewTrackingConfigForBranch("main"),
 		repository.DefaultChunkingConfig(),
+		repository.DefaultEnrichmentBudgetConfig(),
+		repository.DefaultEmbeddingConfig(),
+		repository.DefaultAccessConfig(),
+		repository.DefaultIndexFilterConfig(),
 		time.Now(), time.Now(), time.Time{},
+		"",
+		false, false, false,
 	)
 	repo2 := repository.ReconstructRepository(
 		2, 0, "https://github.com/org/repo2", "https://github.com/org/repo2", "",
 		repository.WorkingCopy{}, repository.NewTrackingConfigForBranch("main"),
 		repository.DefaultChunkingConfig(),
+		repository.DefaultEnrichmentBudgetConfig(),
+		repository.DefaultEmbeddingConfig(),
+		repository.DefaultAccessConfig(),
+		repository.DefaultIndexFilterConfig(),
 		time.Now(), time.Now(), time.Time{},
+		"",
+		false, false, false,
 	)
 	commit := repository.ReconstructCommit(
 		1, "abc123", 1, "init", repository.NewAuthor("A", "a@b.c"),
@@ -190,6 +238,12 @@ func TestScopedMCPServer_NilRepoIDsNoScoping(t *testing.T) {
 		&scopedFakeFileLister{},
 		&scopedFakeFileFinder{},
 		&scopedFakeGrepper{},
+		&scopedFakeOverlayer{},
+		&scopedFakeImpactAnalyzer{},
+		&scopedFakeArchitectureDiagramGenerator{},
+		&scopedFakeWikier{},
+		&scopedFakePatchSummarizer{},
+		&scopedFakeCommitDiffer{},
 		"test",
 		zerolog.Nop(),
 	)
@@ -275,7 +329,13 @@ func TestScopedMCPServer_ListRepositories_SanitizesCredentials(t *testing.T) {
 		repository.WorkingCopy{},
 		repository.NewTrackingConfigForBranch("main"),
 		repository.DefaultChunkingConfig(),
+		repository.DefaultEnrichmentBudgetConfig(),
+		repository.DefaultEmbeddingConfig(),
+		repository.DefaultAccessConfig(),
+		repository.DefaultIndexFilterConfig(),
 		time.Now(), time.Now(), time.Time{},
+		"",
+		false, false, false,
 	)
 	commit := repository.ReconstructCommit(
 		1, "abc123", 1, "init", repository.NewAuthor("A", "a@b.c"),
@@ -283,10 +343,10 @@ func TestScopedMCPServer_ListRepositories_SanitizesCredentials(t *testing.T) {
 	)
 
 	repos := &scopedFakeRepositoryLister{repos: []repository.Repository{repo}}
-	scopedRepos, scopedFC, scopedSS, scopedKS, scopedG, scopedFL :=
+	scopedRepos, scopedFC, scopedSS, scopedKS, scopedG, scopedOV, scopedFL, scopedDG, scopedIA, scopedW, scopedPS, scopedCD :=
 		mcpinternal.Scope(repos, &scopedFakeFileContentReader{},
 			&scopedFakeSemanticSearcher{}, &scopedFakeKeywordSearcher{},
-			&scopedFakeGrepper{}, &scopedFakeFileLister{}, []int64{1})
+			&scopedFakeGrepper{}, &scopedFakeOverlayer{}, &scopedFakeFileLister{}, &scopedFakeArchitectureDiagramGenerator{}, &scopedFakeImpactAnalyzer{}, &scopedFakeWikier{}, &scopedFakePatchSummarizer{}, &scopedFakeCommitDiffer{}, []int64{1})
 
 	srv := mcpinternal.NewServer(
 		scopedRepos,
@@ -303,6 +363,12 @@ func TestScopedMCPServer_ListRepositories_SanitizesCredentials(t *testing.T) {
 		scopedFL,
 		&scopedFakeFileFinder{},
 		scopedG,
+		scopedOV,
+		scopedIA,
+		scopedDG,
+		scopedW,
+		scopedPS,
+		scopedCD,
 		"test",
 		zerolog.Nop(),
 	)
@@ -397,6 +463,10 @@ func (f *scopedFakeEnrichmentQuery) List(_ context.Context, _ *service.Enrichmen
 	return f.enrichments, nil
 }
 
+func (f *scopedFakeEnrichmentQuery) Count(_ context.Context, _ *service.EnrichmentListParams) (int64, error) {
+	return int64(len(f.enrichments)), nil
+}
+
 type scopedFakeFileContentReader struct {
 	content []byte
 }
@@ -435,6 +505,10 @@ func (f *scopedFakeEnrichmentResolver) RepositoryIDs(_ context.Context, _ []int6
 	return f.repositoryIDs, nil
 }
 
+func (f *scopedFakeEnrichmentResolver) ExplainPath(_ context.Context, _ string, _ int64, _ string) ([]service.PathSummary, error) {
+	return nil, nil
+}
+
 type scopedFakeFileFinder struct{}
 
 func (f *scopedFakeFileFinder) Find(_ context.Context, _ ...repository.Option) ([]repository.File, error) {
@@ -447,8 +521,52 @@ func (f *scopedFakeGrepper) Search(_ context.Context, _ int64, _ string, _ strin
 	return nil, nil
 }
 
+type scopedFakeOverlayer struct{}
+
+func (f *scopedFakeOverlayer) Search(_ context.Context, _ int64, _ string) ([]service.OverlaySnippet, error) {
+	return nil, nil
+}
+
 type scopedFakeFileLister struct{}
 
 func (f *scopedFakeFileLister) ListFiles(_ context.Context, _ int64, _ string) ([]service.FileEntry, error) {
 	return nil, nil
 }
+
+type scopedFakeArchitectureDiagramGenerator struct{}
+
+func (f *scopedFakeArchitectureDiagramGenerator) Generate(_ context.Context, _ int64) (string, error) {
+	return "", nil
+}
+
+type scopedFakeImpactAnalyzer struct {
+	impacts []service.RepoImpact
+}
+
+func (f *scopedFakeImpactAnalyzer) Analyze(_ context.Context, _ string, _ int) ([]service.RepoImpact, error) {
+	return f.impacts, nil
+}
+
+type scopedFakeWikier struct {
+	matches []service.WikiPageMatch
+}
+
+func (f *scopedFakeWikier) SearchWiki(_ context.Context, _ int64, _ string, _ int) ([]service.WikiPageMatch, error) {
+	return f.matches, nil
+}
+
+type scopedFakePatchSummarizer struct {
+	summary service.PatchSummary
+}
+
+func (f *scopedFakePatchSummarizer) Summarize(_ context.Context, _ int64, _, _, _ string) (service.PatchSummary, error) {
+	return f.summary, nil
+}
+
+type scopedFakeCommitDiffer struct {
+	diff string
+}
+
+func (f *scopedFakeCommitDiffer) Diff(_ context.Context, _ int64, _, _ string) (string, error) {
+	return f.diff, nil
+}
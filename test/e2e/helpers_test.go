@@ -1,37 +1,31 @@
 package e2e_test
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
-	"io"
 	"net/http"
-	"net/http/httptest"
-	"path/filepath"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
-	"github.com/go-chi/chi/v5"
 	"github.com/helixml/kodit"
 	"github.com/helixml/kodit/domain/enrichment"
 	"github.com/helixml/kodit/domain/repository"
 	"github.com/helixml/kodit/domain/sourcelocation"
 	"github.com/helixml/kodit/domain/task"
-	"github.com/helixml/kodit/infrastructure/api"
-	apimiddleware "github.com/helixml/kodit/infrastructure/api/middleware"
-	v1 "github.com/helixml/kodit/infrastructure/api/v1"
 	"github.com/helixml/kodit/infrastructure/persistence"
 	"github.com/helixml/kodit/internal/config"
 	"github.com/helixml/kodit/internal/database"
+	"github.com/helixml/kodit/kodittest"
 )
 
-// TestServer wraps the API server for e2e testing.
+// TestServer wraps kodittest.Server with the store handles e2e tests need
+// for direct database manipulation (seeding fixtures kodittest itself has
+// no reason to know about).
 type TestServer struct {
-	t          *testing.T
-	client     *kodit.Client
-	db         database.Database
-	httpServer *httptest.Server
+	*kodittest.Server
+	t  *testing.T
+	db database.Database
 
 	// Stores - for direct DB manipulation in tests
 	repoStore           persistence.RepositoryStore
@@ -47,198 +41,51 @@ type TestServer struct {
 }
 
 // NewTestServer creates a new test server with all dependencies wired up.
-// Creates a kodit.Client backed by SQLite and a separate DB handle for test data seeding.
+// Starts a kodittest.Server backed by SQLite and opens a separate DB handle
+// for seeding test data directly.
 func NewTestServer(t *testing.T) *TestServer {
 	t.Helper()
 
-	ctx := context.Background()
-	tmpDir := t.TempDir()
-	dbPath := filepath.Join(tmpDir, "test.db")
-
-	// Create the kodit client first.
 	// Disable periodic sync so the initial sync goroutine does not
 	// race with tests that assert on repository status.
 	syncCfg := config.NewPeriodicSyncConfig().WithEnabled(false)
-	client, err := kodit.New(
-		kodit.WithSQLite(dbPath),
-		kodit.WithDataDir(tmpDir),
-		kodit.WithSkipProviderValidation(),
+	server := kodittest.New(t, kodittest.WithClientOptions(
 		kodit.WithPeriodicSyncConfig(syncCfg),
-	)
-	if err != nil {
-		t.Fatalf("create kodit client: %v", err)
-	}
+	))
 
-	// Open a separate DB handle for seeding test data
-	db, err := database.NewDatabase(ctx, "sqlite:///"+dbPath)
+	db, err := database.NewDatabase(context.Background(), "sqlite:///"+server.DBPath())
 	if err != nil {
 		t.Fatalf("create database: %v", err)
 	}
+	t.Cleanup(func() { _ = db.Close() })
 
-	// Create stores for direct test data manipulation
-	repoStore := persistence.NewRepositoryStore(db)
-	commitStore := persistence.NewCommitStore(db)
-	branchStore := persistence.NewBranchStore(db)
-	tagStore := persistence.NewTagStore(db)
-	fileStore := persistence.NewFileStore(db)
-	taskStore := persistence.NewTaskStore(db)
-	taskStatusStore := persistence.NewStatusStore(db)
-	enrichmentStore := persistence.NewEnrichmentStore(db)
-	associationStore := persistence.NewAssociationStore(db)
-	sourceLocationStore := persistence.NewSourceLocationStore(db)
-	// Create API server using the client
-	logger := client.Logger()
-	server := api.NewServer(":0", logger)
-	router := server.Router()
-
-	// Apply middleware
-	router.Use(apimiddleware.Logging(logger))
-	router.Use(apimiddleware.CorrelationID)
-
-	// Register routes — each router takes just the client
-	router.Route("/api/v1", func(r chi.Router) {
-		r.Mount("/repositories", v1.NewRepositoriesRouter(client).Routes())
-		r.Mount("/enrichments", v1.NewEnrichmentsRouter(client).Routes())
-		r.Mount("/queue", v1.NewQueueRouter(client).Routes())
-
-		r.Mount("/search", v1.NewSearchRouter(client).Routes())
-	})
-
-	// Health check
-	router.Get("/healthz", func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-	})
-
-	// Create httptest server
-	httpServer := httptest.NewServer(router)
-
-	ts := &TestServer{
+	return &TestServer{
+		Server:              server,
 		t:                   t,
-		client:              client,
 		db:                  db,
-		httpServer:          httpServer,
-		repoStore:           repoStore,
-		commitStore:         commitStore,
-		branchStore:         branchStore,
-		tagStore:            tagStore,
-		fileStore:           fileStore,
-		taskStore:           taskStore,
-		taskStatusStore:     taskStatusStore,
-		enrichmentStore:     enrichmentStore,
-		associationStore:    associationStore,
-		sourceLocationStore: sourceLocationStore,
-	}
-
-	t.Cleanup(func() {
-		ts.Close()
-	})
-
-	return ts
-}
-
-// URL returns the base URL of the test server.
-func (ts *TestServer) URL() string {
-	return ts.httpServer.URL
-}
-
-// Close shuts down the test server.
-func (ts *TestServer) Close() {
-	ts.httpServer.Close()
-	_ = ts.client.Close()
-	_ = ts.db.Close()
-}
-
-// GET performs a GET request and returns the response.
-func (ts *TestServer) GET(path string) *http.Response {
-	ts.t.Helper()
-	resp, err := http.Get(ts.URL() + path)
-	if err != nil {
-		ts.t.Fatalf("GET %s: %v", path, err)
+		repoStore:           persistence.NewRepositoryStore(db),
+		commitStore:         persistence.NewCommitStore(db),
+		branchStore:         persistence.NewBranchStore(db),
+		tagStore:            persistence.NewTagStore(db),
+		fileStore:           persistence.NewFileStore(db),
+		taskStore:           persistence.NewTaskStore(db),
+		taskStatusStore:     persistence.NewStatusStore(db),
+		enrichmentStore:     persistence.NewEnrichmentStore(db),
+		associationStore:    persistence.NewAssociationStore(db),
+		sourceLocationStore: persistence.NewSourceLocationStore(db),
 	}
-	return resp
-}
-
-// POST performs a POST request with JSON body and returns the response.
-func (ts *TestServer) POST(path string, body any) *http.Response {
-	ts.t.Helper()
-	jsonBody, err := json.Marshal(body)
-	if err != nil {
-		ts.t.Fatalf("marshal body: %v", err)
-	}
-	resp, err := http.Post(ts.URL()+path, "application/json", bytes.NewReader(jsonBody))
-	if err != nil {
-		ts.t.Fatalf("POST %s: %v", path, err)
-	}
-	return resp
 }
 
 // POSTRaw performs a POST request with a raw string body and returns the response.
 func (ts *TestServer) POSTRaw(path string, body string) *http.Response {
 	ts.t.Helper()
-	resp, err := http.Post(ts.URL()+path, "application/json", bytes.NewReader([]byte(body)))
+	resp, err := http.Post(ts.URL()+path, "application/json", strings.NewReader(body))
 	if err != nil {
 		ts.t.Fatalf("POST %s: %v", path, err)
 	}
 	return resp
 }
 
-// PUT performs a PUT request with JSON body and returns the response.
-func (ts *TestServer) PUT(path string, body any) *http.Response {
-	ts.t.Helper()
-	jsonBody, err := json.Marshal(body)
-	if err != nil {
-		ts.t.Fatalf("marshal body: %v", err)
-	}
-	req, err := http.NewRequest(http.MethodPut, ts.URL()+path, bytes.NewReader(jsonBody))
-	if err != nil {
-		ts.t.Fatalf("create PUT request: %v", err)
-	}
-	req.Header.Set("Content-Type", "application/json")
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		ts.t.Fatalf("PUT %s: %v", path, err)
-	}
-	return resp
-}
-
-// DELETE performs a DELETE request and returns the response.
-func (ts *TestServer) DELETE(path string) *http.Response {
-	ts.t.Helper()
-	req, err := http.NewRequest(http.MethodDelete, ts.URL()+path, nil)
-	if err != nil {
-		ts.t.Fatalf("create DELETE request: %v", err)
-	}
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		ts.t.Fatalf("DELETE %s: %v", path, err)
-	}
-	return resp
-}
-
-// DecodeJSON decodes the response body as JSON into v.
-func (ts *TestServer) DecodeJSON(resp *http.Response, v any) {
-	ts.t.Helper()
-	defer func() {
-		_ = resp.Body.Close()
-	}()
-	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
-		ts.t.Fatalf("decode response: %v", err)
-	}
-}
-
-// ReadBody reads and returns the response body as a string.
-func (ts *TestServer) ReadBody(resp *http.Response) string {
-	ts.t.Helper()
-	defer func() {
-		_ = resp.Body.Close()
-	}()
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		ts.t.Fatalf("read body: %v", err)
-	}
-	return string(body)
-}
-
 // CreateRepository creates a repository in the database directly.
 func (ts *TestServer) CreateRepository(remoteURL string) repository.Repository {
 	ts.t.Helper()
@@ -248,7 +95,7 @@ func (ts *TestServer) CreateRepository(remoteURL string) repository.Repository {
 	if err != nil {
 		ts.t.Fatalf("create repo: %v", err)
 	}
-	defaultPID, err := ts.client.Pipelines.DefaultID(ctx)
+	defaultPID, err := ts.Client().Pipelines.DefaultID(ctx)
 	if err != nil {
 		ts.t.Fatalf("get default pipeline: %v", err)
 	}
@@ -376,7 +223,7 @@ func (ts *TestServer) CreateRepositoryWithWorkingCopy(remoteURL string) reposito
 		ts.t.Fatalf("create repo: %v", err)
 	}
 
-	defaultPID, err := ts.client.Pipelines.DefaultID(ctx)
+	defaultPID, err := ts.Client().Pipelines.DefaultID(ctx)
 	if err != nil {
 		ts.t.Fatalf("get default pipeline: %v", err)
 	}